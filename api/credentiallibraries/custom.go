@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibraries
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/history"
+)
+
+type HistoryResult struct {
+	Items    []*history.Record
+	response *api.Response
+}
+
+func (n HistoryResult) GetItems() []*history.Record {
+	return n.Items
+}
+
+func (n HistoryResult) GetResponse() *api.Response {
+	return n.response
+}
+
+func (c *Client) History(ctx context.Context, credentialLibraryId string, opt ...Option) (*HistoryResult, error) {
+	if credentialLibraryId == "" {
+		return nil, fmt.Errorf("empty credentialLibraryId value passed into History request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "credential-libraries/"+url.PathEscape(credentialLibraryId)+":history", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating History request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during History call: %w", err)
+	}
+
+	target := new(HistoryResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding History response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+type RestoreResult struct {
+	Changes  []*history.Change `json:"changes,omitempty"`
+	DryRun   bool              `json:"dry_run,omitempty"`
+	response *api.Response
+}
+
+func (n RestoreResult) GetChanges() []*history.Change {
+	return n.Changes
+}
+
+func (n RestoreResult) GetDryRun() bool {
+	return n.DryRun
+}
+
+func (n RestoreResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Restore restores the credential library to its state at the given point in time, as
+// recorded in the oplog. If dryRun is set, the changes which would be made
+// are returned without being applied.
+func (c *Client) Restore(ctx context.Context, credentialLibraryId string, at time.Time, dryRun bool, opt ...Option) (*RestoreResult, error) {
+	if credentialLibraryId == "" {
+		return nil, fmt.Errorf("empty credentialLibraryId value passed into Restore request")
+	}
+	if at.IsZero() {
+		return nil, fmt.Errorf("empty time value passed into Restore request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["time"] = at.UTC().Format(time.RFC3339Nano)
+	opts.postMap["dry_run"] = dryRun
+
+	req, err := c.client.NewRequest(ctx, "POST", "credential-libraries/"+url.PathEscape(credentialLibraryId)+":restore", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Restore request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Restore call: %w", err)
+	}
+
+	target := new(RestoreResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Restore response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -77,6 +77,30 @@ func WithFilter(filter string) Option {
 	}
 }
 
+func WithVaultPkiCredentialLibraryAltNames(inAltNames string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["alt_names"] = inAltNames
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultPkiCredentialLibraryAltNames() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["alt_names"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -89,6 +113,42 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithKubernetesCredentialLibraryAudience(inAudience string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["audience"] = inAudience
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesCredentialLibraryAudience() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["audience"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultPkiCredentialLibraryCommonName(inCommonName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["common_name"] = inCommonName
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithCredentialMappingOverrides(inCredentialMappingOverrides map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["credential_mapping_overrides"] = inCredentialMappingOverrides
@@ -149,6 +209,30 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithAwsCredentialLibraryDurationSeconds(inDurationSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["duration_seconds"] = inDurationSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialLibraryDurationSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["duration_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryExtensions(inExtensions map[string]string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -173,6 +257,30 @@ func DefaultVaultSSHCertificateCredentialLibraryExtensions() Option {
 	}
 }
 
+func WithAwsCredentialLibraryFederatedSignin(inFederatedSignin bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["federated_signin"] = inFederatedSignin
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialLibraryFederatedSignin() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["federated_signin"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialLibraryHttpMethod(inHttpMethod string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -221,6 +329,54 @@ func DefaultVaultCredentialLibraryHttpRequestBody() Option {
 	}
 }
 
+func WithVaultPkiCredentialLibraryIpSans(inIpSans string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ip_sans"] = inIpSans
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultPkiCredentialLibraryIpSans() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ip_sans"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultPkiCredentialLibraryKeyBits(inKeyBits uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_bits"] = inKeyBits
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultPkiCredentialLibraryKeyBits() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_bits"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryKeyBits(inKeyBits uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -269,6 +425,30 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyId() Option {
 	}
 }
 
+func WithVaultPkiCredentialLibraryKeyType(inKeyType string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_type"] = inKeyType
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultPkiCredentialLibraryKeyType() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["key_type"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryKeyType(inKeyType string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -305,6 +485,18 @@ func DefaultName() Option {
 	}
 }
 
+func WithKubernetesCredentialLibraryNamespace(inNamespace string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["namespace"] = inNamespace
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialLibraryPath(inPath string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -317,6 +509,18 @@ func WithVaultCredentialLibraryPath(inPath string) Option {
 	}
 }
 
+func WithVaultPkiCredentialLibraryPath(inPath string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["path"] = inPath
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryPath(inPath string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -329,6 +533,102 @@ func WithVaultSSHCertificateCredentialLibraryPath(inPath string) Option {
 	}
 }
 
+func WithAwsCredentialLibraryRoleArn(inRoleArn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["role_arn"] = inRoleArn
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithKubernetesCredentialLibraryServiceAccount(inServiceAccount string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["service_account"] = inServiceAccount
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithAwsCredentialLibrarySessionName(inSessionName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_name"] = inSessionName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialLibrarySessionName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithAwsCredentialLibrarySessionPolicy(inSessionPolicy string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_policy"] = inSessionPolicy
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialLibrarySessionPolicy() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["session_policy"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultPkiCredentialLibraryTtl(inTtl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ttl"] = inTtl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultPkiCredentialLibraryTtl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ttl"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryTtl(inTtl string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -353,6 +653,30 @@ func DefaultVaultSSHCertificateCredentialLibraryTtl() Option {
 	}
 }
 
+func WithKubernetesCredentialLibraryTtlSeconds(inTtlSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ttl_seconds"] = inTtlSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesCredentialLibraryTtlSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ttl_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryUsername(inUsername string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
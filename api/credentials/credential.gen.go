@@ -13,17 +13,18 @@ import (
 )
 
 type Credential struct {
-	Id                string                 `json:"id,omitempty"`
-	CredentialStoreId string                 `json:"credential_store_id,omitempty"`
-	Scope             *scopes.ScopeInfo      `json:"scope,omitempty"`
-	Name              string                 `json:"name,omitempty"`
-	Description       string                 `json:"description,omitempty"`
-	CreatedTime       time.Time              `json:"created_time,omitempty"`
-	UpdatedTime       time.Time              `json:"updated_time,omitempty"`
-	Version           uint32                 `json:"version,omitempty"`
-	Type              string                 `json:"type,omitempty"`
-	Attributes        map[string]interface{} `json:"attributes,omitempty"`
-	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
+	Id                     string                 `json:"id,omitempty"`
+	CredentialStoreId      string                 `json:"credential_store_id,omitempty"`
+	Scope                  *scopes.ScopeInfo      `json:"scope,omitempty"`
+	Name                   string                 `json:"name,omitempty"`
+	Description            string                 `json:"description,omitempty"`
+	CreatedTime            time.Time              `json:"created_time,omitempty"`
+	UpdatedTime            time.Time              `json:"updated_time,omitempty"`
+	Version                uint32                 `json:"version,omitempty"`
+	Type                   string                 `json:"type,omitempty"`
+	MaxConcurrentCheckouts uint32                 `json:"max_concurrent_checkouts,omitempty"`
+	Attributes             map[string]interface{} `json:"attributes,omitempty"`
+	AuthorizedActions      []string               `json:"authorized_actions,omitempty"`
 
 	response *api.Response
 }
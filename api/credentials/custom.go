@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type CheckoutsResult struct {
+	Items    []*CredentialCheckout
+	response *api.Response
+}
+
+func (n CheckoutsResult) GetItems() []*CredentialCheckout {
+	return n.Items
+}
+
+func (n CheckoutsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListCheckouts returns the check-outs of the credential currently held by
+// sessions.
+func (c *Client) ListCheckouts(ctx context.Context, credentialId string, opt ...Option) (*CheckoutsResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into ListCheckouts request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "credentials/"+url.PathEscape(credentialId)+":list-checkouts", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListCheckouts request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListCheckouts call: %w", err)
+	}
+
+	target := new(CheckoutsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListCheckouts response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// CheckIn forcibly releases the check-out of the credential held by the given
+// session, making it available to other sessions. The session itself is not
+// canceled. The remaining check-outs of the credential are returned.
+func (c *Client) CheckIn(ctx context.Context, credentialId, sessionId string, opt ...Option) (*CheckoutsResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into CheckIn request")
+	}
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into CheckIn request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["session_id"] = sessionId
+
+	req, err := c.client.NewRequest(ctx, "POST", "credentials/"+url.PathEscape(credentialId)+":check-in", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CheckIn request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CheckIn call: %w", err)
+	}
+
+	target := new(CheckoutsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CheckIn response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+type RotationPolicyResult struct {
+	Item     *CredentialRotationPolicy
+	response *api.Response
+}
+
+func (n RotationPolicyResult) GetItem() *CredentialRotationPolicy {
+	return n.Item
+}
+
+func (n RotationPolicyResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type RotationPolicyRemoveResult struct {
+	response *api.Response
+}
+
+func (n RotationPolicyRemoveResult) GetResponse() *api.Response {
+	return n.response
+}
+
+type RotationsResult struct {
+	Policy   *CredentialRotationPolicy
+	Items    []*CredentialRotation
+	response *api.Response
+}
+
+func (n RotationsResult) GetPolicy() *CredentialRotationPolicy {
+	return n.Policy
+}
+
+func (n RotationsResult) GetItems() []*CredentialRotation {
+	return n.Items
+}
+
+func (n RotationsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// SetRotationPolicy sets the rotation policy of the username_password
+// credential, replacing any existing policy. Only the rotator, address, admin
+// credential id, rotate after session and rotation interval hours fields of
+// policy are used.
+func (c *Client) SetRotationPolicy(ctx context.Context, credentialId string, policy *CredentialRotationPolicy, opt ...Option) (*RotationPolicyResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into SetRotationPolicy request")
+	}
+	if policy == nil {
+		return nil, fmt.Errorf("nil policy passed into SetRotationPolicy request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	item := map[string]any{
+		"rotator": policy.Rotator,
+		"address": policy.Address,
+	}
+	if policy.AdminCredentialId != "" {
+		item["admin_credential_id"] = policy.AdminCredentialId
+	}
+	if policy.HostKey != "" {
+		item["host_key"] = policy.HostKey
+	}
+	if policy.RotateAfterSession {
+		item["rotate_after_session"] = true
+	}
+	if policy.RotationIntervalHours != 0 {
+		item["rotation_interval_hours"] = policy.RotationIntervalHours
+	}
+	opts.postMap["item"] = item
+
+	req, err := c.client.NewRequest(ctx, "POST", "credentials/"+url.PathEscape(credentialId)+":set-rotation-policy", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SetRotationPolicy request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during SetRotationPolicy call: %w", err)
+	}
+
+	target := new(RotationPolicyResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding SetRotationPolicy response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RemoveRotationPolicy removes the rotation policy of the credential. Its
+// password is no longer rotated.
+func (c *Client) RemoveRotationPolicy(ctx context.Context, credentialId string, opt ...Option) (*RotationPolicyRemoveResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into RemoveRotationPolicy request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", "credentials/"+url.PathEscape(credentialId)+":remove-rotation-policy", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveRotationPolicy request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveRotationPolicy call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveRotationPolicy response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &RotationPolicyRemoveResult{response: resp}, nil
+}
+
+// ListRotations returns the rotation policy of the credential along with its
+// rotation history, most recent first.
+func (c *Client) ListRotations(ctx context.Context, credentialId string, opt ...Option) (*RotationsResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into ListRotations request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "credentials/"+url.PathEscape(credentialId)+":list-rotations", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListRotations request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListRotations call: %w", err)
+	}
+
+	target := new(RotationsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListRotations response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+type VersionsResult struct {
+	Items    []*CredentialVersion
+	response *api.Response
+}
+
+func (n VersionsResult) GetItems() []*CredentialVersion {
+	return n.Items
+}
+
+func (n VersionsResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// ListVersions returns the versions of the credential that are retained for
+// rollback, most recent first.
+func (c *Client) ListVersions(ctx context.Context, credentialId string, opt ...Option) (*VersionsResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into ListVersions request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "credentials/"+url.PathEscape(credentialId)+":list-versions", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListVersions request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListVersions call: %w", err)
+	}
+
+	target := new(VersionsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListVersions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// WithCredentialVersion tells Read to return the credential as it was at the
+// given version. Only versions returned by ListVersions can be read.
+func WithCredentialVersion(version uint32) Option {
+	return func(o *options) {
+		o.queryMap["version"] = strconv.FormatUint(uint64(version), 10)
+	}
+}
+
+// Rollback restores the credential, including its secret, to the state it
+// was in at toVersion. The rollback is recorded as a new version of the
+// credential. version must be the current version of the credential.
+func (c *Client) Rollback(ctx context.Context, credentialId string, version, toVersion uint32, opt ...Option) (*CredentialUpdateResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credentialId value passed into Rollback request")
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("zero version number passed into Rollback request")
+	}
+	if toVersion == 0 {
+		return nil, fmt.Errorf("zero toVersion number passed into Rollback request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["version"] = version
+	opts.postMap["to_version"] = toVersion
+
+	req, err := c.client.NewRequest(ctx, "POST", "credentials/"+url.PathEscape(credentialId)+":rollback", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Rollback request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Rollback call: %w", err)
+	}
+
+	target := new(CredentialUpdateResult)
+	target.Item = new(Credential)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Rollback response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -89,6 +89,42 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithTlsClientCertificateCredentialCaBundle(inCaBundle string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_bundle"] = inCaBundle
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTlsClientCertificateCredentialCaBundle() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_bundle"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithTlsClientCertificateCredentialCertificate(inCertificate string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificate"] = inCertificate
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -101,6 +137,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithMaxConcurrentCheckouts(inMaxConcurrentCheckouts uint32) Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_checkouts"] = inMaxConcurrentCheckouts
+	}
+}
+
+func DefaultMaxConcurrentCheckouts() Option {
+	return func(o *options) {
+		o.postMap["max_concurrent_checkouts"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -149,6 +197,18 @@ func WithSshPrivateKeyCredentialPrivateKey(inPrivateKey string) Option {
 	}
 }
 
+func WithTlsClientCertificateCredentialPrivateKey(inPrivateKey string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["private_key"] = inPrivateKey
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshPrivateKeyCredentialPrivateKeyPassphrase(inPrivateKeyPassphrase string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -173,6 +233,30 @@ func DefaultSshPrivateKeyCredentialPrivateKeyPassphrase() Option {
 	}
 }
 
+func WithTlsClientCertificateCredentialPrivateKeyPassphrase(inPrivateKeyPassphrase string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["private_key_passphrase"] = inPrivateKeyPassphrase
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultTlsClientCertificateCredentialPrivateKeyPassphrase() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["private_key_passphrase"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshPrivateKeyCredentialUsername(inUsername string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialstores
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// RotateToken replaces the Vault token of the vault credential store. The
+// replaced token is revoked by Boundary once all credentials issued with it
+// have been revoked or have expired. If version is zero and automatic
+// versioning is enabled the current version of the credential store is read
+// first.
+func (c *Client) RotateToken(ctx context.Context, credentialStoreId string, version uint32, token string, opt ...Option) (*CredentialStoreUpdateResult, error) {
+	if credentialStoreId == "" {
+		return nil, fmt.Errorf("empty credentialStoreId value passed into RotateToken request")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("empty token value passed into RotateToken request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if version == 0 {
+		if !opts.withAutomaticVersioning {
+			return nil, errors.New("zero version number passed into RotateToken request and automatic versioning not specified")
+		}
+		existingTarget, existingErr := c.Read(ctx, credentialStoreId, append([]Option{WithSkipCurlOutput(true)}, opt...)...)
+		if existingErr != nil {
+			if api.AsServerError(existingErr) != nil {
+				return nil, fmt.Errorf("error from controller when performing initial check-and-set read: %w", existingErr)
+			}
+			return nil, fmt.Errorf("error performing initial check-and-set read: %w", existingErr)
+		}
+		if existingTarget == nil {
+			return nil, errors.New("nil resource response found when performing initial check-and-set read")
+		}
+		if existingTarget.Item == nil {
+			return nil, errors.New("nil resource found when performing initial check-and-set read")
+		}
+		version = existingTarget.Item.Version
+	}
+
+	opts.postMap["version"] = version
+	opts.postMap["token"] = token
+
+	req, err := c.client.NewRequest(ctx, "POST", "credential-stores/"+url.PathEscape(credentialStoreId)+":rotate-token", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RotateToken request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RotateToken call: %w", err)
+	}
+
+	target := new(CredentialStoreUpdateResult)
+	target.Item = new(CredentialStore)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RotateToken response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -4,6 +4,7 @@
 package credentialstores
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -90,6 +91,30 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithAwsCredentialStoreAccessKeyId(inAccessKeyId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["access_key_id"] = inAccessKeyId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialStoreAccessKeyId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["access_key_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreAddress(inAddress string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -102,6 +127,30 @@ func WithVaultCredentialStoreAddress(inAddress string) Option {
 	}
 }
 
+func WithKubernetesCredentialStoreApiUrl(inApiUrl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["api_url"] = inApiUrl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesCredentialStoreApiUrl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["api_url"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -114,6 +163,30 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithKubernetesCredentialStoreCaCert(inCaCert string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_cert"] = inCaCert
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultKubernetesCredentialStoreCaCert() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ca_cert"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreCaCert(inCaCert string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -234,6 +307,126 @@ func DefaultVaultCredentialStoreNamespace() Option {
 	}
 }
 
+func WithPluginId(inPluginId string) Option {
+	return func(o *options) {
+		o.postMap["plugin_id"] = inPluginId
+	}
+}
+
+func WithPluginName(inPluginName string) Option {
+	return func(o *options) {
+		o.queryMap["plugin_name"] = fmt.Sprintf("%v", inPluginName)
+	}
+}
+
+func WithAwsCredentialStoreRegion(inRegion string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["region"] = inRegion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialStoreRegion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["region"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithAwsCredentialStoreSecretAccessKey(inSecretAccessKey string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["secret_access_key"] = inSecretAccessKey
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialStoreSecretAccessKey() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["secret_access_key"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSecrets(inSecrets map[string]interface{}) Option {
+	return func(o *options) {
+		o.postMap["secrets"] = inSecrets
+	}
+}
+
+func DefaultSecrets() Option {
+	return func(o *options) {
+		o.postMap["secrets"] = nil
+	}
+}
+
+func WithAwsCredentialStoreSigninEndpoint(inSigninEndpoint string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signin_endpoint"] = inSigninEndpoint
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialStoreSigninEndpoint() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["signin_endpoint"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithAwsCredentialStoreStsEndpoint(inStsEndpoint string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sts_endpoint"] = inStsEndpoint
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultAwsCredentialStoreStsEndpoint() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["sts_endpoint"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreTlsServerName(inTlsServerName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -282,6 +475,18 @@ func DefaultVaultCredentialStoreTlsSkipVerify() Option {
 	}
 }
 
+func WithKubernetesCredentialStoreToken(inToken string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["token"] = inToken
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultCredentialStoreToken(inToken string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -3,23 +3,29 @@ package credentialstores
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 )
 
 type VaultCredentialStoreAttributes struct {
-	Address                  string `json:"address,omitempty"`
-	Namespace                string `json:"namespace,omitempty"`
-	CaCert                   string `json:"ca_cert,omitempty"`
-	TlsServerName            string `json:"tls_server_name,omitempty"`
-	TlsSkipVerify            bool   `json:"tls_skip_verify,omitempty"`
-	Token                    string `json:"token,omitempty"`
-	TokenHmac                string `json:"token_hmac,omitempty"`
-	ClientCertificate        string `json:"client_certificate,omitempty"`
-	ClientCertificateKey     string `json:"client_certificate_key,omitempty"`
-	ClientCertificateKeyHmac string `json:"client_certificate_key_hmac,omitempty"`
-	WorkerFilter             string `json:"worker_filter,omitempty"`
-	TokenStatus              string `json:"token_status,omitempty"`
+	Address                  string    `json:"address,omitempty"`
+	Namespace                string    `json:"namespace,omitempty"`
+	CaCert                   string    `json:"ca_cert,omitempty"`
+	TlsServerName            string    `json:"tls_server_name,omitempty"`
+	TlsSkipVerify            bool      `json:"tls_skip_verify,omitempty"`
+	Token                    string    `json:"token,omitempty"`
+	TokenHmac                string    `json:"token_hmac,omitempty"`
+	ClientCertificate        string    `json:"client_certificate,omitempty"`
+	ClientCertificateKey     string    `json:"client_certificate_key,omitempty"`
+	ClientCertificateKeyHmac string    `json:"client_certificate_key_hmac,omitempty"`
+	WorkerFilter             string    `json:"worker_filter,omitempty"`
+	TokenStatus              string    `json:"token_status,omitempty"`
+	TokenLastRenewalTime     time.Time `json:"token_last_renewal_time,omitempty"`
+	TokenExpirationTime      time.Time `json:"token_expiration_time,omitempty"`
+	TokenHealthStatus        string    `json:"token_health_status,omitempty"`
+	TokenHealthCheckTime     time.Time `json:"token_health_check_time,omitempty"`
+	TokenMissingCapabilities []string  `json:"token_missing_capabilities,omitempty"`
 }
 
 func AttributesMapToVaultCredentialStoreAttributes(in map[string]interface{}) (*VaultCredentialStoreAttributes, error) {
@@ -28,8 +34,9 @@ func AttributesMapToVaultCredentialStoreAttributes(in map[string]interface{}) (*
 	}
 	var out VaultCredentialStoreAttributes
 	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		Result:  &out,
-		TagName: "json",
+		Result:     &out,
+		TagName:    "json",
+		DecodeHook: mapstructure.StringToTimeHookFunc(time.RFC3339Nano),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error creating mapstructure decoder: %w", err)
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostcatalogs
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Sync requests that every host set of the plugin host catalog be synced from
+// its plugin as soon as possible. The syncs happen asynchronously; their
+// outcome is reported in the sync status of each host set.
+func (c *Client) Sync(ctx context.Context, hostCatalogId string, opt ...Option) (*HostCatalogUpdateResult, error) {
+	if hostCatalogId == "" {
+		return nil, fmt.Errorf("empty hostCatalogId value passed into Sync request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", "host-catalogs/"+url.PathEscape(hostCatalogId)+":sync", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Sync request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Sync call: %w", err)
+	}
+
+	target := new(HostCatalogUpdateResult)
+	target.Item = new(HostCatalog)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Sync response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -139,6 +139,30 @@ func WithPluginName(inPluginName string) Option {
 	}
 }
 
+func WithDnsHostCatalogResolvers(inResolvers []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["resolvers"] = inResolvers
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultDnsHostCatalogResolvers() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["resolvers"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSecrets(inSecrets map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["secrets"] = inSecrets
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hosts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type ImportResult struct {
+	Items    []*HostImportResult `json:"items,omitempty"`
+	DryRun   bool                `json:"dry_run,omitempty"`
+	response *api.Response
+}
+
+func (n ImportResult) GetItems() []*HostImportResult {
+	return n.Items
+}
+
+func (n ImportResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Import creates or updates the given hosts in the static host catalog and
+// adds them to the named host sets in a single transaction. Hosts are matched
+// to the existing hosts of the catalog by name. If any of the hosts cannot be
+// imported no changes are made. If dryRun is set, the changes which would be
+// made are returned without being applied.
+func (c *Client) Import(ctx context.Context, hostCatalogId string, hosts []*HostImport, dryRun bool, opt ...Option) (*ImportResult, error) {
+	if hostCatalogId == "" {
+		return nil, fmt.Errorf("empty hostCatalogId value passed into Import request")
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("empty hosts passed into Import request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["items"] = hosts
+	opts.postMap["dry_run"] = dryRun
+
+	req, err := c.client.NewRequest(ctx, "POST", "host-catalogs/"+url.PathEscape(hostCatalogId)+":import-hosts", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Import request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Import call: %w", err)
+	}
+
+	target := new(ImportResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Import response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
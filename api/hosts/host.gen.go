@@ -30,6 +30,7 @@ type Host struct {
 	DnsNames          []string               `json:"dns_names,omitempty"`
 	ExternalId        string                 `json:"external_id,omitempty"`
 	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
+	Health            []*HostHealth          `json:"health,omitempty"`
 
 	response *api.Response
 }
@@ -101,6 +101,54 @@ func DefaultStaticHostAddress() Option {
 	}
 }
 
+func WithStaticHostLabels(inLabels map[string]string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["labels"] = inLabels
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticHostLabels() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["labels"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithStaticHostPort(inPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["port"] = inPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticHostPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -8,7 +8,9 @@ import (
 )
 
 type StaticHostAttributes struct {
-	Address string `json:"address,omitempty"`
+	Address string            `json:"address,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Port    uint32            `json:"port,omitempty"`
 }
 
 func AttributesMapToStaticHostAttributes(in map[string]interface{}) (*StaticHostAttributes, error) {
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostsets
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/history"
+)
+
+type HistoryResult struct {
+	Items    []*history.Record
+	response *api.Response
+}
+
+func (n HistoryResult) GetItems() []*history.Record {
+	return n.Items
+}
+
+func (n HistoryResult) GetResponse() *api.Response {
+	return n.response
+}
+
+func (c *Client) History(ctx context.Context, hostSetId string, opt ...Option) (*HistoryResult, error) {
+	if hostSetId == "" {
+		return nil, fmt.Errorf("empty hostSetId value passed into History request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "host-sets/"+url.PathEscape(hostSetId)+":history", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating History request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during History call: %w", err)
+	}
+
+	target := new(HistoryResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding History response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+type RestoreResult struct {
+	Changes  []*history.Change `json:"changes,omitempty"`
+	DryRun   bool              `json:"dry_run,omitempty"`
+	response *api.Response
+}
+
+func (n RestoreResult) GetChanges() []*history.Change {
+	return n.Changes
+}
+
+func (n RestoreResult) GetDryRun() bool {
+	return n.DryRun
+}
+
+func (n RestoreResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// Restore restores the host set to its state at the given point in time, as
+// recorded in the oplog. If dryRun is set, the changes which would be made
+// are returned without being applied.
+func (c *Client) Restore(ctx context.Context, hostSetId string, at time.Time, dryRun bool, opt ...Option) (*RestoreResult, error) {
+	if hostSetId == "" {
+		return nil, fmt.Errorf("empty hostSetId value passed into Restore request")
+	}
+	if at.IsZero() {
+		return nil, fmt.Errorf("empty time value passed into Restore request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["time"] = at.UTC().Format(time.RFC3339Nano)
+	opts.postMap["dry_run"] = dryRun
+
+	req, err := c.client.NewRequest(ctx, "POST", "host-sets/"+url.PathEscape(hostSetId)+":restore", opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Restore request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Restore call: %w", err)
+	}
+
+	target := new(RestoreResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Restore response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
+
+// SetHealthCheck sets the health check run by workers against the hosts of
+// the host set, replacing any existing health check. Only the type, port,
+// http path, interval and timeout of the given health check are used.
+func (c *Client) SetHealthCheck(ctx context.Context, hostSetId string, healthCheck *HostSetHealthCheck, opt ...Option) (*HostSetUpdateResult, error) {
+	if hostSetId == "" {
+		return nil, fmt.Errorf("empty hostSetId value passed into SetHealthCheck request")
+	}
+	if healthCheck == nil {
+		return nil, fmt.Errorf("nil health check passed into SetHealthCheck request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["item"] = &HostSetHealthCheck{
+		Type:            healthCheck.Type,
+		Port:            healthCheck.Port,
+		HttpPath:        healthCheck.HttpPath,
+		IntervalSeconds: healthCheck.IntervalSeconds,
+		TimeoutSeconds:  healthCheck.TimeoutSeconds,
+	}
+
+	return c.postAction(ctx, hostSetId, "set-health-check", "SetHealthCheck", opts, apiOpts)
+}
+
+// RemoveHealthCheck removes the health check of the host set along with the
+// recorded health of its hosts.
+func (c *Client) RemoveHealthCheck(ctx context.Context, hostSetId string, opt ...Option) (*HostSetUpdateResult, error) {
+	if hostSetId == "" {
+		return nil, fmt.Errorf("empty hostSetId value passed into RemoveHealthCheck request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	return c.postAction(ctx, hostSetId, "remove-health-check", "RemoveHealthCheck", opts, apiOpts)
+}
+
+// Sync requests that the hosts of the plugin host set be synced from its
+// plugin as soon as possible. The sync itself happens asynchronously; its
+// outcome is reported in the sync status of the host set.
+func (c *Client) Sync(ctx context.Context, hostSetId string, opt ...Option) (*HostSetUpdateResult, error) {
+	if hostSetId == "" {
+		return nil, fmt.Errorf("empty hostSetId value passed into Sync request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	return c.postAction(ctx, hostSetId, "sync", "Sync", opts, apiOpts)
+}
+
+func (c *Client) postAction(ctx context.Context, hostSetId, action, name string, opts options, apiOpts []api.Option) (*HostSetUpdateResult, error) {
+	req, err := c.client.NewRequest(ctx, "POST", "host-sets/"+url.PathEscape(hostSetId)+":"+action, opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	target := new(HostSetUpdateResult)
+	target.Item = new(HostSet)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -29,6 +29,8 @@ type HostSet struct {
 	SyncIntervalSeconds int32                  `json:"sync_interval_seconds,omitempty"`
 	Attributes          map[string]interface{} `json:"attributes,omitempty"`
 	AuthorizedActions   []string               `json:"authorized_actions,omitempty"`
+	HealthCheck         *HostSetHealthCheck    `json:"health_check,omitempty"`
+	SyncStatus          *HostSetSyncStatus     `json:"sync_status,omitempty"`
 
 	response *api.Response
 }
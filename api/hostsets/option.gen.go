@@ -101,6 +101,30 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithStaticHostSetFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = inFilter
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticHostSetFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -113,6 +137,30 @@ func DefaultName() Option {
 	}
 }
 
+func WithDnsHostSetNames(inNames []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["names"] = inNames
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultDnsHostSetNames() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["names"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPreferredEndpoints(inPreferredEndpoints []string) Option {
 	return func(o *options) {
 		o.postMap["preferred_endpoints"] = inPreferredEndpoints
@@ -125,6 +173,30 @@ func DefaultPreferredEndpoints() Option {
 	}
 }
 
+func WithDnsHostSetRecordType(inRecordType string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["record_type"] = inRecordType
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultDnsHostSetRecordType() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["record_type"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSyncIntervalSeconds(inSyncIntervalSeconds int32) Option {
 	return func(o *options) {
 		o.postMap["sync_interval_seconds"] = inSyncIntervalSeconds
@@ -4,9 +4,13 @@
 package scopes
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 )
@@ -215,3 +219,100 @@ func (c *Client) DestroyKeyVersion(ctx context.Context, scopeId string, keyVersi
 	target.response = resp
 	return target, nil
 }
+
+// StreamedEvent is an event received from a scope's event stream. Data holds
+// the event formatted as cloudevents JSON.
+type StreamedEvent struct {
+	Type string
+	Data json.RawMessage
+}
+
+// EventStream is a stream of the events of a scope, as returned by
+// TailEvents. It must be closed when it's no longer needed.
+type EventStream struct {
+	scanner  *bufio.Scanner
+	response *api.Response
+}
+
+// Next blocks until the next event is received and returns it. Streams report
+// the number of events the controller dropped because the client didn't keep
+// up as events of type "dropped". It returns io.EOF when the stream ends.
+func (s *EventStream) Next() (*StreamedEvent, error) {
+	var ev StreamedEvent
+	var data []string
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		switch {
+		case line == "":
+			if len(data) == 0 {
+				// comments, like keep-alives, end with an empty line too
+				continue
+			}
+			ev.Data = json.RawMessage(strings.Join(data, "\n"))
+			return &ev, nil
+		case strings.HasPrefix(line, ":"):
+		case strings.HasPrefix(line, "event:"):
+			ev.Type = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+		}
+	}
+	if err := s.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading event stream: %w", err)
+	}
+	return nil, io.EOF
+}
+
+// Close closes the stream.
+func (s *EventStream) Close() error {
+	return s.response.HttpResponse().Body.Close()
+}
+
+func (s *EventStream) GetResponse() *api.Response {
+	return s.response
+}
+
+// TailEvents streams the events of a scope as they're emitted by the
+// controller. The client's timeout applies to the whole stream, so it should
+// usually be disabled with SetClientTimeout(0). Supports WithFilter.
+func (c *Client) TailEvents(ctx context.Context, scopeId string, opt ...Option) (*EventStream, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into TailEvents request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "scopes/"+url.PathEscape(scopeId)+":tail-events", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating TailEvents request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during TailEvents call: %w", err)
+	}
+
+	if resp.StatusCode() >= 400 {
+		apiErr, err := resp.Decode(nil)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding TailEvents response: %w", err)
+		}
+		return nil, apiErr
+	}
+	scanner := bufio.NewScanner(resp.HttpResponse().Body)
+	// events can be larger than the scanner's default max token size
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	return &EventStream{scanner: scanner, response: resp}, nil
+}
@@ -210,12 +210,48 @@ func DefaultEgressWorkerFilter() Option {
 	}
 }
 
+func WithHostExclusionFilter(inHostExclusionFilter string) Option {
+	return func(o *options) {
+		o.postMap["host_exclusion_filter"] = inHostExclusionFilter
+	}
+}
+
+func DefaultHostExclusionFilter() Option {
+	return func(o *options) {
+		o.postMap["host_exclusion_filter"] = nil
+	}
+}
+
 func WithHostId(inHostId string) Option {
 	return func(o *options) {
 		o.postMap["host_id"] = inHostId
 	}
 }
 
+func WithHostPreferenceFilter(inHostPreferenceFilter string) Option {
+	return func(o *options) {
+		o.postMap["host_preference_filter"] = inHostPreferenceFilter
+	}
+}
+
+func DefaultHostPreferenceFilter() Option {
+	return func(o *options) {
+		o.postMap["host_preference_filter"] = nil
+	}
+}
+
+func WithHostSelectionStrategy(inHostSelectionStrategy string) Option {
+	return func(o *options) {
+		o.postMap["host_selection_strategy"] = inHostSelectionStrategy
+	}
+}
+
+func DefaultHostSelectionStrategy() Option {
+	return func(o *options) {
+		o.postMap["host_selection_strategy"] = nil
+	}
+}
+
 func WithIngressWorkerFilter(inIngressWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["ingress_worker_filter"] = inIngressWorkerFilter
@@ -252,6 +288,24 @@ func DefaultName() Option {
 	}
 }
 
+func WithPortName(inPortName string) Option {
+	return func(o *options) {
+		o.postMap["port_name"] = inPortName
+	}
+}
+
+func WithPorts(inPorts map[string]uint32) Option {
+	return func(o *options) {
+		o.postMap["ports"] = inPorts
+	}
+}
+
+func DefaultPorts() Option {
+	return func(o *options) {
+		o.postMap["ports"] = nil
+	}
+}
+
 func WithScopeId(inScopeId string) Option {
 	return func(o *options) {
 		o.postMap["scope_id"] = inScopeId
@@ -288,6 +342,18 @@ func DefaultSessionMaxSeconds() Option {
 	}
 }
 
+func WithWorkerAffinityFilter(inWorkerAffinityFilter string) Option {
+	return func(o *options) {
+		o.postMap["worker_affinity_filter"] = inWorkerAffinityFilter
+	}
+}
+
+func DefaultWorkerAffinityFilter() Option {
+	return func(o *options) {
+		o.postMap["worker_affinity_filter"] = nil
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
@@ -299,3 +365,15 @@ func DefaultWorkerFilter() Option {
 		o.postMap["worker_filter"] = nil
 	}
 }
+
+func WithWorkerSelectionStrategy(inWorkerSelectionStrategy string) Option {
+	return func(o *options) {
+		o.postMap["worker_selection_strategy"] = inWorkerSelectionStrategy
+	}
+}
+
+func DefaultWorkerSelectionStrategy() Option {
+	return func(o *options) {
+		o.postMap["worker_selection_strategy"] = nil
+	}
+}
@@ -38,6 +38,12 @@ type Target struct {
 	Attributes                             map[string]interface{} `json:"attributes,omitempty"`
 	AuthorizedActions                      []string               `json:"authorized_actions,omitempty"`
 	Address                                string                 `json:"address,omitempty"`
+	HostSelectionStrategy                  string                 `json:"host_selection_strategy,omitempty"`
+	WorkerSelectionStrategy                string                 `json:"worker_selection_strategy,omitempty"`
+	WorkerAffinityFilter                   string                 `json:"worker_affinity_filter,omitempty"`
+	HostExclusionFilter                    string                 `json:"host_exclusion_filter,omitempty"`
+	HostPreferenceFilter                   string                 `json:"host_preference_filter,omitempty"`
+	Ports                                  map[string]uint32      `json:"ports,omitempty"`
 
 	response *api.Response
 }
@@ -4,3 +4,9 @@
 package globals
 
 const MissingPortErrStr = "missing port in address"
+
+// CredentialCheckoutsExhaustedErrStr is contained in the error returned when
+// a session is created using a static credential whose concurrent check-outs
+// are all held by other sessions. It must match the exception raised by the
+// checkout_credential_static database trigger.
+const CredentialCheckoutsExhaustedErrStr = "no available credential check-outs"
@@ -50,6 +50,13 @@ const (
 	WorkerFilterField                           = "worker_filter"
 	EgressWorkerFilterField                     = "egress_worker_filter"
 	IngressWorkerFilterField                    = "ingress_worker_filter"
+	HostSelectionStrategyField                  = "host_selection_strategy"
+	WorkerSelectionStrategyField                = "worker_selection_strategy"
+	WorkerAffinityFilterField                   = "worker_affinity_filter"
+	HostExclusionFilterField                    = "host_exclusion_filter"
+	HostPreferenceFilterField                   = "host_preference_filter"
+	PortsField                                  = "ports"
+	PortNameField                               = "port_name"
 	AccountIdsField                             = "account_ids"
 	AccountsField                               = "accounts"
 	LoginNameField                              = "login_name"
@@ -98,4 +105,14 @@ const (
 	TotalCountField                             = "total_count"
 	DirectlyConnectedDownstreamWorkersField     = "directly_connected_downstream_workers"
 	AttributesAddressField                      = "attributes.address"
+	AttributesLabelsField                       = "attributes.labels"
+	AttributesPortField                         = "attributes.port"
+	AttributesFilterField                       = "attributes.filter"
+	AttributesResolversField                    = "attributes.resolvers"
+	AttributesNamesField                        = "attributes.names"
+	AttributesRecordTypeField                   = "attributes.record_type"
+	MaxConcurrentCheckoutsField                 = "max_concurrent_checkouts"
+	HealthCheckField                            = "health_check"
+	HealthField                                 = "health"
+	SyncStatusField                             = "sync_status"
 )
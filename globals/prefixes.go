@@ -54,6 +54,27 @@ const (
 	// VaultSshCertificateCredentialLibraryPrefix is the prefix for Vault SSH
 	// certificate credential libraries
 	VaultSshCertificateCredentialLibraryPrefix = "clvsclt"
+	// VaultPkiCredentialLibraryPrefix is the prefix for Vault PKI credential
+	// libraries
+	VaultPkiCredentialLibraryPrefix = "clvpki"
+
+	// PluginCredentialStorePrefix is the prefix for plugin credential stores
+	PluginCredentialStorePrefix = "csplg"
+	// PluginCredentialLibraryPrefix is the prefix for plugin credential
+	// libraries
+	PluginCredentialLibraryPrefix = "clplg"
+
+	// KubernetesCredentialStorePrefix is the prefix for kubernetes credential
+	// stores
+	KubernetesCredentialStorePrefix = "cskube"
+	// KubernetesCredentialLibraryPrefix is the prefix for kubernetes
+	// credential libraries
+	KubernetesCredentialLibraryPrefix = "clkube"
+
+	// AwsCredentialStorePrefix is the prefix for aws credential stores
+	AwsCredentialStorePrefix = "csaws"
+	// AwsCredentialLibraryPrefix is the prefix for aws credential libraries
+	AwsCredentialLibraryPrefix = "claws"
 
 	// UsernamePasswordCredentialPrefix is the prefix for username/password
 	// creds
@@ -65,6 +86,9 @@ const (
 	SshPrivateKeyCredentialPrefix = "credspk"
 	// JsonCredentialPrefix is the prefix for generic JSON creds
 	JsonCredentialPrefix = "credjson"
+	// TlsClientCertificateCredentialPrefix is the prefix for TLS client
+	// certificate creds
+	TlsClientCertificateCredentialPrefix = "credtls"
 
 	// StaticHostCatalogPrefix is the prefix for static host catalogs
 	StaticHostCatalogPrefix = "hcst"
@@ -87,6 +111,13 @@ const (
 	// PluginHostPreviousPrefix is the previous prefix for plugin hosts
 	PluginHostPreviousPrefix = "h"
 
+	// DnsHostCatalogPrefix is the prefix for dns host catalogs
+	DnsHostCatalogPrefix = "hcdns"
+	// DnsHostSetPrefix is the prefix for dns host sets
+	DnsHostSetPrefix = "hsdns"
+	// DnsHostPrefix is the prefix for dns hosts
+	DnsHostPrefix = "hdns"
+
 	// SessionPrefix is the prefix for sessions
 	SessionPrefix = "s"
 
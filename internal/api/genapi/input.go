@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/groups"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/history"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
@@ -160,6 +161,16 @@ var inputStructs = []*structInfo{
 		outFile:     "plugins/plugin_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &history.Record{},
+		outFile:     "history/record.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &history.Change{},
+		outFile:     "history/change.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:     &scopes.Key{},
 		outFile:     "scopes/key.gen.go",
@@ -460,6 +471,24 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &credentialstores.KubernetesCredentialStoreAttributes{},
+		outFile:        "credentialstores/kubernetes_credential_store_attributes.gen.go",
+		subtypeName:    "KubernetesCredentialStore",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &credentialstores.AwsCredentialStoreAttributes{},
+		outFile:        "credentialstores/aws_credential_store_attributes.gen.go",
+		subtypeName:    "AwsCredentialStore",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentialstores.CredentialStore{},
 		outFile: "credentialstores/credential_store.gen.go",
@@ -495,6 +524,15 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
+		extraFields: []fieldInfo{
+			{
+				Name:        "PluginName",
+				ProtoName:   "plugin_name",
+				FieldType:   "string",
+				SkipDefault: true,
+				Query:       true,
+			},
+		},
 		fieldOverrides: []fieldInfo{
 			{
 				Name:        "Address",
@@ -504,6 +542,10 @@ var inputStructs = []*structInfo{
 				Name:        "Token",
 				SkipDefault: true,
 			},
+			{
+				Name:        "PluginId",
+				SkipDefault: true,
+			},
 		},
 	},
 	{
@@ -548,6 +590,59 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentiallibraries.VaultPkiCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/vault_pki_credential_library_attributes.gen.go",
+		subtypeName: "VaultPkiCredentialLibrary",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Path",
+				SkipDefault: true,
+			},
+			{
+				Name:        "CommonName",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &credentiallibraries.KubernetesCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/kubernetes_credential_library_attributes.gen.go",
+		subtypeName: "KubernetesCredentialLibrary",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Namespace",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ServiceAccount",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &credentiallibraries.AwsCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/aws_credential_library_attributes.gen.go",
+		subtypeName: "AwsCredentialLibrary",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "RoleArn",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentiallibraries.CredentialLibrary{},
 		outFile: "credentiallibraries/credential_library.gen.go",
@@ -621,6 +716,25 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentials.TlsClientCertificateAttributes{},
+		outFile:     "credentials/tls_client_certificate_attributes.gen.go",
+		subtypeName: "TlsClientCertificateCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Certificate",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PrivateKey",
+				SkipDefault: true,
+			},
+		},
+		parentTypeName: "Credential",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto:     &credentials.JsonAttributes{},
 		outFile:     "credentials/json_attributes.gen.go",
@@ -636,6 +750,26 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentials.CredentialCheckout{},
+		outFile:     "credentials/credential_checkout.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &credentials.CredentialRotationPolicy{},
+		outFile:     "credentials/credential_rotation_policy.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &credentials.CredentialRotation{},
+		outFile:     "credentials/credential_rotation.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &credentials.CredentialVersion{},
+		outFile:     "credentials/credential_version.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &credentials.Credential{},
 		outFile: "credentials/credential.gen.go",
@@ -723,6 +857,15 @@ var inputStructs = []*structInfo{
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 		recursiveListing:    true,
 	},
+	{
+		inProto:        &hostcatalogs.DnsHostCatalogAttributes{},
+		outFile:        "hostcatalogs/dns_host_catalog_attributes.gen.go",
+		subtypeName:    "DnsHostCatalog",
+		parentTypeName: "HostCatalog",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &hosts.Host{},
 		outFile: "hosts/host.gen.go",
@@ -739,6 +882,21 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 	},
+	{
+		inProto:     &hosts.HostHealth{},
+		outFile:     "hosts/host_health.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &hosts.HostImport{},
+		outFile:     "hosts/host_import.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &hosts.HostImportResult{},
+		outFile:     "hosts/host_import_result.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto:        &hosts.StaticHostAttributes{},
 		outFile:        "hosts/static_host_attributes.gen.go",
@@ -770,6 +928,34 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: []string{CreateResponseType, ReadResponseType, UpdateResponseType, DeleteResponseType, ListResponseType},
 	},
+	{
+		inProto:        &hostsets.StaticHostSetAttributes{},
+		outFile:        "hostsets/static_host_set_attributes.gen.go",
+		subtypeName:    "StaticHostSet",
+		parentTypeName: "HostSet",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &hostsets.DnsHostSetAttributes{},
+		outFile:        "hostsets/dns_host_set_attributes.gen.go",
+		subtypeName:    "DnsHostSet",
+		parentTypeName: "HostSet",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:     &hostsets.HostSetHealthCheck{},
+		outFile:     "hostsets/host_set_health_check.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &hostsets.HostSetSyncStatus{},
+		outFile:     "hostsets/host_set_sync_status.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &targets.HostSource{},
 		outFile: "targets/host_source.gen.go",
@@ -865,6 +1051,12 @@ var inputStructs = []*structInfo{
 				FieldType:   "string",
 				SkipDefault: true,
 			},
+			{
+				Name:        "PortName",
+				ProtoName:   "port_name",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
 			{
 				Name:        "ScopeId",
 				ProtoName:   "scope_id",
@@ -815,6 +815,7 @@ var mapstructureConversionTemplate = template.Must(template.New("").Funcs(
 	template.FuncMap{
 		"typeFromSubtype": typeFromSubtype,
 		"kebabCase":       kebabCase,
+		"hasTimeField":    hasTimeField,
 	},
 ).Parse(`
 func AttributesMapTo{{ .Name }}(in map[string]interface{}) (*{{ .Name }}, error) {
@@ -824,7 +825,8 @@ func AttributesMapTo{{ .Name }}(in map[string]interface{}) (*{{ .Name }}, error)
 	var out {{ .Name }}
 	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		Result: &out,
-		TagName: "json",
+		TagName: "json",{{ if hasTimeField .Fields }}
+		DecodeHook: mapstructure.StringToTimeHookFunc(time.RFC3339Nano),{{ end }}
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error creating mapstructure decoder: %w", err)
@@ -855,6 +857,18 @@ func kebabCase(in string) string {
 	return strcase.ToKebab(in)
 }
 
+// hasTimeField reports if any of the fields is a time.Time, in which case
+// the generated attributes need to decode the timestamp strings returned by
+// the API.
+func hasTimeField(fields []fieldInfo) bool {
+	for _, f := range fields {
+		if f.FieldType == "time.Time" {
+			return true
+		}
+	}
+	return false
+}
+
 func getPathWithAction(plResName, parentTypeName, action string) string {
 	_, _, resPath := getArgsAndPaths(plResName, parentTypeName, action)
 	return resPath
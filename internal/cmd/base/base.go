@@ -38,6 +38,9 @@ const (
 	EnabledPluginHostLoopback
 	EnabledPluginHostAws
 	EnabledPluginHostAzure
+	EnabledPluginCredentialLoopback
+	EnabledPluginHostKubernetes
+	EnabledPluginHostFile
 )
 
 func (e EnabledPlugin) String() string {
@@ -48,6 +51,12 @@ func (e EnabledPlugin) String() string {
 		return "AWS"
 	case EnabledPluginHostAzure:
 		return "Azure"
+	case EnabledPluginCredentialLoopback:
+		return "CredentialLoopback"
+	case EnabledPluginHostKubernetes:
+		return "Kubernetes"
+	case EnabledPluginHostFile:
+		return "File"
 	default:
 		return ""
 	}
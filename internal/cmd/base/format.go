@@ -9,10 +9,12 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/history"
 	"github.com/hashicorp/boundary/api/plugins"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/version"
@@ -50,6 +52,61 @@ func PluginInfoForOutput(plg *plugins.PluginInfo, maxLength int) string {
 	return WrapMap(4, maxLength, vals)
 }
 
+// HistoryForOutput formats resource history records, oldest first, for
+// table output.
+func HistoryForOutput(items []*history.Record) string {
+	if len(items) == 0 {
+		return "No history found"
+	}
+	output := []string{
+		"",
+		"History information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:          %s", item.Id),
+			fmt.Sprintf("    Time:      %s", item.CreatedTime.Local().Format(time.RFC1123)),
+		)
+		if item.UserId != "" {
+			output = append(output,
+				fmt.Sprintf("    User ID:   %s", item.UserId),
+			)
+		}
+		output = append(output, "    Changes:")
+		for _, c := range item.Changes {
+			output = append(output,
+				fmt.Sprintf("      %s %s %s.%s: %q -> %q", c.Operation, c.Type, c.Key, c.Field, c.OldValue, c.NewValue),
+			)
+		}
+	}
+	return WrapForHelpText(output)
+}
+
+// RestoreForOutput formats the changes made, or which would be made in a dry
+// run, by restoring a resource to a point in time for table output.
+func RestoreForOutput(changes []*history.Change, dryRun bool) string {
+	if len(changes) == 0 {
+		return "The resource already matches its state at the given time; no changes needed"
+	}
+	header := "Restore changes:"
+	if dryRun {
+		header = "Restore changes (dry run, not applied):"
+	}
+	output := []string{
+		"",
+		header,
+	}
+	for _, c := range changes {
+		output = append(output,
+			fmt.Sprintf("  %s %s %s.%s: %q -> %q", c.Operation, c.Type, c.Key, c.Field, c.OldValue, c.NewValue),
+		)
+	}
+	return WrapForHelpText(output)
+}
+
 func MaxAttributesLength(nonAttributesMap, attributesMap map[string]any, keySubstMap map[string]string) int {
 	// We always print a scope ID and in some cases this particular key ends up
 	// being the longest key, so start with it as a baseline. It's always
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	credplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
@@ -598,6 +599,54 @@ func (b *Server) RegisterHostPlugin(ctx context.Context, name string, plg plgpb.
 	return plugin, nil
 }
 
+// RegisterCredentialPlugin creates a credential plugin in the database if not
+// present. It also registers the plugin in the shared map of running
+// credential plugins. A name is required when calling RegisterCredentialPlugin
+// and will be used even if WithName is provided.
+func (b *Server) RegisterCredentialPlugin(ctx context.Context, name string, plg plgpb.CredentialPluginServiceClient, opt ...credplugin.Option) (*credplugin.Plugin, error) {
+	if name == "" {
+		return nil, fmt.Errorf("no name provided when creating plugin.")
+	}
+	rw := db.New(b.Database)
+
+	kmsCache, err := kms.New(ctx, rw, rw)
+	if err != nil {
+		return nil, fmt.Errorf("error creating kms cache: %w", err)
+	}
+	if err := kmsCache.AddExternalWrappers(
+		ctx,
+		kms.WithRootWrapper(b.RootKms),
+	); err != nil {
+		return nil, fmt.Errorf("error adding config keys to kms: %w", err)
+	}
+
+	cpRepo, err := credplugin.NewRepository(rw, rw, kmsCache)
+	if err != nil {
+		return nil, fmt.Errorf("error creating credential plugin repository: %w", err)
+	}
+
+	plugin, err := cpRepo.LookupPluginByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up credential plugin by name: %w", err)
+	}
+
+	if plugin == nil {
+		opt = append(opt, credplugin.WithName(name))
+		plugin = credplugin.NewPlugin(opt...)
+		plugin, err = cpRepo.CreatePlugin(ctx, plugin, opt...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating credential plugin: %w", err)
+		}
+	}
+
+	if b.CredentialPlugins == nil {
+		b.CredentialPlugins = make(map[string]plgpb.CredentialPluginServiceClient)
+	}
+	b.CredentialPlugins[plugin.GetPublicId()] = plg
+
+	return plugin, nil
+}
+
 // unprivilegedDevUserRoleSetup adds dev user to the role that grants
 // list/read:self/cancel:self on sessions and read:self/delete:self/list on
 // tokens. It also creates a role with an `authorize-session` grant for the
@@ -122,8 +122,9 @@ type Server struct {
 	// auth KMS but want to use PKI for upstream connections
 	DevUsePkiForUpstream bool
 
-	EnabledPlugins []EnabledPlugin
-	HostPlugins    map[string]plgpb.HostPluginServiceClient
+	EnabledPlugins    []EnabledPlugin
+	HostPlugins       map[string]plgpb.HostPluginServiceClient
+	CredentialPlugins map[string]plgpb.CredentialPluginServiceClient
 
 	DevOidcSetup oidcSetup
 
@@ -215,7 +216,10 @@ func (b *Server) SetupEventing(logger hclog.Logger, serializationLock *sync.Mute
 		// There is a cyclic dependency between the eventer and the wrapper, so we instantiate
 		// the eventer with a nil wrapper until we have a wrapper to use.
 		event.WithAuditWrapper(opts.withEventWrapper),
-		event.WithGating(opts.withEventGating))
+		event.WithGating(opts.withEventGating),
+		// Streaming allows administrators to tail events via the controller
+		// API. Events aren't formatted for it unless there are subscribers.
+		event.WithStreaming(opts.withEventerConfig.StreamingEnabled))
 	if err != nil {
 		return berrors.WrapDeprecated(err, op, berrors.WithMsg("unable to create eventer"))
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialstorescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/database"
 	"github.com/hashicorp/boundary/internal/cmd/commands/dev"
+	"github.com/hashicorp/boundary/internal/cmd/commands/eventscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/groupscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostcatalogscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostscmd"
@@ -379,6 +380,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credential-libraries create vault-pki": func() (cli.Command, error) {
+			return &credentiallibrariescmd.VaultPkiCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-libraries create kubernetes": func() (cli.Command, error) {
+			return &credentiallibrariescmd.KubernetesCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-libraries create aws": func() (cli.Command, error) {
+			return &credentiallibrariescmd.AwsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-libraries history": func() (cli.Command, error) {
+			return credentiallibrariescmd.NewHistoryCommand(base.NewCommand(ui)), nil
+		},
+		"credential-libraries restore": func() (cli.Command, error) {
+			return credentiallibrariescmd.NewRestoreCommand(base.NewCommand(ui)), nil
+		},
 		"credential-libraries update": func() (cli.Command, error) {
 			return &credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui),
@@ -403,6 +428,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credential-libraries update vault-pki": func() (cli.Command, error) {
+			return &credentiallibrariescmd.VaultPkiCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credential-libraries update kubernetes": func() (cli.Command, error) {
+			return &credentiallibrariescmd.KubernetesCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credential-libraries update aws": func() (cli.Command, error) {
+			return &credentiallibrariescmd.AwsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"credential-stores": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
@@ -445,6 +488,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credential-stores create kubernetes": func() (cli.Command, error) {
+			return &credentialstorescmd.KubernetesCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-stores create aws": func() (cli.Command, error) {
+			return &credentialstorescmd.AwsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-stores create plugin": func() (cli.Command, error) {
+			return &credentialstorescmd.PluginCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"credential-stores update": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
 				Command: base.NewCommand(ui),
@@ -463,6 +524,29 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credential-stores update kubernetes": func() (cli.Command, error) {
+			return &credentialstorescmd.KubernetesCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credential-stores update aws": func() (cli.Command, error) {
+			return &credentialstorescmd.AwsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credential-stores update plugin": func() (cli.Command, error) {
+			return &credentialstorescmd.PluginCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credential-stores rotate-token": func() (cli.Command, error) {
+			return &credentialstorescmd.RotateTokenCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"credentials": func() (cli.Command, error) {
 			return &credentialscmd.Command{
@@ -481,6 +565,41 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "delete",
 			}, nil
 		},
+		"credentials list-checkouts": func() (cli.Command, error) {
+			return &credentialscmd.ListCheckoutsCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials check-in": func() (cli.Command, error) {
+			return &credentialscmd.CheckInCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials set-rotation-policy": func() (cli.Command, error) {
+			return &credentialscmd.SetRotationPolicyCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials remove-rotation-policy": func() (cli.Command, error) {
+			return &credentialscmd.RemoveRotationPolicyCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials list-rotations": func() (cli.Command, error) {
+			return &credentialscmd.ListRotationsCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials list-versions": func() (cli.Command, error) {
+			return &credentialscmd.ListVersionsCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials rollback": func() (cli.Command, error) {
+			return &credentialscmd.RollbackCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 		"credentials list": func() (cli.Command, error) {
 			return &credentialscmd.Command{
 				Command: base.NewCommand(ui),
@@ -511,6 +630,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"credentials create tls-client-certificate": func() (cli.Command, error) {
+			return &credentialscmd.TlsClientCertificateCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"credentials update": func() (cli.Command, error) {
 			return &credentialscmd.Command{
 				Command: base.NewCommand(ui),
@@ -535,6 +660,23 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credentials update tls-client-certificate": func() (cli.Command, error) {
+			return &credentialscmd.TlsClientCertificateCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+
+		"events": func() (cli.Command, error) {
+			return &eventscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"events tail": func() (cli.Command, error) {
+			return &eventscmd.TailCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"groups": func() (cli.Command, error) {
 			return &groupscmd.Command{
@@ -631,6 +773,17 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"host-catalogs create dns": func() (cli.Command, error) {
+			return &hostcatalogscmd.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"host-catalogs sync": func() (cli.Command, error) {
+			return &hostcatalogscmd.SyncCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 		"host-catalogs update": func() (cli.Command, error) {
 			return &hostcatalogscmd.Command{
 				Command: base.NewCommand(ui),
@@ -649,6 +802,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"host-catalogs update dns": func() (cli.Command, error) {
+			return &hostcatalogscmd.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"host-sets": func() (cli.Command, error) {
 			return &hostsetscmd.Command{
@@ -691,6 +850,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"host-sets create dns": func() (cli.Command, error) {
+			return &hostsetscmd.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"host-sets update": func() (cli.Command, error) {
 			return &hostsetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -709,6 +874,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"host-sets update dns": func() (cli.Command, error) {
+			return &hostsetscmd.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"host-sets add-hosts": func() (cli.Command, error) {
 			return &hostsetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -721,12 +892,33 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-hosts",
 			}, nil
 		},
+		"host-sets remove-health-check": func() (cli.Command, error) {
+			return &hostsetscmd.RemoveHealthCheckCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"host-sets history": func() (cli.Command, error) {
+			return hostsetscmd.NewHistoryCommand(base.NewCommand(ui)), nil
+		},
+		"host-sets restore": func() (cli.Command, error) {
+			return hostsetscmd.NewRestoreCommand(base.NewCommand(ui)), nil
+		},
+		"host-sets set-health-check": func() (cli.Command, error) {
+			return &hostsetscmd.SetHealthCheckCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 		"host-sets set-hosts": func() (cli.Command, error) {
 			return &hostsetscmd.Command{
 				Command: base.NewCommand(ui),
 				Func:    "set-hosts",
 			}, nil
 		},
+		"host-sets sync": func() (cli.Command, error) {
+			return &hostsetscmd.SyncCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"hosts": func() (cli.Command, error) {
 			return &hostscmd.Command{
@@ -775,6 +967,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"hosts import": func() (cli.Command, error) {
+			return &hostscmd.ImportCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"logout": func() (cli.Command, error) {
 			return &logout.LogoutCommand{
@@ -853,6 +1050,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "read",
 			}, nil
 		},
+		"roles history": func() (cli.Command, error) {
+			return rolescmd.NewHistoryCommand(base.NewCommand(ui)), nil
+		},
+		"roles restore": func() (cli.Command, error) {
+			return rolescmd.NewRestoreCommand(base.NewCommand(ui)), nil
+		},
 		"roles delete": func() (cli.Command, error) {
 			return &rolescmd.Command{
 				Command: base.NewCommand(ui),
@@ -993,6 +1196,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "authorize-session",
 			}, nil
 		},
+		"targets history": func() (cli.Command, error) {
+			return targetscmd.NewHistoryCommand(base.NewCommand(ui)), nil
+		},
+		"targets restore": func() (cli.Command, error) {
+			return targetscmd.NewRestoreCommand(base.NewCommand(ui)), nil
+		},
 		"targets read": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
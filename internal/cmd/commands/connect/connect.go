@@ -72,6 +72,7 @@ type Command struct {
 	flagTargetId   string
 	flagTargetName string
 	flagHostId     string
+	flagPortName   string
 	flagExec       string
 	flagUsername   string
 	flagDbname     string
@@ -184,6 +185,12 @@ func (c *Command) Flags() *base.FlagSets {
 		Usage:  "The ID of a specific host to connect to out of the hosts from the target's host sets. If not specified, one is chosen at random.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "port-name",
+		Target: &c.flagPortName,
+		Usage:  "The name of one of the target's named ports to connect to. If not specified, the port of the chosen host or the target's default port is used.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:       "exec",
 		Target:     &c.flagExec,
@@ -382,6 +389,9 @@ func (c *Command) Run(args []string) (retCode int) {
 		if len(c.flagHostId) != 0 {
 			opts = append(opts, targets.WithHostId(c.flagHostId))
 		}
+		if len(c.flagPortName) != 0 {
+			opts = append(opts, targets.WithPortName(c.flagPortName))
+		}
 		if len(c.flagTargetName) > 0 {
 			opts = append(opts, targets.WithName(c.flagTargetName))
 		}
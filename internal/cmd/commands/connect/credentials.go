@@ -28,14 +28,25 @@ type sshPrivateKey struct {
 	consumed bool
 }
 
+type tlsClientCertificate struct {
+	Certificate string `mapstructure:"certificate"`
+	PrivateKey  string `mapstructure:"private_key"`
+	Passphrase  string `mapstructure:"private_key_passphrase"`
+	CaBundle    string `mapstructure:"ca_bundle"`
+
+	raw      *targets.SessionCredential
+	consumed bool
+}
+
 type credentials struct {
-	usernamePassword []usernamePassword
-	sshPrivateKey    []sshPrivateKey
-	unspecified      []*targets.SessionCredential
+	usernamePassword     []usernamePassword
+	sshPrivateKey        []sshPrivateKey
+	tlsClientCertificate []tlsClientCertificate
+	unspecified          []*targets.SessionCredential
 }
 
 func (c credentials) unconsumedSessionCredentials() []*targets.SessionCredential {
-	out := make([]*targets.SessionCredential, 0, len(c.sshPrivateKey)+len(c.usernamePassword)+len(c.unspecified))
+	out := make([]*targets.SessionCredential, 0, len(c.sshPrivateKey)+len(c.usernamePassword)+len(c.tlsClientCertificate)+len(c.unspecified))
 
 	// Unspecified credentials cannot be consumed
 	out = append(out, c.unspecified...)
@@ -50,6 +61,11 @@ func (c credentials) unconsumedSessionCredentials() []*targets.SessionCredential
 			out = append(out, c.raw)
 		}
 	}
+	for _, c := range c.tlsClientCertificate {
+		if !c.consumed {
+			out = append(out, c.raw)
+		}
+	}
 	return out
 }
 
@@ -89,6 +105,19 @@ func parseCredentials(creds []*targets.SessionCredential) (credentials, error) {
 				out.sshPrivateKey = append(out.sshPrivateKey, spkCred)
 				continue
 			}
+
+		case credential.TlsClientCertificateType:
+			// Decode attributes from credential struct
+			var tlsCred tlsClientCertificate
+			if err := mapstructure.Decode(cred.Credential, &tlsCred); err != nil {
+				return credentials{}, err
+			}
+
+			if tlsCred.Certificate != "" && tlsCred.PrivateKey != "" {
+				tlsCred.raw = cred
+				out.tlsClientCertificate = append(out.tlsClientCertificate, tlsCred)
+				continue
+			}
 		}
 
 		// Credential type is unspecified, make a best effort attempt to parse
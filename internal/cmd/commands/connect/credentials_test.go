@@ -35,6 +35,17 @@ var (
 		},
 	}
 
+	typedTlsClientCertificate = &targets.SessionCredential{
+		CredentialSource: &targets.CredentialSource{
+			CredentialType: string(credential.TlsClientCertificateType),
+		},
+		Credential: map[string]any{
+			"certificate": "my-cert",
+			"private_key": "my-pk",
+			"ca_bundle":   "my-ca",
+		},
+	}
+
 	vaultUsernamePasswordDeprecatedSubtype = &targets.SessionCredential{
 		CredentialSource: &targets.CredentialSource{
 			Type: vault.Subtype.String(),
@@ -355,6 +366,23 @@ func Test_parseCredentials(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "tls-client-certificate-decoded",
+			creds: []*targets.SessionCredential{
+				typedTlsClientCertificate,
+			},
+			wantCreds: credentials{
+				tlsClientCertificate: []tlsClientCertificate{
+					{
+						Certificate: "my-cert",
+						PrivateKey:  "my-pk",
+						CaBundle:    "my-ca",
+						raw:         typedTlsClientCertificate,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "unspecified",
 			creds: []*targets.SessionCredential{
@@ -442,6 +470,7 @@ func Test_parseCredentials(t *testing.T) {
 
 			assert.ElementsMatch(tt.wantCreds.usernamePassword, creds.usernamePassword)
 			assert.ElementsMatch(tt.wantCreds.sshPrivateKey, creds.sshPrivateKey)
+			assert.ElementsMatch(tt.wantCreds.tlsClientCertificate, creds.tlsClientCertificate)
 			assert.ElementsMatch(tt.wantCreds.unspecified, creds.unspecified)
 		})
 	}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibrariescmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraAwsFlagsFunc = extraAwsFlagsFuncImpl
+	extraAwsActionsFlagsMapFunc = extraAwsActionsFlagsMapFuncImpl
+	extraAwsFlagsHandlingFunc = extraAwsFlagHandlingFuncImpl
+}
+
+const (
+	roleArnName         = "role-arn"
+	sessionNameName     = "session-name"
+	sessionPolicyName   = "session-policy"
+	durationSecondsName = "duration-seconds"
+	federatedSigninName = "federated-signin"
+)
+
+type extraAwsCmdVars struct {
+	flagRoleArn         string
+	flagSessionName     string
+	flagSessionPolicy   string
+	flagDurationSeconds string
+	flagFederatedSignin string
+}
+
+func extraAwsActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			roleArnName,
+			sessionNameName,
+			sessionPolicyName,
+			durationSecondsName,
+			federatedSigninName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraAwsFlagsFuncImpl(c *AwsCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("AWS Credential Library Options")
+
+	for _, name := range flagsAwsMap[c.Func] {
+		switch name {
+		case roleArnName:
+			f.StringVar(&base.StringVar{
+				Name:   roleArnName,
+				Target: &c.flagRoleArn,
+				Usage:  "The ARN of the IAM role to assume.",
+			})
+		case sessionNameName:
+			f.StringVar(&base.StringVar{
+				Name:   sessionNameName,
+				Target: &c.flagSessionName,
+				Usage:  "A template for the role session name, such as boundary-{{.User.Name}}. If not set the session id is used.",
+			})
+		case sessionPolicyName:
+			f.StringVar(&base.StringVar{
+				Name:   sessionPolicyName,
+				Target: &c.flagSessionPolicy,
+				Usage:  "An inline JSON session policy which further restricts the permissions of the issued credentials. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case durationSecondsName:
+			f.StringVar(&base.StringVar{
+				Name:   durationSecondsName,
+				Target: &c.flagDurationSeconds,
+				Usage:  "The requested lifetime of the issued credentials in seconds, between 900 and 43200. Credentials never outlive the session they were issued for.",
+			})
+		case federatedSigninName:
+			f.StringVar(&base.StringVar{
+				Name:   federatedSigninName,
+				Target: &c.flagFederatedSignin,
+				Usage:  "If true, a URL which signs the user in to the AWS console is issued with the credentials.",
+			})
+		}
+	}
+}
+
+func extraAwsFlagHandlingFuncImpl(c *AwsCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagRoleArn {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithAwsCredentialLibraryRoleArn(c.flagRoleArn))
+	}
+	switch c.flagSessionName {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultAwsCredentialLibrarySessionName())
+	default:
+		*opts = append(*opts, credentiallibraries.WithAwsCredentialLibrarySessionName(c.flagSessionName))
+	}
+	switch c.flagSessionPolicy {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultAwsCredentialLibrarySessionPolicy())
+	default:
+		policy, _ := parseutil.ParsePath(c.flagSessionPolicy)
+		*opts = append(*opts, credentiallibraries.WithAwsCredentialLibrarySessionPolicy(policy))
+	}
+	switch c.flagDurationSeconds {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultAwsCredentialLibraryDurationSeconds())
+	default:
+		d, err := strconv.ParseUint(c.flagDurationSeconds, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDurationSeconds, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithAwsCredentialLibraryDurationSeconds(uint32(d)))
+	}
+	switch c.flagFederatedSignin {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultAwsCredentialLibraryFederatedSignin())
+	default:
+		b, err := strconv.ParseBool(c.flagFederatedSignin)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagFederatedSignin, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithAwsCredentialLibraryFederatedSignin(b))
+	}
+
+	return true
+}
+
+func (c *AwsCommand) extraAwsHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create aws -credential-store-id [options] [args]",
+			"",
+			"  Create an aws-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create aws -credential-store-id csaws_1234567890 -role-arn arn:aws:iam::123456789012:role/deployer`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update aws [options] [args]",
+			"",
+			"  Update an aws-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update aws -id claws_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
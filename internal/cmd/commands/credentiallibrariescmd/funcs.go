@@ -151,6 +151,12 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 		keySubstMap = genericKeySubstMap
 	case "vault-ssh-certificate":
 		keySubstMap = sshCertKeySubstMap
+	case "vault-pki":
+		keySubstMap = pkiKeySubstMap
+	case "kubernetes":
+		keySubstMap = kubernetesKeySubstMap
+	case "aws":
+		keySubstMap = awsKeySubstMap
 	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
@@ -218,3 +224,28 @@ var sshCertKeySubstMap = map[string]string{
 	"critical_options": "Critical Options",
 	"extensions":       "Extensions",
 }
+
+var pkiKeySubstMap = map[string]string{
+	"path":        "Path",
+	"common_name": "Common Name",
+	"alt_names":   "Alt Names",
+	"ip_sans":     "IP SANs",
+	"key_type":    "Key Type",
+	"key_bits":    "Key Bits",
+	"ttl":         "TTL",
+}
+
+var kubernetesKeySubstMap = map[string]string{
+	"service_account": "Service Account",
+	"namespace":       "Namespace",
+	"audience":        "Audience",
+	"ttl_seconds":     "TTL Seconds",
+}
+
+var awsKeySubstMap = map[string]string{
+	"role_arn":         "Role ARN",
+	"session_name":     "Session Name",
+	"session_policy":   "Session Policy",
+	"duration_seconds": "Duration Seconds",
+	"federated_signin": "Federated Sign-in",
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibrariescmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraKubernetesFlagsFunc = extraKubernetesFlagsFuncImpl
+	extraKubernetesActionsFlagsMapFunc = extraKubernetesActionsFlagsMapFuncImpl
+	extraKubernetesFlagsHandlingFunc = extraKubernetesFlagHandlingFuncImpl
+}
+
+const (
+	serviceAccountName = "service-account"
+	namespaceName      = "namespace"
+	audienceName       = "audience"
+	ttlSecondsName     = "ttl-seconds"
+)
+
+type extraKubernetesCmdVars struct {
+	flagServiceAccount string
+	flagNamespace      string
+	flagAudience       string
+	flagTtlSeconds     string
+}
+
+func extraKubernetesActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			serviceAccountName,
+			namespaceName,
+			audienceName,
+			ttlSecondsName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraKubernetesFlagsFuncImpl(c *KubernetesCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Kubernetes Credential Library Options")
+
+	for _, name := range flagsKubernetesMap[c.Func] {
+		switch name {
+		case serviceAccountName:
+			f.StringVar(&base.StringVar{
+				Name:   serviceAccountName,
+				Target: &c.flagServiceAccount,
+				Usage:  "The name of the service account to request tokens for.",
+			})
+		case namespaceName:
+			f.StringVar(&base.StringVar{
+				Name:   namespaceName,
+				Target: &c.flagNamespace,
+				Usage:  "The namespace of the service account.",
+			})
+		case audienceName:
+			f.StringVar(&base.StringVar{
+				Name:   audienceName,
+				Target: &c.flagAudience,
+				Usage:  "The intended audience of the issued tokens. If not set the Kubernetes API server's default audience is used.",
+			})
+		case ttlSecondsName:
+			f.StringVar(&base.StringVar{
+				Name:   ttlSecondsName,
+				Target: &c.flagTtlSeconds,
+				Usage:  "The requested lifetime of the issued tokens in seconds. A token never outlives the session it was issued for.",
+			})
+		}
+	}
+}
+
+func extraKubernetesFlagHandlingFuncImpl(c *KubernetesCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagServiceAccount {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithKubernetesCredentialLibraryServiceAccount(c.flagServiceAccount))
+	}
+	switch c.flagNamespace {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithKubernetesCredentialLibraryNamespace(c.flagNamespace))
+	}
+	switch c.flagAudience {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultKubernetesCredentialLibraryAudience())
+	default:
+		*opts = append(*opts, credentiallibraries.WithKubernetesCredentialLibraryAudience(c.flagAudience))
+	}
+	switch c.flagTtlSeconds {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultKubernetesCredentialLibraryTtlSeconds())
+	default:
+		ttl, err := strconv.ParseUint(c.flagTtlSeconds, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTtlSeconds, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithKubernetesCredentialLibraryTtlSeconds(uint32(ttl)))
+	}
+
+	return true
+}
+
+func (c *KubernetesCommand) extraKubernetesHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create kubernetes -credential-store-id [options] [args]",
+			"",
+			"  Create a kubernetes-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create kubernetes -credential-store-id cskube_1234567890 -namespace apps -service-account deployer`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update kubernetes [options] [args]",
+			"",
+			"  Update a kubernetes-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update kubernetes -id clkube_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibrariescmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// NewRestoreCommand returns the command which restores a credential library to its
+// state at a point in time.
+func NewRestoreCommand(c *base.Command) *common.RestoreCommand {
+	return &common.RestoreCommand{
+		Command:   c,
+		Resource:  resource.CredentialLibrary,
+		ExampleId: "clvlt_1234567890",
+		Restore: func(ctx context.Context, client *api.Client, id string, at time.Time, dryRun bool) (common.RestoreResult, error) {
+			result, err := credentiallibraries.NewClient(client).Restore(ctx, id, at, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibrariescmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraVaultPkiFlagsFunc = extraVaultPkiFlagsFuncImpl
+	extraVaultPkiActionsFlagsMapFunc = extraVaultPkiActionsFlagsMapFuncImpl
+	extraVaultPkiFlagsHandlingFunc = extraVaultPkiFlagHandlingFuncImpl
+}
+
+const (
+	commonNameName = "common-name"
+	altNamesName   = "alt-names"
+	ipSansName     = "ip-sans"
+)
+
+type extraVaultPkiCmdVars struct {
+	flagPath       string
+	flagCommonName string
+	flagAltNames   string
+	flagIpSans     string
+	flagKeyType    string
+	flagKeyBits    string
+	flagTtl        string
+}
+
+func extraVaultPkiActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			pathFlagName,
+			commonNameName,
+			altNamesName,
+			ipSansName,
+			keyTypeName,
+			keyBitsName,
+			ttlName,
+		},
+		"update": {
+			pathFlagName,
+			commonNameName,
+			altNamesName,
+			ipSansName,
+			keyTypeName,
+			keyBitsName,
+			ttlName,
+		},
+	}
+	return flags
+}
+
+func extraVaultPkiFlagsFuncImpl(c *VaultPkiCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Vault PKI Credential Library Options")
+
+	for _, name := range flagsVaultPkiMap[c.Func] {
+		switch name {
+		case pathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   pathFlagName,
+				Target: &c.flagPath,
+				Usage:  "The path in vault to request certificates from. Must be a pki issue or sign endpoint.",
+			})
+		case commonNameName:
+			f.StringVar(&base.StringVar{
+				Name:   commonNameName,
+				Target: &c.flagCommonName,
+				Usage:  "The common name of the issued certificate. Can be a template using user and account data.",
+			})
+		case altNamesName:
+			f.StringVar(&base.StringVar{
+				Name:   altNamesName,
+				Target: &c.flagAltNames,
+				Usage:  "A comma separated list of DNS or email subject alternative names for the issued certificate. Can be a template using user and account data.",
+			})
+		case ipSansName:
+			f.StringVar(&base.StringVar{
+				Name:   ipSansName,
+				Target: &c.flagIpSans,
+				Usage:  "A comma separated list of IP subject alternative names for the issued certificate. Can be a template using user and account data.",
+			})
+		case keyTypeName:
+			f.StringVar(&base.StringVar{
+				Name:   keyTypeName,
+				Target: &c.flagKeyType,
+				Usage:  "The key type for the generated private key. One of: ed25519, ecdsa, rsa.",
+			})
+		case keyBitsName:
+			f.StringVar(&base.StringVar{
+				Name:   keyBitsName,
+				Target: &c.flagKeyBits,
+				Usage:  "The number of bits when generating the private key. Depends on key_type. If ed25519 this should not be set, or set to 0, if ecdsa one of 256, 384, 521, if rsa one of 2048, 3072, 4096.",
+			})
+		case ttlName:
+			f.StringVar(&base.StringVar{
+				Name:   ttlName,
+				Target: &c.flagTtl,
+				Usage:  "The time-to-live for the issued certificate. The certificate never outlives the session it was issued for.",
+			})
+		}
+	}
+}
+
+func extraVaultPkiFlagHandlingFuncImpl(c *VaultPkiCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagPath {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryPath(c.flagPath))
+	}
+	switch c.flagCommonName {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryCommonName(c.flagCommonName))
+	}
+	switch c.flagAltNames {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultPkiCredentialLibraryAltNames())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryAltNames(c.flagAltNames))
+	}
+	switch c.flagIpSans {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultPkiCredentialLibraryIpSans())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryIpSans(c.flagIpSans))
+	}
+	switch c.flagKeyType {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultPkiCredentialLibraryKeyType())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryKeyType(c.flagKeyType))
+	}
+	switch c.flagKeyBits {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultPkiCredentialLibraryKeyBits())
+	default:
+		keyBits, err := strconv.ParseUint(c.flagKeyBits, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKeyBits, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryKeyBits(uint32(keyBits)))
+	}
+	switch c.flagTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultPkiCredentialLibraryTtl())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultPkiCredentialLibraryTtl(c.flagTtl))
+	}
+
+	return true
+}
+
+func (c *VaultPkiCommand) extraVaultPkiHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create vault-pki -credential-store-id [options] [args]",
+			"",
+			"  Create a vault-pki-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create vault-pki -credential-store-id csvlt_1234567890 -vault-path "/pki/issue/role" -common-name "{{.User.Name}}.example.com"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update vault-pki [options] [args]",
+			"",
+			"  Update a vault-pki-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update vault-pki -id clvpki_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
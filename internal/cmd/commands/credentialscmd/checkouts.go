@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListCheckoutsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListCheckoutsCommand)(nil)
+	_ cli.Command             = (*CheckInCommand)(nil)
+	_ cli.CommandAutocomplete = (*CheckInCommand)(nil)
+)
+
+type ListCheckoutsCommand struct {
+	*base.Command
+}
+
+func (c *ListCheckoutsCommand) Synopsis() string {
+	return wordwrap.WrapString("List the sessions currently holding a credential", base.TermWidth)
+}
+
+func (c *ListCheckoutsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials list-checkouts [args]",
+		"",
+		"  List the check-outs of a credential currently held by sessions, along with the user each session belongs to. Example:",
+		"",
+		`    $ boundary credentials list-checkouts -id credup_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListCheckoutsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose check-outs should be listed",
+	})
+
+	return set
+}
+
+func (c *ListCheckoutsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListCheckoutsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListCheckoutsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).ListCheckouts(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing credential check-outs")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list credential check-outs: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printCheckoutsTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
+
+type CheckInCommand struct {
+	*base.Command
+
+	flagSessionId string
+}
+
+func (c *CheckInCommand) Synopsis() string {
+	return wordwrap.WrapString("Forcibly check in a credential held by a session", base.TermWidth)
+}
+
+func (c *CheckInCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials check-in [args]",
+		"",
+		"  Forcibly release the check-out of a credential held by a session, making it available to other sessions. The session is canceled. Example:",
+		"",
+		`    $ boundary credentials check-in -id credup_1234567890 -session-id s_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *CheckInCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential to check in",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "session-id",
+		Target: &c.flagSessionId,
+		Usage:  "The id of the session holding the check-out",
+	})
+
+	return set
+}
+
+func (c *CheckInCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *CheckInCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *CheckInCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagSessionId == "":
+		c.PrintCliError(errors.New("Session ID must be provided via -session-id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).CheckIn(c.Context, c.FlagId, c.flagSessionId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when checking in credential")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to check in credential: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printCheckoutsTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
+
+func printCheckoutsTable(items []*credentials.CredentialCheckout) string {
+	if len(items) == 0 {
+		return "No credential check-outs found"
+	}
+
+	output := []string{
+		"",
+		"Credential check-out information:",
+	}
+	for i, m := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Session ID:            %s", m.SessionId),
+		)
+		if m.UserId != "" {
+			output = append(output,
+				fmt.Sprintf("    User ID:             %s", m.UserId),
+			)
+		}
+		if !m.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Checked Out:         %s", m.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !m.ExpirationTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Session Expires:     %s", m.ExpirationTime.Local().Format(time.RFC1123)),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
@@ -4,7 +4,9 @@
 package credentialscmd
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -13,13 +15,42 @@ import (
 )
 
 const (
-	usernameFlagName             = "username"
-	passwordFlagName             = "password"
-	privateKeyFlagName           = "private-key"
-	privateKeyPassphraseFlagName = "private-key-passphrase"
-	secretFlagName               = "secret"
+	usernameFlagName               = "username"
+	passwordFlagName               = "password"
+	privateKeyFlagName             = "private-key"
+	privateKeyPassphraseFlagName   = "private-key-passphrase"
+	secretFlagName                 = "secret"
+	certificateFlagName            = "certificate"
+	caBundleFlagName               = "ca-bundle"
+	maxConcurrentCheckoutsFlagName = "max-concurrent-checkouts"
 )
 
+func init() {
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	if c.Func == "read" {
+		f.IntVar(&base.IntVar{
+			Name:   "version",
+			Target: &c.FlagVersion,
+			Usage:  "The version of the credential to read. Only versions listed by list-versions can be read. If not specified, the current version is read.",
+		})
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	if c.Func == "read" && c.FlagVersion != 0 {
+		if c.FlagVersion < 0 {
+			c.PrintCliError(errors.New("Version must be a positive number"))
+			return false
+		}
+		*opts = append(*opts, credentials.WithCredentialVersion(uint32(c.FlagVersion)))
+	}
+	return true
+}
+
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -145,6 +176,9 @@ func printItemTable(item *credentials.Credential, resp *api.Response) string {
 	if item.Type != "" {
 		nonAttributeMap["Type"] = item.Type
 	}
+	if item.MaxConcurrentCheckouts != 0 {
+		nonAttributeMap["Max Concurrent Checkouts"] = item.MaxConcurrentCheckouts
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -181,9 +215,35 @@ func printItemTable(item *credentials.Credential, resp *api.Response) string {
 	return base.WrapForHelpText(ret)
 }
 
+func addMaxConcurrentCheckoutsFlag(f *base.FlagSet, target *string) {
+	f.StringVar(&base.StringVar{
+		Name:   maxConcurrentCheckoutsFlagName,
+		Target: target,
+		Usage:  `The maximum number of sessions which may check out the credential at the same time. Use "null" to allow any number of sessions.`,
+	})
+}
+
+func handleMaxConcurrentCheckoutsFlag(c *base.Command, flag string, opts *[]credentials.Option) bool {
+	switch flag {
+	case "":
+	case "null":
+		*opts = append(*opts, credentials.DefaultMaxConcurrentCheckouts())
+	default:
+		max, err := strconv.ParseUint(flag, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", flag, err))
+			return false
+		}
+		*opts = append(*opts, credentials.WithMaxConcurrentCheckouts(uint32(max)))
+	}
+	return true
+}
+
 var keySubstMap = map[string]string{
 	"username":                    "Username",
 	"password_hmac":               "Password HMAC",
 	"private_key_hmac":            "Private Key HMAC",
 	"private_key_passphrase_hmac": "Private Key Passphrase HMAC",
+	"certificate":                 "Certificate",
+	"ca_bundle":                   "CA Bundle",
 }
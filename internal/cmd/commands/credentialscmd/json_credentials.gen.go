@@ -34,6 +34,8 @@ type JsonCommand struct {
 	Func string
 
 	plural string
+
+	extraJsonCmdVars
 }
 
 func (c *JsonCommand) AutocompleteArgs() complete.Predictor {
@@ -4,9 +4,45 @@
 package credentialscmd
 
 import (
+	"github.com/hashicorp/boundary/api/credentials"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+func init() {
+	extraJsonFlagsFunc = extraJsonFlagsFuncImpl
+	extraJsonActionsFlagsMapFunc = extraJsonActionsFlagsMapFuncImpl
+	extraJsonFlagsHandlingFunc = extraJsonFlagHandlingFuncImpl
+}
+
+type extraJsonCmdVars struct {
+	flagMaxConcurrentCheckouts string
+}
+
+func extraJsonActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			maxConcurrentCheckoutsFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraJsonFlagsFuncImpl(c *JsonCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("JSON Credential Options")
+
+	for _, name := range flagsJsonMap[c.Func] {
+		switch name {
+		case maxConcurrentCheckoutsFlagName:
+			addMaxConcurrentCheckoutsFlag(f, &c.flagMaxConcurrentCheckouts)
+		}
+	}
+}
+
+func extraJsonFlagHandlingFuncImpl(c *JsonCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	return handleMaxConcurrentCheckoutsFlag(c.Command, c.flagMaxConcurrentCheckouts, opts)
+}
+
 func (c *JsonCommand) extraJsonHelpFunc(_ map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
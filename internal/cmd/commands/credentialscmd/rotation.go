@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*SetRotationPolicyCommand)(nil)
+	_ cli.CommandAutocomplete = (*SetRotationPolicyCommand)(nil)
+	_ cli.Command             = (*RemoveRotationPolicyCommand)(nil)
+	_ cli.CommandAutocomplete = (*RemoveRotationPolicyCommand)(nil)
+	_ cli.Command             = (*ListRotationsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListRotationsCommand)(nil)
+)
+
+type SetRotationPolicyCommand struct {
+	*base.Command
+
+	flagRotator               string
+	flagAddress               string
+	flagAdminCredentialId     string
+	flagHostKey               string
+	flagRotateAfterSession    bool
+	flagRotationIntervalHours uint
+}
+
+func (c *SetRotationPolicyCommand) Synopsis() string {
+	return wordwrap.WrapString("Set the rotation policy of a username-password credential", base.TermWidth)
+}
+
+func (c *SetRotationPolicyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials set-rotation-policy [args]",
+		"",
+		"  Set the policy which rotates the password of a username-password credential on the system it authenticates to, replacing any existing policy. Example:",
+		"",
+		`    $ boundary credentials set-rotation-policy -id credup_1234567890 -rotator postgres -address db.example.com:5432 -rotate-after-session`,
+		"",
+		"  The ssh rotator requires the host key of the server:",
+		"",
+		`    $ boundary credentials set-rotation-policy -id credup_1234567890 -rotator ssh -address host.example.com:22 -host-key file:///etc/ssh/ssh_host_ed25519_key.pub -rotation-interval-hours 24`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *SetRotationPolicyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose rotation policy should be set",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "rotator",
+		Target:     &c.flagRotator,
+		Usage:      `The rotator used to change the password. One of "postgres", "ssh" or "ldap".`,
+		Completion: complete.PredictSet("postgres", "ssh", "ldap"),
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "address",
+		Target: &c.flagAddress,
+		Usage:  "The host:port of the system the password is changed on. The ldap rotator also accepts an ldap:// or ldaps:// URL.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "admin-credential-id",
+		Target: &c.flagAdminCredentialId,
+		Usage:  "The id of a username-password credential in the same credential store used to authenticate when changing the password. If unset, the credential being rotated is used.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "host-key",
+		Target: &c.flagHostKey,
+		Usage:  "The public key, in authorized_keys format, the host key of the server must match. Required by the ssh rotator. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "rotate-after-session",
+		Target: &c.flagRotateAfterSession,
+		Usage:  "Rotate the password after every session using the credential has terminated.",
+	})
+	f.UintVar(&base.UintVar{
+		Name:   "rotation-interval-hours",
+		Target: &c.flagRotationIntervalHours,
+		Usage:  "The number of hours between periodic rotations of the password.",
+	})
+
+	return set
+}
+
+func (c *SetRotationPolicyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *SetRotationPolicyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *SetRotationPolicyCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagRotator == "":
+		c.PrintCliError(errors.New("Rotator must be provided via -rotator"))
+		return base.CommandUserError
+	case c.flagAddress == "":
+		c.PrintCliError(errors.New("Address must be provided via -address"))
+		return base.CommandUserError
+	case !c.flagRotateAfterSession && c.flagRotationIntervalHours == 0:
+		c.PrintCliError(errors.New("One of -rotate-after-session or -rotation-interval-hours must be provided"))
+		return base.CommandUserError
+	case c.flagRotator == "ssh" && c.flagHostKey == "":
+		c.PrintCliError(errors.New("Host key must be provided via -host-key for the ssh rotator"))
+		return base.CommandUserError
+	}
+
+	hostKey, err := parseutil.ParsePath(c.flagHostKey)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.PrintCliError(fmt.Errorf("Error parsing host key flag: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).SetRotationPolicy(c.Context, c.FlagId, &credentials.CredentialRotationPolicy{
+		Rotator:               c.flagRotator,
+		Address:               c.flagAddress,
+		AdminCredentialId:     c.flagAdminCredentialId,
+		HostKey:               hostKey,
+		RotateAfterSession:    c.flagRotateAfterSession,
+		RotationIntervalHours: uint32(c.flagRotationIntervalHours),
+	})
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when setting credential rotation policy")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to set credential rotation policy: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printRotationPolicyTable(result.GetItem()))
+	}
+
+	return base.CommandSuccess
+}
+
+type RemoveRotationPolicyCommand struct {
+	*base.Command
+}
+
+func (c *RemoveRotationPolicyCommand) Synopsis() string {
+	return wordwrap.WrapString("Remove the rotation policy of a credential", base.TermWidth)
+}
+
+func (c *RemoveRotationPolicyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials remove-rotation-policy [args]",
+		"",
+		"  Remove the rotation policy of a credential. Its password is no longer rotated. Example:",
+		"",
+		`    $ boundary credentials remove-rotation-policy -id credup_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RemoveRotationPolicyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose rotation policy should be removed",
+	})
+
+	return set
+}
+
+func (c *RemoveRotationPolicyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RemoveRotationPolicyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RemoveRotationPolicyCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).RemoveRotationPolicy(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when removing credential rotation policy")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to remove credential rotation policy: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output("The rotation policy was removed successfully.")
+	}
+
+	return base.CommandSuccess
+}
+
+type ListRotationsCommand struct {
+	*base.Command
+}
+
+func (c *ListRotationsCommand) Synopsis() string {
+	return wordwrap.WrapString("List the rotation policy and rotation history of a credential", base.TermWidth)
+}
+
+func (c *ListRotationsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials list-rotations [args]",
+		"",
+		"  List the rotation policy of a credential along with its rotation history, most recent first. Example:",
+		"",
+		`    $ boundary credentials list-rotations -id credup_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListRotationsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose rotations should be listed",
+	})
+
+	return set
+}
+
+func (c *ListRotationsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListRotationsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListRotationsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).ListRotations(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing credential rotations")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list credential rotations: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printRotationPolicyTable(result.GetPolicy()))
+		c.UI.Output(printRotationsTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
+
+func printRotationPolicyTable(p *credentials.CredentialRotationPolicy) string {
+	if p == nil {
+		return "No rotation policy set"
+	}
+
+	nonAttributeMap := map[string]any{
+		"Rotator":              p.Rotator,
+		"Address":              p.Address,
+		"Rotate After Session": p.RotateAfterSession,
+		"Rotation Pending":     p.RotationPending,
+	}
+	if p.AdminCredentialId != "" {
+		nonAttributeMap["Admin Credential ID"] = p.AdminCredentialId
+	}
+	if p.HostKey != "" {
+		nonAttributeMap["Host Key"] = p.HostKey
+	}
+	if p.RotationIntervalHours != 0 {
+		nonAttributeMap["Rotation Interval Hours"] = p.RotationIntervalHours
+	}
+	if !p.LastRotationTime.IsZero() {
+		nonAttributeMap["Last Rotation Time"] = p.LastRotationTime.Local().Format(time.RFC1123)
+	}
+	if !p.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = p.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !p.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = p.UpdatedTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Credential rotation policy:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
+
+func printRotationsTable(items []*credentials.CredentialRotation) string {
+	if len(items) == 0 {
+		return "No credential rotations found"
+	}
+
+	output := []string{
+		"",
+		"Credential rotation information:",
+	}
+	for i, m := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Start Time:            %s", m.StartTime.Local().Format(time.RFC1123)),
+			fmt.Sprintf("    Trigger:             %s", m.Trigger),
+			fmt.Sprintf("    Status:              %s", m.Status),
+		)
+		if !m.EndTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    End Time:            %s", m.EndTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if m.Error != "" {
+			output = append(output,
+				fmt.Sprintf("    Error:               %s", m.Error),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
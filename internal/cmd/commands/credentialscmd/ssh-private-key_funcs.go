@@ -23,9 +23,10 @@ func init() {
 }
 
 type extraSshPrivateKeyCmdVars struct {
-	flagUsername             string
-	flagPrivateKey           string
-	flagPrivateKeyPassphrase string
+	flagUsername               string
+	flagPrivateKey             string
+	flagPrivateKeyPassphrase   string
+	flagMaxConcurrentCheckouts string
 }
 
 func extraSshPrivateKeyActionsFlagsMapFuncImpl() map[string][]string {
@@ -34,6 +35,7 @@ func extraSshPrivateKeyActionsFlagsMapFuncImpl() map[string][]string {
 			usernameFlagName,
 			privateKeyFlagName,
 			privateKeyPassphraseFlagName,
+			maxConcurrentCheckoutsFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -63,11 +65,17 @@ func extraSshPrivateKeyFlagsFuncImpl(c *SshPrivateKeyCommand, set *base.FlagSets
 				Target: &c.flagPrivateKeyPassphrase,
 				Usage:  "The passphrase associated with the SSH private key. This value is ignored if the private key does not require a passphrase or if no private key is supplied. This can refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. Or, if left empty, if the key requires a passphrase it can be entered manually.",
 			})
+		case maxConcurrentCheckoutsFlagName:
+			addMaxConcurrentCheckoutsFlag(f, &c.flagMaxConcurrentCheckouts)
 		}
 	}
 }
 
 func extraSshPrivateKeyFlagHandlingFuncImpl(c *SshPrivateKeyCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	if !handleMaxConcurrentCheckoutsFlag(c.Command, c.flagMaxConcurrentCheckouts, opts) {
+		return false
+	}
+
 	switch c.flagUsername {
 	case "":
 	default:
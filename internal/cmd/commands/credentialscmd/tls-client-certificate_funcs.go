@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/go-secure-stdlib/password"
+)
+
+func init() {
+	extraTlsClientCertificateFlagsFunc = extraTlsClientCertificateFlagsFuncImpl
+	extraTlsClientCertificateActionsFlagsMapFunc = extraTlsClientCertificateActionsFlagsMapFuncImpl
+	extraTlsClientCertificateFlagsHandlingFunc = extraTlsClientCertificateFlagHandlingFuncImpl
+}
+
+type extraTlsClientCertificateCmdVars struct {
+	flagCertificate            string
+	flagPrivateKey             string
+	flagPrivateKeyPassphrase   string
+	flagCaBundle               string
+	flagMaxConcurrentCheckouts string
+}
+
+func extraTlsClientCertificateActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			certificateFlagName,
+			privateKeyFlagName,
+			privateKeyPassphraseFlagName,
+			caBundleFlagName,
+			maxConcurrentCheckoutsFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraTlsClientCertificateFlagsFuncImpl(c *TlsClientCertificateCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("TLS Client Certificate Credential Options")
+
+	for _, name := range flagsTlsClientCertificateMap[c.Func] {
+		switch name {
+		case certificateFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   certificateFlagName,
+				Target: &c.flagCertificate,
+				Usage:  "The PEM encoded client certificate chain associated with the credential, leaf certificate first. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "The PEM encoded private key of the client certificate. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read.",
+			})
+		case privateKeyPassphraseFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyPassphraseFlagName,
+				Target: &c.flagPrivateKeyPassphrase,
+				Usage:  "The passphrase of an encrypted private key. This value is ignored if no private key is supplied. This can refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. Or, if left empty, if the key is encrypted the passphrase can be entered manually.",
+			})
+		case caBundleFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   caBundleFlagName,
+				Target: &c.flagCaBundle,
+				Usage:  `The PEM encoded CA certificates used to verify the server the client certificate is presented to. This can refer to a file on disk (file://) from which the value will be read or an env var (env://) from which the value will be read. Use "null" to clear the value on update.`,
+			})
+		case maxConcurrentCheckoutsFlagName:
+			addMaxConcurrentCheckoutsFlag(f, &c.flagMaxConcurrentCheckouts)
+		}
+	}
+}
+
+func extraTlsClientCertificateFlagHandlingFuncImpl(c *TlsClientCertificateCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	if !handleMaxConcurrentCheckoutsFlag(c.Command, c.flagMaxConcurrentCheckouts, opts) {
+		return false
+	}
+
+	if c.flagCertificate != "" {
+		certificate, err := parseutil.MustParsePath(c.flagCertificate)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.UI.Error("Certificate flag must be used with env:// or file:// syntax")
+			return false
+		default:
+			c.UI.Error(fmt.Sprintf("Error parsing certificate flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentials.WithTlsClientCertificateCredentialCertificate(certificate))
+	}
+
+	switch c.flagCaBundle {
+	case "":
+	case "null":
+		*opts = append(*opts, credentials.DefaultTlsClientCertificateCredentialCaBundle())
+	default:
+		caBundle, err := parseutil.MustParsePath(c.flagCaBundle)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.UI.Error("CA bundle flag must be used with env:// or file:// syntax")
+			return false
+		default:
+			c.UI.Error(fmt.Sprintf("Error parsing CA bundle flag: %v", err))
+			return false
+		}
+		*opts = append(*opts, credentials.WithTlsClientCertificateCredentialCaBundle(caBundle))
+	}
+
+	// If private key not set (e.g. just a certificate update) then don't
+	// check either private key or passphrase
+	if c.flagPrivateKey == "" {
+		return true
+	}
+
+	privateKey, err := parseutil.MustParsePath(c.flagPrivateKey)
+	switch {
+	case err == nil:
+	case errors.Is(err, parseutil.ErrNotParsed):
+		c.UI.Error("Private key flag must be used with env:// or file:// syntax")
+		return false
+	default:
+		c.UI.Error(fmt.Sprintf("Error parsing private key flag: %v", err))
+		return false
+	}
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		c.UI.Error("Error parsing private key: no PEM data found")
+		return false
+	}
+	*opts = append(*opts, credentials.WithTlsClientCertificateCredentialPrivateKey(privateKey))
+
+	// Only legacy PEM encrypted keys require a passphrase
+	if !x509.IsEncryptedPEMBlock(block) {
+		if c.flagPrivateKeyPassphrase != "" {
+			c.UI.Warn("Ignoring private key passphrase as private key is not encrypted.")
+		}
+		return true
+	}
+
+	var privateKeyPassphrase string
+	switch c.flagPrivateKeyPassphrase {
+	case "":
+		fmt.Print("Please enter the passphrase for the private key (it will be hidden): ")
+		privateKeyPassphrase, err = password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the passphrase. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+			return false
+		}
+		privateKeyPassphrase = strings.TrimSpace(privateKeyPassphrase)
+
+	default:
+		privateKeyPassphrase, err = parseutil.MustParsePath(c.flagPrivateKeyPassphrase)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.UI.Error("Private key passphrase flag must be used with env:// or file:// syntax")
+			return false
+		default:
+			c.UI.Error(fmt.Sprintf("Error parsing private key passphrase flag: %v", err))
+			return false
+		}
+	}
+
+	if _, err := x509.DecryptPEMBlock(block, []byte(privateKeyPassphrase)); err != nil {
+		c.UI.Error(fmt.Sprintf("Error decrypting private key: %v", err))
+		return false
+	}
+	*opts = append(*opts, credentials.WithTlsClientCertificateCredentialPrivateKeyPassphrase(privateKeyPassphrase))
+
+	return true
+}
+
+func (c *TlsClientCertificateCommand) extraTlsClientCertificateHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create tls-client-certificate -credential-store-id [options] [args]",
+			"",
+			"  Create a TLS client certificate credential. Example:",
+			"",
+			`    $ boundary credentials create tls-client-certificate -credential-store-id csst_1234567890 -certificate file:///home/user/client.crt -private-key file:///home/user/client.key`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update tls-client-certificate [options] [args]",
+			"",
+			"  Update a TLS client certificate credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update tls-client-certificate -id credtls_1234567890 -ca-bundle file:///home/user/ca.crt`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
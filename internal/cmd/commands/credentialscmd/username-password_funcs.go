@@ -19,8 +19,9 @@ func init() {
 }
 
 type extraUsernamePasswordCmdVars struct {
-	flagUsername string
-	flagPassword string
+	flagUsername               string
+	flagPassword               string
+	flagMaxConcurrentCheckouts string
 }
 
 func extraUsernamePasswordActionsFlagsMapFuncImpl() map[string][]string {
@@ -28,6 +29,7 @@ func extraUsernamePasswordActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			usernameFlagName,
 			passwordFlagName,
+			maxConcurrentCheckoutsFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -51,6 +53,8 @@ func extraUsernamePasswordFlagsFuncImpl(c *UsernamePasswordCommand, set *base.Fl
 				Target: &c.flagPassword,
 				Usage:  "The password associated with the credential. This can be a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case maxConcurrentCheckoutsFlagName:
+			addMaxConcurrentCheckoutsFlag(f, &c.flagMaxConcurrentCheckouts)
 		}
 	}
 }
@@ -77,6 +81,10 @@ func extraUsernamePasswordFlagHandlingFuncImpl(c *UsernamePasswordCommand, _ *ba
 		*opts = append(*opts, credentials.WithUsernamePasswordCredentialPassword(password))
 	}
 
+	if !handleMaxConcurrentCheckoutsFlag(c.Command, c.flagMaxConcurrentCheckouts, opts) {
+		return false
+	}
+
 	return true
 }
 
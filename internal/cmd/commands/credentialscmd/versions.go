@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListVersionsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListVersionsCommand)(nil)
+	_ cli.Command             = (*RollbackCommand)(nil)
+	_ cli.CommandAutocomplete = (*RollbackCommand)(nil)
+)
+
+type ListVersionsCommand struct {
+	*base.Command
+}
+
+func (c *ListVersionsCommand) Synopsis() string {
+	return wordwrap.WrapString("List the retained versions of a credential", base.TermWidth)
+}
+
+func (c *ListVersionsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials list-versions [args]",
+		"",
+		"  List the versions of a credential that are retained for rollback, most recent first. Example:",
+		"",
+		`    $ boundary credentials list-versions -id credup_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListVersionsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential whose versions should be listed",
+	})
+
+	return set
+}
+
+func (c *ListVersionsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListVersionsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListVersionsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := credentials.NewClient(client).ListVersions(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing credential versions")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to list credential versions: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printVersionsTable(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
+
+type RollbackCommand struct {
+	*base.Command
+
+	flagToVersion uint
+}
+
+func (c *RollbackCommand) Synopsis() string {
+	return wordwrap.WrapString("Roll back a credential to a previous version", base.TermWidth)
+}
+
+func (c *RollbackCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials rollback [args]",
+		"",
+		"  Restore a credential, including its secret, to the state it was in at a previous version. The rollback is recorded as a new version of the credential. Example:",
+		"",
+		`    $ boundary credentials rollback -id credup_1234567890 -to-version 2`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RollbackCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the credential to roll back",
+	})
+	f.IntVar(&base.IntVar{
+		Name:   "version",
+		Target: &c.FlagVersion,
+		Usage:  "The current version of the credential. If not specified, the command will perform a check-and-set automatically.",
+	})
+	f.UintVar(&base.UintVar{
+		Name:   "to-version",
+		Target: &c.flagToVersion,
+		Usage:  "The version to roll the credential back to. Must be one of the versions listed by list-versions.",
+	})
+
+	return set
+}
+
+func (c *RollbackCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RollbackCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RollbackCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagToVersion == 0:
+		c.PrintCliError(errors.New("Version to roll back to must be provided via -to-version"))
+		return base.CommandUserError
+	case c.FlagVersion < 0:
+		c.PrintCliError(errors.New("Version must be a positive number"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+	credsClient := credentials.NewClient(client)
+
+	version := uint32(c.FlagVersion)
+	if version == 0 {
+		existing, err := credsClient.Read(c.Context, c.FlagId)
+		if err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when reading credential to roll back")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to read credential to roll back: %w", err))
+			return base.CommandCliError
+		}
+		version = existing.GetItem().Version
+	}
+
+	result, err := credsClient.Rollback(c.Context, c.FlagId, version, uint32(c.flagToVersion))
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when rolling back credential")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to roll back credential: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	}
+
+	return base.CommandSuccess
+}
+
+func printVersionsTable(items []*credentials.CredentialVersion) string {
+	if len(items) == 0 {
+		return "No credential versions found"
+	}
+
+	output := []string{
+		"",
+		"Credential version information:",
+	}
+	for i, m := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Version:               %d", m.Version),
+			fmt.Sprintf("    Created Time:        %s", m.CreatedTime.Local().Format(time.RFC1123)),
+		)
+	}
+
+	return base.WrapForHelpText(output)
+}
@@ -42,6 +42,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores create static -scope-id p_1234567890`,
 			"",
+			"    Create a plugin-type credential store:",
+			"",
+			`      $ boundary credential-stores create plugin -scope-id p_1234567890 -plugin-name loopback`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -58,6 +62,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores update static -id cs_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a plugin-type credential store:",
+			"",
+			`      $ boundary credential-stores update plugin -id csplg_1234567890 -name devops -description "For DevOps usage"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	default:
@@ -148,6 +156,12 @@ func printItemTable(item *credentialstores.CredentialStore, resp *api.Response)
 	if item.Type != "" {
 		nonAttributeMap["Type"] = item.Type
 	}
+	if item.PluginId != "" {
+		nonAttributeMap["Plugin ID"] = item.PluginId
+	}
+	if item.SecretsHmac != "" {
+		nonAttributeMap["Secrets HMAC"] = item.SecretsHmac
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -165,6 +179,14 @@ func printItemTable(item *credentialstores.CredentialStore, resp *api.Response)
 		)
 	}
 
+	if item.Plugin != nil {
+		ret = append(ret,
+			"",
+			"  Plugin:",
+			base.PluginInfoForOutput(item.Plugin, maxLength),
+		)
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
@@ -203,13 +225,24 @@ func printItemTable(item *credentialstores.CredentialStore, resp *api.Response)
 
 var keySubstMap = map[string]string{
 	"address":                     "Address",
+	"api_url":                     "API URL",
 	"namespace":                   "Namespace",
 	"ca_cert":                     "CA Cert",
 	"tls_server_name":             "TLS Server Name",
 	"tls_skip_verify":             "Skip TLS Verification",
 	"token_hmac":                  "Token HMAC",
 	"token_status":                "Token Status",
+	"token_last_renewal_time":     "Token Last Renewal Time",
+	"token_expiration_time":       "Token Expiration Time",
+	"token_health_status":         "Token Health Status",
+	"token_health_check_time":     "Token Health Check Time",
+	"token_missing_capabilities":  "Token Missing Capabilities",
 	"client_certificate":          "Client Certificate",
 	"client_certificate_key_hmac": "Client Certificate Key HMAC",
 	"worker_filter":               "Worker Filter",
+	"region":                      "Region",
+	"sts_endpoint":                "STS Endpoint",
+	"signin_endpoint":             "Sign-in Endpoint",
+	"access_key_id":               "Access Key ID",
+	"secret_access_key_hmac":      "Secret Access Key HMAC",
 }
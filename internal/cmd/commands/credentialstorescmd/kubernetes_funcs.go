@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialstorescmd
+
+import (
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraKubernetesFlagsFunc = extraKubernetesFlagsFuncImpl
+	extraKubernetesActionsFlagsMapFunc = extraKubernetesActionsFlagsMapFuncImpl
+	extraKubernetesFlagsHandlingFunc = extraKubernetesFlagHandlingFuncImpl
+}
+
+const (
+	kubeApiUrlFlagName = "kubernetes-api-url"
+	kubeCaCertFlagName = "kubernetes-ca-cert"
+	kubeTokenFlagName  = "kubernetes-token"
+)
+
+type extraKubernetesCmdVars struct {
+	flagApiUrl string
+	flagCaCert string
+	flagToken  string
+}
+
+func extraKubernetesActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			kubeApiUrlFlagName,
+			kubeCaCertFlagName,
+			kubeTokenFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraKubernetesFlagsFuncImpl(c *KubernetesCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Kubernetes Credential Store Options")
+
+	for _, name := range flagsKubernetesMap[c.Func] {
+		switch name {
+		case kubeApiUrlFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kubeApiUrlFlagName,
+				Target: &c.flagApiUrl,
+				Usage:  "The URL of the Kubernetes API server. This should be a complete URL such as https://127.0.0.1:6443",
+			})
+		case kubeCaCertFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kubeCaCertFlagName,
+				Target: &c.flagCaCert,
+				Usage:  "The CA Cert to use when connecting to the Kubernetes API server. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case kubeTokenFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   kubeTokenFlagName,
+				Target: &c.flagToken,
+				Usage:  "The bearer token boundary uses to request service account tokens from the Kubernetes API server. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraKubernetesFlagHandlingFuncImpl(c *KubernetesCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagApiUrl {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithKubernetesCredentialStoreApiUrl(c.flagApiUrl))
+	}
+	switch c.flagCaCert {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultKubernetesCredentialStoreCaCert())
+	default:
+		cer, _ := parseutil.ParsePath(c.flagCaCert)
+		*opts = append(*opts, credentialstores.WithKubernetesCredentialStoreCaCert(cer))
+	}
+	switch c.flagToken {
+	case "":
+	default:
+		tok, _ := parseutil.ParsePath(c.flagToken)
+		*opts = append(*opts, credentialstores.WithKubernetesCredentialStoreToken(tok))
+	}
+
+	return true
+}
+
+func (c *KubernetesCommand) extraKubernetesHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create kubernetes [options] [args]",
+			"",
+			"  Create a kubernetes-type credential store. Example:",
+			"",
+			`    $ boundary credential-stores create kubernetes -kubernetes-api-url "https://127.0.0.1:6443" -kubernetes-token "file:///path/to/token"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update kubernetes [options] [args]",
+			"",
+			"  Update a kubernetes-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update kubernetes -id cskube_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialstorescmd
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func (c *PluginCommand) extraPluginHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create plugin [options] [args]",
+			"",
+			"  Create a plugin-type credential store. Example:",
+			"",
+			`    $ boundary credential-stores create plugin -scope-id p_1234567890 -plugin-name loopback -name prodops -description "Plugin credential store for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update plugin [options] [args]",
+			"",
+			"  Update a plugin-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update plugin -id csplg_1234567890 -name "devops" -description "Plugin credential store for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
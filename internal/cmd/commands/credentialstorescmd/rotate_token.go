@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentialstorescmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RotateTokenCommand)(nil)
+	_ cli.CommandAutocomplete = (*RotateTokenCommand)(nil)
+)
+
+type RotateTokenCommand struct {
+	*base.Command
+
+	flagVaultToken string
+}
+
+func (c *RotateTokenCommand) Synopsis() string {
+	return wordwrap.WrapString("Rotate the Vault token of a vault credential store", base.TermWidth)
+}
+
+func (c *RotateTokenCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credential-stores rotate-token [args]",
+		"",
+		"  Replace the Vault token of a vault credential store. The new token is validated before it is used. The replaced token is revoked once all credentials issued with it have been revoked or have expired. Example:",
+		"",
+		`    $ boundary credential-stores rotate-token -id csvlt_1234567890 -vault-token env://VAULT_TOKEN`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RotateTokenCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the vault credential store whose token should be rotated",
+	})
+	f.IntVar(&base.IntVar{
+		Name:   "version",
+		Target: &c.FlagVersion,
+		Usage:  "The current version of the credential store. If not specified, the command will perform a check-and-set automatically.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   vaultTokenFlagName,
+		Target: &c.flagVaultToken,
+		Usage:  "The new vault token to use when boundary connects to vault for this store. Can be specified as a file path or env var reference (file://... or env://...).",
+	})
+
+	return set
+}
+
+func (c *RotateTokenCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RotateTokenCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RotateTokenCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagVaultToken == "":
+		c.PrintCliError(errors.New("Vault token must be provided via -vault-token"))
+		return base.CommandUserError
+	case c.FlagVersion < 0:
+		c.PrintCliError(errors.New("Version must be a positive number"))
+		return base.CommandUserError
+	}
+
+	token, err := parseutil.ParsePath(c.flagVaultToken)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.PrintCliError(fmt.Errorf("Error parsing vault token: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []credentialstores.Option
+	version := uint32(c.FlagVersion)
+	if version == 0 {
+		opts = append(opts, credentialstores.WithAutomaticVersioning(true))
+	}
+
+	result, err := credentialstores.NewClient(client).RotateToken(c.Context, c.FlagId, version, token, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when rotating credential store token")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to rotate credential store token: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	}
+
+	return base.CommandSuccess
+}
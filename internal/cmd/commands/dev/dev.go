@@ -650,7 +650,7 @@ func (c *Command) Run(args []string) int {
 	var opts []base.Option
 	if c.flagCreateLoopbackHostPlugin {
 		c.DevLoopbackHostPluginId = "pl_1234567890"
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostLoopback)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostLoopback, base.EnabledPluginCredentialLoopback)
 		c.Config.Controller.Scheduler.JobRunIntervalDuration = 100 * time.Millisecond
 	}
 	switch c.flagDatabaseUrl {
@@ -684,7 +684,10 @@ func (c *Command) Run(args []string) int {
 	}
 
 	{
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure, base.EnabledPluginHostKubernetes)
+		if c.Config.Plugins.FileHostPluginDir != "" {
+			c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostFile)
+		}
 		conf := &controller.Config{
 			RawConfig: c.Config,
 			Server:    c.Server,
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventscmd
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+}
+
+func (c *Command) Synopsis() string {
+	return "Interact with the events emitted by Boundary controllers"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary events <subcommand> [options] [args]",
+		"",
+		"  This command groups subcommands for operators interacting with the events emitted by Boundary controllers. Here is an example:",
+		"",
+		"    Stream the audit events of requests in an org:",
+		"",
+		`      $ boundary events tail -scope-id o_1234567890 -filter '"/type" == "audit"'`,
+		"",
+		"  Please see the individual subcommand help for detailed usage information.",
+	})
+}
+
+func (c *Command) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventscmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*TailCommand)(nil)
+	_ cli.CommandAutocomplete = (*TailCommand)(nil)
+)
+
+// reconnectDelay is how long the command waits before reconnecting when the
+// event stream ends, e.g. because the controller was restarted.
+const reconnectDelay = 2 * time.Second
+
+type TailCommand struct {
+	*base.Command
+}
+
+func (c *TailCommand) Synopsis() string {
+	return wordwrap.WrapString("Stream the events emitted by a controller", base.TermWidth)
+}
+
+func (c *TailCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary events tail [args]",
+		"",
+		"  Stream the events emitted by the controller, until interrupted. Callers with the tail-events permission on the global scope receive every event, while callers with it on an org or project only receive the audit events of requests in that scope. Sensitive fields are redacted. Example:",
+		"",
+		`    $ boundary events tail -scope-id global -filter '"/data/request_info/method" == "POST"'`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *TailCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:    "scope-id",
+		Target:  &c.FlagScopeId,
+		Default: "global",
+		Usage:   "The id of the scope whose events will be streamed",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "filter",
+		Target: &c.FlagFilter,
+		Usage:  "If set, only events matching the filter will be streamed. The filter operates against the event formatted as cloudevents JSON. Using single quotes is recommended as filters contain double quotes. See https://www.boundaryproject.io/docs/concepts/filtering/events for details.",
+	})
+
+	return set
+}
+
+func (c *TailCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *TailCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *TailCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagScopeId == "":
+		c.PrintCliError(errors.New("Scope ID must be provided via -scope-id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+	// The stream is long-lived, so it must not be bounded by the client's
+	// request timeout.
+	client.SetClientTimeout(0)
+
+	var opts []scopes.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, scopes.WithFilter(c.FlagFilter))
+	}
+
+	sClient := scopes.NewClient(client)
+	for {
+		stream, err := sClient.TailEvents(c.Context, c.FlagScopeId, opts...)
+		switch {
+		case c.Context.Err() != nil:
+			return base.CommandSuccess
+		case err != nil:
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.PrintApiError(apiErr, "Error from controller when tailing events")
+				return base.CommandApiError
+			}
+			c.PrintCliError(fmt.Errorf("Error trying to tail events: %w", err))
+			return base.CommandCliError
+		}
+
+		if ok := c.printStream(stream); !ok {
+			return base.CommandCliError
+		}
+		if c.Context.Err() != nil {
+			return base.CommandSuccess
+		}
+		c.UI.Warn("Event stream ended, reconnecting")
+		select {
+		case <-c.Context.Done():
+			return base.CommandSuccess
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// printStream prints the events of the stream until it ends, and reports
+// whether the command should carry on.
+func (c *TailCommand) printStream(stream *scopes.EventStream) bool {
+	defer stream.Close()
+	for {
+		ev, err := stream.Next()
+		switch {
+		case c.Context.Err() != nil, errors.Is(err, io.EOF):
+			return true
+		case err != nil:
+			c.UI.Warn(fmt.Sprintf("Error reading event stream: %s", err))
+			return true
+		}
+
+		if ev.Type == "dropped" {
+			var dropped struct {
+				Dropped uint64 `json:"dropped"`
+			}
+			if err := json.Unmarshal(ev.Data, &dropped); err != nil {
+				c.PrintCliError(fmt.Errorf("Error decoding dropped events: %w", err))
+				return false
+			}
+			c.UI.Warn(fmt.Sprintf("%d events were dropped because the client isn't keeping up", dropped.Dropped))
+			continue
+		}
+
+		switch base.Format(c.UI) {
+		case "json":
+			c.UI.Output(string(ev.Data))
+		default:
+			c.UI.Output(fmt.Sprintf("%s %s", ev.Type, ev.Data))
+		}
+	}
+}
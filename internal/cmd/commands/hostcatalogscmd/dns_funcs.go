@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostcatalogscmd
+
+import (
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraDnsActionsFlagsMapFunc = extraDnsActionsFlagsMapFuncImpl
+	extraDnsFlagsFunc = extraDnsFlagsFuncImpl
+	extraDnsFlagsHandlingFunc = extraDnsFlagsHandlingFuncImpl
+}
+
+type extraDnsCmdVars struct {
+	flagResolvers []string
+}
+
+func extraDnsActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"resolver"},
+		"update": {"resolver"},
+	}
+}
+
+func (c *DnsCommand) extraDnsHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs create dns [options] [args]",
+			"",
+			"  Create a dns-type host catalog. Example:",
+			"",
+			`    $ boundary host-catalogs create dns -scope-id p_1234567890 -name prodops -resolver 10.0.0.2 -resolver 10.0.0.3:53`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs update dns [options] [args]",
+			"",
+			"  Update a dns-type host catalog given its ID. Example:",
+			"",
+			`    $ boundary host-catalogs update dns -id hcdns_1234567890 -resolver null`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraDnsFlagsFuncImpl(c *DnsCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("DNS Host Catalog Options")
+
+	for _, name := range flagsDnsMap[c.Func] {
+		switch name {
+		case "resolver":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "resolver",
+				Target: &c.flagResolvers,
+				Usage: "The IP address, with an optional port, of a DNS server used to resolve the names of the catalog's host sets. " +
+					"May be specified multiple times. If not set, or set to null, the controller's system resolver is used.",
+			})
+		}
+	}
+}
+
+func extraDnsFlagsHandlingFuncImpl(c *DnsCommand, _ *base.FlagSets, opts *[]hostcatalogs.Option) bool {
+	switch {
+	case len(c.flagResolvers) == 0:
+	case len(c.flagResolvers) == 1 && c.flagResolvers[0] == "null":
+		*opts = append(*opts, hostcatalogs.DefaultDnsHostCatalogResolvers())
+	default:
+		*opts = append(*opts, hostcatalogs.WithDnsHostCatalogResolvers(c.flagResolvers))
+	}
+
+	return true
+}
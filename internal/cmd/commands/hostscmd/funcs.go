@@ -210,6 +210,35 @@ func printItemTable(item *hosts.Host, resp *api.Response) string {
 		)
 	}
 
+	if len(item.Health) > 0 {
+		ret = append(ret,
+			"",
+			"  Health:",
+		)
+		for i, h := range item.Health {
+			if i > 0 {
+				ret = append(ret, "")
+			}
+			healthMap := map[string]any{
+				"Host Set ID": h.HostSetId,
+				"Status":      h.Status,
+			}
+			if h.WorkerId != "" {
+				healthMap["Worker ID"] = h.WorkerId
+			}
+			if h.Error != "" {
+				healthMap["Error"] = h.Error
+			}
+			if !h.LastCheckTime.IsZero() {
+				healthMap["Last Check Time"] = h.LastCheckTime.Local().Format(time.RFC1123)
+			}
+			if !h.LastHealthyTime.IsZero() {
+				healthMap["Last Healthy Time"] = h.LastHealthyTime.Local().Format(time.RFC1123)
+			}
+			ret = append(ret, base.WrapMap(4, maxLength, healthMap))
+		}
+	}
+
 	return base.WrapForHelpText(ret)
 }
 
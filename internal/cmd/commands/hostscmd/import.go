@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostscmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ImportCommand)(nil)
+	_ cli.CommandAutocomplete = (*ImportCommand)(nil)
+)
+
+type ImportCommand struct {
+	*base.Command
+
+	flagHostCatalogId string
+	flagFile          string
+	flagFormat        string
+	flagDryRun        bool
+}
+
+func (c *ImportCommand) Synopsis() string {
+	return wordwrap.WrapString("Import hosts into a static host catalog from an inventory file", base.TermWidth)
+}
+
+func (c *ImportCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary hosts import [args]",
+		"",
+		"  Create or update the hosts in an inventory file in a static host catalog and add them to host sets. Hosts are matched to the existing hosts of the catalog by name and host sets which do not exist are created, so importing the same file again makes no changes. If any host cannot be imported no changes are made. Use -dry-run to show the changes without applying them. Example:",
+		"",
+		`    $ boundary hosts import -host-catalog-id hcst_1234567890 -file inventory.ini -dry-run`,
+		"",
+		"  The supported formats are:",
+		"",
+		"    csv: A header row naming the columns, of which name and address are required. Labels are given as key=value pairs and host sets by name, both separated by semicolons:",
+		"",
+		"      name,address,description,labels,host_sets",
+		"      web1,10.0.0.1,Web server,env=prod;tier=web,web;prod",
+		"",
+		"    json: An array of hosts with name, address, description, labels and host_set_names fields.",
+		"",
+		"    ansible-ini, ansible-yaml: An Ansible inventory. Groups become host sets, ansible_host is used as the address, and other variables which are not ansible_ connection variables become labels.",
+		"",
+		"    ssh-config: An OpenSSH client configuration. Each Host alias without wildcards becomes a host whose address is its HostName. User and Port become labels.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ImportCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "host-catalog-id",
+		Target: &c.flagHostCatalogId,
+		Usage:  "The static host catalog to import the hosts into",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "file",
+		Target: &c.flagFile,
+		Usage:  `The inventory file to import, or "-" to read it from stdin`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "format",
+		Target:     &c.flagFormat,
+		Completion: complete.PredictSet(inventoryFormats...),
+		Usage:      fmt.Sprintf("The format of the inventory file, one of %s. If not set, the format is determined from the file name.", strings.Join(inventoryFormats, ", ")),
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "dry-run",
+		Target: &c.flagDryRun,
+		Usage:  "If set, show the changes which would be made without applying them",
+	})
+
+	return set
+}
+
+func (c *ImportCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ImportCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ImportCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.flagHostCatalogId == "":
+		c.PrintCliError(errors.New("Host catalog ID must be provided via -host-catalog-id"))
+		return base.CommandUserError
+	case c.flagFile == "":
+		c.PrintCliError(errors.New("Inventory file must be provided via -file"))
+		return base.CommandUserError
+	case c.flagFile == "-" && c.flagFormat == "":
+		c.PrintCliError(errors.New("Format must be provided via -format when reading from stdin"))
+		return base.CommandUserError
+	}
+
+	var b []byte
+	var err error
+	if c.flagFile == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(c.flagFile)
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error reading inventory file: %w", err))
+		return base.CommandUserError
+	}
+	items, err := readInventory(c.flagFile, c.flagFormat, b)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error parsing inventory file: %w", err))
+		return base.CommandUserError
+	}
+	if len(items) == 0 {
+		c.PrintCliError(errors.New("No hosts found in the inventory file"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := hosts.NewClient(client).Import(c.Context, c.flagHostCatalogId, items, c.flagDryRun)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when importing hosts")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to import hosts: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printImportTable(result.GetItems(), result.DryRun))
+	}
+
+	for _, item := range result.GetItems() {
+		if item.Action == "failed" {
+			return base.CommandApiError
+		}
+	}
+	return base.CommandSuccess
+}
+
+func printImportTable(items []*hosts.HostImportResult, dryRun bool) string {
+	header := "Import results:"
+	if dryRun {
+		header = "Import results (dry run, not applied):"
+	}
+	output := []string{
+		"",
+		header,
+	}
+	counts := make(map[string]int)
+	for _, item := range items {
+		counts[item.Action]++
+		id := item.HostId
+		if id == "" {
+			id = "(none)"
+		}
+		line := fmt.Sprintf("  %5d  %-9s  %-20s  %s", item.Row+1, item.Action, id, item.Name)
+		if item.Error != "" {
+			line = fmt.Sprintf("%s: %s", line, item.Error)
+		}
+		output = append(output, line)
+	}
+	output = append(output,
+		"",
+		fmt.Sprintf("  Created: %d, Updated: %d, Unchanged: %d, Failed: %d, Skipped: %d",
+			counts["created"], counts["updated"], counts["unchanged"], counts["failed"], counts["skipped"]),
+	)
+	return base.WrapForHelpText(output)
+}
@@ -0,0 +1,641 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostscmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/api/hosts"
+	"gopkg.in/yaml.v3"
+)
+
+// The inventory formats supported by the import command.
+const (
+	formatCsv         = "csv"
+	formatJson        = "json"
+	formatAnsibleIni  = "ansible-ini"
+	formatAnsibleYaml = "ansible-yaml"
+	formatSshConfig   = "ssh-config"
+)
+
+var inventoryFormats = []string{formatCsv, formatJson, formatAnsibleIni, formatAnsibleYaml, formatSshConfig}
+
+// inventoryFormat returns the format of the inventory file at path based on
+// its name.
+func inventoryFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return formatCsv, nil
+	case ".json":
+		return formatJson, nil
+	case ".ini":
+		return formatAnsibleIni, nil
+	case ".yml", ".yaml":
+		return formatAnsibleYaml, nil
+	}
+	switch filepath.Base(path) {
+	case "config", "ssh_config":
+		return formatSshConfig, nil
+	case "hosts", "inventory":
+		return formatAnsibleIni, nil
+	}
+	return "", fmt.Errorf("unable to determine the format of %q, specify it with -format", path)
+}
+
+// parseInventory returns the hosts in the inventory read from r.
+func parseInventory(format string, r io.Reader) ([]*hosts.HostImport, error) {
+	switch format {
+	case formatCsv:
+		return parseCsv(r)
+	case formatJson:
+		return parseJson(r)
+	case formatAnsibleIni:
+		return parseAnsibleIni(r)
+	case formatAnsibleYaml:
+		return parseAnsibleYaml(r)
+	case formatSshConfig:
+		return parseSshConfig(r)
+	}
+	return nil, fmt.Errorf("unknown format %q, must be one of %s", format, strings.Join(inventoryFormats, ", "))
+}
+
+// parseCsv parses a CSV file with a header row. The name and address
+// columns are required. Labels are given as key=value pairs and host set
+// names are separated by semicolons.
+func parseCsv(r io.Reader) ([]*hosts.HostImport, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header row")
+		}
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		switch h {
+		case "name", "address", "description", "labels", "host_sets":
+		default:
+			return nil, fmt.Errorf("unknown column %q, must be one of name, address, description, labels, host_sets", h)
+		}
+		columns[h] = i
+	}
+	for _, c := range []string{"name", "address"} {
+		if _, ok := columns[c]; !ok {
+			return nil, fmt.Errorf("missing %s column", c)
+		}
+	}
+	get := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var result []*hosts.HostImport
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		h := &hosts.HostImport{
+			Name:         get(record, "name"),
+			Address:      get(record, "address"),
+			Description:  get(record, "description"),
+			HostSetNames: splitList(get(record, "host_sets")),
+		}
+		for _, kv := range splitList(get(record, "labels")) {
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: label %q is not a key=value pair", line, kv)
+			}
+			if h.Labels == nil {
+				h.Labels = make(map[string]string)
+			}
+			h.Labels[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+		result = append(result, h)
+	}
+	return result, nil
+}
+
+func splitList(s string) []string {
+	var l []string
+	for _, e := range strings.Split(s, ";") {
+		if e = strings.TrimSpace(e); e != "" {
+			l = append(l, e)
+		}
+	}
+	return l
+}
+
+// parseJson parses a JSON array of hosts in the format of the import API.
+func parseJson(r io.Reader) ([]*hosts.HostImport, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var result []*hosts.HostImport
+	if err := dec.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ansibleInventory collects the hosts of an Ansible inventory. Hosts are
+// kept in the order they first appear. Host variables other than the
+// ansible_ connection variables become labels and groups become host sets.
+// Group variables are applied to the hosts of the group and its child
+// groups unless a host sets the variable itself.
+type ansibleInventory struct {
+	hosts    []*hosts.HostImport
+	byName   map[string]*hosts.HostImport
+	hostVars map[string]map[string]string
+	// groupHosts, groupVars and children are keyed by group name.
+	groupHosts map[string][]string
+	groupVars  map[string]map[string]string
+	children   map[string][]string
+}
+
+func newAnsibleInventory() *ansibleInventory {
+	return &ansibleInventory{
+		byName:     make(map[string]*hosts.HostImport),
+		hostVars:   make(map[string]map[string]string),
+		groupHosts: make(map[string][]string),
+		groupVars:  make(map[string]map[string]string),
+		children:   make(map[string][]string),
+	}
+}
+
+func (inv *ansibleInventory) addHost(group, pattern string, vars map[string]string) error {
+	names, err := expandHostPattern(pattern)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if _, ok := inv.byName[name]; !ok {
+			h := &hosts.HostImport{Name: name, Address: name}
+			inv.byName[name] = h
+			inv.hosts = append(inv.hosts, h)
+			inv.hostVars[name] = make(map[string]string)
+		}
+		for k, v := range vars {
+			inv.hostVars[name][k] = v
+		}
+		inv.groupHosts[group] = append(inv.groupHosts[group], name)
+	}
+	return nil
+}
+
+func (inv *ansibleInventory) addGroupVar(group, key, value string) {
+	if inv.groupVars[group] == nil {
+		inv.groupVars[group] = make(map[string]string)
+	}
+	inv.groupVars[group][key] = value
+}
+
+// result resolves group membership and variables and returns the hosts.
+func (inv *ansibleInventory) result() ([]*hosts.HostImport, error) {
+	// Apply the groups from the outermost to the innermost so variables of
+	// child groups override the variables of their parents.
+	vars := make(map[string]map[string]string, len(inv.hosts))
+	sets := make(map[string][]string, len(inv.hosts))
+	// Every host is a member of the all group.
+	for _, h := range inv.hosts {
+		vars[h.Name] = make(map[string]string)
+		for k, v := range inv.groupVars["all"] {
+			vars[h.Name][k] = v
+		}
+	}
+	visited := make(map[string]bool)
+	var visit func(group string, path []string) error
+	visit = func(group string, path []string) error {
+		for _, p := range path {
+			if p == group {
+				return fmt.Errorf("group %q is a child of itself", group)
+			}
+		}
+		visited[group] = true
+		path = append(path, group)
+		for _, name := range inv.groupHosts[group] {
+			for _, g := range path {
+				for k, v := range inv.groupVars[g] {
+					vars[name][k] = v
+				}
+				if g != "all" && g != "ungrouped" {
+					sets[name] = appendUnique(sets[name], g)
+				}
+			}
+		}
+		for _, c := range inv.children[group] {
+			if err := visit(c, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	isChild := make(map[string]bool)
+	for _, cs := range inv.children {
+		for _, c := range cs {
+			isChild[c] = true
+		}
+	}
+	var roots []string
+	for g := range inv.groupHosts {
+		if !isChild[g] {
+			roots = append(roots, g)
+		}
+	}
+	for g := range inv.children {
+		if _, ok := inv.groupHosts[g]; !ok && !isChild[g] {
+			roots = append(roots, g)
+		}
+	}
+	sort.Strings(roots)
+	for _, g := range roots {
+		if err := visit(g, nil); err != nil {
+			return nil, err
+		}
+	}
+	// Groups which were not visited are only reachable from themselves.
+	var cyclic []string
+	for g := range inv.children {
+		if !visited[g] {
+			cyclic = append(cyclic, g)
+		}
+	}
+	if len(cyclic) > 0 {
+		sort.Strings(cyclic)
+		return nil, fmt.Errorf("group %q is a child of itself", cyclic[0])
+	}
+
+	for _, h := range inv.hosts {
+		merged := vars[h.Name]
+		for k, v := range inv.hostVars[h.Name] {
+			merged[k] = v
+		}
+		for k, v := range merged {
+			switch k {
+			case "ansible_host", "ansible_ssh_host":
+				h.Address = v
+			default:
+				if strings.HasPrefix(k, "ansible_") {
+					continue
+				}
+				if h.Labels == nil {
+					h.Labels = make(map[string]string)
+				}
+				h.Labels[k] = v
+			}
+		}
+		h.HostSetNames = sets[h.Name]
+	}
+	return inv.hosts, nil
+}
+
+func appendUnique(l []string, s string) []string {
+	for _, e := range l {
+		if e == s {
+			return l
+		}
+	}
+	return append(l, s)
+}
+
+// expandHostPattern expands the numeric ranges of an Ansible host pattern
+// such as web[01:10].example.com.
+func expandHostPattern(pattern string) ([]string, error) {
+	start := strings.Index(pattern, "[")
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+	end := strings.Index(pattern[start:], "]")
+	if end < 0 {
+		return nil, fmt.Errorf("host pattern %q has an unterminated range", pattern)
+	}
+	end += start
+	spec := pattern[start+1 : end]
+	stride := 1
+	if r, s, ok := strings.Cut(spec, ":"); ok {
+		if b, st, ok := strings.Cut(s, ":"); ok {
+			var err error
+			if stride, err = strconv.Atoi(st); err != nil || stride < 1 {
+				return nil, fmt.Errorf("host pattern %q has an invalid stride", pattern)
+			}
+			s = b
+		}
+		from, err := strconv.Atoi(r)
+		if err != nil {
+			return nil, fmt.Errorf("host pattern %q has an invalid range", pattern)
+		}
+		to, err := strconv.Atoi(s)
+		if err != nil || to < from {
+			return nil, fmt.Errorf("host pattern %q has an invalid range", pattern)
+		}
+		width := 0
+		if len(r) > 1 && r[0] == '0' {
+			width = len(r)
+		}
+		rest, err := expandHostPattern(pattern[end+1:])
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for i := from; i <= to; i += stride {
+			for _, suffix := range rest {
+				names = append(names, fmt.Sprintf("%s%0*d%s", pattern[:start], width, i, suffix))
+			}
+		}
+		return names, nil
+	}
+	return nil, fmt.Errorf("host pattern %q has an invalid range", pattern)
+}
+
+// parseAnsibleIni parses an Ansible inventory in the INI format.
+func parseAnsibleIni(r io.Reader) ([]*hosts.HostImport, error) {
+	inv := newAnsibleInventory()
+	group, section := "ungrouped", "hosts"
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("line %d: invalid section header %q", line, text)
+			}
+			group, section = strings.TrimSpace(text[1:len(text)-1]), "hosts"
+			if g, s, ok := strings.Cut(group, ":"); ok {
+				group, section = g, s
+			}
+			switch section {
+			case "hosts", "vars", "children":
+			default:
+				return nil, fmt.Errorf("line %d: unknown section type %q", line, section)
+			}
+			continue
+		}
+		fields, err := splitIniFields(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		switch section {
+		case "hosts":
+			vars := make(map[string]string, len(fields)-1)
+			for _, f := range fields[1:] {
+				k, v, ok := strings.Cut(f, "=")
+				if !ok {
+					return nil, fmt.Errorf("line %d: host variable %q is not a key=value pair", line, f)
+				}
+				vars[k] = v
+			}
+			if err := inv.addHost(group, fields[0], vars); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		case "vars":
+			k, v, ok := strings.Cut(text, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: group variable %q is not a key=value pair", line, text)
+			}
+			inv.addGroupVar(group, strings.TrimSpace(k), unquote(strings.TrimSpace(v)))
+		case "children":
+			inv.children[group] = appendUnique(inv.children[group], fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return inv.result()
+}
+
+// splitIniFields splits an inventory line into whitespace separated fields,
+// honoring quoted values and dropping trailing comments.
+func splitIniFields(s string) ([]string, error) {
+	var fields []string
+	var cur strings.Builder
+	var quote rune
+	inField := false
+	for _, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+				continue
+			}
+			cur.WriteRune(c)
+		case c == '"' || c == '\'':
+			quote = c
+			inField = true
+		case c == '#' && !inField:
+			return append(fields, flush(&cur, inField)...), nil
+		case c == ' ' || c == '\t':
+			fields = append(fields, flush(&cur, inField)...)
+			inField = false
+		default:
+			cur.WriteRune(c)
+			inField = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+	return append(fields, flush(&cur, inField)...), nil
+}
+
+func flush(b *strings.Builder, inField bool) []string {
+	if !inField {
+		return nil
+	}
+	s := b.String()
+	b.Reset()
+	return []string{s}
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// parseAnsibleYaml parses an Ansible inventory in the YAML format.
+func parseAnsibleYaml(r io.Reader) ([]*hosts.HostImport, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: inventory must be a mapping of groups", root.Line)
+	}
+	inv := newAnsibleInventory()
+	var walk func(group string, n *yaml.Node) error
+	walk = func(group string, n *yaml.Node) error {
+		if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+			return nil
+		}
+		if n.Kind != yaml.MappingNode {
+			return fmt.Errorf("line %d: group %q must be a mapping", n.Line, group)
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i].Value, n.Content[i+1]
+			switch key {
+			case "hosts":
+				if err := eachPair(value, func(k string, v *yaml.Node) error {
+					vars, err := yamlVars(v)
+					if err != nil {
+						return err
+					}
+					if err := inv.addHost(group, k, vars); err != nil {
+						return fmt.Errorf("line %d: %w", v.Line, err)
+					}
+					return nil
+				}); err != nil {
+					return err
+				}
+			case "vars":
+				vars, err := yamlVars(value)
+				if err != nil {
+					return err
+				}
+				for k, v := range vars {
+					inv.addGroupVar(group, k, v)
+				}
+			case "children":
+				if err := eachPair(value, func(k string, v *yaml.Node) error {
+					inv.children[group] = appendUnique(inv.children[group], k)
+					return walk(k, v)
+				}); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("line %d: unknown key %q in group %q, must be one of hosts, vars, children", n.Content[i].Line, key, group)
+			}
+		}
+		return nil
+	}
+	if err := eachPair(root, walk); err != nil {
+		return nil, err
+	}
+	return inv.result()
+}
+
+// eachPair calls fn for each key and value of the mapping n. A null n is an
+// empty mapping.
+func eachPair(n *yaml.Node, fn func(string, *yaml.Node) error) error {
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return nil
+	}
+	if n.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping", n.Line)
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if err := fn(n.Content[i].Value, n.Content[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// yamlVars returns the scalar variables of the mapping n. Variables with
+// other values are ignored.
+func yamlVars(n *yaml.Node) (map[string]string, error) {
+	vars := make(map[string]string)
+	err := eachPair(n, func(k string, v *yaml.Node) error {
+		if v.Kind == yaml.ScalarNode && v.Tag != "!!null" {
+			vars[k] = v.Value
+		}
+		return nil
+	})
+	return vars, err
+}
+
+// parseSshConfig parses an OpenSSH client configuration. Each alias of a
+// Host block without wildcards becomes a host whose address is the HostName
+// of the block, or the alias if the block has no HostName. Hosts with a User
+// or Port get labels of the same names.
+func parseSshConfig(r io.Reader) ([]*hosts.HostImport, error) {
+	var result []*hosts.HostImport
+	var block []*hosts.HostImport
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value := text, ""
+		if i := strings.IndexAny(text, " \t="); i >= 0 {
+			key = text[:i]
+			value = strings.TrimLeft(text[i:], " \t")
+			value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+		}
+		switch strings.ToLower(key) {
+		case "host":
+			block = nil
+			fields, err := splitIniFields(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			for _, alias := range fields {
+				if strings.ContainsAny(alias, "*?!") {
+					continue
+				}
+				h := &hosts.HostImport{Name: alias, Address: alias}
+				block = append(block, h)
+				result = append(result, h)
+			}
+		case "match":
+			block = nil
+		case "hostname":
+			for _, h := range block {
+				h.Address = unquote(value)
+			}
+		case "user", "port":
+			for _, h := range block {
+				if h.Labels == nil {
+					h.Labels = make(map[string]string)
+				}
+				h.Labels[strings.ToLower(key)] = unquote(value)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// readInventory parses the inventory in b, detecting the format from path
+// if format is empty.
+func readInventory(path, format string, b []byte) ([]*hosts.HostImport, error) {
+	if format == "" {
+		var err error
+		if format, err = inventoryFormat(path); err != nil {
+			return nil, err
+		}
+	}
+	return parseInventory(format, bytes.NewReader(b))
+}
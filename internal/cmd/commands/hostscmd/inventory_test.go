@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostscmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/api/hosts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInventory(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		in      string
+		want    []*hosts.HostImport
+		wantErr string
+	}{
+		{
+			name:   "csv",
+			format: formatCsv,
+			in: `name,address,description,labels,host_sets
+# a comment
+web1,10.0.0.1,web server,env=prod;tier=web,web;prod
+db1, db1.internal ,,,
+`,
+			want: []*hosts.HostImport{
+				{Name: "web1", Address: "10.0.0.1", Description: "web server", Labels: map[string]string{"env": "prod", "tier": "web"}, HostSetNames: []string{"web", "prod"}},
+				{Name: "db1", Address: "db1.internal"},
+			},
+		},
+		{
+			name:    "csv-unknown-column",
+			format:  formatCsv,
+			in:      "name,address,port\nweb1,10.0.0.1,22\n",
+			wantErr: `unknown column "port"`,
+		},
+		{
+			name:    "csv-missing-address",
+			format:  formatCsv,
+			in:      "name\nweb1\n",
+			wantErr: "missing address column",
+		},
+		{
+			name:    "csv-invalid-label",
+			format:  formatCsv,
+			in:      "name,address,labels\nweb1,10.0.0.1,prod\n",
+			wantErr: `line 2: label "prod" is not a key=value pair`,
+		},
+		{
+			name:   "json",
+			format: formatJson,
+			in:     `[{"name": "web1", "address": "10.0.0.1", "labels": {"env": "prod"}, "host_set_names": ["web"]}]`,
+			want: []*hosts.HostImport{
+				{Name: "web1", Address: "10.0.0.1", Labels: map[string]string{"env": "prod"}, HostSetNames: []string{"web"}},
+			},
+		},
+		{
+			name:    "json-unknown-field",
+			format:  formatJson,
+			in:      `[{"name": "web1", "port": 22}]`,
+			wantErr: `unknown field "port"`,
+		},
+		{
+			name:   "ansible-ini",
+			format: formatAnsibleIni,
+			in: `mail.example.com
+
+[web]
+web[01:02].example.com env=prod
+foo.example.com ansible_host=10.0.0.5 ansible_user=admin # trailing comment
+
+[db]
+db.example.com role="primary db"
+
+[prod:children]
+web
+
+[prod:vars]
+env=production
+dc=east
+
+[all:vars]
+owner=ops
+`,
+			want: []*hosts.HostImport{
+				{Name: "mail.example.com", Address: "mail.example.com", Labels: map[string]string{"owner": "ops"}},
+				{Name: "web01.example.com", Address: "web01.example.com", Labels: map[string]string{"owner": "ops", "env": "prod", "dc": "east"}, HostSetNames: []string{"prod", "web"}},
+				{Name: "web02.example.com", Address: "web02.example.com", Labels: map[string]string{"owner": "ops", "env": "prod", "dc": "east"}, HostSetNames: []string{"prod", "web"}},
+				{Name: "foo.example.com", Address: "10.0.0.5", Labels: map[string]string{"owner": "ops", "env": "production", "dc": "east"}, HostSetNames: []string{"prod", "web"}},
+				{Name: "db.example.com", Address: "db.example.com", Labels: map[string]string{"owner": "ops", "role": "primary db"}, HostSetNames: []string{"db"}},
+			},
+		},
+		{
+			name:    "ansible-ini-invalid-section",
+			format:  formatAnsibleIni,
+			in:      "[web:other]\nweb1\n",
+			wantErr: `line 1: unknown section type "other"`,
+		},
+		{
+			name:    "ansible-ini-cycle",
+			format:  formatAnsibleIni,
+			in:      "[a]\nweb1\n[a:children]\nb\n[b:children]\na\n",
+			wantErr: `group "a" is a child of itself`,
+		},
+		{
+			name:   "ansible-yaml",
+			format: formatAnsibleYaml,
+			in: `all:
+  vars:
+    owner: ops
+  hosts:
+    mail.example.com:
+  children:
+    web:
+      hosts:
+        web[1:3:2].example.com:
+          ansible_host: 10.0.0.1
+          env: prod
+      vars:
+        tier: web
+      children:
+        canary:
+          hosts:
+            canary.example.com:
+`,
+			want: []*hosts.HostImport{
+				{Name: "mail.example.com", Address: "mail.example.com", Labels: map[string]string{"owner": "ops"}},
+				{Name: "web1.example.com", Address: "10.0.0.1", Labels: map[string]string{"owner": "ops", "env": "prod", "tier": "web"}, HostSetNames: []string{"web"}},
+				{Name: "web3.example.com", Address: "10.0.0.1", Labels: map[string]string{"owner": "ops", "env": "prod", "tier": "web"}, HostSetNames: []string{"web"}},
+				{Name: "canary.example.com", Address: "canary.example.com", Labels: map[string]string{"owner": "ops", "tier": "web"}, HostSetNames: []string{"web", "canary"}},
+			},
+		},
+		{
+			name:    "ansible-yaml-unknown-key",
+			format:  formatAnsibleYaml,
+			in:      "all:\n  host:\n    web1:\n",
+			wantErr: `unknown key "host"`,
+		},
+		{
+			name:   "ssh-config",
+			format: formatSshConfig,
+			in: `Host *
+    ServerAliveInterval 60
+
+# bastions
+Host bastion bastion-alias
+    HostName 203.0.113.10
+    User admin
+    Port=2222
+
+Host web1
+    Hostname web1.internal
+
+Match host foo
+    HostName ignored
+`,
+			want: []*hosts.HostImport{
+				{Name: "bastion", Address: "203.0.113.10", Labels: map[string]string{"user": "admin", "port": "2222"}},
+				{Name: "bastion-alias", Address: "203.0.113.10", Labels: map[string]string{"user": "admin", "port": "2222"}},
+				{Name: "web1", Address: "web1.internal"},
+			},
+		},
+		{
+			name:    "unknown-format",
+			format:  "xml",
+			wantErr: `unknown format "xml"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := parseInventory(tt.format, strings.NewReader(tt.in))
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestInventoryFormat(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "hosts.csv", want: formatCsv},
+		{path: "hosts.JSON", want: formatJson},
+		{path: "inventory.ini", want: formatAnsibleIni},
+		{path: "/etc/ansible/hosts", want: formatAnsibleIni},
+		{path: "inventory.yml", want: formatAnsibleYaml},
+		{path: "/home/user/.ssh/config", want: formatSshConfig},
+		{path: "hosts.txt", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := inventoryFormat(tt.path)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,6 +4,9 @@
 package hostscmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/boundary/api/hosts"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
@@ -16,12 +19,14 @@ func init() {
 
 type extraStaticCmdVars struct {
 	flagAddress string
+	flagLabels  map[string]string
+	flagPort    string
 }
 
 func extraStaticActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"address"},
-		"update": {"address"},
+		"create": {"address", "label", "port"},
+		"update": {"address", "label", "port"},
 	}
 }
 
@@ -34,7 +39,7 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 			"",
 			"  Create a static-type host. Example:",
 			"",
-			`    $ boundary hosts create static -name prodops -description "Static host for ProdOps" -address "127.0.0.1"`,
+			`    $ boundary hosts create static -name prodops -description "Static host for ProdOps" -address "127.0.0.1" -label env=prod`,
 			"",
 			"",
 		})
@@ -67,6 +72,18 @@ func extraStaticFlagsFuncImpl(c *StaticCommand, set *base.FlagSets, _ *base.Flag
 				Target: &c.flagAddress,
 				Usage:  "The address of the host",
 			})
+		case "label":
+			f.StringMapVar(&base.StringMapVar{
+				Name:   "label",
+				Target: &c.flagLabels,
+				Usage:  "A key=value label of the host. Can be specified multiple times. On update, replaces all labels of the host.",
+			})
+		case "port":
+			f.StringVar(&base.StringVar{
+				Name:   "port",
+				Target: &c.flagPort,
+				Usage:  "The port to use for connections to this host, overriding the default port of the target. Use \"null\" to clear it.",
+			})
 		}
 	}
 }
@@ -85,5 +102,22 @@ func extraStaticFlagsHandlingFuncImpl(c *StaticCommand, _ *base.FlagSets, opts *
 		*opts = append(*opts, hosts.WithStaticHostAddress(c.flagAddress))
 	}
 
+	if len(c.flagLabels) > 0 {
+		*opts = append(*opts, hosts.WithStaticHostLabels(c.flagLabels))
+	}
+
+	switch c.flagPort {
+	case "":
+	case "null":
+		*opts = append(*opts, hosts.DefaultStaticHostPort())
+	default:
+		port, err := strconv.ParseUint(c.flagPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagPort, err))
+			return false
+		}
+		*opts = append(*opts, hosts.WithStaticHostPort(uint32(port)))
+	}
+
 	return true
 }
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostsetscmd
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraDnsActionsFlagsMapFunc = extraDnsActionsFlagsMapFuncImpl
+	extraDnsFlagsFunc = extraDnsFlagsFuncImpl
+	extraDnsFlagsHandlingFunc = extraDnsFlagsHandlingFuncImpl
+}
+
+type extraDnsCmdVars struct {
+	flagNames        []string
+	flagRecordType   string
+	flagSyncInterval string
+}
+
+func extraDnsActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"dns-name", "record-type", "sync-interval"},
+		"update": {"dns-name", "record-type", "sync-interval"},
+	}
+}
+
+func (c *DnsCommand) extraDnsHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary host-sets create dns [options] [args]",
+			"",
+			"  Create a dns-type host set. Example:",
+			"",
+			`    $ boundary host-sets create dns -host-catalog-id hcdns_1234567890 -name web -dns-name web.internal.example.com`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary host-sets update dns [options] [args]",
+			"",
+			"  Update a dns-type host set given its ID. Example:",
+			"",
+			`    $ boundary host-sets update dns -id hsdns_1234567890 -dns-name web.internal.example.com -record-type ip4`,
+			"",
+			"",
+		})
+	default:
+		helpStr = helpMap[c.Func]()
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraDnsFlagsFuncImpl(c *DnsCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("DNS Host Set Options")
+
+	for _, name := range flagsDnsMap[c.Func] {
+		switch name {
+		case "dns-name":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "dns-name",
+				Target: &c.flagNames,
+				Usage:  "A DNS name to resolve into the hosts of the host set. May be specified multiple times.",
+			})
+		case "record-type":
+			f.StringVar(&base.StringVar{
+				Name:   "record-type",
+				Target: &c.flagRecordType,
+				Usage:  `The type of DNS lookup used to resolve the names: "ip" (A and AAAA records), "ip4" (A records), "ip6" (AAAA records) or "srv". If not set, or set to null, "ip" is used.`,
+			})
+		case "sync-interval":
+			f.StringVar(&base.StringVar{
+				Name:   "sync-interval",
+				Target: &c.flagSyncInterval,
+				Usage: `An interger number of seconds, or a string such as "400s", "5m", or "6h", ` +
+					"indicating the amount of time that should elapse between resolutions of the host set's names. " +
+					"Setting to any negative value will disable syncing for that host set; setting to null " +
+					"will cause the set to use Boundary's default. The default may change between releases.",
+			})
+		}
+	}
+}
+
+func extraDnsFlagsHandlingFuncImpl(c *DnsCommand, _ *base.FlagSets, opts *[]hostsets.Option) bool {
+	if len(c.flagNames) > 0 {
+		*opts = append(*opts, hostsets.WithDnsHostSetNames(c.flagNames))
+	}
+
+	switch c.flagRecordType {
+	case "":
+	case "null":
+		*opts = append(*opts, hostsets.DefaultDnsHostSetRecordType())
+	default:
+		*opts = append(*opts, hostsets.WithDnsHostSetRecordType(c.flagRecordType))
+	}
+
+	switch c.flagSyncInterval {
+	case "":
+	case "null":
+		*opts = append(*opts, hostsets.DefaultSyncIntervalSeconds())
+
+	default:
+		interval, err := parseutil.ParseDurationSecond(c.flagSyncInterval)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse given sync interval: %s", err))
+			return false
+		}
+		*opts = append(*opts, hostsets.WithSyncIntervalSeconds(int32(interval.Seconds())))
+	}
+
+	return true
+}
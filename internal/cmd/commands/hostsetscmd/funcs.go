@@ -327,6 +327,45 @@ func printItemTable(item *hostsets.HostSet, resp *api.Response) string {
 		)
 	}
 
+	if hc := item.HealthCheck; hc != nil {
+		hcMap := map[string]any{
+			"Type":     hc.Type,
+			"Port":     hc.Port,
+			"Interval": fmt.Sprintf("%d seconds", hc.IntervalSeconds),
+			"Timeout":  fmt.Sprintf("%d seconds", hc.TimeoutSeconds),
+		}
+		if hc.HttpPath != "" {
+			hcMap["HTTP Path"] = hc.HttpPath
+		}
+		ret = append(ret,
+			"",
+			"  Health Check:",
+			base.WrapMap(4, maxLength, hcMap),
+		)
+	}
+
+	if ss := item.SyncStatus; ss != nil {
+		ssMap := map[string]any{
+			"Sync Pending":  ss.SyncPending,
+			"Hosts Added":   ss.HostsAdded,
+			"Hosts Removed": ss.HostsRemoved,
+		}
+		if !ss.LastSyncStartTime.IsZero() {
+			ssMap["Last Sync Start Time"] = ss.LastSyncStartTime.Local().Format(time.RFC1123)
+		}
+		if !ss.LastSyncEndTime.IsZero() {
+			ssMap["Last Sync End Time"] = ss.LastSyncEndTime.Local().Format(time.RFC1123)
+		}
+		if ss.LastSyncError != "" {
+			ssMap["Last Sync Error"] = ss.LastSyncError
+		}
+		ret = append(ret,
+			"",
+			"  Sync Status:",
+			base.WrapMap(4, base.MaxAttributesLength(ssMap, nil, nil), ssMap),
+		)
+	}
+
 	return base.WrapForHelpText(ret)
 }
 
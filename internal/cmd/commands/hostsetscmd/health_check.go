@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostsetscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*SetHealthCheckCommand)(nil)
+	_ cli.CommandAutocomplete = (*SetHealthCheckCommand)(nil)
+	_ cli.Command             = (*RemoveHealthCheckCommand)(nil)
+	_ cli.CommandAutocomplete = (*RemoveHealthCheckCommand)(nil)
+)
+
+type SetHealthCheckCommand struct {
+	*base.Command
+
+	flagType            string
+	flagPort            uint64
+	flagHttpPath        string
+	flagIntervalSeconds uint64
+	flagTimeoutSeconds  uint64
+}
+
+func (c *SetHealthCheckCommand) Synopsis() string {
+	return wordwrap.WrapString("Set the health check of a host set", base.TermWidth)
+}
+
+func (c *SetHealthCheckCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary host-sets set-health-check [args]",
+		"",
+		"  Set the health check run by workers against the hosts of a host set, replacing any existing health check. Hosts which fail the check are not used for new sessions. Example:",
+		"",
+		`    $ boundary host-sets set-health-check -id hsst_1234567890 -type http -port 8080 -http-path /healthz`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *SetHealthCheckCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the host set to set the health check of",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "type",
+		Target:     &c.flagType,
+		Completion: complete.PredictSet("tcp", "tls", "http"),
+		Usage:      `The type of the health check, one of "tcp", "tls" or "http"`,
+	})
+	f.Uint64Var(&base.Uint64Var{
+		Name:   "port",
+		Target: &c.flagPort,
+		Usage:  "The port of the hosts the health check connects to",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "http-path",
+		Target: &c.flagHttpPath,
+		Usage:  `The path requested by "http" health checks; defaults to "/"`,
+	})
+	f.Uint64Var(&base.Uint64Var{
+		Name:   "interval-seconds",
+		Target: &c.flagIntervalSeconds,
+		Usage:  "The number of seconds between health checks of a host; defaults to 30",
+	})
+	f.Uint64Var(&base.Uint64Var{
+		Name:   "timeout-seconds",
+		Target: &c.flagTimeoutSeconds,
+		Usage:  "The number of seconds after which a health check fails; defaults to 5",
+	})
+
+	return set
+}
+
+func (c *SetHealthCheckCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *SetHealthCheckCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *SetHealthCheckCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagType == "":
+		c.PrintCliError(errors.New("Health check type must be provided via -type"))
+		return base.CommandUserError
+	case c.flagPort == 0 || c.flagPort > 65535:
+		c.PrintCliError(errors.New("A valid port must be provided via -port"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	hc := &hostsets.HostSetHealthCheck{
+		Type:            c.flagType,
+		Port:            uint32(c.flagPort),
+		HttpPath:        c.flagHttpPath,
+		IntervalSeconds: uint32(c.flagIntervalSeconds),
+		TimeoutSeconds:  uint32(c.flagTimeoutSeconds),
+	}
+	result, err := hostsets.NewClient(client).SetHealthCheck(c.Context, c.FlagId, hc)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when setting host set health check")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to set host set health check: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	}
+
+	return base.CommandSuccess
+}
+
+type RemoveHealthCheckCommand struct {
+	*base.Command
+}
+
+func (c *RemoveHealthCheckCommand) Synopsis() string {
+	return wordwrap.WrapString("Remove the health check of a host set", base.TermWidth)
+}
+
+func (c *RemoveHealthCheckCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary host-sets remove-health-check [args]",
+		"",
+		"  Remove the health check of a host set along with the recorded health of its hosts. Example:",
+		"",
+		`    $ boundary host-sets remove-health-check -id hsst_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RemoveHealthCheckCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the host set to remove the health check of",
+	})
+
+	return set
+}
+
+func (c *RemoveHealthCheckCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RemoveHealthCheckCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RemoveHealthCheckCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := hostsets.NewClient(client).RemoveHealthCheck(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when removing host set health check")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to remove host set health check: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	}
+
+	return base.CommandSuccess
+}
@@ -4,9 +4,27 @@
 package hostsetscmd
 
 import (
+	"github.com/hashicorp/boundary/api/hostsets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+func init() {
+	extraStaticActionsFlagsMapFunc = extraStaticActionsFlagsMapFuncImpl
+	extraStaticFlagsFunc = extraStaticFlagsFuncImpl
+	extraStaticFlagsHandlingFunc = extraStaticFlagsHandlingFuncImpl
+}
+
+type extraStaticCmdVars struct {
+	flagHostFilter string
+}
+
+func extraStaticActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"host-filter"},
+		"update": {"host-filter"},
+	}
+}
+
 func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -18,6 +36,10 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 			"",
 			`    $ boundary host-sets create static -name prodops -description "Static host-set for ProdOps"`,
 			"",
+			"  Create a static-type host set whose hosts are the hosts of the catalog matching a filter. Example:",
+			"",
+			`    $ boundary host-sets create static -name prodops -host-filter '"/labels/env" == "prod"'`,
+			"",
 			"",
 		})
 
@@ -36,3 +58,30 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 	}
 	return helpStr + c.Flags().Help()
 }
+
+func extraStaticFlagsFuncImpl(c *StaticCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Static Host Set Options")
+
+	for _, name := range flagsStaticMap[c.Func] {
+		switch name {
+		case "host-filter":
+			f.StringVar(&base.StringVar{
+				Name:   "host-filter",
+				Target: &c.flagHostFilter,
+				Usage:  "A boolean expression over the labels and attributes of the hosts of the catalog. If set, the hosts of the host set are the hosts matching the filter.",
+			})
+		}
+	}
+}
+
+func extraStaticFlagsHandlingFuncImpl(c *StaticCommand, _ *base.FlagSets, opts *[]hostsets.Option) bool {
+	switch c.flagHostFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, hostsets.DefaultStaticHostSetFilter())
+	default:
+		*opts = append(*opts, hostsets.WithStaticHostSetFilter(c.flagHostFilter))
+	}
+
+	return true
+}
@@ -34,6 +34,8 @@ type StaticCommand struct {
 	Func string
 
 	plural string
+
+	extraStaticCmdVars
 }
 
 func (c *StaticCommand) AutocompleteArgs() complete.Predictor {
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostsetscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*SyncCommand)(nil)
+	_ cli.CommandAutocomplete = (*SyncCommand)(nil)
+)
+
+type SyncCommand struct {
+	*base.Command
+}
+
+func (c *SyncCommand) Synopsis() string {
+	return wordwrap.WrapString("Sync the hosts of a plugin host set", base.TermWidth)
+}
+
+func (c *SyncCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary host-sets sync [args]",
+		"",
+		"  Request that the hosts of a plugin host set be synced from its plugin as soon as possible, regardless of its sync interval. The sync happens in the background; its outcome is shown in the sync status of the host set. Example:",
+		"",
+		`    $ boundary host-sets sync -id hsplg_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *SyncCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the host set to sync",
+	})
+
+	return set
+}
+
+func (c *SyncCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *SyncCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *SyncCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := hostsets.NewClient(client).Sync(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when syncing host set")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to sync host set: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	}
+
+	return base.CommandSuccess
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolescmd
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// NewHistoryCommand returns the command which shows the change history of
+// a role.
+func NewHistoryCommand(c *base.Command) *common.HistoryCommand {
+	return &common.HistoryCommand{
+		Command:   c,
+		Resource:  resource.Role,
+		ExampleId: "r_1234567890",
+		History: func(ctx context.Context, client *api.Client, id string) (common.HistoryResult, error) {
+			result, err := roles.NewClient(client).History(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+	}
+}
@@ -453,7 +453,10 @@ func (c *Command) Run(args []string) int {
 	}
 
 	if c.Config.Controller != nil {
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure, base.EnabledPluginHostKubernetes)
+		if c.Config.Plugins.FileHostPluginDir != "" {
+			c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostFile)
+		}
 		if err := c.StartController(c.Context); err != nil {
 			c.UI.Error(err.Error())
 			return base.CommandCliError
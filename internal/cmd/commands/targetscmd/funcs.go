@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,12 +39,13 @@ type extraCmdVars struct {
 	flagBrokeredCredentialSources            []string
 	flagInjectedApplicationCredentialSources []string
 	flagHostId                               string
+	flagPortName                             string
 	sar                                      *targets.SessionAuthorizationResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"authorize-session":         {"id", "host-id"},
+		"authorize-session":         {"id", "host-id", "port-name"},
 		"add-host-sources":          {"id", "host-source", "version"},
 		"remove-host-sources":       {"id", "host-source", "version"},
 		"set-host-sources":          {"id", "host-source", "version"},
@@ -239,6 +241,12 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagHostId,
 				Usage:  "The ID of a specific host to connect to out of the hosts from the target's host sets. If not specified, one is chosen at random.",
 			})
+		case "port-name":
+			f.StringVar(&base.StringVar{
+				Name:   "port-name",
+				Target: &c.flagPortName,
+				Usage:  "The name of one of the target's named ports to connect to. If not specified, the port of the chosen host or the target's default port is used.",
+			})
 		case "brokered-credential-source":
 			f.StringSliceVar(&base.StringSliceVar{
 				Name:   "brokered-credential-source",
@@ -386,6 +394,9 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]targets.Op
 		if len(c.flagHostId) != 0 {
 			*opts = append(*opts, targets.WithHostId(c.flagHostId))
 		}
+		if len(c.flagPortName) != 0 {
+			*opts = append(*opts, targets.WithPortName(c.flagPortName))
+		}
 	}
 
 	return true
@@ -536,6 +547,21 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.IngressWorkerFilter != "" {
 		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
 	}
+	if item.HostSelectionStrategy != "" {
+		nonAttributeMap["Host Selection Strategy"] = item.HostSelectionStrategy
+	}
+	if item.WorkerSelectionStrategy != "" {
+		nonAttributeMap["Worker Selection Strategy"] = item.WorkerSelectionStrategy
+	}
+	if item.WorkerAffinityFilter != "" {
+		nonAttributeMap["Worker Affinity Filter"] = item.WorkerAffinityFilter
+	}
+	if item.HostExclusionFilter != "" {
+		nonAttributeMap["Host Exclusion Filter"] = item.HostExclusionFilter
+	}
+	if item.HostPreferenceFilter != "" {
+		nonAttributeMap["Host Preference Filter"] = item.HostPreferenceFilter
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -561,6 +587,17 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 		}
 	}
 
+	var portMap map[string]any
+	if len(item.Ports) > 0 {
+		portMap = make(map[string]any, len(item.Ports))
+		for name, port := range item.Ports {
+			portMap[name] = port
+			if l := len(name); l > maxLength {
+				maxLength = l
+			}
+		}
+	}
+
 	var credentialSourceMaps map[credential.Purpose][]map[string]any
 	if len(item.BrokeredCredentialSources) > 0 {
 		if credentialSourceMaps == nil {
@@ -635,6 +672,14 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 		}
 	}
 
+	if len(portMap) > 0 {
+		ret = append(ret,
+			"  Ports:",
+			base.WrapMap(4, maxLength, portMap),
+			"",
+		)
+	}
+
 	for purpose, sources := range credentialSourceMaps {
 		switch purpose {
 		case credential.BrokeredPurpose:
@@ -664,6 +709,30 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	return base.WrapForHelpText(ret)
 }
 
+// portsOption returns the option setting the named ports given as "name=port"
+// values of the -port flag. A single "null" value removes all named ports.
+func portsOption(vals []string) (targets.Option, error) {
+	if len(vals) == 1 && vals[0] == "null" {
+		return targets.DefaultPorts(), nil
+	}
+	ports := make(map[string]uint32, len(vals))
+	for _, v := range vals {
+		name, port, ok := strings.Cut(v, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("Error parsing port %q: must be in the format name=port", v)
+		}
+		if _, ok := ports[name]; ok {
+			return nil, fmt.Errorf("Port %q specified more than once", name)
+		}
+		p, err := strconv.ParseUint(port, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing port %q: %s", v, err)
+		}
+		ports[name] = uint32(p)
+	}
+	return targets.WithPorts(ports), nil
+}
+
 func printCustomActionOutputImpl(c *Command) (bool, error) {
 	switch c.Func {
 	case "authorize-session":
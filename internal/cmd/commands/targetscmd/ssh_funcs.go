@@ -22,8 +22,8 @@ func init() {
 
 func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"address", "default-port", "session-max-seconds", "session-connection-limit", "egress-worker-filter", "ingress-worker-filter"},
-		"update": {"address", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "egress-worker-filter", "ingress-worker-filter"},
+		"create": {"address", "default-port", "session-max-seconds", "session-connection-limit", "egress-worker-filter", "ingress-worker-filter", "host-selection-strategy", "worker-selection-strategy", "worker-affinity-filter", "host-exclusion-filter", "host-preference-filter", "port"},
+		"update": {"address", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "egress-worker-filter", "ingress-worker-filter", "host-selection-strategy", "worker-selection-strategy", "worker-affinity-filter", "host-exclusion-filter", "host-preference-filter", "port"},
 	}
 }
 
@@ -34,7 +34,13 @@ type extraSshCmdVars struct {
 	flagWorkerFilter           string
 	flagEgressWorkerFilter     string
 	flagIngressWorkerFilter    string
+	flagHostStrategy           string
+	flagWorkerStrategy         string
+	flagWorkerAffinityFilter   string
+	flagHostExclusionFilter    string
+	flagHostPreferenceFilter   string
 	flagAddress                string
+	flagPorts                  []string
 }
 
 func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
@@ -112,6 +118,43 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "host-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-selection-strategy",
+				Target: &c.flagHostStrategy,
+				Usage:  `The strategy used to choose the host of a session: "random", "round-robin", "least-active-sessions" or "sticky-per-user".`,
+			})
+		case "worker-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-selection-strategy",
+				Target: &c.flagWorkerStrategy,
+				Usage:  `The strategy used to order the workers that can handle a session: "random", "least-connections" or "tag-affinity".`,
+			})
+		case "worker-affinity-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-affinity-filter",
+				Target: &c.flagWorkerAffinityFilter,
+				Usage:  "A boolean expression identifying the workers preferred by the tag-affinity worker selection strategy.",
+			})
+		case "host-exclusion-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-exclusion-filter",
+				Target: &c.flagHostExclusionFilter,
+				Usage:  "A boolean expression identifying the hosts which must not be chosen for a session.",
+			})
+		case "host-preference-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-preference-filter",
+				Target: &c.flagHostPreferenceFilter,
+				Usage:  "A boolean expression identifying the hosts which are chosen for a session in preference to the others.",
+			})
+		case "port":
+			fs.StringSliceVar(&base.StringSliceVar{
+				Name:      "port",
+				Target:    &c.flagPorts,
+				NullCheck: func() bool { return c.Func == "update" },
+				Usage:     `A named port of the target in the format "name=port", which can be selected with the -port-name flag of "boundary connect". May be specified multiple times. On update, replaces all named ports of the target; use "null" to remove them.`,
+			})
 		}
 	}
 }
@@ -198,6 +241,54 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagHostStrategy {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHostSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithHostSelectionStrategy(c.flagHostStrategy))
+	}
+	switch c.flagWorkerStrategy {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithWorkerSelectionStrategy(c.flagWorkerStrategy))
+	}
+	switch c.flagWorkerAffinityFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerAffinityFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerAffinityFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse worker affinity filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerAffinityFilter(c.flagWorkerAffinityFilter))
+	}
+	switch c.flagHostExclusionFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHostExclusionFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagHostExclusionFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse host exclusion filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithHostExclusionFilter(c.flagHostExclusionFilter))
+	}
+	switch c.flagHostPreferenceFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHostPreferenceFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagHostPreferenceFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse host preference filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithHostPreferenceFilter(c.flagHostPreferenceFilter))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
@@ -206,6 +297,15 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithAddress(c.flagAddress))
 	}
 
+	if len(c.flagPorts) > 0 {
+		opt, err := portsOption(c.flagPorts)
+		if err != nil {
+			c.UI.Error(err.Error())
+			return false
+		}
+		*opts = append(*opts, opt)
+	}
+
 	return true
 }
 
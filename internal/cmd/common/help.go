@@ -17,14 +17,18 @@ func SynopsisFunc(inFunc, resType string) string {
 	if inFunc == "" {
 		return wordwrap.WrapString(fmt.Sprintf("Manage Boundary %ss", resType), base.TermWidth)
 	}
-	articleType := resType
+	articleType := withArticle(resType)
+	return wordwrap.WrapString(fmt.Sprintf("%s %s", textproto.CanonicalMIMEHeaderKey(inFunc), articleType), base.TermWidth)
+}
+
+// withArticle returns resType preceded by its indefinite article.
+func withArticle(resType string) string {
 	switch resType[0] {
 	case 'a', 'e', 'i', 'o':
-		articleType = fmt.Sprintf("an %s", articleType)
+		return fmt.Sprintf("an %s", resType)
 	default:
-		articleType = fmt.Sprintf("a %s", articleType)
+		return fmt.Sprintf("a %s", resType)
 	}
-	return wordwrap.WrapString(fmt.Sprintf("%s %s", textproto.CanonicalMIMEHeaderKey(inFunc), articleType), base.TermWidth)
 }
 
 func HelpMap(resType string) map[string]func() string {
@@ -123,13 +127,7 @@ func HelpMap(resType string) map[string]func() string {
 func subtype(in []string, resType string, prefixMap map[string]string) []string {
 	hyphenTypePlural := resource.Map[strings.ReplaceAll(resType, " ", "-")].PluralString()
 	pluralTypes := strings.ReplaceAll(hyphenTypePlural, "-", " ")
-	articleType := resType
-	switch resType[0] {
-	case 'a', 'e', 'i', 'o':
-		articleType = fmt.Sprintf("an %s", articleType)
-	default:
-		articleType = fmt.Sprintf("a %s", articleType)
-	}
+	articleType := withArticle(resType)
 	for i, v := range in {
 		in[i] = strings.Replace(
 			strings.Replace(
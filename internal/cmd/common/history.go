@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/history"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*HistoryCommand)(nil)
+	_ cli.CommandAutocomplete = (*HistoryCommand)(nil)
+)
+
+// HistoryResult is the change history of a resource returned by the History
+// function of its API client.
+type HistoryResult interface {
+	GetItems() []*history.Record
+	GetResponse() *api.Response
+}
+
+// HistoryCommand shows the change history of a resource, oldest first, as
+// recorded in the oplog.
+type HistoryCommand struct {
+	*base.Command
+
+	// Resource is the type of the resource whose history is shown.
+	Resource resource.Type
+	// ExampleId is the id of a resource of the type used in the help text.
+	ExampleId string
+	// History reads the change history of the resource id.
+	History func(ctx context.Context, client *api.Client, id string) (HistoryResult, error)
+}
+
+func (c *HistoryCommand) Synopsis() string {
+	return wordwrap.WrapString(fmt.Sprintf("Show the change history of %s", withArticle(resourceName(c.Resource))), base.TermWidth)
+}
+
+func (c *HistoryCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		fmt.Sprintf("Usage: boundary %s history [args]", c.Resource.PluralString()),
+		"",
+		fmt.Sprintf("  Show the change history of %s, oldest first, as recorded in the oplog. Example:", withArticle(resourceName(c.Resource))),
+		"",
+		fmt.Sprintf(`    $ boundary %s history -id %s`, c.Resource.PluralString(), c.ExampleId),
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *HistoryCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  fmt.Sprintf("The id of the %s whose history should be shown", resourceName(c.Resource)),
+	})
+
+	return set
+}
+
+func (c *HistoryCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *HistoryCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *HistoryCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := c.History(c.Context, client, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when reading %s history", resourceName(c.Resource)))
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to read %s history: %w", resourceName(c.Resource), err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(base.HistoryForOutput(result.GetItems()))
+	}
+
+	return base.CommandSuccess
+}
+
+// resourceName returns the name of r used in messages, such as "host set".
+func resourceName(r resource.Type) string {
+	return strings.ReplaceAll(r.String(), "-", " ")
+}
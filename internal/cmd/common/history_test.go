@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryCommand(t *testing.T) {
+	called := false
+	newCommand := func(ui cli.Ui) *HistoryCommand {
+		return &HistoryCommand{
+			Command:   base.NewCommand(ui),
+			Resource:  resource.HostSet,
+			ExampleId: "hsst_1234567890",
+			History: func(context.Context, *api.Client, string) (HistoryResult, error) {
+				called = true
+				return nil, nil
+			},
+		}
+	}
+
+	c := newCommand(cli.NewMockUi())
+	assert.Equal(t, "Show the change history of a host set", c.Synopsis())
+	help := c.Help()
+	assert.Contains(t, help, "Usage: boundary host-sets history [args]")
+	assert.Contains(t, help, "$ boundary host-sets history -id hsst_1234567890")
+	assert.Contains(t, help, "The id of the host set whose history should be shown")
+
+	ui := cli.NewMockUi()
+	assert.Equal(t, base.CommandUserError, newCommand(ui).Run(nil))
+	assert.Contains(t, ui.ErrorWriter.String(), "ID must be provided via -id")
+	assert.False(t, called)
+}
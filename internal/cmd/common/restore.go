@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/history"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RestoreCommand)(nil)
+	_ cli.CommandAutocomplete = (*RestoreCommand)(nil)
+)
+
+// RestoreResult is the result of restoring a resource returned by the
+// Restore function of its API client.
+type RestoreResult interface {
+	GetChanges() []*history.Change
+	GetDryRun() bool
+	GetResponse() *api.Response
+}
+
+// RestoreCommand restores a resource to its state at a point in time, as
+// recorded in the oplog.
+type RestoreCommand struct {
+	*base.Command
+
+	// Resource is the type of the resource which is restored.
+	Resource resource.Type
+	// ExampleId is the id of a resource of the type used in the help text.
+	ExampleId string
+	// Restore restores the resource id to its state at the time at. If
+	// dryRun is set, the changes which would be made are returned without
+	// being applied.
+	Restore func(ctx context.Context, client *api.Client, id string, at time.Time, dryRun bool) (RestoreResult, error)
+
+	flagTime   string
+	flagDryRun bool
+}
+
+func (c *RestoreCommand) Synopsis() string {
+	return wordwrap.WrapString(fmt.Sprintf("Restore %s to its state at a point in time", withArticle(resourceName(c.Resource))), base.TermWidth)
+}
+
+func (c *RestoreCommand) Help() string {
+	name := resourceName(c.Resource)
+	return base.WrapForHelpText([]string{
+		fmt.Sprintf("Usage: boundary %s restore [args]", c.Resource.PluralString()),
+		"",
+		fmt.Sprintf("  Restore %s to its state at a point in time, as recorded in the oplog. A deleted %s is recreated with its original ID. Use -dry-run to show the changes without applying them. Example:", withArticle(name), name),
+		"",
+		fmt.Sprintf(`    $ boundary %s restore -id %s -time 2023-05-01T15:04:05Z -dry-run`, c.Resource.PluralString(), c.ExampleId),
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RestoreCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  fmt.Sprintf("The id of the %s to restore", resourceName(c.Resource)),
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "time",
+		Target: &c.flagTime,
+		Usage:  fmt.Sprintf("The point in time to restore the %s to, in RFC 3339 format", resourceName(c.Resource)),
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "dry-run",
+		Target: &c.flagDryRun,
+		Usage:  "If set, show the changes which would be made without applying them",
+	})
+
+	return set
+}
+
+func (c *RestoreCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RestoreCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RestoreCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagTime == "":
+		c.PrintCliError(errors.New("Time must be provided via -time"))
+		return base.CommandUserError
+	}
+	at, err := time.Parse(time.RFC3339, c.flagTime)
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error parsing -time as an RFC 3339 timestamp: %w", err))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	result, err := c.Restore(c.Context, client, c.FlagId, at, c.flagDryRun)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when restoring %s", resourceName(c.Resource)))
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to restore %s: %w", resourceName(c.Resource), err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+
+	default:
+		c.UI.Output(base.RestoreForOutput(result.GetChanges(), result.GetDryRun()))
+	}
+
+	return base.CommandSuccess
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestoreCommand(t *testing.T) {
+	called := false
+	newCommand := func(ui cli.Ui) *RestoreCommand {
+		return &RestoreCommand{
+			Command:   base.NewCommand(ui),
+			Resource:  resource.CredentialLibrary,
+			ExampleId: "clvlt_1234567890",
+			Restore: func(context.Context, *api.Client, string, time.Time, bool) (RestoreResult, error) {
+				called = true
+				return nil, nil
+			},
+		}
+	}
+
+	c := newCommand(cli.NewMockUi())
+	assert.Equal(t, "Restore a credential library to its state at a point in time", c.Synopsis())
+	help := c.Help()
+	assert.Contains(t, help, "Usage: boundary credential-libraries restore [args]")
+	assert.Contains(t, help, "A deleted credential library is recreated with its original ID.")
+	assert.Contains(t, help, "$ boundary credential-libraries restore -id clvlt_1234567890 -time")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing-id",
+			args:    []string{"-time", "2023-05-01T15:04:05Z"},
+			wantErr: "ID must be provided via -id",
+		},
+		{
+			name:    "missing-time",
+			args:    []string{"-id", "clvlt_1234567890"},
+			wantErr: "Time must be provided via -time",
+		},
+		{
+			name:    "invalid-time",
+			args:    []string{"-id", "clvlt_1234567890", "-time", "yesterday"},
+			wantErr: "Error parsing -time as an RFC 3339 timestamp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ui := cli.NewMockUi()
+			assert.Equal(t, base.CommandUserError, newCommand(ui).Run(tt.args))
+			assert.Contains(t, ui.ErrorWriter.String(), tt.wantErr)
+		})
+	}
+	assert.False(t, called)
+}
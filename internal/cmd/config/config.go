@@ -14,6 +14,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -185,6 +186,25 @@ type Controller struct {
 	LivenessTimeToStale         interface{}   `hcl:"liveness_time_to_stale"`
 	LivenessTimeToStaleDuration time.Duration `hcl:"-"`
 
+	// StaticCredentialRetainedVersions is the number of versions of each
+	// static credential that are kept for rollback. Zero means the default
+	// is used.
+	StaticCredentialRetainedVersions int `hcl:"static_credential_retained_versions"`
+
+	// VaultTokenExpiryWarningThreshold is the time (as a duration) before
+	// the expiration of a Vault credential store token at which its health
+	// is reported as a warning. Only tokens that can no longer be extended
+	// by renewals are considered.
+	VaultTokenExpiryWarningThreshold         any           `hcl:"vault_token_expiry_warning_threshold"`
+	VaultTokenExpiryWarningThresholdDuration time.Duration `hcl:"-"`
+
+	// VaultTokenExpiryErrorThreshold is the time (as a duration) before the
+	// expiration of a Vault credential store token at which its health is
+	// reported as an error. Only tokens that can no longer be extended by
+	// renewals are considered.
+	VaultTokenExpiryErrorThreshold         any           `hcl:"vault_token_expiry_error_threshold"`
+	VaultTokenExpiryErrorThresholdDuration time.Duration `hcl:"-"`
+
 	// SchedulerRunJobInterval is the time interval between waking up the
 	// scheduler to run pending jobs.
 	//
@@ -294,6 +314,11 @@ type Scheduler struct {
 
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
+
+	// FileHostPluginDir is the directory the hosts files of the catalogs of
+	// the file host plugin must be in. The file host plugin is only enabled
+	// if it is set.
+	FileHostPluginDir string `hcl:"file_host_plugin_dir"`
 }
 
 // DevWorker is a Config that is used for dev mode of Boundary
@@ -566,6 +591,31 @@ func Parse(d string) (*Config, error) {
 			return nil, errors.New("Controller liveness time to stale value is negative")
 		}
 
+		if result.Controller.StaticCredentialRetainedVersions < 0 {
+			return nil, errors.New("Controller static credential retained versions value is negative")
+		}
+
+		if result.Controller.VaultTokenExpiryWarningThreshold != nil {
+			t, err := parseutil.ParseDurationSecond(result.Controller.VaultTokenExpiryWarningThreshold)
+			if err != nil {
+				return result, err
+			}
+			result.Controller.VaultTokenExpiryWarningThresholdDuration = t
+		}
+		if result.Controller.VaultTokenExpiryWarningThresholdDuration < 0 {
+			return nil, errors.New("Controller vault token expiry warning threshold value is negative")
+		}
+		if result.Controller.VaultTokenExpiryErrorThreshold != nil {
+			t, err := parseutil.ParseDurationSecond(result.Controller.VaultTokenExpiryErrorThreshold)
+			if err != nil {
+				return result, err
+			}
+			result.Controller.VaultTokenExpiryErrorThresholdDuration = t
+		}
+		if result.Controller.VaultTokenExpiryErrorThresholdDuration < 0 {
+			return nil, errors.New("Controller vault token expiry error threshold value is negative")
+		}
+
 		if result.Controller.Database != nil {
 			if result.Controller.Database.MaxOpenConnectionsRaw != nil {
 				switch t := result.Controller.Database.MaxOpenConnectionsRaw.(type) {
@@ -858,6 +908,15 @@ func Parse(d string) (*Config, error) {
 			return nil, fmt.Errorf("Error parsing plugins execution dir: %w", err)
 		}
 	}
+	if result.Plugins.FileHostPluginDir != "" {
+		result.Plugins.FileHostPluginDir, err = parseutil.ParsePath(result.Plugins.FileHostPluginDir)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return nil, fmt.Errorf("Error parsing plugins file host plugin dir: %w", err)
+		}
+		if !filepath.IsAbs(result.Plugins.FileHostPluginDir) {
+			return nil, errors.New("Plugins file host plugin dir must be an absolute path")
+		}
+	}
 
 	for _, f := range extraParsingFuncs {
 		if err := f(result); err != nil {
@@ -932,6 +991,33 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 		return nil, fmt.Errorf(`error interpreting "events" node as an object type`)
 	}
 	list := eventObjType.List
+
+	// Decode the redaction profiles before the sinks which reference them
+	for i, item := range list.Filter("redaction_profile").Items {
+		var p event.RedactionProfile
+		if err := hcl.DecodeObject(&p, item.Val); err != nil {
+			return nil, fmt.Errorf("error decoding redaction profile entry %d: %w", i, err)
+		}
+		if p.Name == "" && len(item.Keys) == 1 {
+			p.Name = item.Keys[0].Token.Value().(string)
+		}
+		profileObjType, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, fmt.Errorf("error interpreting redaction profile entry %d as an object type", i)
+		}
+		for j, ruleItem := range profileObjType.List.Filter("rule").Items {
+			var r event.RedactionRule
+			if err := hcl.DecodeObject(&r, ruleItem.Val); err != nil {
+				return nil, fmt.Errorf("error decoding redaction profile entry %d rule %d: %w", i, j, err)
+			}
+			p.Rules = append(p.Rules, &r)
+		}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		result.RedactionProfiles = append(result.RedactionProfiles, &p)
+	}
+
 	sinkList := list.Filter("sink")
 	// Go through each sink and decode
 	for i, item := range sinkList.Items {
@@ -992,6 +1078,9 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 	if len(result.Sinks) == 0 {
 		result.Sinks = []*event.SinkConfig{event.DefaultSink()}
 	}
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
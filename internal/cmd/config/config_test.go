@@ -1221,6 +1221,94 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "redaction_profiles",
+			config: []string{
+				`events {
+					audit_enabled = true
+					stream_redaction_profiles = ["credentials"]
+					redaction_profile "credentials" {
+						rule {
+							path      = "request.details.attributes.password"
+							operation = "redact"
+						}
+						rule {
+							path      = "response.details.item.authorized_actions"
+							operation = "drop"
+						}
+					}
+					sink {
+						name = "audit-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						file {
+							file_name = "audit.log"
+						}
+						audit_config {
+							redaction_profiles = ["credentials"]
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled:            true,
+				StreamRedactionProfiles: []string{"credentials"},
+				RedactionProfiles: []*event.RedactionProfile{
+					{
+						Name: "credentials",
+						Rules: []*event.RedactionRule{
+							{Path: "request.details.attributes.password", Operation: event.RedactOperation},
+							{Path: "response.details.item.authorized_actions", Operation: event.DropOperation},
+						},
+					},
+				},
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "file",
+						Name:       "audit-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						FileConfig: &event.FileSinkTypeConfig{
+							FileName: "audit.log",
+						},
+						AuditConfig: &event.AuditConfig{
+							RedactionProfiles: []string{"credentials"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "redaction_profile_invalid_operation",
+			config: []string{
+				`events {
+					redaction_profile "credentials" {
+						rule {
+							path      = "request.details.attributes.password"
+							operation = "invalid"
+						}
+					}
+				}`,
+			},
+			wantErr: `error parsing "events": event.(RedactionProfile).Validate: profile 'credentials' rule 0 is invalid: event.(RedactionRule).Validate: invalid operation 'invalid' for path 'request.details.attributes.password': invalid parameter`,
+		},
+		{
+			name: "redaction_profile_unknown",
+			config: []string{
+				`events {
+					sink {
+						name = "audit-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						stderr {}
+						audit_config {
+							redaction_profiles = ["missing"]
+						}
+					}
+				}`,
+			},
+			wantErr: `error parsing "events": event.(EventerConfig).Validate: sink 0 references unknown redaction profile 'missing': invalid parameter`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1570,6 +1658,54 @@ func TestPluginExecutionDir(t *testing.T) {
 	}
 }
 
+func TestPluginFileHostPluginDir(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		envDir    string
+		expDir    string
+		expErrStr string
+	}{
+		{
+			name: "Valid dir from env var",
+			in: `
+			plugins {
+				file_host_plugin_dir = "env://FILE_HOST_PLUGIN_DIR"
+			}`,
+			envDir: "/etc/boundary/hosts",
+			expDir: "/etc/boundary/hosts",
+		},
+		{
+			name: "Relative dir",
+			in: `
+			plugins {
+				file_host_plugin_dir = "hosts"
+			}`,
+			expErrStr: "Plugins file host plugin dir must be an absolute path",
+		},
+		{
+			name: "Not set",
+			in: `
+			plugins {
+				execution_dir = "/tmp/foobar"
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("FILE_HOST_PLUGIN_DIR", tt.envDir)
+			p, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, p)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expDir, p.Plugins.FileHostPluginDir)
+		})
+	}
+}
+
 func TestDatabaseMaxConnections(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -219,6 +219,55 @@ var inputStructs = map[string][]*cmdInfo{
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialStore.String(),
+			Pkg:                  "credentialstores",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "plugin",
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			IsPluginType:         true,
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			HasGenericAttributes: true,
+			HasGenericSecrets:    true,
+		},
+		{
+			ResourceType:         resource.CredentialStore.String(),
+			Pkg:                  "credentialstores",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "kubernetes",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
+		{
+			ResourceType:         resource.CredentialStore.String(),
+			Pkg:                  "credentialstores",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "aws",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 	},
 	"credentiallibraries": {
 		{
@@ -277,6 +326,54 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update"},
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialLibrary.String(),
+			Pkg:                  "credentiallibraries",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "vault-pki",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			NeedsSubtypeInCreate: true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
+		{
+			ResourceType:         resource.CredentialLibrary.String(),
+			Pkg:                  "credentiallibraries",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "kubernetes",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			NeedsSubtypeInCreate: true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
+		{
+			ResourceType:         resource.CredentialLibrary.String(),
+			Pkg:                  "credentiallibraries",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "aws",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			NeedsSubtypeInCreate: true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 	},
 	"credentials": {
 		{
@@ -319,11 +416,28 @@ var inputStructs = map[string][]*cmdInfo{
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.Credential.String(),
+			Pkg:                  "credentials",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "tls_client_certificate",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 		{
 			ResourceType:         resource.Credential.String(),
 			Pkg:                  "credentials",
 			StdActions:           []string{"create", "update"},
 			SubActionPrefix:      "json",
+			HasExtraCommandVars:  true,
 			SkipNormalHelp:       true,
 			HasExtraHelpFunc:     true,
 			HasId:                true,
@@ -390,6 +504,21 @@ var inputStructs = map[string][]*cmdInfo{
 			HasGenericAttributes: true,
 			HasGenericSecrets:    true,
 		},
+		{
+			ResourceType:         resource.HostCatalog.String(),
+			Pkg:                  "hostcatalogs",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "dns",
+			SkipNormalHelp:       true,
+			HasExtraCommandVars:  true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
 	},
 	"hostsets": {
 		{
@@ -405,17 +534,18 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:    []string{"add-hosts", "set-hosts", "remove-hosts"},
 		},
 		{
-			ResourceType:     resource.HostSet.String(),
-			Pkg:              "hostsets",
-			StdActions:       []string{"create", "update"},
-			SubActionPrefix:  "static",
-			SkipNormalHelp:   true,
-			HasExtraHelpFunc: true,
-			HasId:            true,
-			HasName:          true,
-			Container:        "HostCatalog",
-			HasDescription:   true,
-			VersionedActions: []string{"update"},
+			ResourceType:        resource.HostSet.String(),
+			Pkg:                 "hostsets",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "static",
+			SkipNormalHelp:      true,
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "HostCatalog",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
 		},
 		{
 			ResourceType:         resource.HostSet.String(),
@@ -432,6 +562,20 @@ var inputStructs = map[string][]*cmdInfo{
 			HasGenericAttributes: true,
 			VersionedActions:     []string{"update"},
 		},
+		{
+			ResourceType:        resource.HostSet.String(),
+			Pkg:                 "hostsets",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "dns",
+			SkipNormalHelp:      true,
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "HostCatalog",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
 	},
 	"hosts": {
 		{
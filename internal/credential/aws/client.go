@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// minDuration and maxDuration are the shortest and longest lifetimes
+	// STS accepts for the credentials of an assumed role.
+	minDuration = 15 * time.Minute
+	maxDuration = 12 * time.Hour
+
+	// clientTimeout bounds the time a single call to an AWS endpoint may
+	// take.
+	clientTimeout = 30 * time.Second
+
+	// defaultSigninEndpoint is the AWS federation endpoint used to create
+	// sign-in urls when a store does not specify one.
+	defaultSigninEndpoint = "https://signin.aws.amazon.com"
+
+	// consoleUrl is the destination of federated sign-in urls.
+	consoleUrl = "https://console.aws.amazon.com/"
+)
+
+// invalidSessionNameChars matches the characters STS does not allow in a
+// role session name.
+var invalidSessionNameChars = regexp.MustCompile(`[^\w+=,.@-]`)
+
+type clientConfig struct {
+	Region          string
+	StsEndpoint     string
+	SigninEndpoint  string
+	AccessKeyId     string
+	SecretAccessKey []byte
+}
+
+type client struct {
+	cl             *http.Client
+	sts            *sts.Client
+	stsEndpoint    string
+	signinEndpoint string
+}
+
+func newClient(ctx context.Context, c *clientConfig) (*client, error) {
+	const op = "aws.newClient"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing client config")
+	case c.Region == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing region")
+	case c.AccessKeyId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access key id")
+	case len(c.SecretAccessKey) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing secret access key")
+	}
+
+	stsEndpoint := c.StsEndpoint
+	if stsEndpoint == "" {
+		stsEndpoint = fmt.Sprintf("https://sts.%s.amazonaws.com", c.Region)
+	}
+	signinEndpoint := c.SigninEndpoint
+	if signinEndpoint == "" {
+		signinEndpoint = defaultSigninEndpoint
+	}
+	for _, e := range []string{stsEndpoint, signinEndpoint} {
+		u, err := url.Parse(e)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		if u.Scheme != "https" && u.Scheme != "http" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported endpoint scheme: %q", u.Scheme))
+		}
+	}
+
+	cl := &http.Client{
+		Timeout: clientTimeout,
+	}
+	accessKeyId, secretAccessKey := c.AccessKeyId, string(c.SecretAccessKey)
+	opts := sts.Options{
+		Region:     c.Region,
+		HTTPClient: cl,
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: accessKeyId, SecretAccessKey: secretAccessKey}, nil
+		}),
+	}
+	if c.StsEndpoint != "" {
+		opts.EndpointResolver = sts.EndpointResolverFromURL(stsEndpoint)
+	}
+
+	return &client{
+		cl:             cl,
+		sts:            sts.New(opts),
+		stsEndpoint:    strings.TrimSuffix(stsEndpoint, "/"),
+		signinEndpoint: strings.TrimSuffix(signinEndpoint, "/"),
+	}, nil
+}
+
+// stsCredentials are the temporary security credentials returned by STS.
+type stsCredentials struct {
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      time.Time
+}
+
+// sessionName returns in with the characters STS does not allow in a role
+// session name replaced, truncated to the maximum length STS accepts.
+func sessionName(in string) string {
+	n := invalidSessionNameChars.ReplaceAllString(in, "-")
+	if len(n) > 64 {
+		n = n[:64]
+	}
+	return n
+}
+
+// assumeRole calls the STS AssumeRole API to assume roleArn with the role
+// session name name. policy is an optional inline session policy. The
+// duration is clamped to the range STS accepts. See
+// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html.
+func (c *client) assumeRole(ctx context.Context, roleArn, name, policy string, duration time.Duration) (*stsCredentials, error) {
+	const op = "aws.(client).assumeRole"
+	switch {
+	case roleArn == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role arn")
+	case len(name) < 2:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "role session name must be at least 2 characters")
+	}
+	switch {
+	case duration < minDuration:
+		duration = minDuration
+	case duration > maxDuration:
+		duration = maxDuration
+	}
+
+	in := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(roleArn),
+		RoleSessionName: aws.String(name),
+		DurationSeconds: aws.Int32(int32(duration / time.Second)),
+	}
+	if policy != "" {
+		in.Policy = aws.String(policy)
+	}
+
+	out, err := c.sts.AssumeRole(ctx, in)
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("aws sts (%s): assume role %s failed: %s: %s", c.stsEndpoint, roleArn, apiErr.ErrorCode(), apiErr.ErrorMessage()))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg(fmt.Sprintf("aws sts: %s", c.stsEndpoint)))
+	}
+	creds := out.Credentials
+	if creds == nil || aws.ToString(creds.AccessKeyId) == "" || aws.ToString(creds.SecretAccessKey) == "" || aws.ToString(creds.SessionToken) == "" {
+		return nil, errors.New(ctx, errors.Unknown, op, "aws sts: assume role returned no credentials")
+	}
+	return &stsCredentials{
+		AccessKeyId:     aws.ToString(creds.AccessKeyId),
+		SecretAccessKey: aws.ToString(creds.SecretAccessKey),
+		SessionToken:    aws.ToString(creds.SessionToken),
+		Expiration:      aws.ToTime(creds.Expiration),
+	}, nil
+}
+
+// signinUrl exchanges creds for a sign-in token with the AWS federation
+// endpoint and returns a url which signs in to the AWS console with it.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_enable-console-custom-url.html.
+func (c *client) signinUrl(ctx context.Context, creds *stsCredentials) (string, error) {
+	const op = "aws.(client).signinUrl"
+	if creds == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing credentials")
+	}
+	session, err := json.Marshal(map[string]string{
+		"sessionId":    creds.AccessKeyId,
+		"sessionKey":   creds.SecretAccessKey,
+		"sessionToken": creds.SessionToken,
+	})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+
+	q := url.Values{}
+	q.Set("Action", "getSigninToken")
+	q.Set("Session", string(session))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.signinEndpoint+"/federation?"+q.Encode(), nil)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.cl.Do(req)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg(fmt.Sprintf("aws federation: %s", c.signinEndpoint)))
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(ctx, errors.Unknown, op, fmt.Sprintf("aws federation (%s): get sign-in token failed: %s", c.signinEndpoint, resp.Status))
+	}
+	var got struct {
+		SigninToken string `json:"SigninToken"`
+	}
+	if err := json.Unmarshal(respBody, &got); err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to decode sign-in token response"))
+	}
+	if got.SigninToken == "" {
+		return "", errors.New(ctx, errors.Unknown, op, "aws federation: no sign-in token returned")
+	}
+
+	q = url.Values{}
+	q.Set("Action", "login")
+	q.Set("Issuer", "boundary")
+	q.Set("Destination", consoleUrl)
+	q.Set("SigninToken", got.SigninToken)
+	return c.signinEndpoint + "/federation?" + q.Encode(), nil
+}
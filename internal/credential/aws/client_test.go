@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "s_1234567890", want: "s_1234567890"},
+		{in: "boundary-jim@example.com", want: "boundary-jim@example.com"},
+		{in: "Jim Smith/admin", want: "Jim-Smith-admin"},
+		{in: strings.Repeat("a", 70), want: strings.Repeat("a", 64)},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, sessionName(tt.in))
+	}
+}
+
+func TestClient_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		in      *clientConfig
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-config",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-region",
+			in:      &clientConfig{AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-access-key-id",
+			in:      &clientConfig{Region: "us-east-1", SecretAccessKey: []byte("secret")},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-secret-access-key",
+			in:      &clientConfig{Region: "us-east-1", AccessKeyId: "AKIDEXAMPLE"},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "bad-sts-endpoint-scheme",
+			in:      &clientConfig{Region: "us-east-1", StsEndpoint: "ftp://example.com", AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			in:   &clientConfig{Region: "us-east-1", AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := newClient(ctx, tt.in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("https://sts.us-east-1.amazonaws.com", got.stsEndpoint)
+			assert.Equal(defaultSigninEndpoint, got.signinEndpoint)
+		})
+	}
+}
+
+func TestClient_AssumeRole(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const roleArn = "arn:aws:iam::123456789012:role/boundary"
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "us-west-2", "AKIDEXAMPLE", "secret")
+		cl, err := newClient(ctx, &clientConfig{Region: "us-west-2", StsEndpoint: ts.URL, SigninEndpoint: ts.URL, AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")})
+		require.NoError(err)
+
+		policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		creds, err := cl.assumeRole(ctx, roleArn, "s_1234567890", policy, time.Hour)
+		require.NoError(err)
+		assert.Equal("ASIATEST000000000001", creds.AccessKeyId)
+		assert.Equal("secret-1", creds.SecretAccessKey)
+		assert.Equal("session-token-1", creds.SessionToken)
+		assert.WithinDuration(time.Now().Add(time.Hour), creds.Expiration, 5*time.Second)
+
+		reqs := ts.Requests()
+		require.Len(reqs, 1)
+		assert.Equal(TestAssumeRoleRequest{
+			RoleArn:         roleArn,
+			RoleSessionName: "s_1234567890",
+			Policy:          policy,
+			DurationSeconds: 3600,
+		}, reqs[0])
+
+		signin, err := cl.signinUrl(ctx, creds)
+		require.NoError(err)
+		u, err := url.Parse(signin)
+		require.NoError(err)
+		assert.Equal("/federation", u.Path)
+		assert.Equal("login", u.Query().Get("Action"))
+		assert.Equal("signin-ASIATEST000000000001", u.Query().Get("SigninToken"))
+		assert.Equal(consoleUrl, u.Query().Get("Destination"))
+	})
+
+	t.Run("duration-clamped-to-minimum", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "us-east-1", "AKIDEXAMPLE", "secret")
+		cl, err := newClient(ctx, &clientConfig{Region: "us-east-1", StsEndpoint: ts.URL, AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")})
+		require.NoError(err)
+
+		_, err = cl.assumeRole(ctx, roleArn, "s_1234567890", "", time.Minute)
+		require.NoError(err)
+		reqs := ts.Requests()
+		require.Len(reqs, 1)
+		assert.Equal(int64(minDuration/time.Second), reqs[0].DurationSeconds)
+	})
+
+	t.Run("bad-signature", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "us-east-1", "AKIDEXAMPLE", "secret")
+		cl, err := newClient(ctx, &clientConfig{Region: "us-east-1", StsEndpoint: ts.URL, AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("wrong")})
+		require.NoError(err)
+
+		creds, err := cl.assumeRole(ctx, roleArn, "s_1234567890", "", time.Hour)
+		require.Error(err)
+		assert.Nil(creds)
+		assert.Contains(err.Error(), "SignatureDoesNotMatch")
+		assert.Empty(ts.Requests())
+	})
+
+	t.Run("wrong-region", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "us-east-1", "AKIDEXAMPLE", "secret")
+		cl, err := newClient(ctx, &clientConfig{Region: "eu-west-1", StsEndpoint: ts.URL, AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")})
+		require.NoError(err)
+
+		_, err = cl.assumeRole(ctx, roleArn, "s_1234567890", "", time.Hour)
+		require.Error(err)
+		assert.Contains(err.Error(), "SignatureDoesNotMatch")
+	})
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "us-east-1", "AKIDEXAMPLE", "secret")
+		cl, err := newClient(ctx, &clientConfig{Region: "us-east-1", StsEndpoint: ts.URL, AccessKeyId: "AKIDEXAMPLE", SecretAccessKey: []byte("secret")})
+		require.NoError(err)
+
+		_, err = cl.assumeRole(ctx, "", "s_1234567890", "", time.Hour)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+		_, err = cl.assumeRole(ctx, roleArn, "s", "", time.Hour)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+		_, err = cl.signinUrl(ctx, nil)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/aws/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// A Credential records the temporary security credentials issued by an aws
+// credential library for a session. Only the access key id is stored.
+type Credential struct {
+	*store.Credential
+	tableName string `gorm:"-"`
+}
+
+func newCredential(ctx context.Context, libraryId, sessionId, accessKeyId string, expiration time.Time) (*Credential, error) {
+	const op = "aws.newCredential"
+	switch {
+	case libraryId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no library id")
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	case accessKeyId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no access key id")
+	case expiration.IsZero():
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no expiration time")
+	}
+
+	c := &Credential{
+		Credential: &store.Credential{
+			LibraryId:      libraryId,
+			SessionId:      sessionId,
+			AccessKeyId:    accessKeyId,
+			ExpirationTime: timestamp.New(expiration),
+		},
+	}
+	return c, nil
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_aws_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+var (
+	_ credential.Dynamic       = (*baseCred)(nil)
+	_ credential.AwsCredential = (*baseCred)(nil)
+)
+
+// baseCred is a set of temporary security credentials issued by an aws
+// credential library.
+type baseCred struct {
+	*Credential
+	lib       *CredentialLibrary
+	purpose   credential.Purpose
+	creds     *stsCredentials
+	signinUrl string
+}
+
+func (bc *baseCred) GetPublicId() string         { return bc.PublicId }
+func (bc *baseCred) GetSessionId() string        { return bc.SessionId }
+func (bc *baseCred) Library() credential.Library { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose { return bc.purpose }
+func (bc *baseCred) AccessKeyId() string         { return bc.creds.AccessKeyId }
+func (bc *baseCred) SecretAccessKey() string     { return bc.creds.SecretAccessKey }
+func (bc *baseCred) SessionToken() string        { return bc.creds.SessionToken }
+
+// Expiration returns the time the credentials expire.
+func (bc *baseCred) Expiration() time.Time { return bc.creds.Expiration }
+
+// SigninUrl returns the federated sign-in url issued with the credentials,
+// if any.
+func (bc *baseCred) SigninUrl() string { return bc.signinUrl }
+
+// Secret returns the credentials as a JSON compatible map.
+func (bc *baseCred) Secret() credential.SecretData {
+	s := map[string]any{
+		"access_key_id":     bc.creds.AccessKeyId,
+		"secret_access_key": bc.creds.SecretAccessKey,
+		"session_token":     bc.creds.SessionToken,
+		"expiration":        bc.creds.Expiration.UTC().Format(time.RFC3339),
+	}
+	if bc.signinUrl != "" {
+		s["signin_url"] = bc.signinUrl
+	}
+	return s
+}
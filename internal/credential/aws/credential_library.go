@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/aws/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialLibrary issues temporary security credentials for an IAM
+// role. It is owned by an aws credential store.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary assigned
+// to storeId which issues credentials for the IAM role roleArn. Name,
+// description, session name, session policy, duration seconds and
+// federated sign-in are the only valid options. All other options are
+// ignored.
+func NewCredentialLibrary(storeId, roleArn string, opt ...Option) (*CredentialLibrary, error) {
+	opts := getOpts(opt...)
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:         storeId,
+			Name:            opts.withName,
+			Description:     opts.withDescription,
+			RoleArn:         roleArn,
+			SessionName:     opts.withSessionName,
+			SessionPolicy:   opts.withSessionPolicy,
+			DurationSeconds: opts.withDurationSeconds,
+			FederatedSignin: opts.withFederatedSignin,
+			CredentialType:  string(credential.AwsCredentialType),
+		},
+	}
+	return l, nil
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	return &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_aws_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-aws-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library issues. An
+// aws credential library always issues aws credentials.
+func (l *CredentialLibrary) CredentialType() credential.Type {
+	return credential.AwsCredentialType
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/aws/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStore contains aws credential libraries. It holds the region
+// and the optional endpoints of the AWS services it calls and an access key
+// which is allowed to assume the roles of its libraries. It is owned by a
+// project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+}
+
+var _ credential.Store = (*CredentialStore)(nil)
+
+// NewCredentialStore creates a new in memory CredentialStore for the AWS
+// region assigned to projectId which calls STS with the access key
+// accessKeyId and secretAccessKey. Name, description, STS endpoint and
+// sign-in endpoint are the only valid options. All other options are
+// ignored.
+func NewCredentialStore(projectId, region, accessKeyId string, secretAccessKey []byte, opt ...Option) (*CredentialStore, error) {
+	opts := getOpts(opt...)
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:       projectId,
+			Name:            opts.withName,
+			Description:     opts.withDescription,
+			Region:          region,
+			StsEndpoint:     opts.withStsEndpoint,
+			SigninEndpoint:  opts.withSigninEndpoint,
+			AccessKeyId:     accessKeyId,
+			SecretAccessKey: secretAccessKey,
+		},
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	return &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+	}
+}
+
+// TableName returns the table name.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_aws_store"
+}
+
+// SetTableName sets the table name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"credential-aws-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
+
+func (cs *CredentialStore) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "aws.(CredentialStore).encrypt"
+	if len(cs.SecretAccessKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no secret access key defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, cs.CredentialStore, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	cs.KeyId = keyId
+	if err := cs.hmacSecretAccessKey(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (cs *CredentialStore) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "aws.(CredentialStore).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, cs.CredentialStore, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (cs *CredentialStore) hmacSecretAccessKey(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "aws.(CredentialStore).hmacSecretAccessKey"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, cs.SecretAccessKey, cipher, []byte(cs.PublicId), nil, crypto.WithEd25519())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	cs.SecretAccessKeyHmac = []byte(hm)
+	return nil
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package aws provides temporary AWS security credentials issued by the AWS
+// Security Token Service (STS). Each credential library assumes an IAM role
+// with the STS AssumeRole API and returns the resulting access key id,
+// secret access key and session token to the session, optionally along
+// with a federated sign-in url for the AWS console.
+package aws
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+// These constants are the field names used in the aws related field masks.
+const (
+	nameField            = "Name"
+	descriptionField     = "Description"
+	regionField          = "Region"
+	stsEndpointField     = "StsEndpoint"
+	signinEndpointField  = "SigninEndpoint"
+	accessKeyIdField     = "AccessKeyId"
+	secretAccessKeyField = "SecretAccessKey"
+	roleArnField         = "RoleArn"
+	sessionNameField     = "SessionName"
+	sessionPolicyField   = "SessionPolicy"
+	durationSecondsField = "DurationSeconds"
+	federatedSigninField = "FederatedSignin"
+)
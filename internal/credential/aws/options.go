@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withPublicId        string
+	withName            string
+	withDescription     string
+	withStsEndpoint     string
+	withSigninEndpoint  string
+	withSessionName     string
+	withSessionPolicy   string
+	withDurationSeconds uint32
+	withFederatedSignin bool
+	withLimit           int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(with string) Option {
+	return func(o *options) {
+		o.withPublicId = with
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithStsEndpoint provides an optional url of the STS endpoint used by a
+// credential store instead of the regional STS endpoint.
+func WithStsEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.withStsEndpoint = endpoint
+	}
+}
+
+// WithSigninEndpoint provides an optional url of the AWS federation
+// endpoint used by a credential store instead of the global one.
+func WithSigninEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.withSigninEndpoint = endpoint
+	}
+}
+
+// WithSessionName provides an optional template for the role session name
+// used by a credential library.
+func WithSessionName(name string) Option {
+	return func(o *options) {
+		o.withSessionName = name
+	}
+}
+
+// WithSessionPolicy provides an optional inline session policy used by a
+// credential library.
+func WithSessionPolicy(policy string) Option {
+	return func(o *options) {
+		o.withSessionPolicy = policy
+	}
+}
+
+// WithDurationSeconds provides an optional requested lifetime for the
+// credentials issued by a credential library.
+func WithDurationSeconds(d uint32) Option {
+	return func(o *options) {
+		o.withDurationSeconds = d
+	}
+}
+
+// WithFederatedSignin provides an option to issue a federated sign-in url
+// with the credentials issued by a credential library.
+func WithFederatedSignin(b bool) Option {
+	return func(o *options) {
+		o.withFederatedSignin = b
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("claws_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "claws_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithStsEndpoint", func(t *testing.T) {
+		opts := getOpts(WithStsEndpoint("http://127.0.0.1:4566"))
+		testOpts := getDefaultOptions()
+		testOpts.withStsEndpoint = "http://127.0.0.1:4566"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSigninEndpoint", func(t *testing.T) {
+		opts := getOpts(WithSigninEndpoint("http://127.0.0.1:4566"))
+		testOpts := getDefaultOptions()
+		testOpts.withSigninEndpoint = "http://127.0.0.1:4566"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSessionName", func(t *testing.T) {
+		opts := getOpts(WithSessionName("boundary-{{.User.Name}}"))
+		testOpts := getDefaultOptions()
+		testOpts.withSessionName = "boundary-{{.User.Name}}"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSessionPolicy", func(t *testing.T) {
+		opts := getOpts(WithSessionPolicy(`{"Version":"2012-10-17"}`))
+		testOpts := getDefaultOptions()
+		testOpts.withSessionPolicy = `{"Version":"2012-10-17"}`
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDurationSeconds", func(t *testing.T) {
+		opts := getOpts(WithDurationSeconds(3600))
+		testOpts := getDefaultOptions()
+		testOpts.withDurationSeconds = 3600
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithFederatedSignin", func(t *testing.T) {
+		opts := getOpts(WithFederatedSignin(true))
+		testOpts := getDefaultOptions()
+		testOpts.withFederatedSignin = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(credential.Domain, Subtype, globals.AwsCredentialStorePrefix, globals.AwsCredentialLibraryPrefix, DynamicCredentialPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the aws package.
+const (
+	// DynamicCredentialPrefix is the prefix for temporary security
+	// credentials issued by an aws credential library.
+	DynamicCredentialPrefix = "cdaws"
+
+	Subtype = subtypes.Subtype("aws")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	const op = "aws.newCredentialStoreId"
+	id, err := db.NewPublicId(globals.AwsCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	const op = "aws.newCredentialLibraryId"
+	id, err := db.NewPublicId(globals.AwsCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	const op = "aws.newCredentialId"
+	id, err := db.NewPublicId(DynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
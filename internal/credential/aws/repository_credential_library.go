@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+// CreateCredentialLibrary inserts l into the repository and returns a new
+// CredentialLibrary containing the credential library's PublicId. l is not
+// changed. l must not contain a PublicId. The PublicId is generated and
+// assigned by this method unless the WithPublicId option is provided.
+//
+// l must contain a valid StoreId and RoleArn. l.Name, l.Description,
+// l.SessionName, l.SessionPolicy, l.DurationSeconds and l.FederatedSignin
+// are optional. If l.Name is set, it must be unique within l.StoreId.
+//
+// Both l.CreateTime and l.UpdateTime are ignored.
+func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, opt ...Option) (*CredentialLibrary, error) {
+	const op = "aws.(Repository).CreateCredentialLibrary"
+	if l == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialLibrary")
+	}
+	if l.CredentialLibrary == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CredentialLibrary")
+	}
+	if l.StoreId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	if l.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if l.RoleArn == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no role arn")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	l = l.clone()
+
+	opts := getOpts(opt...)
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.AwsCredentialLibraryPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.AwsCredentialLibraryPrefix))
+		}
+		l.PublicId = opts.withPublicId
+	} else {
+		id, err := newCredentialLibraryId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		l.PublicId = id
+	}
+	l.CredentialLibrary.CredentialType = string(credential.AwsCredentialType)
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCredentialLibrary *CredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredentialLibrary = l.clone()
+			if err := w.Create(ctx, newCredentialLibrary, db.WithOplog(oplogWrapper, l.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", l.StoreId, l.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", l.StoreId)))
+	}
+	return newCredentialLibrary, nil
+}
+
+// UpdateCredentialLibrary updates the repository entry for l.PublicId with
+// the values in l for the fields listed in fieldMaskPaths. It returns a
+// new CredentialLibrary containing the updated values and a count of the
+// number of records updated. l is not changed.
+//
+// l must contain a valid PublicId. Only Name, Description, RoleArn,
+// SessionName, SessionPolicy, DurationSeconds and FederatedSignin can be
+// updated. RoleArn cannot be set to NULL. If l.Name is set to a non-empty
+// string, it must be unique within l.StoreId.
+func (r *Repository) UpdateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialLibrary, int, error) {
+	const op = "aws.(Repository).UpdateCredentialLibrary"
+	if l == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing CredentialLibrary")
+	}
+	if l.CredentialLibrary == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CredentialLibrary")
+	}
+	if l.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if projectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	l = l.clone()
+
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(roleArnField, f):
+		case strings.EqualFold(sessionNameField, f):
+		case strings.EqualFold(sessionPolicyField, f):
+		case strings.EqualFold(durationSecondsField, f):
+		case strings.EqualFold(federatedSigninField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:            l.Name,
+			descriptionField:     l.Description,
+			roleArnField:         l.RoleArn,
+			sessionNameField:     l.SessionName,
+			sessionPolicyField:   l.SessionPolicy,
+			durationSecondsField: l.DurationSeconds,
+			federatedSigninField: l.FederatedSignin,
+		},
+		fieldMaskPaths,
+		[]string{federatedSigninField},
+	)
+	for _, f := range nullFields {
+		if strings.EqualFold(roleArnField, f) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "role arn cannot be unset")
+		}
+	}
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredentialLibrary *CredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCredentialLibrary = l.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredentialLibrary, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, l.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err == nil && rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return err
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", l.Name, l.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(l.PublicId))
+	}
+	return returnedCredentialLibrary, rowsUpdated, nil
+}
+
+// LookupCredentialLibrary returns the CredentialLibrary for publicId.
+// Returns nil, nil if no CredentialLibrary is found for publicId.
+func (r *Repository) LookupCredentialLibrary(ctx context.Context, publicId string, _ ...Option) (*CredentialLibrary, error) {
+	const op = "aws.(Repository).LookupCredentialLibrary"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	l := allocCredentialLibrary()
+	l.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, l); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return l, nil
+}
+
+// DeleteCredentialLibrary deletes publicId from the repository and returns
+// the number of records deleted.
+func (r *Repository) DeleteCredentialLibrary(ctx context.Context, projectId string, publicId string, _ ...Option) (int, error) {
+	const op = "aws.(Repository).DeleteCredentialLibrary"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if projectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	l := allocCredentialLibrary()
+	l.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dl := l.clone()
+			rowsDeleted, err = w.Delete(ctx, dl, db.WithOplog(oplogWrapper, l.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err == nil && rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 CredentialLibrary would have been deleted")
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", l.PublicId)))
+	}
+	return rowsDeleted, nil
+}
+
+// ListCredentialLibraries returns a slice of CredentialLibraries for the
+// storeId. WithLimit is the only option supported.
+func (r *Repository) ListCredentialLibraries(ctx context.Context, storeId string, opt ...Option) ([]*CredentialLibrary, error) {
+	const op = "aws.(Repository).ListCredentialLibraries"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no storeId")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var libs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &libs, "store_id = ?", []any{storeId}, db.WithLimit(limit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return libs, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/util/template"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Issue(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ts := NewTestServer(t, "us-west-2", "AKIDEXAMPLE", "secret")
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in, err := NewCredentialStore(prj.GetPublicId(), "us-west-2", "AKIDEXAMPLE", []byte("secret"), WithStsEndpoint(ts.URL), WithSigninEndpoint(ts.URL))
+	require.NoError(t, err)
+	cs, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+	assert.Empty(t, cs.GetSecretAccessKey())
+	assert.NotEmpty(t, cs.GetCtSecretAccessKey())
+	assert.NotEmpty(t, cs.GetSecretAccessKeyHmac())
+
+	const roleArn = "arn:aws:iam::123456789012:role/boundary"
+	lib, err := NewCredentialLibrary(cs.GetPublicId(), roleArn, WithSessionName("boundary-{{.User.Name}}"), WithDurationSeconds(3600), WithFederatedSignin(true))
+	require.NoError(t, err)
+	lib, err = repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), lib)
+	require.NoError(t, err)
+	require.NotNil(t, lib)
+	assert.Equal(t, credential.AwsCredentialType, lib.CredentialType())
+
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	requests := []credential.Request{
+		{
+			SourceId: lib.GetPublicId(),
+			Purpose:  credential.BrokeredPurpose,
+		},
+	}
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+		DynamicCredentials: []*session.DynamicCredential{
+			session.NewDynamicCredential(lib.GetPublicId(), credential.BrokeredPurpose),
+		},
+	})
+
+	_, err = repo.Issue(ctx, "", requests)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = repo.Issue(ctx, sess.GetPublicId(), nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	sessExp := time.Now().Add(30 * time.Minute)
+	userName := "Jim Smith"
+	creds, err := repo.Issue(ctx, sess.GetPublicId(), requests,
+		credential.WithSessionExpiration(sessExp),
+		credential.WithTemplateData(template.Data{User: template.User{Name: &userName}}))
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	got := creds[0]
+	assert.Equal(t, sess.GetPublicId(), got.GetSessionId())
+	assert.Equal(t, lib.GetPublicId(), got.Library().GetPublicId())
+	assert.Equal(t, credential.BrokeredPurpose, got.Purpose())
+
+	// The duration of the library is bounded by the session expiration and
+	// the session name is generated from the template.
+	reqs := ts.Requests()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, roleArn, reqs[0].RoleArn)
+	assert.Equal(t, "boundary-Jim-Smith", reqs[0].RoleSessionName)
+	assert.InDelta(t, (30 * time.Minute).Seconds(), reqs[0].DurationSeconds, 5)
+
+	awsCred, ok := got.(credential.AwsCredential)
+	require.True(t, ok)
+	assert.Equal(t, "ASIATEST000000000001", awsCred.AccessKeyId())
+	assert.Equal(t, "secret-1", awsCred.SecretAccessKey())
+	assert.Equal(t, "session-token-1", awsCred.SessionToken())
+
+	secret, ok := got.Secret().(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "ASIATEST000000000001", secret["access_key_id"])
+	assert.Contains(t, secret["signin_url"], "SigninToken=signin-ASIATEST000000000001")
+
+	c := allocCredential()
+	c.PublicId = got.GetPublicId()
+	require.NoError(t, rw.LookupByPublicId(ctx, c))
+	assert.Equal(t, lib.GetPublicId(), c.GetLibraryId())
+	assert.Equal(t, "ASIATEST000000000001", c.GetAccessKeyId())
+	assert.WithinDuration(t, sessExp, c.GetExpirationTime().AsTime(), 5*time.Second)
+}
+
+func TestRepository_UpdateCredentialStore_SecretAccessKey(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), "us-east-1", "AKIDEXAMPLE", []byte("old-secret"))
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in := cs.clone()
+	in.SecretAccessKey = nil
+	_, _, err = repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{secretAccessKeyField})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	in.Region = ""
+	_, _, err = repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{regionField})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	in = cs.clone()
+	in.AccessKeyId = "AKIDNEW"
+	in.SecretAccessKey = []byte("new-secret")
+	got, n, err := repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{accessKeyIdField, secretAccessKeyField})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Empty(t, got.GetSecretAccessKey())
+	assert.Equal(t, "AKIDNEW", got.GetAccessKeyId())
+	assert.NotEqual(t, cs.GetSecretAccessKeyHmac(), got.GetSecretAccessKeyHmac())
+
+	decrypted, err := repo.getDecryptedStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new-secret"), decrypted.GetSecretAccessKey())
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates an aws credential store in the provided DB
+// with the provided project id, region and access key. The secret access
+// key is encrypted with the database key of the project. If any errors are
+// encountered during the creation of the store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, wrapper wrapping.Wrapper, projectId, region, accessKeyId string, secretAccessKey []byte, opt ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	w := db.New(conn)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	require.NotNil(t, databaseWrapper)
+
+	cs, err := NewCredentialStore(projectId, region, accessKeyId, secretAccessKey, opt...)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+
+	opts := getOpts(opt...)
+	id := opts.withPublicId
+	if id == "" {
+		id, err = newCredentialStoreId(ctx)
+		require.NoError(t, err)
+	}
+	cs.PublicId = id
+	require.NoError(t, cs.encrypt(ctx, databaseWrapper))
+
+	require.NoError(t, w.Create(ctx, cs))
+	return cs
+}
+
+// TestCredentialLibraries creates count number of aws credential libraries
+// in the provided DB with the provided store id and role arn. If any errors
+// are encountered during the creation of the credential libraries, the
+// test will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId, roleArn string, count int, opt ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(storeId, roleArn, opt...)
+		require.NoError(t, err)
+		require.NotNil(t, lib)
+
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
+
+// TestAssumeRoleRequest records an AssumeRole request received by a
+// TestServer.
+type TestAssumeRoleRequest struct {
+	RoleArn         string
+	RoleSessionName string
+	Policy          string
+	DurationSeconds int64
+}
+
+const (
+	amzDateFormat = "20060102T150405Z"
+	stsApiVersion = "2011-06-15"
+)
+
+type testCredentials struct {
+	AccessKeyId     string    `xml:"AccessKeyId"`
+	SecretAccessKey string    `xml:"SecretAccessKey"`
+	SessionToken    string    `xml:"SessionToken"`
+	Expiration      time.Time `xml:"Expiration"`
+}
+
+type testAssumeRoleResponse struct {
+	XMLName     xml.Name        `xml:"AssumeRoleResponse"`
+	Credentials testCredentials `xml:"AssumeRoleResult>Credentials"`
+}
+
+// TestServer is a fake AWS endpoint which implements the STS AssumeRole API
+// and the getSigninToken action of the AWS federation endpoint. It only
+// accepts STS requests signed with AccessKeyId and SecretAccessKey. Use
+// its URL as both the STS endpoint and the sign-in endpoint of a
+// credential store.
+type TestServer struct {
+	*httptest.Server
+
+	// Region is the region the server expects requests to be signed for.
+	Region string
+	// AccessKeyId and SecretAccessKey are the access key the server
+	// accepts.
+	AccessKeyId     string
+	SecretAccessKey string
+
+	mu       sync.Mutex
+	requests []TestAssumeRoleRequest
+	issued   map[string]bool
+}
+
+// NewTestServer starts a TestServer which accepts requests for region
+// signed with accessKeyId and secretAccessKey. The server is closed when
+// the test completes.
+func NewTestServer(t testing.TB, region, accessKeyId, secretAccessKey string) *TestServer {
+	t.Helper()
+	ts := &TestServer{
+		Region:          region,
+		AccessKeyId:     accessKeyId,
+		SecretAccessKey: secretAccessKey,
+		issued:          make(map[string]bool),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", ts.handleAssumeRole)
+	mux.HandleFunc("/federation", ts.handleFederation)
+	ts.Server = httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// Requests returns the AssumeRole requests received by the server.
+func (ts *TestServer) Requests() []TestAssumeRoleRequest {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return append([]TestAssumeRoleRequest(nil), ts.requests...)
+}
+
+func (ts *TestServer) writeError(w http.ResponseWriter, status int, code, msg string) {
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error></ErrorResponse>`, code, msg)
+}
+
+func (ts *TestServer) handleAssumeRole(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ts.writeError(w, http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+
+	// Verify the signature of the request by signing a copy of it with the
+	// expected credentials.
+	date, err := time.Parse(amzDateFormat, r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		ts.writeError(w, http.StatusForbidden, "IncompleteSignature", "missing or invalid X-Amz-Date")
+		return
+	}
+	auth := r.Header.Get("Authorization")
+	_, signed, ok := strings.Cut(auth, "SignedHeaders=")
+	if !ok {
+		ts.writeError(w, http.StatusForbidden, "IncompleteSignature", "missing SignedHeaders")
+		return
+	}
+	signed, _, _ = strings.Cut(signed, ",")
+	check, err := http.NewRequestWithContext(r.Context(), r.Method, "http://"+r.Host+r.URL.RequestURI(), nil)
+	if err != nil {
+		ts.writeError(w, http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+	check.ContentLength = r.ContentLength
+	for _, h := range strings.Split(signed, ";") {
+		if h != "host" {
+			check.Header[http.CanonicalHeaderKey(h)] = r.Header.Values(h)
+		}
+	}
+	hash := sha256.Sum256(body)
+	creds := aws.Credentials{AccessKeyID: ts.AccessKeyId, SecretAccessKey: ts.SecretAccessKey}
+	if err := v4.NewSigner().SignHTTP(r.Context(), creds, check, hex.EncodeToString(hash[:]), "sts", ts.Region, date); err != nil {
+		ts.writeError(w, http.StatusBadRequest, "InvalidRequest", err.Error())
+		return
+	}
+	if check.Header.Get("Authorization") != auth {
+		ts.writeError(w, http.StatusForbidden, "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.")
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil || form.Get("Action") != "AssumeRole" || form.Get("Version") != stsApiVersion {
+		ts.writeError(w, http.StatusBadRequest, "InvalidAction", "Could not find operation")
+		return
+	}
+	d, err := strconv.ParseInt(form.Get("DurationSeconds"), 10, 64)
+	if err != nil || d < int64(minDuration/time.Second) || d > int64(maxDuration/time.Second) {
+		ts.writeError(w, http.StatusBadRequest, "ValidationError", "invalid DurationSeconds")
+		return
+	}
+	name := form.Get("RoleSessionName")
+	if len(name) < 2 || len(name) > 64 || invalidSessionNameChars.MatchString(name) {
+		ts.writeError(w, http.StatusBadRequest, "ValidationError", "invalid RoleSessionName")
+		return
+	}
+
+	ts.mu.Lock()
+	ts.requests = append(ts.requests, TestAssumeRoleRequest{
+		RoleArn:         form.Get("RoleArn"),
+		RoleSessionName: name,
+		Policy:          form.Get("Policy"),
+		DurationSeconds: d,
+	})
+	n := len(ts.requests)
+	keyId := fmt.Sprintf("ASIATEST%012d", n)
+	ts.issued[keyId] = true
+	ts.mu.Unlock()
+
+	resp := testAssumeRoleResponse{
+		Credentials: testCredentials{
+			AccessKeyId:     keyId,
+			SecretAccessKey: fmt.Sprintf("secret-%d", n),
+			SessionToken:    fmt.Sprintf("session-token-%d", n),
+			Expiration:      time.Now().Add(time.Duration(d) * time.Second).UTC().Truncate(time.Second),
+		},
+	}
+	w.Header().Set("Content-Type", "text/xml")
+	_ = xml.NewEncoder(w).Encode(resp)
+}
+
+func (ts *TestServer) handleFederation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Query().Get("Action") != "getSigninToken" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	var session map[string]string
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("Session")), &session); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	ts.mu.Lock()
+	ok := ts.issued[session["sessionId"]]
+	ts.mu.Unlock()
+	if !ok || session["sessionKey"] == "" || session["sessionToken"] == "" {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"SigninToken": "signin-" + session["sessionId"],
+	})
+}
@@ -7,6 +7,7 @@ package credential
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/boundary"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -30,11 +31,13 @@ type Type string
 
 // Credential type values.
 const (
-	UnspecifiedType      Type = "unspecified"
-	UsernamePasswordType Type = "username_password"
-	SshPrivateKeyType    Type = "ssh_private_key"
-	SshCertificateType   Type = "ssh_certificate"
-	JsonType             Type = "json"
+	UnspecifiedType          Type = "unspecified"
+	UsernamePasswordType     Type = "username_password"
+	SshPrivateKeyType        Type = "ssh_private_key"
+	SshCertificateType       Type = "ssh_certificate"
+	JsonType                 Type = "json"
+	TlsClientCertificateType Type = "tls_client_certificate"
+	AwsCredentialType        Type = "aws_credential"
 )
 
 // A Library is a resource that provides credentials that are of the same
@@ -154,3 +157,27 @@ type SshPrivateKey interface {
 	PrivateKey() PrivateKey
 	PrivateKeyPassphrase() []byte
 }
+
+// TlsClientCertificate is a credential containing a PEM encoded client
+// certificate chain, the PEM encoded private key of the leaf certificate,
+// an optional private key passphrase and an optional PEM encoded CA bundle.
+type TlsClientCertificate interface {
+	Credential
+	Certificate() []byte
+	PrivateKey() PrivateKey
+	PrivateKeyPassphrase() []byte
+	CaBundle() []byte
+}
+
+// AwsCredential is a credential containing temporary AWS security
+// credentials: an access key id, a secret access key and a session token.
+// SigninUrl returns an empty string unless a federated sign-in url was
+// requested.
+type AwsCredential interface {
+	Credential
+	AccessKeyId() string
+	SecretAccessKey() string
+	SessionToken() string
+	Expiration() time.Time
+	SigninUrl() string
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// minTokenTtl is the shortest lifetime the Kubernetes API server accepts
+// for a requested service account token.
+const minTokenTtl = 10 * time.Minute
+
+// clientTimeout bounds the time a single call to the Kubernetes API server
+// may take.
+const clientTimeout = 30 * time.Second
+
+type clientConfig struct {
+	ApiUrl string
+	Token  []byte
+	CaCert []byte
+}
+
+type client struct {
+	cl     *http.Client
+	apiUrl string
+	token  []byte
+}
+
+func newClient(ctx context.Context, c *clientConfig) (*client, error) {
+	const op = "kubernetes.newClient"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing client config")
+	case c.ApiUrl == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing api url")
+	case len(c.Token) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	}
+	u, err := url.Parse(c.ApiUrl)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported api url scheme: %q", u.Scheme))
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(c.CaCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(c.CaCert) {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to parse ca certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &client{
+		cl: &http.Client{
+			Transport: transport,
+			Timeout:   clientTimeout,
+		},
+		apiUrl: strings.TrimSuffix(c.ApiUrl, "/"),
+		token:  c.Token,
+	}, nil
+}
+
+// tokenRequest is the subset of the Kubernetes authentication.k8s.io/v1
+// TokenRequest resource used by the client.
+type tokenRequest struct {
+	ApiVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Spec       tokenRequestSpec   `json:"spec"`
+	Status     tokenRequestStatus `json:"status,omitempty"`
+}
+
+type tokenRequestSpec struct {
+	Audiences         []string `json:"audiences,omitempty"`
+	ExpirationSeconds int64    `json:"expirationSeconds,omitempty"`
+}
+
+type tokenRequestStatus struct {
+	Token               string    `json:"token"`
+	ExpirationTimestamp time.Time `json:"expirationTimestamp"`
+}
+
+// requestToken calls the TokenRequest API of the Kubernetes API server to
+// create a token for serviceAccount in namespace. It returns the token and
+// its expiration time as reported by the API server. See
+// https://kubernetes.io/docs/reference/kubernetes-api/authentication-resources/token-request-v1/.
+func (c *client) requestToken(ctx context.Context, namespace, serviceAccount, audience string, ttl time.Duration) (string, time.Time, error) {
+	const op = "kubernetes.(client).requestToken"
+	switch {
+	case namespace == "":
+		return "", time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing namespace")
+	case serviceAccount == "":
+		return "", time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing service account")
+	}
+	if ttl < minTokenTtl {
+		ttl = minTokenTtl
+	}
+
+	tr := tokenRequest{
+		ApiVersion: "authentication.k8s.io/v1",
+		Kind:       "TokenRequest",
+		Spec: tokenRequestSpec{
+			ExpirationSeconds: int64(ttl / time.Second),
+		},
+	}
+	if audience != "" {
+		tr.Spec.Audiences = []string{audience}
+	}
+	body, err := json.Marshal(tr)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(ctx, err, op)
+	}
+
+	u := fmt.Sprintf("%s/api/v1/namespaces/%s/serviceaccounts/%s/token", c.apiUrl, url.PathEscape(namespace), url.PathEscape(serviceAccount))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	req.Header.Set("Authorization", "Bearer "+string(c.token))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.cl.Do(req)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unavailable), errors.WithMsg(fmt.Sprintf("kubernetes: %s", c.apiUrl)))
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", time.Time{}, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("kubernetes (%s): token request for %s/%s failed: %s: %s", c.apiUrl, namespace, serviceAccount, resp.Status, strings.TrimSpace(string(respBody))))
+	}
+
+	var got tokenRequest
+	if err := json.Unmarshal(respBody, &got); err != nil {
+		return "", time.Time{}, errors.Wrap(ctx, err, op, errors.WithMsg("unable to decode token request response"))
+	}
+	if got.Status.Token == "" {
+		return "", time.Time{}, errors.New(ctx, errors.Unknown, op, "kubernetes: token request returned no token")
+	}
+	return got.Status.Token, got.Status.ExpirationTimestamp, nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestServer(t, "admin-token")
+
+	tests := []struct {
+		name    string
+		in      *clientConfig
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-config",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-api-url",
+			in:      &clientConfig{Token: []byte("admin-token")},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "no-token",
+			in:      &clientConfig{ApiUrl: ts.URL},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "bad-scheme",
+			in:      &clientConfig{ApiUrl: "ftp://example.com", Token: []byte("admin-token")},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "bad-ca-cert",
+			in:      &clientConfig{ApiUrl: ts.URL, Token: []byte("admin-token"), CaCert: []byte("not a cert")},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			in:   &clientConfig{ApiUrl: ts.URL, Token: []byte("admin-token"), CaCert: ts.CaCert},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := newClient(ctx, tt.in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotNil(got)
+		})
+	}
+}
+
+func TestClient_RequestToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "admin-token")
+		cl, err := newClient(ctx, &clientConfig{ApiUrl: ts.URL, Token: []byte("admin-token"), CaCert: ts.CaCert})
+		require.NoError(err)
+
+		before := time.Now()
+		token, exp, err := cl.requestToken(ctx, "default", "deployer", "https://kubernetes.default.svc", time.Hour)
+		require.NoError(err)
+		assert.Equal("token-default-deployer-1", token)
+		assert.WithinDuration(before.Add(time.Hour), exp, 5*time.Second)
+
+		reqs := ts.Requests()
+		require.Len(reqs, 1)
+		assert.Equal(TestTokenRequest{
+			Namespace:         "default",
+			ServiceAccount:    "deployer",
+			Audiences:         []string{"https://kubernetes.default.svc"},
+			ExpirationSeconds: 3600,
+		}, reqs[0])
+	})
+
+	t.Run("ttl-below-minimum", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "admin-token")
+		cl, err := newClient(ctx, &clientConfig{ApiUrl: ts.URL, Token: []byte("admin-token"), CaCert: ts.CaCert})
+		require.NoError(err)
+
+		_, _, err = cl.requestToken(ctx, "default", "deployer", "", time.Minute)
+		require.NoError(err)
+		reqs := ts.Requests()
+		require.Len(reqs, 1)
+		assert.Equal(int64(minTokenTtl/time.Second), reqs[0].ExpirationSeconds)
+		assert.Empty(reqs[0].Audiences)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "admin-token")
+		cl, err := newClient(ctx, &clientConfig{ApiUrl: ts.URL, Token: []byte("wrong-token"), CaCert: ts.CaCert})
+		require.NoError(err)
+
+		token, _, err := cl.requestToken(ctx, "default", "deployer", "", time.Hour)
+		require.Error(err)
+		assert.Empty(token)
+		assert.Contains(err.Error(), "401")
+		assert.Empty(ts.Requests())
+	})
+
+	t.Run("untrusted-server", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "admin-token")
+		cl, err := newClient(ctx, &clientConfig{ApiUrl: ts.URL, Token: []byte("admin-token")})
+		require.NoError(err)
+
+		_, _, err = cl.requestToken(ctx, "default", "deployer", "", time.Hour)
+		require.Error(err)
+		assert.Empty(ts.Requests())
+	})
+
+	t.Run("missing-namespace", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ts := NewTestServer(t, "admin-token")
+		cl, err := newClient(ctx, &clientConfig{ApiUrl: ts.URL, Token: []byte("admin-token"), CaCert: ts.CaCert})
+		require.NoError(err)
+
+		_, _, err = cl.requestToken(ctx, "", "deployer", "", time.Hour)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+	})
+}
+
+func TestKubeconfig(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	cs, err := NewCredentialStore("p_1234567890", "https://kube.example.com:6443", []byte("admin-token"), WithCaCert([]byte("ca")))
+	require.NoError(t, err)
+	lib, err := NewCredentialLibrary("cskube_1234567890", "apps", "deployer")
+	require.NoError(t, err)
+
+	got := kubeconfig(cs, lib, "sa-token")
+	assert.Equal("v1", got["apiVersion"])
+	assert.Equal("Config", got["kind"])
+	assert.Equal("boundary", got["current-context"])
+	assert.Equal([]any{map[string]any{
+		"name": "boundary",
+		"cluster": map[string]any{
+			"server":                     "https://kube.example.com:6443",
+			"certificate-authority-data": "Y2E=",
+		},
+	}}, got["clusters"])
+	assert.Equal([]any{map[string]any{
+		"name": "boundary",
+		"user": map[string]any{"token": "sa-token"},
+	}}, got["users"])
+	assert.Equal([]any{map[string]any{
+		"name": "boundary",
+		"context": map[string]any{
+			"cluster":   "boundary",
+			"user":      "boundary",
+			"namespace": "apps",
+		},
+	}}, got["contexts"])
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/kubernetes/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// A Credential records a service account token issued by a kubernetes
+// credential library for a session. The token itself is not stored.
+type Credential struct {
+	*store.Credential
+	tableName string `gorm:"-"`
+}
+
+func newCredential(ctx context.Context, libraryId, sessionId string, expiration time.Time) (*Credential, error) {
+	const op = "kubernetes.newCredential"
+	switch {
+	case libraryId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no library id")
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	case expiration.IsZero():
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no expiration time")
+	}
+
+	c := &Credential{
+		Credential: &store.Credential{
+			LibraryId:      libraryId,
+			SessionId:      sessionId,
+			ExpirationTime: timestamp.New(expiration),
+		},
+	}
+	return c, nil
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_kubernetes_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+var _ credential.Dynamic = (*baseCred)(nil)
+
+// baseCred is a service account token issued by a kubernetes credential
+// library along with the kubeconfig built for it.
+type baseCred struct {
+	*Credential
+	lib        *CredentialLibrary
+	purpose    credential.Purpose
+	secretData map[string]any
+}
+
+func (bc *baseCred) GetPublicId() string           { return bc.PublicId }
+func (bc *baseCred) GetSessionId() string          { return bc.SessionId }
+func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
+func (bc *baseCred) Library() credential.Library   { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose   { return bc.purpose }
+
+// kubeconfig returns a kubeconfig, as a JSON compatible map, for accessing
+// the API server of cs in the namespace of lib with token.
+func kubeconfig(cs *CredentialStore, lib *CredentialLibrary, token string) map[string]any {
+	const name = "boundary"
+	cluster := map[string]any{
+		"server": cs.GetApiUrl(),
+	}
+	if len(cs.GetCaCert()) > 0 {
+		cluster["certificate-authority-data"] = base64.StdEncoding.EncodeToString(cs.GetCaCert())
+	}
+	return map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Config",
+		"clusters": []any{
+			map[string]any{
+				"name":    name,
+				"cluster": cluster,
+			},
+		},
+		"users": []any{
+			map[string]any{
+				"name": name,
+				"user": map[string]any{
+					"token": token,
+				},
+			},
+		},
+		"contexts": []any{
+			map[string]any{
+				"name": name,
+				"context": map[string]any{
+					"cluster":   name,
+					"user":      name,
+					"namespace": lib.GetNamespace(),
+				},
+			},
+		},
+		"current-context": name,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/kubernetes/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialLibrary issues tokens for a Kubernetes service account. It is
+// owned by a kubernetes credential store.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary assigned
+// to storeId which issues tokens for the service account serviceAccount in
+// namespace. Name, description, audience and ttl seconds are the only
+// valid options. All other options are ignored.
+func NewCredentialLibrary(storeId, namespace, serviceAccount string, opt ...Option) (*CredentialLibrary, error) {
+	opts := getOpts(opt...)
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:        storeId,
+			Name:           opts.withName,
+			Description:    opts.withDescription,
+			Namespace:      namespace,
+			ServiceAccount: serviceAccount,
+			Audience:       opts.withAudience,
+			TtlSeconds:     opts.withTtlSeconds,
+			CredentialType: string(credential.JsonType),
+		},
+	}
+	return l, nil
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	return &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_kubernetes_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-kubernetes-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library issues. A
+// kubernetes credential library always issues json credentials.
+func (l *CredentialLibrary) CredentialType() credential.Type {
+	return credential.JsonType
+}
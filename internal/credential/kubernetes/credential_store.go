@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/kubernetes/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStore contains kubernetes credential libraries. It holds the
+// address of a Kubernetes API server and a token which is allowed to
+// request service account tokens from it. It is owned by a project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+}
+
+var _ credential.Store = (*CredentialStore)(nil)
+
+// NewCredentialStore creates a new in memory CredentialStore for a
+// Kubernetes API server at apiUrl assigned to projectId. Name, description
+// and CA certificate are the only valid options. All other options are
+// ignored.
+func NewCredentialStore(projectId, apiUrl string, token []byte, opt ...Option) (*CredentialStore, error) {
+	opts := getOpts(opt...)
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:   projectId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			ApiUrl:      apiUrl,
+			CaCert:      opts.withCaCert,
+			Token:       token,
+		},
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	return &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+	}
+}
+
+// TableName returns the table name.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_kubernetes_store"
+}
+
+// SetTableName sets the table name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"credential-kubernetes-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
+
+func (cs *CredentialStore) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "kubernetes.(CredentialStore).encrypt"
+	if len(cs.Token) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no token defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, cs.CredentialStore, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	cs.KeyId = keyId
+	if err := cs.hmacToken(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (cs *CredentialStore) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "kubernetes.(CredentialStore).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, cs.CredentialStore, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (cs *CredentialStore) hmacToken(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "kubernetes.(CredentialStore).hmacToken"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, cs.Token, cipher, []byte(cs.PublicId), nil, crypto.WithEd25519())
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	cs.TokenHmac = []byte(hm)
+	return nil
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package kubernetes provides short-lived service account tokens issued by
+// a Kubernetes API server using the TokenRequest API. Each token is returned
+// to the session as a JSON credential shaped like a kubeconfig file.
+package kubernetes
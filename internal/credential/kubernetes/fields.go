@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+// These constants are the field names used in the kubernetes related field
+// masks.
+const (
+	nameField           = "Name"
+	descriptionField    = "Description"
+	apiUrlField         = "ApiUrl"
+	caCertField         = "CaCert"
+	tokenField          = "Token"
+	serviceAccountField = "ServiceAccount"
+	namespaceField      = "Namespace"
+	audienceField       = "Audience"
+	ttlSecondsField     = "TtlSeconds"
+)
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withPublicId    string
+	withName        string
+	withDescription string
+	withCaCert      []byte
+	withAudience    string
+	withTtlSeconds  uint32
+	withLimit       int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(with string) Option {
+	return func(o *options) {
+		o.withPublicId = with
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithCaCert provides an optional PEM encoded CA certificate used to
+// verify the certificate of the Kubernetes API server.
+func WithCaCert(cert []byte) Option {
+	return func(o *options) {
+		o.withCaCert = cert
+	}
+}
+
+// WithAudience provides an optional audience for the tokens issued by a
+// credential library.
+func WithAudience(audience string) Option {
+	return func(o *options) {
+		o.withAudience = audience
+	}
+}
+
+// WithTtlSeconds provides an optional requested lifetime for the tokens
+// issued by a credential library.
+func WithTtlSeconds(ttl uint32) Option {
+	return func(o *options) {
+		o.withTtlSeconds = ttl
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
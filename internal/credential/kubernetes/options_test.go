@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("clkube_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "clkube_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithCaCert", func(t *testing.T) {
+		opts := getOpts(WithCaCert([]byte("ca-cert")))
+		testOpts := getDefaultOptions()
+		testOpts.withCaCert = []byte("ca-cert")
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAudience", func(t *testing.T) {
+		opts := getOpts(WithAudience("boundary"))
+		testOpts := getDefaultOptions()
+		testOpts.withAudience = "boundary"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithTtlSeconds", func(t *testing.T) {
+		opts := getOpts(WithTtlSeconds(3600))
+		testOpts := getDefaultOptions()
+		testOpts.withTtlSeconds = 3600
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(credential.Domain, Subtype, globals.KubernetesCredentialStorePrefix, globals.KubernetesCredentialLibraryPrefix, DynamicCredentialPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the kubernetes package.
+const (
+	// DynamicCredentialPrefix is the prefix for service account tokens
+	// issued by a kubernetes credential library.
+	DynamicCredentialPrefix = "cdkube"
+
+	Subtype = subtypes.Subtype("kubernetes")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	const op = "kubernetes.newCredentialStoreId"
+	id, err := db.NewPublicId(globals.KubernetesCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	const op = "kubernetes.newCredentialLibraryId"
+	id, err := db.NewPublicId(globals.KubernetesCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	const op = "kubernetes.newCredentialId"
+	id, err := db.NewPublicId(DynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+const (
+	sessionExpirationQuery = `
+select expiration_time
+  from session
+ where public_id = ?;
+`
+
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+
+	credKubernetesStoreRewrapQuery = `
+select public_id,
+       token,
+       key_id
+  from credential_kubernetes_store
+ where project_id = ?
+   and key_id = ?;
+`
+)
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// defaultTokenTtl is the lifetime requested for a token when the library
+// does not specify one.
+const defaultTokenTtl = time.Hour
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue requests service account tokens from the Kubernetes API servers of
+// the libraries in requests and returns them, as kubeconfig shaped json
+// credentials, assigned to sessionId. The lifetime of each token is the
+// library's ttl bounded by the expiration time of the session.
+//
+// Supported options: credential.WithSessionExpiration
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, opt ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "kubernetes.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	libIds := make([]string, 0, len(requests))
+	for _, req := range requests {
+		libIds = append(libIds, req.SourceId)
+	}
+	var libs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &libs, "public_id in (?)", []any{libIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	libsById := make(map[string]*CredentialLibrary, len(libs))
+	for _, l := range libs {
+		libsById[l.GetPublicId()] = l
+	}
+
+	sessExp := opts.WithSessionExpiration
+	if sessExp.IsZero() {
+		if sessExp, err = r.sessionExpiration(ctx, sessionId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	stores := make(map[string]*CredentialStore)
+	var creds []credential.Dynamic
+	for _, req := range requests {
+		lib, ok := libsById[req.SourceId]
+		if !ok {
+			return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+		}
+		cs, ok := stores[lib.GetStoreId()]
+		if !ok {
+			if cs, err = r.getDecryptedStore(ctx, lib.GetStoreId()); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			stores[lib.GetStoreId()] = cs
+		}
+
+		ttl := defaultTokenTtl
+		if lib.GetTtlSeconds() > 0 {
+			ttl = time.Duration(lib.GetTtlSeconds()) * time.Second
+		}
+		if remaining := time.Until(sessExp).Truncate(time.Second); remaining < ttl {
+			ttl = remaining
+		}
+
+		cl, err := newClient(ctx, &clientConfig{
+			ApiUrl: cs.GetApiUrl(),
+			Token:  cs.GetToken(),
+			CaCert: cs.GetCaCert(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		token, exp, err := cl.requestToken(ctx, lib.GetNamespace(), lib.GetServiceAccount(), lib.GetAudience(), ttl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to issue token for credential library %s", lib.GetPublicId())))
+		}
+		if exp.IsZero() {
+			exp = time.Now().Add(ttl)
+		}
+
+		c, err := newCredential(ctx, lib.GetPublicId(), sessionId, exp)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if c.PublicId, err = newCredentialId(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+
+		if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				if err := w.Create(ctx, c.clone()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsUpdated, err := w.Exec(ctx, updateSessionCredentialQuery, []any{
+					sql.Named("public_id", c.PublicId),
+					sql.Named("library_id", c.LibraryId),
+					sql.Named("session_id", sessionId),
+					sql.Named("purpose", string(req.Purpose)),
+				})
+				switch {
+				case err != nil:
+					return errors.Wrap(ctx, err, op)
+				case rowsUpdated == 0:
+					return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+				case rowsUpdated > 1:
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+				}
+				return nil
+			},
+		); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+
+		creds = append(creds, &baseCred{
+			Credential: c,
+			lib:        lib,
+			purpose:    req.Purpose,
+			secretData: kubeconfig(cs, lib, token),
+		})
+	}
+	return creds, nil
+}
+
+// getDecryptedStore returns the credential store for storeId with its
+// token decrypted.
+func (r *Repository) getDecryptedStore(ctx context.Context, storeId string) (*CredentialStore, error) {
+	const op = "kubernetes.(Repository).getDecryptedStore"
+	cs, err := r.LookupCredentialStore(ctx, storeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cs == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential store %s not found", storeId))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, cs.GetProjectId(), kms.KeyPurposeDatabase, kms.WithKeyId(cs.GetKeyId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := cs.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return cs, nil
+}
+
+// sessionExpiration returns the expiration time of sessionId.
+func (r *Repository) sessionExpiration(ctx context.Context, sessionId string) (time.Time, error) {
+	const op = "kubernetes.(Repository).sessionExpiration"
+	rows, err := r.reader.Query(ctx, sessionExpirationQuery, []any{sessionId})
+	if err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return time.Time{}, errors.Wrap(ctx, err, op)
+		}
+		return time.Time{}, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session %s not found", sessionId))
+	}
+	var exp time.Time
+	if err := rows.Scan(&exp); err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	return exp, nil
+}
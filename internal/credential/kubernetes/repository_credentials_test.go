@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Issue(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ts := NewTestServer(t, "admin-token")
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in, err := NewCredentialStore(prj.GetPublicId(), ts.URL, []byte("admin-token"), WithCaCert(ts.CaCert))
+	require.NoError(t, err)
+	cs, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+	assert.Empty(t, cs.GetToken())
+	assert.NotEmpty(t, cs.GetCtToken())
+	assert.NotEmpty(t, cs.GetTokenHmac())
+
+	lib, err := NewCredentialLibrary(cs.GetPublicId(), "apps", "deployer", WithAudience("boundary"), WithTtlSeconds(3600))
+	require.NoError(t, err)
+	lib, err = repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), lib)
+	require.NoError(t, err)
+	require.NotNil(t, lib)
+	assert.Equal(t, credential.JsonType, lib.CredentialType())
+
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	requests := []credential.Request{
+		{
+			SourceId: lib.GetPublicId(),
+			Purpose:  credential.BrokeredPurpose,
+		},
+	}
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+		DynamicCredentials: []*session.DynamicCredential{
+			session.NewDynamicCredential(lib.GetPublicId(), credential.BrokeredPurpose),
+		},
+	})
+
+	_, err = repo.Issue(ctx, "", requests)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = repo.Issue(ctx, sess.GetPublicId(), nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	sessExp := time.Now().Add(30 * time.Minute)
+	creds, err := repo.Issue(ctx, sess.GetPublicId(), requests, credential.WithSessionExpiration(sessExp))
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	got := creds[0]
+	assert.Equal(t, sess.GetPublicId(), got.GetSessionId())
+	assert.Equal(t, lib.GetPublicId(), got.Library().GetPublicId())
+	assert.Equal(t, credential.BrokeredPurpose, got.Purpose())
+
+	// The ttl of the library is bounded by the session expiration.
+	reqs := ts.Requests()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "apps", reqs[0].Namespace)
+	assert.Equal(t, "deployer", reqs[0].ServiceAccount)
+	assert.Equal(t, []string{"boundary"}, reqs[0].Audiences)
+	assert.InDelta(t, (30 * time.Minute).Seconds(), reqs[0].ExpirationSeconds, 5)
+
+	secret, ok := got.Secret().(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "Config", secret["kind"])
+	users := secret["users"].([]any)
+	require.Len(t, users, 1)
+	assert.Equal(t, "token-apps-deployer-1", users[0].(map[string]any)["user"].(map[string]any)["token"])
+
+	c := allocCredential()
+	c.PublicId = got.GetPublicId()
+	require.NoError(t, rw.LookupByPublicId(ctx, c))
+	assert.Equal(t, lib.GetPublicId(), c.GetLibraryId())
+	assert.WithinDuration(t, sessExp, c.GetExpirationTime().AsTime(), 5*time.Second)
+}
+
+func TestRepository_UpdateCredentialStore_Token(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ts := NewTestServer(t, "admin-token")
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), ts.URL, []byte("old-token"), WithCaCert(ts.CaCert))
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in := cs.clone()
+	in.Token = nil
+	_, _, err = repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{tokenField})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	in.ApiUrl = ""
+	_, _, err = repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{apiUrlField})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	in = cs.clone()
+	in.Token = []byte("admin-token")
+	got, n, err := repo.UpdateCredentialStore(ctx, in, cs.GetVersion(), []string{tokenField})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Empty(t, got.GetToken())
+	assert.NotEqual(t, cs.GetTokenHmac(), got.GetTokenHmac())
+
+	decrypted, err := repo.getDecryptedStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, []byte("admin-token"), decrypted.GetToken())
+}
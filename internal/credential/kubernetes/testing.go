@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a kubernetes credential store in the provided
+// DB with the provided project id, api url and token. The token is encrypted
+// with the database key of the project. If any errors are encountered
+// during the creation of the store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, wrapper wrapping.Wrapper, projectId, apiUrl string, token []byte, opt ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	w := db.New(conn)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	require.NotNil(t, databaseWrapper)
+
+	cs, err := NewCredentialStore(projectId, apiUrl, token, opt...)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+
+	opts := getOpts(opt...)
+	id := opts.withPublicId
+	if id == "" {
+		id, err = newCredentialStoreId(ctx)
+		require.NoError(t, err)
+	}
+	cs.PublicId = id
+	require.NoError(t, cs.encrypt(ctx, databaseWrapper))
+
+	require.NoError(t, w.Create(ctx, cs))
+	return cs
+}
+
+// TestCredentialLibraries creates count number of kubernetes credential
+// libraries in the provided DB with the provided store id, namespace and
+// service account. If any errors are encountered during the creation of the
+// credential libraries, the test will fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId, namespace, serviceAccount string, count int, opt ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(storeId, namespace, serviceAccount, opt...)
+		require.NoError(t, err)
+		require.NotNil(t, lib)
+
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
+
+// TestTokenRequest records a TokenRequest received by a TestServer.
+type TestTokenRequest struct {
+	Namespace         string
+	ServiceAccount    string
+	Audiences         []string
+	ExpirationSeconds int64
+}
+
+// TestServer is a fake Kubernetes API server which only implements the
+// TokenRequest API. It accepts requests authenticated with Token and
+// issues tokens for any service account.
+type TestServer struct {
+	*httptest.Server
+
+	// CaCert is the PEM encoded certificate of the server.
+	CaCert []byte
+	// Token is the bearer token the server accepts.
+	Token string
+
+	mu       sync.Mutex
+	requests []TestTokenRequest
+}
+
+// NewTestServer starts a TLS TestServer which accepts token. The server is
+// closed when the test completes.
+func NewTestServer(t testing.TB, token string) *TestServer {
+	t.Helper()
+	ts := &TestServer{
+		Token: token,
+	}
+	ts.Server = httptest.NewTLSServer(http.HandlerFunc(ts.handleTokenRequest))
+	t.Cleanup(ts.Close)
+	ts.CaCert = pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: ts.Certificate().Raw,
+	})
+	return ts
+}
+
+// Requests returns the TokenRequests received by the server.
+func (ts *TestServer) Requests() []TestTokenRequest {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return append([]TestTokenRequest(nil), ts.requests...)
+}
+
+func (ts *TestServer) handleTokenRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+ts.Token {
+		http.Error(w, `{"kind":"Status","reason":"Unauthorized","code":401}`, http.StatusUnauthorized)
+		return
+	}
+	// /api/v1/namespaces/{namespace}/serviceaccounts/{name}/token
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if r.Method != http.MethodPost || len(parts) != 7 ||
+		parts[0] != "api" || parts[1] != "v1" || parts[2] != "namespaces" ||
+		parts[4] != "serviceaccounts" || parts[6] != "token" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var tr tokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&tr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if tr.Spec.ExpirationSeconds < int64(minTokenTtl/time.Second) {
+		http.Error(w, `{"kind":"Status","reason":"Invalid","code":422}`, http.StatusUnprocessableEntity)
+		return
+	}
+
+	ts.mu.Lock()
+	ts.requests = append(ts.requests, TestTokenRequest{
+		Namespace:         parts[3],
+		ServiceAccount:    parts[5],
+		Audiences:         tr.Spec.Audiences,
+		ExpirationSeconds: tr.Spec.ExpirationSeconds,
+	})
+	n := len(ts.requests)
+	ts.mu.Unlock()
+
+	tr.Status = tokenRequestStatus{
+		Token:               fmt.Sprintf("token-%s-%s-%d", parts[3], parts[5], n),
+		ExpirationTimestamp: time.Now().Add(time.Duration(tr.Spec.ExpirationSeconds) * time.Second).UTC().Truncate(time.Second),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(tr)
+}
@@ -4,6 +4,8 @@
 package credential
 
 import (
+	"time"
+
 	"github.com/hashicorp/boundary/internal/util/template"
 )
 
@@ -26,7 +28,8 @@ type Option func(*options) error
 
 // options = how options are represented
 type options struct {
-	WithTemplateData template.Data
+	WithTemplateData      template.Data
+	WithSessionExpiration time.Time
 }
 
 func getDefaultOptions() *options {
@@ -40,3 +43,13 @@ func WithTemplateData(with template.Data) Option {
 		return nil
 	}
 }
+
+// WithSessionExpiration provides the expiration time of the session
+// credentials are issued for. Issuers may use it to bound the lifetime of
+// the credentials they issue.
+func WithSessionExpiration(with time.Time) Option {
+	return func(o *options) error {
+		o.WithSessionExpiration = with
+		return nil
+	}
+}
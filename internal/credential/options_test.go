@@ -5,6 +5,7 @@ package credential
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/boundary/internal/util/template"
@@ -21,4 +22,12 @@ func Test_GetOpts(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "foo", *opts.WithTemplateData.User.Id)
 	})
+	t.Run("WithSessionExpiration", func(t *testing.T) {
+		opts := getDefaultOptions()
+		assert.Zero(t, opts.WithSessionExpiration)
+		exp := time.Now().Add(time.Hour)
+		opts, err := GetOpts(WithSessionExpiration(exp))
+		require.NoError(t, err)
+		assert.Equal(t, exp, opts.WithSessionExpiration)
+	})
 }
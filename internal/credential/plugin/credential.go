@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/db/sanitize"
+	"github.com/hashicorp/boundary/internal/db/sentinel"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStatus represents the status of a credential issued by a
+// credential plugin.
+type CredentialStatus string
+
+const (
+	// ActiveCredential represents a credential that is being used in an
+	// active session.
+	ActiveCredential CredentialStatus = "active"
+
+	// RevokeCredential represents a credential that needs to be revoked by
+	// the plugin.
+	RevokeCredential CredentialStatus = "revoke"
+
+	// RevokedCredential represents a credential that has been revoked. This
+	// is a terminal status.
+	RevokedCredential CredentialStatus = "revoked"
+
+	// UnknownCredentialStatus represents a credential that has an unknown
+	// status.
+	UnknownCredentialStatus CredentialStatus = "unknown"
+)
+
+// A Credential contains the data for a credential issued by a credential
+// plugin for a session. It is owned by a credential library.
+type Credential struct {
+	*store.Credential
+	tableName string `gorm:"-"`
+}
+
+func newCredential(ctx context.Context, libraryId, sessionId, externalId string, revocable bool) (*Credential, error) {
+	const op = "plugin.newCredential"
+	switch {
+	case libraryId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no library id")
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+
+	externalId = sanitize.String(externalId)
+	if externalId == "" {
+		externalId = sentinel.ExternalIdNone
+		revocable = false
+	}
+	// Credentials which cannot be revoked by the plugin are never tracked
+	// by the revocation job.
+	status := string(ActiveCredential)
+	if !revocable {
+		status = string(UnknownCredentialStatus)
+	}
+
+	c := &Credential{
+		Credential: &store.Credential{
+			LibraryId:   libraryId,
+			SessionId:   sessionId,
+			ExternalId:  externalId,
+			IsRevocable: revocable,
+			Status:      status,
+		},
+	}
+	return c, nil
+}
+
+func allocCredential() *Credential {
+	return &Credential{
+		Credential: &store.Credential{},
+	}
+}
+
+func (c *Credential) clone() *Credential {
+	cp := proto.Clone(c.Credential)
+	return &Credential{
+		Credential: cp.(*store.Credential),
+	}
+}
+
+// TableName returns the table name.
+func (c *Credential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_plugin_credential"
+}
+
+// SetTableName sets the table name.
+func (c *Credential) SetTableName(n string) {
+	c.tableName = n
+}
+
+var _ credential.Dynamic = (*baseCred)(nil)
+
+// baseCred is a credential issued by a credential plugin along with the
+// secret data returned by the plugin.
+type baseCred struct {
+	*Credential
+	lib        *CredentialLibrary
+	purpose    credential.Purpose
+	secretData map[string]any
+}
+
+func (bc *baseCred) GetPublicId() string           { return bc.PublicId }
+func (bc *baseCred) GetSessionId() string          { return bc.SessionId }
+func (bc *baseCred) Secret() credential.SecretData { return bc.secretData }
+func (bc *baseCred) Library() credential.Library   { return bc.lib }
+func (bc *baseCred) Purpose() credential.Purpose   { return bc.purpose }
+
+var _ credential.UsernamePassword = (*usrPassCred)(nil)
+
+type usrPassCred struct {
+	*baseCred
+	username string
+	password credential.Password
+}
+
+func (c *usrPassCred) Username() string              { return c.username }
+func (c *usrPassCred) Password() credential.Password { return c.password }
+
+var _ credential.SshPrivateKey = (*sshPrivateKeyCred)(nil)
+
+type sshPrivateKeyCred struct {
+	*baseCred
+	username   string
+	privateKey credential.PrivateKey
+	passphrase []byte
+}
+
+func (c *sshPrivateKeyCred) Username() string                  { return c.username }
+func (c *sshPrivateKeyCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshPrivateKeyCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
+
+var _ credential.TlsClientCertificate = (*tlsClientCertCred)(nil)
+
+type tlsClientCertCred struct {
+	*baseCred
+	certificate []byte
+	privateKey  credential.PrivateKey
+	passphrase  []byte
+	caBundle    []byte
+}
+
+func (c *tlsClientCertCred) Certificate() []byte               { return c.certificate }
+func (c *tlsClientCertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *tlsClientCertCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
+func (c *tlsClientCertCred) CaBundle() []byte                  { return c.caBundle }
+
+// convert returns bc as the typed credential of its library's credential
+// type. The secret data returned by the plugin for a typed credential must
+// contain the fields of that type.
+func convert(ctx context.Context, bc *baseCred) (credential.Dynamic, error) {
+	const op = "plugin.convert"
+	stringField := func(name string, required bool) (string, error) {
+		v, ok := bc.secretData[name]
+		if !ok {
+			if required {
+				return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential for library %s is missing %q", bc.lib.GetPublicId(), name))
+			}
+			return "", nil
+		}
+		s, ok := v.(string)
+		if !ok {
+			return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential for library %s has a non-string %q", bc.lib.GetPublicId(), name))
+		}
+		return s, nil
+	}
+
+	switch bc.lib.CredentialType() {
+	case credential.UsernamePasswordType:
+		username, err := stringField("username", true)
+		if err != nil {
+			return nil, err
+		}
+		password, err := stringField("password", true)
+		if err != nil {
+			return nil, err
+		}
+		return &usrPassCred{
+			baseCred: bc,
+			username: username,
+			password: credential.Password(password),
+		}, nil
+	case credential.SshPrivateKeyType:
+		username, err := stringField("username", true)
+		if err != nil {
+			return nil, err
+		}
+		pk, err := stringField("private_key", true)
+		if err != nil {
+			return nil, err
+		}
+		passphrase, err := stringField("private_key_passphrase", false)
+		if err != nil {
+			return nil, err
+		}
+		return &sshPrivateKeyCred{
+			baseCred:   bc,
+			username:   username,
+			privateKey: credential.PrivateKey(pk),
+			passphrase: []byte(passphrase),
+		}, nil
+	case credential.TlsClientCertificateType:
+		cert, err := stringField("certificate", true)
+		if err != nil {
+			return nil, err
+		}
+		pk, err := stringField("private_key", true)
+		if err != nil {
+			return nil, err
+		}
+		passphrase, err := stringField("private_key_passphrase", false)
+		if err != nil {
+			return nil, err
+		}
+		caBundle, err := stringField("ca_bundle", false)
+		if err != nil {
+			return nil, err
+		}
+		c := &tlsClientCertCred{
+			baseCred:    bc,
+			certificate: []byte(cert),
+			privateKey:  credential.PrivateKey(pk),
+		}
+		if passphrase != "" {
+			c.passphrase = []byte(passphrase)
+		}
+		if caBundle != "" {
+			c.caBundle = []byte(caBundle)
+		}
+		return c, nil
+	}
+	return bc, nil
+}
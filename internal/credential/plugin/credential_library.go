@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialLibrary contains the attributes passed to a credential plugin
+// when credentials are issued from it. It is owned by a plugin credential
+// store.
+type CredentialLibrary struct {
+	*store.CredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+var _ credential.Library = (*CredentialLibrary)(nil)
+
+// NewCredentialLibrary creates a new in memory CredentialLibrary assigned to
+// storeId. Name, description, attributes and credential type are the only
+// valid options. All other options are ignored.
+func NewCredentialLibrary(ctx context.Context, storeId string, opt ...Option) (*CredentialLibrary, error) {
+	const op = "plugin.NewCredentialLibrary"
+	opts := getOpts(opt...)
+
+	attrs, err := proto.Marshal(opts.withAttributes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+
+	l := &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{
+			StoreId:        storeId,
+			Name:           opts.withName,
+			Description:    opts.withDescription,
+			Attributes:     attrs,
+			CredentialType: string(opts.withCredentialType),
+		},
+	}
+	return l, nil
+}
+
+func allocCredentialLibrary() *CredentialLibrary {
+	return &CredentialLibrary{
+		CredentialLibrary: &store.CredentialLibrary{},
+	}
+}
+
+func (l *CredentialLibrary) clone() *CredentialLibrary {
+	cp := proto.Clone(l.CredentialLibrary)
+	ret := &CredentialLibrary{
+		CredentialLibrary: cp.(*store.CredentialLibrary),
+	}
+	// proto.Clone will convert slices with length and capacity of 0 to nil.
+	// Fix this since gorm treats empty slices differently than nil.
+	if l.Attributes != nil && len(l.Attributes) == 0 && ret.Attributes == nil {
+		ret.Attributes = []byte{}
+	}
+	return ret
+}
+
+// TableName returns the table name.
+func (l *CredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_plugin_library"
+}
+
+// SetTableName sets the table name.
+func (l *CredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+func (l *CredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-plugin-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library issues.
+func (l *CredentialLibrary) CredentialType() credential.Type {
+	switch ct := l.GetCredentialType(); ct {
+	case "":
+		return credential.UnspecifiedType
+	default:
+		return credential.Type(ct)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/plugin/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// A CredentialStore contains plugin credential libraries. It is owned by a
+// project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+
+	Secrets *structpb.Struct `gorm:"-"`
+}
+
+var _ credential.Store = (*CredentialStore)(nil)
+
+// NewCredentialStore creates a new in memory CredentialStore assigned to
+// projectId and pluginId. Name, description, attributes and secrets are the
+// only valid options. All other options are ignored.
+func NewCredentialStore(ctx context.Context, projectId, pluginId string, opt ...Option) (*CredentialStore, error) {
+	const op = "plugin.NewCredentialStore"
+	opts := getOpts(opt...)
+
+	attrs, err := proto.Marshal(opts.withAttributes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:   projectId,
+			PluginId:    pluginId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Attributes:  attrs,
+			SecretsHmac: opts.withSecretsHmac,
+		},
+		Secrets: opts.withSecrets,
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+// hmacSecrets before writing it to the db
+func (cs *CredentialStore) hmacSecrets(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "plugin.(CredentialStore).hmacSecrets"
+	if cs.Secrets == nil {
+		cs.SecretsHmac = nil
+		return nil
+	}
+	secretsMap := cs.Secrets.AsMap()
+	if len(secretsMap) == 0 {
+		cs.SecretsHmac = nil
+		return nil
+	}
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	// Go's JSON encoding is stable (that is, it alphabetizes keys) so it's a
+	// good option to produce an HMAC-able string.
+	jsonSecrets, err := json.Marshal(secretsMap)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	hm, err := crypto.HmacSha256(ctx, jsonSecrets, cipher, []byte(cs.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	cs.SecretsHmac = []byte(hm)
+	return nil
+}
+
+// clone provides a deep copy of the CredentialStore.
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	newSecret := proto.Clone(cs.Secrets)
+
+	ret := &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+		Secrets:         newSecret.(*structpb.Struct),
+	}
+	// proto.Clone will convert slices with length and capacity of 0 to nil.
+	// Fix this since gorm treats empty slices differently than nil.
+	if cs.Attributes != nil && len(cs.Attributes) == 0 && ret.Attributes == nil {
+		ret.Attributes = []byte{}
+	}
+	return ret
+}
+
+// TableName returns the table name for the credential store.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_plugin_store"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"credential-plugin-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
+
+type storeAgg struct {
+	PublicId            string `gorm:"primary_key"`
+	ProjectId           string
+	PluginId            string
+	Name                string
+	Description         string
+	CreateTime          *timestamp.Timestamp
+	UpdateTime          *timestamp.Timestamp
+	Version             uint32
+	SecretsHmac         []byte
+	Attributes          []byte
+	Secret              []byte
+	KeyId               string
+	PersistedCreateTime *timestamp.Timestamp
+	PersistedUpdateTime *timestamp.Timestamp
+}
+
+func (agg *storeAgg) toStoreAndPersisted() (*CredentialStore, *CredentialStoreSecret) {
+	if agg == nil {
+		return nil, nil
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = agg.PublicId
+	cs.ProjectId = agg.ProjectId
+	cs.PluginId = agg.PluginId
+	cs.Name = agg.Name
+	cs.Description = agg.Description
+	cs.CreateTime = agg.CreateTime
+	cs.UpdateTime = agg.UpdateTime
+	cs.Version = agg.Version
+	cs.SecretsHmac = agg.SecretsHmac
+	cs.Attributes = agg.Attributes
+
+	var s *CredentialStoreSecret
+	if len(agg.Secret) > 0 {
+		s = allocCredentialStoreSecret()
+		s.StoreId = agg.PublicId
+		s.CtSecret = agg.Secret
+		s.KeyId = agg.KeyId
+		s.CreateTime = agg.PersistedCreateTime
+		s.UpdateTime = agg.PersistedUpdateTime
+	}
+	return cs, s
+}
+
+// TableName returns the table name for gorm
+func (agg *storeAgg) TableName() string {
+	return "credential_plugin_store_with_secret"
+}
+
+func (agg *storeAgg) GetPublicId() string {
+	return agg.PublicId
+}
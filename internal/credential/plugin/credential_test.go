@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db/sentinel"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredential_New(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		libraryId  string
+		sessionId  string
+		externalId string
+		revocable  bool
+		wantStatus CredentialStatus
+		wantExtId  string
+		wantErr    errors.Code
+	}{
+		{
+			name:      "missing-library-id",
+			sessionId: "s_1234567890",
+			wantErr:   errors.InvalidParameter,
+		},
+		{
+			name:      "missing-session-id",
+			libraryId: "clplg_1234567890",
+			wantErr:   errors.InvalidParameter,
+		},
+		{
+			name:       "revocable",
+			libraryId:  "clplg_1234567890",
+			sessionId:  "s_1234567890",
+			externalId: "ext",
+			revocable:  true,
+			wantStatus: ActiveCredential,
+			wantExtId:  "ext",
+		},
+		{
+			name:       "not-revocable",
+			libraryId:  "clplg_1234567890",
+			sessionId:  "s_1234567890",
+			externalId: "ext",
+			wantStatus: UnknownCredentialStatus,
+			wantExtId:  "ext",
+		},
+		{
+			name:       "no-external-id",
+			libraryId:  "clplg_1234567890",
+			sessionId:  "s_1234567890",
+			revocable:  true,
+			wantStatus: UnknownCredentialStatus,
+			wantExtId:  sentinel.ExternalIdNone,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := newCredential(ctx, tt.libraryId, tt.sessionId, tt.externalId, tt.revocable)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(string(tt.wantStatus), got.GetStatus())
+			assert.Equal(tt.wantExtId, got.GetExternalId())
+			assert.Equal(tt.wantStatus == ActiveCredential, got.GetIsRevocable())
+		})
+	}
+}
+
+func TestCredential_Convert(t *testing.T) {
+	ctx := context.Background()
+
+	newBase := func(ct credential.Type, secret map[string]any) *baseCred {
+		lib := allocCredentialLibrary()
+		lib.PublicId = "clplg_1234567890"
+		lib.CredentialLibrary.CredentialType = string(ct)
+		return &baseCred{
+			Credential: allocCredential(),
+			lib:        lib,
+			purpose:    credential.BrokeredPurpose,
+			secretData: secret,
+		}
+	}
+
+	t.Run("unspecified", func(t *testing.T) {
+		got, err := convert(ctx, newBase(credential.UnspecifiedType, map[string]any{"any": "thing"}))
+		require.NoError(t, err)
+		_, ok := got.(*baseCred)
+		assert.True(t, ok)
+		assert.Equal(t, credential.SecretData(map[string]any{"any": "thing"}), got.Secret())
+	})
+	t.Run("username-password", func(t *testing.T) {
+		got, err := convert(ctx, newBase(credential.UsernamePasswordType, map[string]any{"username": "user", "password": "pass"}))
+		require.NoError(t, err)
+		up, ok := got.(credential.UsernamePassword)
+		require.True(t, ok)
+		assert.Equal(t, "user", up.Username())
+		assert.Equal(t, credential.Password("pass"), up.Password())
+	})
+	t.Run("username-password-missing-password", func(t *testing.T) {
+		got, err := convert(ctx, newBase(credential.UsernamePasswordType, map[string]any{"username": "user"}))
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+		assert.Nil(t, got)
+	})
+	t.Run("ssh-private-key", func(t *testing.T) {
+		got, err := convert(ctx, newBase(credential.SshPrivateKeyType, map[string]any{"username": "user", "private_key": "key"}))
+		require.NoError(t, err)
+		pk, ok := got.(credential.SshPrivateKey)
+		require.True(t, ok)
+		assert.Equal(t, "user", pk.Username())
+		assert.Equal(t, credential.PrivateKey("key"), pk.PrivateKey())
+		assert.Empty(t, pk.PrivateKeyPassphrase())
+	})
+	t.Run("ssh-private-key-non-string", func(t *testing.T) {
+		got, err := convert(ctx, newBase(credential.SshPrivateKeyType, map[string]any{"username": "user", "private_key": 1}))
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+		assert.Nil(t, got)
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRevocationJobName = "plugin_credential_revocation"
+
+	defaultNextRunIn = 5 * time.Minute
+)
+
+// RegisterJobs registers the jobs used by plugin credential stores with
+// the provided scheduler.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, plgm map[string]plgpb.CredentialPluginServiceClient) error {
+	const op = "plugin.RegisterJobs"
+	credRevoke, err := newCredentialRevocationJob(ctx, r, w, kms, scheduler, plgm)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, credRevoke); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential revocation job"))
+	}
+	return nil
+}
+
+// CredentialRevocationJob is the recurring job that asks credential plugins
+// to revoke the credentials they issued for sessions which have ended.
+// The CredentialRevocationJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
+type CredentialRevocationJob struct {
+	repo  *Repository
+	limit int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+// newCredentialRevocationJob creates a new in-memory CredentialRevocationJob.
+//
+// WithLimit is the only supported option.
+func newCredentialRevocationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sched *scheduler.Scheduler, plgm map[string]plgpb.CredentialPluginServiceClient, opt ...Option) (*CredentialRevocationJob, error) {
+	const op = "plugin.newCredentialRevocationJob"
+	repo, err := NewRepository(ctx, r, w, kms, sched, plgm)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialRevocationJob{
+		repo:  repo,
+		limit: opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the credential revocation job. Total
+// is the total number of credentials that are set to be revoked. Completed
+// is the number of credentials already revoked.
+func (j *CredentialRevocationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numCreds,
+	}
+}
+
+// revokeCredential is a credential in the revoke state along with the id
+// of the store it was issued from.
+type revokeCredential struct {
+	PublicId   string
+	LibraryId  string
+	SessionId  string
+	ExternalId string
+	StoreId    string
+}
+
+// Run queries the repo for credentials that need to be revoked, groups them
+// by credential store and session, and asks each store's plugin to revoke
+// them. Can not be run in parallel, if Run is invoked while already running
+// an error with code JobAlreadyRunning will be returned.
+func (j *CredentialRevocationJob) Run(ctx context.Context) error {
+	const op = "plugin.(CredentialRevocationJob).Run"
+	if !j.running.CAS(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	rows, err := j.repo.reader.Query(ctx, credentialsToRevokeQuery, []any{j.limit})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var creds []*revokeCredential
+	for rows.Next() {
+		var c revokeCredential
+		if err := j.repo.reader.ScanRows(ctx, rows, &c); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// The query orders the credentials by store and session so each
+	// contiguous group can be revoked with a single plugin call.
+	j.numProcessed, j.numCreds = 0, len(creds)
+	for start := 0; start < len(creds); {
+		end := start + 1
+		for end < len(creds) && creds[end].StoreId == creds[start].StoreId && creds[end].SessionId == creds[start].SessionId {
+			end++
+		}
+		// Verify context is not done before revoking the next group
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := j.revoke(ctx, creds[start:end]); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error revoking credentials", "credential store id", creds[start].StoreId, "session id", creds[start].SessionId))
+		}
+		j.numProcessed += end - start
+		start = end
+	}
+	return nil
+}
+
+// revoke revokes creds, which must all be from the same store and session.
+func (j *CredentialRevocationJob) revoke(ctx context.Context, creds []*revokeCredential) error {
+	const op = "plugin.(CredentialRevocationJob).revoke"
+	cs, persisted, err := j.repo.getStore(ctx, creds[0].StoreId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	plgClient, ok := j.repo.plugins[cs.GetPluginId()]
+	if !ok || plgClient == nil {
+		return errors.New(ctx, errors.Internal, op, fmt.Sprintf("plugin %q not available", cs.GetPluginId()))
+	}
+	plgCs, err := toPluginStore(ctx, cs)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	req := &plgpb.RevokeCredentialsRequest{
+		Store:     plgCs,
+		Persisted: persisted,
+		SessionId: creds[0].SessionId,
+	}
+	idsByExternalId := make(map[string]string, len(creds))
+	for _, c := range creds {
+		req.Credentials = append(req.Credentials, &plgpb.RevokeCredential{
+			LibraryId:  c.LibraryId,
+			ExternalId: c.ExternalId,
+		})
+		idsByExternalId[c.ExternalId] = c.PublicId
+	}
+	resp, err := plgClient.RevokeCredentials(ctx, req)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke credentials"))
+	}
+
+	var revoked []string
+	for _, extId := range resp.GetRevokedExternalIds() {
+		if id, ok := idsByExternalId[extId]; ok {
+			revoked = append(revoked, id)
+		}
+	}
+	if len(revoked) == 0 {
+		return nil
+	}
+	if _, err := j.repo.writer.Exec(ctx, updateCredentialStatusQuery, []any{string(RevokedCredential), revoked}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential revocation job should run.
+func (j *CredentialRevocationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (j *CredentialRevocationJob) Name() string {
+	return credentialRevocationJobName
+}
+
+// Description is the human readable description of the job.
+func (j *CredentialRevocationJob) Description() string {
+	return "Periodically asks credential plugins to revoke credentials that are no longer in use and have been set for revocation (in the revoke state)."
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// loopbackPluginSecretAttrField is the library attribute holding the
+	// secret data the loopback plugin returns for the library.
+	loopbackPluginSecretAttrField = "secret"
+
+	// loopbackPluginRevocableAttrField is the library attribute which marks
+	// the credentials issued by the loopback plugin as revocable.
+	loopbackPluginRevocableAttrField = "revocable"
+)
+
+var _ plgpb.CredentialPluginServiceServer = (*loopbackPlugin)(nil)
+
+// loopbackPlugin provides a credential plugin with functionality useful for
+// certain kinds of testing. Issued credentials contain the secret data found
+// in the "secret" attribute of the credential library.
+type loopbackPlugin struct {
+	*TestPluginServer
+
+	mu      sync.Mutex
+	issued  map[string]struct{}
+	revoked map[string]struct{}
+}
+
+// NewLoopbackPlugin returns a new loopback credential plugin
+func NewLoopbackPlugin() plgpb.CredentialPluginServiceServer {
+	ret := &loopbackPlugin{
+		TestPluginServer: new(TestPluginServer),
+		issued:           make(map[string]struct{}),
+		revoked:          make(map[string]struct{}),
+	}
+	ret.OnCreateStoreFn = ret.onCreateStore
+	ret.OnUpdateStoreFn = ret.onUpdateStore
+	ret.IssueCredentialsFn = ret.issueCredentials
+	ret.RevokeCredentialsFn = ret.revokeCredentials
+	return ret
+}
+
+func (l *loopbackPlugin) onCreateStore(ctx context.Context, req *plgpb.OnCreateStoreRequest) (*plgpb.OnCreateStoreResponse, error) {
+	const op = "plugin.(loopbackPlugin).onCreateStore"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if secrets := req.GetStore().GetSecrets(); secrets != nil {
+		return &plgpb.OnCreateStoreResponse{
+			Persisted: &plgpb.CredentialStorePersisted{
+				Secrets: secrets,
+			},
+		}, nil
+	}
+	return &plgpb.OnCreateStoreResponse{}, nil
+}
+
+func (l *loopbackPlugin) onUpdateStore(ctx context.Context, req *plgpb.OnUpdateStoreRequest) (*plgpb.OnUpdateStoreResponse, error) {
+	const op = "plugin.(loopbackPlugin).onUpdateStore"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if secrets := req.GetNewStore().GetSecrets(); secrets != nil {
+		return &plgpb.OnUpdateStoreResponse{
+			Persisted: &plgpb.CredentialStorePersisted{
+				Secrets: secrets,
+			},
+		}, nil
+	}
+	return &plgpb.OnUpdateStoreResponse{}, nil
+}
+
+func (l *loopbackPlugin) issueCredentials(ctx context.Context, req *plgpb.IssueCredentialsRequest) (*plgpb.IssueCredentialsResponse, error) {
+	const op = "plugin.(loopbackPlugin).issueCredentials"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	resp := &plgpb.IssueCredentialsResponse{}
+	for i, cr := range req.GetRequests() {
+		attrs := cr.GetLibrary().GetAttributes().GetFields()
+		secret := &structpb.Struct{}
+		if v, ok := attrs[loopbackPluginSecretAttrField]; ok {
+			if v.GetStructValue() == nil {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q attribute of library %s is not an object", loopbackPluginSecretAttrField, cr.GetLibrary().GetId()))
+			}
+			secret = v.GetStructValue()
+		}
+		extId := fmt.Sprintf("%s_%s_%d", req.GetSessionId(), cr.GetLibrary().GetId(), i)
+		l.issued[extId] = struct{}{}
+		resp.Credentials = append(resp.Credentials, &plgpb.IssuedCredential{
+			LibraryId:      cr.GetLibrary().GetId(),
+			Purpose:        cr.GetPurpose(),
+			ExternalId:     extId,
+			Secret:         secret,
+			ExpirationTime: req.GetSessionExpirationTime(),
+			Revocable:      attrs[loopbackPluginRevocableAttrField].GetBoolValue(),
+		})
+	}
+	return resp, nil
+}
+
+func (l *loopbackPlugin) revokeCredentials(ctx context.Context, req *plgpb.RevokeCredentialsRequest) (*plgpb.RevokeCredentialsResponse, error) {
+	const op = "plugin.(loopbackPlugin).revokeCredentials"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	resp := &plgpb.RevokeCredentialsResponse{}
+	for _, c := range req.GetCredentials() {
+		if _, ok := l.issued[c.GetExternalId()]; !ok {
+			continue
+		}
+		delete(l.issued, c.GetExternalId())
+		l.revoked[c.GetExternalId()] = struct{}{}
+		resp.RevokedExternalIds = append(resp.RevokedExternalIds, c.GetExternalId())
+	}
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	cp "github.com/hashicorp/boundary/sdk/plugins/credential"
+	"github.com/hashicorp/go-secure-stdlib/pluginutil/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestLoopbackPlugin_External builds the loopback credential plugin binary
+// and loads it through the go-plugin based credential plugin loader.
+func TestLoopbackPlugin_External(t *testing.T) {
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available")
+	}
+	ctx := context.Background()
+
+	_, thisFile, _, ok := runtime.Caller(0)
+	require.True(t, ok)
+	mainDir := filepath.Join(filepath.Dir(thisFile), "..", "..", "..", "plugins", "credential", "mains", "loopback")
+	binPath := filepath.Join(t.TempDir(), "boundary-plugin-credential-loopback")
+	if runtime.GOOS == "windows" {
+		binPath += ".exe"
+	}
+	build := exec.Command(goBin, "build", "-o", binPath, ".")
+	build.Dir = mainDir
+	out, err := build.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	f, err := os.Open(binPath)
+	require.NoError(t, err)
+	hasher := sha256.New()
+	_, err = io.Copy(hasher, f)
+	require.NoError(t, f.Close())
+	require.NoError(t, err)
+
+	client, cleanup, err := cp.CreateCredentialPlugin(ctx, "loopback",
+		cp.WithPluginOptions(
+			pluginutil.WithPluginFile(pluginutil.PluginFileInfo{
+				Name:     "loopback",
+				Path:     binPath,
+				Checksum: hasher.Sum(nil),
+			}),
+			pluginutil.WithPluginExecutionDirectory(t.TempDir()),
+		),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cleanup() })
+
+	secrets, err := structpb.NewStruct(map[string]any{"token": "abc123"})
+	require.NoError(t, err)
+	csResp, err := client.OnCreateStore(ctx, &plgpb.OnCreateStoreRequest{
+		Store: &credentialstores.CredentialStore{Secrets: secrets},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, secrets.AsMap(), csResp.GetPersisted().GetSecrets().AsMap())
+
+	attrs, err := structpb.NewStruct(map[string]any{
+		"secret":    map[string]any{"username": "user", "password": "pass"},
+		"revocable": true,
+	})
+	require.NoError(t, err)
+	issueResp, err := client.IssueCredentials(ctx, &plgpb.IssueCredentialsRequest{
+		SessionId: "s_1234567890",
+		Requests: []*plgpb.CredentialRequest{
+			{
+				Library: &credentiallibraries.CredentialLibrary{
+					Id:    "clplg_1234567890",
+					Attrs: &credentiallibraries.CredentialLibrary_Attributes{Attributes: attrs},
+				},
+				Purpose: "brokered",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, issueResp.GetCredentials(), 1)
+	issued := issueResp.GetCredentials()[0]
+	assert.Equal(t, map[string]any{"username": "user", "password": "pass"}, issued.GetSecret().AsMap())
+
+	revokeResp, err := client.RevokeCredentials(ctx, &plgpb.RevokeCredentialsRequest{
+		SessionId: "s_1234567890",
+		Credentials: []*plgpb.RevokeCredential{
+			{LibraryId: issued.GetLibraryId(), ExternalId: issued.GetExternalId()},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{issued.GetExternalId()}, revokeResp.GetRevokedExternalIds())
+}
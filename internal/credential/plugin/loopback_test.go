@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	ta "github.com/stretchr/testify/assert"
+	tr "github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestLoopbackPlugin is a quick test of basic functionality.
+func TestLoopbackPlugin(t *testing.T) {
+	require, assert := tr.New(t), ta.New(t)
+	ctx := context.Background()
+
+	plg := NewLoopbackPlugin()
+	secretsMap := map[string]any{
+		"token": "abc123",
+	}
+	secrets, err := structpb.NewStruct(secretsMap)
+	require.NoError(err)
+
+	// Secrets given to the store come back as persisted data
+	csResp, err := plg.OnCreateStore(ctx, &plgpb.OnCreateStoreRequest{
+		Store: &credentialstores.CredentialStore{
+			Secrets: secrets,
+		},
+	})
+	require.NoError(err)
+	require.NotNil(csResp.GetPersisted().GetSecrets())
+	assert.EqualValues(secretsMap, csResp.GetPersisted().GetSecrets().AsMap())
+
+	upResp, err := plg.OnUpdateStore(ctx, &plgpb.OnUpdateStoreRequest{
+		CurrentStore: &credentialstores.CredentialStore{},
+		NewStore: &credentialstores.CredentialStore{
+			Secrets: secrets,
+		},
+	})
+	require.NoError(err)
+	require.NotNil(upResp.GetPersisted().GetSecrets())
+	assert.EqualValues(secretsMap, upResp.GetPersisted().GetSecrets().AsMap())
+
+	// Issued credentials contain the secret attribute of the library
+	attrs, err := structpb.NewStruct(map[string]any{
+		"secret": map[string]any{
+			"username": "user",
+			"password": "pass",
+		},
+		"revocable": true,
+	})
+	require.NoError(err)
+	issueResp, err := plg.IssueCredentials(ctx, &plgpb.IssueCredentialsRequest{
+		SessionId: "s_1234567890",
+		Requests: []*plgpb.CredentialRequest{
+			{
+				Library: &credentiallibraries.CredentialLibrary{
+					Id:    "clplg_1234567890",
+					Attrs: &credentiallibraries.CredentialLibrary_Attributes{Attributes: attrs},
+				},
+				Purpose: "brokered",
+			},
+		},
+	})
+	require.NoError(err)
+	require.Len(issueResp.GetCredentials(), 1)
+	issued := issueResp.GetCredentials()[0]
+	assert.Equal("clplg_1234567890", issued.GetLibraryId())
+	assert.Equal("brokered", issued.GetPurpose())
+	assert.True(issued.GetRevocable())
+	assert.NotEmpty(issued.GetExternalId())
+	assert.EqualValues(map[string]any{"username": "user", "password": "pass"}, issued.GetSecret().AsMap())
+
+	// Only credentials issued by the plugin are revoked, and only once
+	revokeReq := &plgpb.RevokeCredentialsRequest{
+		SessionId: "s_1234567890",
+		Credentials: []*plgpb.RevokeCredential{
+			{LibraryId: "clplg_1234567890", ExternalId: issued.GetExternalId()},
+			{LibraryId: "clplg_1234567890", ExternalId: "unknown"},
+		},
+	}
+	revokeResp, err := plg.RevokeCredentials(ctx, revokeReq)
+	require.NoError(err)
+	assert.Equal([]string{issued.GetExternalId()}, revokeResp.GetRevokedExternalIds())
+
+	revokeResp, err = plg.RevokeCredentials(ctx, revokeReq)
+	require.NoError(err)
+	assert.Empty(revokeResp.GetRevokedExternalIds())
+}
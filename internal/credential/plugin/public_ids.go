@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(credential.Domain, Subtype, globals.PluginCredentialStorePrefix, globals.PluginCredentialLibraryPrefix, DynamicCredentialPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the plugin package.
+const (
+	// DynamicCredentialPrefix is the prefix for credentials issued by a
+	// credential plugin.
+	DynamicCredentialPrefix = "cdplg"
+
+	Subtype = subtypes.Subtype("plugin")
+)
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	const op = "plugin.newCredentialStoreId"
+	id, err := db.NewPublicId(globals.PluginCredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialLibraryId(ctx context.Context) (string, error) {
+	const op = "plugin.newCredentialLibraryId"
+	id, err := db.NewPublicId(globals.PluginCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context) (string, error) {
+	const op = "plugin.newCredentialId"
+	id, err := db.NewPublicId(DynamicCredentialPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
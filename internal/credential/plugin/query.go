@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+const (
+	sessionExpirationQuery = `
+select expiration_time
+  from session
+ where public_id = ?;
+`
+
+	updateSessionCredentialQuery = `
+update session_credential_dynamic
+   set credential_id = @public_id
+ where library_id = @library_id
+   and session_id = @session_id
+   and credential_purpose = @purpose
+   and credential_id is null
+returning *;
+`
+
+	revokeCredentialsQuery = `
+update credential_plugin_credential
+   set status = 'revoke'
+ where session_id = ?
+   and status = 'active'
+   and is_revocable;
+`
+
+	credentialsToRevokeQuery = `
+select cred.public_id    as public_id,
+       cred.library_id   as library_id,
+       cred.session_id   as session_id,
+       cred.external_id  as external_id,
+       lib.store_id      as store_id
+  from credential_plugin_credential cred
+  join credential_plugin_library lib
+    on lib.public_id = cred.library_id
+ where cred.status = 'revoke'
+   and cred.is_revocable
+ order by lib.store_id, cred.session_id
+ limit ?;
+`
+
+	updateCredentialStatusQuery = `
+update credential_plugin_credential
+   set status = ?
+ where public_id in (?);
+`
+)
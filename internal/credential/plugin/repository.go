@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+)
+
+// A Repository stores and retrieves the persistent types in the plugin
+// credential package. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader    db.Reader
+	writer    db.Writer
+	kms       *kms.Kms
+	scheduler *scheduler.Scheduler
+
+	// plugins is a map from plugin resource id to credential plugin client.
+	plugins map[string]plgpb.CredentialPluginServiceClient
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it. WithLimit option is used as a repo wide default
+// limit applied to all ListX methods.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, sched *scheduler.Scheduler, plgm map[string]plgpb.CredentialPluginServiceClient, opt ...Option) (*Repository, error) {
+	const op = "plugin.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "kms")
+	case sched == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "scheduler")
+	case plgm == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "plgm")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+
+	plgs := make(map[string]plgpb.CredentialPluginServiceClient, len(plgm))
+	for k, v := range plgm {
+		plgs[k] = v
+	}
+
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		scheduler:    sched,
+		plugins:      plgs,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	credplugin "github.com/hashicorp/boundary/internal/plugin/credential"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRepository_CredentialStore(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := credplugin.TestPlugin(t, conn, "test")
+
+	var deleteCalled bool
+	plgm := map[string]plgpb.CredentialPluginServiceClient{
+		plg.GetPublicId(): NewWrappingPluginClient(&TestPluginServer{
+			NormalizeStoreDataFn: func(_ context.Context, req *plgpb.NormalizeStoreDataRequest) (*plgpb.NormalizeStoreDataResponse, error) {
+				attrs := req.GetAttributes()
+				if v, ok := attrs.GetFields()["region"]; ok {
+					attrs.Fields["region"] = structpb.NewStringValue(v.GetStringValue() + "-normalized")
+				}
+				return &plgpb.NormalizeStoreDataResponse{Attributes: attrs}, nil
+			},
+			OnCreateStoreFn: func(_ context.Context, req *plgpb.OnCreateStoreRequest) (*plgpb.OnCreateStoreResponse, error) {
+				return &plgpb.OnCreateStoreResponse{Persisted: &plgpb.CredentialStorePersisted{Secrets: req.GetStore().GetSecrets()}}, nil
+			},
+			OnUpdateStoreFn: func(_ context.Context, req *plgpb.OnUpdateStoreRequest) (*plgpb.OnUpdateStoreResponse, error) {
+				return &plgpb.OnUpdateStoreResponse{Persisted: &plgpb.CredentialStorePersisted{Secrets: req.GetNewStore().GetSecrets()}}, nil
+			},
+			OnDeleteStoreFn: func(_ context.Context, _ *plgpb.OnDeleteStoreRequest) (*plgpb.OnDeleteStoreResponse, error) {
+				deleteCalled = true
+				return &plgpb.OnDeleteStoreResponse{}, nil
+			},
+		}),
+	}
+	repo, err := NewRepository(ctx, rw, rw, kms, sched, plgm)
+	require.NoError(t, err)
+
+	_, _, err = repo.CreateCredentialStore(ctx, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	attrs, err := structpb.NewStruct(map[string]any{"region": "us-east-1"})
+	require.NoError(t, err)
+	secrets, err := structpb.NewStruct(map[string]any{"token": "abc123"})
+	require.NoError(t, err)
+	in, err := NewCredentialStore(ctx, prj.GetPublicId(), plg.GetPublicId(), WithName("test"), WithAttributes(attrs), WithSecrets(secrets))
+	require.NoError(t, err)
+
+	cs, _, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+	gotAttrs, err := toPluginStore(ctx, cs)
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1-normalized", gotAttrs.GetAttributes().AsMap()["region"])
+
+	// Duplicate names within a project are not allowed
+	_, _, err = repo.CreateCredentialStore(ctx, in)
+	assert.Truef(t, errors.Match(errors.T(errors.NotUnique), err), "got: %q", err)
+
+	got, gotPlg, err := repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, cs.GetPublicId(), got.GetPublicId())
+	assert.Equal(t, plg.GetPublicId(), gotPlg.GetPublicId())
+
+	stores, plgs, err := repo.ListCredentialStores(ctx, []string{prj.GetPublicId()})
+	require.NoError(t, err)
+	assert.Len(t, stores, 1)
+	assert.Len(t, plgs, 1)
+
+	// Updating only the secrets bumps the version and the hmac
+	newSecrets, err := structpb.NewStruct(map[string]any{"token": "def456"})
+	require.NoError(t, err)
+	up := allocCredentialStore()
+	up.PublicId = cs.GetPublicId()
+	up.ProjectId = cs.GetProjectId()
+	up.Secrets = newSecrets
+	updated, _, n, err := repo.UpdateCredentialStore(ctx, up, cs.GetVersion(), []string{"secrets"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, cs.GetVersion()+1, updated.GetVersion())
+	assert.NotEqual(t, cs.GetSecretsHmac(), updated.GetSecretsHmac())
+	_, persisted, err := repo.getStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, newSecrets.AsMap(), persisted.GetSecrets().AsMap())
+
+	_, _, _, err = repo.UpdateCredentialStore(ctx, up, cs.GetVersion(), []string{"secrets"})
+	assert.Truef(t, errors.Match(errors.T(errors.VersionMismatch), err), "got: %q", err)
+
+	n, err = repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.True(t, deleteCalled)
+
+	got, _, err = repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util/template"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue issues and returns dynamic credentials from the credential plugins
+// of the libraries in requests and assigns them to sessionId. The requests
+// are grouped by credential store and each store's plugin is called once.
+//
+// Supported options: credential.WithTemplateData
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, opt ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "plugin.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	templateData := toPluginTemplateData(opts.WithTemplateData)
+
+	libIds := make([]string, 0, len(requests))
+	for _, req := range requests {
+		libIds = append(libIds, req.SourceId)
+	}
+	var libs []*CredentialLibrary
+	if err := r.reader.SearchWhere(ctx, &libs, "public_id in (?)", []any{libIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	libsById := make(map[string]*CredentialLibrary, len(libs))
+	for _, l := range libs {
+		libsById[l.GetPublicId()] = l
+	}
+
+	// Group the requests by store, preserving the order of the requests.
+	var storeIds []string
+	reqsByStore := make(map[string][]credential.Request)
+	for _, req := range requests {
+		lib, ok := libsById[req.SourceId]
+		if !ok {
+			return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("credential library %s not found", req.SourceId))
+		}
+		if _, ok := reqsByStore[lib.GetStoreId()]; !ok {
+			storeIds = append(storeIds, lib.GetStoreId())
+		}
+		reqsByStore[lib.GetStoreId()] = append(reqsByStore[lib.GetStoreId()], req)
+	}
+
+	sessExp, err := r.sessionExpiration(ctx, sessionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var creds []credential.Dynamic
+	for _, storeId := range storeIds {
+		cs, persisted, err := r.getStore(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		plgClient, ok := r.plugins[cs.GetPluginId()]
+		if !ok || plgClient == nil {
+			return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("plugin %q not available", cs.GetPluginId()))
+		}
+		plgCs, err := toPluginStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+
+		storeReqs := reqsByStore[storeId]
+		plgReqs := make([]*plgpb.CredentialRequest, 0, len(storeReqs))
+		for _, req := range storeReqs {
+			plgLib, err := toPluginLibrary(ctx, libsById[req.SourceId])
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			plgReqs = append(plgReqs, &plgpb.CredentialRequest{
+				Library: plgLib,
+				Purpose: string(req.Purpose),
+			})
+		}
+
+		resp, err := plgClient.IssueCredentials(ctx, &plgpb.IssueCredentialsRequest{
+			Store:                 plgCs,
+			Persisted:             persisted,
+			SessionId:             sessionId,
+			SessionExpirationTime: sessExp,
+			Requests:              plgReqs,
+			TemplateData:          templateData,
+		})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("credential plugin %s failed to issue credentials", cs.GetPluginId())))
+		}
+		if len(resp.GetCredentials()) != len(storeReqs) {
+			return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("credential plugin %s returned %d credentials for %d requests", cs.GetPluginId(), len(resp.GetCredentials()), len(storeReqs)))
+		}
+
+		for i, issued := range resp.GetCredentials() {
+			req := storeReqs[i]
+			lib := libsById[req.SourceId]
+			c, err := newCredential(ctx, lib.GetPublicId(), sessionId, issued.GetExternalId(), issued.GetRevocable())
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if c.PublicId, err = newCredentialId(ctx); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if issued.GetExpirationTime() != nil {
+				c.ExpirationTime = &timestamp.Timestamp{Timestamp: issued.GetExpirationTime()}
+			}
+
+			if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+				func(_ db.Reader, w db.Writer) error {
+					if err := w.Create(ctx, c.clone()); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					rowsUpdated, err := w.Exec(ctx, updateSessionCredentialQuery, []any{
+						sql.Named("public_id", c.PublicId),
+						sql.Named("library_id", c.LibraryId),
+						sql.Named("session_id", sessionId),
+						sql.Named("purpose", string(req.Purpose)),
+					})
+					switch {
+					case err != nil:
+						return errors.Wrap(ctx, err, op)
+					case rowsUpdated == 0:
+						return errors.New(ctx, errors.InvalidDynamicCredential, op, "no matching dynamic credential for session found")
+					case rowsUpdated > 1:
+						return errors.New(ctx, errors.MultipleRecords, op, "more than 1 session credential would have been updated")
+					}
+					return nil
+				},
+			); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+
+			cred, err := convert(ctx, &baseCred{
+				Credential: c,
+				lib:        lib,
+				purpose:    req.Purpose,
+				secretData: issued.GetSecret().AsMap(),
+			})
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			creds = append(creds, cred)
+		}
+	}
+	return creds, nil
+}
+
+var _ credential.Revoker = (*Repository)(nil)
+
+// Revoke flags all revocable credentials issued by credential plugins for
+// sessionId to be revoked by the credential revocation job.
+func (r *Repository) Revoke(ctx context.Context, sessionId string) error {
+	const op = "plugin.(Repository).Revoke"
+	if sessionId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, revokeCredentialsQuery, []any{sessionId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Best effort run of the revocation job, an error should not cause
+	// Revoke to fail.
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, credentialRevocationJobName, 0)
+	return nil
+}
+
+// sessionExpiration returns the expiration time of sessionId.
+func (r *Repository) sessionExpiration(ctx context.Context, sessionId string) (*timestamppb.Timestamp, error) {
+	const op = "plugin.(Repository).sessionExpiration"
+	rows, err := r.reader.Query(ctx, sessionExpirationQuery, []any{sessionId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session %s not found", sessionId))
+	}
+	var exp time.Time
+	if err := rows.Scan(&exp); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return timestamppb.New(exp), nil
+}
+
+// toPluginTemplateData returns the template data in the format expected by
+// the credential plugin system.
+func toPluginTemplateData(in template.Data) *plgpb.TemplateData {
+	str := func(s *string) *wrapperspb.StringValue {
+		if s == nil {
+			return nil
+		}
+		return wrapperspb.String(*s)
+	}
+	return &plgpb.TemplateData{
+		User: &plgpb.TemplateUser{
+			Id:       str(in.User.Id),
+			Name:     str(in.User.Name),
+			FullName: str(in.User.FullName),
+			Email:    str(in.User.Email),
+		},
+		Account: &plgpb.TemplateAccount{
+			Id:        str(in.Account.Id),
+			Name:      str(in.Account.Name),
+			LoginName: str(in.Account.LoginName),
+			Subject:   str(in.Account.Subject),
+			Email:     str(in.Account.Email),
+		},
+	}
+}
+
+// toPluginLibrary returns a credential library in the format expected by
+// the credential plugin system.
+func toPluginLibrary(ctx context.Context, in *CredentialLibrary) (*pb.CredentialLibrary, error) {
+	const op = "plugin.toPluginLibrary"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil credential library")
+	}
+	var name, description *wrapperspb.StringValue
+	if inName := in.GetName(); inName != "" {
+		name = wrapperspb.String(inName)
+	}
+	if inDescription := in.GetDescription(); inDescription != "" {
+		description = wrapperspb.String(inDescription)
+	}
+
+	l := &pb.CredentialLibrary{
+		Id:                in.GetPublicId(),
+		CredentialStoreId: in.GetStoreId(),
+		Name:              name,
+		Description:       description,
+		Type:              Subtype.String(),
+		CredentialType:    string(in.CredentialType()),
+	}
+	if in.GetAttributes() != nil {
+		attrs := &structpb.Struct{}
+		if err := proto.Unmarshal(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal attributes"))
+		}
+		l.Attrs = &pb.CredentialLibrary_Attributes{
+			Attributes: attrs,
+		}
+	}
+	return l, nil
+}
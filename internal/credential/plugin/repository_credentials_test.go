@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	credplugin "github.com/hashicorp/boundary/internal/plugin/credential"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/util/template"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRepository_IssueRevoke(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	plg := credplugin.TestPlugin(t, conn, "loopback")
+	lp := NewLoopbackPlugin().(*loopbackPlugin)
+	var gotTemplateData *plgpb.TemplateData
+	issueCredentials := lp.IssueCredentialsFn
+	lp.IssueCredentialsFn = func(ctx context.Context, req *plgpb.IssueCredentialsRequest) (*plgpb.IssueCredentialsResponse, error) {
+		gotTemplateData = req.GetTemplateData()
+		return issueCredentials(ctx, req)
+	}
+	plgm := map[string]plgpb.CredentialPluginServiceClient{
+		plg.GetPublicId(): NewWrappingPluginClient(lp),
+	}
+
+	repo, err := NewRepository(ctx, rw, rw, kms, sched, plgm)
+	require.NoError(t, err)
+
+	secrets, err := structpb.NewStruct(map[string]any{"token": "abc123"})
+	require.NoError(t, err)
+	in, err := NewCredentialStore(ctx, prj.GetPublicId(), plg.GetPublicId(), WithSecrets(secrets))
+	require.NoError(t, err)
+	cs, gotPlg, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+	assert.Equal(t, plg.GetPublicId(), gotPlg.GetPublicId())
+	assert.NotEmpty(t, cs.GetSecretsHmac())
+
+	_, persisted, err := repo.getStore(ctx, cs.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, secrets.AsMap(), persisted.GetSecrets().AsMap())
+
+	attrs, err := structpb.NewStruct(map[string]any{
+		"secret": map[string]any{
+			"username": "user",
+			"password": "pass",
+		},
+		"revocable": true,
+	})
+	require.NoError(t, err)
+	lib, err := NewCredentialLibrary(ctx, cs.GetPublicId(), WithAttributes(attrs), WithCredentialType(credential.UsernamePasswordType))
+	require.NoError(t, err)
+	lib, err = repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), lib)
+	require.NoError(t, err)
+	require.NotNil(t, lib)
+
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	requests := []credential.Request{
+		{
+			SourceId: lib.GetPublicId(),
+			Purpose:  credential.BrokeredPurpose,
+		},
+	}
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+		DynamicCredentials: []*session.DynamicCredential{
+			session.NewDynamicCredential(lib.GetPublicId(), credential.BrokeredPurpose),
+		},
+	})
+
+	_, err = repo.Issue(ctx, "", requests)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+
+	userName := "alice"
+	creds, err := repo.Issue(ctx, sess.GetPublicId(), requests, credential.WithTemplateData(template.Data{
+		User: template.User{Id: &at.IamUserId, Name: &userName},
+	}))
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, at.GetIamUserId(), gotTemplateData.GetUser().GetId().GetValue())
+	assert.Equal(t, userName, gotTemplateData.GetUser().GetName().GetValue())
+	assert.Nil(t, gotTemplateData.GetUser().GetEmail())
+	assert.Nil(t, gotTemplateData.GetAccount().GetLoginName())
+	up, ok := creds[0].(credential.UsernamePassword)
+	require.True(t, ok)
+	assert.Equal(t, "user", up.Username())
+	assert.Equal(t, credential.Password("pass"), up.Password())
+	assert.Equal(t, sess.GetPublicId(), creds[0].GetSessionId())
+
+	lookupCred := func() *Credential {
+		c := allocCredential()
+		c.PublicId = up.GetPublicId()
+		require.NoError(t, rw.LookupByPublicId(ctx, c))
+		return c
+	}
+	assert.Equal(t, string(ActiveCredential), lookupCred().GetStatus())
+
+	require.NoError(t, repo.Revoke(ctx, sess.GetPublicId()))
+	assert.Equal(t, string(RevokeCredential), lookupCred().GetStatus())
+
+	job, err := newCredentialRevocationJob(ctx, rw, rw, kms, sched, plgm)
+	require.NoError(t, err)
+	require.NoError(t, job.Run(ctx))
+	assert.Equal(t, 1, job.Status().Completed)
+	assert.Equal(t, string(RevokedCredential), lookupCred().GetStatus())
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	credplugin "github.com/hashicorp/boundary/internal/plugin/credential"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a plugin credential store in the provided DB
+// with the provided project id and plugin id. If any errors are encountered
+// during the creation of the credential store, the test will fail.
+func TestCredentialStore(t testing.TB, conn *db.DB, projectId, pluginId string, opt ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(ctx, projectId, pluginId, opt...)
+	require.NoError(t, err)
+	assert.NotNil(t, cs)
+
+	plg := credplugin.NewPlugin()
+	plg.PublicId = pluginId
+	require.NoError(t, w.LookupByPublicId(ctx, plg))
+
+	id, err := newCredentialStoreId(ctx)
+	require.NoError(t, err)
+	cs.PublicId = id
+
+	require.NoError(t, w.Create(ctx, cs))
+	return cs
+}
+
+// TestCredentialLibraries creates count number of plugin credential
+// libraries in the provided DB with the provided store id. If any errors are
+// encountered during the creation of the credential libraries, the test will
+// fail.
+func TestCredentialLibraries(t testing.TB, conn *db.DB, storeId string, count int, opt ...Option) []*CredentialLibrary {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	var libs []*CredentialLibrary
+	for i := 0; i < count; i++ {
+		lib, err := NewCredentialLibrary(ctx, storeId, opt...)
+		require.NoError(t, err)
+		require.NotNil(t, lib)
+
+		id, err := newCredentialLibraryId(ctx)
+		require.NoError(t, err)
+		lib.PublicId = id
+
+		require.NoError(t, w.Create(ctx, lib))
+		libs = append(libs, lib)
+	}
+	return libs
+}
+
+var _ plgpb.CredentialPluginServiceServer = (*TestPluginServer)(nil)
+
+// TestPluginServer provides a credential plugin service server where each
+// method can be overwritten for tests.
+type TestPluginServer struct {
+	NormalizeStoreDataFn func(context.Context, *plgpb.NormalizeStoreDataRequest) (*plgpb.NormalizeStoreDataResponse, error)
+	OnCreateStoreFn      func(context.Context, *plgpb.OnCreateStoreRequest) (*plgpb.OnCreateStoreResponse, error)
+	OnUpdateStoreFn      func(context.Context, *plgpb.OnUpdateStoreRequest) (*plgpb.OnUpdateStoreResponse, error)
+	OnDeleteStoreFn      func(context.Context, *plgpb.OnDeleteStoreRequest) (*plgpb.OnDeleteStoreResponse, error)
+	IssueCredentialsFn   func(context.Context, *plgpb.IssueCredentialsRequest) (*plgpb.IssueCredentialsResponse, error)
+	RevokeCredentialsFn  func(context.Context, *plgpb.RevokeCredentialsRequest) (*plgpb.RevokeCredentialsResponse, error)
+	plgpb.UnimplementedCredentialPluginServiceServer
+}
+
+func (t TestPluginServer) NormalizeStoreData(ctx context.Context, req *plgpb.NormalizeStoreDataRequest) (*plgpb.NormalizeStoreDataResponse, error) {
+	if t.NormalizeStoreDataFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.NormalizeStoreData(ctx, req)
+	}
+	return t.NormalizeStoreDataFn(ctx, req)
+}
+
+func (t TestPluginServer) OnCreateStore(ctx context.Context, req *plgpb.OnCreateStoreRequest) (*plgpb.OnCreateStoreResponse, error) {
+	if t.OnCreateStoreFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.OnCreateStore(ctx, req)
+	}
+	return t.OnCreateStoreFn(ctx, req)
+}
+
+func (t TestPluginServer) OnUpdateStore(ctx context.Context, req *plgpb.OnUpdateStoreRequest) (*plgpb.OnUpdateStoreResponse, error) {
+	if t.OnUpdateStoreFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.OnUpdateStore(ctx, req)
+	}
+	return t.OnUpdateStoreFn(ctx, req)
+}
+
+func (t TestPluginServer) OnDeleteStore(ctx context.Context, req *plgpb.OnDeleteStoreRequest) (*plgpb.OnDeleteStoreResponse, error) {
+	if t.OnDeleteStoreFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.OnDeleteStore(ctx, req)
+	}
+	return t.OnDeleteStoreFn(ctx, req)
+}
+
+func (t TestPluginServer) IssueCredentials(ctx context.Context, req *plgpb.IssueCredentialsRequest) (*plgpb.IssueCredentialsResponse, error) {
+	if t.IssueCredentialsFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.IssueCredentials(ctx, req)
+	}
+	return t.IssueCredentialsFn(ctx, req)
+}
+
+func (t TestPluginServer) RevokeCredentials(ctx context.Context, req *plgpb.RevokeCredentialsRequest) (*plgpb.RevokeCredentialsResponse, error) {
+	if t.RevokeCredentialsFn == nil {
+		return t.UnimplementedCredentialPluginServiceServer.RevokeCredentials(ctx, req)
+	}
+	return t.RevokeCredentialsFn(ctx, req)
+}
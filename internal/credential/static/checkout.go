@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// A Checkout is a check-out of a static credential held by a session. A
+// credential is checked out when a session using it is created and checked
+// back in when the session is terminated, or when the check-out is
+// forcibly released with CheckIn, which also cancels the session.
+type Checkout struct {
+	// CredentialId is the public id of the checked out credential.
+	CredentialId string
+	// SessionId is the public id of the session holding the check-out.
+	SessionId string
+	// UserId is the public id of the user the session belongs to. It is
+	// empty if the user has since been deleted.
+	UserId string
+	// CreateTime is the time the credential was checked out.
+	CreateTime *timestamp.Timestamp
+	// ExpirationTime is the time the session holding the check-out expires.
+	ExpirationTime *timestamp.Timestamp
+}
+
+// checkout is the check-out of a static credential by a session as stored
+// in the database. It is only used to write check-ins to the oplog.
+type checkout struct {
+	*store.Checkout
+	tableName string `gorm:"-"`
+}
+
+func allocCheckout() *checkout {
+	return &checkout{
+		Checkout: &store.Checkout{},
+	}
+}
+
+// TableName returns the table name.
+func (c *checkout) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_checkout"
+}
+
+// SetTableName sets the table name.
+func (c *checkout) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *checkout) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{c.CredentialStaticId},
+		"resource-type":      []string{"credential-static-checkout"},
+		"op-type":            []string{op.String()},
+		"session-id":         []string{c.SessionId},
+	}
+}
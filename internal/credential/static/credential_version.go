@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultRetainedVersions is the number of versions of a static credential
+// that are kept when no other value is configured.
+const DefaultRetainedVersions = 10
+
+// A CredentialVersion is a record of the state of a static credential,
+// including its secret, at a specific version. A version is recorded each
+// time a static credential is created or updated.
+type CredentialVersion struct {
+	*store.CredentialVersion
+	tableName string `gorm:"-"`
+}
+
+func allocCredentialVersion() *CredentialVersion {
+	return &CredentialVersion{
+		CredentialVersion: &store.CredentialVersion{},
+	}
+}
+
+func (v *CredentialVersion) clone() *CredentialVersion {
+	cp := proto.Clone(v.CredentialVersion)
+	return &CredentialVersion{
+		CredentialVersion: cp.(*store.CredentialVersion),
+	}
+}
+
+// TableName returns the table name.
+func (v *CredentialVersion) TableName() string {
+	if v.tableName != "" {
+		return v.tableName
+	}
+	return "credential_static_version"
+}
+
+// SetTableName sets the table name.
+func (v *CredentialVersion) SetTableName(n string) {
+	v.tableName = n
+}
+
+func (v *CredentialVersion) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialVersion).encrypt"
+	if len(v.Data) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no data defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, v.CredentialVersion, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	v.KeyId = keyId
+	return nil
+}
+
+func (v *CredentialVersion) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(CredentialVersion).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, v.CredentialVersion, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// marshalCredential returns the serialized state of c. The secret of c must
+// be in plaintext. The encrypted form of the secret and the id of the key
+// used to encrypt it are not included.
+func marshalCredential(ctx context.Context, c credential.Static) ([]byte, error) {
+	const op = "static.marshalCredential"
+	var m proto.Message
+	switch c := c.(type) {
+	case *UsernamePasswordCredential:
+		cp := c.clone()
+		cp.CtPassword = nil
+		cp.KeyId = ""
+		m = cp.UsernamePasswordCredential
+	case *SshPrivateKeyCredential:
+		cp := c.clone()
+		cp.PrivateKeyEncrypted = nil
+		cp.PrivateKeyPassphraseEncrypted = nil
+		cp.KeyId = ""
+		m = cp.SshPrivateKeyCredential
+	case *JsonCredential:
+		cp := c.clone()
+		cp.ObjectEncrypted = nil
+		cp.KeyId = ""
+		m = cp.JsonCredential
+	case *TlsClientCertificateCredential:
+		cp := c.clone()
+		cp.PrivateKeyEncrypted = nil
+		cp.PrivateKeyPassphraseEncrypted = nil
+		cp.KeyId = ""
+		m = cp.TlsClientCertificateCredential
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unsupported credential type")
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	return data, nil
+}
+
+// unmarshalCredential returns the static credential with credentialId
+// serialized in data by marshalCredential.
+func unmarshalCredential(ctx context.Context, credentialId string, data []byte) (credential.Static, error) {
+	const op = "static.unmarshalCredential"
+	var c credential.Static
+	var m proto.Message
+	switch subtypes.SubtypeFromId(credential.Domain, credentialId) {
+	case credential.UsernamePasswordSubtype:
+		upCred := allocUsernamePasswordCredential()
+		c, m = upCred, upCred.UsernamePasswordCredential
+	case credential.SshPrivateKeySubtype:
+		spkCred := allocSshPrivateKeyCredential()
+		c, m = spkCred, spkCred.SshPrivateKeyCredential
+	case credential.JsonSubtype:
+		jsonCred := allocJsonCredential()
+		c, m = jsonCred, jsonCred.JsonCredential
+	case credential.TlsClientCertificateSubtype:
+		tlsCred := allocTlsClientCertificateCredential()
+		c, m = tlsCred, tlsCred.TlsClientCertificateCredential
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unsupported credential type")
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+	}
+	return c, nil
+}
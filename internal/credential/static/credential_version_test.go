@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarshalCredential(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	upId, err := credential.NewUsernamePasswordCredentialId(ctx)
+	require.NoError(t, err)
+	spkId, err := credential.NewSshPrivateKeyCredentialId(ctx)
+	require.NoError(t, err)
+	jsonId, err := credential.NewJsonCredentialId(ctx)
+	require.NoError(t, err)
+	tlsId, err := credential.NewTlsClientCertificateCredentialId(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		in   credential.Static
+		want credential.Static
+	}{
+		{
+			name: "username-password",
+			in: &UsernamePasswordCredential{
+				UsernamePasswordCredential: &store.UsernamePasswordCredential{
+					PublicId:     upId,
+					StoreId:      "csst_1234567890",
+					Version:      2,
+					Username:     "user",
+					Password:     []byte("pass"),
+					CtPassword:   []byte("encrypted"),
+					PasswordHmac: []byte("hmac"),
+					KeyId:        "kdkv_1234567890",
+				},
+			},
+			want: &UsernamePasswordCredential{
+				UsernamePasswordCredential: &store.UsernamePasswordCredential{
+					PublicId:     upId,
+					StoreId:      "csst_1234567890",
+					Version:      2,
+					Username:     "user",
+					Password:     []byte("pass"),
+					PasswordHmac: []byte("hmac"),
+				},
+			},
+		},
+		{
+			name: "ssh-private-key",
+			in: &SshPrivateKeyCredential{
+				SshPrivateKeyCredential: &store.SshPrivateKeyCredential{
+					PublicId:                      spkId,
+					Username:                      "user",
+					PrivateKey:                    []byte("key"),
+					PrivateKeyEncrypted:           []byte("encrypted"),
+					PrivateKeyPassphrase:          []byte("passphrase"),
+					PrivateKeyPassphraseEncrypted: []byte("encrypted"),
+					KeyId:                         "kdkv_1234567890",
+				},
+			},
+			want: &SshPrivateKeyCredential{
+				SshPrivateKeyCredential: &store.SshPrivateKeyCredential{
+					PublicId:             spkId,
+					Username:             "user",
+					PrivateKey:           []byte("key"),
+					PrivateKeyPassphrase: []byte("passphrase"),
+				},
+			},
+		},
+		{
+			name: "json",
+			in: &JsonCredential{
+				JsonCredential: &store.JsonCredential{
+					PublicId:        jsonId,
+					Object:          []byte(`{"secret":"value"}`),
+					ObjectEncrypted: []byte("encrypted"),
+					KeyId:           "kdkv_1234567890",
+				},
+			},
+			want: &JsonCredential{
+				JsonCredential: &store.JsonCredential{
+					PublicId: jsonId,
+					Object:   []byte(`{"secret":"value"}`),
+				},
+			},
+		},
+		{
+			name: "tls-client-certificate",
+			in: &TlsClientCertificateCredential{
+				TlsClientCertificateCredential: &store.TlsClientCertificateCredential{
+					PublicId:            tlsId,
+					Certificate:         []byte("cert"),
+					PrivateKey:          []byte("key"),
+					PrivateKeyEncrypted: []byte("encrypted"),
+					KeyId:               "kdkv_1234567890",
+				},
+			},
+			want: &TlsClientCertificateCredential{
+				TlsClientCertificateCredential: &store.TlsClientCertificateCredential{
+					PublicId:    tlsId,
+					Certificate: []byte("cert"),
+					PrivateKey:  []byte("key"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			data, err := marshalCredential(ctx, tt.in)
+			require.NoError(err)
+			require.NotEmpty(data)
+
+			got, err := unmarshalCredential(ctx, tt.in.GetPublicId(), data)
+			require.NoError(err)
+			require.IsType(tt.want, got)
+			switch want := tt.want.(type) {
+			case *UsernamePasswordCredential:
+				assert.True(proto.Equal(want.UsernamePasswordCredential, got.(*UsernamePasswordCredential).UsernamePasswordCredential))
+			case *SshPrivateKeyCredential:
+				assert.True(proto.Equal(want.SshPrivateKeyCredential, got.(*SshPrivateKeyCredential).SshPrivateKeyCredential))
+			case *JsonCredential:
+				assert.True(proto.Equal(want.JsonCredential, got.(*JsonCredential).JsonCredential))
+			case *TlsClientCertificateCredential:
+				assert.True(proto.Equal(want.TlsClientCertificateCredential, got.(*TlsClientCertificateCredential).TlsClientCertificateCredential))
+			}
+		})
+	}
+
+	t.Run("unknown-type", func(t *testing.T) {
+		_, err := unmarshalCredential(ctx, "csst_1234567890", []byte("data"))
+		assert.Error(t, err)
+	})
+}
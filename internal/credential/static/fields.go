@@ -5,11 +5,14 @@ package static
 
 // These constants are the field names used in the static related field masks.
 const (
-	nameField                 = "Name"
-	descriptionField          = "Description"
-	usernameField             = "Username"
-	passwordField             = "Password"
-	privateKeyField           = "PrivateKey"
-	PrivateKeyPassphraseField = "PrivateKeyPassphrase"
-	objectField               = "Object"
+	nameField                   = "Name"
+	descriptionField            = "Description"
+	maxConcurrentCheckoutsField = "MaxConcurrentCheckouts"
+	usernameField               = "Username"
+	passwordField               = "Password"
+	privateKeyField             = "PrivateKey"
+	PrivateKeyPassphraseField   = "PrivateKeyPassphrase"
+	objectField                 = "Object"
+	certificateField            = "Certificate"
+	caBundleField               = "CaBundle"
 )
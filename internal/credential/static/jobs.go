@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRotationJobName = "static_credential_rotation"
+
+	rotationJobRunInterval = time.Minute
+	// rotationRetryBackoff is how long the rotation job waits before
+	// retrying a credential whose rotation failed.
+	rotationRetryBackoff = 10 * time.Minute
+	// rotationTimeout bounds how long a rotator may take to change a
+	// password. The credential cannot be checked out while it is changed.
+	rotationTimeout = time.Minute
+	// rotatedPasswordLength is the length of the passwords generated by the
+	// rotation job. The passwords are alphanumeric so that rotators never
+	// have to quote them.
+	rotatedPasswordLength = 32
+	rotationIdPrefix      = "csrot"
+)
+
+// RegisterJobs registers the static credential related jobs with the provided
+// scheduler. rotators maps rotator names to the Rotator used to change
+// passwords with that name. WithRetainedVersions is passed to the repository
+// used by the jobs.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, rotators map[string]Rotator, opt ...Option) error {
+	const op = "static.RegisterJobs"
+	rotation, err := newCredentialRotationJob(ctx, r, w, kms, rotators, opt...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, rotation); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential rotation job"))
+	}
+	return nil
+}
+
+// CredentialRotationJob is the recurring job that rotates the passwords of
+// username password credentials according to their rotation policies.
+type CredentialRotationJob struct {
+	reader   db.Reader
+	writer   db.Writer
+	kms      *kms.Kms
+	rotators map[string]Rotator
+	limit    int
+	// retainedVersions is passed to the repository used to store the
+	// rotated passwords.
+	retainedVersions int
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+// newCredentialRotationJob creates a new in-memory CredentialRotationJob.
+//
+// WithLimit and WithRetainedVersions are the only supported options.
+func newCredentialRotationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, rotators map[string]Rotator, opt ...Option) (*CredentialRotationJob, error) {
+	const op = "static.newCredentialRotationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &CredentialRotationJob{
+		reader:           r,
+		writer:           w,
+		kms:              kms,
+		rotators:         rotators,
+		limit:            opts.withLimit,
+		retainedVersions: opts.withRetainedVersions,
+	}, nil
+}
+
+// Status returns the current status of the credential rotation job. Total is
+// the number of credentials due to be rotated. Completed is the number of
+// credentials already processed.
+func (j *CredentialRotationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numCreds,
+	}
+}
+
+type dueRotation struct {
+	credentialId string
+	projectId    string
+	pending      bool
+}
+
+// Run queries the static credential repo for credentials whose password is
+// due to be rotated, changes each password with the rotator named by the
+// credential's policy and stores the new password. Can not be run in
+// parallel, if Run is invoked while already running an error with code
+// JobAlreadyRunning will be returned.
+func (j *CredentialRotationJob) Run(ctx context.Context) error {
+	const op = "static.(CredentialRotationJob).Run"
+	if !j.running.CAS(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	rows, err := j.reader.Query(ctx, rotationsDueQuery, []any{rotationRetryBackoff.Seconds(), j.limit})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var due []dueRotation
+	for rows.Next() {
+		var d dueRotation
+		if err := rows.Scan(&d.credentialId, &d.projectId, &d.pending); err != nil {
+			_ = rows.Close()
+			return errors.Wrap(ctx, err, op)
+		}
+		due = append(due, d)
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return errors.Wrap(ctx, err, op)
+	}
+	_ = rows.Close()
+
+	// Set numProcessed and numCreds for status report
+	j.numProcessed, j.numCreds = 0, len(due)
+	for _, d := range due {
+		// Verify context is not done before rotating next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		trigger := IntervalRotationTrigger
+		if d.pending {
+			trigger = SessionRotationTrigger
+		}
+		start := time.Now()
+		rotateErr := j.rotate(ctx, d.projectId, d.credentialId)
+		if errors.Match(errors.T(errors.Conflict), rotateErr) {
+			// A session checked the credential out after it was found
+			// due. It is rotated once no session holds it.
+			j.numProcessed++
+			continue
+		}
+		if err := j.recordRotation(ctx, d.credentialId, trigger, start, rotateErr); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error recording credential rotation", "credential id", d.credentialId))
+		}
+		if rotateErr != nil {
+			event.WriteError(ctx, op, rotateErr, event.WithInfoMsg("error rotating credential", "credential id", d.credentialId, "trigger", string(trigger)))
+		} else {
+			event.WriteSysEvent(ctx, op, "rotated credential", "credential id", d.credentialId, "trigger", string(trigger))
+		}
+		j.numProcessed++
+	}
+	return nil
+}
+
+// rotate changes the password of the credential credentialId and stores the
+// new password. The new password is stored, encrypted, as a pending rotation
+// before it is changed on the target system, and the change and the commit of
+// the new password happen while the credential is locked against new
+// check-outs. If the change succeeds but cannot be committed, the pending
+// password is retried by the next rotation.
+func (j *CredentialRotationJob) rotate(ctx context.Context, projectId, credentialId string) error {
+	const op = "static.(CredentialRotationJob).rotate"
+	repo, err := NewRepository(ctx, j.reader, j.writer, j.kms, WithRetainedVersions(j.retainedVersions))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	p, err := repo.LookupRotationPolicy(ctx, credentialId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if p == nil {
+		// The policy was removed after the job queried for due rotations.
+		return errors.New(ctx, errors.RecordNotFound, op, "rotation policy not found")
+	}
+	rotator, ok := j.rotators[p.Rotator]
+	if !ok || rotator == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("no rotator registered for %q", p.Rotator))
+	}
+
+	ids := []string{credentialId}
+	if p.AdminCredentialId != "" {
+		ids = append(ids, p.AdminCredentialId)
+	}
+	creds, err := repo.Retrieve(ctx, projectId, ids)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var cred, admin *UsernamePasswordCredential
+	for _, c := range creds {
+		up, ok := c.(*UsernamePasswordCredential)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is not a username password credential", c.GetPublicId()))
+		}
+		switch up.GetPublicId() {
+		case credentialId:
+			cred = up
+		case p.AdminCredentialId:
+			admin = up
+		}
+	}
+	if cred == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, "credential not found")
+	}
+	if admin == nil {
+		admin = cred
+	}
+
+	// The password of an interrupted rotation is reused, the target system
+	// may already have been changed to it.
+	newPassword, interrupted, err := repo.pendingRotationPassword(ctx, projectId, credentialId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if !interrupted {
+		newPassword, err = base62.Random(rotatedPasswordLength)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate password"))
+		}
+		if err := repo.setPendingRotation(ctx, projectId, credentialId, newPassword); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to store pending password"))
+		}
+	}
+	req := &RotateRequest{
+		Address:         p.Address,
+		Username:        cred.Username,
+		CurrentPassword: string(cred.Password),
+		NewPassword:     newPassword,
+		AdminUsername:   admin.Username,
+		AdminPassword:   string(admin.Password),
+		HostKey:         p.HostKey,
+	}
+	rotate := func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, rotationTimeout)
+		defer cancel()
+		err := rotator.Rotate(ctx, req)
+		if err != nil && interrupted && p.AdminCredentialId == "" {
+			// If the interrupted rotation changed the password, the
+			// credential can only authenticate with the new password.
+			retry := *req
+			retry.CurrentPassword, retry.AdminPassword = newPassword, newPassword
+			if rotator.Rotate(ctx, &retry) == nil {
+				return nil
+			}
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("%s rotator failed", p.Rotator)))
+		}
+		return nil
+	}
+	if err := repo.commitRotation(ctx, projectId, cred, newPassword, rotate); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// recordRotation adds the outcome of a rotation to the rotation history and
+// updates the credential's rotation policy accordingly.
+func (j *CredentialRotationJob) recordRotation(ctx context.Context, credentialId string, trigger RotationTrigger, start time.Time, rotateErr error) error {
+	const op = "static.(CredentialRotationJob).recordRotation"
+	id, err := db.NewPrivateId(rotationIdPrefix)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	status, policyQuery, msg := SucceededRotationStatus, rotationSucceededQuery, ""
+	if rotateErr != nil {
+		status, policyQuery, msg = FailedRotationStatus, rotationFailedQuery, rotateErr.Error()
+	}
+	_, err = j.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, insertRotationQuery, []any{id, credentialId, string(trigger), string(status), msg, start}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if _, err := w.Exec(ctx, policyQuery, []any{credentialId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn determines when the next credential rotation job should run.
+func (j *CredentialRotationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return rotationJobRunInterval, nil
+}
+
+// Name is the unique name of the job.
+func (j *CredentialRotationJob) Name() string {
+	return credentialRotationJobName
+}
+
+// Description is the human readable description of the job.
+func (j *CredentialRotationJob) Description() string {
+	return "Periodically rotates the passwords of static username password credentials according to their rotation policies."
+}
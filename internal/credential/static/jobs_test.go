@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRotator struct {
+	mu   sync.Mutex
+	reqs []*RotateRequest
+	err  error
+}
+
+func (r *testRotator) Rotate(_ context.Context, req *RotateRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reqs = append(r.reqs, req)
+	return r.err
+}
+
+func (r *testRotator) requests() []*RotateRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*RotateRequest(nil), r.reqs...)
+}
+
+func TestNewCredentialRotationJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	_, err := newCredentialRotationJob(ctx, nil, rw, kmsCache, nil)
+	assert.Error(t, err)
+	_, err = newCredentialRotationJob(ctx, rw, nil, kmsCache, nil)
+	assert.Error(t, err)
+	_, err = newCredentialRotationJob(ctx, rw, rw, nil, nil)
+	assert.Error(t, err)
+
+	got, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, nil)
+	require.NoError(t, err)
+	assert.Equal(t, db.DefaultLimit, got.limit)
+	assert.Equal(t, credentialRotationJobName, got.Name())
+	next, err := got.NextRunIn(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, rotationJobRunInterval, next)
+	assert.Equal(t, scheduler.JobStatus{}, got.Status())
+}
+
+func TestCredentialRotationJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+	admin := TestUsernamePasswordCredential(t, conn, wrapper, "admin", "secret", cs.PublicId, prj.PublicId)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	p, err := NewRotationPolicy(cred.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true), WithAdminCredentialId(admin.PublicId))
+	require.NoError(t, err)
+	_, err = repo.SetRotationPolicy(ctx, prj.PublicId, p)
+	require.NoError(t, err)
+
+	rotator := &testRotator{}
+	job, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, map[string]Rotator{PostgresRotator: rotator})
+	require.NoError(t, err)
+
+	markPending := func(t *testing.T) {
+		t.Helper()
+		_, err := rw.Exec(ctx, "update credential_static_rotation_policy set rotation_pending = true where credential_id = ?", []any{cred.PublicId})
+		require.NoError(t, err)
+	}
+	password := func(t *testing.T) string {
+		t.Helper()
+		creds, err := repo.Retrieve(ctx, prj.PublicId, []string{cred.PublicId})
+		require.NoError(t, err)
+		require.Len(t, creds, 1)
+		return string(creds[0].(*UsernamePasswordCredential).Password)
+	}
+
+	// Nothing is due until a session using the credential terminates.
+	require.NoError(t, job.Run(ctx))
+	assert.Empty(t, rotator.requests())
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		markPending(t)
+		require.NoError(job.Run(ctx))
+		assert.Equal(1, job.Status().Total)
+		assert.Equal(1, job.Status().Completed)
+
+		reqs := rotator.requests()
+		require.Len(reqs, 1)
+		assert.Equal("db:5432", reqs[0].Address)
+		assert.Equal("user", reqs[0].Username)
+		assert.Equal("pass", reqs[0].CurrentPassword)
+		assert.Equal("admin", reqs[0].AdminUsername)
+		assert.Equal("secret", reqs[0].AdminPassword)
+		assert.Len(reqs[0].NewPassword, rotatedPasswordLength)
+		assert.Equal(reqs[0].NewPassword, password(t))
+
+		got, err := repo.LookupRotationPolicy(ctx, cred.PublicId)
+		require.NoError(err)
+		assert.False(got.RotationPending)
+		assert.NotNil(got.LastRotationTime)
+
+		rotations, err := repo.ListRotations(ctx, cred.PublicId)
+		require.NoError(err)
+		require.Len(rotations, 1)
+		assert.Equal(SessionRotationTrigger, rotations[0].Trigger)
+		assert.Equal(SucceededRotationStatus, rotations[0].Status)
+		assert.Empty(rotations[0].Error)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		before := password(t)
+		rotator.err = fmt.Errorf("connection refused")
+		markPending(t)
+		require.NoError(job.Run(ctx))
+		reqs := rotator.requests()
+		require.Len(reqs, 2)
+		assert.Equal(before, password(t))
+
+		// The new password was stored before the rotator ran.
+		pending, ok, err := repo.pendingRotationPassword(ctx, prj.PublicId, cred.PublicId)
+		require.NoError(err)
+		assert.True(ok)
+		assert.Equal(reqs[1].NewPassword, pending)
+
+		got, err := repo.LookupRotationPolicy(ctx, cred.PublicId)
+		require.NoError(err)
+		assert.True(got.RotationPending)
+		assert.NotNil(got.LastFailureTime)
+
+		rotations, err := repo.ListRotations(ctx, cred.PublicId)
+		require.NoError(err)
+		require.Len(rotations, 2)
+		assert.Equal(FailedRotationStatus, rotations[0].Status)
+		assert.Contains(rotations[0].Error, "connection refused")
+
+		// Failed rotations are not retried until the backoff has elapsed.
+		require.NoError(job.Run(ctx))
+		assert.Len(rotator.requests(), 2)
+	})
+
+	t.Run("retry-pending", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		pending, ok, err := repo.pendingRotationPassword(ctx, prj.PublicId, cred.PublicId)
+		require.NoError(err)
+		require.True(ok)
+
+		rotator.err = nil
+		_, err = rw.Exec(ctx, "update credential_static_rotation_policy set last_failure_time = now() - interval '1 hour' where credential_id = ?", []any{cred.PublicId})
+		require.NoError(err)
+		require.NoError(job.Run(ctx))
+
+		reqs := rotator.requests()
+		require.Len(reqs, 3)
+		assert.Equal(pending, reqs[2].NewPassword)
+		assert.Equal(pending, password(t))
+
+		_, ok, err = repo.pendingRotationPassword(ctx, prj.PublicId, cred.PublicId)
+		require.NoError(err)
+		assert.False(ok)
+	})
+}
@@ -27,8 +27,8 @@ type JsonCredential struct {
 }
 
 // NewJsonCredential creates a new in memory static Credential containing a
-// json secret that is assigned to storeId. Name and description are the only
-// valid options. All other options are ignored.
+// json secret that is assigned to storeId. Name, description and max concurrent
+// checkouts are the only valid options. All other options are ignored.
 func NewJsonCredential(
 	ctx context.Context,
 	storeId string,
@@ -52,10 +52,11 @@ func NewJsonCredential(
 	opts := getOpts(opt...)
 	jsonCred := &JsonCredential{
 		JsonCredential: &store.JsonCredential{
-			StoreId:     storeId,
-			Name:        opts.withName,
-			Description: opts.withDescription,
-			Object:      objectB,
+			StoreId:                storeId,
+			Name:                   opts.withName,
+			Description:            opts.withDescription,
+			MaxConcurrentCheckouts: opts.withMaxConcurrentCheckouts,
+			Object:                 objectB,
 		},
 	}
 
@@ -17,11 +17,18 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName                 string
-	withDescription          string
-	withLimit                int
-	withPublicId             string
-	withPrivateKeyPassphrase []byte
+	withName                   string
+	withDescription            string
+	withLimit                  int
+	withPublicId               string
+	withPrivateKeyPassphrase   []byte
+	withCaBundle               []byte
+	withMaxConcurrentCheckouts uint32
+	withAdminCredentialId      string
+	withHostKey                string
+	withRotateAfterSession     bool
+	withRotationIntervalHours  uint32
+	withRetainedVersions       int
 }
 
 func getDefaultOptions() options {
@@ -58,9 +65,67 @@ func WithPublicId(name string) Option {
 	}
 }
 
-// WithPrivateKeyPassphrase provides an optional SSH private key passphrase to use.
+// WithPrivateKeyPassphrase provides an optional private key passphrase to use.
 func WithPrivateKeyPassphrase(with []byte) Option {
 	return func(o *options) {
 		o.withPrivateKeyPassphrase = with
 	}
 }
+
+// WithCaBundle provides an optional PEM encoded bundle of CA certificates
+// to use.
+func WithCaBundle(with []byte) Option {
+	return func(o *options) {
+		o.withCaBundle = with
+	}
+}
+
+// WithMaxConcurrentCheckouts provides an optional maximum number of sessions
+// which may check out a credential at the same time.
+func WithMaxConcurrentCheckouts(with uint32) Option {
+	return func(o *options) {
+		o.withMaxConcurrentCheckouts = with
+	}
+}
+
+// WithAdminCredentialId provides an optional id of a username password
+// credential used to authenticate when rotating a credential's password.
+func WithAdminCredentialId(with string) Option {
+	return func(o *options) {
+		o.withAdminCredentialId = with
+	}
+}
+
+// WithHostKey provides an optional public key, in authorized_keys format,
+// the host key of an ssh server must match when rotating a credential's
+// password with the ssh rotator.
+func WithHostKey(with string) Option {
+	return func(o *options) {
+		o.withHostKey = with
+	}
+}
+
+// WithRotateAfterSession provides an option to rotate a credential's password
+// after the sessions using it have terminated.
+func WithRotateAfterSession(with bool) Option {
+	return func(o *options) {
+		o.withRotateAfterSession = with
+	}
+}
+
+// WithRotationIntervalHours provides an optional number of hours between
+// periodic rotations of a credential's password.
+func WithRotationIntervalHours(with uint32) Option {
+	return func(o *options) {
+		o.withRotationIntervalHours = with
+	}
+}
+
+// WithRetainedVersions provides an optional number of versions of each
+// static credential to retain. Zero signals DefaultRetainedVersions should
+// be used.
+func WithRetainedVersions(with int) Option {
+	return func(o *options) {
+		o.withRetainedVersions = with
+	}
+}
@@ -36,4 +36,53 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrivateKeyPassphrase = []byte("my-pass")
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithCaBundle", func(t *testing.T) {
+		opts := getOpts(WithCaBundle([]byte("my-bundle")))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withCaBundle = []byte("my-bundle")
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithMaxConcurrentCheckouts", func(t *testing.T) {
+		opts := getOpts(WithMaxConcurrentCheckouts(2))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withMaxConcurrentCheckouts = 2
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAdminCredentialId", func(t *testing.T) {
+		opts := getOpts(WithAdminCredentialId("credup_1234567890"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withAdminCredentialId = "credup_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithHostKey", func(t *testing.T) {
+		opts := getOpts(WithHostKey("ssh-ed25519 AAAA"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withHostKey = "ssh-ed25519 AAAA"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRotateAfterSession", func(t *testing.T) {
+		opts := getOpts(WithRotateAfterSession(true))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withRotateAfterSession = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRotationIntervalHours", func(t *testing.T) {
+		opts := getOpts(WithRotationIntervalHours(24))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withRotationIntervalHours = 24
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRetainedVersions", func(t *testing.T) {
+		opts := getOpts(WithRetainedVersions(5))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withRetainedVersions = 5
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -40,4 +40,175 @@ from credential_static_json_credential json
 where store.project_id = ?
   and json.key_id = ?;
 `
+
+	credStaticTlsClientCertRewrapQuery = `
+select distinct
+  tls.public_id,
+  tls.private_key_encrypted,
+  tls.private_key_passphrase_encrypted,
+  tls.key_id
+from credential_static_tls_client_certificate_credential tls
+  inner join credential_static_store store
+    on store.public_id = tls.store_id
+where store.project_id = ?
+  and tls.key_id = ?;
+`
+)
+
+const (
+	listCheckoutsQuery = `
+select co.credential_static_id,
+       co.session_id,
+       coalesce(s.user_id, ''),
+       co.create_time,
+       s.expiration_time
+  from credential_static_checkout co
+  join session s
+    on s.public_id = co.session_id
+ where co.credential_static_id = ?
+ order by co.create_time, co.session_id;
+`
+
+	cancelSessionQuery = `
+select cancel_session(?);
+`
+)
+
+const (
+	listRotationsQuery = `
+select credential_id,
+       trigger,
+       status,
+       coalesce(error, ''),
+       start_time,
+       end_time
+  from credential_static_rotation
+ where credential_id = ?
+ order by start_time desc, private_id
+ limit ?;
+`
+
+	// rotationsDueQuery returns the credentials whose password is due to be
+	// rotated. A credential is skipped while any session holds a check-out
+	// of it, and for a while after a failed rotation.
+	rotationsDueQuery = `
+select p.credential_id,
+       s.project_id,
+       p.rotation_pending
+  from credential_static_rotation_policy p
+  join credential_static_store s
+    on s.public_id = p.store_id
+ where (p.rotation_pending
+        or (p.rotation_interval_hours is not null
+            and coalesce(p.last_rotation_time, p.create_time) + make_interval(hours => p.rotation_interval_hours) <= now()))
+   and (p.last_failure_time is null
+        or p.last_failure_time <= now() - make_interval(secs => ?))
+   and not exists (
+         select
+           from credential_static_checkout co
+          where co.credential_static_id = p.credential_id
+       )
+ order by p.credential_id
+ limit ?;
+`
+
+	// lockRotationQuery locks the rotation policy and the credential for the
+	// duration of a rotation. Locking the credential blocks sessions from
+	// checking it out until the rotation is committed or rolled back. No row
+	// is returned if a session checked the credential out after it was found
+	// due.
+	lockRotationQuery = `
+select p.credential_id
+  from credential_static_rotation_policy p
+  join credential_static c
+    on c.public_id = p.credential_id
+ where p.credential_id = ?
+   and not exists (
+         select
+           from credential_static_checkout co
+          where co.credential_static_id = p.credential_id
+       )
+   for update of p, c;
+`
+
+	deletePendingRotationQuery = `
+delete from credential_static_rotation_pending
+ where credential_id = ?;
+`
+
+	rotationSucceededQuery = `
+update credential_static_rotation_policy
+   set rotation_pending   = false,
+       last_rotation_time = now(),
+       last_failure_time  = null
+ where credential_id = ?;
+`
+
+	rotationFailedQuery = `
+update credential_static_rotation_policy
+   set last_failure_time = now()
+ where credential_id = ?;
+`
+
+	insertRotationQuery = `
+insert into credential_static_rotation
+  (private_id, credential_id, trigger, status, error, start_time)
+values
+  (?, ?, ?, ?, nullif(?, ''), ?);
+`
+
+	credStaticRotationPendingRewrapQuery = `
+select distinct
+  pen.credential_id,
+  pen.password_encrypted,
+  pen.key_id
+from credential_static_rotation_pending pen
+  inner join credential_static_rotation_policy pol
+    on pol.credential_id = pen.credential_id
+  inner join credential_static_store store
+    on store.public_id = pol.store_id
+where store.project_id = ?
+  and pen.key_id = ?;
+`
+)
+
+const (
+	listCredentialVersionsQuery = `
+select credential_id,
+       version,
+       create_time
+  from credential_static_version
+ where credential_id = ?
+ order by version desc
+ limit ?;
+`
+
+	// deleteExpiredCredentialVersionsQuery deletes the versions of a
+	// credential older than the number of versions to retain.
+	deleteExpiredCredentialVersionsQuery = `
+delete from credential_static_version
+ where credential_id = ?
+   and version not in (
+         select version
+           from credential_static_version
+          where credential_id = ?
+          order by version desc
+          limit ?
+       );
+`
+
+	credStaticVersionRewrapQuery = `
+select distinct
+  ver.credential_id,
+  ver.version,
+  ver.data_encrypted,
+  ver.key_id
+from credential_static_version ver
+  inner join credential_static cred
+    on cred.public_id = ver.credential_id
+  inner join credential_static_store store
+    on store.public_id = cred.store_id
+where store.project_id = ?
+  and ver.key_id = ?;
+`
 )
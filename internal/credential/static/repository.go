@@ -20,12 +20,16 @@ type Repository struct {
 	// defaultLimit provides a default for limiting the number of results
 	// returned from the repo
 	defaultLimit int
+	// retainedVersions is the number of versions of each static credential
+	// retained in the repo
+	retainedVersions int
 }
 
 // NewRepository creates a new Repository. The returned repository should
 // only be used for one transaction and it is not safe for concurrent go
 // routines to access it. WithLimit option is used as a repo wide default
-// limit applied to all ListX methods.
+// limit applied to all ListX methods. WithRetainedVersions option sets the
+// number of versions of each static credential retained by the repository.
 func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "static.NewRepository"
 	switch {
@@ -42,11 +46,16 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		// zero signals the boundary defaults should be used.
 		opts.withLimit = db.DefaultLimit
 	}
+	if opts.withRetainedVersions == 0 {
+		// zero signals the default should be used.
+		opts.withRetainedVersions = DefaultRetainedVersions
+	}
 
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:           r,
+		writer:           w,
+		kms:              kms,
+		defaultLimit:     opts.withLimit,
+		retainedVersions: opts.withRetainedVersions,
 	}, nil
 }
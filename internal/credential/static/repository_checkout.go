@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// ListCheckouts returns the check-outs of the static credential credentialId
+// currently held by sessions, ordered by the time they were checked out.
+func (r *Repository) ListCheckouts(ctx context.Context, credentialId string, _ ...Option) ([]*Checkout, error) {
+	const op = "static.(Repository).ListCheckouts"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	rows, err := r.reader.Query(ctx, listCheckoutsQuery, []any{credentialId})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var checkouts []*Checkout
+	for rows.Next() {
+		c := &Checkout{
+			CreateTime:     &timestamp.Timestamp{},
+			ExpirationTime: &timestamp.Timestamp{},
+		}
+		if err := rows.Scan(
+			&c.CredentialId,
+			&c.SessionId,
+			&c.UserId,
+			c.CreateTime,
+			c.ExpirationTime,
+		); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		checkouts = append(checkouts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return checkouts, nil
+}
+
+// CheckIn forcibly releases the check-out of the static credential
+// credentialId held by the session sessionId, making it available to other
+// sessions, and cancels the session so it can no longer use the credential.
+// The check-in is written to the oplog of the project projectId. CheckIn
+// returns the number of check-outs released, which is 0 if the session does
+// not hold a check-out of the credential.
+func (r *Repository) CheckIn(ctx context.Context, projectId, credentialId, sessionId string, _ ...Option) (int, error) {
+	const op = "static.(Repository).CheckIn"
+	switch {
+	case projectId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case credentialId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case sessionId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rowsDeleted = 0
+			c := allocCheckout()
+			if err := reader.LookupWhere(ctx, c, "credential_static_id = ? and session_id = ?", []any{credentialId, sessionId}); err != nil {
+				if errors.IsNotFoundError(err) {
+					return nil
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			var err error
+			rowsDeleted, err = w.Delete(ctx, c, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 check-out would have been released")
+			}
+			// The session must not keep using a credential which may be
+			// rotated as soon as it is checked in.
+			if _, err := w.Exec(ctx, cancelSessionQuery, []any{sessionId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to cancel session"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", credentialId)))
+	}
+	return rowsDeleted, nil
+}
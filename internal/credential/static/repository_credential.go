@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/hashicorp/go-dbw"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
 
 // CreateUsernamePasswordCredential inserts c into the repository and returns a new
@@ -79,12 +80,15 @@ func (r *Repository) CreateUsernamePasswordCredential(
 
 	var newCred *UsernamePasswordCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newCred = c.clone()
 			if err := w.Create(ctx, newCred,
 				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if err := r.recordVersion(ctx, reader, w, projectId, newCred.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 
 			return nil
 		},
@@ -167,12 +171,15 @@ func (r *Repository) CreateSshPrivateKeyCredential(
 
 	var newCred *SshPrivateKeyCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newCred = c.clone()
 			if err := w.Create(ctx, newCred,
 				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if err := r.recordVersion(ctx, reader, w, projectId, newCred.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 
 			return nil
 		},
@@ -254,12 +261,15 @@ func (r *Repository) CreateJsonCredential(
 
 	var newCred *JsonCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			newCred = c.clone()
 			if err := w.Create(ctx, newCred,
 				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			if err := r.recordVersion(ctx, reader, w, projectId, newCred.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 
 			return nil
 		},
@@ -278,6 +288,101 @@ func (r *Repository) CreateJsonCredential(
 	return newCred, nil
 }
 
+// CreateTlsClientCertificateCredential inserts c into the repository and
+// returns a new TlsClientCertificateCredential containing the credential's
+// PublicId. c is not changed. c must not contain a PublicId. The PublicId is
+// generated and assigned by this method. c must contain a valid StoreId.
+//
+// The private key is encrypted and a HmacSha256 of the private key is
+// calculated. If a passphrase is supplied, it is also encrypted and an
+// HmacSha256 of passphrase is calculated. Only the PrivateKeyHmac (and
+// PrivateKeyPassphraseHmac) is returned, the plain-text and encrypted private
+// key and passphrase are not returned.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.ProjectId. Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateTlsClientCertificateCredential(
+	ctx context.Context,
+	projectId string,
+	c *TlsClientCertificateCredential,
+	_ ...Option,
+) (*TlsClientCertificateCredential, error) {
+	const op = "static.(Repository).CreateTlsClientCertificateCredential"
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	if c.TlsClientCertificateCredential == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if c.Certificate == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing certificate")
+	}
+	if c.PrivateKey == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing private key")
+	}
+	if c.StoreId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	if c.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+
+	c = c.clone()
+	id, err := credential.NewTlsClientCertificateCredentialId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c.PublicId = id
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	// encrypt
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var newCred *TlsClientCertificateCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			newCred = c.clone()
+			if err := w.Create(ctx, newCred,
+				db.WithOplog(oplogWrapper, newCred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := r.recordVersion(ctx, reader, w, projectId, newCred.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s: name %s already exists", c.StoreId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s", c.StoreId)))
+	}
+
+	// Clear private key fields, only PrivateKeyHmac should be returned
+	newCred.PrivateKeyEncrypted = nil
+	newCred.PrivateKey = nil
+
+	// Clear passphrase fields, only PrivateKeyPassphraseHmac should be returned if it exists
+	newCred.PrivateKeyPassphraseEncrypted = nil
+	newCred.PrivateKeyPassphrase = nil
+
+	return newCred, nil
+}
+
 // LookupCredential returns the Credential for the publicId. Returns
 // nil, nil if no Credential is found for the publicId.
 // TODO: This should hit a view and return the interface type...
@@ -334,6 +439,23 @@ func (r *Repository) LookupCredential(ctx context.Context, publicId string, _ ..
 		jsonCred.ObjectEncrypted = nil
 		jsonCred.Object = nil
 		cred = jsonCred
+
+	case credential.TlsClientCertificateSubtype:
+		tlsCred := allocTlsClientCertificateCredential()
+		tlsCred.PublicId = publicId
+		if err := r.reader.LookupByPublicId(ctx, tlsCred); err != nil {
+			if errors.IsNotFoundError(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+		}
+		// Clear private key fields, only PrivateKeyHmac should be returned
+		tlsCred.PrivateKeyEncrypted = nil
+		tlsCred.PrivateKey = nil
+		// Clear passphrase fields, only PrivateKeyPassphraseHmac should be returned if it exists
+		tlsCred.PrivateKeyPassphraseEncrypted = nil
+		tlsCred.PrivateKeyPassphrase = nil
+		cred = tlsCred
 	}
 
 	return cred, nil
@@ -344,8 +466,8 @@ func (r *Repository) LookupCredential(ctx context.Context, publicId string, _ ..
 // new UsernamePasswordCredential containing the updated values and a count of the
 // number of records updated. c is not changed.
 //
-// c must contain a valid PublicId. Only Name, Description, Username and Password can be
-// changed. If c.Name is set to a non-empty string, it must be unique within c.ProjectId.
+// c must contain a valid PublicId. Only Name, Description, MaxConcurrentCheckouts,
+// Username and Password can be changed. If c.Name is set to a non-empty string, it must be unique within c.ProjectId.
 //
 // An attribute of c will be set to NULL in the database if the attribute
 // in c is the zero value and it is included in fieldMaskPaths.
@@ -381,6 +503,7 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 		switch {
 		case strings.EqualFold(nameField, f):
 		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(maxConcurrentCheckoutsField, f):
 		case strings.EqualFold(usernameField, f):
 		case strings.EqualFold(passwordField, f):
 		default:
@@ -389,10 +512,11 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 	}
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:        c.Name,
-			descriptionField: c.Description,
-			usernameField:    c.Username,
-			passwordField:    c.Password,
+			nameField:                   c.Name,
+			descriptionField:            c.Description,
+			maxConcurrentCheckoutsField: c.MaxConcurrentCheckouts,
+			usernameField:               c.Username,
+			passwordField:               c.Password,
 		},
 		fieldMaskPaths,
 		nil,
@@ -426,7 +550,7 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 	var rowsUpdated int
 	var returnedCredential *UsernamePasswordCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedCredential = c.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedCredential,
@@ -439,6 +563,11 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				if err := r.recordVersion(ctx, reader, w, projectId, returnedCredential.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -458,8 +587,8 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 // new SshPrivateKeyCredential containing the updated values and a count of the
 // number of records updated. c is not changed.
 //
-// c must contain a valid PublicId. Only Name, Description, Username,
-// PrivateKey and PrivateKeyPassphrase can be changed. If c.Name is set to a non-empty string, it
+// c must contain a valid PublicId. Only Name, Description, MaxConcurrentCheckouts,
+// Username, PrivateKey and PrivateKeyPassphrase can be changed. If c.Name is set to a non-empty string, it
 // must be unique within c.ProjectId.
 //
 // An attribute of c will be set to NULL in the database if the attribute in c
@@ -496,6 +625,7 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 		switch {
 		case strings.EqualFold(nameField, f):
 		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(maxConcurrentCheckoutsField, f):
 		case strings.EqualFold(usernameField, f):
 		case strings.EqualFold(privateKeyField, f):
 		case strings.EqualFold(PrivateKeyPassphraseField, f):
@@ -505,11 +635,12 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 	}
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:                 c.Name,
-			descriptionField:          c.Description,
-			usernameField:             c.Username,
-			privateKeyField:           c.PrivateKey,
-			PrivateKeyPassphraseField: c.PrivateKeyPassphrase,
+			nameField:                   c.Name,
+			descriptionField:            c.Description,
+			maxConcurrentCheckoutsField: c.MaxConcurrentCheckouts,
+			usernameField:               c.Username,
+			privateKeyField:             c.PrivateKey,
+			PrivateKeyPassphraseField:   c.PrivateKeyPassphrase,
 		},
 		fieldMaskPaths,
 		nil,
@@ -555,7 +686,7 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 	var rowsUpdated int
 	var returnedCredential *SshPrivateKeyCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedCredential = c.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedCredential,
@@ -568,6 +699,11 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				if err := r.recordVersion(ctx, reader, w, projectId, returnedCredential.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -591,8 +727,8 @@ func (r *Repository) UpdateSshPrivateKeyCredential(ctx context.Context,
 // new JsonCredential containing the updated values and a count of the
 // number of records updated. c is not changed.
 //
-// c must contain a valid PublicId. Only Name, Description and
-// Json can be changed. If c.Name is set to a non-empty string, it must be
+// c must contain a valid PublicId. Only Name, Description,
+// MaxConcurrentCheckouts and Json can be changed. If c.Name is set to a non-empty string, it must be
 // unique within c.ProjectId.
 //
 // An attribute of c will be set to NULL in the database if the attribute in c
@@ -647,6 +783,7 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 		switch {
 		case strings.EqualFold(nameField, f):
 		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(maxConcurrentCheckoutsField, f):
 		case strings.EqualFold(objectField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
@@ -654,9 +791,10 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	}
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:        c.Name,
-			descriptionField: c.Description,
-			objectField:      c.Object,
+			nameField:                   c.Name,
+			descriptionField:            c.Description,
+			maxConcurrentCheckoutsField: c.MaxConcurrentCheckouts,
+			objectField:                 c.Object,
 		},
 		reducedFieldMaskPaths,
 		nil,
@@ -688,7 +826,7 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	var rowsUpdated int
 	var returnedCredential *JsonCredential
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedCredential = c.clone()
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedCredential,
@@ -701,6 +839,11 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 1 {
+				if err := r.recordVersion(ctx, reader, w, projectId, returnedCredential.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			return nil
 		},
 	)
@@ -715,8 +858,189 @@ func (r *Repository) UpdateJsonCredential(ctx context.Context,
 	return returnedCredential, rowsUpdated, nil
 }
 
-// ListCredentials returns a slice of UsernamePasswordCredentials, SshPrivateKeyCredentials, and JsonCredentials
-// for the storeId. WithLimit is the only option supported.
+// UpdateTlsClientCertificateCredential updates the repository entry for
+// c.PublicId with the values in c for the fields listed in fieldMaskPaths. It
+// returns a new TlsClientCertificateCredential containing the updated values
+// and a count of the number of records updated. c is not changed.
+//
+// c must contain a valid PublicId. Only Name, Description,
+// MaxConcurrentCheckouts, Certificate, PrivateKey, PrivateKeyPassphrase and
+// CaBundle can be changed. If c.Name is
+// set to a non-empty string, it must be unique within c.ProjectId. If the
+// Certificate, PrivateKey or PrivateKeyPassphrase is changed, the resulting
+// private key must match the leaf certificate of the resulting certificate
+// chain.
+//
+// An attribute of c will be set to NULL in the database if the attribute in c
+// is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateTlsClientCertificateCredential(ctx context.Context,
+	projectId string,
+	c *TlsClientCertificateCredential,
+	version uint32,
+	fieldMaskPaths []string,
+	_ ...Option,
+) (*TlsClientCertificateCredential, int, error) {
+	const op = "static.(Repository).UpdateTlsClientCertificateCredential"
+	if c == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential")
+	}
+	if c.TlsClientCertificateCredential == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded credential")
+	}
+	if c.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if projectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if c.StoreId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	c = c.clone()
+
+	var updateKeyPair bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(maxConcurrentCheckoutsField, f):
+		case strings.EqualFold(caBundleField, f):
+		case strings.EqualFold(certificateField, f),
+			strings.EqualFold(privateKeyField, f),
+			strings.EqualFold(PrivateKeyPassphraseField, f):
+			updateKeyPair = true
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:                   c.Name,
+			descriptionField:            c.Description,
+			maxConcurrentCheckoutsField: c.MaxConcurrentCheckouts,
+			certificateField:            c.Certificate,
+			privateKeyField:             c.PrivateKey,
+			PrivateKeyPassphraseField:   c.PrivateKeyPassphrase,
+			caBundleField:               c.CaBundle,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	var databaseWrapper wrapping.Wrapper
+	if updateKeyPair {
+		var err error
+		databaseWrapper, err = r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+
+		// The key pair is validated as a whole, so fill in the values which
+		// are not being updated from the stored credential.
+		orig := allocTlsClientCertificateCredential()
+		orig.PublicId = c.PublicId
+		if err := r.reader.LookupByPublicId(ctx, orig); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", c.PublicId)))
+		}
+		if err := orig.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		certificate, privateKey, passphrase := orig.Certificate, orig.PrivateKey, orig.PrivateKeyPassphrase
+		for _, f := range fieldMaskPaths {
+			switch {
+			case strings.EqualFold(certificateField, f):
+				certificate = c.Certificate
+			case strings.EqualFold(privateKeyField, f):
+				privateKey = c.PrivateKey
+			case strings.EqualFold(PrivateKeyPassphraseField, f):
+				passphrase = c.PrivateKeyPassphrase
+			}
+		}
+		if err := validateCertificateChain(ctx, certificate); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if err := validateKeyPair(ctx, certificate, privateKey, passphrase); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		c.PrivateKey, c.PrivateKeyPassphrase = privateKey, passphrase
+	}
+
+	var performedEncryption bool
+	for _, f := range fieldMaskPaths {
+		if strings.EqualFold(privateKeyField, f) || strings.EqualFold(PrivateKeyPassphraseField, f) {
+			if !performedEncryption {
+				// We don't need to encrypt twice so keep track
+				performedEncryption = true
+				if err := c.encrypt(ctx, databaseWrapper); err != nil {
+					return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+				}
+			}
+
+			dbMask = append(dbMask, "KeyId")
+			if strings.EqualFold(privateKeyField, f) {
+				// Set PrivateKeyHmac and PrivateKeyEncrypted masks for update.
+				dbMask = append(dbMask, "PrivateKeyHmac", "PrivateKeyEncrypted")
+			}
+			if strings.EqualFold(PrivateKeyPassphraseField, f) {
+				// Set PassphraseHmac and PassphraseEncrypted masks for update.
+				dbMask = append(dbMask, "PrivateKeyPassphraseHmac", "PrivateKeyPassphraseEncrypted")
+			}
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected,
+			errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredential *TlsClientCertificateCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedCredential = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredential,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, returnedCredential.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			if rowsUpdated == 1 {
+				if err := r.recordVersion(ctx, reader, w, projectId, returnedCredential.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+
+	// Clear private key fields, only PrivateKeyHmac should be returned
+	returnedCredential.PrivateKeyEncrypted = nil
+	returnedCredential.PrivateKey = nil
+
+	// Clear passphrase fields, only PrivateKeyPassphraseHmac should be returned if it exists
+	returnedCredential.PrivateKeyPassphraseEncrypted = nil
+	returnedCredential.PrivateKeyPassphrase = nil
+
+	return returnedCredential, rowsUpdated, nil
+}
+
+// ListCredentials returns a slice of UsernamePasswordCredentials, SshPrivateKeyCredentials, JsonCredentials
+// and TlsClientCertificateCredentials for the storeId. WithLimit is the only option supported.
 // TODO: This should hit a view and return the interface type...
 func (r *Repository) ListCredentials(ctx context.Context, storeId string, opt ...Option) ([]credential.Static, error) {
 	const op = "static.(Repository).ListCredentials"
@@ -748,7 +1072,13 @@ func (r *Repository) ListCredentials(ctx context.Context, storeId string, opt ..
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
-	ret := make([]credential.Static, 0, len(upCreds)+len(spkCreds)+len(jsonCreds))
+	var tlsCreds []*TlsClientCertificateCredential
+	err = r.reader.SearchWhere(ctx, &tlsCreds, "store_id = ?", []any{storeId}, db.WithLimit(limit))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	ret := make([]credential.Static, 0, len(upCreds)+len(spkCreds)+len(jsonCreds)+len(tlsCreds))
 
 	for _, c := range upCreds {
 		// Clear password fields, only PasswordHmac should be returned
@@ -775,6 +1105,17 @@ func (r *Repository) ListCredentials(ctx context.Context, storeId string, opt ..
 		ret = append(ret, c)
 	}
 
+	for _, c := range tlsCreds {
+		// Clear private key fields, only PrivateKeyHmac should be returned
+		c.PrivateKeyEncrypted = nil
+		c.PrivateKey = nil
+
+		// Clear passphrase fields, only PrivateKeyPassphraseHmac should be returned if it exists
+		c.PrivateKeyPassphraseEncrypted = nil
+		c.PrivateKeyPassphrase = nil
+		ret = append(ret, c)
+	}
+
 	return ret, nil
 }
 
@@ -808,6 +1149,11 @@ func (r *Repository) DeleteCredential(ctx context.Context, projectId, id string,
 		c.PublicId = id
 		input = c
 		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
+	case credential.TlsClientCertificateSubtype:
+		c := allocTlsClientCertificateCredential()
+		c.PublicId = id
+		input = c
+		md = c.oplog(oplog.OpType_OP_TYPE_DELETE)
 	default:
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "unknown type")
 	}
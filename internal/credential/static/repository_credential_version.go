@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// recordVersion records the current state of the static credential
+// credentialId as a new CredentialVersion and deletes the oldest versions of
+// the credential beyond the number of versions the repository retains. It
+// must be called within the transaction that created or updated the
+// credential.
+func (r *Repository) recordVersion(ctx context.Context, reader db.Reader, w db.Writer, projectId, credentialId string) error {
+	const op = "static.(Repository).recordVersion"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	var cred credential.Static
+	var version uint32
+	switch subtypes.SubtypeFromId(credential.Domain, credentialId) {
+	case credential.UsernamePasswordSubtype:
+		upCred := allocUsernamePasswordCredential()
+		upCred.PublicId = credentialId
+		if err := reader.LookupByPublicId(ctx, upCred); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := upCred.decrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		cred, version = upCred, upCred.Version
+	case credential.SshPrivateKeySubtype:
+		spkCred := allocSshPrivateKeyCredential()
+		spkCred.PublicId = credentialId
+		if err := reader.LookupByPublicId(ctx, spkCred); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := spkCred.decrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		cred, version = spkCred, spkCred.Version
+	case credential.JsonSubtype:
+		jsonCred := allocJsonCredential()
+		jsonCred.PublicId = credentialId
+		if err := reader.LookupByPublicId(ctx, jsonCred); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := jsonCred.decrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		cred, version = jsonCred, jsonCred.Version
+	case credential.TlsClientCertificateSubtype:
+		tlsCred := allocTlsClientCertificateCredential()
+		tlsCred.PublicId = credentialId
+		if err := reader.LookupByPublicId(ctx, tlsCred); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := tlsCred.decrypt(ctx, databaseWrapper); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		cred, version = tlsCred, tlsCred.Version
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type for: %s", credentialId))
+	}
+
+	data, err := marshalCredential(ctx, cred)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	v := allocCredentialVersion()
+	v.CredentialId = credentialId
+	v.Version = version
+	v.Data = data
+	if err := v.encrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := w.Create(ctx, v); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := w.Exec(ctx, deleteExpiredCredentialVersionsQuery, []any{credentialId, credentialId, r.retainedVersions}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete expired versions"))
+	}
+	return nil
+}
+
+// ListCredentialVersions returns the versions of the static credential
+// credentialId that are retained in the repository, newest first. The
+// recorded state of the credential is not returned. Supports WithLimit
+// which overrides the limit set in the repository.
+func (r *Repository) ListCredentialVersions(ctx context.Context, credentialId string, opt ...Option) ([]*CredentialVersion, error) {
+	const op = "static.(Repository).ListCredentialVersions"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var limitArg any = limit
+	if limit < 0 {
+		// negative signals no limit, which postgres spells "limit null".
+		limitArg = nil
+	}
+
+	rows, err := r.reader.Query(ctx, listCredentialVersionsQuery, []any{credentialId, limitArg})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var versions []*CredentialVersion
+	for rows.Next() {
+		v := allocCredentialVersion()
+		v.CreateTime = &timestamp.Timestamp{}
+		if err := rows.Scan(
+			&v.CredentialId,
+			&v.Version,
+			v.CreateTime,
+		); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return versions, nil
+}
+
+// LookupCredentialVersion returns the static credential credentialId as it
+// was at version. The secret of the credential is not returned, only its
+// hmac. Returns nil, nil if the version is not retained in the repository.
+func (r *Repository) LookupCredentialVersion(ctx context.Context, projectId, credentialId string, version uint32, _ ...Option) (credential.Static, error) {
+	const op = "static.(Repository).LookupCredentialVersion"
+	cred, err := r.lookupCredentialVersion(ctx, projectId, credentialId, version)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cred == nil {
+		return nil, nil
+	}
+
+	switch c := cred.(type) {
+	case *UsernamePasswordCredential:
+		// Clear password fields, only PasswordHmac should be returned
+		c.Password = nil
+	case *SshPrivateKeyCredential:
+		// Clear private key and passphrase fields, only the hmacs should be returned
+		c.PrivateKey = nil
+		c.PrivateKeyPassphrase = nil
+	case *JsonCredential:
+		// Clear object fields, only ObjectHmac should be returned
+		c.Object = nil
+	case *TlsClientCertificateCredential:
+		// Clear private key and passphrase fields, only the hmacs should be returned
+		c.PrivateKey = nil
+		c.PrivateKeyPassphrase = nil
+	}
+	return cred, nil
+}
+
+// lookupCredentialVersion returns the static credential credentialId as it
+// was at version, including its secret in plaintext.
+func (r *Repository) lookupCredentialVersion(ctx context.Context, projectId, credentialId string, version uint32) (credential.Static, error) {
+	const op = "static.(Repository).lookupCredentialVersion"
+	switch {
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case version == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	v := allocCredentialVersion()
+	if err := r.reader.LookupWhere(ctx, v, "credential_id = ? and version = ?", []any{credentialId, version}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s version %d", credentialId, version)))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase, kms.WithKeyId(v.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := v.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cred, err := unmarshalCredential(ctx, credentialId, v.Data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return cred, nil
+}
+
+// RollbackCredential restores the static credential credentialId to the
+// state it was in at toVersion, including its secret. The rollback is
+// recorded as a new version of the credential. version must match the
+// current version of the credential. The secret of the returned credential
+// is not returned, only its hmac. A credential with a rotation policy can not
+// be rolled back, since its password is managed by the rotator and restoring
+// an old password would leave it out of sync with the target system.
+func (r *Repository) RollbackCredential(ctx context.Context, projectId, credentialId string, toVersion, version uint32, _ ...Option) (credential.Static, error) {
+	const op = "static.(Repository).RollbackCredential"
+	switch {
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case credentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case toVersion == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version to roll back to")
+	case version == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case toVersion >= version:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "version to roll back to must be older than the current version")
+	}
+
+	prev, err := r.lookupCredentialVersion(ctx, projectId, credentialId, toVersion)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if prev == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("version %d of %s is not retained", toVersion, credentialId))
+	}
+
+	var cred credential.Static
+	var rowsUpdated int
+	switch c := prev.(type) {
+	case *UsernamePasswordCredential:
+		var p *RotationPolicy
+		if p, err = r.LookupRotationPolicy(ctx, credentialId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if p != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s has a rotation policy, its password can not be rolled back", credentialId))
+		}
+		cred, rowsUpdated, err = r.UpdateUsernamePasswordCredential(ctx, projectId, c, version,
+			[]string{nameField, descriptionField, maxConcurrentCheckoutsField, usernameField, passwordField})
+	case *SshPrivateKeyCredential:
+		cred, rowsUpdated, err = r.UpdateSshPrivateKeyCredential(ctx, projectId, c, version,
+			[]string{nameField, descriptionField, maxConcurrentCheckoutsField, usernameField, privateKeyField, PrivateKeyPassphraseField})
+	case *JsonCredential:
+		cred, rowsUpdated, err = r.UpdateJsonCredential(ctx, projectId, c, version,
+			[]string{nameField, descriptionField, maxConcurrentCheckoutsField, objectField})
+	case *TlsClientCertificateCredential:
+		cred, rowsUpdated, err = r.UpdateTlsClientCertificateCredential(ctx, projectId, c, version,
+			[]string{nameField, descriptionField, maxConcurrentCheckoutsField, certificateField, privateKeyField, PrivateKeyPassphraseField, caBundleField})
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential type for: %s", credentialId))
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated == 0 {
+		return nil, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("version %d of %s is not the current version", version, credentialId))
+	}
+	return cred, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CredentialVersions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in, err := NewUsernamePasswordCredential(cs.PublicId, "user", "pass", WithName("first"))
+	require.NoError(t, err)
+	v1, err := repo.CreateUsernamePasswordCredential(ctx, prj.PublicId, in)
+	require.NoError(t, err)
+
+	upd, err := NewUsernamePasswordCredential(cs.PublicId, "other", "new-pass", WithName("second"))
+	require.NoError(t, err)
+	upd.PublicId = v1.PublicId
+	v2, _, err := repo.UpdateUsernamePasswordCredential(ctx, prj.PublicId, upd, v1.Version, []string{nameField, usernameField, passwordField})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), v2.Version)
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListCredentialVersions(ctx, v1.PublicId)
+		require.NoError(err)
+		require.Len(got, 2)
+		assert.Equal(uint32(2), got[0].Version)
+		assert.Equal(uint32(1), got[1].Version)
+		for _, v := range got {
+			assert.Equal(v1.PublicId, v.CredentialId)
+			assert.NotNil(v.CreateTime)
+			assert.Empty(v.Data)
+			assert.Empty(v.CtData)
+		}
+
+		got, err = repo.ListCredentialVersions(ctx, v1.PublicId, WithLimit(1))
+		require.NoError(err)
+		assert.Len(got, 1)
+
+		_, err = repo.ListCredentialVersions(ctx, "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupCredentialVersion(ctx, prj.PublicId, v1.PublicId, 1)
+		require.NoError(err)
+		require.IsType(&UsernamePasswordCredential{}, got)
+		up := got.(*UsernamePasswordCredential)
+		assert.Equal(uint32(1), up.Version)
+		assert.Equal("first", up.Name)
+		assert.Equal("user", up.Username)
+		assert.Equal(v1.PasswordHmac, up.PasswordHmac)
+		assert.Empty(up.Password)
+		assert.Empty(up.CtPassword)
+
+		got, err = repo.LookupCredentialVersion(ctx, prj.PublicId, v1.PublicId, 9)
+		require.NoError(err)
+		assert.Nil(got)
+
+		_, err = repo.LookupCredentialVersion(ctx, prj.PublicId, v1.PublicId, 0)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.RollbackCredential(ctx, prj.PublicId, v1.PublicId, 2, 2)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+		_, err = repo.RollbackCredential(ctx, prj.PublicId, v1.PublicId, 1, 3)
+		assert.Truef(errors.Match(errors.T(errors.VersionMismatch), err), "unexpected error %v", err)
+
+		_, err = repo.RollbackCredential(ctx, prj.PublicId, v1.PublicId, 8, 9)
+		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+
+		got, err := repo.RollbackCredential(ctx, prj.PublicId, v1.PublicId, 1, 2)
+		require.NoError(err)
+		up := got.(*UsernamePasswordCredential)
+		assert.Equal(uint32(3), up.Version)
+		assert.Equal("first", up.Name)
+		assert.Equal("user", up.Username)
+		assert.Equal(v1.PasswordHmac, up.PasswordHmac)
+		assert.Empty(up.Password)
+
+		cur, err := repo.LookupCredential(ctx, v1.PublicId)
+		require.NoError(err)
+		assert.Equal(v1.PasswordHmac, cur.(*UsernamePasswordCredential).PasswordHmac)
+
+		versions, err := repo.ListCredentialVersions(ctx, v1.PublicId)
+		require.NoError(err)
+		assert.Len(versions, 3)
+	})
+
+	t.Run("rollback-with-rotation-policy", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p, err := NewRotationPolicy(v1.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true))
+		require.NoError(err)
+		_, err = repo.SetRotationPolicy(ctx, prj.PublicId, p)
+		require.NoError(err)
+
+		_, err = repo.RollbackCredential(ctx, prj.PublicId, v1.PublicId, 2, 3)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+		cur, err := repo.LookupCredential(ctx, v1.PublicId)
+		require.NoError(err)
+		assert.Equal(uint32(3), cur.(*UsernamePasswordCredential).Version)
+	})
+}
+
+func TestRepository_CredentialVersions_Retained(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+
+	repo, err := NewRepository(ctx, rw, rw, kms, WithRetainedVersions(2))
+	require.NoError(t, err)
+
+	in, err := NewUsernamePasswordCredential(cs.PublicId, "user", "pass")
+	require.NoError(t, err)
+	cred, err := repo.CreateUsernamePasswordCredential(ctx, prj.PublicId, in)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		upd, err := NewUsernamePasswordCredential(cs.PublicId, "user", credential.Password(fmt.Sprintf("pass-%d", i)))
+		require.NoError(t, err)
+		upd.PublicId = cred.PublicId
+		cred, _, err = repo.UpdateUsernamePasswordCredential(ctx, prj.PublicId, upd, cred.Version, []string{passwordField})
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint32(4), cred.Version)
+
+	versions, err := repo.ListCredentialVersions(ctx, cred.PublicId)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, uint32(4), versions[0].Version)
+	assert.Equal(t, uint32(3), versions[1].Version)
+
+	got, err := repo.LookupCredentialVersion(ctx, prj.PublicId, cred.PublicId, 1)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = repo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, 1, cred.Version)
+	assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+}
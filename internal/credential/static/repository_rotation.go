@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+// SetRotationPolicy sets the rotation policy of the username password
+// credential p.CredentialId, replacing any existing policy, and returns the
+// policy as stored in the repository. p is not changed.
+//
+// p must contain a valid CredentialId, Rotator and Address, and at least one
+// of RotateAfterSession or RotationIntervalHours. If p.AdminCredentialId is
+// set, it must be a username password credential in the same credential store.
+// p.HostKey is required if p.Rotator is SshRotator and must not be set
+// otherwise, so that the ssh rotator never connects to an unverified server.
+func (r *Repository) SetRotationPolicy(ctx context.Context, projectId string, p *RotationPolicy, _ ...Option) (*RotationPolicy, error) {
+	const op = "static.(Repository).SetRotationPolicy"
+	switch {
+	case p == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing rotation policy")
+	case p.RotationPolicy == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded rotation policy")
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case p.CredentialId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	case !ValidRotator(p.Rotator):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown rotator %q", p.Rotator))
+	case p.Address == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing address")
+	case !p.RotateAfterSession && p.RotationIntervalHours == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "rotate after session or rotation interval hours must be set")
+	}
+	if subtypes.SubtypeFromId(credential.Domain, p.CredentialId) != credential.UsernamePasswordSubtype {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "only username password credentials can be rotated")
+	}
+	if p.AdminCredentialId != "" && subtypes.SubtypeFromId(credential.Domain, p.AdminCredentialId) != credential.UsernamePasswordSubtype {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "admin credential must be a username password credential")
+	}
+	switch {
+	case p.Rotator == SshRotator && p.HostKey == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host key for ssh rotator")
+	case p.Rotator != SshRotator && p.HostKey != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "host key is only supported by the ssh rotator")
+	case p.HostKey != "":
+		if _, err := ParseHostKeys(ctx, p.HostKey); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	p = p.clone()
+
+	cred := allocUsernamePasswordCredential()
+	cred.PublicId = p.CredentialId
+	if err := r.reader.LookupByPublicId(ctx, cred); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", p.CredentialId)))
+	}
+	p.StoreId = cred.StoreId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedPolicy *RotationPolicy
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedPolicy = p.clone()
+			existing := allocRotationPolicy()
+			err := reader.LookupWhere(ctx, existing, "credential_id = ?", []any{p.CredentialId})
+			switch {
+			case errors.IsNotFoundError(err):
+				if err := w.Create(ctx, returnedPolicy, db.WithOplog(oplogWrapper, returnedPolicy.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			default:
+				// Rotator, Address and RotateAfterSession are always set,
+				// the optional fields are cleared when they are not.
+				dbMask := []string{"Rotator", "Address", "RotateAfterSession"}
+				var nullFields []string
+				if returnedPolicy.AdminCredentialId != "" {
+					dbMask = append(dbMask, "AdminCredentialId")
+				} else {
+					nullFields = append(nullFields, "AdminCredentialId")
+				}
+				if returnedPolicy.HostKey != "" {
+					dbMask = append(dbMask, "HostKey")
+				} else {
+					nullFields = append(nullFields, "HostKey")
+				}
+				if returnedPolicy.RotationIntervalHours != 0 {
+					dbMask = append(dbMask, "RotationIntervalHours")
+				} else {
+					nullFields = append(nullFields, "RotationIntervalHours")
+				}
+				rowsUpdated, err := w.Update(ctx, returnedPolicy, dbMask, nullFields,
+					db.WithOplog(oplogWrapper, returnedPolicy.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 rotation policy would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", p.CredentialId)))
+	}
+	return returnedPolicy, nil
+}
+
+// LookupRotationPolicy returns the rotation policy of the credential
+// credentialId. Returns nil, nil if the credential has no rotation policy.
+func (r *Repository) LookupRotationPolicy(ctx context.Context, credentialId string, _ ...Option) (*RotationPolicy, error) {
+	const op = "static.(Repository).LookupRotationPolicy"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	p := allocRotationPolicy()
+	if err := r.reader.LookupWhere(ctx, p, "credential_id = ?", []any{credentialId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", credentialId)))
+	}
+	return p, nil
+}
+
+// DeleteRotationPolicy deletes the rotation policy of the credential
+// credentialId. It returns the number of policies deleted, which is 0 if the
+// credential has no rotation policy. The rotation history is kept.
+func (r *Repository) DeleteRotationPolicy(ctx context.Context, projectId, credentialId string, _ ...Option) (int, error) {
+	const op = "static.(Repository).DeleteRotationPolicy"
+	switch {
+	case projectId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case credentialId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			p := allocRotationPolicy()
+			if err := reader.LookupWhere(ctx, p, "credential_id = ?", []any{credentialId}); err != nil {
+				if errors.IsNotFoundError(err) {
+					return nil
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			var err error
+			rowsDeleted, err = w.Delete(ctx, p, db.WithOplog(oplogWrapper, p.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 rotation policy would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", credentialId)))
+	}
+	return rowsDeleted, nil
+}
+
+// ListRotations returns the rotation history of the credential credentialId,
+// most recent first. WithLimit is the only supported option.
+func (r *Repository) ListRotations(ctx context.Context, credentialId string, opt ...Option) ([]*Rotation, error) {
+	const op = "static.(Repository).ListRotations"
+	if credentialId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var limitArg any = limit
+	if limit < 0 {
+		// negative signals no limit, which postgres spells "limit null".
+		limitArg = nil
+	}
+
+	rows, err := r.reader.Query(ctx, listRotationsQuery, []any{credentialId, limitArg})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var rotations []*Rotation
+	for rows.Next() {
+		rot := &Rotation{
+			StartTime: &timestamp.Timestamp{},
+			EndTime:   &timestamp.Timestamp{},
+		}
+		if err := rows.Scan(
+			&rot.CredentialId,
+			&rot.Trigger,
+			&rot.Status,
+			&rot.Error,
+			rot.StartTime,
+			rot.EndTime,
+		); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rotations = append(rotations, rot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return rotations, nil
+}
+
+// pendingRotationPassword returns the password stored by setPendingRotation
+// for the credential credentialId and true, or false if there is none.
+func (r *Repository) pendingRotationPassword(ctx context.Context, projectId, credentialId string) (string, bool, error) {
+	const op = "static.(Repository).pendingRotationPassword"
+	p := allocPendingRotation()
+	if err := r.reader.LookupWhere(ctx, p, "credential_id = ?", []any{credentialId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", false, nil
+		}
+		return "", false, errors.Wrap(ctx, err, op)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase, kms.WithKeyId(p.KeyId))
+	if err != nil {
+		return "", false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := p.decrypt(ctx, databaseWrapper); err != nil {
+		return "", false, errors.Wrap(ctx, err, op)
+	}
+	return string(p.Password), true, nil
+}
+
+// setPendingRotation stores password, encrypted, as the password the
+// credential credentialId is about to be rotated to. It must be called
+// before the password is changed on the target system.
+func (r *Repository) setPendingRotation(ctx context.Context, projectId, credentialId, password string) error {
+	const op = "static.(Repository).setPendingRotation"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	p := allocPendingRotation()
+	p.CredentialId = credentialId
+	p.Password = []byte(password)
+	if err := p.encrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := r.writer.Create(ctx, p); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// commitRotation changes the password of cred to password by calling rotate
+// and then storing password on cred, in a single transaction which holds a
+// lock on the credential and its rotation policy. Sessions cannot check out
+// the credential while the lock is held. An error with code Conflict is
+// returned, and rotate is not called, if a session holds a check-out of the
+// credential. The pending rotation is removed when the password is stored.
+func (r *Repository) commitRotation(ctx context.Context, projectId string, cred *UsernamePasswordCredential, password string, rotate func(context.Context) error) error {
+	const op = "static.(Repository).commitRotation"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	updated := cred.clone()
+	updated.Password = []byte(password)
+	if err := updated.encrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rows, err := reader.Query(ctx, lockRotationQuery, []any{cred.PublicId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			locked := rows.Next()
+			if err := rows.Err(); err != nil {
+				_ = rows.Close()
+				return errors.Wrap(ctx, err, op)
+			}
+			_ = rows.Close()
+			if !locked {
+				return errors.New(ctx, errors.Conflict, op, "credential is checked out")
+			}
+
+			if err := rotate(ctx); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			returned := updated.clone()
+			version := cred.Version
+			rowsUpdated, err := w.Update(ctx, returned, []string{"PasswordHmac", "CtPassword", "KeyId"}, nil,
+				db.WithOplog(oplogWrapper, returned.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("password was changed but could not be stored"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("password was changed but %d credentials would have been updated", rowsUpdated))
+			}
+			if err := r.recordVersion(ctx, reader, w, projectId, cred.PublicId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if _, err := w.Exec(ctx, deletePendingRotationQuery, []any{cred.PublicId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", cred.PublicId)))
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testHostKey is an ed25519 public key in authorized_keys format.
+const testHostKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJ2Ts4C/3ZTWGdc6j1L3pc7ZBfZPnXT/4C0kXBZc6RmP"
+
+func TestRepository_SetRotationPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	otherCs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+	admin := TestUsernamePasswordCredential(t, conn, wrapper, "admin", "secret", cs.PublicId, prj.PublicId)
+	otherAdmin := TestUsernamePasswordCredential(t, conn, wrapper, "admin", "secret", otherCs.PublicId, prj.PublicId)
+	spk := TestSshPrivateKeyCredential(t, conn, wrapper, "user", string(TestSshPrivateKeyPem), cs.PublicId, prj.PublicId)
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	newPolicy := func(credId, rotator, address string, opt ...Option) *RotationPolicy {
+		p, err := NewRotationPolicy(credId, rotator, address, opt...)
+		require.NoError(t, err)
+		return p
+	}
+
+	tests := []struct {
+		name        string
+		projectId   string
+		policy      *RotationPolicy
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "missing-policy",
+			projectId:   prj.PublicId,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-project-id",
+			policy:      newPolicy(cred.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true)),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "unknown-rotator",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, "telnet", "db:5432", WithRotateAfterSession(true)),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-address",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, PostgresRotator, "", WithRotateAfterSession(true)),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-schedule",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, PostgresRotator, "db:5432"),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "not-username-password",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(spk.PublicId, SshRotator, "host:22", WithRotateAfterSession(true)),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "ssh-missing-host-key",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, SshRotator, "host:22", WithRotateAfterSession(true)),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "ssh-invalid-host-key",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, SshRotator, "host:22", WithRotateAfterSession(true), WithHostKey("not a key")),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "host-key-without-ssh",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true), WithHostKey(testHostKey)),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "admin-in-other-store",
+			projectId:   prj.PublicId,
+			policy:      newPolicy(cred.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true), WithAdminCredentialId(otherAdmin.PublicId)),
+			wantErrCode: errors.NotSpecificIntegrity,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := repo.SetRotationPolicy(ctx, tt.projectId, tt.policy)
+			assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "want err: %q got: %q", tt.wantErrCode, err)
+			assert.Nil(got)
+		})
+	}
+
+	t.Run("create-update-delete", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+
+		got, err := repo.SetRotationPolicy(ctx, prj.PublicId,
+			newPolicy(cred.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true), WithAdminCredentialId(admin.PublicId)))
+		require.NoError(err)
+		assert.Equal(cs.PublicId, got.StoreId)
+		assert.Equal(admin.PublicId, got.AdminCredentialId)
+
+		got, err = repo.SetRotationPolicy(ctx, prj.PublicId,
+			newPolicy(cred.PublicId, SshRotator, "host:22", WithRotationIntervalHours(24), WithHostKey(testHostKey)))
+		require.NoError(err)
+		assert.Equal(SshRotator, got.Rotator)
+
+		found, err := repo.LookupRotationPolicy(ctx, cred.PublicId)
+		require.NoError(err)
+		require.NotNil(found)
+		assert.Equal(SshRotator, found.Rotator)
+		assert.Equal("host:22", found.Address)
+		assert.Equal(testHostKey, found.HostKey)
+		assert.Empty(found.AdminCredentialId)
+		assert.False(found.RotateAfterSession)
+		assert.Equal(uint32(24), found.RotationIntervalHours)
+
+		n, err := repo.DeleteRotationPolicy(ctx, prj.PublicId, cred.PublicId)
+		require.NoError(err)
+		assert.Equal(1, n)
+		n, err = repo.DeleteRotationPolicy(ctx, prj.PublicId, cred.PublicId)
+		require.NoError(err)
+		assert.Equal(0, n)
+
+		found, err = repo.LookupRotationPolicy(ctx, cred.PublicId)
+		require.NoError(err)
+		assert.Nil(found)
+	})
+}
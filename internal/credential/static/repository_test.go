@@ -42,10 +42,11 @@ func TestRepository_New(t *testing.T) {
 				kms: kmsCache,
 			},
 			want: &Repository{
-				reader:       rw,
-				writer:       rw,
-				kms:          kmsCache,
-				defaultLimit: db.DefaultLimit,
+				reader:           rw,
+				writer:           rw,
+				kms:              kmsCache,
+				defaultLimit:     db.DefaultLimit,
+				retainedVersions: DefaultRetainedVersions,
 			},
 		},
 		{
@@ -57,10 +58,27 @@ func TestRepository_New(t *testing.T) {
 				opts: []Option{WithLimit(5)},
 			},
 			want: &Repository{
-				reader:       rw,
-				writer:       rw,
-				kms:          kmsCache,
-				defaultLimit: 5,
+				reader:           rw,
+				writer:           rw,
+				kms:              kmsCache,
+				defaultLimit:     5,
+				retainedVersions: DefaultRetainedVersions,
+			},
+		},
+		{
+			name: "valid-with-retained-versions",
+			args: args{
+				r:    rw,
+				w:    rw,
+				kms:  kmsCache,
+				opts: []Option{WithRetainedVersions(3)},
+			},
+			want: &Repository{
+				reader:           rw,
+				writer:           rw,
+				kms:              kmsCache,
+				defaultLimit:     db.DefaultLimit,
+				retainedVersions: 3,
 			},
 		},
 		{
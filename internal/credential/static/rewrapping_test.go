@@ -248,3 +248,119 @@ func TestRewrap_credStaticJsonRewrapFn(t *testing.T) {
 		assert.Equal(t, cred.GetObjectHmac(), got.GetObjectHmac())
 	})
 }
+
+func TestRewrap_credStaticVersionRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT 1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`select distinct ver\.credential_id, ver\.version, ver\.data_encrypted, ver\.key_id from credential_static_version ver inner join credential_static cred on cred\.public_id = ver\.credential_id inner join credential_static_store store on store\.public_id = cred\.store_id where store\.project_id = \$1 and ver\.key_id = \$2;`,
+		).WillReturnError(errors.New("Query error"))
+		err := credStaticVersionRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+		repo, err := NewRepository(ctx, rw, rw, kmsCache)
+		require.NoError(t, err)
+		in, err := NewUsernamePasswordCredential(cs.GetPublicId(), "username", "password")
+		require.NoError(t, err)
+		cred, err := repo.CreateUsernamePasswordCredential(ctx, prj.PublicId, in)
+		require.NoError(t, err)
+
+		ver := allocCredentialVersion()
+		require.NoError(t, rw.LookupWhere(ctx, ver, "credential_id = ? and version = ?", []any{cred.PublicId, cred.Version}))
+
+		// now things are stored in the db, we can rotate and rewrap
+		assert.NoError(t, kmsCache.RotateKeys(ctx, prj.PublicId))
+		assert.NoError(t, credStaticVersionRewrapFn(ctx, ver.GetKeyId(), prj.PublicId, rw, rw, kmsCache))
+
+		// now we pull the version back from the db, decrypt it with the new key, and ensure things match
+		got := allocCredentialVersion()
+		require.NoError(t, rw.LookupWhere(ctx, got, "credential_id = ? and version = ?", []any{cred.PublicId, cred.Version}))
+
+		kmsWrapper2, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		assert.NoError(t, err)
+		newKeyVersionId, err := kmsWrapper2.KeyId(ctx)
+		assert.NoError(t, err)
+
+		// decrypt with the new key version and check to make sure things match
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper2))
+		assert.NotEmpty(t, got.GetKeyId())
+		assert.NotEqual(t, ver.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersionId, got.GetKeyId())
+		restored, err := unmarshalCredential(ctx, cred.PublicId, got.GetData())
+		require.NoError(t, err)
+		assert.Equal(t, "password", string(restored.(*UsernamePasswordCredential).GetPassword()))
+	})
+}
+
+func TestRewrap_credStaticRotationPendingRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT 1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`select distinct pen\.credential_id, pen\.password_encrypted, pen\.key_id from credential_static_rotation_pending pen inner join credential_static_rotation_policy pol on pol\.credential_id = pen\.credential_id inner join credential_static_store store on store\.public_id = pol\.store_id where store\.project_id = \$1 and pen\.key_id = \$2;`,
+		).WillReturnError(errors.New("Query error"))
+		err := credStaticRotationPendingRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+		wrapper := db.TestWrapper(t)
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		repo, err := NewRepository(ctx, rw, rw, kmsCache)
+		require.NoError(t, err)
+		p, err := NewRotationPolicy(cred.PublicId, PostgresRotator, "db:5432", WithRotateAfterSession(true))
+		require.NoError(t, err)
+		_, err = repo.SetRotationPolicy(ctx, prj.PublicId, p)
+		require.NoError(t, err)
+		require.NoError(t, repo.setPendingRotation(ctx, prj.PublicId, cred.PublicId, "new-password"))
+
+		pending := allocPendingRotation()
+		require.NoError(t, rw.LookupWhere(ctx, pending, "credential_id = ?", []any{cred.PublicId}))
+
+		// now things are stored in the db, we can rotate and rewrap
+		assert.NoError(t, kmsCache.RotateKeys(ctx, prj.PublicId))
+		assert.NoError(t, credStaticRotationPendingRewrapFn(ctx, pending.GetKeyId(), prj.PublicId, rw, rw, kmsCache))
+
+		// now we pull the pending rotation back from the db, decrypt it with the new key, and ensure things match
+		got := allocPendingRotation()
+		require.NoError(t, rw.LookupWhere(ctx, got, "credential_id = ?", []any{cred.PublicId}))
+
+		kmsWrapper2, err := kmsCache.GetWrapper(context.Background(), prj.PublicId, kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		assert.NoError(t, err)
+		newKeyVersionId, err := kmsWrapper2.KeyId(ctx)
+		assert.NoError(t, err)
+
+		// decrypt with the new key version and check to make sure things match
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper2))
+		assert.NotEmpty(t, got.GetKeyId())
+		assert.NotEqual(t, pending.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersionId, got.GetKeyId())
+		assert.Equal(t, "new-password", string(got.GetPassword()))
+	})
+}
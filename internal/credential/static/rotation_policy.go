@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+// Names of the rotators which can change the password of a username
+// password credential.
+const (
+	PostgresRotator = "postgres"
+	SshRotator      = "ssh"
+	LdapRotator     = "ldap"
+)
+
+// ValidRotator returns true if name is the name of a supported rotator.
+func ValidRotator(name string) bool {
+	switch name {
+	case PostgresRotator, SshRotator, LdapRotator:
+		return true
+	}
+	return false
+}
+
+// RotationTrigger is the cause of a Rotation.
+type RotationTrigger string
+
+const (
+	// SessionRotationTrigger is a rotation performed after the sessions
+	// using a credential terminated.
+	SessionRotationTrigger RotationTrigger = "session"
+	// IntervalRotationTrigger is a rotation performed because the rotation
+	// interval of a credential elapsed.
+	IntervalRotationTrigger RotationTrigger = "interval"
+)
+
+// RotationStatus is the outcome of a Rotation.
+type RotationStatus string
+
+const (
+	SucceededRotationStatus RotationStatus = "succeeded"
+	FailedRotationStatus    RotationStatus = "failed"
+)
+
+// A RotateRequest contains everything a Rotator needs to change the password
+// of a username password credential.
+type RotateRequest struct {
+	// Address is the host:port of the system the password is changed on.
+	Address string
+	// Username is the username of the credential being rotated.
+	Username string
+	// CurrentPassword is the current password of the credential.
+	CurrentPassword string
+	// NewPassword is the password to set.
+	NewPassword string
+	// AdminUsername and AdminPassword are used to authenticate to the
+	// system. They are the credential's own username and current password
+	// if the policy does not reference an admin credential.
+	AdminUsername string
+	AdminPassword string
+	// HostKey is the public key, in authorized_keys format, the host key of
+	// the system must match. It is only set for the ssh rotator.
+	HostKey string
+}
+
+// ParseHostKeys parses hostKey, which contains one or more public keys in
+// authorized_keys format, one per line. Empty lines and comments are
+// ignored. An error is returned if hostKey contains no keys.
+func ParseHostKeys(ctx context.Context, hostKey string) ([]ssh.PublicKey, error) {
+	const op = "static.ParseHostKeys"
+	var keys []ssh.PublicKey
+	rest := []byte(hostKey)
+	for len(bytes.TrimSpace(rest)) > 0 {
+		key, _, _, r, err := ssh.ParseAuthorizedKey(rest)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid host key"))
+		}
+		keys = append(keys, key)
+		rest = r
+	}
+	if len(keys) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host key")
+	}
+	return keys, nil
+}
+
+// A Rotator changes the password of a username password credential on the
+// system the credential authenticates to.
+type Rotator interface {
+	Rotate(ctx context.Context, req *RotateRequest) error
+}
+
+// A RotationPolicy describes when and how the password of a username
+// password credential is rotated.
+type RotationPolicy struct {
+	*store.RotationPolicy
+	tableName string `gorm:"-"`
+}
+
+// NewRotationPolicy creates a new in memory RotationPolicy for the username
+// password credential credentialId which changes the password on the system
+// at address using the rotator named rotator. WithAdminCredentialId,
+// WithHostKey, WithRotateAfterSession and WithRotationIntervalHours are the
+// only valid options. All other options are ignored.
+func NewRotationPolicy(credentialId, rotator, address string, opt ...Option) (*RotationPolicy, error) {
+	opts := getOpts(opt...)
+	p := &RotationPolicy{
+		RotationPolicy: &store.RotationPolicy{
+			CredentialId:          credentialId,
+			Rotator:               rotator,
+			Address:               address,
+			AdminCredentialId:     opts.withAdminCredentialId,
+			HostKey:               opts.withHostKey,
+			RotateAfterSession:    opts.withRotateAfterSession,
+			RotationIntervalHours: opts.withRotationIntervalHours,
+		},
+	}
+	return p, nil
+}
+
+func allocRotationPolicy() *RotationPolicy {
+	return &RotationPolicy{
+		RotationPolicy: &store.RotationPolicy{},
+	}
+}
+
+func (p *RotationPolicy) clone() *RotationPolicy {
+	cp := proto.Clone(p.RotationPolicy)
+	return &RotationPolicy{
+		RotationPolicy: cp.(*store.RotationPolicy),
+	}
+}
+
+// TableName returns the table name.
+func (p *RotationPolicy) TableName() string {
+	if p.tableName != "" {
+		return p.tableName
+	}
+	return "credential_static_rotation_policy"
+}
+
+// SetTableName sets the table name.
+func (p *RotationPolicy) SetTableName(n string) {
+	p.tableName = n
+}
+
+func (p *RotationPolicy) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{p.CredentialId},
+		"resource-type":      []string{"credential-static-rotation-policy"},
+		"op-type":            []string{op.String()},
+	}
+	if p.StoreId != "" {
+		metadata["store-id"] = []string{p.StoreId}
+	}
+	return metadata
+}
+
+// A pendingRotation is a password generated by the rotation job which has
+// not yet been committed to the credential. It is stored before the password
+// is changed on the target system so that the password is not lost if the
+// rotation is interrupted after the change.
+type pendingRotation struct {
+	*store.PendingRotation
+	tableName string `gorm:"-"`
+}
+
+func allocPendingRotation() *pendingRotation {
+	return &pendingRotation{
+		PendingRotation: &store.PendingRotation{},
+	}
+}
+
+// TableName returns the table name.
+func (p *pendingRotation) TableName() string {
+	if p.tableName != "" {
+		return p.tableName
+	}
+	return "credential_static_rotation_pending"
+}
+
+// SetTableName sets the table name.
+func (p *pendingRotation) SetTableName(n string) {
+	p.tableName = n
+}
+
+func (p *pendingRotation) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(pendingRotation).encrypt"
+	if len(p.Password) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no password defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, p.PendingRotation, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	p.KeyId = keyId
+	return nil
+}
+
+func (p *pendingRotation) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(pendingRotation).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, p.PendingRotation, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// A Rotation is an attempt to rotate the password of a username password
+// credential.
+type Rotation struct {
+	// CredentialId is the public id of the rotated credential.
+	CredentialId string
+	// Trigger is the cause of the rotation.
+	Trigger RotationTrigger
+	// Status is the outcome of the rotation.
+	Status RotationStatus
+	// Error is the error the rotation failed with. It is empty if the
+	// rotation succeeded.
+	Error string
+	// StartTime is the time the rotation started.
+	StartTime *timestamp.Timestamp
+	// EndTime is the time the rotation finished.
+	EndTime *timestamp.Timestamp
+}
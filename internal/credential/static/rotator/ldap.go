@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotator
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Ldap changes the userPassword attribute of an LDAP entry with a modify
+// operation. The credential's username and the admin username must be
+// distinguished names. The request's address is either host:port, which is
+// connected to with TLS, or an ldap:// or ldaps:// URL.
+type Ldap struct {
+	// TlsConfig is used for ldaps connections. If nil, the system roots are
+	// used to verify the server.
+	TlsConfig *tls.Config
+}
+
+var _ static.Rotator = (*Ldap)(nil)
+
+// Rotate implements static.Rotator.
+func (l *Ldap) Rotate(ctx context.Context, req *static.RotateRequest) error {
+	const op = "rotator.(Ldap).Rotate"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	netConn, isTls, err := l.dial(ctx, req.Address)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to connect"))
+	}
+	conn := ldap.NewConn(netConn, isTls)
+	conn.Start()
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetTimeout(time.Until(deadline))
+	}
+
+	if err := conn.Bind(req.AdminUsername, req.AdminPassword); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("bind failed"))
+	}
+
+	modify := ldap.NewModifyRequest(req.Username, nil)
+	modify.Replace("userPassword", []string{req.NewPassword})
+	if err := conn.Modify(modify); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("modify failed"))
+	}
+	return nil
+}
+
+// dial connects to address and reports whether the connection uses TLS.
+func (l *Ldap) dial(ctx context.Context, address string) (net.Conn, bool, error) {
+	useTls := true
+	switch {
+	case strings.HasPrefix(address, "ldaps://"):
+		address = strings.TrimPrefix(address, "ldaps://")
+	case strings.HasPrefix(address, "ldap://"):
+		address = strings.TrimPrefix(address, "ldap://")
+		useTls = false
+	}
+	if !useTls {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", address)
+		return conn, false, err
+	}
+	cfg := l.TlsConfig
+	if cfg == nil {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, false, err
+		}
+		cfg = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	}
+	d := tls.Dialer{Config: cfg}
+	conn, err := d.DialContext(ctx, "tcp", address)
+	return conn, true, err
+}
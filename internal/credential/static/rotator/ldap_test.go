@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLdapServer accepts a single connection and answers a bind and a modify
+// request with the provided result codes. The decoded requests are sent on
+// the returned channel.
+func testLdapServer(t *testing.T, bindCode, modifyCode uint16) (string, <-chan *ber.Packet) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	requests := make(chan *ber.Packet, 2)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		respond := func(id int64, tag ber.Tag, code uint16) {
+			res := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "Message ID"))
+			op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+			op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "resultCode"))
+			op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+			op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, fmt.Sprintf("code %d", code), "diagnosticMessage"))
+			res.AppendChild(op)
+			_, _ = conn.Write(res.Bytes())
+		}
+
+		for _, step := range []struct {
+			responseTag ber.Tag
+			code        uint16
+		}{
+			{ldap.ApplicationBindResponse, bindCode},
+			{ldap.ApplicationModifyResponse, modifyCode},
+		} {
+			p, err := ber.ReadPacket(conn)
+			if err != nil || len(p.Children) < 2 {
+				return
+			}
+			requests <- p.Children[1]
+			respond(p.Children[0].Value.(int64), step.responseTag, step.code)
+			if step.code != ldap.LDAPResultSuccess {
+				return
+			}
+		}
+	}()
+	return "ldap://" + l.Addr().String(), requests
+}
+
+func TestLdap_Rotate(t *testing.T) {
+	ctx := context.Background()
+	newReq := func(addr string) *static.RotateRequest {
+		return &static.RotateRequest{
+			Address:         addr,
+			Username:        "uid=alice,ou=people,dc=example,dc=com",
+			CurrentPassword: "old",
+			NewPassword:     "new-password",
+			AdminUsername:   "cn=admin,dc=example,dc=com",
+			AdminPassword:   "admin-password",
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		addr, requests := testLdapServer(t, ldap.LDAPResultSuccess, ldap.LDAPResultSuccess)
+		require.NoError((&Ldap{}).Rotate(ctx, newReq(addr)))
+
+		bind := <-requests
+		require.Equal(ber.Tag(ldap.ApplicationBindRequest), bind.Tag)
+		require.Len(bind.Children, 3)
+		assert.Equal(int64(3), bind.Children[0].Value)
+		assert.Equal("cn=admin,dc=example,dc=com", bind.Children[1].Value)
+		assert.Equal("admin-password", bind.Children[2].Data.String())
+
+		modify := <-requests
+		require.Equal(ber.Tag(ldap.ApplicationModifyRequest), modify.Tag)
+		require.Len(modify.Children, 2)
+		assert.Equal("uid=alice,ou=people,dc=example,dc=com", modify.Children[0].Value)
+		changes := modify.Children[1].Children
+		require.Len(changes, 1)
+		assert.Equal(int64(ldap.ReplaceAttribute), changes[0].Children[0].Value)
+		attr := changes[0].Children[1]
+		assert.Equal("userPassword", attr.Children[0].Value)
+		require.Len(attr.Children[1].Children, 1)
+		assert.Equal("new-password", attr.Children[1].Children[0].Value)
+	})
+	t.Run("bind-fails", func(t *testing.T) {
+		addr, _ := testLdapServer(t, ldap.LDAPResultInvalidCredentials, ldap.LDAPResultSuccess)
+		err := (&Ldap{}).Rotate(ctx, newReq(addr))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bind failed")
+		assert.Contains(t, err.Error(), "Result Code 49")
+	})
+	t.Run("modify-fails", func(t *testing.T) {
+		addr, _ := testLdapServer(t, ldap.LDAPResultSuccess, ldap.LDAPResultUnwillingToPerform)
+		err := (&Ldap{}).Rotate(ctx, newReq(addr))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "modify failed")
+		assert.Contains(t, err.Error(), "Result Code 53")
+	})
+	t.Run("missing-request", func(t *testing.T) {
+		require.Error(t, (&Ldap{}).Rotate(ctx, nil))
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotator
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/jackc/pgx/v4"
+)
+
+// Postgres changes the password of a PostgreSQL role with ALTER ROLE. It
+// connects to the postgres database at the request's address as the admin
+// user, which must be a superuser, have the CREATEROLE attribute or be the
+// role being rotated. The connection always uses TLS and the server's
+// certificate is verified, the admin password is never sent in plaintext.
+type Postgres struct {
+	// TlsConfig is used for the connection. If nil, the system roots are
+	// used to verify the server and the server's certificate must be valid
+	// for the host of the request's address (sslmode=verify-full).
+	TlsConfig *tls.Config
+}
+
+var _ static.Rotator = (*Postgres)(nil)
+
+// Rotate implements static.Rotator.
+func (p *Postgres) Rotate(ctx context.Context, req *static.RotateRequest) error {
+	const op = "rotator.(Postgres).Rotate"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	cfg, err := p.connConfig(req)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("invalid address"))
+	}
+	conn, err := pgx.ConnectConfig(ctx, cfg)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to connect"))
+	}
+	defer conn.Close(ctx)
+
+	// ALTER ROLE does not accept bind parameters.
+	stmt := fmt.Sprintf("alter role %s with password '%s'",
+		pgx.Identifier{req.Username}.Sanitize(),
+		strings.ReplaceAll(req.NewPassword, "'", "''"))
+	if _, err := conn.Exec(ctx, stmt); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to alter role"))
+	}
+	return nil
+}
+
+// connConfig returns the configuration used to connect to the server at
+// the request's address. The configuration requires TLS and never falls
+// back to a plaintext connection.
+func (p *Postgres) connConfig(req *static.RotateRequest) (*pgx.ConnConfig, error) {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(req.AdminUsername, req.AdminPassword),
+		Host:     req.Address,
+		Path:     "/postgres",
+		RawQuery: url.Values{"sslmode": []string{"verify-full"}}.Encode(),
+	}
+	cfg, err := pgx.ParseConfig(u.String())
+	if err != nil {
+		return nil, err
+	}
+	if p.TlsConfig != nil {
+		tlsConfig := p.TlsConfig.Clone()
+		if tlsConfig.ServerName == "" {
+			host, _, err := net.SplitHostPort(req.Address)
+			if err != nil {
+				host = req.Address
+			}
+			tlsConfig.ServerName = host
+		}
+		cfg.TLSConfig = tlsConfig
+	}
+	// Fallbacks could contain a configuration without TLS.
+	cfg.Fallbacks = nil
+	return cfg, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotator
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPostgresServer accepts a single connection, reads the SSLRequest
+// message and refuses TLS. Anything the client sends after the refusal is
+// sent on the returned channel.
+func testPostgresServer(t *testing.T) (string, <-chan []byte) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	received := make(chan []byte, 1)
+	go func() {
+		defer close(received)
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		sslRequest := make([]byte, 8)
+		if _, err := io.ReadFull(conn, sslRequest); err != nil {
+			return
+		}
+		// 80877103 is the SSLRequest code
+		if binary.BigEndian.Uint32(sslRequest[4:]) != 80877103 {
+			received <- sslRequest
+			return
+		}
+		if _, err := conn.Write([]byte("N")); err != nil {
+			return
+		}
+		rest, _ := io.ReadAll(conn)
+		if len(rest) > 0 {
+			received <- rest
+		}
+	}()
+	return l.Addr().String(), received
+}
+
+func TestPostgres_Rotate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil-request", func(t *testing.T) {
+		p := &Postgres{}
+		assert.Error(t, p.Rotate(ctx, nil))
+	})
+
+	t.Run("tls-refused", func(t *testing.T) {
+		addr, received := testPostgresServer(t)
+		p := &Postgres{}
+		err := p.Rotate(ctx, &static.RotateRequest{
+			Address:       addr,
+			Username:      "alice",
+			NewPassword:   "new-password",
+			AdminUsername: "admin",
+			AdminPassword: "admin-password",
+		})
+		require.Error(t, err)
+		// The client must not fall back to a plaintext connection.
+		for b := range received {
+			assert.Failf(t, "unexpected plaintext message", "%q", b)
+		}
+	})
+}
+
+func TestPostgres_connConfig(t *testing.T) {
+	req := &static.RotateRequest{
+		Address:       "db.example.com:5432",
+		AdminUsername: "admin",
+		AdminPassword: "admin-password",
+	}
+
+	t.Run("default", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p := &Postgres{}
+		cfg, err := p.connConfig(req)
+		require.NoError(err)
+		require.NotNil(cfg.TLSConfig)
+		assert.False(cfg.TLSConfig.InsecureSkipVerify)
+		assert.Equal("db.example.com", cfg.TLSConfig.ServerName)
+		assert.Empty(cfg.Fallbacks)
+		assert.Equal("admin", cfg.User)
+		assert.Equal("admin-password", cfg.Password)
+		assert.Equal("postgres", cfg.Database)
+	})
+
+	t.Run("tls-config", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p := &Postgres{TlsConfig: &tls.Config{MinVersion: tls.VersionTLS13}}
+		cfg, err := p.connConfig(req)
+		require.NoError(err)
+		require.NotNil(cfg.TLSConfig)
+		assert.Equal(uint16(tls.VersionTLS13), cfg.TLSConfig.MinVersion)
+		assert.Equal("db.example.com", cfg.TLSConfig.ServerName)
+		assert.Empty(cfg.Fallbacks)
+		// the configured TlsConfig is not modified
+		assert.Empty(p.TlsConfig.ServerName)
+	})
+
+	t.Run("tls-config-server-name", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p := &Postgres{TlsConfig: &tls.Config{ServerName: "other.example.com"}}
+		cfg, err := p.connConfig(req)
+		require.NoError(err)
+		assert.Equal("other.example.com", cfg.TLSConfig.ServerName)
+	})
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package rotator provides the static.Rotator implementations used to change
+// the passwords of static username password credentials.
+package rotator
+
+import (
+	"github.com/hashicorp/boundary/internal/credential/static"
+)
+
+// Defaults returns the rotators supported by Boundary keyed by their names.
+func Defaults() map[string]static.Rotator {
+	return map[string]static.Rotator{
+		static.PostgresRotator: &Postgres{},
+		static.SshRotator:      &Ssh{},
+		static.LdapRotator:     &Ldap{},
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// Ssh changes the password of a user on a host by running chpasswd over an
+// SSH connection to the request's address. It authenticates as the admin
+// user with password authentication, so the admin user must be allowed to
+// run chpasswd. The host key of the server must match one of the keys in
+// the request's HostKey; a request without a host key is rejected.
+type Ssh struct {
+	// HostKeyCallback verifies the host key of the server. If nil, the host
+	// key must match the request's HostKey.
+	HostKeyCallback ssh.HostKeyCallback
+}
+
+var _ static.Rotator = (*Ssh)(nil)
+
+// Rotate implements static.Rotator.
+func (s *Ssh) Rotate(ctx context.Context, req *static.RotateRequest) error {
+	const op = "rotator.(Ssh).Rotate"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+	if strings.ContainsAny(req.Username, ":\n") {
+		return errors.New(ctx, errors.InvalidParameter, op, "username contains characters chpasswd does not accept")
+	}
+	hostKeyCallback := s.HostKeyCallback
+	if hostKeyCallback == nil {
+		if req.HostKey == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing host key")
+		}
+		keys, err := static.ParseHostKeys(ctx, req.HostKey)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		hostKeyCallback = pinnedHostKeys(keys)
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", req.Address)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to connect"))
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, req.Address, &ssh.ClientConfig{
+		User:            req.AdminUsername,
+		Auth:            []ssh.AuthMethod{ssh.Password(req.AdminPassword)},
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to establish ssh connection"))
+	}
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create ssh session"))
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stdin = strings.NewReader(fmt.Sprintf("%s:%s\n", req.Username, req.NewPassword))
+	session.Stderr = &stderr
+	if err := session.Run("chpasswd"); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("chpasswd failed: %s", strings.TrimSpace(stderr.String()))))
+	}
+	return nil
+}
+
+// pinnedHostKeys returns a HostKeyCallback which accepts a server only if
+// its host key is one of keys.
+func pinnedHostKeys(keys []ssh.PublicKey) ssh.HostKeyCallback {
+	return func(hostname string, _ net.Addr, key ssh.PublicKey) error {
+		for _, k := range keys {
+			if k.Type() == key.Type() && bytes.Equal(k.Marshal(), key.Marshal()) {
+				return nil
+			}
+		}
+		return fmt.Errorf("host key %s %s of %s is not pinned", key.Type(), ssh.FingerprintSHA256(key), hostname)
+	}
+}
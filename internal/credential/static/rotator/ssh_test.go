@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rotator
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func testHostKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return key
+}
+
+func TestSsh_Rotate_RequiresHostKey(t *testing.T) {
+	ctx := context.Background()
+	err := (&Ssh{}).Rotate(ctx, &static.RotateRequest{
+		Address:       "127.0.0.1:0",
+		Username:      "user",
+		NewPassword:   "new",
+		AdminUsername: "user",
+		AdminPassword: "old",
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	assert.Contains(t, err.Error(), "missing host key")
+
+	err = (&Ssh{}).Rotate(ctx, &static.RotateRequest{
+		Address:  "127.0.0.1:0",
+		Username: "user",
+		HostKey:  "not a key",
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
+func TestPinnedHostKeys(t *testing.T) {
+	pinned, other, unpinned := testHostKey(t), testHostKey(t), testHostKey(t)
+	keys, err := static.ParseHostKeys(context.Background(),
+		string(ssh.MarshalAuthorizedKey(pinned))+"\n# comment\n"+string(ssh.MarshalAuthorizedKey(other)))
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	cb := pinnedHostKeys(keys)
+	assert.NoError(t, cb("host:22", nil, pinned))
+	assert.NoError(t, cb("host:22", nil, other))
+	assert.Error(t, cb("host:22", nil, unpinned))
+}
@@ -27,8 +27,8 @@ type SshPrivateKeyCredential struct {
 }
 
 // NewSshPrivateKeyCredential creates a new in memory static Credential containing a
-// username and private key that is assigned to storeId. Name and description are the only
-// valid options. All other options are ignored.
+// username and private key that is assigned to storeId. Name, description and max concurrent
+// checkouts are the only valid options. All other options are ignored.
 func NewSshPrivateKeyCredential(
 	ctx context.Context,
 	storeId string,
@@ -57,12 +57,13 @@ func NewSshPrivateKeyCredential(
 
 	l := &SshPrivateKeyCredential{
 		SshPrivateKeyCredential: &store.SshPrivateKeyCredential{
-			StoreId:              storeId,
-			Name:                 opts.withName,
-			Description:          opts.withDescription,
-			Username:             username,
-			PrivateKey:           privateKey,
-			PrivateKeyPassphrase: opts.withPrivateKeyPassphrase,
+			StoreId:                storeId,
+			Name:                   opts.withName,
+			Description:            opts.withDescription,
+			MaxConcurrentCheckouts: opts.withMaxConcurrentCheckouts,
+			Username:               username,
+			PrivateKey:             privateKey,
+			PrivateKeyPassphrase:   opts.withPrivateKeyPassphrase,
 		},
 	}
 
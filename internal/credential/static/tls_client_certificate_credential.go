@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ credential.Static = (*TlsClientCertificateCredential)(nil)
+
+// A TlsClientCertificateCredential contains the credential with a client
+// certificate chain, the private key of the leaf certificate and an optional
+// CA bundle. It is owned by a credential store.
+type TlsClientCertificateCredential struct {
+	*store.TlsClientCertificateCredential
+	tableName          string `gorm:"-"`
+	PassphraseUnneeded bool   `gorm:"-"`
+}
+
+// NewTlsClientCertificateCredential creates a new in memory static Credential
+// containing a PEM encoded client certificate chain and the PEM encoded
+// private key of the leaf certificate that is assigned to storeId. If both
+// the certificate and the private key are provided, the private key must
+// match the leaf certificate. Name, description, WithPrivateKeyPassphrase,
+// WithCaBundle and WithMaxConcurrentCheckouts are the only valid options. All other options are ignored.
+func NewTlsClientCertificateCredential(
+	ctx context.Context,
+	storeId string,
+	certificate []byte,
+	privateKey credential.PrivateKey,
+	opt ...Option,
+) (*TlsClientCertificateCredential, error) {
+	const op = "static.NewTlsClientCertificateCredential"
+
+	opts := getOpts(opt...)
+	if len(certificate) != 0 {
+		if err := validateCertificateChain(ctx, certificate); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if len(certificate) != 0 && len(privateKey) != 0 {
+		if err := validateKeyPair(ctx, certificate, privateKey, opts.withPrivateKeyPassphrase); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if len(opts.withCaBundle) != 0 {
+		if ok := x509.NewCertPool().AppendCertsFromPEM(opts.withCaBundle); !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "ca bundle does not contain any PEM encoded certificates")
+		}
+	}
+
+	l := &TlsClientCertificateCredential{
+		TlsClientCertificateCredential: &store.TlsClientCertificateCredential{
+			StoreId:                storeId,
+			Name:                   opts.withName,
+			Description:            opts.withDescription,
+			MaxConcurrentCheckouts: opts.withMaxConcurrentCheckouts,
+			Certificate:            certificate,
+			PrivateKey:             privateKey,
+			PrivateKeyPassphrase:   opts.withPrivateKeyPassphrase,
+			CaBundle:               opts.withCaBundle,
+		},
+	}
+
+	// If a private key was given and no passphrase was given and everything is
+	// okay, we can nil out any passphrase we have, so set this as a hint
+	if len(privateKey) != 0 && len(opts.withPrivateKeyPassphrase) == 0 {
+		l.PassphraseUnneeded = true
+		// These shouldn't be set, but safety
+		l.PrivateKeyPassphrase = nil
+		l.PrivateKeyPassphraseEncrypted = nil
+		l.PrivateKeyPassphraseHmac = nil
+	}
+
+	return l, nil
+}
+
+// validateCertificateChain reports an error if chain does not consist of
+// one or more PEM encoded x509 certificates.
+func validateCertificateChain(ctx context.Context, chain []byte) error {
+	const op = "static.validateCertificateChain"
+	var count int
+	for rest := chain; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return errors.New(ctx, errors.InvalidParameter, op, "certificate chain must only contain PEM encoded certificates")
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		count++
+	}
+	if count == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "certificate chain does not contain any PEM encoded certificates")
+	}
+	return nil
+}
+
+// validateKeyPair reports an error if privateKey, decrypted with passphrase
+// when it is encrypted, is not the private key of the leaf certificate in
+// chain.
+func validateKeyPair(ctx context.Context, chain []byte, privateKey credential.PrivateKey, passphrase []byte) error {
+	const op = "static.validateKeyPair"
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "private key is not PEM encoded")
+	}
+	keyPem := []byte(privateKey)
+	switch {
+	case block.Type == "ENCRYPTED PRIVATE KEY":
+		return errors.New(ctx, errors.InvalidParameter, op, "encrypted PKCS #8 private keys are not supported")
+	case x509.IsEncryptedPEMBlock(block):
+		// Legacy PEM encryption is the only private key encryption
+		// supported by the standard library.
+		if len(passphrase) == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "private key is encrypted and no passphrase was provided")
+		}
+		der, err := x509.DecryptPEMBlock(block, passphrase)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to decrypt private key"))
+		}
+		keyPem = pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: der})
+	}
+	if _, err := tls.X509KeyPair(chain, keyPem); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	return nil
+}
+
+func allocTlsClientCertificateCredential() *TlsClientCertificateCredential {
+	return &TlsClientCertificateCredential{
+		TlsClientCertificateCredential: &store.TlsClientCertificateCredential{},
+	}
+}
+
+func (c *TlsClientCertificateCredential) clone() *TlsClientCertificateCredential {
+	cp := proto.Clone(c.TlsClientCertificateCredential)
+	return &TlsClientCertificateCredential{
+		TlsClientCertificateCredential: cp.(*store.TlsClientCertificateCredential),
+	}
+}
+
+// TableName returns the table name.
+func (c *TlsClientCertificateCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_tls_client_certificate_credential"
+}
+
+// SetTableName sets the table name.
+func (c *TlsClientCertificateCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *TlsClientCertificateCredential) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"credential-static-tls-client-certificate"},
+		"op-type":            []string{op.String()},
+	}
+	if c.StoreId != "" {
+		metadata["store-id"] = []string{c.StoreId}
+	}
+	return metadata
+}
+
+func (c *TlsClientCertificateCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(TlsClientCertificateCredential).encrypt"
+
+	if len(c.PrivateKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no private key defined")
+	}
+
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	c.KeyId = keyId
+
+	// Encrypt private key
+	blobInfo, err := cipher.Encrypt(ctx, c.PrivateKey)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	protoBytes, err := proto.Marshal(blobInfo)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	c.PrivateKeyEncrypted = protoBytes
+	if err := c.hmacPrivateKey(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	if len(c.PrivateKeyPassphrase) > 0 {
+		// Encrypt passphrase
+		blobInfo, err := cipher.Encrypt(ctx, c.PrivateKeyPassphrase)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+		}
+		protoBytes, err := proto.Marshal(blobInfo)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+		}
+		c.PrivateKeyPassphraseEncrypted = protoBytes
+		if err := c.hmacPrivateKeyPassphrase(ctx, cipher); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
+	return nil
+}
+
+func (c *TlsClientCertificateCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(TlsClientCertificateCredential).decrypt"
+
+	if len(c.PrivateKeyEncrypted) > 0 {
+		dec := new(wrapping.BlobInfo)
+		if err := proto.Unmarshal(c.PrivateKeyEncrypted, dec); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+		pt, err := cipher.Decrypt(ctx, dec)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+		}
+		c.PrivateKey = pt
+	}
+
+	if len(c.PrivateKeyPassphraseEncrypted) > 0 {
+		dec := new(wrapping.BlobInfo)
+		if err := proto.Unmarshal(c.PrivateKeyPassphraseEncrypted, dec); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+		pt, err := cipher.Decrypt(ctx, dec)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+		}
+		c.PrivateKeyPassphrase = pt
+	}
+
+	return nil
+}
+
+func (c *TlsClientCertificateCredential) hmacPrivateKey(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(TlsClientCertificateCredential).hmacPrivateKey"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, c.PrivateKey, cipher, []byte(c.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.PrivateKeyHmac = []byte(hm)
+	return nil
+}
+
+func (c *TlsClientCertificateCredential) hmacPrivateKeyPassphrase(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(TlsClientCertificateCredential).hmacPrivateKeyPassphrase"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, c.PrivateKeyPassphrase, cipher, []byte(c.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.PrivateKeyPassphraseHmac = []byte(hm)
+	return nil
+}
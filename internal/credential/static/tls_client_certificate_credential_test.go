@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTlsClientCertificateCredential(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cert, key := TestTlsClientCertificate(t)
+	otherCert, otherKey := TestTlsClientCertificate(t)
+
+	block, _ := pem.Decode(key)
+	require.NotNil(t, block)
+	encBlock, err := x509.EncryptPEMBlock(rand.Reader, block.Type, block.Bytes, []byte("passphrase"), x509.PEMCipherAES256)
+	require.NoError(t, err)
+	encKey := pem.EncodeToMemory(encBlock)
+
+	tests := []struct {
+		name        string
+		certificate []byte
+		privateKey  []byte
+		opts        []Option
+		wantErr     bool
+	}{
+		{
+			name:        "valid",
+			certificate: cert,
+			privateKey:  key,
+		},
+		{
+			name:        "valid-chain-with-ca-bundle",
+			certificate: append(append([]byte{}, cert...), otherCert...),
+			privateKey:  key,
+			opts:        []Option{WithCaBundle(otherCert)},
+		},
+		{
+			name:        "valid-encrypted-key",
+			certificate: cert,
+			privateKey:  encKey,
+			opts:        []Option{WithPrivateKeyPassphrase([]byte("passphrase"))},
+		},
+		{
+			name:        "encrypted-key-no-passphrase",
+			certificate: cert,
+			privateKey:  encKey,
+			wantErr:     true,
+		},
+		{
+			name:        "encrypted-key-wrong-passphrase",
+			certificate: cert,
+			privateKey:  encKey,
+			opts:        []Option{WithPrivateKeyPassphrase([]byte("wrong"))},
+			wantErr:     true,
+		},
+		{
+			name:        "mismatched-key",
+			certificate: cert,
+			privateKey:  otherKey,
+			wantErr:     true,
+		},
+		{
+			name:        "leaf-not-first",
+			certificate: append(append([]byte{}, otherCert...), cert...),
+			privateKey:  key,
+			wantErr:     true,
+		},
+		{
+			name:        "certificate-not-pem",
+			certificate: []byte("not a certificate"),
+			privateKey:  key,
+			wantErr:     true,
+		},
+		{
+			name:        "certificate-contains-key",
+			certificate: append(append([]byte{}, cert...), key...),
+			privateKey:  key,
+			wantErr:     true,
+		},
+		{
+			name:        "key-not-pem",
+			certificate: cert,
+			privateKey:  []byte("not a key"),
+			wantErr:     true,
+		},
+		{
+			name:        "invalid-ca-bundle",
+			certificate: cert,
+			privateKey:  key,
+			opts:        []Option{WithCaBundle([]byte("not a bundle"))},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewTlsClientCertificateCredential(ctx, "csst_1234567890", tt.certificate, credential.PrivateKey(tt.privateKey), tt.opts...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.certificate, got.GetCertificate())
+			assert.Equal(tt.privateKey, got.GetPrivateKey())
+			assert.Equal(getOpts(tt.opts...).withCaBundle, got.GetCaBundle())
+		})
+	}
+}
+
+func TestTlsClientCertificateCredential_Create(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kkms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	cert, key := TestTlsClientCertificate(t)
+
+	databaseWrapper, err := kkms.GetWrapper(ctx, prj.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	cred, err := NewTlsClientCertificateCredential(ctx, cs.PublicId, cert, credential.PrivateKey(key), WithName("tls"), WithCaBundle(cert))
+	require.NoError(t, err)
+	cred.PublicId, err = credential.NewTlsClientCertificateCredentialId(ctx)
+	require.NoError(t, err)
+	require.NoError(t, cred.encrypt(ctx, databaseWrapper))
+	require.NoError(t, rw.Create(ctx, cred))
+
+	got := allocTlsClientCertificateCredential()
+	got.PublicId = cred.PublicId
+	require.NoError(t, rw.LookupByPublicId(ctx, got))
+	assert.Equal(t, cert, got.GetCertificate())
+	assert.Equal(t, cert, got.GetCaBundle())
+	assert.Empty(t, got.GetPrivateKey())
+	assert.Empty(t, got.GetPrivateKeyPassphraseHmac())
+
+	require.NoError(t, got.decrypt(ctx, databaseWrapper))
+	assert.Equal(t, key, []byte(got.GetPrivateKey()))
+
+	hm, err := crypto.HmacSha256(ctx, key, databaseWrapper, []byte(cs.PublicId), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(hm), got.GetPrivateKeyHmac())
+}
@@ -26,8 +26,8 @@ type UsernamePasswordCredential struct {
 }
 
 // NewUsernamePasswordCredential creates a new in memory static Credential containing a
-// username and password that is assigned to storeId. Name and description are the only
-// valid options. All other options are ignored.
+// username and password that is assigned to storeId. Name, description and max concurrent
+// checkouts are the only valid options. All other options are ignored.
 func NewUsernamePasswordCredential(
 	storeId string,
 	username string,
@@ -37,11 +37,12 @@ func NewUsernamePasswordCredential(
 	opts := getOpts(opt...)
 	l := &UsernamePasswordCredential{
 		UsernamePasswordCredential: &store.UsernamePasswordCredential{
-			StoreId:     storeId,
-			Name:        opts.withName,
-			Description: opts.withDescription,
-			Username:    username,
-			Password:    []byte(password),
+			StoreId:                storeId,
+			Name:                   opts.withName,
+			Description:            opts.withDescription,
+			MaxConcurrentCheckouts: opts.withMaxConcurrentCheckouts,
+			Username:               username,
+			Password:               []byte(password),
 		},
 	}
 	return l, nil
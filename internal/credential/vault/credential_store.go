@@ -22,6 +22,7 @@ type CredentialStore struct {
 	clientCert  *ClientCertificate `gorm:"-"`
 	inputToken  TokenSecret        `gorm:"-"`
 	outputToken *Token             `gorm:"-"`
+	health      *Health            `gorm:"-"`
 
 	privateClientCert *ClientCertificate `gorm:"-"`
 	privateToken      *Token             `gorm:"-"`
@@ -167,6 +168,12 @@ func (cs *CredentialStore) Token() *Token {
 	return cs.outputToken
 }
 
+// Health returns the results of the most recent health check of the
+// current vault token if available.
+func (cs *CredentialStore) Health() *Health {
+	return cs.health
+}
+
 // ClientCertificate returns the client certificate if available.
 func (cs *CredentialStore) ClientCertificate() *ClientCertificate {
 	return cs.clientCert
@@ -24,6 +24,10 @@ const (
 	// update has been requested.
 	ExtensionsField = "Extensions"
 
+	commonNameField = "CommonName"
+	altNamesField   = "AltNames"
+	ipSansField     = "IpSans"
+
 	certificateField    = "Certificate"
 	certificateKeyField = "CertificateKey"
 	vaultAddressField   = "VaultAddress"
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+// A HealthStatus represents the health of the current Vault token of a
+// credential store.
+type HealthStatus string
+
+const (
+	// HealthyStatus represents a token that is renewed as expected and has
+	// all of the capabilities required by Boundary.
+	HealthyStatus HealthStatus = "healthy"
+
+	// WarningStatus represents a token that is missing capabilities, has
+	// not been renewed when expected, or will expire within the warning
+	// threshold.
+	WarningStatus HealthStatus = "warning"
+
+	// ErrorStatus represents a token that will expire within the error
+	// threshold.
+	ErrorStatus HealthStatus = "error"
+)
+
+const (
+	defaultTokenExpiryWarningThreshold = 24 * time.Hour
+	defaultTokenExpiryErrorThreshold   = time.Hour
+)
+
+// Health contains the results of the most recent health check of the
+// current Vault token of a credential store.
+type Health struct {
+	// Status is the health status of the token. It is empty if the token
+	// has not been checked yet.
+	Status HealthStatus
+
+	// CheckTime is the time of the most recent health check.
+	CheckTime time.Time
+
+	// LastRenewalTime is the time the token was last successfully renewed
+	// with Vault.
+	LastRenewalTime time.Time
+
+	// ExpirationTime is the time the token is expected to expire.
+	ExpirationTime time.Time
+
+	// MissingCapabilities contains the required capabilities the token was
+	// missing at CheckTime. Each entry contains a Vault path followed by
+	// the capabilities missing for that path.
+	MissingCapabilities []string
+}
+
+// tokenHealth contains the columns of a Vault token needed to determine
+// its health.
+type tokenHealth struct {
+	TokenHmac           []byte `gorm:"primary_key"`
+	StoreId             string
+	LastRenewalTime     *timestamp.Timestamp
+	ExpirationTime      *timestamp.Timestamp
+	ExpirationCapped    bool
+	HealthStatus        string
+	HealthCheckTime     *timestamp.Timestamp
+	MissingCapabilities string
+}
+
+func allocTokenHealth() *tokenHealth {
+	return &tokenHealth{}
+}
+
+// TableName returns the table name for gorm.
+func (*tokenHealth) TableName() string { return "credential_vault_token" }
+
+// renewalOverdue reports if the token should have been renewed by now. A
+// token is renewed at the midpoint between its last renewal and its
+// expiration.
+func (th *tokenHealth) renewalOverdue(now time.Time) bool {
+	lastRenewal := th.LastRenewalTime.AsTime()
+	expiration := th.ExpirationTime.AsTime()
+	renewal := lastRenewal.Add(expiration.Sub(lastRenewal) / 2)
+	return now.After(renewal.Add(renewalWindow))
+}
+
+// status returns the health status of the token at now. The expiration of
+// a token is only taken into account if the token is no longer extended
+// by renewals, either because it reached its maximum ttl in Vault or
+// because renewing it fails.
+func (th *tokenHealth) status(now time.Time, warningThreshold, errorThreshold time.Duration, missing pathCapabilities) HealthStatus {
+	overdue := th.renewalOverdue(now)
+	expiring := th.ExpirationCapped || overdue
+	remaining := th.ExpirationTime.AsTime().Sub(now)
+	switch {
+	case expiring && remaining <= errorThreshold:
+		return ErrorStatus
+	case expiring && remaining <= warningThreshold, overdue, len(missing) > 0:
+		return WarningStatus
+	default:
+		return HealthyStatus
+	}
+}
+
+func (th *tokenHealth) updateQuery(status HealthStatus, missing []string) (query string, queryValues []any) {
+	query = updateTokenHealthQuery
+	queryValues = []any{
+		sql.Named("health_status", string(status)),
+		sql.Named("missing_capabilities", strings.Join(missing, "\n")),
+		sql.Named("token_hmac", th.TokenHmac),
+	}
+	return
+}
+
+// entries returns a sorted list of the paths in pc along with their
+// capabilities.
+func (pc pathCapabilities) entries() []string {
+	if len(pc) == 0 {
+		return nil
+	}
+	entries := make([]string, 0, len(pc))
+	for path, caps := range pc {
+		entries = append(entries, fmt.Sprintf("%s: %s", path, caps))
+	}
+	sort.Strings(entries)
+	return entries
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTokenHealth_status(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	ts := func(d time.Duration) *timestamp.Timestamp {
+		return &timestamp.Timestamp{Timestamp: timestamppb.New(now.Add(d))}
+	}
+
+	tests := []struct {
+		name    string
+		th      *tokenHealth
+		missing pathCapabilities
+		want    HealthStatus
+	}{
+		{
+			name: "renewed",
+			th: &tokenHealth{
+				LastRenewalTime: ts(-time.Minute),
+				ExpirationTime:  ts(19 * time.Minute),
+			},
+			want: HealthyStatus,
+		},
+		{
+			name: "renewed-missing-capabilities",
+			th: &tokenHealth{
+				LastRenewalTime: ts(-time.Minute),
+				ExpirationTime:  ts(19 * time.Minute),
+			},
+			missing: pathCapabilities{"sys/leases/renew": updateCapability},
+			want:    WarningStatus,
+		},
+		{
+			name: "capped-outside-thresholds",
+			th: &tokenHealth{
+				LastRenewalTime:  ts(-time.Minute),
+				ExpirationTime:   ts(48 * time.Hour),
+				ExpirationCapped: true,
+			},
+			want: HealthyStatus,
+		},
+		{
+			name: "capped-within-warning-threshold",
+			th: &tokenHealth{
+				LastRenewalTime:  ts(-time.Minute),
+				ExpirationTime:   ts(12 * time.Hour),
+				ExpirationCapped: true,
+			},
+			want: WarningStatus,
+		},
+		{
+			name: "capped-within-error-threshold",
+			th: &tokenHealth{
+				LastRenewalTime:  ts(-time.Minute),
+				ExpirationTime:   ts(30 * time.Minute),
+				ExpirationCapped: true,
+			},
+			want: ErrorStatus,
+		},
+		{
+			name: "renewal-overdue",
+			th: &tokenHealth{
+				LastRenewalTime: ts(-3 * time.Hour),
+				ExpirationTime:  ts(2 * time.Hour),
+			},
+			want: WarningStatus,
+		},
+		{
+			name: "renewal-overdue-within-error-threshold",
+			th: &tokenHealth{
+				LastRenewalTime: ts(-59 * time.Minute),
+				ExpirationTime:  ts(time.Minute),
+			},
+			want: ErrorStatus,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.th.status(now, defaultTokenExpiryWarningThreshold, defaultTokenExpiryErrorThreshold, tt.missing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPathCapabilities_entries(t *testing.T) {
+	t.Parallel()
+	pc := pathCapabilities{
+		"sys/leases/revoke": updateCapability,
+		"auth/token/renew":  updateCapability | readCapability,
+	}
+	want := []string{
+		`auth/token/renew: ["read", "update"]`,
+		`sys/leases/revoke: ["update"]`,
+	}
+	assert.Equal(t, want, pc.entries())
+	assert.Nil(t, pathCapabilities{}.entries())
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tlsclientcertificate provides access to the client certificate,
+// private key and CA bundle stored in a Vault secret.
+package tlsclientcertificate
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tlsclientcertificate
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/mitchellh/pointerstructure"
+)
+
+type (
+	data map[string]any
+
+	// extractFunc attempts to extract the certificate, private key,
+	// passphrase and CA bundle from sd using the provided attribute names,
+	// using a known Vault data response format.
+	extractFunc func(sd data, certificateAttr, privateKeyAttr, passphraseAttr, caBundleAttr string) ([]byte, credential.PrivateKey, []byte, []byte)
+)
+
+// Extract attempts to extract the values of the certificate, private key,
+// optional passphrase and optional CA bundle stored within the provided data
+// using the given attribute names. Attributes holding a list of strings, such
+// as the ca_chain returned by the Vault PKI secrets engine, are joined with
+// newlines.
+//
+// Extract does not return partial results, i.e. if either the certificate or
+// the private key were not extracted (nil, nil, nil, nil) will be returned.
+func Extract(d data, certificateAttr, privateKeyAttr, passphraseAttr, caBundleAttr string) ([]byte, credential.PrivateKey, []byte, []byte) {
+	for _, f := range []extractFunc{
+		defaultExtract,
+		kv2Extract,
+	} {
+		certificate, privateKey, passphrase, caBundle := f(d, certificateAttr, privateKeyAttr, passphraseAttr, caBundleAttr)
+		if certificate != nil && privateKey != nil {
+			// got valid certificate and privateKey from secret
+			return certificate, privateKey, passphrase, caBundle
+		}
+	}
+
+	return nil, nil, nil, nil
+}
+
+// defaultExtract looks for the attributes in the data map.
+func defaultExtract(sd data, certificateAttr, privateKeyAttr, passphraseAttr, caBundleAttr string) (
+	certificate []byte, privateKey credential.PrivateKey, passphrase []byte, caBundle []byte,
+) {
+	if sd == nil {
+		// nothing to do return early
+		return nil, nil, nil, nil
+	}
+
+	get := func(attr string) (any, bool) {
+		if strings.HasPrefix(attr, "/") {
+			v, err := pointerstructure.Get(sd, attr)
+			if err != nil {
+				return nil, false
+			}
+			return v, true
+		}
+		return sd[attr], true
+	}
+
+	c, ok := get(certificateAttr)
+	if !ok {
+		return nil, nil, nil, nil
+	}
+	certificate = toBytes(c)
+
+	pk, ok := get(privateKeyAttr)
+	if !ok {
+		return nil, nil, nil, nil
+	}
+	if pk := toBytes(pk); pk != nil {
+		privateKey = credential.PrivateKey(pk)
+	}
+
+	pass, ok := get(passphraseAttr)
+	if !ok {
+		return nil, nil, nil, nil
+	}
+	passphrase = toBytes(pass)
+
+	ca, ok := get(caBundleAttr)
+	if !ok {
+		return nil, nil, nil, nil
+	}
+	caBundle = toBytes(ca)
+
+	return certificate, privateKey, passphrase, caBundle
+}
+
+// kv2Extract looks for the attributes in the embedded 'data' field within
+// the data map.
+//
+// Additionaly it validates the data is in the expected KV-v2 format:
+//
+//	{
+//		"data": {},
+//		"metadata: {}
+//	}
+//
+// If the format does not match, it returns (nil, nil, nil, nil). See:
+// https://www.vaultproject.io/api/secret/kv/kv-v2#sample-response-1
+func kv2Extract(sd data, certificateAttr, privateKeyAttr, passphraseAttr, caBundleAttr string) (
+	certificate []byte, privateKey credential.PrivateKey, passphrase []byte, caBundle []byte,
+) {
+	if sd == nil {
+		// nothing to do return early
+		return nil, nil, nil, nil
+	}
+
+	var data, metadata map[string]any
+	for k, v := range sd {
+		switch k {
+		case "data":
+			var ok bool
+			if data, ok = v.(map[string]any); !ok {
+				// data field should be of type map[string]any in KV-v2
+				return nil, nil, nil, nil
+			}
+		case "metadata":
+			var ok bool
+			if metadata, ok = v.(map[string]any); !ok {
+				// metadata field should be of type map[string]any in KV-v2
+				return nil, nil, nil, nil
+			}
+		default:
+			// secretData contains a non valid KV-v2 top level field
+			return nil, nil, nil, nil
+		}
+	}
+	if data == nil || metadata == nil {
+		// missing required KV-v2 field
+		return nil, nil, nil, nil
+	}
+
+	certificate = toBytes(data[certificateAttr])
+	if pk := toBytes(data[privateKeyAttr]); pk != nil {
+		privateKey = credential.PrivateKey(pk)
+	}
+	passphrase = toBytes(data[passphraseAttr])
+	caBundle = toBytes(data[caBundleAttr])
+
+	return certificate, privateKey, passphrase, caBundle
+}
+
+// toBytes returns v as a byte slice if v is a non-empty string or a list of
+// strings. A list of strings is joined with newlines. Any other value
+// returns nil.
+func toBytes(v any) []byte {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []byte(v)
+	case []any:
+		var parts []string
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil
+			}
+			parts = append(parts, strings.TrimSpace(s))
+		}
+		if len(parts) == 0 {
+			return nil
+		}
+		return []byte(strings.Join(parts, "\n"))
+	case []string:
+		if len(v) == 0 {
+			return nil
+		}
+		parts := make([]string, 0, len(v))
+		for _, s := range v {
+			parts = append(parts, strings.TrimSpace(s))
+		}
+		return []byte(strings.Join(parts, "\n"))
+	}
+	return nil
+}
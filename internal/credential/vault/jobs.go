@@ -5,6 +5,7 @@ package vault
 
 import (
 	"context"
+	stderrors "errors"
 	"net/http"
 	"time"
 
@@ -25,12 +26,13 @@ const (
 	credentialRevocationJobName   = "vault_credential_revocation"
 	credentialStoreCleanupJobName = "vault_credential_store_cleanup"
 	credentialCleanupJobName      = "vault_credential_cleanup"
+	tokenHealthJobName            = "vault_token_health"
 
 	defaultNextRunIn = 5 * time.Minute
 	renewalWindow    = 10 * time.Minute
 )
 
-func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) error {
 	const op = "vault.RegisterJobs"
 	tokenRenewal, err := newTokenRenewalJob(r, w, kms)
 	if err != nil {
@@ -74,6 +76,13 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 	if err = scheduler.RegisterJob(ctx, credCleanup); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithMsg("credential cleanup job"))
 	}
+	tokenHealth, err := newTokenHealthJob(r, w, kms, opt...)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, tokenHealth); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("token health job"))
+	}
 	return nil
 }
 
@@ -299,6 +308,190 @@ func (r *TokenRenewalJob) Description() string {
 	return "Periodically renews Vault credential store tokens that are in a maintaining or current state."
 }
 
+// TokenHealthJob is the recurring job that checks the health of the current
+// Vault token of each credential store. It records the capabilities the
+// token is missing and the resulting health status, and emits an event when
+// the health status of a token changes. The TokenHealthJob is not thread
+// safe, an attempt to Run the job concurrently will result in an
+// JobAlreadyRunning error.
+type TokenHealthJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	limit  int
+
+	warningThreshold time.Duration
+	errorThreshold   time.Duration
+
+	running      ua.Bool
+	numTokens    int
+	numProcessed int
+}
+
+// newTokenHealthJob creates a new in-memory TokenHealthJob.
+//
+// WithLimit, WithTokenExpiryWarningThreshold and
+// WithTokenExpiryErrorThreshold are the only supported options.
+func newTokenHealthJob(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*TokenHealthJob, error) {
+	const op = "vault.newTokenHealthJob"
+	switch {
+	case r == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	if opts.withTokenExpiryWarningThreshold == 0 {
+		opts.withTokenExpiryWarningThreshold = defaultTokenExpiryWarningThreshold
+	}
+	if opts.withTokenExpiryErrorThreshold == 0 {
+		opts.withTokenExpiryErrorThreshold = defaultTokenExpiryErrorThreshold
+	}
+	if opts.withTokenExpiryErrorThreshold > opts.withTokenExpiryWarningThreshold {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "token expiry error threshold is greater than the warning threshold")
+	}
+	return &TokenHealthJob{
+		reader:           r,
+		writer:           w,
+		kms:              kms,
+		limit:            opts.withLimit,
+		warningThreshold: opts.withTokenExpiryWarningThreshold,
+		errorThreshold:   opts.withTokenExpiryErrorThreshold,
+	}, nil
+}
+
+// Status returns the current status of the token health job. Total is the
+// total number of tokens that are set to be checked. Completed is the number
+// of tokens already checked.
+func (r *TokenHealthJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numTokens,
+	}
+}
+
+// Run queries the vault credential repo for the current token of each
+// credential store, it then creates a vault client and checks the health of
+// each token. Can not be run in parallel, if Run is invoked while already
+// running an error with code JobAlreadyRunning will be returned.
+func (r *TokenHealthJob) Run(ctx context.Context) error {
+	const op = "vault.(TokenHealthJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var ps []*clientStore
+	err := r.reader.SearchWhere(ctx, &ps, "token_status = ?", []any{CurrentToken}, db.WithLimit(r.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numTokens for status report
+	r.numProcessed, r.numTokens = 0, len(ps)
+
+	for _, s := range ps {
+		// Verify context is not done before checking next token
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := r.checkToken(ctx, s); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error checking token health", "credential store id", s.PublicId))
+		}
+		r.numProcessed++
+	}
+
+	return nil
+}
+
+func (r *TokenHealthJob) checkToken(ctx context.Context, s *clientStore) error {
+	const op = "vault.(TokenHealthJob).checkToken"
+	databaseWrapper, err := r.kms.GetWrapper(ctx, s.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err = s.decrypt(ctx, databaseWrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	token := s.token()
+	if token == nil {
+		// Store has no token to check
+		return nil
+	}
+
+	th := allocTokenHealth()
+	if err := r.reader.LookupWhere(ctx, th, "token_hmac = ?", []any{token.TokenHmac}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	vc, err := s.client(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	available, err := vc.capabilities(ctx, requiredCapabilities.paths())
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault capabilities"))
+	}
+	missing := available.missing(requiredCapabilities)
+
+	status := th.status(time.Now(), r.warningThreshold, r.errorThreshold, missing)
+	query, values := th.updateQuery(status, missing.entries())
+	numRows, err := r.writer.Exec(ctx, query, values)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "token checked but failed to update repo")
+	}
+
+	if HealthStatus(th.HealthStatus) == status {
+		return nil
+	}
+	expiration := th.ExpirationTime.AsTime()
+	switch status {
+	case ErrorStatus:
+		event.WriteError(ctx, op, stderrors.New("vault credential store token is about to expire"),
+			event.WithInfo("credential store id", s.PublicId, "token expiration time", expiration, "token expiration capped", th.ExpirationCapped))
+	case WarningStatus:
+		event.WriteSysEvent(ctx, op, "Vault credential store token health is degraded",
+			"credential store id", s.PublicId, "token expiration time", expiration, "token expiration capped", th.ExpirationCapped,
+			"missing capabilities", missing.entries())
+	case HealthyStatus:
+		if th.HealthStatus != "" {
+			event.WriteSysEvent(ctx, op, "Vault credential store token health has recovered", "credential store id", s.PublicId)
+		}
+	}
+	return nil
+}
+
+// NextRunIn returns the default run frequency of the token health job.
+func (r *TokenHealthJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (r *TokenHealthJob) Name() string {
+	return tokenHealthJobName
+}
+
+// Description is the human readable description of the job.
+func (r *TokenHealthJob) Description() string {
+	return "Periodically checks the expiration and capabilities of current Vault credential store tokens."
+}
+
 // TokenRevocationJob is the recurring job that revokes credential store Vault tokens that
 // are in the `maintaining` state and have no credentials being used by an active or pending session.
 // The TokenRevocationJob is not thread safe, an attempt to Run the job concurrently will result in
@@ -421,7 +614,26 @@ func (r *TokenRevocationJob) revokeToken(ctx context.Context, s *clientStore) er
 		return errors.Wrap(ctx, err, op)
 	}
 
+	// Certificates issued by a PKI credential library are not bound to the
+	// lease of the token, revoking the token does not revoke them. Revoke
+	// them by serial number while the token is still valid.
+	var pkiCreds []*privateCredential
+	where := "token_hmac = ? and pki_vault_path is not null and status in (?)"
+	if err := r.reader.SearchWhere(ctx, &pkiCreds, where, []any{token.TokenHmac, []string{string(ActiveCredential), string(RevokeCredential)}}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list pki credentials of token"))
+	}
 	var respErr *vault.ResponseError
+	for _, c := range pkiCreds {
+		err = vc.revokeCertificate(ctx, c.pkiMountPath(), c.ExternalId)
+		if ok := errors.As(err, &respErr); ok && respErr.StatusCode == http.StatusBadRequest {
+			// Vault returned a 400, the certificate is already revoked or expired.
+			err = nil
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke pki credential"))
+		}
+	}
+
 	err = vc.revokeToken(ctx)
 	if ok := errors.As(err, &respErr); ok && respErr.StatusCode == http.StatusForbidden {
 		// Vault returned a 403 when attempting a revoke self, the token is already expired.
@@ -534,7 +746,9 @@ func (r *CredentialRenewalJob) Run(ctx context.Context) error {
 	// Fetch all active credentials that will reach their renewal point within the renewalWindow.
 	// This is done to avoid constantly scheduling the credential renewal job when there are
 	// multiple credentials set to renew in sequence.
-	err := r.reader.SearchWhere(ctx, &creds, `renewal_time < wt_add_seconds_to_now(?) and status = ?`, []any{renewalWindow.Seconds(), ActiveCredential}, db.WithLimit(r.limit))
+	// Certificates issued by PKI credential libraries are not leases and
+	// cannot be renewed.
+	err := r.reader.SearchWhere(ctx, &creds, `renewal_time < wt_add_seconds_to_now(?) and status = ? and pki_vault_path is null`, []any{renewalWindow.Seconds(), ActiveCredential}, db.WithLimit(r.limit))
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -732,7 +946,13 @@ func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCred
 
 	cred := c.toCredential()
 	var respErr *vault.ResponseError
-	err = vc.revokeLease(ctx, c.ExternalId)
+	if mountPath := c.pkiMountPath(); mountPath != "" {
+		// Certificates issued by a PKI credential library are not leases,
+		// they are revoked by serial number.
+		err = vc.revokeCertificate(ctx, mountPath, c.ExternalId)
+	} else {
+		err = vc.revokeLease(ctx, c.ExternalId)
+	}
 	if ok := errors.As(err, &respErr); ok && respErr.StatusCode == http.StatusBadRequest {
 		// Vault returned a 400 when attempting a revoke lease, the lease is already expired.
 		// Clobber error and set status to "revoked" below.
@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"path"
 	"strings"
 	"testing"
@@ -621,6 +622,166 @@ func TestTokenRenewalJob_NextRunIn(t *testing.T) {
 	}
 }
 
+func TestNewTokenHealthJob(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	type args struct {
+		r   db.Reader
+		w   db.Writer
+		kms *kms.Kms
+	}
+	tests := []struct {
+		name        string
+		args        args
+		options     []Option
+		wantLimit   int
+		wantWarning time.Duration
+		wantError   time.Duration
+		wantErr     bool
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "nil reader",
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil writer",
+			args: args{
+				r: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "nil kms",
+			args: args{
+				r: rw,
+				w: rw,
+			},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "error-threshold-greater-than-warning",
+			args: args{
+				r:   rw,
+				w:   rw,
+				kms: kmsCache,
+			},
+			options:     []Option{WithTokenExpiryWarningThreshold(time.Hour), WithTokenExpiryErrorThreshold(2 * time.Hour)},
+			wantErr:     true,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid-no-options",
+			args: args{
+				r:   rw,
+				w:   rw,
+				kms: kmsCache,
+			},
+			wantLimit:   db.DefaultLimit,
+			wantWarning: defaultTokenExpiryWarningThreshold,
+			wantError:   defaultTokenExpiryErrorThreshold,
+		},
+		{
+			name: "valid-with-options",
+			args: args{
+				r:   rw,
+				w:   rw,
+				kms: kmsCache,
+			},
+			options:     []Option{WithLimit(100), WithTokenExpiryWarningThreshold(2 * time.Hour), WithTokenExpiryErrorThreshold(time.Minute)},
+			wantLimit:   100,
+			wantWarning: 2 * time.Hour,
+			wantError:   time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			got, err := newTokenHealthJob(tt.args.r, tt.args.w, tt.args.kms, tt.options...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(got)
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "Unexpected error %s", err)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.args.r, got.reader)
+			assert.Equal(tt.args.w, got.writer)
+			assert.Equal(tt.args.kms, got.kms)
+			assert.Equal(tt.wantLimit, got.limit)
+			assert.Equal(tt.wantWarning, got.warningThreshold)
+			assert.Equal(tt.wantError, got.errorThreshold)
+		})
+	}
+}
+
+func TestTokenHealthJob_Run(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	v := NewTestVaultServer(t)
+
+	_, token := v.CreateToken(t, WithTokenPeriod(24*time.Hour))
+
+	in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(token))
+	require.NoError(err)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	r, err := newTokenHealthJob(rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(sche.RegisterJob(ctx, r))
+
+	repo, err := NewRepository(rw, rw, kmsCache, sche)
+	require.NoError(err)
+	cs, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(err)
+
+	// The health of the token is unknown until it has been checked
+	got, err := repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	require.NotNil(got.Health())
+	assert.Empty(got.Health().Status)
+	assert.True(got.Health().CheckTime.IsZero())
+	assert.False(got.Health().ExpirationTime.IsZero())
+
+	require.NoError(r.Run(ctx))
+	assert.Equal(1, r.numProcessed)
+
+	got, err = repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(HealthyStatus, got.Health().Status)
+	assert.False(got.Health().CheckTime.IsZero())
+	assert.False(got.Health().LastRenewalTime.IsZero())
+	assert.Empty(got.Health().MissingCapabilities)
+
+	// Cap the expiration of the token within the error threshold
+	_, err = rw.Exec(ctx,
+		"update credential_vault_token set expiration_capped = true, expiration_time = wt_add_seconds_to_now(?) where token_hmac = ?",
+		[]any{(30 * time.Minute).Seconds(), cs.outputToken.TokenHmac})
+	require.NoError(err)
+
+	require.NoError(r.Run(ctx))
+	got, err = repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(ErrorStatus, got.Health().Status)
+}
+
 func TestNewTokenRevocationJob(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -931,6 +1092,105 @@ func TestTokenRevocationJob_Run(t *testing.T) {
 	assert.Equal(0, r.numProcessed)
 }
 
+func TestTokenRevocationJob_RunPki(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	v := NewTestVaultServer(t)
+	v.MountPKI(t)
+
+	_, ct := v.CreateToken(t)
+	in, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(ct))
+	require.NoError(err)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache, sche)
+	require.NoError(err)
+	cs, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(err)
+
+	r, err := newTokenRevocationJob(rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(sche.RegisterJob(ctx, r))
+
+	revokeToken := testVaultToken(t, conn, wrapper, v, cs, RevokeToken, 5*time.Minute)
+
+	// inserting new tokens moves the current token to a maintaining state, move it back to current and set expiration time
+	count, err := rw.Exec(ctx, testUpdateTokenStatusExpirationQuery, []any{CurrentToken, (5 * time.Minute).Seconds(), cs.outputToken.TokenHmac})
+	require.NoError(err)
+	assert.Equal(1, count)
+
+	lib := TestPkiCredentialLibraries(t, conn, wrapper, cs.GetPublicId(), 1)[0]
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	target.TestCredentialLibrary(t, conn, tar.GetPublicId(), lib.GetPublicId())
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+	})
+
+	// Issue a certificate with the token being revoked
+	secret, err := v.ClientUsingToken(t, string(revokeToken.GetToken())).post(ctx, "pki/issue/boundary", []byte(`{"common_name":"revoke.example.com"}`))
+	require.NoError(err)
+	serial, ok := secret.Data["serial_number"].(string)
+	require.True(ok)
+
+	id, err := newCredentialId()
+	require.NoError(err)
+	numRows, err := rw.Exec(ctx, insertCredentialWithExpirationQuery, []any{
+		sql.Named("public_id", id),
+		sql.Named("library_id", lib.GetPublicId()),
+		sql.Named("session_id", sess.GetPublicId()),
+		sql.Named("token_hmac", revokeToken.GetTokenHmac()),
+		sql.Named("external_id", serial),
+		sql.Named("is_renewable", false),
+		sql.Named("status", ActiveCredential),
+		sql.Named("last_renewal_time", "now()"),
+		sql.Named("expiration_time", int((5 * time.Minute).Seconds())),
+	})
+	require.NoError(err)
+	require.Equal(1, numRows)
+
+	require.NoError(r.Run(ctx))
+	assert.Equal(1, r.numProcessed)
+
+	// Verify the token was revoked in vault and in the repo
+	v.VerifyTokenInvalid(t, string(revokeToken.GetToken()))
+	repoToken := allocToken()
+	require.NoError(rw.LookupWhere(ctx, &repoToken, "token_hmac = ?", []any{revokeToken.TokenHmac}))
+	assert.Equal(string(RevokedToken), repoToken.Status)
+
+	// Verify the certificate was revoked in vault, it is not bound to the
+	// lease of the token
+	cert, err := v.client(t).cl.Logical().Read(path.Join("pki", "cert", serial))
+	require.NoError(err)
+	require.NotNil(cert)
+	revocationTime, ok := cert.Data["revocation_time"].(json.Number)
+	require.True(ok)
+	assert.NotEqual("0", revocationTime.String())
+
+	// Verify the credential was set to revoked in the repo
+	lookupCred := allocCredential()
+	lookupCred.PublicId = id
+	require.NoError(rw.LookupById(ctx, lookupCred))
+	assert.Equal(string(RevokedCredential), lookupCred.Status)
+}
+
 func TestNewCredentialRenewalJob(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
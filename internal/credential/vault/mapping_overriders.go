@@ -21,6 +21,8 @@ func validMappingOverride(m MappingOverride, ct credential.Type) bool {
 		return ct == credential.UsernamePasswordType
 	case *SshPrivateKeyOverride:
 		return ct == credential.SshPrivateKeyType
+	case *TlsClientCertificateOverride:
+		return ct == credential.TlsClientCertificateType
 	default:
 		return false // an unknown mapping override type is never valid
 	}
@@ -168,3 +170,76 @@ func (o *SshPrivateKeyOverride) TableName() string {
 func (o *SshPrivateKeyOverride) SetTableName(n string) {
 	o.tableName = n
 }
+
+// A TlsClientCertificateOverride contains optional values for overriding the
+// default mappings used to map a Vault secret to a TlsClientCertificate
+// credential type for the credential library that owns it.
+type TlsClientCertificateOverride struct {
+	*store.TlsClientCertificateOverride
+	tableName string `gorm:"-"`
+}
+
+var _ MappingOverride = (*TlsClientCertificateOverride)(nil)
+
+// NewTlsClientCertificateOverride creates a new in memory
+// TlsClientCertificateOverride. WithOverrideCertificateAttribute,
+// WithOverridePrivateKeyAttribute, WithOverridePrivateKeyPassphraseAttribute
+// and WithOverrideCaBundleAttribute are the only valid options. All other
+// options are ignored.
+func NewTlsClientCertificateOverride(opt ...Option) *TlsClientCertificateOverride {
+	opts := getOpts(opt...)
+	o := &TlsClientCertificateOverride{
+		TlsClientCertificateOverride: &store.TlsClientCertificateOverride{
+			CertificateAttribute:          sanitize.String(opts.withOverrideCertificateAttribute),
+			PrivateKeyAttribute:           sanitize.String(opts.withOverridePrivateKeyAttribute),
+			PrivateKeyPassphraseAttribute: sanitize.String(opts.withOverridePrivateKeyPassphraseAttribute),
+			CaBundleAttribute:             sanitize.String(opts.withOverrideCaBundleAttribute),
+		},
+	}
+	return o
+}
+
+func allocTlsClientCertificateOverride() *TlsClientCertificateOverride {
+	return &TlsClientCertificateOverride{
+		TlsClientCertificateOverride: &store.TlsClientCertificateOverride{},
+	}
+}
+
+func (o *TlsClientCertificateOverride) clone() MappingOverride {
+	cp := proto.Clone(o.TlsClientCertificateOverride)
+	return &TlsClientCertificateOverride{
+		TlsClientCertificateOverride: cp.(*store.TlsClientCertificateOverride),
+	}
+}
+
+func (o *TlsClientCertificateOverride) setLibraryId(i string) {
+	o.LibraryId = i
+}
+
+func (o *TlsClientCertificateOverride) sanitize() {
+	if sentinel.Is(o.CertificateAttribute) {
+		o.CertificateAttribute = ""
+	}
+	if sentinel.Is(o.PrivateKeyAttribute) {
+		o.PrivateKeyAttribute = ""
+	}
+	if sentinel.Is(o.PrivateKeyPassphraseAttribute) {
+		o.PrivateKeyPassphraseAttribute = ""
+	}
+	if sentinel.Is(o.CaBundleAttribute) {
+		o.CaBundleAttribute = ""
+	}
+}
+
+// TableName returns the table name.
+func (o *TlsClientCertificateOverride) TableName() string {
+	if o.tableName != "" {
+		return o.tableName
+	}
+	return "credential_vault_library_tls_client_certificate_mapping_override"
+}
+
+// SetTableName sets the table name.
+func (o *TlsClientCertificateOverride) SetTableName(n string) {
+	o.tableName = n
+}
@@ -3,7 +3,11 @@
 
 package vault
 
-import "github.com/hashicorp/boundary/internal/credential"
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -22,6 +26,7 @@ type options struct {
 	withName           string
 	withDescription    string
 	withLimit          int
+	withPublicId       string
 	withCACert         []byte
 	withNamespace      string
 	withTlsServerName  string
@@ -36,6 +41,8 @@ type options struct {
 	withOverridePasswordAttribute             string
 	withOverridePrivateKeyAttribute           string
 	withOverridePrivateKeyPassphraseAttribute string
+	withOverrideCertificateAttribute          string
+	withOverrideCaBundleAttribute             string
 	withMappingOverride                       MappingOverride
 
 	withKeyType         string
@@ -44,6 +51,11 @@ type options struct {
 	withKeyId           string
 	withCriticalOptions string
 	withExtensions      string
+	withAltNames        string
+	withIpSans          string
+
+	withTokenExpiryWarningThreshold time.Duration
+	withTokenExpiryErrorThreshold   time.Duration
 }
 
 func getDefaultOptions() options {
@@ -80,6 +92,14 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithPublicId provides an optional public id to use when creating a
+// credential library instead of generating a new one.
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
 // WithCACert provides an optional PEM-encoded certificate
 // to verify the Vault server's SSL certificate.
 func WithCACert(cert []byte) Option {
@@ -177,6 +197,22 @@ func WithOverridePrivateKeyPassphraseAttribute(s string) Option {
 	}
 }
 
+// WithOverrideCertificateAttribute provides the name of an attribute in the
+// Data field of a Vault api.Secret that maps to a certificate value.
+func WithOverrideCertificateAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCertificateAttribute = s
+	}
+}
+
+// WithOverrideCaBundleAttribute provides the name of an attribute in the
+// Data field of a Vault api.Secret that maps to a CA bundle value.
+func WithOverrideCaBundleAttribute(s string) Option {
+	return func(o *options) {
+		o.withOverrideCaBundleAttribute = s
+	}
+}
+
 // WithMappingOverride provides an optional mapping override to use for
 // mapping the Data fields of a Vault api.Secret to a credential.
 func WithMappingOverride(m MappingOverride) Option {
@@ -229,3 +265,39 @@ func WithExtensions(s string) Option {
 		o.withExtensions = s
 	}
 }
+
+// WithAltNames provides an optional comma separated list of DNS and email
+// subject alternative names for the certificates issued by a PKI credential
+// library.
+func WithAltNames(s string) Option {
+	return func(o *options) {
+		o.withAltNames = s
+	}
+}
+
+// WithIpSans provides an optional comma separated list of IP subject
+// alternative names for the certificates issued by a PKI credential
+// library.
+func WithIpSans(s string) Option {
+	return func(o *options) {
+		o.withIpSans = s
+	}
+}
+
+// WithTokenExpiryWarningThreshold provides an optional duration before the
+// expiration of a credential store's Vault token at which the token health
+// job reports a warning. Zero means the default is used.
+func WithTokenExpiryWarningThreshold(d time.Duration) Option {
+	return func(o *options) {
+		o.withTokenExpiryWarningThreshold = d
+	}
+}
+
+// WithTokenExpiryErrorThreshold provides an optional duration before the
+// expiration of a credential store's Vault token at which the token health
+// job reports an error. Zero means the default is used.
+func WithTokenExpiryErrorThreshold(d time.Duration) Option {
+	return func(o *options) {
+		o.withTokenExpiryErrorThreshold = d
+	}
+}
@@ -5,6 +5,7 @@ package vault
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("clvlt_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "clvlt_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithCACert", func(t *testing.T) {
 		opts := getOpts(WithCACert([]byte("test cert")))
 		testOpts := getDefaultOptions()
@@ -124,10 +131,52 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withOverridePrivateKeyPassphraseAttribute = "test"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithOverrideCertificateAttribute", func(t *testing.T) {
+		opts := getOpts(WithOverrideCertificateAttribute("test"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withOverrideCertificateAttribute = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithOverrideCaBundleAttribute", func(t *testing.T) {
+		opts := getOpts(WithOverrideCaBundleAttribute("test"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withOverrideCaBundleAttribute = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAltNames", func(t *testing.T) {
+		opts := getOpts(WithAltNames("a.example.com,b.example.com"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withAltNames = "a.example.com,b.example.com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithIpSans", func(t *testing.T) {
+		opts := getOpts(WithIpSans("10.0.0.1"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withIpSans = "10.0.0.1"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithMappingOverride", func(t *testing.T) {
 		opts := getOpts(WithMappingOverride(unknownMapper(1)))
 		testOpts := getDefaultOptions()
 		testOpts.withMappingOverride = unknownMapper(1)
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithTokenExpiryWarningThreshold", func(t *testing.T) {
+		opts := getOpts(WithTokenExpiryWarningThreshold(time.Hour))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withTokenExpiryWarningThreshold = time.Hour
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithTokenExpiryErrorThreshold", func(t *testing.T) {
+		opts := getOpts(WithTokenExpiryErrorThreshold(time.Minute))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(t, opts, testOpts)
+		testOpts.withTokenExpiryErrorThreshold = time.Minute
+		assert.Equal(t, opts, testOpts)
+	})
 }
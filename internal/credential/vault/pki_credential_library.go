@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// PkiCredentialLibrary is a credential library that issues TLS client
+// certificates using the vault PKI secrets engine.
+// See: https://developer.hashicorp.com/vault/api-docs/secret/pki#generate-certificate-and-key
+// and https://developer.hashicorp.com/vault/api-docs/secret/pki#sign-certificate
+type PkiCredentialLibrary struct {
+	*store.PkiCredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+// NewPkiCredentialLibrary creates a new in memory PkiCredentialLibrary for
+// a Vault backend at vaultPath assigned to storeId. The common name field
+// must be set. Name, description, key type, key bits, ttl, alt names and
+// ip sans are the only valid options. All other options are ignored.
+func NewPkiCredentialLibrary(storeId string, vaultPath string, commonName string, opt ...Option) (*PkiCredentialLibrary, error) {
+	opts := getOpts(opt...)
+
+	l := &PkiCredentialLibrary{
+		PkiCredentialLibrary: &store.PkiCredentialLibrary{
+			StoreId:        storeId,
+			Name:           opts.withName,
+			Description:    opts.withDescription,
+			VaultPath:      vaultPath,
+			CommonName:     commonName,
+			AltNames:       opts.withAltNames,
+			IpSans:         opts.withIpSans,
+			KeyType:        opts.withKeyType,
+			KeyBits:        opts.withKeyBits,
+			Ttl:            opts.withTtl,
+			CredentialType: string(credential.TlsClientCertificateType),
+		},
+	}
+
+	return l, nil
+}
+
+func allocPkiCredentialLibrary() *PkiCredentialLibrary {
+	return &PkiCredentialLibrary{
+		PkiCredentialLibrary: &store.PkiCredentialLibrary{},
+	}
+}
+
+func (l *PkiCredentialLibrary) clone() *PkiCredentialLibrary {
+	cp := proto.Clone(l.PkiCredentialLibrary)
+	return &PkiCredentialLibrary{
+		PkiCredentialLibrary: cp.(*store.PkiCredentialLibrary),
+	}
+}
+
+func (l *PkiCredentialLibrary) setId(i string) {
+	l.PublicId = i
+}
+
+// TableName returns the table name.
+func (l *PkiCredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_vault_pki_library"
+}
+
+// SetTableName sets the table name.
+func (l *PkiCredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+func (l *PkiCredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-vault-pki-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+func (l *PkiCredentialLibrary) getDefaultKeyBits() uint32 {
+	switch l.KeyType {
+	case KeyTypeEcdsa:
+		return KeyBitsEcdsa256
+	case KeyTypeRsa:
+		return KeyBitsRsa2048
+	default:
+		return KeyBitsDefault
+	}
+}
+
+// CredentialType returns the type of credential the library retrieves.
+func (l *PkiCredentialLibrary) CredentialType() credential.Type {
+	return credential.Type(l.PkiCredentialLibrary.CredentialType)
+}
+
+var _ credential.Library = (*PkiCredentialLibrary)(nil)
@@ -5,6 +5,7 @@ package vault
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -48,6 +49,7 @@ type privateCredential struct {
 	CtClientKey          []byte
 	ClientKeyHmac        []byte
 	ClientKeyId          string
+	PkiVaultPath         string
 }
 
 func (pc *privateCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
@@ -124,6 +126,24 @@ func (pc *privateCredential) toCredential() *Credential {
 	}
 }
 
+// pkiMountPath returns the mount path of the PKI secrets engine that issued
+// the credential or an empty string if the credential was not issued by a
+// PKI credential library.
+func (pc *privateCredential) pkiMountPath() string {
+	if pc.PkiVaultPath == "" {
+		return ""
+	}
+	// PKI vault paths are in the format <mount path>/(sign|issue)/<role>
+	p := strings.TrimSuffix(pc.PkiVaultPath, "/")
+	if i := strings.LastIndex(p, "/"); i > 0 {
+		p = p[:i]
+	}
+	if i := strings.LastIndex(p, "/"); i > 0 {
+		return p[:i]
+	}
+	return ""
+}
+
 // GetPublicId returns the public id.
 func (pc *privateCredential) GetPublicId() string {
 	return pc.PublicId
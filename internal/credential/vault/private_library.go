@@ -5,12 +5,14 @@ package vault
 
 import (
 	"context"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"database/sql"
 	"encoding/base64"
 	"encoding/json"
@@ -22,6 +24,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/credential/vault/internal/sshprivatekey"
+	"github.com/hashicorp/boundary/internal/credential/vault/internal/tlsclientcertificate"
 	"github.com/hashicorp/boundary/internal/credential/vault/internal/usernamepassword"
 	"github.com/hashicorp/boundary/internal/db/sentinel"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -30,6 +33,7 @@ import (
 	"github.com/hashicorp/boundary/internal/util/template"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	vault "github.com/hashicorp/vault/api"
 	"github.com/mikesmitty/edkey"
 	"golang.org/x/crypto/ssh"
@@ -60,6 +64,8 @@ func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
 		return baseToUsrPass(ctx, bc)
 	case credential.SshPrivateKeyType:
 		return baseToSshPriKey(ctx, bc)
+	case credential.TlsClientCertificateType:
+		return baseToTlsClientCert(ctx, bc)
 	}
 	return bc, nil
 }
@@ -160,6 +166,65 @@ func baseToSshPriKey(ctx context.Context, bc *baseCred) (*sshPrivateKeyCred, err
 	}, nil
 }
 
+var _ credential.TlsClientCertificate = (*tlsClientCertCred)(nil)
+
+type tlsClientCertCred struct {
+	*baseCred
+	certificate []byte
+	privateKey  credential.PrivateKey
+	passphrase  []byte
+	caBundle    []byte
+}
+
+func (c *tlsClientCertCred) Certificate() []byte               { return c.certificate }
+func (c *tlsClientCertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *tlsClientCertCred) PrivateKeyPassphrase() []byte      { return c.passphrase }
+func (c *tlsClientCertCred) CaBundle() []byte                  { return c.caBundle }
+
+func baseToTlsClientCert(ctx context.Context, bc *baseCred) (*tlsClientCertCred, error) {
+	switch {
+	case bc == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred"))
+	case bc.lib == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred.lib"))
+	case bc.Library().CredentialType() != credential.TlsClientCertificateType:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid credential type"))
+	}
+
+	lib, ok := bc.lib.(*genericIssuingCredentialLibrary)
+	if !ok {
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("baseCred.lib is not of type genericIssuingCredentialLibrary"))
+	}
+
+	cAttr, pkAttr, pAttr, caAttr := lib.CertificateAttribute, lib.PrivateKeyAttribute, lib.PrivateKeyPassphraseAttribute, lib.CaBundleAttribute
+	if cAttr == "" {
+		cAttr = "certificate"
+	}
+	if pkAttr == "" {
+		pkAttr = "private_key"
+	}
+	if pAttr == "" {
+		pAttr = "private_key_passphrase"
+	}
+	if caAttr == "" {
+		// The default matches the ca_chain returned by the Vault PKI
+		// secrets engine when issuing a certificate.
+		caAttr = "ca_chain"
+	}
+	cert, pk, pass, ca := tlsclientcertificate.Extract(bc.secretData, cAttr, pkAttr, pAttr, caAttr)
+	if cert == nil || pk == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+
+	return &tlsClientCertCred{
+		baseCred:    bc,
+		certificate: cert,
+		privateKey:  pk,
+		passphrase:  pass,
+		caBundle:    ca,
+	}, nil
+}
+
 type sshCertCred struct {
 	*sshPrivateKeyCred
 	certificate []byte
@@ -208,6 +273,8 @@ type genericIssuingCredentialLibrary struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	CaBundleAttribute             string
 	Purpose                       credential.Purpose
 }
 
@@ -222,6 +289,8 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		PasswordAttribute:             pl.PasswordAttribute,
 		PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 		PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+		CertificateAttribute:          pl.CertificateAttribute,
+		CaBundleAttribute:             pl.CaBundleAttribute,
 		Name:                          pl.Name,
 		Description:                   pl.Description,
 		CreateTime:                    proto.Clone(pl.CreateTime).(*timestamp.Timestamp),
@@ -480,6 +549,8 @@ type privateCredentialLibraryAllTypes struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	CaBundleAttribute             string
 	Purpose                       credential.Purpose `gorm:"-"`
 	KeyType                       string
 	KeyBits                       int
@@ -488,6 +559,9 @@ type privateCredentialLibraryAllTypes struct {
 	KeyId                         string
 	CriticalOptions               []byte
 	Extensions                    []byte
+	CommonName                    string
+	AltNames                      string
+	IpSans                        string
 	CredLibType                   string
 }
 
@@ -537,6 +611,8 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		PasswordAttribute:             pl.PasswordAttribute,
 		PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 		PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+		CertificateAttribute:          pl.CertificateAttribute,
+		CaBundleAttribute:             pl.CaBundleAttribute,
 		Name:                          pl.Name,
 		Description:                   pl.Description,
 		CreateTime:                    proto.Clone(pl.CreateTime).(*timestamp.Timestamp),
@@ -568,6 +644,9 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		KeyId:                         pl.KeyId,
 		CriticalOptions:               pl.CriticalOptions,
 		Extensions:                    pl.Extensions,
+		CommonName:                    pl.CommonName,
+		AltNames:                      pl.AltNames,
+		IpSans:                        pl.IpSans,
 		CredLibType:                   pl.CredLibType,
 	}
 }
@@ -609,6 +688,40 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			CriticalOptions: pl.CriticalOptions,
 			Extensions:      pl.Extensions,
 		}
+	case "pki":
+		return &pkiIssuingCredentialLibrary{
+			PublicId:      pl.PublicId,
+			StoreId:       pl.StoreId,
+			CredType:      pl.CredType,
+			Name:          pl.Name,
+			Description:   pl.Description,
+			CreateTime:    pl.CreateTime,
+			UpdateTime:    pl.UpdateTime,
+			Version:       pl.Version,
+			ProjectId:     pl.ProjectId,
+			VaultPath:     pl.VaultPath,
+			VaultAddress:  pl.VaultAddress,
+			Namespace:     pl.Namespace,
+			CaCert:        pl.CaCert,
+			TlsServerName: pl.TlsServerName,
+			TlsSkipVerify: pl.TlsSkipVerify,
+			WorkerFilter:  pl.WorkerFilter,
+			TokenHmac:     pl.TokenHmac,
+			Token:         pl.Token,
+			CtToken:       pl.CtToken,
+			TokenKeyId:    pl.TokenKeyId,
+			ClientCert:    pl.ClientCert,
+			ClientKey:     pl.ClientKey,
+			CtClientKey:   pl.CtClientKey,
+			ClientKeyId:   pl.ClientKeyId,
+			Purpose:       pl.Purpose,
+			CommonName:    pl.CommonName,
+			AltNames:      pl.AltNames,
+			IpSans:        pl.IpSans,
+			KeyType:       pl.KeyType,
+			KeyBits:       pl.KeyBits,
+			Ttl:           pl.Ttl,
+		}
 	default:
 		return &genericIssuingCredentialLibrary{
 			PublicId:                      pl.PublicId,
@@ -618,6 +731,8 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			PasswordAttribute:             pl.PasswordAttribute,
 			PrivateKeyAttribute:           pl.PrivateKeyAttribute,
 			PrivateKeyPassphraseAttribute: pl.PrivateKeyPassphraseAttribute,
+			CertificateAttribute:          pl.CertificateAttribute,
+			CaBundleAttribute:             pl.CaBundleAttribute,
 			Name:                          pl.Name,
 			Description:                   pl.Description,
 			CreateTime:                    pl.CreateTime,
@@ -1005,3 +1120,312 @@ func (lib *sshCertIssuingCredentialLibrary) retrieveCredential(ctx context.Conte
 		certificate: []byte(cert),
 	}, nil
 }
+
+type pkiIssuingCredentialLibrary struct {
+	PublicId      string
+	StoreId       string
+	Name          string
+	Description   string
+	CreateTime    *timestamp.Timestamp
+	UpdateTime    *timestamp.Timestamp
+	Version       uint32
+	VaultPath     string
+	CredType      string
+	ProjectId     string
+	VaultAddress  string
+	Namespace     string
+	CaCert        []byte
+	TlsServerName string
+	TlsSkipVerify bool
+	WorkerFilter  string
+	Token         TokenSecret
+	CtToken       []byte
+	TokenHmac     []byte
+	TokenKeyId    string
+	ClientCert    []byte
+	ClientKey     KeySecret
+	CtClientKey   []byte
+	ClientKeyId   string
+	CommonName    string
+	AltNames      string
+	IpSans        string
+	KeyType       string
+	KeyBits       int
+	Ttl           string
+	Purpose       credential.Purpose
+}
+
+func (lib *pkiIssuingCredentialLibrary) GetPublicId() string            { return lib.PublicId }
+func (lib *pkiIssuingCredentialLibrary) GetStoreId() string             { return lib.StoreId }
+func (lib *pkiIssuingCredentialLibrary) GetName() string                { return lib.Name }
+func (lib *pkiIssuingCredentialLibrary) GetDescription() string         { return lib.Description }
+func (lib *pkiIssuingCredentialLibrary) GetVersion() uint32             { return lib.Version }
+func (lib *pkiIssuingCredentialLibrary) GetPurpose() credential.Purpose { return lib.Purpose }
+func (lib *pkiIssuingCredentialLibrary) GetCreateTime() *timestamp.Timestamp {
+	return lib.CreateTime
+}
+
+func (lib *pkiIssuingCredentialLibrary) GetUpdateTime() *timestamp.Timestamp {
+	return lib.UpdateTime
+}
+
+func (lib *pkiIssuingCredentialLibrary) CredentialType() credential.Type {
+	switch ct := lib.CredType; ct {
+	case "":
+		return credential.UnspecifiedType
+	default:
+		return credential.Type(ct)
+	}
+}
+
+func (lib *pkiIssuingCredentialLibrary) client(ctx context.Context) (vaultClient, error) {
+	const op = "vault.(pkiIssuingCredentialLibrary).client"
+	clientConfig := &clientConfig{
+		Addr:          lib.VaultAddress,
+		Token:         lib.Token,
+		CaCert:        lib.CaCert,
+		TlsServerName: lib.TlsServerName,
+		TlsSkipVerify: lib.TlsSkipVerify,
+		Namespace:     lib.Namespace,
+	}
+
+	if lib.ClientKey != nil {
+		clientConfig.ClientCert = lib.ClientCert
+		clientConfig.ClientKey = lib.ClientKey
+	}
+
+	client, err := vaultClientFactoryFn(ctx, clientConfig, WithWorkerFilter(lib.WorkerFilter))
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to create vault client"))
+	}
+	return client, nil
+}
+
+// generateCsr generates a private key of keyType and keyBits and a
+// certificate signing request for commonName signed by the private key.
+// It returns the PEM encoded certificate signing request and the PEM
+// encoded PKCS #8 private key.
+func generateCsr(ctx context.Context, keyType string, keyBits int, commonName string) (string, []byte, error) {
+	const op = "vault.generateCsr"
+	var key crypto.Signer
+	var err error
+	switch keyType {
+	case KeyTypeRsa:
+		key, err = rsa.GenerateKey(rand.Reader, keyBits)
+	case KeyTypeEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case KeyTypeEcdsa:
+		var curve elliptic.Curve
+		switch keyBits {
+		case 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return "", nil, errors.New(ctx, errors.InvalidParameter, op, "invalid KeyBits. when KeyType=ecdsa, KeyBits must be one of: 256, 384, or 521")
+		}
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return "", nil, errors.New(ctx, errors.InvalidParameter, op, "invalid KeyType, must be one of: \"rsa\", \"ed25519\", or \"ecdsa\"")
+	}
+	if err != nil {
+		return "", nil, errors.Wrap(ctx, err, op)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", nil, errors.Wrap(ctx, err, op)
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, key)
+	if err != nil {
+		return "", nil, errors.Wrap(ctx, err, op)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// pkiTtl returns the time to live to request for a certificate. The time
+// to live is ttl bounded by sessionExpiration, if set.
+func pkiTtl(ctx context.Context, ttl string, sessionExpiration time.Time) (string, error) {
+	const op = "vault.pkiTtl"
+	if sessionExpiration.IsZero() {
+		return ttl, nil
+	}
+	remaining := time.Until(sessionExpiration).Truncate(time.Second)
+	if remaining < time.Second {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "session has expired")
+	}
+	if ttl != "" {
+		d, err := parseutil.ParseDurationSecond(ttl)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid ttl"))
+		}
+		if d <= remaining {
+			return ttl, nil
+		}
+	}
+	return fmt.Sprintf("%ds", int64(remaining.Seconds())), nil
+}
+
+type pkiVaultBody struct {
+	CommonName       string `json:"common_name,omitempty"`
+	AltNames         string `json:"alt_names,omitempty"`
+	IpSans           string `json:"ip_sans,omitempty"`
+	TTL              string `json:"ttl,omitempty"`
+	Format           string `json:"format,omitempty"`             // always "pem"
+	Csr              string `json:"csr,omitempty"`                // only used with sign
+	KeyType          string `json:"key_type,omitempty"`           // only used with issue
+	KeyBits          int    `json:"key_bits,omitempty"`           // only used with issue
+	PrivateKeyFormat string `json:"private_key_format,omitempty"` // only used with issue, always "pkcs8"
+}
+
+// retrieveCredential retrieves a dynamic TLS client certificate from the
+// Vault PKI secrets engine for a specific session. If the library's vault
+// path is a sign endpoint, the private key is generated locally and only
+// the certificate signing request is sent to Vault. The serial number of
+// the certificate is used as the external id of the credential so it can
+// be revoked when the session ends.
+//
+// Supported options: credential.WithTemplateData, credential.WithSessionExpiration
+func (lib *pkiIssuingCredentialLibrary) retrieveCredential(ctx context.Context, op errors.Op, opt ...credential.Option) (dynamicCred, error) {
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// Get the credential ID early. No need to get a secret from Vault
+	// if there is no way to save it in the database.
+	credId, err := newCredentialId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	client, err := lib.client(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	generate := func(in string) (string, error) {
+		if in == "" {
+			return "", nil
+		}
+		tplate, err := template.New(ctx, in)
+		if err != nil {
+			return "", err
+		}
+		return tplate.Generate(ctx, opts.WithTemplateData)
+	}
+	payload := pkiVaultBody{
+		Format: "pem",
+	}
+	if payload.CommonName, err = generate(lib.CommonName); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if payload.AltNames, err = generate(lib.AltNames); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if payload.IpSans, err = generate(lib.IpSans); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if payload.TTL, err = pkiTtl(ctx, lib.Ttl, opts.WithSessionExpiration); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	match := vaultPathRegexp.FindStringSubmatch(lib.VaultPath)
+	if len(match) < 2 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "vault path was not in an expected format. expected path containing \"sign\" or \"issue\"")
+	}
+
+	var privateKey credential.PrivateKey
+	switch match[1] {
+	case "sign":
+		payload.Csr, privateKey, err = generateCsr(ctx, lib.KeyType, lib.KeyBits, payload.CommonName)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	case "issue":
+		payload.KeyBits = lib.KeyBits
+		payload.PrivateKeyFormat = "pkcs8"
+		if lib.KeyType == KeyTypeEcdsa {
+			// Vault refers to elliptic curve keys as "ec"
+			payload.KeyType = "ec"
+		} else {
+			payload.KeyType = lib.KeyType
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	secret, err := client.post(ctx, lib.VaultPath, body)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if secret == nil {
+		return nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
+	}
+
+	certPem, _ := secret.Data["certificate"].(string)
+	if privateKey == nil {
+		if pk, ok := secret.Data["private_key"].(string); ok && pk != "" {
+			privateKey = credential.PrivateKey(pk)
+		}
+	}
+	if certPem == "" || privateKey == nil {
+		return nil, errors.New(ctx, errors.VaultInvalidCredentialMapping, op, "vault secret did not contain a certificate and private key or response was not in the expected format")
+	}
+	cert := []byte(certPem)
+	var caBundle []byte
+	if chain, ok := secret.Data["ca_chain"].([]any); ok {
+		var parts []string
+		for _, c := range chain {
+			if c, ok := c.(string); ok && c != "" {
+				parts = append(parts, c)
+			}
+		}
+		if len(parts) > 0 {
+			caBundle = []byte(strings.Join(parts, "\n"))
+		}
+	}
+	if ca, ok := secret.Data["issuing_ca"].(string); ok && ca != "" && caBundle == nil {
+		caBundle = []byte(ca)
+	}
+	serial, ok := secret.Data["serial_number"].(string)
+	if !ok || serial == "" {
+		return nil, errors.New(ctx, errors.VaultInvalidCredentialMapping, op, "vault secret did not contain a serial number or response was not in the expected format")
+	}
+	block, _ := pem.Decode(cert)
+	if block == nil {
+		return nil, errors.New(ctx, errors.VaultInvalidCredentialMapping, op, "vault secret certificate is not PEM encoded")
+	}
+	x509Cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+
+	// The certificate serial number is the external id of the credential.
+	// Certificates are not leases, they cannot be renewed and are revoked
+	// by serial number.
+	cred, err := newCredential(lib.GetPublicId(), serial, lib.TokenHmac, time.Until(x509Cert.NotAfter))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cred.PublicId = credId
+	cred.IsRenewable = false
+
+	return &tlsClientCertCred{
+		baseCred: &baseCred{
+			Credential: cred,
+			lib:        lib,
+			secretData: secret.Data,
+		},
+		certificate: cert,
+		privateKey:  privateKey,
+		caBundle:    caBundle,
+	}, nil
+}
@@ -5,6 +5,9 @@ package vault
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"testing"
 	"time"
@@ -1390,3 +1393,257 @@ func TestRepository_sshCertIssuingCredentialLibrary_retrieveCredential(t *testin
 		})
 	}
 }
+
+func TestRepository_pkiIssuingCredentialLibrary_retrieveCredential(t *testing.T) {
+	t.Parallel()
+
+	// create test vault server
+	v := NewTestVaultServer(t, WithTestVaultTLS(TestNoTLS))
+	require.NotNil(t, v)
+	v.MountPKI(t)
+
+	// create and setup db
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	sec, token := v.CreateToken(t, WithPolicies([]string{"default", "boundary-controller", "pki"}), WithTokenPeriod(time.Hour))
+
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms, sche)
+	require.NoError(t, err)
+	require.NotNil(t, repo)
+
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), v.Addr, token, sec.Auth.Accessor)
+
+	tests := []struct {
+		name       string
+		commonName string
+		vaultPath  string
+		opts       []Option
+		retOpts    []credential.Option
+		wantCn     string
+		wantDns    []string
+		wantErr    string
+	}{
+		{
+			name:       "vault issue rsa(2048) cert",
+			commonName: "issue-rsa.example.com",
+			vaultPath:  "pki/issue/boundary",
+			opts:       []Option{WithKeyType(KeyTypeRsa), WithKeyBits(KeyBitsRsa2048)},
+			wantCn:     "issue-rsa.example.com",
+		},
+		{
+			name:       "vault issue ec(384) cert",
+			commonName: "issue-ec.example.com",
+			vaultPath:  "pki/issue/boundary",
+			opts:       []Option{WithKeyType(KeyTypeEcdsa), WithKeyBits(KeyBitsEcdsa384)},
+			wantCn:     "issue-ec.example.com",
+		},
+		{
+			name:       "vault sign ed25519 key",
+			commonName: "sign-ed25519.example.com",
+			vaultPath:  "pki/sign/boundary",
+			opts:       []Option{WithKeyType(KeyTypeEd25519)},
+			wantCn:     "sign-ed25519.example.com",
+		},
+		{
+			name:       "vault sign rsa(3072) key with alt names",
+			commonName: "sign-rsa.example.com",
+			vaultPath:  "pki/sign/boundary",
+			opts:       []Option{WithKeyType(KeyTypeRsa), WithKeyBits(KeyBitsRsa3072), WithAltNames("www.example.com")},
+			wantCn:     "sign-rsa.example.com",
+			wantDns:    []string{"sign-rsa.example.com", "www.example.com"},
+		},
+		{
+			name:       "vault issue cert with template common name",
+			commonName: "{{ .User.Name }}.example.com",
+			vaultPath:  "pki/issue/boundary",
+			retOpts:    []credential.Option{credential.WithTemplateData(template.Data{User: template.User{Name: util.Pointer("templated")}})},
+			wantCn:     "templated.example.com",
+		},
+		{
+			name:       "vault issue cert bounded by session expiration",
+			commonName: "bounded.example.com",
+			vaultPath:  "pki/issue/boundary",
+			opts:       []Option{WithTtl("24h")},
+			retOpts:    []credential.Option{credential.WithSessionExpiration(time.Now().Add(time.Hour))},
+			wantCn:     "bounded.example.com",
+		},
+		{
+			name:       "session expired",
+			commonName: "expired.example.com",
+			vaultPath:  "pki/issue/boundary",
+			retOpts:    []credential.Option{credential.WithSessionExpiration(time.Now().Add(-time.Minute))},
+			wantErr:    "session has expired",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			lib, err := NewPkiCredentialLibrary(cs.GetPublicId(), tt.vaultPath, tt.commonName, tt.opts...)
+			require.NoError(err)
+			lib, err = repo.CreatePkiCredentialLibrary(ctx, prj.GetPublicId(), lib)
+			require.NoError(err)
+
+			req := credential.Request{
+				SourceId: lib.GetPublicId(),
+				Purpose:  "doesn't matter",
+			}
+			libs, err := repo.getIssueCredLibraries(ctx, []credential.Request{req})
+			require.NoError(err)
+			require.Len(libs, 1)
+
+			cred, err := libs[0].retrieveCredential(ctx, "op", tt.retOpts...)
+			if tt.wantErr != "" {
+				require.ErrorContains(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+
+			tc, ok := cred.(credential.TlsClientCertificate)
+			require.True(ok)
+			require.NotEmpty(tc.PrivateKey())
+			require.NotEmpty(tc.CaBundle())
+
+			// the private key must belong to the certificate
+			_, err = tls.X509KeyPair(tc.Certificate(), tc.PrivateKey())
+			require.NoError(err)
+
+			block, _ := pem.Decode(tc.Certificate())
+			require.NotNil(block)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			require.NoError(err)
+			assert.Equal(tt.wantCn, cert.Subject.CommonName)
+			if tt.wantDns != nil {
+				assert.ElementsMatch(tt.wantDns, cert.DNSNames)
+			}
+			for _, o := range tt.retOpts {
+				opts, err := credential.GetOpts(o)
+				require.NoError(err)
+				if !opts.WithSessionExpiration.IsZero() {
+					assert.False(cert.NotAfter.After(opts.WithSessionExpiration))
+				}
+			}
+
+			// the serial number is used to revoke the certificate
+			dc := cred.(*tlsClientCertCred)
+			assert.NotEmpty(dc.ExternalId)
+			assert.False(dc.IsRenewable)
+			vc := v.client(t)
+			assert.NoError(vc.revokeCertificate(ctx, "pki", dc.ExternalId))
+		})
+	}
+}
+
+func TestGenerateCsr(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		keyType string
+		keyBits int
+		wantErr bool
+	}{
+		{name: "rsa-2048", keyType: KeyTypeRsa, keyBits: KeyBitsRsa2048},
+		{name: "ecdsa-256", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa256},
+		{name: "ecdsa-521", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa521},
+		{name: "ed25519", keyType: KeyTypeEd25519},
+		{name: "ecdsa-invalid-bits", keyType: KeyTypeEcdsa, keyBits: KeyBitsRsa2048, wantErr: true},
+		{name: "invalid-key-type", keyType: "dsa", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			csrPem, keyPem, err := generateCsr(ctx, tt.keyType, tt.keyBits, "example.com")
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+
+			block, _ := pem.Decode([]byte(csrPem))
+			require.NotNil(block)
+			assert.Equal("CERTIFICATE REQUEST", block.Type)
+			csr, err := x509.ParseCertificateRequest(block.Bytes)
+			require.NoError(err)
+			require.NoError(csr.CheckSignature())
+			assert.Equal("example.com", csr.Subject.CommonName)
+
+			block, _ = pem.Decode(keyPem)
+			require.NotNil(block)
+			assert.Equal("PRIVATE KEY", block.Type)
+			_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+			assert.NoError(err)
+		})
+	}
+}
+
+func TestPkiTtl(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		ttl       string
+		sessExp   time.Time
+		wantTtl   string
+		wantBound bool
+		wantErr   bool
+	}{
+		{name: "no-session-expiration", ttl: "1h", wantTtl: "1h"},
+		{name: "no-ttl-no-session-expiration"},
+		{name: "ttl-within-session", ttl: "1m", sessExp: time.Now().Add(time.Hour), wantTtl: "1m"},
+		{name: "ttl-exceeds-session", ttl: "24h", sessExp: time.Now().Add(time.Hour), wantBound: true},
+		{name: "no-ttl-with-session", sessExp: time.Now().Add(time.Hour), wantBound: true},
+		{name: "invalid-ttl", ttl: "forever", sessExp: time.Now().Add(time.Hour), wantErr: true},
+		{name: "session-expired", ttl: "1h", sessExp: time.Now().Add(-time.Minute), wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := pkiTtl(ctx, tt.ttl, tt.sessExp)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			if !tt.wantBound {
+				assert.Equal(tt.wantTtl, got)
+				return
+			}
+			d, err := time.ParseDuration(got)
+			require.NoError(err)
+			assert.LessOrEqual(d, time.Until(tt.sessExp))
+			assert.Greater(d, time.Until(tt.sessExp)-time.Minute)
+		})
+	}
+}
+
+func TestPrivateCredential_pkiMountPath(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "", want: ""},
+		{path: "pki/issue/role", want: "pki"},
+		{path: "/pki/sign/role", want: "/pki"},
+		{path: "team/pki-int/issue/role", want: "team/pki-int"},
+	}
+	for _, tt := range tests {
+		pc := &privateCredential{PkiVaultPath: tt.path}
+		assert.Equal(t, tt.want, pc.pkiMountPath(), tt.path)
+	}
+}
@@ -21,6 +21,9 @@ func init() {
 	if err := subtypes.Register(credential.Domain, SSHCertificateLibrarySubtype, globals.VaultSshCertificateCredentialLibraryPrefix); err != nil {
 		panic(err)
 	}
+	if err := subtypes.Register(credential.Domain, PkiLibrarySubtype, globals.VaultPkiCredentialLibraryPrefix); err != nil {
+		panic(err)
+	}
 }
 
 // PublicId prefixes for the resources in the vault package.
@@ -31,6 +34,7 @@ const (
 	Subtype                      = subtypes.Subtype("vault")
 	GenericLibrarySubtype        = subtypes.Subtype("vault-generic")
 	SSHCertificateLibrarySubtype = subtypes.Subtype("vault-ssh-certificate")
+	PkiLibrarySubtype            = subtypes.Subtype("vault-pki")
 )
 
 func newCredentialStoreId() (string, error) {
@@ -64,3 +68,11 @@ func newSSHCertificateCredentialLibraryId() (string, error) {
 	}
 	return id, nil
 }
+
+func newPkiCredentialLibraryId() (string, error) {
+	id, err := db.NewPublicId(globals.VaultPkiCredentialLibraryPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, "vault.newPkiCredentialLibraryId")
+	}
+	return id, nil
+}
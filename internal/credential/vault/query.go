@@ -109,8 +109,19 @@ returning *;
 	updateTokenExpirationQuery = `
 update credential_vault_token
    set last_renewal_time = now(),
-       expiration_time   = wt_add_seconds_to_now(?)
- where token_hmac = ?;
+       expiration_time   = wt_add_seconds_to_now(@expiration),
+       -- the expiration is capped when Vault renewed the token for less
+       -- time than it did on the previous renewal
+       expiration_capped = (expiration_time - last_renewal_time) - make_interval(secs => @expiration) > interval '1 second'
+ where token_hmac = @token_hmac;
+`
+
+	updateTokenHealthQuery = `
+update credential_vault_token
+   set health_status        = @health_status,
+       health_check_time    = now(),
+       missing_capabilities = nullif(@missing_capabilities, '')
+ where token_hmac = @token_hmac;
 `
 
 	updateTokenStatusQuery = `
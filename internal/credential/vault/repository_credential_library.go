@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -21,13 +22,14 @@ import (
 // CreateCredentialLibrary inserts l into the repository and returns a new
 // CredentialLibrary containing the credential library's PublicId. l is not
 // changed. l must contain a valid StoreId. l must not contain a PublicId.
-// The PublicId is generated and assigned by this method.
+// The PublicId is generated and assigned by this method unless the
+// WithPublicId option is provided.
 //
 // Both l.Name and l.Description are optional. If l.Name is set, it must be
 // unique within l.StoreId.
 //
 // Both l.CreateTime and l.UpdateTime are ignored.
-func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, _ ...Option) (*CredentialLibrary, error) {
+func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId string, l *CredentialLibrary, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.(Repository).CreateCredentialLibrary"
 	if l == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialLibrary")
@@ -57,11 +59,19 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, projectId stri
 		return nil, err // intentionally not wrapped.
 	}
 
-	id, err := newCredentialLibraryId()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	opts := getOpts(opt...)
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.VaultCredentialLibraryPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.VaultCredentialLibraryPrefix))
+		}
+		l.setId(opts.withPublicId)
+	} else {
+		id, err := newCredentialLibraryId()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		l.setId(id)
 	}
-	l.setId(id)
 
 	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
 	if err != nil {
@@ -363,6 +373,8 @@ type listLookupLibrary struct {
 	PasswordAttribute             string
 	PrivateKeyAttribute           string
 	PrivateKeyPassphraseAttribute string
+	CertificateAttribute          string
+	CaBundleAttribute             string
 }
 
 func allocListLookupLibrary() *listLookupLibrary {
@@ -403,6 +415,17 @@ func (pl *listLookupLibrary) toCredentialLibrary() *CredentialLibrary {
 			pk.sanitize()
 			cl.MappingOverride = pk
 		}
+	case string(credential.TlsClientCertificateType):
+		if pl.CertificateAttribute != "" || pl.PrivateKeyAttribute != "" || pl.PrivateKeyPassphraseAttribute != "" || pl.CaBundleAttribute != "" {
+			tc := allocTlsClientCertificateOverride()
+			tc.LibraryId = pl.PublicId
+			tc.CertificateAttribute = pl.CertificateAttribute
+			tc.PrivateKeyAttribute = pl.PrivateKeyAttribute
+			tc.PrivateKeyPassphraseAttribute = pl.PrivateKeyPassphraseAttribute
+			tc.CaBundleAttribute = pl.CaBundleAttribute
+			tc.sanitize()
+			cl.MappingOverride = tc
+		}
 	}
 	return cl
 }
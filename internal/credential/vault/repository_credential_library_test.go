@@ -576,6 +576,38 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 		assert.Equal(in2.Description, got2.Description)
 		assert.Equal(got2.CreateTime, got2.UpdateTime)
 	})
+
+	t.Run("with-public-id", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ctx := context.Background()
+		kms := kms.TestKms(t, conn, wrapper)
+		sche := scheduler.TestScheduler(t, conn, wrapper)
+		repo, err := NewRepository(rw, rw, kms, sche)
+		require.NoError(err)
+		require.NotNil(repo)
+
+		_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+		in := &CredentialLibrary{
+			CredentialLibrary: &store.CredentialLibrary{
+				StoreId:    cs.GetPublicId(),
+				HttpMethod: "GET",
+				VaultPath:  "/some/path",
+			},
+		}
+		id, err := newCredentialLibraryId()
+		require.NoError(err)
+
+		got, err := repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), in, WithPublicId(id))
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(id, got.GetPublicId())
+
+		_, err = repo.CreateCredentialLibrary(ctx, prj.GetPublicId(), in, WithPublicId("bad_"+id))
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.InvalidPublicId), err), "want err code: %q got err: %q", errors.InvalidPublicId, err)
+	})
 }
 
 func TestRepository_UpdateCredentialLibrary(t *testing.T) {
@@ -212,6 +212,7 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	// cause update to fail.
 	// TODO (lcr 05/2021): log error once repo has logger
 	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenRenewalJobName, token.renewalIn())
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenHealthJobName, 0, scheduler.WithRunNow(true))
 
 	return newCredentialStore, nil
 }
@@ -286,6 +287,12 @@ type listLookupStore struct {
 	TokenStatus       string
 	ClientCert        []byte
 	ClientCertKeyHmac []byte
+
+	TokenLastRenewalTime     *timestamp.Timestamp
+	TokenExpirationTime      *timestamp.Timestamp
+	TokenHealthStatus        string
+	TokenHealthCheckTime     *timestamp.Timestamp
+	TokenMissingCapabilities string
 }
 
 func allocListLookupStore() *listLookupStore {
@@ -313,6 +320,21 @@ func (ps *listLookupStore) toCredentialStore() *CredentialStore {
 	tk.Status = ps.TokenStatus
 	cs.outputToken = tk
 
+	if ps.TokenHmac != nil {
+		h := &Health{
+			Status:          HealthStatus(ps.TokenHealthStatus),
+			LastRenewalTime: ps.TokenLastRenewalTime.AsTime(),
+			ExpirationTime:  ps.TokenExpirationTime.AsTime(),
+		}
+		if ps.TokenHealthCheckTime != nil {
+			h.CheckTime = ps.TokenHealthCheckTime.AsTime()
+		}
+		if ps.TokenMissingCapabilities != "" {
+			h.MissingCapabilities = strings.Split(ps.TokenMissingCapabilities, "\n")
+		}
+		cs.health = h
+	}
+
 	if ps.ClientCert != nil {
 		cert := allocClientCertificate()
 		cert.Certificate = ps.ClientCert
@@ -641,6 +663,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 		// cause update to fail.
 		// TODO (lcr 05/2021): log error once repo has logger
 		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenRenewalJobName, token.renewalIn())
+		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenHealthJobName, 0, scheduler.WithRunNow(true))
 	}
 
 	return returnedCredentialStore, rowsUpdated, nil
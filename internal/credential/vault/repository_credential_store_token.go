@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+// RotateToken replaces the current Vault token of the credential store
+// publicId with token and returns the updated CredentialStore. version must
+// match the current version of the credential store. The new token must
+// have the same properties defined in CreateCredentialStore and is
+// validated with the store's Vault server before it is swapped in.
+//
+// The replaced token is not revoked immediately. It transitions to the
+// maintaining state and is renewed for as long as credentials issued with
+// it are in use, so their leases can still be renewed and revoked. Once all
+// of those credentials have been revoked or have expired, the replaced
+// token is revoked by the token revocation job.
+func (r *Repository) RotateToken(ctx context.Context, publicId string, version uint32, token TokenSecret, _ ...Option) (*CredentialStore, error) {
+	const op = "vault.(Repository).RotateToken"
+	switch {
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	case version == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case len(token) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing vault token")
+	}
+
+	ps, err := r.lookupClientStore(ctx, publicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup private credential store"))
+	}
+	if ps == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential store %s", publicId))
+	}
+	if bytes.Equal(ps.Token, token) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "token is already the current token of the credential store")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, ps.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, ps.ProjectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+
+	if len(ps.Token) == 0 {
+		// The current token has expired, the client can only be created
+		// with the new token.
+		ps.Token = token
+	}
+	client, err := ps.client(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create vault client"))
+	}
+	client.swapToken(ctx, token)
+
+	tokenLookup, err := client.lookupToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup vault token"))
+	}
+	if err := validateTokenLookup(op, tokenLookup); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	available, err := client.capabilities(ctx, requiredCapabilities.paths())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault capabilities"))
+	}
+	missing := available.missing(requiredCapabilities)
+	if len(missing) > 0 {
+		return nil, errors.New(ctx, errors.VaultTokenMissingCapabilities, op, fmt.Sprintf("missing capabilites: %v", missing))
+	}
+
+	renewedToken, err := client.renewToken(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
+	}
+	tokenExpires, err := renewedToken.TokenTTL()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token expiration"))
+	}
+	accessor, err := renewedToken.TokenAccessor()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token accessor"))
+	}
+	newTk, err := newToken(publicId, token, []byte(accessor), tokenExpires)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	runJobsInterval := r.scheduler.GetRunJobsInterval()
+	if newTk.expiration <= runJobsInterval {
+		return nil, errors.Wrap(ctx, fmt.Errorf("scheduler interval must be greater than token ttl. scheduler jobs interval value: %s", runJobsInterval.String()), op)
+	}
+	if err := newTk.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	cs.ProjectId = ps.ProjectId
+
+	var rotatedStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 2)
+			ticket, err := w.GetTicket(ctx, cs)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			cs := cs.clone()
+			cs.Version = version + 1
+			var csOplogMsg oplog.Message
+			rowsUpdated, err := w.Update(ctx, cs, []string{"Version"}, nil, db.NewOplogMsg(&csOplogMsg), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store version"))
+			}
+			switch rowsUpdated {
+			case 1:
+			case 0:
+				return errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("version %d is not the current version of credential store %s", version, publicId))
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated credential store version and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &csOplogMsg)
+
+			// Inserting the new token moves the current token to the
+			// maintaining state.
+			query, values := newTk.insertQuery()
+			rows, err := w.Exec(ctx, query, values)
+			if err != nil {
+				if errors.IsUniqueError(err) {
+					return errors.New(ctx, errors.NotUnique, op, "token already exists")
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			if rows > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 token would have been created")
+			}
+			msgs = append(msgs, newTk.oplogMessage(db.CreateOp))
+
+			agg := allocListLookupStore()
+			agg.PublicId = publicId
+			if err := reader.LookupByPublicId(ctx, agg); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup credential store: %s", publicId)))
+			}
+			rotatedStore = agg.toCredentialStore()
+
+			metadata := cs.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Best effort update next run time of the token jobs, but an error should
+	// not cause the rotation to fail. The revocation job revokes the replaced
+	// token right away if no credentials were issued with it.
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenRenewalJobName, newTk.renewalIn())
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenHealthJobName, 0, scheduler.WithRunNow(true))
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, tokenRevocationJobName, 0, scheduler.WithRunNow(true))
+
+	return rotatedStore, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RotateToken(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	tests := []struct {
+		name               string
+		newTokenOpts       []TestOption
+		sameToken          bool
+		version            uint32
+		wantOldTokenStatus TokenStatus
+		updateToken        func(ctx context.Context, tokenHmac []byte)
+		wantErr            errors.Code
+	}{
+		{
+			name:               "valid",
+			version:            1,
+			wantOldTokenStatus: MaintainingToken,
+		},
+		{
+			name:               "valid-token-expired",
+			version:            1,
+			wantOldTokenStatus: ExpiredToken,
+			updateToken: func(ctx context.Context, tokenHmac []byte) {
+				_, err := rw.Exec(ctx,
+					"update credential_vault_token set status = ? where token_hmac = ?",
+					[]any{ExpiredToken, tokenHmac})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "missing-version",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "wrong-version",
+			version: 2,
+			wantErr: errors.VersionMismatch,
+		},
+		{
+			name:      "same-token",
+			version:   1,
+			sameToken: true,
+			wantErr:   errors.InvalidParameter,
+		},
+		{
+			name:         "token-missing-capabilities",
+			version:      1,
+			newTokenOpts: []TestOption{WithPolicies([]string{"default"})},
+			wantErr:      errors.VaultTokenMissingCapabilities,
+		},
+		{
+			name:         "token-not-renewable",
+			version:      1,
+			newTokenOpts: []TestOption{TestRenewableToken(false)},
+			wantErr:      errors.VaultTokenNotRenewable,
+		},
+		{
+			name:         "token-not-periodic",
+			version:      1,
+			newTokenOpts: []TestOption{TestPeriodicToken(false)},
+			wantErr:      errors.VaultTokenNotPeriodic,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			kms := kms.TestKms(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms, sche)
+			require.NoError(err)
+			require.NotNil(repo)
+			_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+			require.NoError(RegisterJobs(ctx, sche, rw, rw, kms))
+
+			v := NewTestVaultServer(t)
+			_, origToken := v.CreateToken(t)
+
+			origIn, err := NewCredentialStore(prj.GetPublicId(), v.Addr, []byte(origToken))
+			require.NoError(err)
+			orig, err := repo.CreateCredentialStore(ctx, origIn)
+			require.NoError(err)
+			require.NotNil(orig)
+
+			if tt.updateToken != nil {
+				tt.updateToken(ctx, orig.outputToken.TokenHmac)
+			}
+
+			newToken := origToken
+			if !tt.sameToken {
+				_, newToken = v.CreateToken(t, tt.newTokenOpts...)
+			}
+
+			got, err := repo.RotateToken(ctx, orig.GetPublicId(), tt.version, TokenSecret(newToken))
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.version+1, got.GetVersion())
+			require.NotNil(got.Token())
+			assert.Equal(string(CurrentToken), got.Token().GetStatus())
+			assert.NotEqual(orig.outputToken.TokenHmac, got.Token().TokenHmac)
+
+			oldToken := allocToken()
+			require.NoError(rw.LookupWhere(ctx, &oldToken, "token_hmac = ?", []any{orig.outputToken.TokenHmac}))
+			assert.Equal(string(tt.wantOldTokenStatus), oldToken.Status)
+
+			// The replaced token is not revoked until the revocation job runs
+			v.LookupToken(t, origToken)
+		})
+	}
+
+	t.Run("not-found", func(t *testing.T) {
+		assert := assert.New(t)
+		repo, err := NewRepository(rw, rw, kms.TestKms(t, conn, wrapper), sche)
+		require.NoError(t, err)
+		got, err := repo.RotateToken(context.Background(), "csvlt_1234567890", 1, TokenSecret("token"))
+		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "want err: %q got: %q", errors.RecordNotFound, err)
+		assert.Nil(got)
+	})
+}
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreatePkiCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	tests := []struct {
+		name    string
+		in      *PkiCredentialLibrary
+		want    *PkiCredentialLibrary
+		wantErr errors.Code
+	}{
+		{
+			name:    "nil-PkiCredentialLibrary",
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name:    "nil-embedded-PkiCredentialLibrary",
+			in:      &PkiCredentialLibrary{},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-no-store-id",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary("", "/pki/issue/foo", "example.com")
+				return s
+			}(),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-no-common-name",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/issue/foo", "")
+				return s
+			}(),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-ssh-certificate-credential-type",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/issue/foo", "example.com", WithCredentialType(credential.SshCertificateType))
+				return s
+			}(),
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-vault-path",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/roles/foo", "example.com")
+				return s
+			}(),
+			wantErr: errors.CheckConstraint,
+		},
+		{
+			name: "valid-no-options",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/issue/foo", "example.com")
+				return s
+			}(),
+			want: &PkiCredentialLibrary{
+				PkiCredentialLibrary: &store.PkiCredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					VaultPath:  "/pki/issue/foo",
+					CommonName: "example.com",
+					KeyType:    KeyTypeRsa,
+					KeyBits:    KeyBitsRsa2048,
+				},
+			},
+		},
+		{
+			name: "valid-sign-ecdsa-384",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/sign/foo", "example.com", WithKeyType(KeyTypeEcdsa), WithKeyBits(KeyBitsEcdsa384))
+				return s
+			}(),
+			want: &PkiCredentialLibrary{
+				PkiCredentialLibrary: &store.PkiCredentialLibrary{
+					StoreId:    cs.GetPublicId(),
+					VaultPath:  "/pki/sign/foo",
+					CommonName: "example.com",
+					KeyType:    KeyTypeEcdsa,
+					KeyBits:    KeyBitsEcdsa384,
+				},
+			},
+		},
+		{
+			name: "valid-all-options",
+			in: func() *PkiCredentialLibrary {
+				s, _ := NewPkiCredentialLibrary(
+					cs.GetPublicId(),
+					"/pki/issue/foo",
+					"{{.User.Name}}.example.com",
+					WithName("test-name-repo"),
+					WithDescription("test-description-repo"),
+					WithAltNames("www.example.com,{{.User.Email}}"),
+					WithIpSans("10.0.0.1"),
+					WithKeyType(KeyTypeEd25519),
+					WithTtl("1h"),
+				)
+				return s
+			}(),
+			want: &PkiCredentialLibrary{
+				PkiCredentialLibrary: &store.PkiCredentialLibrary{
+					StoreId:     cs.GetPublicId(),
+					Name:        "test-name-repo",
+					Description: "test-description-repo",
+					VaultPath:   "/pki/issue/foo",
+					CommonName:  "{{.User.Name}}.example.com",
+					AltNames:    "www.example.com,{{.User.Email}}",
+					IpSans:      "10.0.0.1",
+					KeyType:     KeyTypeEd25519,
+					KeyBits:     KeyBitsDefault,
+					Ttl:         "1h",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			kms := kms.TestKms(t, conn, wrapper)
+			sche := scheduler.TestScheduler(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms, sche)
+			require.NoError(err)
+			require.NotNil(repo)
+			got, err := repo.CreatePkiCredentialLibrary(ctx, prj.GetPublicId(), tt.in)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Empty(tt.in.PublicId)
+			require.NotNil(got)
+			assertPublicId(t, globals.VaultPkiCredentialLibraryPrefix, got.GetPublicId())
+			assert.NotSame(tt.in, got)
+			assert.Equal(tt.want.Name, got.Name)
+			assert.Equal(tt.want.Description, got.Description)
+			assert.Equal(tt.want.VaultPath, got.VaultPath)
+			assert.Equal(tt.want.CommonName, got.CommonName)
+			assert.Equal(tt.want.AltNames, got.AltNames)
+			assert.Equal(tt.want.IpSans, got.IpSans)
+			assert.Equal(tt.want.KeyType, got.KeyType)
+			assert.Equal(tt.want.KeyBits, got.KeyBits)
+			assert.Equal(tt.want.Ttl, got.Ttl)
+			assert.Equal(credential.TlsClientCertificateType, got.CredentialType())
+			assert.Equal(got.CreateTime, got.UpdateTime)
+
+			assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+}
+
+func TestRepository_UpdatePkiCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	tests := []struct {
+		name      string
+		update    *store.PkiCredentialLibrary
+		masks     []string
+		want      *store.PkiCredentialLibrary
+		wantCount int
+		wantErr   errors.Code
+	}{
+		{
+			name:    "no-masks",
+			update:  &store.PkiCredentialLibrary{Name: "new-name"},
+			wantErr: errors.EmptyFieldMask,
+		},
+		{
+			name:    "clear-common-name",
+			update:  &store.PkiCredentialLibrary{},
+			masks:   []string{commonNameField},
+			wantErr: errors.NotNull,
+		},
+		{
+			name:    "invalid-key-bits",
+			update:  &store.PkiCredentialLibrary{KeyBits: KeyBitsEcdsa521},
+			masks:   []string{keyBitsField},
+			wantErr: errors.NotSpecificIntegrity,
+		},
+		{
+			name: "change-names",
+			update: &store.PkiCredentialLibrary{
+				Name:       "new-name",
+				CommonName: "new.example.com",
+				AltNames:   "www.new.example.com",
+				IpSans:     "10.0.0.2",
+			},
+			masks: []string{nameField, commonNameField, altNamesField, ipSansField},
+			want: &store.PkiCredentialLibrary{
+				Name:       "new-name",
+				VaultPath:  "/pki/issue/foo",
+				CommonName: "new.example.com",
+				AltNames:   "www.new.example.com",
+				IpSans:     "10.0.0.2",
+				KeyType:    KeyTypeRsa,
+				KeyBits:    KeyBitsRsa2048,
+				Ttl:        "1h",
+			},
+			wantCount: 1,
+		},
+		{
+			name:   "clear-alt-names-and-ttl",
+			update: &store.PkiCredentialLibrary{},
+			masks:  []string{altNamesField, ttlField},
+			want: &store.PkiCredentialLibrary{
+				VaultPath:  "/pki/issue/foo",
+				CommonName: "example.com",
+				KeyType:    KeyTypeRsa,
+				KeyBits:    KeyBitsRsa2048,
+			},
+			wantCount: 1,
+		},
+		{
+			name: "change-key-type-and-path",
+			update: &store.PkiCredentialLibrary{
+				VaultPath: "/pki/sign/bar",
+				KeyType:   KeyTypeEcdsa,
+				KeyBits:   KeyBitsEcdsa521,
+			},
+			masks: []string{vaultPathField, keyTypeField, keyBitsField},
+			want: &store.PkiCredentialLibrary{
+				VaultPath:  "/pki/sign/bar",
+				CommonName: "example.com",
+				AltNames:   "www.example.com",
+				KeyType:    KeyTypeEcdsa,
+				KeyBits:    KeyBitsEcdsa521,
+				Ttl:        "1h",
+			},
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := context.Background()
+			kms := kms.TestKms(t, conn, wrapper)
+			sche := scheduler.TestScheduler(t, conn, wrapper)
+			repo, err := NewRepository(rw, rw, kms, sche)
+			require.NoError(err)
+			require.NotNil(repo)
+
+			in, err := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/issue/foo", "example.com", WithAltNames("www.example.com"), WithTtl("1h"))
+			require.NoError(err)
+			orig, err := repo.CreatePkiCredentialLibrary(ctx, prj.GetPublicId(), in)
+			require.NoError(err)
+			require.NotNil(orig)
+
+			upd := &PkiCredentialLibrary{PkiCredentialLibrary: tt.update}
+			upd.PublicId = orig.GetPublicId()
+			got, gotCount, err := repo.UpdatePkiCredentialLibrary(ctx, prj.GetPublicId(), upd, orig.GetVersion(), tt.masks)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Equal(tt.wantCount, gotCount, "row count")
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCount, gotCount, "row count")
+			require.NotNil(got)
+			assert.Equal(tt.want.Name, got.Name)
+			assert.Equal(tt.want.VaultPath, got.VaultPath)
+			assert.Equal(tt.want.CommonName, got.CommonName)
+			assert.Equal(tt.want.AltNames, got.AltNames)
+			assert.Equal(tt.want.IpSans, got.IpSans)
+			assert.Equal(tt.want.KeyType, got.KeyType)
+			assert.Equal(tt.want.KeyBits, got.KeyBits)
+			assert.Equal(tt.want.Ttl, got.Ttl)
+			assert.Equal(credential.TlsClientCertificateType, got.CredentialType())
+
+			assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+}
+
+func TestRepository_LookupListDeletePkiCredentialLibrary(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms, sche)
+	require.NoError(err)
+	require.NotNil(repo)
+
+	var ids []string
+	for _, cn := range []string{"a.example.com", "b.example.com"} {
+		in, err := NewPkiCredentialLibrary(cs.GetPublicId(), "/pki/issue/foo", cn)
+		require.NoError(err)
+		l, err := repo.CreatePkiCredentialLibrary(ctx, prj.GetPublicId(), in)
+		require.NoError(err)
+		ids = append(ids, l.GetPublicId())
+	}
+
+	got, err := repo.LookupPkiCredentialLibrary(ctx, ids[0])
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal("a.example.com", got.GetCommonName())
+	assert.Equal(credential.TlsClientCertificateType, got.CredentialType())
+
+	badId, err := newPkiCredentialLibraryId()
+	require.NoError(err)
+	got, err = repo.LookupPkiCredentialLibrary(ctx, badId)
+	assert.NoError(err)
+	assert.Nil(got)
+
+	_, err = repo.LookupPkiCredentialLibrary(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	libs, err := repo.ListPkiCredentialLibraries(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Len(libs, 2)
+
+	libs, err = repo.ListPkiCredentialLibraries(ctx, cs.GetPublicId(), WithLimit(1))
+	require.NoError(err)
+	assert.Len(libs, 1)
+
+	rows, err := repo.DeletePkiCredentialLibrary(ctx, prj.GetPublicId(), ids[0])
+	require.NoError(err)
+	assert.Equal(1, rows)
+
+	rows, err = repo.DeletePkiCredentialLibrary(ctx, prj.GetPublicId(), badId)
+	require.NoError(err)
+	assert.Equal(0, rows)
+
+	got, err = repo.LookupPkiCredentialLibrary(ctx, ids[0])
+	assert.NoError(err)
+	assert.Nil(got)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -19,13 +20,14 @@ import (
 // CreateSSHCertificateCredentialLibrary inserts l into the repository and returns a new
 // SSHCertificateCredentialLibrary containing the credential library's PublicId. l is not
 // changed. l must contain a valid StoreId. l must not contain a PublicId.
-// The PublicId is generated and assigned by this method.
+// The PublicId is generated and assigned by this method unless the
+// WithPublicId option is provided.
 //
 // Both l.Name and l.Description are optional. If l.Name is set, it must be
 // unique within l.StoreId.
 //
 // Both l.CreateTime and l.UpdateTime are ignored.
-func (r *Repository) CreateSSHCertificateCredentialLibrary(ctx context.Context, projectId string, l *SSHCertificateCredentialLibrary, _ ...Option) (*SSHCertificateCredentialLibrary, error) {
+func (r *Repository) CreateSSHCertificateCredentialLibrary(ctx context.Context, projectId string, l *SSHCertificateCredentialLibrary, opt ...Option) (*SSHCertificateCredentialLibrary, error) {
 	const op = "vault.(Repository).CreateSSHCertificateCredentialLibrary"
 	if l == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil SSHCertificateCredentialLibrary")
@@ -66,11 +68,19 @@ func (r *Repository) CreateSSHCertificateCredentialLibrary(ctx context.Context,
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid credential type")
 	}
 
-	id, err := newSSHCertificateCredentialLibraryId()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+	opts := getOpts(opt...)
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.VaultSshCertificateCredentialLibraryPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.VaultSshCertificateCredentialLibraryPrefix))
+		}
+		l.setId(opts.withPublicId)
+	} else {
+		id, err := newSSHCertificateCredentialLibraryId()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		l.setId(id)
 	}
-	l.setId(id)
 
 	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -23,6 +24,7 @@ type vaultClient interface {
 	revokeToken(context.Context) error
 	renewLease(context.Context, string, time.Duration) (*vault.Secret, error)
 	revokeLease(context.Context, string) error
+	revokeCertificate(context.Context, string, string) error
 	lookupToken(context.Context) (*vault.Secret, error)
 	swapToken(context.Context, TokenSecret) (old TokenSecret)
 	get(context.Context, string) (*vault.Secret, error)
@@ -186,6 +188,20 @@ func (c *client) revokeLease(ctx context.Context, leaseId string) error {
 	return nil
 }
 
+// revokeCertificate calls the revoke endpoint of the PKI secrets engine
+// mounted at mountPath to revoke the certificate with serialNumber. See
+// https://developer.hashicorp.com/vault/api-docs/secret/pki#revoke-certificate.
+func (c *client) revokeCertificate(ctx context.Context, mountPath, serialNumber string) error {
+	const op = "vault.(client).revokeCertificate"
+	data := map[string]any{
+		"serial_number": serialNumber,
+	}
+	if _, err := c.cl.Logical().Write(path.Join(mountPath, "revoke"), data); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Unknown), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	return nil
+}
+
 // lookupToken calls the /auth/token/lookup-self Vault endpoint and returns
 // the vault.Secret response. This endpoint is accessible with the default
 // policy in Vault 1.7.2. See
@@ -170,8 +170,8 @@ func (t *Token) updateExpirationQuery() (query string, queryValues []any) {
 
 	exp := int(t.expiration.Round(time.Second).Seconds())
 	queryValues = []any{
-		exp,
-		t.TokenHmac,
+		sql.Named("expiration", exp),
+		sql.Named("token_hmac", t.TokenHmac),
 	}
 	return
 }
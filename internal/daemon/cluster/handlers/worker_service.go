@@ -35,7 +35,12 @@ type workerServiceServer struct {
 	workerAuthRepoFn    common.WorkerAuthRepoStorageFactory
 	sessionRepoFn       session.RepositoryFactory
 	connectionRepoFn    common.ConnectionRepoFactory
+	staticHostRepoFn    common.StaticRepoFactory
+	pluginHostRepoFn    common.PluginHostRepoFactory
+	dnsHostRepoFn       common.DnsHostRepoFactory
+	hostHealthRepoFn    common.HostHealthRepoFactory
 	downstreams         common.Downstreamers
+	healthCheckCache    *sync.Map
 	updateTimes         *sync.Map
 	kms                 *kms.Kms
 	livenessTimeToStale *atomic.Int64
@@ -69,6 +74,10 @@ func NewWorkerServiceServer(
 	workerAuthRepoFn common.WorkerAuthRepoStorageFactory,
 	sessionRepoFn session.RepositoryFactory,
 	connectionRepoFn common.ConnectionRepoFactory,
+	staticHostRepoFn common.StaticRepoFactory,
+	pluginHostRepoFn common.PluginHostRepoFactory,
+	dnsHostRepoFn common.DnsHostRepoFactory,
+	hostHealthRepoFn common.HostHealthRepoFactory,
 	downstreams common.Downstreamers,
 	updateTimes *sync.Map,
 	kms *kms.Kms,
@@ -79,7 +88,12 @@ func NewWorkerServiceServer(
 		workerAuthRepoFn:    workerAuthRepoFn,
 		sessionRepoFn:       sessionRepoFn,
 		connectionRepoFn:    connectionRepoFn,
+		staticHostRepoFn:    staticHostRepoFn,
+		pluginHostRepoFn:    pluginHostRepoFn,
+		dnsHostRepoFn:       dnsHostRepoFn,
+		hostHealthRepoFn:    hostHealthRepoFn,
 		downstreams:         downstreams,
+		healthCheckCache:    new(sync.Map),
 		updateTimes:         updateTimes,
 		kms:                 kms,
 		livenessTimeToStale: livenessTimeToStale,
@@ -208,6 +222,17 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		AuthorizedDownstreamWorkers: authorizedDownstreams,
 	}
 
+	// Host health checks are best effort and must not prevent the worker
+	// from reporting its status. Results are only stored for the checks
+	// assigned to the worker.
+	hostHealthChecks, err := ws.assignedHostHealthChecks(ctx, wrk)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error getting host health checks"))
+	} else if err := ws.recordHostHealth(ctx, wrk, hostHealthChecks, req.GetHostHealthResults()); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error storing host health results"))
+	}
+	ret.HostHealthChecks = hostHealthChecks
+
 	stateReport := make([]*session.StateReport, 0, len(req.GetJobs()))
 
 	for _, jobStatus := range req.GetJobs() {
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+	"net"
+	"time"
+
+	dcommon "github.com/hashicorp/boundary/internal/daemon/common"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/go-bexpr"
+)
+
+const (
+	hostDomain = "host"
+
+	// hostHealthChecksTtl is how long the host health checks assigned to a
+	// worker are reused before they are computed again.
+	hostHealthChecksTtl = 30 * time.Second
+)
+
+// assignedHostHealthChecks are the host health checks assigned to a worker
+// and the time they stop being reused. They are stored in the
+// healthCheckCache of workerServiceServer keyed by worker id.
+type assignedHostHealthChecks struct {
+	checks  []*pbs.HostHealthCheck
+	expires time.Time
+}
+
+type hostHealthCheckKey struct {
+	hostSetId string
+	hostId    string
+}
+
+// recordHostHealth stores the results of the host health checks run by the
+// worker wrk. The results are stored for wrk, so they don't replace the
+// results of other workers checking the same hosts. Results for checks
+// which are not in checks, the checks assigned to wrk, are dropped.
+func (ws *workerServiceServer) recordHostHealth(ctx context.Context, wrk *server.Worker, checks []*pbs.HostHealthCheck, results []*pbs.HostHealthResult) error {
+	const op = "workers.(workerServiceServer).recordHostHealth"
+	if len(results) == 0 || len(checks) == 0 {
+		return nil
+	}
+	assigned := make(map[hostHealthCheckKey]bool, len(checks))
+	for _, c := range checks {
+		assigned[hostHealthCheckKey{hostSetId: c.GetHostSetId(), hostId: c.GetHostId()}] = true
+	}
+	hh := make([]*health.HostHealth, 0, len(results))
+	for _, r := range results {
+		if !assigned[hostHealthCheckKey{hostSetId: r.GetHostSetId(), hostId: r.GetHostId()}] {
+			continue
+		}
+		h, err := health.NewHostHealth(ctx, r.GetHostSetId(), r.GetHostId(), wrk.GetPublicId(), r.GetHealthy(), r.GetCheckTime().AsTime(),
+			health.WithError(r.GetError()))
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		hh = append(hh, h)
+	}
+	if len(hh) == 0 {
+		return nil
+	}
+	healthRepo, err := ws.hostHealthRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := healthRepo.UpsertHostHealth(ctx, hh); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// assignedHostHealthChecks returns the host health checks the worker wrk
+// should run. The checks are computed at most once every
+// hostHealthChecksTtl for each worker.
+func (ws *workerServiceServer) assignedHostHealthChecks(ctx context.Context, wrk *server.Worker) ([]*pbs.HostHealthCheck, error) {
+	const op = "workers.(workerServiceServer).assignedHostHealthChecks"
+	now := time.Now()
+	if v, ok := ws.healthCheckCache.Load(wrk.GetPublicId()); ok {
+		if a := v.(*assignedHostHealthChecks); now.Before(a.expires) {
+			return a.checks, nil
+		}
+	}
+	checks, err := ws.hostHealthChecks(ctx, wrk)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ws.healthCheckCache.Store(wrk.GetPublicId(), &assignedHostHealthChecks{
+		checks:  checks,
+		expires: now.Add(hostHealthChecksTtl),
+	})
+	return checks, nil
+}
+
+// hostHealthChecks returns the host health checks the worker wrk should
+// run. A worker runs the health check of a host set if it matches the
+// worker filter of any target using the host set.
+func (ws *workerServiceServer) hostHealthChecks(ctx context.Context, wrk *server.Worker) ([]*pbs.HostHealthCheck, error) {
+	const op = "workers.(workerServiceServer).hostHealthChecks"
+	healthRepo, err := ws.hostHealthRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	filters, err := healthRepo.ListHealthCheckWorkerFilters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var setIds []string
+	for setId, setFilters := range filters {
+		for _, f := range setFilters {
+			ok, err := workerMatchesFilter(wrk, f)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if ok {
+				setIds = append(setIds, setId)
+				break
+			}
+		}
+	}
+	if len(setIds) == 0 {
+		return nil, nil
+	}
+	checks, err := healthRepo.ListHealthChecks(ctx, setIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(checks) == 0 {
+		return nil, nil
+	}
+
+	var endpoints []*host.Endpoint
+	var pluginHostSetIds, dnsHostSetIds []string
+	for _, c := range checks {
+		switch subtypes.SubtypeFromId(hostDomain, c.GetHostSetId()) {
+		case static.Subtype:
+			staticHostRepo, err := ws.staticHostRepoFn()
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			eps, err := staticHostRepo.Endpoints(ctx, c.GetHostSetId())
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			endpoints = append(endpoints, eps...)
+		case dns.Subtype:
+			dnsHostSetIds = append(dnsHostSetIds, c.GetHostSetId())
+		default:
+			pluginHostSetIds = append(pluginHostSetIds, c.GetHostSetId())
+		}
+	}
+	if len(pluginHostSetIds) > 0 {
+		pluginHostRepo, err := ws.pluginHostRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		eps, err := pluginHostRepo.Endpoints(ctx, pluginHostSetIds)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		endpoints = append(endpoints, eps...)
+	}
+	if len(dnsHostSetIds) > 0 {
+		dnsHostRepo, err := ws.dnsHostRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		eps, err := dnsHostRepo.Endpoints(ctx, dnsHostSetIds)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		endpoints = append(endpoints, eps...)
+	}
+
+	checksBySet := make(map[string]*health.HealthCheck, len(checks))
+	for _, c := range checks {
+		checksBySet[c.GetHostSetId()] = c
+	}
+	ret := make([]*pbs.HostHealthCheck, 0, len(endpoints))
+	for _, ep := range endpoints {
+		c, ok := checksBySet[ep.SetId]
+		if !ok || ep.Address == "" {
+			continue
+		}
+		address := ep.Address
+		if h, _, err := net.SplitHostPort(address); err == nil {
+			address = h
+		}
+		ret = append(ret, &pbs.HostHealthCheck{
+			HostSetId:       ep.SetId,
+			HostId:          ep.HostId,
+			Address:         address,
+			CheckType:       c.GetCheckType(),
+			Port:            c.GetPort(),
+			HttpPath:        c.GetHttpPath(),
+			IntervalSeconds: c.GetIntervalSeconds(),
+			TimeoutSeconds:  c.GetTimeoutSeconds(),
+		})
+	}
+	return ret, nil
+}
+
+// workerMatchesFilter reports if wrk matches the worker filter f. An empty
+// filter matches every worker.
+func workerMatchesFilter(wrk *server.Worker, f string) (bool, error) {
+	if f == "" {
+		return true, nil
+	}
+	eval, err := bexpr.CreateEvaluator(f)
+	if err != nil {
+		return false, err
+	}
+	matched, err := dcommon.WorkerList{wrk}.Filtered(eval)
+	if err != nil {
+		return false, err
+	}
+	return len(matched) > 0, nil
+}
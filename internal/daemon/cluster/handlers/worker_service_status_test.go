@@ -10,6 +10,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -17,15 +18,20 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/servers"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/health"
+	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/server/store"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/hashicorp/nodeenrollment"
 	"github.com/hashicorp/nodeenrollment/registration"
 	"github.com/hashicorp/nodeenrollment/rotation"
@@ -35,6 +41,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestStatus(t *testing.T) {
@@ -42,6 +50,7 @@ func TestStatus(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
 	kms := kms.TestKms(t, conn, wrapper)
 	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
 
@@ -62,6 +71,18 @@ func TestStatus(t *testing.T) {
 	connRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms)
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 
 	repo, err := sessionRepoFn()
 	require.NoError(t, err)
@@ -98,7 +119,7 @@ func TestStatus(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
 	require.NotNil(t, s)
 
 	connection, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker1.PublicId)
@@ -237,6 +258,7 @@ func TestStatusSessionClosed(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
 	kms := kms.TestKms(t, conn, wrapper)
 	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
 
@@ -257,6 +279,18 @@ func TestStatusSessionClosed(t *testing.T) {
 	connRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms)
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 
 	repo, err := sessionRepoFn()
 	require.NoError(t, err)
@@ -305,7 +339,7 @@ func TestStatusSessionClosed(t *testing.T) {
 	sess2, _, err = repo.ActivateSession(ctx, sess2.PublicId, sess2.Version, tofu2)
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
 	require.NotNil(t, s)
 
 	connection, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker1.PublicId)
@@ -422,6 +456,7 @@ func TestStatusDeadConnection(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
 	kms := kms.TestKms(t, conn, wrapper)
 	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
 
@@ -445,6 +480,18 @@ func TestStatusDeadConnection(t *testing.T) {
 	connRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms, session.WithWorkerStateDelay(0))
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 
 	repo, err := sessionRepoFn()
 	require.NoError(t, err)
@@ -491,7 +538,7 @@ func TestStatusDeadConnection(t *testing.T) {
 	sess2, _, err = repo.ActivateSession(ctx, sess2.PublicId, sess2.Version, tofu2)
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
 	require.NotNil(t, s)
 
 	connection, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker1.PublicId)
@@ -572,6 +619,7 @@ func TestStatusWorkerWithKeyId(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
 	kms := kms.TestKms(t, conn, wrapper)
 	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
 
@@ -592,6 +640,18 @@ func TestStatusWorkerWithKeyId(t *testing.T) {
 	connRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms)
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 
 	repo, err := sessionRepoFn()
 	require.NoError(t, err)
@@ -651,7 +711,7 @@ func TestStatusWorkerWithKeyId(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, err)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
 	require.NotNil(t, s)
 
 	connection, _, err := connRepo.AuthorizeConnection(ctx, sess.PublicId, worker1.PublicId)
@@ -767,6 +827,7 @@ func TestStatusAuthorizedWorkers(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
 	kmsCache := kms.TestKms(t, conn, wrapper)
 
 	err := kmsCache.CreateKeys(context.Background(), scope.Global.String(), kms.WithRandomReader(rand.Reader))
@@ -789,13 +850,25 @@ func TestStatusAuthorizedWorkers(t *testing.T) {
 	connRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kmsCache)
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kmsCache)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kmsCache, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kmsCache, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kmsCache)
+	}
 
 	worker1 := server.TestKmsWorker(t, conn, wrapper)
 	var w1KeyId, w2KeyId string
 	w1 := server.TestPkiWorker(t, conn, wrapper, server.WithTestPkiWorkerAuthorizedKeyId(&w1KeyId))
 	w2 := server.TestPkiWorker(t, conn, wrapper, server.WithTestPkiWorkerAuthorizedKeyId(&w2KeyId))
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kmsCache, new(atomic.Int64))
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kmsCache, new(atomic.Int64))
 	require.NotNil(t, s)
 
 	cases := []struct {
@@ -981,6 +1054,7 @@ func TestWorkerOperationalStatus(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
 	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
 	kms := kms.TestKms(t, conn, wrapper)
 
 	serverRepo, _ := server.NewRepository(rw, rw, kms)
@@ -1000,10 +1074,22 @@ func TestWorkerOperationalStatus(t *testing.T) {
 	connRepoFn := func() (*session.ConnectionRepository, error) {
 		return session.NewConnectionRepository(ctx, rw, rw, kms)
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 
 	worker1 := server.TestKmsWorker(t, conn, wrapper)
 
-	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
 	require.NotNil(t, s)
 
 	cases := []struct {
@@ -1083,3 +1169,155 @@ func TestWorkerOperationalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusHostHealth(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	serverRepo, _ := server.NewRepository(rw, rw, kms)
+	serversRepoFn := func() (*server.Repository, error) {
+		return serverRepo, nil
+	}
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return server.NewRepositoryStorage(ctx, rw, rw, kms)
+	}
+	sessionRepoFn := func(opts ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opts...)
+	}
+	connRepoFn := func() (*session.ConnectionRepository, error) {
+		return session.NewConnectionRepository(ctx, rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*pluginhost.Repository, error) {
+		return pluginhost.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
+
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test",
+		target.WithHostSources([]string{hs.GetPublicId()}),
+		target.WithEgressWorkerFilter(`"east" in "/tags/region"`),
+	)
+
+	healthRepo, err := hostHealthRepoFn()
+	require.NoError(t, err)
+	check, err := health.NewHealthCheck(ctx, hs.GetPublicId(), health.TcpCheck, 22)
+	require.NoError(t, err)
+	_, err = healthRepo.SetHealthCheck(ctx, prj.GetPublicId(), check)
+	require.NoError(t, err)
+
+	eastWorker := server.TestKmsWorker(t, conn, wrapper)
+	westWorker := server.TestKmsWorker(t, conn, wrapper)
+
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connRepoFn, staticHostRepoFn, pluginHostRepoFn, dnsHostRepoFn, hostHealthRepoFn, nil, new(sync.Map), kms, new(atomic.Int64))
+	require.NotNil(t, s)
+
+	statusReq := func(w *server.Worker, region string) *pbs.StatusRequest {
+		return &pbs.StatusRequest{
+			WorkerStatus: &pb.ServerWorkerStatus{
+				PublicId: w.GetPublicId(),
+				Name:     w.GetName(),
+				Address:  w.GetAddress(),
+				Tags:     []*pb.TagPair{{Key: "region", Value: region}},
+			},
+		}
+	}
+
+	got, err := s.Status(ctx, statusReq(westWorker, "west"))
+	require.NoError(t, err)
+	assert.Empty(t, got.GetHostHealthChecks())
+
+	got, err = s.Status(ctx, statusReq(eastWorker, "east"))
+	require.NoError(t, err)
+	require.Len(t, got.GetHostHealthChecks(), 1)
+	assert.Empty(t, cmp.Diff(&pbs.HostHealthCheck{
+		HostSetId:       hs.GetPublicId(),
+		HostId:          h.GetPublicId(),
+		Address:         h.GetAddress(),
+		CheckType:       string(health.TcpCheck),
+		Port:            22,
+		IntervalSeconds: health.DefaultIntervalSeconds,
+		TimeoutSeconds:  health.DefaultTimeoutSeconds,
+	}, got.GetHostHealthChecks()[0], protocmp.Transform()))
+
+	req := statusReq(eastWorker, "east")
+	req.HostHealthResults = []*pbs.HostHealthResult{
+		{
+			HostSetId: hs.GetPublicId(),
+			HostId:    h.GetPublicId(),
+			Healthy:   false,
+			Error:     "connection refused",
+			CheckTime: timestamppb.Now(),
+		},
+	}
+	_, err = s.Status(ctx, req)
+	require.NoError(t, err)
+
+	hh, err := healthRepo.ListHostHealth(ctx, h.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, hh, 1)
+	assert.Equal(t, health.Unhealthy, hh[0].CurrentStatus(time.Now()))
+	assert.Equal(t, "connection refused", hh[0].Error)
+	assert.Equal(t, eastWorker.GetPublicId(), hh[0].WorkerId)
+
+	// Results for checks which are not assigned to the worker are dropped.
+	req = statusReq(westWorker, "west")
+	req.HostHealthResults = []*pbs.HostHealthResult{
+		{
+			HostSetId: hs.GetPublicId(),
+			HostId:    h.GetPublicId(),
+			Healthy:   true,
+			CheckTime: timestamppb.Now(),
+		},
+	}
+	_, err = s.Status(ctx, req)
+	require.NoError(t, err)
+
+	hh, err = healthRepo.ListHostHealth(ctx, h.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, hh, 1)
+	assert.Equal(t, health.Unhealthy, hh[0].CurrentStatus(time.Now()))
+	assert.Equal(t, eastWorker.GetPublicId(), hh[0].WorkerId)
+
+	// The result of another worker running the same check is stored
+	// alongside the first worker's result instead of replacing it.
+	otherEastWorker := server.TestKmsWorker(t, conn, wrapper)
+	req = statusReq(otherEastWorker, "east")
+	req.HostHealthResults = []*pbs.HostHealthResult{
+		{
+			HostSetId: hs.GetPublicId(),
+			HostId:    h.GetPublicId(),
+			Healthy:   true,
+			CheckTime: timestamppb.Now(),
+		},
+	}
+	_, err = s.Status(ctx, req)
+	require.NoError(t, err)
+
+	hh, err = healthRepo.ListHostHealth(ctx, h.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, hh, 2)
+	byWorker := make(map[string]health.Status, len(hh))
+	for _, r := range hh {
+		byWorker[r.WorkerId] = r.CurrentStatus(time.Now())
+	}
+	assert.Equal(t, map[string]health.Status{
+		eastWorker.GetPublicId():      health.Unhealthy,
+		otherEastWorker.GetPublicId(): health.Healthy,
+	}, byWorker)
+}
@@ -247,6 +247,7 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 			reqInfo.UserId = ret.UserId
 		}
 		ea.UserInfo = &event.UserInfo{UserId: ret.UserId}
+		ea.ScopeId = ret.Scope.GetId()
 		ret.Error = nil
 		return
 	}
@@ -282,6 +283,7 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	}
 	ret.AuthTokenId = v.requestInfo.PublicId
 	ret.AuthenticationFinished = authResults.AuthenticationFinished
+	ea.ScopeId = ret.Scope.GetId()
 	if !authResults.Authorized {
 		if v.requestInfo.DisableAuthzFailures {
 			ret.Error = nil
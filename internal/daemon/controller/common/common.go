@@ -6,29 +6,45 @@ package common
 import (
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	credkube "github.com/hashicorp/boundary/internal/credential/kubernetes"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/health"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target/selection"
 )
 
 type (
-	AuthTokenRepoFactory         = oidc.AuthTokenRepoFactory
-	VaultCredentialRepoFactory   = func() (*vault.Repository, error)
-	StaticCredentialRepoFactory  = func() (*credstatic.Repository, error)
-	IamRepoFactory               = iam.IamRepoFactory
-	OidcAuthRepoFactory          = oidc.OidcRepoFactory
-	PasswordAuthRepoFactory      func() (*password.Repository, error)
-	ServersRepoFactory           func() (*server.Repository, error)
-	StaticRepoFactory            func() (*static.Repository, error)
-	PluginHostRepoFactory        func() (*pluginhost.Repository, error)
-	HostPluginRepoFactory        func() (*hostplugin.Repository, error)
-	ConnectionRepoFactory        func() (*session.ConnectionRepository, error)
-	WorkerAuthRepoStorageFactory func() (*server.WorkerAuthRepositoryStorage, error)
+	AuthTokenRepoFactory            = oidc.AuthTokenRepoFactory
+	VaultCredentialRepoFactory      = func() (*vault.Repository, error)
+	StaticCredentialRepoFactory     = func() (*credstatic.Repository, error)
+	PluginCredentialRepoFactory     = func() (*credplugin.Repository, error)
+	KubernetesCredentialRepoFactory = func() (*credkube.Repository, error)
+	AwsCredentialRepoFactory        = func() (*credaws.Repository, error)
+	IamRepoFactory                  = iam.IamRepoFactory
+	OidcAuthRepoFactory             = oidc.OidcRepoFactory
+	PasswordAuthRepoFactory         func() (*password.Repository, error)
+	ServersRepoFactory              func() (*server.Repository, error)
+	StaticRepoFactory               func() (*static.Repository, error)
+	PluginHostRepoFactory           func() (*pluginhost.Repository, error)
+	DnsHostRepoFactory              func() (*dns.Repository, error)
+	HostPluginRepoFactory           func() (*hostplugin.Repository, error)
+	CredentialPluginRepoFactory     func() (*credentialplugin.Repository, error)
+	ConnectionRepoFactory           func() (*session.ConnectionRepository, error)
+	WorkerAuthRepoStorageFactory    func() (*server.WorkerAuthRepositoryStorage, error)
+	HistoryRepoFactory              func() (*history.Repository, error)
+	SelectionRepoFactory            func() (*selection.Repository, error)
+	HostHealthRepoFactory           func() (*health.Repository, error)
 )
 
 // Downstreamers provides at least a minimum interface that must be met by a
@@ -16,7 +16,11 @@ import (
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	credkube "github.com/hashicorp/boundary/internal/credential/kubernetes"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/static/rotator"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
@@ -24,12 +28,16 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	dnshost "github.com/hashicorp/boundary/internal/host/dns"
+	hosthealth "github.com/hashicorp/boundary/internal/host/health"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	kmsjob "github.com/hashicorp/boundary/internal/kms/job"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	"github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/scheduler/cleaner"
@@ -38,6 +46,7 @@ import (
 	serversjob "github.com/hashicorp/boundary/internal/server/job"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/selection"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	host_plugin_assets "github.com/hashicorp/boundary/plugins/host"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
@@ -115,6 +124,9 @@ type Controller struct {
 	AuthTokenRepoFn         common.AuthTokenRepoFactory
 	VaultCredentialRepoFn   common.VaultCredentialRepoFactory
 	StaticCredentialRepoFn  common.StaticCredentialRepoFactory
+	PluginCredentialRepoFn  common.PluginCredentialRepoFactory
+	KubeCredentialRepoFn    common.KubernetesCredentialRepoFactory
+	AwsCredentialRepoFn     common.AwsCredentialRepoFactory
 	IamRepoFn               common.IamRepoFactory
 	OidcRepoFn              common.OidcAuthRepoFactory
 	PasswordAuthRepoFn      common.PasswordAuthRepoFactory
@@ -123,8 +135,13 @@ type Controller struct {
 	ConnectionRepoFn        common.ConnectionRepoFactory
 	StaticHostRepoFn        common.StaticRepoFactory
 	PluginHostRepoFn        common.PluginHostRepoFactory
+	DnsHostRepoFn           common.DnsHostRepoFactory
 	HostPluginRepoFn        common.HostPluginRepoFactory
+	CredentialPluginRepoFn  common.CredentialPluginRepoFactory
 	TargetRepoFn            target.RepositoryFactory
+	HistoryRepoFn           common.HistoryRepoFactory
+	SelectionRepoFn         common.SelectionRepoFactory
+	HostHealthRepoFn        common.HostHealthRepoFactory
 	WorkerAuthRepoStorageFn common.WorkerAuthRepoStorageFactory
 
 	scheduler *scheduler.Scheduler
@@ -142,6 +159,11 @@ type Controller struct {
 
 func New(ctx context.Context, conf *Config) (*Controller, error) {
 	metric.InitializeApiCollectors(conf.PrometheusRegisterer)
+	metric.InitializeSessionCollectors(conf.PrometheusRegisterer)
+	metric.InitializeWorkerCollectors(conf.PrometheusRegisterer)
+	metric.InitializeJobCollectors(conf.PrometheusRegisterer)
+	metric.InitializeCredentialCollectors(conf.PrometheusRegisterer)
+	metric.InitializeKmsCollectors(conf.PrometheusRegisterer)
 	c := &Controller{
 		conf:                    conf,
 		logger:                  conf.Logger.Named("controller"),
@@ -249,8 +271,12 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			if _, err = conf.RegisterHostPlugin(ctx, "loopback", plg, opts...); err != nil {
 				return nil, err
 			}
-		case base.EnabledPluginHostAzure, base.EnabledPluginHostAws:
+		case base.EnabledPluginHostAzure, base.EnabledPluginHostAws, base.EnabledPluginHostKubernetes, base.EnabledPluginHostFile:
 			pluginType := strings.ToLower(enabledPlugin.String())
+			var pluginArgs []string
+			if enabledPlugin == base.EnabledPluginHostFile {
+				pluginArgs = append(pluginArgs, "-allowed-dir", conf.RawConfig.Plugins.FileHostPluginDir)
+			}
 			client, cleanup, err := external_host_plugins.CreateHostPlugin(
 				ctx,
 				pluginType,
@@ -259,6 +285,7 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 					pluginutil.WithPluginsFilesystem(host_plugin_assets.HostPluginPrefix, host_plugin_assets.FileSystem()),
 				),
 				external_host_plugins.WithLogger(pluginLogger.Named(pluginType)),
+				external_host_plugins.WithPluginArgs(pluginArgs...),
 			)
 			if err != nil {
 				return nil, fmt.Errorf("error creating %s host plugin: %w", pluginType, err)
@@ -267,12 +294,23 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			if _, err := conf.RegisterHostPlugin(ctx, pluginType, client, host.WithDescription(fmt.Sprintf("Built-in %s host plugin", enabledPlugin.String()))); err != nil {
 				return nil, fmt.Errorf("error registering %s host plugin: %w", pluginType, err)
 			}
+		case base.EnabledPluginCredentialLoopback:
+			plg := credplugin.NewWrappingPluginClient(credplugin.NewLoopbackPlugin())
+			opts := []credentialplugin.Option{
+				credentialplugin.WithDescription("Provides an initial loopback credential plugin in Boundary"),
+			}
+			if _, err = conf.RegisterCredentialPlugin(ctx, "loopback", plg, opts...); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	if conf.HostPlugins == nil {
 		conf.HostPlugins = make(map[string]plugin.HostPluginServiceClient)
 	}
+	if conf.CredentialPlugins == nil {
+		conf.CredentialPlugins = make(map[string]plugin.CredentialPluginServiceClient)
+	}
 
 	// Set up repo stuff
 	dbase := db.New(c.conf.Database)
@@ -320,7 +358,10 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return job.NewRepository(dbase, dbase, c.kms)
 	}
 	// TODO: Allow setting run jobs limit from config
-	schedulerOpts := []scheduler.Option{scheduler.WithRunJobsLimit(-1)}
+	schedulerOpts := []scheduler.Option{
+		scheduler.WithRunJobsLimit(-1),
+		scheduler.WithRunJobObserver(metric.RecordJobRun),
+	}
 	if sche := c.conf.RawConfig.Controller.Scheduler; sche != nil {
 		if sche.JobRunIntervalDuration > 0 {
 			schedulerOpts = append(schedulerOpts, scheduler.WithRunJobsInterval(sche.JobRunIntervalDuration))
@@ -342,9 +383,15 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.PluginHostRepoFn = func() (*pluginhost.Repository, error) {
 		return pluginhost.NewRepository(dbase, dbase, c.kms, c.scheduler, c.conf.HostPlugins)
 	}
+	c.DnsHostRepoFn = func() (*dnshost.Repository, error) {
+		return dnshost.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler)
+	}
 	c.HostPluginRepoFn = func() (*host.Repository, error) {
 		return host.NewRepository(dbase, dbase, c.kms)
 	}
+	c.CredentialPluginRepoFn = func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(dbase, dbase, c.kms)
+	}
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(dbase, dbase, c.kms,
 			authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
@@ -354,7 +401,17 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler)
 	}
 	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
-		return credstatic.NewRepository(ctx, dbase, dbase, c.kms)
+		return credstatic.NewRepository(ctx, dbase, dbase, c.kms,
+			credstatic.WithRetainedVersions(c.conf.RawConfig.Controller.StaticCredentialRetainedVersions))
+	}
+	c.PluginCredentialRepoFn = func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler, c.conf.CredentialPlugins)
+	}
+	c.KubeCredentialRepoFn = func() (*credkube.Repository, error) {
+		return credkube.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.AwsCredentialRepoFn = func() (*credaws.Repository, error) {
+		return credaws.NewRepository(ctx, dbase, dbase, c.kms)
 	}
 	c.ServersRepoFn = func() (*server.Repository, error) {
 		return server.NewRepository(dbase, dbase, c.kms)
@@ -380,6 +437,15 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.WorkerAuthRepoStorageFn = func() (*server.WorkerAuthRepositoryStorage, error) {
 		return server.NewRepositoryStorage(ctx, dbase, dbase, c.kms)
 	}
+	c.HistoryRepoFn = func() (*history.Repository, error) {
+		return history.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.SelectionRepoFn = func() (*selection.Repository, error) {
+		return selection.NewRepository(ctx, dbase, dbase)
+	}
+	c.HostHealthRepoFn = func() (*hosthealth.Repository, error) {
+		return hosthealth.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 
 	// Check that credentials are available at startup, to avoid some harmless
 	// but nasty-looking errors
@@ -450,6 +516,13 @@ func (c *Controller) Start() error {
 		defer c.tickerWg.Done()
 		c.startCloseExpiredPendingTokens(c.baseContext)
 	}()
+	if c.conf.PrometheusRegisterer != nil {
+		c.tickerWg.Add(1)
+		go func() {
+			defer c.tickerWg.Done()
+			c.startDomainMetricsTicking(c.baseContext)
+		}()
+	}
 	if err := c.startWorkerConnectionMaintenanceTicking(c.baseContext, c.tickerWg, c.pkiConnManager); err != nil {
 		return errors.Wrap(c.baseContext, err, op)
 	}
@@ -504,12 +577,24 @@ func (c *Controller) Start() error {
 
 func (c *Controller) registerJobs() error {
 	rw := db.New(c.conf.Database)
-	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms,
+		vault.WithTokenExpiryWarningThreshold(c.conf.RawConfig.Controller.VaultTokenExpiryWarningThresholdDuration),
+		vault.WithTokenExpiryErrorThreshold(c.conf.RawConfig.Controller.VaultTokenExpiryErrorThresholdDuration)); err != nil {
 		return err
 	}
 	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
 		return err
 	}
+	if err := dnshost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
+	if err := credplugin.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.CredentialPlugins); err != nil {
+		return err
+	}
+	if err := credstatic.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, rotator.Defaults(),
+		credstatic.WithRetainedVersions(c.conf.RawConfig.Controller.StaticCredentialRetainedVersions)); err != nil {
+		return err
+	}
 	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod); err != nil {
 		return err
 	}
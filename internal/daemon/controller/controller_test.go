@@ -253,26 +253,31 @@ func TestController_NewPluginsConfig(t *testing.T) {
 	require.NoError(err)
 	tmpDir := t.TempDir()
 	initialConfig.Plugins.ExecutionDir = tmpDir
+	initialConfig.Plugins.FileHostPluginDir = t.TempDir()
 	conf := TestControllerConfig(t, ctx, tc, &TestControllerOpts{Config: initialConfig})
 	conf.EnabledPlugins = []base.EnabledPlugin{
 		base.EnabledPluginHostAws,
 		base.EnabledPluginHostAzure,
+		base.EnabledPluginHostKubernetes,
+		base.EnabledPluginHostFile,
 	}
 
 	_, err = New(testCtx, conf)
 	require.NoError(err)
 
-	// Check that both plugins were written to the temp dir
+	// Check that all plugins were written to the temp dir
 	files, err := os.ReadDir(tmpDir)
 	require.NoError(err)
-	require.Len(files, 2)
+	require.Len(files, 4)
 	for _, file := range files {
 		name := filepath.Base(file.Name())
 		// Remove random chars and hyphen
 		name = name[0 : len(name)-6]
 		switch name {
 		case host_plugin_assets.HostPluginPrefix + "aws",
-			host_plugin_assets.HostPluginPrefix + "azure":
+			host_plugin_assets.HostPluginPrefix + "azure",
+			host_plugin_assets.HostPluginPrefix + "kubernetes",
+			host_plugin_assets.HostPluginPrefix + "file":
 		default:
 			require.Fail("unexpected name", name)
 		}
@@ -72,6 +72,7 @@ func createMuxWithEndpoints(c *Controller, props HandlerProperties) (http.Handle
 
 	mux := http.NewServeMux()
 	mux.Handle("/v1/", grpcGwMux)
+	mux.Handle("/v1/scopes/", handleTailEvents(c, props, grpcGwMux))
 	mux.Handle(uiPath, handleUi(c))
 
 	isUiRequest := func(req *http.Request) bool {
@@ -133,21 +134,21 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 	currentServices := s.GetServiceInfo()
 
 	if _, ok := currentServices[services.HostCatalogService_ServiceDesc.ServiceName]; !ok {
-		hcs, err := host_catalogs.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn, c.HostPluginRepoFn, c.IamRepoFn)
+		hcs, err := host_catalogs.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn, c.DnsHostRepoFn, c.HostPluginRepoFn, c.IamRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create host catalog handler service: %w", err)
 		}
 		services.RegisterHostCatalogServiceServer(s, hcs)
 	}
 	if _, ok := currentServices[services.HostSetService_ServiceDesc.ServiceName]; !ok {
-		hss, err := host_sets.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn)
+		hss, err := host_sets.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn, c.DnsHostRepoFn, c.HistoryRepoFn, c.HostHealthRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create host set handler service: %w", err)
 		}
 		services.RegisterHostSetServiceServer(s, hss)
 	}
 	if _, ok := currentServices[services.HostService_ServiceDesc.ServiceName]; !ok {
-		hs, err := hosts.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn)
+		hs, err := hosts.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn, c.DnsHostRepoFn, c.HostHealthRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create host handler service: %w", err)
 		}
@@ -197,9 +198,16 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.ServersRepoFn,
 			c.SessionRepoFn,
 			c.PluginHostRepoFn,
+			c.DnsHostRepoFn,
 			c.StaticHostRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.PluginCredentialRepoFn,
+			c.KubeCredentialRepoFn,
+			c.AwsCredentialRepoFn,
+			c.HistoryRepoFn,
+			c.SelectionRepoFn,
+			c.HostHealthRepoFn,
 			c.downstreamWorkers,
 			c.workerStatusGracePeriod)
 		if err != nil {
@@ -215,7 +223,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterGroupServiceServer(s, gs)
 	}
 	if _, ok := currentServices[services.RoleService_ServiceDesc.ServiceName]; !ok {
-		rs, err := roles.NewService(c.IamRepoFn)
+		rs, err := roles.NewService(c.IamRepoFn, c.HistoryRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create role handler service: %w", err)
 		}
@@ -236,14 +244,14 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		services.RegisterManagedGroupServiceServer(s, mgs)
 	}
 	if _, ok := currentServices[services.CredentialStoreService_ServiceDesc.ServiceName]; !ok {
-		cs, err := credentialstores.NewService(c.baseContext, c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.IamRepoFn)
+		cs, err := credentialstores.NewService(c.baseContext, c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.PluginCredentialRepoFn, c.KubeCredentialRepoFn, c.AwsCredentialRepoFn, c.CredentialPluginRepoFn, c.IamRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create credential store handler service: %w", err)
 		}
 		services.RegisterCredentialStoreServiceServer(s, cs)
 	}
 	if _, ok := currentServices[services.CredentialLibraryService_ServiceDesc.ServiceName]; !ok {
-		cl, err := credentiallibraries.NewService(c.VaultCredentialRepoFn, c.IamRepoFn)
+		cl, err := credentiallibraries.NewService(c.VaultCredentialRepoFn, c.PluginCredentialRepoFn, c.KubeCredentialRepoFn, c.AwsCredentialRepoFn, c.IamRepoFn, c.HistoryRepoFn)
 		if err != nil {
 			return fmt.Errorf("failed to create credential library handler service: %w", err)
 		}
@@ -356,8 +364,16 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 		// Set the Cache-Control header for all responses returned
 		w.Header().Set("Cache-Control", "no-store")
 
-		// Start with the request context and our timeout
-		ctx, cancelFunc := context.WithTimeout(r.Context(), maxRequestDuration)
+		// Start with the request context and our timeout. Event streams are
+		// long-lived, so they're only bounded by the client and the server's
+		// shutdown.
+		var ctx context.Context
+		var cancelFunc context.CancelFunc
+		if isTailEventsRequest(r) {
+			ctx, cancelFunc = context.WithCancel(r.Context())
+		} else {
+			ctx, cancelFunc = context.WithTimeout(r.Context(), maxRequestDuration)
+		}
 		defer cancelFunc()
 
 		// Add a size limiter if desired
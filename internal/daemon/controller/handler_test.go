@@ -127,13 +127,16 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/hosts/someid",
 			"v1/roles",
 			"v1/roles/someid",
+			"v1/roles/someid:history",
 			"400_v1/sc\u200Bopes",
 			"200_v1/scopes",
 			"v1/scopes/someid",
+			"400_v1/scopes/someid:tail-events",
 			"v1/sessions",
 			"v1/sessions/someid",
 			"v1/targets",
 			"v1/targets/someid",
+			"v1/targets/someid:history",
 			"v1/users",
 			"v1/users/someid",
 		},
@@ -161,12 +164,14 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/host-sets/someid:add-hosts",
 			"v1/host-sets/someid:remove-hosts",
 			"v1/host-sets/someid:set-hosts",
+			"v1/host-sets/someid:restore",
 			"v1/roles/someid:add-grants",
 			"v1/roles/someid:set-grants",
 			"v1/roles/someid:remove-grants",
 			"v1/roles/someid:add-principals",
 			"v1/roles/someid:set-principals",
 			"v1/roles/someid:remove-principals",
+			"v1/roles/someid:restore",
 			"v1/sessions/someid:cancel",
 			"v1/targets/someid:authorize-session",
 			"v1/targets/someid:add-host-sources",
@@ -175,6 +180,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/targets/someid:add-credential-sources",
 			"v1/targets/someid:set-credential-sources",
 			"v1/targets/someid:remove-credential-sources",
+			"v1/targets/someid:restore",
 			"v1/users/someid:add-accounts",
 			"v1/users/someid:set-accounts",
 			"v1/users/someid:remove-accounts",
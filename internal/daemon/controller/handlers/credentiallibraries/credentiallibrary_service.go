@@ -7,10 +7,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	awsstore "github.com/hashicorp/boundary/internal/credential/aws/store"
+	"github.com/hashicorp/boundary/internal/credential/kubernetes"
+	kubestore "github.com/hashicorp/boundary/internal/credential/kubernetes/store"
+	"github.com/hashicorp/boundary/internal/credential/plugin"
+	pluginstore "github.com/hashicorp/boundary/internal/credential/plugin/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -18,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -39,10 +47,16 @@ const (
 	httpRequestBodyField       = "attributes.http_request_body"
 	credentialMappingPathField = "credential_mapping_overrides"
 	sshCertUsernameField       = "attributes.username"
+	pkiCommonNameField         = "attributes.common_name"
 	keyTypeField               = "attributes.key_type"
 	keyBitsField               = "attributes.key_bits"
 	criticalOptionsField       = "attributes.critical_options"
 	extensionsField            = "attributes.extensions"
+	kubeNamespaceField         = "attributes.namespace"
+	kubeServiceAccountField    = "attributes.service_account"
+	awsRoleArnField            = "attributes.role_arn"
+	awsSessionPolicyField      = "attributes.session_policy"
+	awsDurationSecondsField    = "attributes.duration_seconds"
 	domain                     = "credential"
 )
 
@@ -52,11 +66,17 @@ const (
 	passwordAttribute     string = "password_attribute"
 	privateKeyAttribute   string = "private_key_attribute"
 	pkPassphraseAttribute string = "private_key_passphrase_attribute"
+	certificateAttribute  string = "certificate_attribute"
+	caBundleAttribute     string = "ca_bundle_attribute"
 )
 
 var (
 	maskManager        handlers.MaskManager
 	sshCertMaskManager handlers.MaskManager
+	pkiMaskManager     handlers.MaskManager
+	pluginMaskManager  handlers.MaskManager
+	kubeMaskManager    handlers.MaskManager
+	awsMaskManager     handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -65,6 +85,8 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.History,
+		action.Restore,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -77,6 +99,7 @@ var (
 	validCredentialTypesVaultGeneric = []credential.Type{
 		credential.UsernamePasswordType,
 		credential.SshPrivateKeyType,
+		credential.TlsClientCertificateType,
 		credential.UnspecifiedType,
 	}
 
@@ -85,6 +108,8 @@ var (
 		vault.KeyTypeEd25519,
 		vault.KeyTypeRsa,
 	}
+
+	awsRoleArnRegexp = regexp.MustCompile(`^arn:[a-z-]+:iam::[0-9]{12}:role/.+$`)
 )
 
 func init() {
@@ -97,20 +122,40 @@ func init() {
 		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.VaultSSHCertificateCredentialLibraryAttributes{}}); err != nil {
 		panic(err)
 	}
+	if pkiMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.PkiCredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.VaultPkiCredentialLibraryAttributes{}}); err != nil {
+		panic(err)
+	}
+	if pluginMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&pluginstore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}}); err != nil {
+		panic(err)
+	}
+	if kubeMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&kubestore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.KubernetesCredentialLibraryAttributes{}}); err != nil {
+		panic(err)
+	}
+	if awsMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&awsstore.CredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.AwsCredentialLibraryAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialLibraryServiceServer interface.
 type Service struct {
 	pbs.UnsafeCredentialLibraryServiceServer
 
-	iamRepoFn common.IamRepoFactory
-	repoFn    common.VaultCredentialRepoFactory
+	iamRepoFn     common.IamRepoFactory
+	repoFn        common.VaultCredentialRepoFactory
+	pluginRepoFn  common.PluginCredentialRepoFactory
+	kubeRepoFn    common.KubernetesCredentialRepoFactory
+	awsRepoFn     common.AwsCredentialRepoFactory
+	historyRepoFn common.HistoryRepoFactory
 }
 
 var _ pbs.CredentialLibraryServiceServer = (*Service)(nil)
 
 // NewService returns a credential library service which handles credential library related requests to boundary.
-func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.VaultCredentialRepoFactory, pluginRepo common.PluginCredentialRepoFactory, kubeRepo common.KubernetesCredentialRepoFactory, awsRepo common.AwsCredentialRepoFactory, iamRepo common.IamRepoFactory, historyRepo common.HistoryRepoFactory) (Service, error) {
 	const op = "credentiallibraries.NewService"
 	if iamRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
@@ -118,7 +163,19 @@ func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFa
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing vault credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+	if pluginRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing plugin credential repository")
+	}
+	if kubeRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kubernetes credential repository")
+	}
+	if awsRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing aws credential repository")
+	}
+	if historyRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing history repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo, pluginRepoFn: pluginRepo, kubeRepoFn: kubeRepo, awsRepoFn: awsRepo, historyRepoFn: historyRepo}, nil
 }
 
 // ListCredentialLibraries implements the interface pbs.CredentialLibraryServiceServer
@@ -272,12 +329,60 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 	var currentCredentialType credential.Type
 	var mo vault.MappingOverride
 	switch subtypes.SubtypeFromId(domain, req.GetId()) {
+	case plugin.Subtype:
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := pluginRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
+	case kubernetes.Subtype:
+		kubeRepo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := kubeRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
+	case credaws.Subtype:
+		awsRepo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := awsRepo.LookupCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = cur.CredentialType()
 	case vault.SSHCertificateLibrarySubtype:
 		cur, err := repo.LookupSSHCertificateCredentialLibrary(ctx, req.Id)
 		if err != nil {
 			return nil, err
 		}
 		currentCredentialType = credential.Type(cur.GetCredentialType())
+	case vault.PkiLibrarySubtype:
+		cur, err := repo.LookupPkiCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist.", req.GetId())
+		}
+		currentCredentialType = credential.Type(cur.GetCredentialType())
 	default:
 		cur, err := repo.LookupCredentialLibrary(ctx, req.Id)
 		if err != nil {
@@ -337,8 +442,103 @@ func (s Service) DeleteCredentialLibrary(ctx context.Context, req *pbs.DeleteCre
 	return nil, nil
 }
 
+// GetCredentialLibraryHistory implements the interface pbs.CredentialLibraryServiceServer.
+func (s Service) GetCredentialLibraryHistory(ctx context.Context, req *pbs.GetCredentialLibraryHistoryRequest) (*pbs.GetCredentialLibraryHistoryResponse, error) {
+	if err := validateGetHistoryRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.History)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	records, err := repo.ListResourceHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetCredentialLibraryHistoryResponse{Items: handlers.HistoryToProto(records)}, nil
+}
+
+// RestoreCredentialLibrary implements the interface pbs.CredentialLibraryServiceServer.
+func (s Service) RestoreCredentialLibrary(ctx context.Context, req *pbs.RestoreCredentialLibraryRequest) (*pbs.RestoreCredentialLibraryResponse, error) {
+	if err := validateRestoreRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Restore)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	want, current, err := handlers.RestoreStates(ctx, repo, req.GetId(), req.GetTime().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateRestoreDependencies(ctx, want); err != nil {
+		return nil, err
+	}
+	changes := history.Diff(current, want)
+	if !req.GetDryRun() && len(changes) > 0 {
+		if err := s.restoreInRepo(ctx, authResults.Scope.GetId(), want, current, changes); err != nil {
+			return nil, err
+		}
+	}
+	return &pbs.RestoreCredentialLibraryResponse{Changes: handlers.ChangesToProto(changes), DryRun: req.GetDryRun()}, nil
+}
+
 func (s Service) listFromRepo(ctx context.Context, storeId string) ([]credential.Library, error) {
 	const op = "credentiallibraries.(Service).listFromRepo"
+	if subtypes.SubtypeFromId(domain, storeId) == plugin.Subtype {
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		pluginCsl, err := repo.ListCredentialLibraries(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		csl := make([]credential.Library, 0, len(pluginCsl))
+		for _, s := range pluginCsl {
+			csl = append(csl, s)
+		}
+		return csl, nil
+	}
+	if subtypes.SubtypeFromId(domain, storeId) == kubernetes.Subtype {
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		kubeCsl, err := repo.ListCredentialLibraries(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		csl := make([]credential.Library, 0, len(kubeCsl))
+		for _, s := range kubeCsl {
+			csl = append(csl, s)
+		}
+		return csl, nil
+	}
+	if subtypes.SubtypeFromId(domain, storeId) == credaws.Subtype {
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		awsCsl, err := repo.ListCredentialLibraries(ctx, storeId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		csl := make([]credential.Library, 0, len(awsCsl))
+		for _, s := range awsCsl {
+			csl = append(csl, s)
+		}
+		return csl, nil
+	}
+
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -351,14 +551,21 @@ func (s Service) listFromRepo(ctx context.Context, storeId string) ([]credential
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	pkiCsl, err := repo.ListPkiCredentialLibraries(ctx, storeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
-	csl := make([]credential.Library, 0, len(genCsl)+len(certCsl))
+	csl := make([]credential.Library, 0, len(genCsl)+len(certCsl)+len(pkiCsl))
 	for _, s := range genCsl {
 		csl = append(csl, s)
 	}
 	for _, s := range certCsl {
 		csl = append(csl, s)
 	}
+	for _, s := range pkiCsl {
+		csl = append(csl, s)
+	}
 	return csl, nil
 }
 
@@ -387,6 +594,54 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential library %q not found", id))
 		}
 		return cs, err
+	case vault.PkiLibrarySubtype:
+		cs, err := repo.LookupPkiCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("pki credential library %q not found", id))
+		}
+		return cs, err
+	case plugin.Subtype:
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := pluginRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("plugin credential library %q not found", id))
+		}
+		return cs, err
+	case kubernetes.Subtype:
+		kubeRepo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := kubeRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("kubernetes credential library %q not found", id))
+		}
+		return cs, err
+	case credaws.Subtype:
+		awsRepo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := awsRepo.LookupCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("aws credential library %q not found", id))
+		}
+		return cs, err
 	}
 	return nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype")
 }
@@ -395,6 +650,57 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 	const op = "credentiallibraries.(Service).createInRepo"
 	var out credential.Library
 	switch subtypes.SubtypeFromType(domain, item.GetType()) {
+	case plugin.Subtype:
+		cl, err := toStoragePluginLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential library but no error returned from repository.")
+		}
+		out = rl
+	case kubernetes.Subtype:
+		cl, err := toStorageKubernetesLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential library but no error returned from repository.")
+		}
+		out = rl
+	case credaws.Subtype:
+		cl, err := toStorageAwsLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential library but no error returned from repository.")
+		}
+		out = rl
 	case vault.SSHCertificateLibrarySubtype:
 		cl, err := toStorageVaultSSHCertificateLibrary(item.GetCredentialStoreId(), item)
 		if err != nil {
@@ -412,6 +718,23 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh certificate credential library but no error returned from repository.")
 		}
 		out = rl
+	case vault.PkiLibrarySubtype:
+		cl, err := toStorageVaultPkiLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreatePkiCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create pki credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create pki credential library but no error returned from repository.")
+		}
+		out = rl
 	default:
 		cl, err := toStorageVaultLibrary(item.GetCredentialStoreId(), item)
 		if err != nil {
@@ -447,6 +770,76 @@ func (s Service) updateInRepo(
 	item := proto.Clone(in).(*pb.CredentialLibrary)
 	item.CredentialType = string(currentCredentialType)
 
+	if subtypes.SubtypeFromId(domain, id) == plugin.Subtype {
+		dbMasks = pluginMaskManager.Translate(masks, "attributes")
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStoragePluginLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+	}
+	if subtypes.SubtypeFromId(domain, id) == kubernetes.Subtype {
+		dbMasks = kubeMaskManager.Translate(masks)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageKubernetesLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+	}
+	if subtypes.SubtypeFromId(domain, id) == credaws.Subtype {
+		dbMasks = awsMaskManager.Translate(masks)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageAwsLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+	}
+
 	mapping, update := getMappingUpdates(currentCredentialType, currentMapping, item.GetCredentialMappingOverrides().AsMap(), masks)
 	if update {
 		// got mapping update, append mapping override db field mask
@@ -488,6 +881,23 @@ func (s Service) updateInRepo(
 		if rowsUpdated == 0 {
 			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
 		}
+	case vault.PkiLibrarySubtype:
+		dbMasks = append(dbMasks, pkiMaskManager.Translate(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageVaultPkiLibrary(item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		out, rowsUpdated, err = repo.UpdatePkiCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
 	default:
 		dbMasks = append(dbMasks, maskManager.Translate(masks)...)
 		if len(dbMasks) == 0 {
@@ -517,8 +927,28 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	}
 	rows := 0
 	switch subtypes.SubtypeFromId(domain, id) {
+	case plugin.Subtype:
+		pluginRepo, err := s.pluginRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = pluginRepo.DeleteCredentialLibrary(ctx, scopeId, id)
+	case kubernetes.Subtype:
+		kubeRepo, err := s.kubeRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = kubeRepo.DeleteCredentialLibrary(ctx, scopeId, id)
+	case credaws.Subtype:
+		awsRepo, err := s.awsRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = awsRepo.DeleteCredentialLibrary(ctx, scopeId, id)
 	case vault.SSHCertificateLibrarySubtype:
 		rows, err = repo.DeleteSSHCertificateCredentialLibrary(ctx, scopeId, id)
+	case vault.PkiLibrarySubtype:
+		rows, err = repo.DeletePkiCredentialLibrary(ctx, scopeId, id)
 	default:
 		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	}
@@ -539,6 +969,21 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	pluginRepo, err := s.pluginRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	kubeRepo, err := s.kubeRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	awsRepo, err := s.awsRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialLibrary), auth.WithAction(a)}
@@ -555,17 +1000,85 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				res.Error = err
 				return res
 			}
-			if cl == nil {
+			switch {
+			case cl != nil:
+				parentId = cl.GetStoreId()
+			case a == action.Restore:
+				parentId, err = s.deletedLibraryStoreId(ctx, id)
+				if err != nil {
+					res.Error = err
+					return res
+				}
+			default:
 				res.Error = handlers.NotFoundError()
 				return res
 			}
-			parentId = cl.GetStoreId()
 		case vault.SSHCertificateLibrarySubtype:
 			cl, err := repo.LookupSSHCertificateCredentialLibrary(ctx, id)
 			if err != nil {
 				res.Error = err
 				return res
 			}
+			switch {
+			case cl != nil:
+				parentId = cl.GetStoreId()
+			case a == action.Restore:
+				parentId, err = s.deletedLibraryStoreId(ctx, id)
+				if err != nil {
+					res.Error = err
+					return res
+				}
+			default:
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+		case vault.PkiLibrarySubtype:
+			cl, err := repo.LookupPkiCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			switch {
+			case cl != nil:
+				parentId = cl.GetStoreId()
+			case a == action.Restore:
+				parentId, err = s.deletedLibraryStoreId(ctx, id)
+				if err != nil {
+					res.Error = err
+					return res
+				}
+			default:
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+		case plugin.Subtype:
+			cl, err := pluginRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
+		case kubernetes.Subtype:
+			cl, err := kubeRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
+		case credaws.Subtype:
+			cl, err := awsRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
 			if cl == nil {
 				res.Error = handlers.NotFoundError()
 				return res
@@ -575,18 +1088,54 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
 			return res
 		}
-	}
-
-	if parentId == "" {
-		res.Error = errors.New(ctx, errors.RecordNotFound, op, "unable to find credential store for provided library")
-		return res
-	}
-
-	opts = append(opts, auth.WithPin(parentId))
-
-	switch subtypes.SubtypeFromId(domain, parentId) {
-	case vault.Subtype:
-		cs, err := repo.LookupCredentialStore(ctx, parentId)
+	}
+
+	if parentId == "" {
+		res.Error = errors.New(ctx, errors.RecordNotFound, op, "unable to find credential store for provided library")
+		return res
+	}
+
+	opts = append(opts, auth.WithPin(parentId))
+
+	switch subtypes.SubtypeFromId(domain, parentId) {
+	case vault.Subtype:
+		cs, err := repo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			if a == action.Restore {
+				res.Error = handlers.RestoreDependencyError("credential store", parentId)
+			}
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case plugin.Subtype:
+		cs, _, err := pluginRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case kubernetes.Subtype:
+		cs, err := kubeRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case credaws.Subtype:
+		cs, err := awsRepo.LookupCredentialStore(ctx, parentId)
 		if err != nil {
 			res.Error = err
 			return res
@@ -674,6 +1223,20 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 					if mapping.PrivateKeyPassphraseAttribute != "" {
 						m[pkPassphraseAttribute] = mapping.PrivateKeyPassphraseAttribute
 					}
+
+				case *vault.TlsClientCertificateOverride:
+					if mapping.CertificateAttribute != "" {
+						m[certificateAttribute] = mapping.CertificateAttribute
+					}
+					if mapping.PrivateKeyAttribute != "" {
+						m[privateKeyAttribute] = mapping.PrivateKeyAttribute
+					}
+					if mapping.PrivateKeyPassphraseAttribute != "" {
+						m[pkPassphraseAttribute] = mapping.PrivateKeyPassphraseAttribute
+					}
+					if mapping.CaBundleAttribute != "" {
+						m[caBundleAttribute] = mapping.CaBundleAttribute
+					}
 				}
 				if len(m) > 0 {
 					mp, err := structpb.NewStruct(m)
@@ -738,11 +1301,185 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 				VaultSshCertificateCredentialLibraryAttributes: attrs,
 			}
 		}
-
+	case vault.PkiLibrarySubtype:
+		vaultIn, ok := in.(*vault.PkiCredentialLibrary)
+		if !ok {
+			return nil, errors.NewDeprecated(errors.Internal, op, "unable to cast to vault pki credential library")
+		}
+		// We don't check for mapping overrides here -- this subtype does not currently support them.
+		out.CredentialType = vaultIn.GetCredentialType()
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.VaultPkiCredentialLibraryAttributes{
+				Path:       wrapperspb.String(vaultIn.GetVaultPath()),
+				CommonName: wrapperspb.String(vaultIn.GetCommonName()),
+			}
+			if vaultIn.GetAltNames() != "" {
+				attrs.AltNames = wrapperspb.String(vaultIn.GetAltNames())
+			}
+			if vaultIn.GetIpSans() != "" {
+				attrs.IpSans = wrapperspb.String(vaultIn.GetIpSans())
+			}
+			if vaultIn.GetKeyType() != "" {
+				attrs.KeyType = wrapperspb.String(vaultIn.GetKeyType())
+			}
+			if vaultIn.GetKeyBits() != 0 {
+				attrs.KeyBits = &wrapperspb.UInt32Value{Value: vaultIn.GetKeyBits()}
+			}
+			if vaultIn.GetTtl() != "" {
+				attrs.Ttl = wrapperspb.String(vaultIn.GetTtl())
+			}
+			out.Attrs = &pb.CredentialLibrary_VaultPkiCredentialLibraryAttributes{
+				VaultPkiCredentialLibraryAttributes: attrs,
+			}
+		}
+	case plugin.Subtype:
+		pluginIn, ok := in.(*plugin.CredentialLibrary)
+		if !ok {
+			return nil, errors.NewDeprecated(errors.Internal, op, "unable to cast to plugin credential library")
+		}
+		if outputFields.Has(globals.CredentialTypeField) && pluginIn.CredentialType() != credential.UnspecifiedType {
+			out.CredentialType = string(pluginIn.CredentialType())
+		}
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &structpb.Struct{}
+			if err := proto.Unmarshal(pluginIn.GetAttributes(), attrs); err != nil {
+				return nil, errors.WrapDeprecated(err, op)
+			}
+			if len(attrs.GetFields()) > 0 {
+				out.Attrs = &pb.CredentialLibrary_Attributes{
+					Attributes: attrs,
+				}
+			}
+		}
+	case kubernetes.Subtype:
+		kubeIn, ok := in.(*kubernetes.CredentialLibrary)
+		if !ok {
+			return nil, errors.NewDeprecated(errors.Internal, op, "unable to cast to kubernetes credential library")
+		}
+		if outputFields.Has(globals.CredentialTypeField) {
+			out.CredentialType = string(kubeIn.CredentialType())
+		}
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.KubernetesCredentialLibraryAttributes{
+				ServiceAccount: wrapperspb.String(kubeIn.GetServiceAccount()),
+				Namespace:      wrapperspb.String(kubeIn.GetNamespace()),
+			}
+			if kubeIn.GetAudience() != "" {
+				attrs.Audience = wrapperspb.String(kubeIn.GetAudience())
+			}
+			if kubeIn.GetTtlSeconds() != 0 {
+				attrs.TtlSeconds = wrapperspb.UInt32(kubeIn.GetTtlSeconds())
+			}
+			out.Attrs = &pb.CredentialLibrary_KubernetesCredentialLibraryAttributes{
+				KubernetesCredentialLibraryAttributes: attrs,
+			}
+		}
+	case credaws.Subtype:
+		awsIn, ok := in.(*credaws.CredentialLibrary)
+		if !ok {
+			return nil, errors.NewDeprecated(errors.Internal, op, "unable to cast to aws credential library")
+		}
+		if outputFields.Has(globals.CredentialTypeField) {
+			out.CredentialType = string(awsIn.CredentialType())
+		}
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.AwsCredentialLibraryAttributes{
+				RoleArn: wrapperspb.String(awsIn.GetRoleArn()),
+			}
+			if awsIn.GetSessionName() != "" {
+				attrs.SessionName = wrapperspb.String(awsIn.GetSessionName())
+			}
+			if awsIn.GetSessionPolicy() != "" {
+				attrs.SessionPolicy = wrapperspb.String(awsIn.GetSessionPolicy())
+			}
+			if awsIn.GetDurationSeconds() != 0 {
+				attrs.DurationSeconds = wrapperspb.UInt32(awsIn.GetDurationSeconds())
+			}
+			if awsIn.GetFederatedSignin() {
+				attrs.FederatedSignin = wrapperspb.Bool(true)
+			}
+			out.Attrs = &pb.CredentialLibrary_AwsCredentialLibraryAttributes{
+				AwsCredentialLibraryAttributes: attrs,
+			}
+		}
 	}
 	return &out, nil
 }
 
+func toStoragePluginLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (*plugin.CredentialLibrary, error) {
+	const op = "credentiallibraries.toStoragePluginLibrary"
+	var opts []plugin.Option
+	if in.GetName() != nil {
+		opts = append(opts, plugin.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, plugin.WithDescription(in.GetDescription().GetValue()))
+	}
+	if attrs := in.GetAttributes(); attrs != nil {
+		opts = append(opts, plugin.WithAttributes(attrs))
+	}
+	if ct := in.GetCredentialType(); ct != "" {
+		opts = append(opts, plugin.WithCredentialType(credential.Type(ct)))
+	}
+	cl, err := plugin.NewCredentialLibrary(ctx, storeId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, nil
+}
+
+func toStorageKubernetesLibrary(storeId string, in *pb.CredentialLibrary) (*kubernetes.CredentialLibrary, error) {
+	const op = "credentiallibraries.toStorageKubernetesLibrary"
+	var opts []kubernetes.Option
+	if in.GetName() != nil {
+		opts = append(opts, kubernetes.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, kubernetes.WithDescription(in.GetDescription().GetValue()))
+	}
+	attrs := in.GetKubernetesCredentialLibraryAttributes()
+	if attrs.GetAudience() != nil {
+		opts = append(opts, kubernetes.WithAudience(attrs.GetAudience().GetValue()))
+	}
+	if attrs.GetTtlSeconds() != nil {
+		opts = append(opts, kubernetes.WithTtlSeconds(attrs.GetTtlSeconds().GetValue()))
+	}
+	cl, err := kubernetes.NewCredentialLibrary(storeId, attrs.GetNamespace().GetValue(), attrs.GetServiceAccount().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, nil
+}
+
+func toStorageAwsLibrary(storeId string, in *pb.CredentialLibrary) (*credaws.CredentialLibrary, error) {
+	const op = "credentiallibraries.toStorageAwsLibrary"
+	var opts []credaws.Option
+	if in.GetName() != nil {
+		opts = append(opts, credaws.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, credaws.WithDescription(in.GetDescription().GetValue()))
+	}
+	attrs := in.GetAwsCredentialLibraryAttributes()
+	if attrs.GetSessionName() != nil {
+		opts = append(opts, credaws.WithSessionName(attrs.GetSessionName().GetValue()))
+	}
+	if attrs.GetSessionPolicy() != nil {
+		opts = append(opts, credaws.WithSessionPolicy(attrs.GetSessionPolicy().GetValue()))
+	}
+	if attrs.GetDurationSeconds() != nil {
+		opts = append(opts, credaws.WithDurationSeconds(attrs.GetDurationSeconds().GetValue()))
+	}
+	if attrs.GetFederatedSignin() != nil {
+		opts = append(opts, credaws.WithFederatedSignin(attrs.GetFederatedSignin().GetValue()))
+	}
+	cl, err := credaws.NewCredentialLibrary(storeId, attrs.GetRoleArn().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, nil
+}
+
 func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault.CredentialLibrary, err error) {
 	const op = "credentiallibraries.toStorageVaultLibrary"
 	var opts []vault.Option
@@ -797,6 +1534,26 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 		if len(mapOpts) > 0 {
 			opts = append(opts, vault.WithMappingOverride(vault.NewSshPrivateKeyOverride(mapOpts...)))
 		}
+
+	case credential.TlsClientCertificateType:
+		opts = append(opts, vault.WithCredentialType(credentialType))
+		overrides := in.CredentialMappingOverrides.AsMap()
+		var mapOpts []vault.Option
+		if cert := overrides[certificateAttribute]; cert != nil {
+			mapOpts = append(mapOpts, vault.WithOverrideCertificateAttribute(cert.(string)))
+		}
+		if pk := overrides[privateKeyAttribute]; pk != nil {
+			mapOpts = append(mapOpts, vault.WithOverridePrivateKeyAttribute(pk.(string)))
+		}
+		if pass := overrides[pkPassphraseAttribute]; pass != nil {
+			mapOpts = append(mapOpts, vault.WithOverridePrivateKeyPassphraseAttribute(pass.(string)))
+		}
+		if ca := overrides[caBundleAttribute]; ca != nil {
+			mapOpts = append(mapOpts, vault.WithOverrideCaBundleAttribute(ca.(string)))
+		}
+		if len(mapOpts) > 0 {
+			opts = append(opts, vault.WithMappingOverride(vault.NewTlsClientCertificateOverride(mapOpts...)))
+		}
 	}
 
 	cs, err := vault.NewCredentialLibrary(storeId, attrs.GetPath().GetValue(), opts...)
@@ -852,6 +1609,41 @@ func toStorageVaultSSHCertificateLibrary(storeId string, in *pb.CredentialLibrar
 	return cs, err
 }
 
+func toStorageVaultPkiLibrary(storeId string, in *pb.CredentialLibrary) (out *vault.PkiCredentialLibrary, err error) {
+	const op = "credentiallibraries.toStorageVaultPkiLibrary"
+	var opts []vault.Option
+	if in.GetName() != nil {
+		opts = append(opts, vault.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, vault.WithDescription(in.GetDescription().GetValue()))
+	}
+	opts = append(opts, vault.WithCredentialType(credential.Type(in.GetCredentialType())))
+
+	attrs := in.GetVaultPkiCredentialLibraryAttributes()
+	if attrs.GetAltNames() != nil {
+		opts = append(opts, vault.WithAltNames(attrs.GetAltNames().GetValue()))
+	}
+	if attrs.GetIpSans() != nil {
+		opts = append(opts, vault.WithIpSans(attrs.GetIpSans().GetValue()))
+	}
+	if attrs.GetKeyType() != nil {
+		opts = append(opts, vault.WithKeyType(attrs.GetKeyType().GetValue()))
+	}
+	if attrs.GetKeyBits() != nil {
+		opts = append(opts, vault.WithKeyBits(attrs.GetKeyBits().GetValue()))
+	}
+	if attrs.GetTtl() != nil {
+		opts = append(opts, vault.WithTtl(attrs.GetTtl().GetValue()))
+	}
+
+	cs, err := vault.NewPkiCredentialLibrary(storeId, attrs.GetPath().GetValue(), attrs.GetCommonName().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cs, err
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -862,6 +1654,14 @@ func validateGetRequest(req *pbs.GetCredentialLibraryRequest) error {
 	switch subtypes.SubtypeFromId(domain, req.GetId()) {
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case vault.PkiLibrarySubtype:
+		prefix = globals.VaultPkiCredentialLibraryPrefix
+	case plugin.Subtype:
+		prefix = globals.PluginCredentialLibraryPrefix
+	case kubernetes.Subtype:
+		prefix = globals.KubernetesCredentialLibraryPrefix
+	case credaws.Subtype:
+		prefix = globals.AwsCredentialLibraryPrefix
 	default:
 		prefix = globals.VaultCredentialLibraryPrefix
 	}
@@ -908,8 +1708,9 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 			}
 
 			if subtypes.SubtypeFromType(domain, t) != vault.GenericLibrarySubtype &&
-				subtypes.SubtypeFromType(domain, t) != vault.SSHCertificateLibrarySubtype {
-				badFields[globals.CredentialStoreIdField] = fmt.Sprintf("Type must be a vault subtype %q or %q", vault.GenericLibrarySubtype.String(), vault.SSHCertificateLibrarySubtype.String())
+				subtypes.SubtypeFromType(domain, t) != vault.SSHCertificateLibrarySubtype &&
+				subtypes.SubtypeFromType(domain, t) != vault.PkiLibrarySubtype {
+				badFields[globals.CredentialStoreIdField] = fmt.Sprintf("Type must be a vault subtype %q, %q or %q", vault.GenericLibrarySubtype.String(), vault.SSHCertificateLibrarySubtype.String(), vault.PkiLibrarySubtype.String())
 			}
 
 			switch subtypes.SubtypeFromType(domain, req.GetItem().GetType()) {
@@ -971,7 +1772,99 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 					badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
+			case vault.PkiLibrarySubtype:
+				if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(credential.TlsClientCertificateType) {
+					badFields[globals.CredentialTypeField] = fmt.Sprintf("If set, value must be %q.", credential.TlsClientCertificateType)
+				}
+				if req.GetItem().GetCredentialMappingOverrides() != nil {
+					badFields[credentialMappingPathField] = "This field is not supported for pki credential libraries."
+				}
+
+				attrs := req.GetItem().GetVaultPkiCredentialLibraryAttributes()
+				if attrs == nil {
+					badFields[attributesPathField] = "This is a required field."
+				}
+				if attrs.GetPath().GetValue() == "" {
+					badFields[vaultPathField] = "This is a required field."
+				}
+				if attrs.GetCommonName().GetValue() == "" {
+					badFields[pkiCommonNameField] = "This is a required field."
+				}
+				if t := attrs.GetKeyType(); t != nil && !strutil.StrListContains(validKeyTypes, strings.ToLower(t.GetValue())) {
+					badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+				}
+				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
+			}
+		case plugin.Subtype:
+			switch t := req.GetItem().GetType(); {
+			case t == "":
+				req.GetItem().Type = plugin.Subtype.String()
+			case subtypes.SubtypeFromType(domain, t) != plugin.Subtype:
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for libraries in a plugin credential store.", plugin.Subtype.String())
+			}
+			switch req.GetItem().Attrs.(type) {
+			case nil, *pb.CredentialLibrary_Attributes:
+			default:
+				badFields[globals.AttributesField] = "Plugin credential libraries only support generic attributes."
+			}
+			isValidCred := false
+			ct := req.GetItem().GetCredentialType()
+			for _, t := range validCredentialTypesVaultGeneric {
+				if ct == "" || ct == string(t) {
+					isValidCred = true
+					break
+				}
+			}
+			if !isValidCred {
+				badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", ct)
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[credentialMappingPathField] = "This field is not supported for plugin credential libraries."
+			}
+		case kubernetes.Subtype:
+			switch t := req.GetItem().GetType(); {
+			case t == "":
+				req.GetItem().Type = kubernetes.Subtype.String()
+			case subtypes.SubtypeFromType(domain, t) != kubernetes.Subtype:
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for libraries in a kubernetes credential store.", kubernetes.Subtype.String())
+			}
+			if ct := req.GetItem().GetCredentialType(); ct != "" && ct != string(credential.JsonType) {
+				badFields[globals.CredentialTypeField] = fmt.Sprintf("If set, value must be %q.", credential.JsonType)
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[credentialMappingPathField] = "This field is not supported for kubernetes credential libraries."
+			}
+			attrs := req.GetItem().GetKubernetesCredentialLibraryAttributes()
+			if attrs == nil {
+				badFields[globals.AttributesField] = "This is a required field."
 			}
+			if attrs.GetNamespace().GetValue() == "" {
+				badFields[kubeNamespaceField] = "This is a required field."
+			}
+			if attrs.GetServiceAccount().GetValue() == "" {
+				badFields[kubeServiceAccountField] = "This is a required field."
+			}
+		case credaws.Subtype:
+			switch t := req.GetItem().GetType(); {
+			case t == "":
+				req.GetItem().Type = credaws.Subtype.String()
+			case subtypes.SubtypeFromType(domain, t) != credaws.Subtype:
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for libraries in an aws credential store.", credaws.Subtype.String())
+			}
+			if ct := req.GetItem().GetCredentialType(); ct != "" && ct != string(credential.AwsCredentialType) {
+				badFields[globals.CredentialTypeField] = fmt.Sprintf("If set, value must be %q.", credential.AwsCredentialType)
+			}
+			if req.GetItem().GetCredentialMappingOverrides() != nil {
+				badFields[credentialMappingPathField] = "This field is not supported for aws credential libraries."
+			}
+			attrs := req.GetItem().GetAwsCredentialLibraryAttributes()
+			if attrs == nil {
+				badFields[globals.AttributesField] = "This is a required field."
+			}
+			if attrs.GetRoleArn().GetValue() == "" {
+				badFields[awsRoleArnField] = "This is a required field."
+			}
+			validateAwsLibraryAttributes(badFields, attrs)
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -987,6 +1880,14 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 		prefix = globals.VaultCredentialLibraryPrefix
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case vault.PkiLibrarySubtype:
+		prefix = globals.VaultPkiCredentialLibraryPrefix
+	case plugin.Subtype:
+		prefix = globals.PluginCredentialLibraryPrefix
+	case kubernetes.Subtype:
+		prefix = globals.KubernetesCredentialLibraryPrefix
+	case credaws.Subtype:
+		prefix = globals.AwsCredentialLibraryPrefix
 	}
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1035,18 +1936,84 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case vault.PkiLibrarySubtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != vault.PkiLibrarySubtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			attrs := req.GetItem().GetVaultPkiCredentialLibraryAttributes()
+			if attrs != nil {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), vaultPathField) && attrs.GetPath().GetValue() == "" {
+					badFields[vaultPathField] = "This is a required field and cannot be set to empty."
+				}
+				if cn := attrs.GetCommonName().GetValue(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), pkiCommonNameField) && cn == "" {
+					badFields[pkiCommonNameField] = "This is a required field and cannot be set to empty."
+				}
+				if t := attrs.GetKeyType(); t != nil && !strutil.StrListContains(validKeyTypes, strings.ToLower(t.GetValue())) {
+					badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+				}
+				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
+			}
+		case plugin.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != plugin.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			switch req.GetItem().Attrs.(type) {
+			case nil, *pb.CredentialLibrary_Attributes:
+			default:
+				badFields[globals.AttributesField] = "Plugin credential libraries only support generic attributes."
+			}
+		case kubernetes.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != kubernetes.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			attrs := req.GetItem().GetKubernetesCredentialLibraryAttributes()
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), kubeNamespaceField) && attrs.GetNamespace().GetValue() == "" {
+				badFields[kubeNamespaceField] = "This is a required field and cannot be set to empty."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), kubeServiceAccountField) && attrs.GetServiceAccount().GetValue() == "" {
+				badFields[kubeServiceAccountField] = "This is a required field and cannot be set to empty."
+			}
+		case credaws.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != credaws.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			attrs := req.GetItem().GetAwsCredentialLibraryAttributes()
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), awsRoleArnField) && attrs.GetRoleArn().GetValue() == "" {
+				badFields[awsRoleArnField] = "This is a required field and cannot be set to empty."
+			}
+			validateAwsLibraryAttributes(badFields, attrs)
 		}
 		return badFields
 	}, prefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialLibraryRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.VaultPkiCredentialLibraryPrefix, globals.PluginCredentialLibraryPrefix, globals.KubernetesCredentialLibraryPrefix, globals.AwsCredentialLibraryPrefix)
+}
+
+func validateGetHistoryRequest(req *pbs.GetCredentialLibraryHistoryRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.VaultPkiCredentialLibraryPrefix, globals.PluginCredentialLibraryPrefix, globals.KubernetesCredentialLibraryPrefix, globals.AwsCredentialLibraryPrefix)
+}
+
+func validateRestoreRequest(req *pbs.RestoreCredentialLibraryRequest) error {
+	return handlers.ValidateRestoreRequest(req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.VaultPkiCredentialLibraryPrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix, globals.PluginCredentialStorePrefix, globals.KubernetesCredentialStorePrefix, globals.AwsCredentialStorePrefix) {
 		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -1058,6 +2025,22 @@ func validateListRequest(req *pbs.ListCredentialLibrariesRequest) error {
 	return nil
 }
 
+// validateAwsLibraryAttributes adds an entry to badFields for each set aws
+// credential library attribute which has an invalid value.
+func validateAwsLibraryAttributes(badFields map[string]string, attrs *pb.AwsCredentialLibraryAttributes) {
+	if arn := attrs.GetRoleArn().GetValue(); arn != "" && !awsRoleArnRegexp.MatchString(arn) {
+		badFields[awsRoleArnField] = "Must be a valid IAM role ARN."
+	}
+	if p := attrs.GetSessionPolicy().GetValue(); p != "" && !json.Valid([]byte(p)) {
+		badFields[awsSessionPolicyField] = "Must be a valid JSON policy document."
+	}
+	if attrs.GetDurationSeconds() != nil {
+		if d := attrs.GetDurationSeconds().GetValue(); d < 900 || d > 43200 {
+			badFields[awsDurationSecondsField] = "Must be between 900 and 43200 seconds."
+		}
+	}
+}
+
 func validateMapping(badFields map[string]string, credentialType credential.Type, overrides map[string]any) {
 	validFields := make(map[string]bool)
 	switch credentialType {
@@ -1073,6 +2056,11 @@ func validateMapping(badFields map[string]string, credentialType credential.Type
 		validFields[usernameAttribute] = true
 		validFields[privateKeyAttribute] = true
 		validFields[pkPassphraseAttribute] = true
+	case credential.TlsClientCertificateType:
+		validFields[certificateAttribute] = true
+		validFields[privateKeyAttribute] = true
+		validFields[pkPassphraseAttribute] = true
+		validFields[caBundleAttribute] = true
 	default:
 		badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", credentialType)
 		return
@@ -1191,6 +2179,43 @@ func getMappingUpdates(credentialType credential.Type, current vault.MappingOver
 		default:
 			ret[pkPassphraseAttribute] = currentpPass
 		}
+
+	case credential.TlsClientCertificateType:
+		var currentCert, currentPk, currentpPass, currentCa any
+		if overrides, ok := current.(*vault.TlsClientCertificateOverride); ok {
+			currentCert = overrides.CertificateAttribute
+			currentPk = overrides.PrivateKeyAttribute
+			currentpPass = overrides.PrivateKeyPassphraseAttribute
+			currentCa = overrides.CaBundleAttribute
+		}
+
+		switch {
+		case masks[certificateAttribute]:
+			ret[certificateAttribute] = new[certificateAttribute]
+		default:
+			ret[certificateAttribute] = currentCert
+		}
+
+		switch {
+		case masks[privateKeyAttribute]:
+			ret[privateKeyAttribute] = new[privateKeyAttribute]
+		default:
+			ret[privateKeyAttribute] = currentPk
+		}
+
+		switch {
+		case masks[pkPassphraseAttribute]:
+			ret[pkPassphraseAttribute] = new[pkPassphraseAttribute]
+		default:
+			ret[pkPassphraseAttribute] = currentpPass
+		}
+
+		switch {
+		case masks[caBundleAttribute]:
+			ret[caBundleAttribute] = new[caBundleAttribute]
+		default:
+			ret[caBundleAttribute] = currentCa
+		}
 	}
 
 	return ret, true
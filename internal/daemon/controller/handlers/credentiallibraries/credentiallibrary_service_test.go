@@ -14,18 +14,26 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	credkube "github.com/hashicorp/boundary/internal/credential/kubernetes"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -37,7 +45,47 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "history", "restore"}
+
+func testPluginRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper, plgm map[string]plgpb.CredentialPluginServiceClient) common.PluginCredentialRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	sche := scheduler.TestScheduler(t, conn, wrap)
+	if plgm == nil {
+		plgm = map[string]plgpb.CredentialPluginServiceClient{}
+	}
+	return func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kmsCache, sche, plgm)
+	}
+}
+
+func testKubeRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.KubernetesCredentialRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
+}
+
+func testAwsRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.AwsCredentialRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
+}
+
+func testHistoryRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.HistoryRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*history.Repository, error) {
+		return history.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
+}
 
 func TestList(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -137,7 +185,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -250,7 +298,7 @@ func TestList_Attributes(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -847,7 +895,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 			require.NoError(err, "Error when getting new credential store service.")
 
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -901,7 +949,7 @@ func TestGet(t *testing.T) {
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	unspecifiedLib := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 	require.NoError(t, err)
 
 	repo, err := repoFn()
@@ -1106,7 +1154,7 @@ func TestDelete(t *testing.T) {
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	vl := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
 	vl2 := vault.TestSSHCertificateCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1169,7 +1217,7 @@ func TestUpdate(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2169,7 +2217,7 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 			require.NoError(err, "Error when getting new credential store service.")
 
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -2222,7 +2270,7 @@ func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2585,3 +2633,355 @@ func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 		})
 	}
 }
+
+func TestCrudPlugin(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	plg := credentialplugin.TestPlugin(t, conn, "test")
+	cs := credplugin.TestCredentialStore(t, conn, prj.GetPublicId(), plg.GetPublicId())
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
+	require.NoError(t, err)
+
+	attrs, err := structpb.NewStruct(map[string]any{"role": "reader"})
+	require.NoError(t, err)
+
+	t.Run("vault-attributes", func(t *testing.T) {
+		_, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+			CredentialStoreId: cs.GetPublicId(),
+			Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+				VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+					Path: wrapperspb.String("secret/foo"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("wrong-type", func(t *testing.T) {
+		_, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+			CredentialStoreId: cs.GetPublicId(),
+			Type:              vault.GenericLibrarySubtype.String(),
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+		CredentialStoreId: cs.GetPublicId(),
+		Name:              wrapperspb.String("plugin-lib"),
+		CredentialType:    string(credential.UsernamePasswordType),
+		Attrs:             &pb.CredentialLibrary_Attributes{Attributes: attrs},
+	}})
+	require.NoError(err)
+	item := created.GetItem()
+	assert.True(strings.HasPrefix(item.GetId(), globals.PluginCredentialLibraryPrefix+"_"))
+	assert.Equal(credplugin.Subtype.String(), item.GetType())
+	assert.Equal(string(credential.UsernamePasswordType), item.GetCredentialType())
+	assert.Empty(cmp.Diff(attrs, item.GetAttributes(), protocmp.Transform()))
+
+	got, err := s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: item.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(item, got.GetItem(), protocmp.Transform()))
+
+	list, err := s.ListCredentialLibraries(ctx, &pbs.ListCredentialLibrariesRequest{CredentialStoreId: cs.GetPublicId()})
+	require.NoError(err)
+	require.Len(list.GetItems(), 1)
+	assert.Equal(item.GetId(), list.GetItems()[0].GetId())
+
+	updated, err := s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "attributes.role"}},
+		Item: &pb.CredentialLibrary{
+			Version:     item.GetVersion(),
+			Description: wrapperspb.String("updated"),
+			Attrs: &pb.CredentialLibrary_Attributes{Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"role": structpb.NewStringValue("writer"),
+			}}},
+		},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updated.GetItem().GetDescription().GetValue())
+	assert.Equal("writer", updated.GetItem().GetAttributes().GetFields()["role"].GetStringValue())
+
+	_, err = s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"credential_type"}},
+		Item: &pb.CredentialLibrary{
+			Version:        updated.GetItem().GetVersion(),
+			CredentialType: string(credential.SshPrivateKeyType),
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	_, err = s.DeleteCredentialLibrary(ctx, &pbs.DeleteCredentialLibraryRequest{Id: item.GetId()})
+	require.NoError(err)
+	_, err = s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: item.GetId()})
+	assert.Error(err)
+}
+
+func TestCrudKubernetes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := credkube.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), "https://kube.example.com:6443", []byte("admin-token"))
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
+	require.NoError(t, err)
+
+	t.Run("vault-attributes", func(t *testing.T) {
+		_, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+			CredentialStoreId: cs.GetPublicId(),
+			Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+				VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+					Path: wrapperspb.String("secret/foo"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("missing-service-account", func(t *testing.T) {
+		_, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+			CredentialStoreId: cs.GetPublicId(),
+			Attrs: &pb.CredentialLibrary_KubernetesCredentialLibraryAttributes{
+				KubernetesCredentialLibraryAttributes: &pb.KubernetesCredentialLibraryAttributes{
+					Namespace: wrapperspb.String("apps"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("wrong-credential-type", func(t *testing.T) {
+		_, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+			CredentialStoreId: cs.GetPublicId(),
+			CredentialType:    string(credential.UsernamePasswordType),
+			Attrs: &pb.CredentialLibrary_KubernetesCredentialLibraryAttributes{
+				KubernetesCredentialLibraryAttributes: &pb.KubernetesCredentialLibraryAttributes{
+					Namespace:      wrapperspb.String("apps"),
+					ServiceAccount: wrapperspb.String("deployer"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+		CredentialStoreId: cs.GetPublicId(),
+		Name:              wrapperspb.String("kube-lib"),
+		Attrs: &pb.CredentialLibrary_KubernetesCredentialLibraryAttributes{
+			KubernetesCredentialLibraryAttributes: &pb.KubernetesCredentialLibraryAttributes{
+				Namespace:      wrapperspb.String("apps"),
+				ServiceAccount: wrapperspb.String("deployer"),
+				TtlSeconds:     wrapperspb.UInt32(900),
+			},
+		},
+	}})
+	require.NoError(err)
+	item := created.GetItem()
+	assert.True(strings.HasPrefix(item.GetId(), globals.KubernetesCredentialLibraryPrefix+"_"))
+	assert.Equal(credkube.Subtype.String(), item.GetType())
+	assert.Equal(string(credential.JsonType), item.GetCredentialType())
+	assert.Equal("apps", item.GetKubernetesCredentialLibraryAttributes().GetNamespace().GetValue())
+	assert.Equal("deployer", item.GetKubernetesCredentialLibraryAttributes().GetServiceAccount().GetValue())
+	assert.Equal(uint32(900), item.GetKubernetesCredentialLibraryAttributes().GetTtlSeconds().GetValue())
+
+	got, err := s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: item.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(item, got.GetItem(), protocmp.Transform()))
+
+	list, err := s.ListCredentialLibraries(ctx, &pbs.ListCredentialLibrariesRequest{CredentialStoreId: cs.GetPublicId()})
+	require.NoError(err)
+	require.Len(list.GetItems(), 1)
+	assert.Equal(item.GetId(), list.GetItems()[0].GetId())
+
+	updated, err := s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "attributes.audience"}},
+		Item: &pb.CredentialLibrary{
+			Version:     item.GetVersion(),
+			Description: wrapperspb.String("updated"),
+			Attrs: &pb.CredentialLibrary_KubernetesCredentialLibraryAttributes{
+				KubernetesCredentialLibraryAttributes: &pb.KubernetesCredentialLibraryAttributes{
+					Audience: wrapperspb.String("boundary"),
+				},
+			},
+		},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updated.GetItem().GetDescription().GetValue())
+	assert.Equal("boundary", updated.GetItem().GetKubernetesCredentialLibraryAttributes().GetAudience().GetValue())
+	assert.Equal("apps", updated.GetItem().GetKubernetesCredentialLibraryAttributes().GetNamespace().GetValue())
+
+	_, err = s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.namespace"}},
+		Item: &pb.CredentialLibrary{
+			Version: updated.GetItem().GetVersion(),
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	_, err = s.DeleteCredentialLibrary(ctx, &pbs.DeleteCredentialLibraryRequest{Id: item.GetId()})
+	require.NoError(err)
+	_, err = s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: item.GetId()})
+	assert.Error(err)
+}
+
+func TestCrudAws(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	cs := credaws.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), "us-east-1", "AKIDEXAMPLE", []byte("secret"))
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+	s, err := NewService(repoFn, testPluginRepoFn(t, conn, wrapper, nil), testKubeRepoFn(t, conn, wrapper), testAwsRepoFn(t, conn, wrapper), iamRepoFn, testHistoryRepoFn(t, conn, wrapper))
+	require.NoError(t, err)
+
+	const roleArn = "arn:aws:iam::123456789012:role/boundary"
+	tests := []struct {
+		name  string
+		attrs *pb.AwsCredentialLibraryAttributes
+		ct    credential.Type
+	}{
+		{
+			name:  "missing-role-arn",
+			attrs: &pb.AwsCredentialLibraryAttributes{},
+		},
+		{
+			name:  "bad-role-arn",
+			attrs: &pb.AwsCredentialLibraryAttributes{RoleArn: wrapperspb.String("arn:aws:iam::123:user/boundary")},
+		},
+		{
+			name:  "bad-session-policy",
+			attrs: &pb.AwsCredentialLibraryAttributes{RoleArn: wrapperspb.String(roleArn), SessionPolicy: wrapperspb.String("{")},
+		},
+		{
+			name:  "duration-too-short",
+			attrs: &pb.AwsCredentialLibraryAttributes{RoleArn: wrapperspb.String(roleArn), DurationSeconds: wrapperspb.UInt32(60)},
+		},
+		{
+			name:  "wrong-credential-type",
+			attrs: &pb.AwsCredentialLibraryAttributes{RoleArn: wrapperspb.String(roleArn)},
+			ct:    credential.UsernamePasswordType,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: cs.GetPublicId(),
+				CredentialType:    string(tt.ct),
+				Attrs: &pb.CredentialLibrary_AwsCredentialLibraryAttributes{
+					AwsCredentialLibraryAttributes: tt.attrs,
+				},
+			}})
+			assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+		})
+	}
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+		CredentialStoreId: cs.GetPublicId(),
+		Name:              wrapperspb.String("aws-lib"),
+		Attrs: &pb.CredentialLibrary_AwsCredentialLibraryAttributes{
+			AwsCredentialLibraryAttributes: &pb.AwsCredentialLibraryAttributes{
+				RoleArn:         wrapperspb.String(roleArn),
+				DurationSeconds: wrapperspb.UInt32(900),
+				FederatedSignin: wrapperspb.Bool(true),
+			},
+		},
+	}})
+	require.NoError(err)
+	item := created.GetItem()
+	assert.True(strings.HasPrefix(item.GetId(), globals.AwsCredentialLibraryPrefix+"_"))
+	assert.Equal(credaws.Subtype.String(), item.GetType())
+	assert.Equal(string(credential.AwsCredentialType), item.GetCredentialType())
+	assert.Equal(roleArn, item.GetAwsCredentialLibraryAttributes().GetRoleArn().GetValue())
+	assert.Equal(uint32(900), item.GetAwsCredentialLibraryAttributes().GetDurationSeconds().GetValue())
+	assert.True(item.GetAwsCredentialLibraryAttributes().GetFederatedSignin().GetValue())
+
+	got, err := s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: item.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(item, got.GetItem(), protocmp.Transform()))
+
+	list, err := s.ListCredentialLibraries(ctx, &pbs.ListCredentialLibrariesRequest{CredentialStoreId: cs.GetPublicId()})
+	require.NoError(err)
+	require.Len(list.GetItems(), 1)
+	assert.Equal(item.GetId(), list.GetItems()[0].GetId())
+
+	updated, err := s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "attributes.session_name", "attributes.federated_signin"}},
+		Item: &pb.CredentialLibrary{
+			Version:     item.GetVersion(),
+			Description: wrapperspb.String("updated"),
+			Attrs: &pb.CredentialLibrary_AwsCredentialLibraryAttributes{
+				AwsCredentialLibraryAttributes: &pb.AwsCredentialLibraryAttributes{
+					SessionName:     wrapperspb.String("boundary-{{.User.Name}}"),
+					FederatedSignin: wrapperspb.Bool(false),
+				},
+			},
+		},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updated.GetItem().GetDescription().GetValue())
+	assert.Equal("boundary-{{.User.Name}}", updated.GetItem().GetAwsCredentialLibraryAttributes().GetSessionName().GetValue())
+	assert.False(updated.GetItem().GetAwsCredentialLibraryAttributes().GetFederatedSignin().GetValue())
+	assert.Equal(roleArn, updated.GetItem().GetAwsCredentialLibraryAttributes().GetRoleArn().GetValue())
+
+	_, err = s.UpdateCredentialLibrary(ctx, &pbs.UpdateCredentialLibraryRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.role_arn"}},
+		Item: &pb.CredentialLibrary{
+			Version: updated.GetItem().GetVersion(),
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	_, err = s.DeleteCredentialLibrary(ctx, &pbs.DeleteCredentialLibraryRequest{Id: item.GetId()})
+	require.NoError(err)
+	_, err = s.GetCredentialLibrary(ctx, &pbs.GetCredentialLibraryRequest{Id: item.GetId()})
+	assert.Error(err)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentiallibraries
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// The oplog type names of the items making up a credential library.
+const (
+	vaultLibraryType             = "credential_vault_library"
+	usernamePasswordOverrideType = "credential_vault_library_username_password_mapping_override"
+	sshPrivateKeyOverrideType    = "credential_vault_library_ssh_private_key_mapping_override"
+	tlsClientCertOverrideType    = "credential_vault_library_tls_client_certificate_mapping_override"
+	sshCertLibraryType           = "credential_vault_ssh_cert_library"
+	pkiLibraryType               = "credential_vault_pki_library"
+)
+
+// restoreFieldMask maps the restorable fields of a credential library to
+// the field mask paths accepted by the repository.
+var restoreFieldMask = map[string]string{
+	"name":              "Name",
+	"description":       "Description",
+	"vault_path":        "VaultPath",
+	"http_method":       "HttpMethod",
+	"http_request_body": "HttpRequestBody",
+	"username":          "Username",
+	"common_name":       "CommonName",
+	"alt_names":         "AltNames",
+	"ip_sans":           "IpSans",
+	"key_type":          "KeyType",
+	"key_bits":          "KeyBits",
+	"ttl":               "Ttl",
+	"key_id":            "KeyId",
+	"critical_options":  vault.CriticalOptionsField,
+	"extensions":        vault.ExtensionsField,
+}
+
+// deletedLibraryStoreId returns the id of the credential store a deleted
+// credential library belonged to, as last recorded by the oplog.
+func (s Service) deletedLibraryStoreId(ctx context.Context, id string) (string, error) {
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return "", err
+	}
+	state, err := repo.ResourceState(ctx, id, time.Now())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", handlers.NotFoundError()
+		}
+		return "", err
+	}
+	switch l := state.Resource(libraryType(id)).(type) {
+	case *store.CredentialLibrary:
+		return l.GetStoreId(), nil
+	case *store.SSHCertificateCredentialLibrary:
+		return l.GetStoreId(), nil
+	case *store.PkiCredentialLibrary:
+		return l.GetStoreId(), nil
+	}
+	return "", handlers.NotFoundError()
+}
+
+// libraryType returns the oplog type name of the credential library with
+// the given id.
+func libraryType(id string) string {
+	switch subtypes.SubtypeFromId(domain, id) {
+	case vault.SSHCertificateLibrarySubtype:
+		return sshCertLibraryType
+	case vault.PkiLibrarySubtype:
+		return pkiLibraryType
+	}
+	return vaultLibraryType
+}
+
+// validateRestoreDependencies verifies that the credential store the
+// credential library belonged to in the state still exists.
+func (s Service) validateRestoreDependencies(ctx context.Context, want *history.State) error {
+	var storeId string
+	switch l := want.Resource(libraryType(want.PublicId)).(type) {
+	case *store.CredentialLibrary:
+		storeId = l.GetStoreId()
+	case *store.SSHCertificateCredentialLibrary:
+		storeId = l.GetStoreId()
+	case *store.PkiCredentialLibrary:
+		storeId = l.GetStoreId()
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	cs, err := repo.LookupCredentialStore(ctx, storeId)
+	if err != nil {
+		return err
+	}
+	if cs == nil {
+		return handlers.RestoreDependencyError("credential store", storeId)
+	}
+	return nil
+}
+
+// restoreInRepo applies the changes needed to bring the credential library
+// to the wanted state. A deleted credential library is recreated with its
+// original id. The library and its mapping override are written by a single
+// create or update, so the restore is applied in one repository transaction.
+func (s Service) restoreInRepo(ctx context.Context, projectId string, want, current *history.State, changes []*history.Change) error {
+	const op = "credentiallibraries.(Service).restoreInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	id := want.PublicId
+
+	var mask []string
+	for _, c := range changes {
+		var p string
+		switch c.Type {
+		case vaultLibraryType, sshCertLibraryType, pkiLibraryType:
+			p = restoreFieldMask[c.Field]
+		case usernamePasswordOverrideType, sshPrivateKeyOverrideType, tlsClientCertOverrideType:
+			p = vault.MappingOverrideField
+		}
+		if p != "" && !strutil.StrListContains(mask, p) {
+			mask = append(mask, p)
+		}
+	}
+
+	switch wl := want.Resource(libraryType(id)).(type) {
+	case *store.SSHCertificateCredentialLibrary:
+		l := &vault.SSHCertificateCredentialLibrary{SSHCertificateCredentialLibrary: proto.Clone(wl).(*store.SSHCertificateCredentialLibrary)}
+		l.CreateTime, l.UpdateTime, l.Version = nil, nil, 0
+		switch {
+		case !current.Exists():
+			l.PublicId = ""
+			if _, err := repo.CreateSSHCertificateCredentialLibrary(ctx, projectId, l, vault.WithPublicId(id)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to recreate ssh certificate credential library"))
+			}
+		case len(mask) > 0:
+			cur, err := repo.LookupSSHCertificateCredentialLibrary(ctx, id)
+			if err != nil {
+				return err
+			}
+			if _, _, err := repo.UpdateSSHCertificateCredentialLibrary(ctx, projectId, l, cur.GetVersion(), mask); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update ssh certificate credential library"))
+			}
+		}
+	case *store.PkiCredentialLibrary:
+		l := &vault.PkiCredentialLibrary{PkiCredentialLibrary: proto.Clone(wl).(*store.PkiCredentialLibrary)}
+		l.CreateTime, l.UpdateTime, l.Version = nil, nil, 0
+		switch {
+		case !current.Exists():
+			l.PublicId = ""
+			if _, err := repo.CreatePkiCredentialLibrary(ctx, projectId, l, vault.WithPublicId(id)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to recreate pki credential library"))
+			}
+		case len(mask) > 0:
+			cur, err := repo.LookupPkiCredentialLibrary(ctx, id)
+			if err != nil {
+				return err
+			}
+			if _, _, err := repo.UpdatePkiCredentialLibrary(ctx, projectId, l, cur.GetVersion(), mask); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update pki credential library"))
+			}
+		}
+	case *store.CredentialLibrary:
+		l := &vault.CredentialLibrary{CredentialLibrary: proto.Clone(wl).(*store.CredentialLibrary)}
+		l.CreateTime, l.UpdateTime, l.Version = nil, nil, 0
+		for _, m := range want.Items(usernamePasswordOverrideType) {
+			l.MappingOverride = &vault.UsernamePasswordOverride{UsernamePasswordOverride: proto.Clone(m).(*store.UsernamePasswordOverride)}
+		}
+		for _, m := range want.Items(sshPrivateKeyOverrideType) {
+			l.MappingOverride = &vault.SshPrivateKeyOverride{SshPrivateKeyOverride: proto.Clone(m).(*store.SshPrivateKeyOverride)}
+		}
+		for _, m := range want.Items(tlsClientCertOverrideType) {
+			l.MappingOverride = &vault.TlsClientCertificateOverride{TlsClientCertificateOverride: proto.Clone(m).(*store.TlsClientCertificateOverride)}
+		}
+		switch {
+		case !current.Exists():
+			l.PublicId = ""
+			if _, err := repo.CreateCredentialLibrary(ctx, projectId, l, vault.WithPublicId(id)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to recreate credential library"))
+			}
+		case len(mask) > 0:
+			cur, err := repo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				return err
+			}
+			if _, _, err := repo.UpdateCredentialLibrary(ctx, projectId, l, cur.GetVersion(), mask); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+			}
+		}
+	}
+	return nil
+}
@@ -5,10 +5,13 @@ package credentials
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
@@ -25,6 +28,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -38,6 +42,8 @@ const (
 	privateKeyField           = "attributes.private_key"
 	privateKeyPassphraseField = "attributes.private_key_passphrase"
 	objectField               = "attributes.object"
+	certificateField          = "attributes.certificate"
+	caBundleField             = "attributes.ca_bundle"
 	domain                    = "credential"
 )
 
@@ -45,6 +51,7 @@ var (
 	upMaskManager   handlers.MaskManager
 	spkMaskManager  handlers.MaskManager
 	jsonMaskManager handlers.MaskManager
+	tlsMaskManager  handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -53,6 +60,13 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.ListCheckouts,
+		action.CheckIn,
+		action.SetRotationPolicy,
+		action.RemoveRotationPolicy,
+		action.ListRotations,
+		action.ListVersions,
+		action.Rollback,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -77,6 +91,10 @@ func init() {
 		handlers.MaskSource{&pb.Credential{}, &pb.JsonAttributes{}}); err != nil {
 		panic(err)
 	}
+	if tlsMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.TlsClientCertificateCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.TlsClientCertificateAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialServiceServer interface.
@@ -173,7 +191,13 @@ func (s Service) GetCredential(ctx context.Context, req *pbs.GetCredentialReques
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	c, err := s.getFromRepo(ctx, req.GetId())
+	var c credential.Static
+	var err error
+	if req.GetVersion() != 0 {
+		c, err = s.getVersionFromRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetVersion())
+	} else {
+		c, err = s.getFromRepo(ctx, req.GetId())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +325,234 @@ func (s Service) DeleteCredential(ctx context.Context, req *pbs.DeleteCredential
 	return nil, nil
 }
 
+// ListCredentialCheckouts implements the interface pbs.CredentialServiceServer.
+func (s Service) ListCredentialCheckouts(ctx context.Context, req *pbs.ListCredentialCheckoutsRequest) (*pbs.ListCredentialCheckoutsResponse, error) {
+	const op = "credentials.(Service).ListCredentialCheckouts"
+
+	if err := validateListCheckoutsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListCheckouts)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	checkouts, err := repo.ListCheckouts(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.ListCredentialCheckoutsResponse{Items: toCheckoutsProto(checkouts)}, nil
+}
+
+// CheckInCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) CheckInCredential(ctx context.Context, req *pbs.CheckInCredentialRequest) (*pbs.CheckInCredentialResponse, error) {
+	const op = "credentials.(Service).CheckInCredential"
+
+	if err := validateCheckInRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CheckIn)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.CheckIn(ctx, authResults.Scope.GetId(), req.GetId(), req.GetSessionId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to check in credential"))
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Session %q doesn't hold a check-out of credential %q.", req.GetSessionId(), req.GetId())
+	}
+	checkouts, err := repo.ListCheckouts(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.CheckInCredentialResponse{Items: toCheckoutsProto(checkouts)}, nil
+}
+
+// SetCredentialRotationPolicy implements the interface pbs.CredentialServiceServer.
+func (s Service) SetCredentialRotationPolicy(ctx context.Context, req *pbs.SetCredentialRotationPolicyRequest) (*pbs.SetCredentialRotationPolicyResponse, error) {
+	const op = "credentials.(Service).SetCredentialRotationPolicy"
+
+	if err := validateSetRotationPolicyRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.SetRotationPolicy)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item := req.GetItem()
+	p, err := static.NewRotationPolicy(req.GetId(), item.GetRotator(), item.GetAddress(),
+		static.WithAdminCredentialId(item.GetAdminCredentialId()),
+		static.WithHostKey(item.GetHostKey()),
+		static.WithRotateAfterSession(item.GetRotateAfterSession()),
+		static.WithRotationIntervalHours(item.GetRotationIntervalHours()),
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.SetRotationPolicy(ctx, authResults.Scope.Id, p)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set rotation policy"))
+	}
+	return &pbs.SetCredentialRotationPolicyResponse{Item: toRotationPolicyProto(out)}, nil
+}
+
+// RemoveCredentialRotationPolicy implements the interface pbs.CredentialServiceServer.
+func (s Service) RemoveCredentialRotationPolicy(ctx context.Context, req *pbs.RemoveCredentialRotationPolicyRequest) (*pbs.RemoveCredentialRotationPolicyResponse, error) {
+	const op = "credentials.(Service).RemoveCredentialRotationPolicy"
+
+	if err := validateRemoveRotationPolicyRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RemoveRotationPolicy)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.DeleteRotationPolicy(ctx, authResults.Scope.Id, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to remove rotation policy"))
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't have a rotation policy.", req.GetId())
+	}
+	return &pbs.RemoveCredentialRotationPolicyResponse{}, nil
+}
+
+// ListCredentialRotations implements the interface pbs.CredentialServiceServer.
+func (s Service) ListCredentialRotations(ctx context.Context, req *pbs.ListCredentialRotationsRequest) (*pbs.ListCredentialRotationsResponse, error) {
+	const op = "credentials.(Service).ListCredentialRotations"
+
+	if err := validateListRotationsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListRotations)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p, err := repo.LookupRotationPolicy(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rotations, err := repo.ListRotations(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out := &pbs.ListCredentialRotationsResponse{
+		Items: make([]*pb.CredentialRotation, 0, len(rotations)),
+	}
+	if p != nil {
+		out.Policy = toRotationPolicyProto(p)
+	}
+	for _, r := range rotations {
+		out.Items = append(out.Items, &pb.CredentialRotation{
+			Trigger:   string(r.Trigger),
+			Status:    string(r.Status),
+			Error:     r.Error,
+			StartTime: r.StartTime.GetTimestamp(),
+			EndTime:   r.EndTime.GetTimestamp(),
+		})
+	}
+	return out, nil
+}
+
+// ListCredentialVersions implements the interface pbs.CredentialServiceServer.
+func (s Service) ListCredentialVersions(ctx context.Context, req *pbs.ListCredentialVersionsRequest) (*pbs.ListCredentialVersionsResponse, error) {
+	const op = "credentials.(Service).ListCredentialVersions"
+
+	if err := validateListVersionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListVersions)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	versions, err := repo.ListCredentialVersions(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out := &pbs.ListCredentialVersionsResponse{
+		Items: make([]*pb.CredentialVersion, 0, len(versions)),
+	}
+	for _, v := range versions {
+		out.Items = append(out.Items, &pb.CredentialVersion{
+			Version:     v.GetVersion(),
+			CreatedTime: v.GetCreateTime().GetTimestamp(),
+		})
+	}
+	return out, nil
+}
+
+// RollbackCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) RollbackCredential(ctx context.Context, req *pbs.RollbackCredentialRequest) (*pbs.RollbackCredentialResponse, error) {
+	const op = "credentials.(Service).RollbackCredential"
+
+	if err := validateRollbackRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Rollback)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c, err := repo.RollbackCredential(ctx, authResults.Scope.GetId(), req.GetId(), req.GetToVersion(), req.GetVersion())
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.RecordNotFound), err):
+			return nil, handlers.NotFoundErrorf("Version %d of credential %q not found.", req.GetToVersion(), req.GetId())
+		case errors.Match(errors.T(errors.VersionMismatch), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Version %d is not the current version of credential %q.", req.GetVersion(), req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to roll back credential"))
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RollbackCredentialResponse{Item: item}, nil
+}
+
 func (s Service) listFromRepo(ctx context.Context, storeId string) ([]credential.Static, error) {
 	const op = "credentials.(Service).listFromRepo"
 	repo, err := s.repoFn()
@@ -334,6 +586,22 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static,
 	return cred, err
 }
 
+func (s Service) getVersionFromRepo(ctx context.Context, scopeId, id string, version uint32) (credential.Static, error) {
+	const op = "credentials.(Service).getVersionFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cred, err := repo.LookupCredentialVersion(ctx, scopeId, id, version)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if cred == nil {
+		return nil, handlers.NotFoundErrorf("Version %d of credential %q not found.", version, id)
+	}
+	return cred, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Credential) (credential.Static, error) {
 	const op = "credentials.(Service).createInRepo"
 	switch item.GetType() {
@@ -388,6 +656,23 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
 		}
 		return out, nil
+	case credential.TlsClientCertificateSubtype.String():
+		cred, err := toTlsClientCertificateStorageCredential(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateTlsClientCertificateCredential(ctx, scopeId, cred)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
+		}
+		if out == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
+		}
+		return out, nil
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, fmt.Sprintf("Unsupported credential type %q", item.GetType()))
 	}
@@ -487,6 +772,35 @@ func (s Service) updateInRepo(
 		}
 		return out, nil
 
+	case credential.TlsClientCertificateSubtype:
+		dbMasks = append(dbMasks, tlsMaskManager.Translate(masks)...)
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+
+		cred, err := toTlsClientCertificateStorageCredential(ctx, storeId, in)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to convert to tls client certificate storage credential"))
+		}
+		if cred.PassphraseUnneeded && !strutil.StrListContains(dbMasks, static.PrivateKeyPassphraseField) {
+			// A new private key was given without a passphrase, so make
+			// sure any passphrase stored for the previous key is removed.
+			dbMasks = append(dbMasks, static.PrivateKeyPassphraseField)
+		}
+		cred.PublicId = id
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err := repo.UpdateTlsClientCertificateCredential(ctx, scopeId, cred, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+		}
+		return out, nil
+
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, fmt.Sprintf("Unsupported credential type %q", item.GetType()))
 
@@ -581,6 +895,8 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 			out.Type = credential.SshPrivateKeySubtype.String()
 		case *static.JsonCredential:
 			out.Type = credential.JsonSubtype.String()
+		case *static.TlsClientCertificateCredential:
+			out.Type = credential.TlsClientCertificateSubtype.String()
 		}
 	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
@@ -589,6 +905,11 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 	if outputFields.Has(globals.NameField) && in.GetName() != "" {
 		out.Name = wrapperspb.String(in.GetName())
 	}
+	if outputFields.Has(globals.MaxConcurrentCheckoutsField) {
+		if c, ok := in.(interface{ GetMaxConcurrentCheckouts() uint32 }); ok && c.GetMaxConcurrentCheckouts() > 0 {
+			out.MaxConcurrentCheckouts = wrapperspb.UInt32(c.GetMaxConcurrentCheckouts())
+		}
+	}
 	if outputFields.Has(globals.CreatedTimeField) {
 		out.CreatedTime = in.GetCreateTime().GetTimestamp()
 	}
@@ -633,10 +954,52 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 				},
 			}
 		}
+	case *static.TlsClientCertificateCredential:
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.TlsClientCertificateAttributes{
+				Certificate:              wrapperspb.String(string(cred.GetCertificate())),
+				PrivateKeyHmac:           base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyHmac()),
+				PrivateKeyPassphraseHmac: base64.RawURLEncoding.EncodeToString(cred.GetPrivateKeyPassphraseHmac()),
+			}
+			if len(cred.GetCaBundle()) > 0 {
+				attrs.CaBundle = wrapperspb.String(string(cred.GetCaBundle()))
+			}
+			out.Attrs = &pb.Credential_TlsClientCertificateAttributes{
+				TlsClientCertificateAttributes: attrs,
+			}
+		}
 	}
 	return &out, nil
 }
 
+func toCheckoutsProto(in []*static.Checkout) []*pb.CredentialCheckout {
+	out := make([]*pb.CredentialCheckout, 0, len(in))
+	for _, c := range in {
+		out = append(out, &pb.CredentialCheckout{
+			SessionId:      c.SessionId,
+			UserId:         c.UserId,
+			CreatedTime:    c.CreateTime.GetTimestamp(),
+			ExpirationTime: c.ExpirationTime.GetTimestamp(),
+		})
+	}
+	return out
+}
+
+func toRotationPolicyProto(in *static.RotationPolicy) *pb.CredentialRotationPolicy {
+	return &pb.CredentialRotationPolicy{
+		Rotator:               in.GetRotator(),
+		Address:               in.GetAddress(),
+		AdminCredentialId:     in.GetAdminCredentialId(),
+		RotateAfterSession:    in.GetRotateAfterSession(),
+		RotationIntervalHours: in.GetRotationIntervalHours(),
+		LastRotationTime:      in.GetLastRotationTime().GetTimestamp(),
+		RotationPending:       in.GetRotationPending(),
+		CreatedTime:           in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:           in.GetUpdateTime().GetTimestamp(),
+		HostKey:               in.GetHostKey(),
+	}
+}
+
 func toUsernamePasswordStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.UsernamePasswordCredential, err error) {
 	const op = "credentials.toUsernamePasswordStorageCredential"
 	var opts []static.Option
@@ -646,6 +1009,9 @@ func toUsernamePasswordStorageCredential(ctx context.Context, storeId string, in
 	if in.GetDescription() != nil {
 		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
 	}
+	if in.GetMaxConcurrentCheckouts() != nil {
+		opts = append(opts, static.WithMaxConcurrentCheckouts(in.GetMaxConcurrentCheckouts().GetValue()))
+	}
 
 	attrs := in.GetUsernamePasswordAttributes()
 	cs, err := static.NewUsernamePasswordCredential(
@@ -669,6 +1035,9 @@ func toSshPrivateKeyStorageCredential(ctx context.Context, storeId string, in *p
 	if in.GetDescription() != nil {
 		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
 	}
+	if in.GetMaxConcurrentCheckouts() != nil {
+		opts = append(opts, static.WithMaxConcurrentCheckouts(in.GetMaxConcurrentCheckouts().GetValue()))
+	}
 
 	attrs := in.GetSshPrivateKeyAttributes()
 	if attrs.GetPrivateKeyPassphrase() != nil {
@@ -696,6 +1065,9 @@ func toJsonStorageCredential(ctx context.Context, storeId string, in *pb.Credent
 	if in.GetDescription() != nil {
 		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
 	}
+	if in.GetMaxConcurrentCheckouts() != nil {
+		opts = append(opts, static.WithMaxConcurrentCheckouts(in.GetMaxConcurrentCheckouts().GetValue()))
+	}
 
 	attrs := in.GetJsonAttributes()
 	object := attrs.GetObject()
@@ -719,6 +1091,108 @@ func toJsonStorageCredential(ctx context.Context, storeId string, in *pb.Credent
 	return cs, err
 }
 
+func toTlsClientCertificateStorageCredential(ctx context.Context, storeId string, in *pb.Credential) (out *static.TlsClientCertificateCredential, err error) {
+	const op = "credentials.toTlsClientCertificateStorageCredential"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+	if in.GetMaxConcurrentCheckouts() != nil {
+		opts = append(opts, static.WithMaxConcurrentCheckouts(in.GetMaxConcurrentCheckouts().GetValue()))
+	}
+
+	attrs := in.GetTlsClientCertificateAttributes()
+	if attrs.GetPrivateKeyPassphrase() != nil {
+		opts = append(opts, static.WithPrivateKeyPassphrase([]byte(attrs.GetPrivateKeyPassphrase().GetValue())))
+	}
+	if attrs.GetCaBundle() != nil {
+		opts = append(opts, static.WithCaBundle([]byte(attrs.GetCaBundle().GetValue())))
+	}
+	cs, err := static.NewTlsClientCertificateCredential(
+		ctx,
+		storeId,
+		[]byte(attrs.GetCertificate().GetValue()),
+		credential.PrivateKey(attrs.GetPrivateKey().GetValue()),
+		opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential"))
+	}
+
+	return cs, err
+}
+
+// validateTlsClientCertificate adds to badFields if the certificate chain,
+// private key or CA bundle can not be parsed, or if the private key does not
+// match the leaf certificate when both are provided.
+func validateTlsClientCertificate(badFields map[string]string, certificate, privateKey, passphrase, caBundle string) {
+	if certificate != "" {
+		rest := []byte(certificate)
+		var count int
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				badFields[certificateField] = fmt.Sprintf("Unexpected PEM block of type %q, only certificates are allowed.", block.Type)
+				return
+			}
+			if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+				badFields[certificateField] = fmt.Sprintf("Unable to parse given certificate value: %v.", err)
+				return
+			}
+			count++
+		}
+		if count == 0 {
+			badFields[certificateField] = "No PEM encoded certificates found."
+			return
+		}
+	}
+	if caBundle != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(caBundle)); !ok {
+			badFields[caBundleField] = "No PEM encoded certificates found."
+		}
+	}
+	if privateKey == "" {
+		return
+	}
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		badFields[privateKeyField] = "Private key is not PEM encoded."
+		return
+	}
+	keyPem := []byte(privateKey)
+	switch {
+	case block.Type == "ENCRYPTED PRIVATE KEY":
+		badFields[privateKeyField] = "Encrypted PKCS #8 private keys are not supported."
+		return
+	case x509.IsEncryptedPEMBlock(block):
+		if passphrase == "" {
+			badFields[privateKeyPassphraseField] = "Field required for an encrypted private key."
+			return
+		}
+		der, err := x509.DecryptPEMBlock(block, []byte(passphrase))
+		if err != nil {
+			badFields[privateKeyPassphraseField] = "Incorrect private key passphrase."
+			return
+		}
+		keyPem = pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: der})
+	case passphrase != "":
+		badFields[privateKeyPassphraseField] = "Passphrase supplied for unencrypted key."
+		return
+	}
+	if certificate == "" {
+		return
+	}
+	if _, err := tls.X509KeyPair([]byte(certificate), keyPem); err != nil {
+		badFields[privateKeyField] = fmt.Sprintf("Private key does not match the certificate: %v.", err)
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -732,6 +1206,7 @@ func validateGetRequest(req *pbs.GetCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
 	)
 }
 
@@ -792,6 +1267,22 @@ func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
 				badFields[objectField] = "Field required for creating a json credential."
 			}
 
+		case credential.TlsClientCertificateSubtype.String():
+			attrs := req.Item.GetTlsClientCertificateAttributes()
+			if attrs.GetCertificate().GetValue() == "" {
+				badFields[certificateField] = "Field required for creating a TLS client certificate credential."
+			}
+			if attrs.GetPrivateKey().GetValue() == "" {
+				badFields[privateKeyField] = "Field required for creating a TLS client certificate credential."
+			}
+			if len(badFields) == 0 {
+				validateTlsClientCertificate(badFields,
+					attrs.GetCertificate().GetValue(),
+					attrs.GetPrivateKey().GetValue(),
+					attrs.GetPrivateKeyPassphrase().GetValue(),
+					attrs.GetCaBundle().GetValue())
+			}
+
 		default:
 			badFields[globals.TypeField] = fmt.Sprintf("Unsupported credential type %q", req.Item.GetType())
 		}
@@ -863,6 +1354,31 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 				badFields[objectField] = "This is a required field and cannot be set to empty."
 			}
 
+		case credential.TlsClientCertificateSubtype:
+			attrs := req.GetItem().GetTlsClientCertificateAttributes()
+			paths := req.GetUpdateMask().GetPaths()
+			var certificate, privateKey, passphrase, caBundle string
+			if handlers.MaskContains(paths, certificateField) {
+				if certificate = attrs.GetCertificate().GetValue(); certificate == "" {
+					badFields[certificateField] = "This is a required field and cannot be set to empty."
+				}
+			}
+			if handlers.MaskContains(paths, privateKeyField) {
+				if privateKey = attrs.GetPrivateKey().GetValue(); privateKey == "" {
+					badFields[privateKeyField] = "This is a required field and cannot be set to empty."
+				}
+				passphrase = attrs.GetPrivateKeyPassphrase().GetValue()
+			}
+			if handlers.MaskContains(paths, caBundleField) {
+				caBundle = attrs.GetCaBundle().GetValue()
+			}
+			if len(badFields) == 0 {
+				// The key pair is only checked here when both halves are
+				// provided, the repository validates partial updates against
+				// the stored values.
+				validateTlsClientCertificate(badFields, certificate, privateKey, passphrase, caBundle)
+			}
+
 		default:
 			badFields[globals.IdField] = "Unknown credential type."
 		}
@@ -873,6 +1389,147 @@ func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+	)
+}
+
+func validateListCheckoutsRequest(req *pbs.ListCredentialCheckoutsRequest) error {
+	return handlers.ValidateGetRequest(
+		handlers.NoopValidatorFn,
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+	)
+}
+
+func validateCheckInRequest(req *pbs.CheckInCredentialRequest) error {
+	return handlers.ValidateGetRequest(
+		func() map[string]string {
+			badFields := map[string]string{}
+			if !handlers.ValidId(handlers.Id(req.GetSessionId()), globals.SessionPrefix) {
+				badFields["session_id"] = "Incorrectly formatted identifier."
+			}
+			return badFields
+		},
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+	)
+}
+
+func validateSetRotationPolicyRequest(ctx context.Context, req *pbs.SetCredentialRotationPolicyRequest) error {
+	return handlers.ValidateGetRequest(
+		func() map[string]string {
+			badFields := map[string]string{}
+			item := req.GetItem()
+			if item == nil {
+				badFields["item"] = "This field is required."
+				return badFields
+			}
+			if !static.ValidRotator(item.GetRotator()) {
+				badFields["item.rotator"] = fmt.Sprintf("Must be one of %q, %q or %q.", static.PostgresRotator, static.SshRotator, static.LdapRotator)
+			}
+			if strings.TrimSpace(item.GetAddress()) == "" {
+				badFields["item.address"] = "This field is required."
+			}
+			if item.GetAdminCredentialId() != "" {
+				switch {
+				case !handlers.ValidId(handlers.Id(item.GetAdminCredentialId()), globals.UsernamePasswordCredentialPrefix, globals.UsernamePasswordCredentialPreviousPrefix):
+					badFields["item.admin_credential_id"] = "Must be the ID of a username_password credential."
+				case item.GetAdminCredentialId() == req.GetId():
+					badFields["item.admin_credential_id"] = "Must not be the credential being rotated; leave unset to authenticate with it."
+				}
+			}
+			switch {
+			case item.GetRotator() == static.SshRotator && item.GetHostKey() == "":
+				badFields["item.host_key"] = "This field is required for the ssh rotator."
+			case item.GetRotator() != static.SshRotator && item.GetHostKey() != "":
+				badFields["item.host_key"] = "Only valid for the ssh rotator."
+			case item.GetHostKey() != "":
+				if _, err := static.ParseHostKeys(ctx, item.GetHostKey()); err != nil {
+					badFields["item.host_key"] = "Must be one or more public keys in authorized_keys format."
+				}
+			}
+			if !item.GetRotateAfterSession() && item.GetRotationIntervalHours() == 0 {
+				badFields["item.rotate_after_session"] = "Either this field or rotation_interval_hours must be set."
+			}
+			if item.GetLastRotationTime() != nil {
+				badFields["item.last_rotation_time"] = "This is a read only field."
+			}
+			if item.GetRotationPending() {
+				badFields["item.rotation_pending"] = "This is a read only field."
+			}
+			if item.GetCreatedTime() != nil {
+				badFields["item.created_time"] = "This is a read only field."
+			}
+			if item.GetUpdatedTime() != nil {
+				badFields["item.updated_time"] = "This is a read only field."
+			}
+			return badFields
+		},
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+	)
+}
+
+func validateRemoveRotationPolicyRequest(req *pbs.RemoveCredentialRotationPolicyRequest) error {
+	return handlers.ValidateGetRequest(
+		handlers.NoopValidatorFn,
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+	)
+}
+
+func validateListRotationsRequest(req *pbs.ListCredentialRotationsRequest) error {
+	return handlers.ValidateGetRequest(
+		handlers.NoopValidatorFn,
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+	)
+}
+
+func validateListVersionsRequest(req *pbs.ListCredentialVersionsRequest) error {
+	return handlers.ValidateGetRequest(
+		handlers.NoopValidatorFn,
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
+	)
+}
+
+func validateRollbackRequest(req *pbs.RollbackCredentialRequest) error {
+	return handlers.ValidateGetRequest(
+		func() map[string]string {
+			badFields := map[string]string{}
+			if req.GetVersion() == 0 {
+				badFields[globals.VersionField] = "Required field."
+			}
+			switch {
+			case req.GetToVersion() == 0:
+				badFields["to_version"] = "Required field."
+			case req.GetToVersion() >= req.GetVersion():
+				badFields["to_version"] = "Must be older than the current version."
+			}
+			return badFields
+		},
+		req,
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
 	)
 }
 
@@ -884,6 +1541,7 @@ func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
 		globals.UsernamePasswordCredentialPreviousPrefix,
 		globals.SshPrivateKeyCredentialPrefix,
 		globals.JsonCredentialPrefix,
+		globals.TlsClientCertificateCredentialPrefix,
 	)
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/libs/crypto"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
@@ -40,7 +41,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "list-checkouts", "check-in", "set-rotation-policy", "remove-rotation-policy", "list-rotations", "list-versions", "rollback"}
 
 func TestList(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1371,3 +1372,329 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckouts(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(context.Background(), rw, rw, kms)
+	}
+	s, err := NewService(staticRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	params := session.TestSessionParams(t, conn, wrapper, iamRepo)
+	store := static.TestCredentialStore(t, conn, wrapper, params.ProjectId)
+	cred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), params.ProjectId, static.WithMaxConcurrentCheckouts(1))
+	params.StaticCredentials = []*session.StaticCredential{session.NewStaticCredential(cred.GetPublicId(), credential.BrokeredPurpose)}
+	sess := session.TestSession(t, conn, wrapper, params)
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, params.ProjectId)
+
+	got, err := s.GetCredential(ctx, &pbs.GetCredentialRequest{Id: cred.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, wrapperspb.UInt32(1), got.GetItem().GetMaxConcurrentCheckouts())
+
+	list, err := s.ListCredentialCheckouts(ctx, &pbs.ListCredentialCheckoutsRequest{Id: cred.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, list.GetItems(), 1)
+	assert.Equal(t, sess.GetPublicId(), list.GetItems()[0].GetSessionId())
+	assert.Equal(t, params.UserId, list.GetItems()[0].GetUserId())
+	assert.NotNil(t, list.GetItems()[0].GetCreatedTime())
+	assert.NotNil(t, list.GetItems()[0].GetExpirationTime())
+
+	cases := []struct {
+		name      string
+		id        string
+		sessionId string
+		err       error
+	}{
+		{
+			name:      "bad credential prefix",
+			id:        fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePrefix),
+			sessionId: sess.GetPublicId(),
+			err:       handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:      "bad session prefix",
+			id:        cred.GetPublicId(),
+			sessionId: fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePrefix),
+			err:       handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:      "session without check-out",
+			id:        cred.GetPublicId(),
+			sessionId: fmt.Sprintf("%s_1234567890", globals.SessionPrefix),
+			err:       handlers.NotFoundError(),
+		},
+		{
+			name:      "success",
+			id:        cred.GetPublicId(),
+			sessionId: sess.GetPublicId(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, gErr := s.CheckInCredential(ctx, &pbs.CheckInCredentialRequest{Id: tc.id, SessionId: tc.sessionId})
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err), "CheckInCredential(%q) got error %v, wanted %v", tc.id, gErr, tc.err)
+				return
+			}
+			require.NoError(t, gErr)
+			assert.Empty(t, got.GetItems())
+		})
+	}
+}
+
+// testHostKey is an ed25519 public key in authorized_keys format.
+const testHostKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJ2Ts4C/3ZTWGdc6j1L3pc7ZBfZPnXT/4C0kXBZc6RmP"
+
+func TestRotationPolicy(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(context.Background(), rw, rw, kms)
+	}
+	s, err := NewService(staticRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	cred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId())
+	admin := static.TestUsernamePasswordCredential(t, conn, wrapper, "admin", "secret", store.GetPublicId(), prj.GetPublicId())
+	spk := static.TestSshPrivateKeyCredential(t, conn, wrapper, "user", static.TestSshPrivateKeyPem, store.GetPublicId(), prj.GetPublicId())
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	setCases := []struct {
+		name string
+		req  *pbs.SetCredentialRotationPolicyRequest
+		err  error
+	}{
+		{
+			name: "missing item",
+			req:  &pbs.SetCredentialRotationPolicyRequest{Id: cred.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "not username password",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   spk.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "ssh", Address: "host:22", RotateAfterSession: true},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "unknown rotator",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "telnet", Address: "host:23", RotateAfterSession: true},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing schedule",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "postgres", Address: "db:5432"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "self admin credential",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "postgres", Address: "db:5432", RotateAfterSession: true, AdminCredentialId: cred.GetPublicId()},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "ssh without host key",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "ssh", Address: "host:22", RotateAfterSession: true},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "invalid host key",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "ssh", Address: "host:22", RotateAfterSession: true, HostKey: "not a key"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "host key without ssh",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "postgres", Address: "db:5432", RotateAfterSession: true, HostKey: testHostKey},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "read only field",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id:   cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{Rotator: "postgres", Address: "db:5432", RotateAfterSession: true, RotationPending: true},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "success",
+			req: &pbs.SetCredentialRotationPolicyRequest{
+				Id: cred.GetPublicId(),
+				Item: &pb.CredentialRotationPolicy{
+					Rotator:               "postgres",
+					Address:               "db:5432",
+					AdminCredentialId:     admin.GetPublicId(),
+					RotateAfterSession:    true,
+					RotationIntervalHours: 24,
+				},
+			},
+		},
+	}
+	for _, tc := range setCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, gErr := s.SetCredentialRotationPolicy(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err), "SetCredentialRotationPolicy(%q) got error %v, wanted %v", tc.req.GetId(), gErr, tc.err)
+				return
+			}
+			require.NoError(t, gErr)
+			assert.Equal(t, "postgres", got.GetItem().GetRotator())
+			assert.Equal(t, admin.GetPublicId(), got.GetItem().GetAdminCredentialId())
+			assert.Equal(t, uint32(24), got.GetItem().GetRotationIntervalHours())
+			assert.NotNil(t, got.GetItem().GetCreatedTime())
+		})
+	}
+
+	list, err := s.ListCredentialRotations(ctx, &pbs.ListCredentialRotationsRequest{Id: cred.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, "db:5432", list.GetPolicy().GetAddress())
+	assert.True(t, list.GetPolicy().GetRotateAfterSession())
+	assert.False(t, list.GetPolicy().GetRotationPending())
+	assert.Empty(t, list.GetItems())
+
+	_, err = s.RemoveCredentialRotationPolicy(ctx, &pbs.RemoveCredentialRotationPolicyRequest{Id: cred.GetPublicId()})
+	require.NoError(t, err)
+	_, err = s.RemoveCredentialRotationPolicy(ctx, &pbs.RemoveCredentialRotationPolicyRequest{Id: cred.GetPublicId()})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "RemoveCredentialRotationPolicy got error %v, wanted not found", err)
+
+	list, err = s.ListCredentialRotations(ctx, &pbs.ListCredentialRotationsRequest{Id: cred.GetPublicId()})
+	require.NoError(t, err)
+	assert.Nil(t, list.GetPolicy())
+}
+
+func TestVersions(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(context.Background(), rw, rw, kms)
+	}
+	s, err := NewService(staticRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	repo, err := staticRepoFn()
+	require.NoError(t, err)
+	in, err := static.NewUsernamePasswordCredential(store.GetPublicId(), "user", "pass")
+	require.NoError(t, err)
+	v1, err := repo.CreateUsernamePasswordCredential(context.Background(), prj.GetPublicId(), in)
+	require.NoError(t, err)
+	upd, err := static.NewUsernamePasswordCredential(store.GetPublicId(), "user", "new-pass")
+	require.NoError(t, err)
+	upd.PublicId = v1.GetPublicId()
+	v2, _, err := repo.UpdateUsernamePasswordCredential(context.Background(), prj.GetPublicId(), upd, v1.GetVersion(), []string{"Password"})
+	require.NoError(t, err)
+	require.NotEqual(t, v1.GetPasswordHmac(), v2.GetPasswordHmac())
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	list, err := s.ListCredentialVersions(ctx, &pbs.ListCredentialVersionsRequest{Id: v1.GetPublicId()})
+	require.NoError(t, err)
+	require.Len(t, list.GetItems(), 2)
+	assert.Equal(t, uint32(2), list.GetItems()[0].GetVersion())
+	assert.Equal(t, uint32(1), list.GetItems()[1].GetVersion())
+	assert.NotNil(t, list.GetItems()[0].GetCreatedTime())
+
+	got, err := s.GetCredential(ctx, &pbs.GetCredentialRequest{Id: v1.GetPublicId(), Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), got.GetItem().GetVersion())
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(v1.GetPasswordHmac()), got.GetItem().GetUsernamePasswordAttributes().GetPasswordHmac())
+
+	_, err = s.GetCredential(ctx, &pbs.GetCredentialRequest{Id: v1.GetPublicId(), Version: 9})
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "GetCredential got error %v, wanted not found", err)
+
+	rollbackCases := []struct {
+		name string
+		req  *pbs.RollbackCredentialRequest
+		err  error
+	}{
+		{
+			name: "missing version",
+			req:  &pbs.RollbackCredentialRequest{Id: v1.GetPublicId(), ToVersion: 1},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing to version",
+			req:  &pbs.RollbackCredentialRequest{Id: v1.GetPublicId(), Version: 2},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "to version not older",
+			req:  &pbs.RollbackCredentialRequest{Id: v1.GetPublicId(), Version: 2, ToVersion: 2},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "to version not retained",
+			req:  &pbs.RollbackCredentialRequest{Id: v1.GetPublicId(), Version: 9, ToVersion: 8},
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "version mismatch",
+			req:  &pbs.RollbackCredentialRequest{Id: v1.GetPublicId(), Version: 3, ToVersion: 1},
+			err:  handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+	}
+	for _, tc := range rollbackCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, gErr := s.RollbackCredential(ctx, tc.req)
+			require.Error(t, gErr)
+			assert.True(t, errors.Is(gErr, tc.err), "RollbackCredential(%q) got error %v, wanted %v", tc.req.GetId(), gErr, tc.err)
+		})
+	}
+
+	rolledBack, err := s.RollbackCredential(ctx, &pbs.RollbackCredentialRequest{Id: v1.GetPublicId(), Version: 2, ToVersion: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), rolledBack.GetItem().GetVersion())
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(v1.GetPasswordHmac()), rolledBack.GetItem().GetUsernamePasswordAttributes().GetPasswordHmac())
+
+	list, err = s.ListCredentialVersions(ctx, &pbs.ListCredentialVersionsRequest{Id: v1.GetPublicId()})
+	require.NoError(t, err)
+	assert.Len(t, list.GetItems(), 3)
+}
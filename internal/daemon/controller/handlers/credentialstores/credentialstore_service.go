@@ -8,9 +8,16 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	awsstore "github.com/hashicorp/boundary/internal/credential/aws/store"
+	"github.com/hashicorp/boundary/internal/credential/kubernetes"
+	kubestore "github.com/hashicorp/boundary/internal/credential/kubernetes/store"
+	"github.com/hashicorp/boundary/internal/credential/plugin"
+	pluginstore "github.com/hashicorp/boundary/internal/credential/plugin/store"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
@@ -23,14 +30,19 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/perms"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -41,11 +53,23 @@ const (
 	caCertsField           = "attributes.ca_cert"
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
+	kubeApiUrlField        = "attributes.api_url"
+	kubeTokenField         = "attributes.token"
+	kubeTokenHmacField     = "attributes.token_hmac"
+	awsRegionField         = "attributes.region"
+	awsStsEndpointField    = "attributes.sts_endpoint"
+	awsSigninEndpointField = "attributes.signin_endpoint"
+	awsAccessKeyIdField    = "attributes.access_key_id"
+	awsSecretKeyField      = "attributes.secret_access_key"
+	awsSecretKeyHmacField  = "attributes.secret_access_key_hmac"
 	domain                 = "credential"
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager           handlers.MaskManager
+	pluginMaskManager     handlers.MaskManager
+	kubernetesMaskManager handlers.MaskManager
+	awsMaskManager        handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -54,6 +78,7 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.RotateToken,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -69,6 +94,15 @@ var (
 	staticCollectionTypeMap = map[resource.Type]action.ActionSet{
 		resource.Credential: credentials.CollectionActions,
 	}
+	pluginCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
+	kubernetesCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
+	awsCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
 	validateVaultWorkerFilterFn = vaultWorkerFilterUnsupported
 	vaultWorkerFilterToProto    = false
 )
@@ -83,15 +117,30 @@ func init() {
 		handlers.MaskSource{&pb.CredentialStore{}, &pb.VaultCredentialStoreAttributes{}}); err != nil {
 		panic(err)
 	}
+	if pluginMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&pluginstore.CredentialStore{}}, handlers.MaskSource{&pb.CredentialStore{}}); err != nil {
+		panic(err)
+	}
+	if kubernetesMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&kubestore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}, &pb.KubernetesCredentialStoreAttributes{}}); err != nil {
+		panic(err)
+	}
+	if awsMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&awsstore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}, &pb.AwsCredentialStoreAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialStoreServiceServer interface.
 type Service struct {
 	pbs.UnsafeCredentialStoreServiceServer
 
-	iamRepoFn    common.IamRepoFactory
-	vaultRepoFn  common.VaultCredentialRepoFactory
-	staticRepoFn common.StaticCredentialRepoFactory
+	iamRepoFn        common.IamRepoFactory
+	vaultRepoFn      common.VaultCredentialRepoFactory
+	staticRepoFn     common.StaticCredentialRepoFactory
+	pluginRepoFn     common.PluginCredentialRepoFactory
+	kubeRepoFn       common.KubernetesCredentialRepoFactory
+	awsRepoFn        common.AwsCredentialRepoFactory
+	credPluginRepoFn common.CredentialPluginRepoFactory
 }
 
 var _ pbs.CredentialStoreServiceServer = (*Service)(nil)
@@ -101,6 +150,10 @@ func NewService(
 	ctx context.Context,
 	vaultRepo common.VaultCredentialRepoFactory,
 	staticRepo common.StaticCredentialRepoFactory,
+	pluginRepo common.PluginCredentialRepoFactory,
+	kubeRepo common.KubernetesCredentialRepoFactory,
+	awsRepo common.AwsCredentialRepoFactory,
+	credPluginRepo common.CredentialPluginRepoFactory,
 	iamRepo common.IamRepoFactory,
 ) (Service, error) {
 	const op = "credentialstores.NewService"
@@ -113,7 +166,27 @@ func NewService(
 	if staticRepo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, vaultRepoFn: vaultRepo, staticRepoFn: staticRepo}, nil
+	if pluginRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin credential repository")
+	}
+	if kubeRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing kubernetes credential repository")
+	}
+	if awsRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing aws credential repository")
+	}
+	if credPluginRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential plugin repository")
+	}
+	return Service{
+		iamRepoFn:        iamRepo,
+		vaultRepoFn:      vaultRepo,
+		staticRepoFn:     staticRepo,
+		pluginRepoFn:     pluginRepo,
+		kubeRepoFn:       kubeRepo,
+		awsRepoFn:        awsRepo,
+		credPluginRepoFn: credPluginRepo,
+	}, nil
 }
 
 // ListCredentialStores implements the interface pbs.CredentialStoreServiceServer
@@ -145,7 +218,7 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 		return &pbs.ListCredentialStoresResponse{}, nil
 	}
 
-	csl, err := s.listFromRepo(ctx, scopeIds)
+	csl, pluginInfoMap, err := s.listFromRepo(ctx, scopeIds)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +258,11 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 			}
 			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 		}
+		if cs, ok := item.(*plugin.CredentialStore); ok {
+			if plgInfo, ok := pluginInfoMap[cs.GetPluginId()]; ok {
+				outputOpts = append(outputOpts, handlers.WithPlugin(plgInfo))
+			}
+		}
 
 		item, err := toProto(ctx, item, outputOpts...)
 		if err != nil {
@@ -213,7 +291,7 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cs, err := s.getFromRepo(ctx, req.GetId())
+	cs, plg, err := s.getFromRepo(ctx, req.GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +316,9 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
 
 	item, err := toProto(ctx, cs, outputOpts...)
 	if err != nil {
@@ -258,7 +339,7 @@ func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCrede
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cs, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	cs, plg, err := s.createInRepo(ctx, authResults.Scope.GetId(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -283,6 +364,9 @@ func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCrede
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
 
 	item, err := toProto(ctx, cs, outputOpts...)
 	if err != nil {
@@ -306,7 +390,7 @@ func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCrede
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cs, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	cs, plg, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +415,9 @@ func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCrede
 		}
 		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
 
 	item, err := toProto(ctx, cs, outputOpts...)
 	if err != nil {
@@ -356,159 +443,422 @@ func (s Service) DeleteCredentialStore(ctx context.Context, req *pbs.DeleteCrede
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]credential.Store, error) {
+// RotateCredentialStoreToken implements the interface pbs.CredentialStoreServiceServer.
+func (s Service) RotateCredentialStoreToken(ctx context.Context, req *pbs.RotateCredentialStoreTokenRequest) (*pbs.RotateCredentialStoreTokenResponse, error) {
+	const op = "credentialstores.(Service).RotateCredentialStoreToken"
+
+	if err := validateRotateTokenRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RotateToken)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.vaultRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cs, err := repo.RotateToken(ctx, req.GetId(), req.GetVersion(), vault.TokenSecret(req.GetToken()))
+	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.RecordNotFound), err):
+			return nil, handlers.NotFoundErrorf("Credential store %q not found.", req.GetId())
+		case errors.Match(errors.T(errors.VersionMismatch), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Version %d is not the current version of credential store %q.", req.GetVersion(), req.GetId())
+		case errors.Match(errors.T(errors.VaultToken), err), errors.Match(errors.T(errors.InvalidParameter), err):
+			return nil, handlers.InvalidArgumentErrorf("Unable to rotate the credential store token.", map[string]string{"token": err.Error()})
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to rotate credential store token"))
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
+	}
+	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+		collectionActions, err := calculateAuthorizedCollectionActions(ctx, authResults, cs.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+	}
+
+	item, err := toProto(ctx, cs, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RotateCredentialStoreTokenResponse{Item: item}, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]credential.Store, map[string]*plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).listFromRepo"
 
 	vaultRepo, err := s.vaultRepoFn()
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	vaultCsl, err := vaultRepo.ListCredentialStores(ctx, scopeIds)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 
 	staticRepo, err := s.staticRepoFn()
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	staticCsl, err := staticRepo.ListCredentialStores(ctx, scopeIds)
 	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	pluginRepo, err := s.pluginRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	pluginCsl, plgs, err := pluginRepo.ListCredentialStores(ctx, scopeIds)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	kubeRepo, err := s.kubeRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	kubeCsl, err := kubeRepo.ListCredentialStores(ctx, scopeIds)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 
-	csl := make([]credential.Store, 0, len(staticCsl)+len(vaultCsl))
+	awsRepo, err := s.awsRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	awsCsl, err := awsRepo.ListCredentialStores(ctx, scopeIds)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	csl := make([]credential.Store, 0, len(staticCsl)+len(vaultCsl)+len(pluginCsl)+len(kubeCsl)+len(awsCsl))
 	for _, s := range vaultCsl {
 		csl = append(csl, s)
 	}
 	for _, s := range staticCsl {
 		csl = append(csl, s)
 	}
+	for _, s := range pluginCsl {
+		csl = append(csl, s)
+	}
+	for _, s := range kubeCsl {
+		csl = append(csl, s)
+	}
+	for _, s := range awsCsl {
+		csl = append(csl, s)
+	}
 
-	return csl, nil
+	pluginInfoMap := make(map[string]*plugins.PluginInfo, len(plgs))
+	for _, plg := range plgs {
+		pluginInfoMap[plg.GetPublicId()] = toPluginInfo(plg)
+	}
+	return csl, pluginInfoMap, nil
 }
 
-func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
+func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, *plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).getFromRepo"
 
 	switch subtypes.SubtypeFromId(domain, id) {
 	case vault.Subtype:
 		repo, err := s.vaultRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs, err := repo.LookupCredentialStore(ctx, id)
 		if err != nil && !errors.IsNotFoundError(err) {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		if cs != nil {
-			return cs, nil
+			return cs, nil, nil
 		}
 
 	case static.Subtype:
 		repo, err := s.staticRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs, err := repo.LookupCredentialStore(ctx, id)
 		if err != nil && !errors.IsNotFoundError(err) {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil, nil
+		}
+
+	case plugin.Subtype:
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs, plg, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, toPluginInfo(plg), nil
+		}
+
+	case kubernetes.Subtype:
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil, nil
+		}
+
+	case credaws.Subtype:
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		if cs != nil {
-			return cs, nil
+			return cs, nil, nil
 		}
 	}
 
-	return nil, handlers.NotFoundErrorf("credential store %q not found", id)
+	return nil, nil, handlers.NotFoundErrorf("credential store %q not found", id)
 }
 
-func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (credential.Store, error) {
+func (s Service) createInRepo(ctx context.Context, projId string, req *pbs.CreateCredentialStoreRequest) (credential.Store, *plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).createInRepo"
+	item := req.GetItem()
 
 	switch item.Type {
 	case vault.Subtype.String():
 		cs, err := toStorageVaultStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		repo, err := s.vaultRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, err := repo.CreateCredentialStore(ctx, cs)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
 		}
-		return out, nil
+		return out, nil, nil
 
 	case static.Subtype.String():
 		cs, err := toStorageStaticStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		repo, err := s.staticRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, err := repo.CreateCredentialStore(ctx, cs)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
 		}
-		return out, nil
+		return out, nil, nil
+
+	case plugin.Subtype.String():
+		pluginId := item.GetPluginId()
+		if pluginId == "" {
+			plgRepo, err := s.credPluginRepoFn()
+			if err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+			plg, err := plgRepo.LookupPluginByName(ctx, req.GetPluginName())
+			if err != nil {
+				return nil, nil, errors.Wrap(ctx, err, op)
+			}
+			if plg == nil {
+				return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "plugin with provided name not found")
+			}
+			pluginId = plg.GetPublicId()
+		}
+		cs, err := toStoragePluginStore(ctx, projId, pluginId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, plg, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, toPluginInfo(plg), nil
+
+	case kubernetes.Subtype.String():
+		cs, err := toStorageKubernetesStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil, nil
+
+	case credaws.Subtype.String():
+		cs, err := toStorageAwsStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil, nil
 
 	default:
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
+		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
 	}
 }
 
-func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
+func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, *plugins.PluginInfo, error) {
 	const op = "credentialstores.(Service).updateInRepo"
 
 	var out credential.Store
+	var plg *plugins.PluginInfo
 	var rowsUpdated int
 
-	dbMask := maskManager.Translate(mask)
-	if len(dbMask) == 0 {
-		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
-	}
-
 	switch subtypes.SubtypeFromId(domain, id) {
 	case vault.Subtype:
+		dbMask := maskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
 		cs, err := toStorageVaultStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs.PublicId = id
 
 		repo, err := s.vaultRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
 
 	case static.Subtype:
+		dbMask := maskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
 		cs, err := toStorageStaticStore(ctx, projId, item)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		cs.PublicId = id
 
 		repo, err := s.staticRepoFn()
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op)
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+
+	case plugin.Subtype:
+		dbMask := pluginMaskManager.Translate(mask, "attributes", "secrets")
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cs, err := toStoragePluginStore(ctx, projId, "", item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		var p *credentialplugin.Plugin
+		out, p, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+		plg = toPluginInfo(p)
+
+	case kubernetes.Subtype:
+		dbMask := kubernetesMaskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cs, err := toStorageKubernetesStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
 		}
 		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
 		if err != nil {
-			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+
+	case credaws.Subtype:
+		dbMask := awsMaskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cs, err := toStorageAwsStore(ctx, projId, item)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
 	}
 	if rowsUpdated == 0 {
-		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
+		return nil, nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
 	}
-	return out, nil
+	return out, plg, nil
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
@@ -541,6 +891,45 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 			}
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
 		}
+
+	case plugin.Subtype:
+		repo, err := s.pluginRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
+
+	case kubernetes.Subtype:
+		repo, err := s.kubeRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
+
+	case credaws.Subtype:
+		repo, err := s.awsRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -562,6 +951,21 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	pluginRepo, err := s.pluginRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	kubeRepo, err := s.kubeRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	awsRepo, err := s.awsRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -603,6 +1007,42 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cs.GetProjectId()
+
+		case plugin.Subtype:
+			cs, _, err := pluginRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
+
+		case kubernetes.Subtype:
+			cs, err := kubeRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
+
+		case credaws.Subtype:
+			cs, err := awsRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -647,9 +1087,20 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
+	if outputFields.Has(globals.PluginField) {
+		out.Plugin = opts.WithPlugin
+	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
 		out.AuthorizedActions = opts.WithAuthorizedActions
 	}
+	if pluginIn, ok := in.(*plugin.CredentialStore); ok {
+		if outputFields.Has(globals.PluginIdField) {
+			out.PluginId = pluginIn.GetPluginId()
+		}
+		if outputFields.Has(globals.SecretsHmacField) && len(pluginIn.GetSecretsHmac()) > 0 {
+			out.SecretsHmac = base58.Encode(pluginIn.GetSecretsHmac())
+		}
+	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		out.AuthorizedCollectionActions = opts.WithAuthorizedCollectionActions
 	}
@@ -679,6 +1130,15 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 				attrs.TokenHmac = base64.RawURLEncoding.EncodeToString(vaultIn.Token().GetTokenHmac())
 				attrs.TokenStatus = vaultIn.Token().GetStatus()
 			}
+			if h := vaultIn.Health(); h != nil {
+				attrs.TokenLastRenewalTime = timestamppb.New(h.LastRenewalTime)
+				attrs.TokenExpirationTime = timestamppb.New(h.ExpirationTime)
+				if h.Status != "" {
+					attrs.TokenHealthStatus = string(h.Status)
+					attrs.TokenHealthCheckTime = timestamppb.New(h.CheckTime)
+				}
+				attrs.TokenMissingCapabilities = h.MissingCapabilities
+			}
 			if vaultIn.GetWorkerFilter() != "" {
 				if vaultWorkerFilterToProto {
 					attrs.WorkerFilter = wrapperspb.String(vaultIn.GetWorkerFilter())
@@ -694,11 +1154,99 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
 			}
+
+		case plugin.Subtype:
+			pluginIn, ok := in.(*plugin.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to plugin credential store")
+			}
+			attrs := &structpb.Struct{}
+			if err := proto.Unmarshal(pluginIn.GetAttributes(), attrs); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			if len(attrs.GetFields()) > 0 {
+				out.Attrs = &pb.CredentialStore_Attributes{
+					Attributes: attrs,
+				}
+			}
+
+		case kubernetes.Subtype:
+			kubeIn, ok := in.(*kubernetes.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to kubernetes credential store")
+			}
+			attrs := &pb.KubernetesCredentialStoreAttributes{
+				ApiUrl: wrapperspb.String(kubeIn.GetApiUrl()),
+			}
+			if len(kubeIn.GetCaCert()) != 0 {
+				attrs.CaCert = wrapperspb.String(string(kubeIn.GetCaCert()))
+			}
+			if len(kubeIn.GetTokenHmac()) != 0 {
+				attrs.TokenHmac = base64.RawURLEncoding.EncodeToString(kubeIn.GetTokenHmac())
+			}
+			out.Attrs = &pb.CredentialStore_KubernetesCredentialStoreAttributes{
+				KubernetesCredentialStoreAttributes: attrs,
+			}
+
+		case credaws.Subtype:
+			awsIn, ok := in.(*credaws.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to aws credential store")
+			}
+			attrs := &pb.AwsCredentialStoreAttributes{
+				Region:      wrapperspb.String(awsIn.GetRegion()),
+				AccessKeyId: wrapperspb.String(awsIn.GetAccessKeyId()),
+			}
+			if awsIn.GetStsEndpoint() != "" {
+				attrs.StsEndpoint = wrapperspb.String(awsIn.GetStsEndpoint())
+			}
+			if awsIn.GetSigninEndpoint() != "" {
+				attrs.SigninEndpoint = wrapperspb.String(awsIn.GetSigninEndpoint())
+			}
+			if len(awsIn.GetSecretAccessKeyHmac()) != 0 {
+				attrs.SecretAccessKeyHmac = base64.RawURLEncoding.EncodeToString(awsIn.GetSecretAccessKeyHmac())
+			}
+			out.Attrs = &pb.CredentialStore_AwsCredentialStoreAttributes{
+				AwsCredentialStoreAttributes: attrs,
+			}
 		}
 	}
 	return &out, nil
 }
 
+func toPluginInfo(plg *credentialplugin.Plugin) *plugins.PluginInfo {
+	if plg == nil {
+		return nil
+	}
+	return &plugins.PluginInfo{
+		Id:          plg.GetPublicId(),
+		Name:        plg.GetName(),
+		Description: plg.GetDescription(),
+	}
+}
+
+func toStoragePluginStore(ctx context.Context, projectId, plgId string, in *pb.CredentialStore) (*plugin.CredentialStore, error) {
+	const op = "credentialstores.toStoragePluginStore"
+	var opts []plugin.Option
+	if in.GetName() != nil {
+		opts = append(opts, plugin.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, plugin.WithDescription(in.GetDescription().GetValue()))
+	}
+	if attrs := in.GetAttributes(); attrs != nil {
+		opts = append(opts, plugin.WithAttributes(attrs))
+	}
+	if secrets := in.GetSecrets(); secrets != nil {
+		opts = append(opts, plugin.WithSecrets(secrets))
+	}
+	cs, err := plugin.NewCredentialStore(ctx, projectId, plgId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store"))
+	}
+	return cs, nil
+}
+
 func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *static.CredentialStore, err error) {
 	const op = "credentialstores.toStorageStaticStore"
 	var opts []static.Option
@@ -772,13 +1320,60 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 	return cs, err
 }
 
+func toStorageKubernetesStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (*kubernetes.CredentialStore, error) {
+	const op = "credentialstores.toStorageKubernetesStore"
+	var opts []kubernetes.Option
+	if in.GetName() != nil {
+		opts = append(opts, kubernetes.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, kubernetes.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetKubernetesCredentialStoreAttributes()
+	if attrs.GetCaCert() != nil {
+		opts = append(opts, kubernetes.WithCaCert([]byte(attrs.GetCaCert().GetValue())))
+	}
+
+	cs, err := kubernetes.NewCredentialStore(scopeId, attrs.GetApiUrl().GetValue(), []byte(attrs.GetToken().GetValue()), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store"))
+	}
+	return cs, nil
+}
+
+func toStorageAwsStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (*credaws.CredentialStore, error) {
+	const op = "credentialstores.toStorageAwsStore"
+	var opts []credaws.Option
+	if in.GetName() != nil {
+		opts = append(opts, credaws.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, credaws.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetAwsCredentialStoreAttributes()
+	if attrs.GetStsEndpoint() != nil {
+		opts = append(opts, credaws.WithStsEndpoint(attrs.GetStsEndpoint().GetValue()))
+	}
+	if attrs.GetSigninEndpoint() != nil {
+		opts = append(opts, credaws.WithSigninEndpoint(attrs.GetSigninEndpoint().GetValue()))
+	}
+
+	cs, err := credaws.NewCredentialStore(scopeId, attrs.GetRegion().GetValue(), attrs.GetAccessKeyId().GetValue(), []byte(attrs.GetSecretAccessKey().GetValue()), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store"))
+	}
+	return cs, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.PluginCredentialStorePrefix, globals.KubernetesCredentialStorePrefix, globals.AwsCredentialStorePrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRequest) error {
@@ -787,6 +1382,20 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 		if !handlers.ValidId(handlers.Id(req.GetItem().GetScopeId()), scope.Project.Prefix()) {
 			badFields["scope_id"] = "This field must be a valid project scope id."
 		}
+		if req.GetItem().GetSecretsHmac() != "" {
+			badFields[globals.SecretsHmacField] = "This is a read only field."
+		}
+		if subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != plugin.Subtype {
+			if req.GetItem().GetPluginId() != "" || req.GetPluginName() != "" {
+				badFields[globals.PluginIdField] = "This field is only supported for plugin credential stores."
+			}
+			if req.GetItem().GetAttributes() != nil {
+				badFields[globals.AttributesField] = "Generic attributes are only supported for plugin credential stores."
+			}
+			if req.GetItem().GetSecrets() != nil {
+				badFields["secrets"] = "This field is only supported for plugin credential stores."
+			}
+		}
 		switch subtypes.SubtypeFromType(domain, req.GetItem().GetType()) {
 		case vault.Subtype:
 			attrs := req.GetItem().GetVaultCredentialStoreAttributes()
@@ -827,6 +1436,65 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			}
 		case static.Subtype:
 			// No additional validation required for static credential store
+		case kubernetes.Subtype:
+			attrs := req.GetItem().GetKubernetesCredentialStoreAttributes()
+			if attrs == nil {
+				badFields[globals.AttributesField] = "Attributes are required for creating a kubernetes credential store"
+			}
+			if attrs.GetApiUrl().GetValue() == "" {
+				badFields[kubeApiUrlField] = "Field required for creating a kubernetes credential store."
+			} else if err := validateKubernetesApiUrl(attrs.GetApiUrl().GetValue()); err != nil {
+				badFields[kubeApiUrlField] = err.Error()
+			}
+			if attrs.GetToken().GetValue() == "" {
+				badFields[kubeTokenField] = "Field required for creating a kubernetes credential store."
+			}
+			if attrs.GetTokenHmac() != "" {
+				badFields[kubeTokenHmacField] = "This is a read only field."
+			}
+			_, err := decodePemBlocks(ctx, attrs.GetCaCert().GetValue())
+			if attrs.GetCaCert() != nil && err != nil {
+				badFields[caCertsField] = "Incorrectly formatted value."
+			}
+		case credaws.Subtype:
+			attrs := req.GetItem().GetAwsCredentialStoreAttributes()
+			if attrs == nil {
+				badFields[globals.AttributesField] = "Attributes are required for creating an aws credential store"
+			}
+			if attrs.GetRegion().GetValue() == "" {
+				badFields[awsRegionField] = "Field required for creating an aws credential store."
+			}
+			if attrs.GetAccessKeyId().GetValue() == "" {
+				badFields[awsAccessKeyIdField] = "Field required for creating an aws credential store."
+			}
+			if attrs.GetSecretAccessKey().GetValue() == "" {
+				badFields[awsSecretKeyField] = "Field required for creating an aws credential store."
+			}
+			if attrs.GetSecretAccessKeyHmac() != "" {
+				badFields[awsSecretKeyHmacField] = "This is a read only field."
+			}
+			if attrs.GetStsEndpoint() != nil {
+				if err := validateAwsEndpoint(attrs.GetStsEndpoint().GetValue()); err != nil {
+					badFields[awsStsEndpointField] = err.Error()
+				}
+			}
+			if attrs.GetSigninEndpoint() != nil {
+				if err := validateAwsEndpoint(attrs.GetSigninEndpoint().GetValue()); err != nil {
+					badFields[awsSigninEndpointField] = err.Error()
+				}
+			}
+		case plugin.Subtype:
+			if req.GetItem().GetPlugin() != nil {
+				badFields[globals.PluginField] = "This is a read only field."
+			}
+			if req.GetItem().GetPluginId() == "" && req.GetPluginName() == "" {
+				badFields[globals.PluginIdField] = "This or plugin name is a required field."
+				badFields[globals.PluginNameField] = "This or plugin id is a required field."
+			}
+			if req.GetItem().GetPluginId() != "" && req.GetPluginName() != "" {
+				badFields[globals.PluginIdField] = "Can't set the plugin name field along with this field."
+				badFields[globals.PluginNameField] = "Can't set the plugin id field along with this field."
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -837,6 +1505,9 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRequest) error {
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
+		if req.GetItem().GetSecretsHmac() != "" {
+			badFields[globals.SecretsHmacField] = "This is a read only field."
+		}
 		switch subtypes.SubtypeFromId(domain, req.GetId()) {
 		case vault.Subtype:
 			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != vault.Subtype {
@@ -873,13 +1544,121 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 					badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 				}
 			}
+		case plugin.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != plugin.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetPlugin() != nil {
+				badFields[globals.PluginField] = "This is a read only field."
+			}
+			if req.GetItem().GetPluginId() != "" {
+				badFields[globals.PluginIdField] = "This field cannot be updated."
+			}
+		case kubernetes.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != kubernetes.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			attrs := req.GetItem().GetKubernetesCredentialStoreAttributes()
+			if attrs != nil {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), kubeApiUrlField) {
+					if attrs.GetApiUrl().GetValue() == "" {
+						badFields[kubeApiUrlField] = "This is a required field and cannot be unset."
+					} else if err := validateKubernetesApiUrl(attrs.GetApiUrl().GetValue()); err != nil {
+						badFields[kubeApiUrlField] = err.Error()
+					}
+				}
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), kubeTokenField) &&
+					attrs.GetToken().GetValue() == "" {
+					badFields[kubeTokenField] = "This is a required field and cannot be unset."
+				}
+				if attrs.GetTokenHmac() != "" {
+					badFields[kubeTokenHmacField] = "This is a read only field."
+				}
+				_, err := decodePemBlocks(ctx, attrs.GetCaCert().GetValue())
+				if attrs.GetCaCert() != nil && err != nil {
+					badFields[caCertsField] = "Incorrectly formatted value."
+				}
+			}
+		case credaws.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != credaws.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			attrs := req.GetItem().GetAwsCredentialStoreAttributes()
+			if attrs != nil {
+				paths := req.GetUpdateMask().GetPaths()
+				if handlers.MaskContains(paths, awsRegionField) && attrs.GetRegion().GetValue() == "" {
+					badFields[awsRegionField] = "This is a required field and cannot be unset."
+				}
+				if handlers.MaskContains(paths, awsAccessKeyIdField) && attrs.GetAccessKeyId().GetValue() == "" {
+					badFields[awsAccessKeyIdField] = "This is a required field and cannot be unset."
+				}
+				if handlers.MaskContains(paths, awsSecretKeyField) && attrs.GetSecretAccessKey().GetValue() == "" {
+					badFields[awsSecretKeyField] = "This is a required field and cannot be unset."
+				}
+				if attrs.GetSecretAccessKeyHmac() != "" {
+					badFields[awsSecretKeyHmacField] = "This is a read only field."
+				}
+				if attrs.GetStsEndpoint() != nil {
+					if err := validateAwsEndpoint(attrs.GetStsEndpoint().GetValue()); err != nil {
+						badFields[awsStsEndpointField] = err.Error()
+					}
+				}
+				if attrs.GetSigninEndpoint() != nil {
+					if err := validateAwsEndpoint(attrs.GetSigninEndpoint().GetValue()); err != nil {
+						badFields[awsSigninEndpointField] = err.Error()
+					}
+				}
+			}
 		}
 		return badFields
-	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.PluginCredentialStorePrefix, globals.KubernetesCredentialStorePrefix, globals.AwsCredentialStorePrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.PluginCredentialStorePrefix, globals.KubernetesCredentialStorePrefix, globals.AwsCredentialStorePrefix)
+}
+
+func validateRotateTokenRequest(req *pbs.RotateCredentialStoreTokenRequest) error {
+	return handlers.ValidateGetRequest(
+		func() map[string]string {
+			badFields := map[string]string{}
+			if req.GetVersion() == 0 {
+				badFields[globals.VersionField] = "Required field."
+			}
+			if req.GetToken() == "" {
+				badFields["token"] = "Required field."
+			}
+			return badFields
+		},
+		req,
+		globals.VaultCredentialStorePrefix,
+	)
+}
+
+// validateKubernetesApiUrl returns an error if u is not an absolute http or
+// https url.
+func validateKubernetesApiUrl(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("Incorrectly formatted value.")
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return fmt.Errorf("Must be an http or https url.")
+	}
+	return nil
+}
+
+// validateAwsEndpoint returns an error if u is not an absolute http or https
+// url.
+func validateAwsEndpoint(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("Incorrectly formatted value.")
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return fmt.Errorf("Must be an http or https url.")
+	}
+	return nil
 }
 
 func validateListRequest(req *pbs.ListCredentialStoresRequest) error {
@@ -906,6 +1685,15 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	case static.Subtype:
 		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, staticCollectionTypeMap, authResults.Scope.Id, id)
+
+	case plugin.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, pluginCollectionTypeMap, authResults.Scope.Id, id)
+
+	case kubernetes.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, kubernetesCollectionTypeMap, authResults.Scope.Id, id)
+
+	case credaws.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, awsCollectionTypeMap, authResults.Scope.Id, id)
 	}
 	if err != nil {
 		return nil, err
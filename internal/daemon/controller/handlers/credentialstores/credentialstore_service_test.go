@@ -15,6 +15,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	credkube "github.com/hashicorp/boundary/internal/credential/kubernetes"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -24,10 +27,12 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	credentialplugin "github.com/hashicorp/boundary/internal/plugin/credential"
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -40,7 +45,7 @@ import (
 )
 
 var (
-	testAuthorizedActions                = []string{"no-op", "read", "update", "delete"}
+	testAuthorizedActions                = []string{"no-op", "read", "update", "delete", "rotate-token"}
 	testAuthorizedVaultCollectionActions = map[string]*structpb.ListValue{
 		"credential-libraries": {
 			Values: []*structpb.Value{
@@ -57,6 +62,10 @@ var (
 			},
 		},
 	}
+
+	// ignoreVaultTokenTimes ignores the times of the vault token of a
+	// credential store which are set by the database.
+	ignoreVaultTokenTimes = protocmp.IgnoreFields(&pb.VaultCredentialStoreAttributes{}, "token_last_renewal_time", "token_expiration_time")
 )
 
 func TestList(t *testing.T) {
@@ -79,6 +88,18 @@ func TestList(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prjNoStores := iam.TestScopes(t, iamRepo)
 	_, prj := iam.TestScopes(t, iamRepo)
@@ -173,7 +194,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -184,7 +205,7 @@ func TestList(t *testing.T) {
 				return
 			}
 			require.NoError(t, gErr)
-			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform(), protocmp.SortRepeated(func(x, y *pb.CredentialStore) bool {
+			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform(), ignoreVaultTokenTimes, protocmp.SortRepeated(func(x, y *pb.CredentialStore) bool {
 				return x.Id < y.Id
 			})))
 
@@ -221,6 +242,18 @@ func TestCreateVault(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	defaultCreated := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0].GetCreateTime().GetTimestamp()
@@ -532,7 +565,7 @@ func TestCreateVault(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -602,6 +635,18 @@ func TestCreateStatic(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	defaultCreated := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
@@ -709,7 +754,7 @@ func TestCreateStatic(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+			s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -768,13 +813,25 @@ func TestGet(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
 	staticStorePrev := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), credstatic.WithPublicId(fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePreviousPrefix)))
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -870,7 +927,11 @@ func TestGet(t *testing.T) {
 				return
 			}
 			require.NoError(t, gErr)
-			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform()))
+			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform(), ignoreVaultTokenTimes))
+			if attrs := got.GetItem().GetVaultCredentialStoreAttributes(); attrs != nil {
+				assert.NotNil(t, attrs.GetTokenLastRenewalTime())
+				assert.NotNil(t, attrs.GetTokenExpirationTime())
+			}
 
 			// Test anonymous get
 			got, gErr = s.GetCredentialStore(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId(), auth.WithUserId(globals.AnonymousUserId)), req)
@@ -902,12 +963,24 @@ func TestDelete(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
 	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -976,11 +1049,23 @@ func TestUpdateVault(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1220,7 +1305,7 @@ func TestUpdateVault(t *testing.T) {
 				got.Item.GetVaultCredentialStoreAttributes().ClientCertificateKeyHmac = "<hmac>"
 			}
 
-			assert.Empty(cmp.Diff(got, want, protocmp.Transform()))
+			assert.Empty(cmp.Diff(got, want, protocmp.Transform(), ignoreVaultTokenTimes))
 		})
 	}
 
@@ -1292,6 +1377,117 @@ func TestUpdateVault(t *testing.T) {
 	}
 }
 
+func TestRotateTokenVault(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+	err := vault.RegisterJobs(context.Background(), sche, rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(context.Background(), rw, rw, kms)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	v := vault.NewTestVaultServer(t)
+	secret, token := v.CreateToken(t)
+	st := vault.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), v.Addr, token, secret.Auth.Accessor)
+	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	_, newToken := v.CreateToken(t)
+	_, missingCapsToken := v.CreateToken(t, vault.WithPolicies([]string{"default"}))
+
+	cases := []struct {
+		name    string
+		req     *pbs.RotateCredentialStoreTokenRequest
+		errCode codes.Code
+	}{
+		{
+			name:    "missing-version",
+			req:     &pbs.RotateCredentialStoreTokenRequest{Id: st.GetPublicId(), Token: newToken},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "missing-token",
+			req:     &pbs.RotateCredentialStoreTokenRequest{Id: st.GetPublicId(), Version: st.GetVersion()},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "static-store",
+			req:     &pbs.RotateCredentialStoreTokenRequest{Id: staticStore.GetPublicId(), Version: staticStore.GetVersion(), Token: newToken},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "not-found",
+			req:     &pbs.RotateCredentialStoreTokenRequest{Id: globals.VaultCredentialStorePrefix + "_DoesntExis", Version: 1, Token: newToken},
+			errCode: codes.NotFound,
+		},
+		{
+			name:    "wrong-version",
+			req:     &pbs.RotateCredentialStoreTokenRequest{Id: st.GetPublicId(), Version: st.GetVersion() + 1, Token: newToken},
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "missing-capabilities",
+			req:     &pbs.RotateCredentialStoreTokenRequest{Id: st.GetPublicId(), Version: st.GetVersion(), Token: missingCapsToken},
+			errCode: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, gErr := s.RotateCredentialStoreToken(ctx, tc.req)
+			require.Error(t, gErr)
+			assert.Truef(t, errors.Is(gErr, handlers.ApiErrorWithCode(tc.errCode)), "RotateCredentialStoreToken(%+v) got error %v, wanted %v", tc.req, gErr, tc.errCode)
+			assert.Nil(t, got)
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.RotateCredentialStoreToken(ctx, &pbs.RotateCredentialStoreTokenRequest{
+			Id:      st.GetPublicId(),
+			Version: st.GetVersion(),
+			Token:   newToken,
+		})
+		require.NoError(err)
+		require.NotNil(got)
+		item := got.GetItem()
+		assert.Equal(st.GetPublicId(), item.GetId())
+		assert.Equal(st.GetVersion()+1, item.GetVersion())
+		assert.Equal(testAuthorizedActions, item.GetAuthorizedActions())
+		attrs := item.GetVaultCredentialStoreAttributes()
+		require.NotNil(attrs)
+		assert.Equal(string(vault.CurrentToken), attrs.GetTokenStatus())
+		assert.NotEqual(base64.RawURLEncoding.EncodeToString(st.Token().GetTokenHmac()), attrs.GetTokenHmac())
+	})
+}
+
 func TestUpdateStatic(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrapper := db.TestWrapper(t)
@@ -1309,11 +1505,23 @@ func TestUpdateStatic(t *testing.T) {
 	staticRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, iamRepoFn)
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1466,3 +1674,402 @@ func TestUpdateStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestCrudPlugin(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(context.Background(), rw, rw, kms)
+	}
+	plg := credentialplugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.CredentialPluginServiceClient{
+		plg.GetPublicId(): credplugin.NewWrappingPluginClient(credplugin.NewLoopbackPlugin()),
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, plgm)
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	attrs, err := structpb.NewStruct(map[string]any{"foo": "bar"})
+	require.NoError(t, err)
+	secrets, err := structpb.NewStruct(map[string]any{"key": "secret"})
+	require.NoError(t, err)
+
+	t.Run("create-missing-plugin", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credplugin.Subtype.String(),
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("create-with-plugin-id-and-name", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{
+			PluginName: plg.GetName(),
+			Item: &pb.CredentialStore{
+				ScopeId:  prj.GetPublicId(),
+				Type:     credplugin.Subtype.String(),
+				PluginId: plg.GetPublicId(),
+			},
+		})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("attributes-on-non-plugin-store", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credstatic.Subtype.String(),
+			Attrs:   &pb.CredentialStore_Attributes{Attributes: attrs},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{
+		PluginName: plg.GetName(),
+		Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credplugin.Subtype.String(),
+			Name:    wrapperspb.String("plugin-store"),
+			Attrs:   &pb.CredentialStore_Attributes{Attributes: attrs},
+			Secrets: secrets,
+		},
+	})
+	require.NoError(err)
+	item := created.GetItem()
+	assert.True(strings.HasPrefix(item.GetId(), globals.PluginCredentialStorePrefix+"_"))
+	assert.Equal(credplugin.Subtype.String(), item.GetType())
+	assert.Equal(plg.GetPublicId(), item.GetPluginId())
+	assert.Equal(plg.GetName(), item.GetPlugin().GetName())
+	assert.NotEmpty(item.GetSecretsHmac())
+	assert.Nil(item.GetSecrets())
+	assert.Empty(cmp.Diff(attrs, item.GetAttributes(), protocmp.Transform()))
+
+	got, err := s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: item.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(item, got.GetItem(), protocmp.Transform()))
+
+	list, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: prj.GetPublicId()})
+	require.NoError(err)
+	require.Len(list.GetItems(), 1)
+	assert.Equal(item.GetId(), list.GetItems()[0].GetId())
+	assert.Equal(plg.GetPublicId(), list.GetItems()[0].GetPlugin().GetId())
+
+	updated, err := s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "attributes.foo"}},
+		Item: &pb.CredentialStore{
+			Version: item.GetVersion(),
+			Name:    wrapperspb.String("updated"),
+			Attrs: &pb.CredentialStore_Attributes{Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"foo": structpb.NewStringValue("baz"),
+			}}},
+		},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updated.GetItem().GetName().GetValue())
+	assert.Equal("baz", updated.GetItem().GetAttributes().GetFields()["foo"].GetStringValue())
+	assert.Equal(item.GetVersion()+1, updated.GetItem().GetVersion())
+
+	_, err = s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"plugin_id"}},
+		Item: &pb.CredentialStore{
+			Version:  updated.GetItem().GetVersion(),
+			PluginId: "pl_1234567890",
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	_, err = s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: item.GetId()})
+	require.NoError(err)
+	_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: item.GetId()})
+	assert.Truef(errors.Is(err, handlers.NotFoundError()), "got error %v, wanted not found", err)
+}
+
+func TestCrudKubernetes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(context.Background(), rw, rw, kms)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	t.Run("create-missing-token", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credkube.Subtype.String(),
+			Attrs: &pb.CredentialStore_KubernetesCredentialStoreAttributes{
+				KubernetesCredentialStoreAttributes: &pb.KubernetesCredentialStoreAttributes{
+					ApiUrl: wrapperspb.String("https://kube.example.com:6443"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("create-bad-api-url", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credkube.Subtype.String(),
+			Attrs: &pb.CredentialStore_KubernetesCredentialStoreAttributes{
+				KubernetesCredentialStoreAttributes: &pb.KubernetesCredentialStoreAttributes{
+					ApiUrl: wrapperspb.String("ftp://kube.example.com"),
+					Token:  wrapperspb.String("admin-token"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+		ScopeId: prj.GetPublicId(),
+		Type:    credkube.Subtype.String(),
+		Name:    wrapperspb.String("kube-store"),
+		Attrs: &pb.CredentialStore_KubernetesCredentialStoreAttributes{
+			KubernetesCredentialStoreAttributes: &pb.KubernetesCredentialStoreAttributes{
+				ApiUrl: wrapperspb.String("https://kube.example.com:6443"),
+				Token:  wrapperspb.String("admin-token"),
+			},
+		},
+	}})
+	require.NoError(err)
+	item := created.GetItem()
+	assert.True(strings.HasPrefix(item.GetId(), globals.KubernetesCredentialStorePrefix+"_"))
+	assert.Equal(credkube.Subtype.String(), item.GetType())
+	attrs := item.GetKubernetesCredentialStoreAttributes()
+	assert.Equal("https://kube.example.com:6443", attrs.GetApiUrl().GetValue())
+	assert.Nil(attrs.GetToken())
+	assert.NotEmpty(attrs.GetTokenHmac())
+
+	got, err := s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: item.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(item, got.GetItem(), protocmp.Transform()))
+
+	list, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: prj.GetPublicId()})
+	require.NoError(err)
+	require.Len(list.GetItems(), 1)
+	assert.Equal(item.GetId(), list.GetItems()[0].GetId())
+
+	updated, err := s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "attributes.token"}},
+		Item: &pb.CredentialStore{
+			Version: item.GetVersion(),
+			Name:    wrapperspb.String("updated"),
+			Attrs: &pb.CredentialStore_KubernetesCredentialStoreAttributes{
+				KubernetesCredentialStoreAttributes: &pb.KubernetesCredentialStoreAttributes{
+					Token: wrapperspb.String("new-admin-token"),
+				},
+			},
+		},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updated.GetItem().GetName().GetValue())
+	assert.NotEqual(attrs.GetTokenHmac(), updated.GetItem().GetKubernetesCredentialStoreAttributes().GetTokenHmac())
+	assert.Equal(item.GetVersion()+1, updated.GetItem().GetVersion())
+
+	_, err = s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.api_url"}},
+		Item: &pb.CredentialStore{
+			Version: updated.GetItem().GetVersion(),
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	_, err = s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: item.GetId()})
+	require.NoError(err)
+	_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: item.GetId()})
+	assert.Truef(errors.Is(err, handlers.NotFoundError()), "got error %v, wanted not found", err)
+}
+
+func TestCrudAws(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	vaultRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(context.Background(), rw, rw, kms)
+	}
+	pluginRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	credPluginRepoFn := func() (*credentialplugin.Repository, error) {
+		return credentialplugin.NewRepository(rw, rw, kms)
+	}
+	kubeRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+
+	s, err := NewService(ctx, vaultRepoFn, staticRepoFn, pluginRepoFn, kubeRepoFn, awsRepoFn, credPluginRepoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	t.Run("create-missing-secret-access-key", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credaws.Subtype.String(),
+			Attrs: &pb.CredentialStore_AwsCredentialStoreAttributes{
+				AwsCredentialStoreAttributes: &pb.AwsCredentialStoreAttributes{
+					Region:      wrapperspb.String("us-east-1"),
+					AccessKeyId: wrapperspb.String("AKIDEXAMPLE"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("create-bad-sts-endpoint", func(t *testing.T) {
+		_, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+			ScopeId: prj.GetPublicId(),
+			Type:    credaws.Subtype.String(),
+			Attrs: &pb.CredentialStore_AwsCredentialStoreAttributes{
+				AwsCredentialStoreAttributes: &pb.AwsCredentialStoreAttributes{
+					Region:          wrapperspb.String("us-east-1"),
+					StsEndpoint:     wrapperspb.String("ftp://sts.example.com"),
+					AccessKeyId:     wrapperspb.String("AKIDEXAMPLE"),
+					SecretAccessKey: wrapperspb.String("secret"),
+				},
+			},
+		}})
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	created, err := s.CreateCredentialStore(ctx, &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+		ScopeId: prj.GetPublicId(),
+		Type:    credaws.Subtype.String(),
+		Name:    wrapperspb.String("aws-store"),
+		Attrs: &pb.CredentialStore_AwsCredentialStoreAttributes{
+			AwsCredentialStoreAttributes: &pb.AwsCredentialStoreAttributes{
+				Region:          wrapperspb.String("us-east-1"),
+				AccessKeyId:     wrapperspb.String("AKIDEXAMPLE"),
+				SecretAccessKey: wrapperspb.String("secret"),
+			},
+		},
+	}})
+	require.NoError(err)
+	item := created.GetItem()
+	assert.True(strings.HasPrefix(item.GetId(), globals.AwsCredentialStorePrefix+"_"))
+	assert.Equal(credaws.Subtype.String(), item.GetType())
+	attrs := item.GetAwsCredentialStoreAttributes()
+	assert.Equal("us-east-1", attrs.GetRegion().GetValue())
+	assert.Equal("AKIDEXAMPLE", attrs.GetAccessKeyId().GetValue())
+	assert.Nil(attrs.GetSecretAccessKey())
+	assert.NotEmpty(attrs.GetSecretAccessKeyHmac())
+
+	got, err := s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: item.GetId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(item, got.GetItem(), protocmp.Transform()))
+
+	list, err := s.ListCredentialStores(ctx, &pbs.ListCredentialStoresRequest{ScopeId: prj.GetPublicId()})
+	require.NoError(err)
+	require.Len(list.GetItems(), 1)
+	assert.Equal(item.GetId(), list.GetItems()[0].GetId())
+
+	updated, err := s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "attributes.sts_endpoint", "attributes.secret_access_key"}},
+		Item: &pb.CredentialStore{
+			Version: item.GetVersion(),
+			Name:    wrapperspb.String("updated"),
+			Attrs: &pb.CredentialStore_AwsCredentialStoreAttributes{
+				AwsCredentialStoreAttributes: &pb.AwsCredentialStoreAttributes{
+					StsEndpoint:     wrapperspb.String("https://sts.example.com"),
+					SecretAccessKey: wrapperspb.String("new-secret"),
+				},
+			},
+		},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updated.GetItem().GetName().GetValue())
+	assert.Equal("https://sts.example.com", updated.GetItem().GetAwsCredentialStoreAttributes().GetStsEndpoint().GetValue())
+	assert.NotEqual(attrs.GetSecretAccessKeyHmac(), updated.GetItem().GetAwsCredentialStoreAttributes().GetSecretAccessKeyHmac())
+	assert.Equal(item.GetVersion()+1, updated.GetItem().GetVersion())
+
+	_, err = s.UpdateCredentialStore(ctx, &pbs.UpdateCredentialStoreRequest{
+		Id:         item.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"attributes.region"}},
+		Item: &pb.CredentialStore{
+			Version: updated.GetItem().GetVersion(),
+			Attrs: &pb.CredentialStore_AwsCredentialStoreAttributes{
+				AwsCredentialStoreAttributes: &pb.AwsCredentialStoreAttributes{},
+			},
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	_, err = s.DeleteCredentialStore(ctx, &pbs.DeleteCredentialStoreRequest{Id: item.GetId()})
+	require.NoError(err)
+	_, err = s.GetCredentialStore(ctx, &pbs.GetCredentialStoreRequest{Id: item.GetId()})
+	assert.Truef(errors.Is(err, handlers.NotFoundError()), "got error %v, wanted not found", err)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/history"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HistoryToProto converts the resource history records returned by the
+// history repository to their API representation.
+func HistoryToProto(in []*history.Record) []*pb.Record {
+	out := make([]*pb.Record, 0, len(in))
+	for _, r := range in {
+		out = append(out, &pb.Record{
+			Id:          r.Id,
+			CreatedTime: timestamppb.New(r.CreateTime),
+			UserId:      r.UserId,
+			Changes:     ChangesToProto(r.Changes),
+		})
+	}
+	return out
+}
+
+// ChangesToProto converts field-level changes to their API representation.
+func ChangesToProto(in []*history.Change) []*pb.Change {
+	out := make([]*pb.Change, 0, len(in))
+	for _, c := range in {
+		out = append(out, &pb.Change{
+			Type:      c.Type,
+			Key:       c.Key,
+			Operation: c.Operation,
+			Field:     c.Field,
+			OldValue:  c.OldValue,
+			NewValue:  c.NewValue,
+		})
+	}
+	return out
+}
+
+// RestoreRequest is implemented by the requests to restore a resource to its
+// state at a point in time.
+type RestoreRequest interface {
+	GetId() string
+	GetTime() *timestamppb.Timestamp
+	GetDryRun() bool
+}
+
+// ValidateRestoreRequest validates the id and the point in time of a restore
+// request.
+func ValidateRestoreRequest(r RestoreRequest, prefix ...string) error {
+	return ValidateGetRequest(func() map[string]string {
+		badFields := map[string]string{}
+		switch {
+		case r.GetTime() == nil:
+			badFields["time"] = "This field is required."
+		case !r.GetTime().IsValid():
+			badFields["time"] = "Invalid timestamp."
+		case r.GetTime().AsTime().After(time.Now()):
+			badFields["time"] = "Cannot restore to a time in the future."
+		}
+		return badFields
+	}, r, prefix...)
+}
+
+// RestoreStates returns the state of the resource with the given id at the
+// point in time at, and its current state, as reconstructed from the oplog.
+// An invalid argument error is returned if the resource did not exist at
+// that time.
+func RestoreStates(ctx context.Context, repo *history.Repository, id string, at time.Time) (want, current *history.State, err error) {
+	notExists := InvalidArgumentErrorf("Error in provided request.", map[string]string{
+		"time": "The resource did not exist at the provided time.",
+	})
+	want, err = repo.ResourceState(ctx, id, at)
+	switch {
+	case errors.IsNotFoundError(err):
+		return nil, nil, notExists
+	case err != nil:
+		return nil, nil, err
+	case !want.Exists():
+		return nil, nil, notExists
+	}
+	current, err = repo.ResourceState(ctx, id, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return want, current, nil
+}
+
+// RestoreDependencyError returns the error reported when a resource which a
+// restored resource depends on no longer exists.
+func RestoreDependencyError(kind, id string) error {
+	return ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Unable to restore: %s %q no longer exists.", kind, id)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	dnsstore "github.com/hashicorp/boundary/internal/host/dns/store"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	pluginstore "github.com/hashicorp/boundary/internal/host/plugin/store"
 	"github.com/hashicorp/boundary/internal/host/static"
@@ -29,6 +31,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	hostspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
@@ -40,14 +43,31 @@ import (
 var (
 	staticMaskManager handlers.MaskManager
 	pluginMaskManager handlers.MaskManager
+	dnsMaskManager    handlers.MaskManager
 
-	// IdActions contains the set of actions that can be performed on
+	// idActionsTypeMap contains the set of actions that can be performed on
 	// individual resources
-	IdActions = action.ActionSet{
-		action.NoOp,
-		action.Read,
-		action.Update,
-		action.Delete,
+	idActionsTypeMap = map[subtypes.Subtype]action.ActionSet{
+		static.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+			action.ImportHosts,
+		},
+		plugin.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+			action.Sync,
+		},
+		dns.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -68,6 +88,12 @@ var (
 				action.List,
 			},
 		},
+		dns.Subtype: {
+			resource.HostSet: host_sets.CollectionActions,
+			resource.Host: action.ActionSet{
+				action.List,
+			},
+		},
 	}
 )
 
@@ -81,6 +107,9 @@ func init() {
 	if pluginMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&pluginstore.HostCatalog{}}, handlers.MaskSource{&pb.HostCatalog{}}); err != nil {
 		panic(err)
 	}
+	if dnsMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&dnsstore.HostCatalog{}}, handlers.MaskSource{&pb.HostCatalog{}, &pb.DnsHostCatalogAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 type Service struct {
@@ -88,6 +117,7 @@ type Service struct {
 
 	staticRepoFn     common.StaticRepoFactory
 	pluginHostRepoFn common.PluginHostRepoFactory
+	dnsHostRepoFn    common.DnsHostRepoFactory
 	pluginRepoFn     common.HostPluginRepoFactory
 	iamRepoFn        common.IamRepoFactory
 }
@@ -96,7 +126,7 @@ var _ pbs.HostCatalogServiceServer = (*Service)(nil)
 
 // NewService returns a host catalog Service which handles host catalog related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory, pluginHostRepoFn common.PluginHostRepoFactory, hostPluginRepoFn common.HostPluginRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+func NewService(repoFn common.StaticRepoFactory, pluginHostRepoFn common.PluginHostRepoFactory, dnsHostRepoFn common.DnsHostRepoFactory, hostPluginRepoFn common.HostPluginRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
 	const op = "host_catalogs.NewService"
 	if repoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static repository")
@@ -104,13 +134,16 @@ func NewService(repoFn common.StaticRepoFactory, pluginHostRepoFn common.PluginH
 	if pluginHostRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing plugin host repository")
 	}
+	if dnsHostRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing dns host repository")
+	}
 	if hostPluginRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing host plugin repository")
 	}
 	if iamRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{staticRepoFn: repoFn, pluginHostRepoFn: pluginHostRepoFn, pluginRepoFn: hostPluginRepoFn, iamRepoFn: iamRepoFn}, nil
+	return Service{staticRepoFn: repoFn, pluginHostRepoFn: pluginHostRepoFn, dnsHostRepoFn: dnsHostRepoFn, pluginRepoFn: hostPluginRepoFn, iamRepoFn: iamRepoFn}, nil
 }
 
 func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogsRequest) (*pbs.ListHostCatalogsResponse, error) {
@@ -160,7 +193,7 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 	for _, item := range items {
 		res.Id = item.GetPublicId()
 		res.ScopeId = item.GetProjectId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActionsTypeMap[subtypes.SubtypeFromId(domain, item.GetPublicId())], auth.WithResource(&res)).Strings()
 		if len(authorizedActions) == 0 {
 			continue
 		}
@@ -181,6 +214,8 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 				subtype = static.Subtype
 			case *plugin.HostCatalog:
 				subtype = plugin.Subtype
+			case *dns.HostCatalog:
+				subtype = dns.Subtype
 			}
 			if subtype != "" {
 				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, item.GetPublicId())
@@ -242,7 +277,7 @@ func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequ
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[subtypes.SubtypeFromId(domain, hc.GetPublicId())]).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype subtypes.Subtype
@@ -251,6 +286,8 @@ func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequ
 			subtype = static.Subtype
 		case *plugin.HostCatalog:
 			subtype = plugin.Subtype
+		case *dns.HostCatalog:
+			subtype = dns.Subtype
 		}
 		if subtype != "" {
 			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, hc.GetPublicId())
@@ -299,7 +336,7 @@ func (s Service) CreateHostCatalog(ctx context.Context, req *pbs.CreateHostCatal
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[subtypes.SubtypeFromId(domain, hc.GetPublicId())]).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype subtypes.Subtype
@@ -308,6 +345,8 @@ func (s Service) CreateHostCatalog(ctx context.Context, req *pbs.CreateHostCatal
 			subtype = static.Subtype
 		case *plugin.HostCatalog:
 			subtype = plugin.Subtype
+		case *dns.HostCatalog:
+			subtype = dns.Subtype
 		}
 		if subtype != "" {
 			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, hc.GetPublicId())
@@ -362,7 +401,7 @@ func (s Service) UpdateHostCatalog(ctx context.Context, req *pbs.UpdateHostCatal
 		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
-		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), IdActions).Strings()))
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[subtypes.SubtypeFromId(domain, hc.GetPublicId())]).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
 		var subtype subtypes.Subtype
@@ -371,6 +410,8 @@ func (s Service) UpdateHostCatalog(ctx context.Context, req *pbs.UpdateHostCatal
 			subtype = static.Subtype
 		case *plugin.HostCatalog:
 			subtype = plugin.Subtype
+		case *dns.HostCatalog:
+			subtype = dns.Subtype
 		}
 		if subtype != "" {
 			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, hc.GetPublicId())
@@ -404,6 +445,105 @@ func (s Service) DeleteHostCatalog(ctx context.Context, req *pbs.DeleteHostCatal
 	return nil, nil
 }
 
+// ImportHosts implements the interface pbs.HostCatalogServiceServer.
+func (s Service) ImportHosts(ctx context.Context, req *pbs.ImportHostsRequest) (*pbs.ImportHostsResponse, error) {
+	const op = "host_catalogs.(Service).ImportHosts"
+
+	if err := validateImportHostsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ImportHosts)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows := make([]*static.ImportHost, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		rows = append(rows, &static.ImportHost{
+			Name:        item.GetName(),
+			Description: item.GetDescription(),
+			Address:     item.GetAddress(),
+			Labels:      item.GetLabels(),
+			SetNames:    item.GetHostSetNames(),
+		})
+	}
+	results, err := repo.ImportHosts(ctx, authResults.Scope.GetId(), req.GetId(), rows, static.WithDryRun(req.GetDryRun()))
+	if err != nil {
+		if errors.Match(errors.T(errors.RecordNotFound), err) {
+			return nil, handlers.NotFoundErrorf("Host catalog %q not found.", req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to import hosts"))
+	}
+
+	items := make([]*hostspb.HostImportResult, 0, len(results))
+	for _, r := range results {
+		items = append(items, &hostspb.HostImportResult{
+			Row:        uint32(r.Row),
+			Name:       r.Name,
+			HostId:     r.HostId,
+			Action:     string(r.Action),
+			HostSetIds: r.SetIds,
+			Error:      r.Error,
+		})
+	}
+	return &pbs.ImportHostsResponse{Items: items, DryRun: req.GetDryRun()}, nil
+}
+
+// SyncHostCatalog implements the interface pbs.HostCatalogServiceServer.
+func (s Service) SyncHostCatalog(ctx context.Context, req *pbs.SyncHostCatalogRequest) (*pbs.SyncHostCatalogResponse, error) {
+	const op = "host_catalogs.(Service).SyncHostCatalog"
+
+	if err := validateSyncRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Sync)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	hc, plg, err := repo.SyncCatalog(ctx, req.GetId())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Host catalog %q not found.", req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to sync host catalog"))
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hc.GetPublicId(), idActionsTypeMap[plugin.Subtype]).Strings()))
+	}
+	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[plugin.Subtype], authResults.Scope.Id, hc.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+	}
+	outputOpts = append(outputOpts, handlers.WithPlugin(toPluginInfo(plg)))
+
+	item, err := toProto(ctx, hc, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.SyncHostCatalogResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (host.Catalog, *plugins.PluginInfo, error) {
 	var plg *plugins.PluginInfo
 	var cat host.Catalog
@@ -435,6 +575,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (host.Catalog, *plu
 		}
 		cat = hc
 		plg = toPluginInfo(hcplg)
+	case dns.Subtype:
+		repo, err := s.dnsHostRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		hc, err := repo.LookupCatalog(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if hc == nil {
+			return nil, nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist.", id)
+		}
+		cat = hc
 	}
 	return cat, plg, nil
 }
@@ -463,6 +616,17 @@ func (s Service) listFromRepo(ctx context.Context, projectIds []string) ([]host.
 	for _, c := range pl {
 		res = append(res, c)
 	}
+	dnsRepo, err := s.dnsHostRepoFn()
+	if err != nil {
+		return nil, nil, err
+	}
+	dl, err := dnsRepo.ListCatalogs(ctx, projectIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range dl {
+		res = append(res, c)
+	}
 	pluginsMap := make(map[string]*plugins.PluginInfo, len(plgs))
 	for _, plg := range plgs {
 		pluginsMap[plg.GetPublicId()] = toPluginInfo(plg)
@@ -491,6 +655,26 @@ func (s Service) createStaticInRepo(ctx context.Context, projId string, item *pb
 	return out, nil
 }
 
+func (s Service) createDnsInRepo(ctx context.Context, projId string, item *pb.HostCatalog) (*dns.HostCatalog, error) {
+	const op = "host_catalogs.(Service).createDnsInRepo"
+	h, err := toStorageDnsCatalog(ctx, projId, item)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build catalog for creation"))
+	}
+	repo, err := s.dnsHostRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateCatalog(ctx, h)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create host catalog"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host catalog but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createPluginInRepo(ctx context.Context, projId string, req *pbs.CreateHostCatalogRequest) (*plugin.HostCatalog, *plugins.PluginInfo, error) {
 	const op = "host_catalogs.(Service).createPluginInRepo"
 	item := req.GetItem()
@@ -532,6 +716,8 @@ func (s Service) createInRepo(ctx context.Context, projId string, req *pbs.Creat
 	switch subtypes.SubtypeFromType(domain, req.GetItem().GetType()) {
 	case static.Subtype:
 		hc, err = s.createStaticInRepo(ctx, projId, req.GetItem())
+	case dns.Subtype:
+		hc, err = s.createDnsInRepo(ctx, projId, req.GetItem())
 	default:
 		hc, plg, err = s.createPluginInRepo(ctx, projId, req)
 	}
@@ -564,6 +750,32 @@ func (s Service) updateStaticInRepo(ctx context.Context, projId, id string, mask
 	return out, nil
 }
 
+func (s Service) updateDnsInRepo(ctx context.Context, projId, id string, mask []string, item *pb.HostCatalog) (*dns.HostCatalog, error) {
+	const op = "host_catalogs.(Service).updateDnsInRepo"
+	h, err := toStorageDnsCatalog(ctx, projId, item)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build host catalog for update"))
+	}
+	version := item.GetVersion()
+	h.PublicId = id
+	dbMask := dnsMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.dnsHostRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateCatalog(ctx, h, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update host catalog"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updatePluginInRepo(ctx context.Context, projId, id string, mask []string, item *pb.HostCatalog) (*plugin.HostCatalog, *plugins.PluginInfo, error) {
 	const op = "host_catalogs.(Service).updatePluginInRepo"
 	h, err := toStoragePluginCatalog(ctx, projId, "", item)
@@ -598,6 +810,8 @@ func (s Service) updateInRepo(ctx context.Context, projId string, req *pbs.Updat
 		hc, err = s.updateStaticInRepo(ctx, projId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	case plugin.Subtype:
 		hc, plg, err = s.updatePluginInRepo(ctx, projId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	case dns.Subtype:
+		hc, err = s.updateDnsInRepo(ctx, projId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	}
 	return
 }
@@ -624,6 +838,15 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 		if err != nil {
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete host"))
 		}
+	case dns.Subtype:
+		repo, err := s.dnsHostRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, err = repo.DeleteCatalog(ctx, id)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete host"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -686,6 +909,23 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			}
 			parentId = cat.GetProjectId()
 			opts = append(opts, auth.WithId(id))
+		case dns.Subtype:
+			repo, err := s.dnsHostRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			cat, err := repo.LookupCatalog(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cat == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cat.GetProjectId()
+			opts = append(opts, auth.WithId(id))
 		}
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
@@ -724,6 +964,8 @@ func toProto(ctx context.Context, in host.Catalog, opt ...handlers.Option) (*pb.
 			out.Type = static.Subtype.String()
 		case *plugin.HostCatalog:
 			out.Type = plugin.Subtype.String()
+		case *dns.HostCatalog:
+			out.Type = dns.Subtype.String()
 		}
 	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
@@ -773,6 +1015,14 @@ func toProto(ctx context.Context, in host.Catalog, opt ...handlers.Option) (*pb.
 				}
 			}
 		}
+	case *dns.HostCatalog:
+		if outputFields.Has(globals.AttributesField) {
+			out.Attrs = &pb.HostCatalog_DnsHostCatalogAttributes{
+				DnsHostCatalogAttributes: &pb.DnsHostCatalogAttributes{
+					Resolvers: h.GetResolvers(),
+				},
+			}
+		}
 	}
 	return &out, nil
 }
@@ -793,6 +1043,25 @@ func toStorageStaticCatalog(ctx context.Context, projectId string, item *pb.Host
 	return hc, nil
 }
 
+func toStorageDnsCatalog(ctx context.Context, projectId string, item *pb.HostCatalog) (*dns.HostCatalog, error) {
+	const op = "host_catalog_service.toStorageDnsCatalog"
+	var opts []dns.Option
+	if name := item.GetName(); name != nil {
+		opts = append(opts, dns.WithName(name.GetValue()))
+	}
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, dns.WithDescription(desc.GetValue()))
+	}
+	if resolvers := item.GetDnsHostCatalogAttributes().GetResolvers(); len(resolvers) > 0 {
+		opts = append(opts, dns.WithResolvers(resolvers))
+	}
+	hc, err := dns.NewHostCatalog(ctx, projectId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build host catalog"))
+	}
+	return hc, nil
+}
+
 func toStoragePluginCatalog(ctx context.Context, projectId, plgId string, item *pb.HostCatalog) (*plugin.HostCatalog, error) {
 	const op = "host_catalog_service.toStoragePluginCatalog"
 	var opts []plugin.Option
@@ -823,7 +1092,7 @@ func toStoragePluginCatalog(ctx context.Context, projectId, plgId string, item *
 //   - The type asserted by the ID and/or field is known
 //   - If relevant, the type derived from the id prefix matches what is claimed by the type field
 func validateGetRequest(req *pbs.GetHostCatalogRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix, globals.DnsHostCatalogPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
@@ -837,6 +1106,11 @@ func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
 		}
 		switch subtypes.SubtypeFromType(domain, req.GetItem().GetType()) {
 		case static.Subtype:
+		case dns.Subtype:
+			if req.GetItem().GetPlugin() != nil {
+				badFields[globals.PluginField] = "This field is unused for this type of host catalog."
+			}
+			validateDnsResolvers(req.GetItem().GetDnsHostCatalogAttributes().GetResolvers(), badFields)
 		case plugin.Subtype:
 			if req.GetItem().GetPlugin() != nil {
 				badFields[globals.PluginField] = "This is a read only field."
@@ -850,7 +1124,7 @@ func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
 				badFields[globals.PluginNameField] = "Can't set the plugin id field along with this field."
 			}
 		default:
-			badFields[globals.TypeField] = fmt.Sprintf("This is a required field and must be either %q or %q.", static.Subtype.String(), plugin.Subtype.String())
+			badFields[globals.TypeField] = fmt.Sprintf("This is a required field and must be one of %q, %q or %q.", static.Subtype.String(), plugin.Subtype.String(), dns.Subtype.String())
 		}
 		return badFields
 	})
@@ -877,13 +1151,46 @@ func validateUpdateRequest(req *pbs.UpdateHostCatalogRequest) error {
 			if req.GetItem().GetPlugin() != nil {
 				badFields[globals.PluginField] = "This is a read only field."
 			}
+		case dns.Subtype:
+			if req.GetItem().GetType() != "" && subtypes.SubtypeFromType(domain, req.GetItem().GetType()) != dns.Subtype {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetPlugin() != nil {
+				badFields[globals.PluginField] = "This field is unused for this type of host catalog."
+			}
+			validateDnsResolvers(req.GetItem().GetDnsHostCatalogAttributes().GetResolvers(), badFields)
 		}
 		return badFields
-	}, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix)
+	}, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix, globals.DnsHostCatalogPrefix)
+}
+
+// validateDnsResolvers adds an entry to badFields if any of the provided
+// resolver addresses is not an IP address with an optional port.
+func validateDnsResolvers(resolvers []string, badFields map[string]string) {
+	for _, r := range resolvers {
+		if _, err := dns.NormalizeResolver(context.Background(), r); err != nil {
+			badFields[globals.AttributesResolversField] = fmt.Sprintf("%q is not a valid resolver address; it must be an IP address with an optional port.", r)
+			return
+		}
+	}
 }
 
 func validateDeleteRequest(req *pbs.DeleteHostCatalogRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix, globals.DnsHostCatalogPrefix)
+}
+
+func validateImportHostsRequest(req *pbs.ImportHostsRequest) error {
+	return handlers.ValidateGetRequest(func() map[string]string {
+		badFields := map[string]string{}
+		if len(req.GetItems()) == 0 {
+			badFields["items"] = "Must be non-empty."
+		}
+		return badFields
+	}, req, globals.StaticHostCatalogPrefix)
+}
+
+func validateSyncRequest(req *pbs.SyncHostCatalogRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix)
 }
 
 func validateListRequest(req *pbs.ListHostCatalogsRequest) error {
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_catalogs"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -29,6 +30,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	hostspb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
@@ -73,9 +75,26 @@ var authorizedCollectionActions = map[subtypes.Subtype]map[string]*structpb.List
 			},
 		},
 	},
+	dns.Subtype: {
+		"host-sets": {
+			Values: []*structpb.Value{
+				structpb.NewStringValue("create"),
+				structpb.NewStringValue("list"),
+			},
+		},
+		"hosts": {
+			Values: []*structpb.Value{
+				structpb.NewStringValue("list"),
+			},
+		},
+	},
 }
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = map[subtypes.Subtype][]string{
+	static.Subtype: {"no-op", "read", "update", "delete", "import-hosts"},
+	plugin.Subtype: {"no-op", "read", "update", "delete", "sync"},
+	dns.Subtype:    {"no-op", "read", "update", "delete"},
+}
 
 func TestGet_Static(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -91,6 +110,9 @@ func TestGet_Static(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -110,7 +132,7 @@ func TestGet_Static(t *testing.T) {
 		CreatedTime:                 hc.CreateTime.GetTimestamp(),
 		UpdatedTime:                 hc.UpdateTime.GetTimestamp(),
 		Type:                        "static",
-		AuthorizedActions:           testAuthorizedActions,
+		AuthorizedActions:           testAuthorizedActions[static.Subtype],
 		AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 	}
 
@@ -150,7 +172,7 @@ func TestGet_Static(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+			s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 			require.NoError(err, "Couldn't create a new host catalog service.")
 
 			got, gErr := s.GetHostCatalog(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -181,6 +203,9 @@ func TestGet_Plugin(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -213,7 +238,7 @@ func TestGet_Plugin(t *testing.T) {
 		CreatedTime:                 hc.CreateTime.GetTimestamp(),
 		UpdatedTime:                 hc.UpdateTime.GetTimestamp(),
 		Type:                        plugin.Subtype.String(),
-		AuthorizedActions:           testAuthorizedActions,
+		AuthorizedActions:           testAuthorizedActions[plugin.Subtype],
 		AuthorizedCollectionActions: authorizedCollectionActions[plugin.Subtype],
 		SecretsHmac:                 base58.Encode([]byte("foobar")),
 	}
@@ -265,7 +290,7 @@ func TestGet_Plugin(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+			s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 			require.NoError(err, "Couldn't create a new host catalog service.")
 
 			got, gErr := s.GetHostCatalog(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -294,6 +319,9 @@ func TestList(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -316,7 +344,7 @@ func TestList(t *testing.T) {
 			Scope:                       &scopepb.ScopeInfo{Id: pWithCatalogs.GetPublicId(), Type: scope.Project.String(), ParentScopeId: oWithCatalogs.GetPublicId()},
 			Version:                     1,
 			Type:                        "static",
-			AuthorizedActions:           testAuthorizedActions,
+			AuthorizedActions:           testAuthorizedActions[static.Subtype],
 			AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 		})
 	}
@@ -340,7 +368,7 @@ func TestList(t *testing.T) {
 			},
 			Version:                     1,
 			Type:                        plugin.Subtype.String(),
-			AuthorizedActions:           testAuthorizedActions,
+			AuthorizedActions:           testAuthorizedActions[plugin.Subtype],
 			AuthorizedCollectionActions: authorizedCollectionActions[plugin.Subtype],
 		}
 		wantSomeCatalogs = append(wantSomeCatalogs, cat)
@@ -357,7 +385,7 @@ func TestList(t *testing.T) {
 			Scope:                       &scopepb.ScopeInfo{Id: pWithOtherCatalogs.GetPublicId(), Type: scope.Project.String(), ParentScopeId: oWithOtherCatalogs.GetPublicId()},
 			Version:                     1,
 			Type:                        "static",
-			AuthorizedActions:           testAuthorizedActions,
+			AuthorizedActions:           testAuthorizedActions[static.Subtype],
 			AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 		})
 	}
@@ -380,7 +408,7 @@ func TestList(t *testing.T) {
 			},
 			Version:                     1,
 			Type:                        plugin.Subtype.String(),
-			AuthorizedActions:           testAuthorizedActions,
+			AuthorizedActions:           testAuthorizedActions[plugin.Subtype],
 			AuthorizedCollectionActions: authorizedCollectionActions[plugin.Subtype],
 		})
 	}
@@ -453,7 +481,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_catalogs.NewService(repoFn, pluginHostRepo, pluginRepo, iamRepoFn)
+			s, err := host_catalogs.NewService(repoFn, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			// Test with non-anon user
@@ -500,6 +528,9 @@ func TestDelete_Static(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -508,7 +539,7 @@ func TestDelete_Static(t *testing.T) {
 	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
-	s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+	s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 	require.NoError(t, err, "Couldn't create a new host catalog service.")
 
 	cases := []struct {
@@ -570,6 +601,9 @@ func TestDelete_Plugin(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -579,7 +613,7 @@ func TestDelete_Plugin(t *testing.T) {
 	plg := host.TestPlugin(t, conn, "test")
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 
-	s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+	s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 	require.NoError(t, err, "Couldn't create a new host catalog service.")
 
 	cases := []struct {
@@ -642,6 +676,9 @@ func TestDelete_twice(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -650,7 +687,7 @@ func TestDelete_twice(t *testing.T) {
 	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
-	s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+	s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 	require.NoError(err, "Couldn't create a new host catalog service.")
 	req := &pbs.DeleteHostCatalogRequest{
 		Id: hc.GetPublicId(),
@@ -663,6 +700,173 @@ func TestDelete_twice(t *testing.T) {
 	assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "Expected permission denied for the second delete.")
 }
 
+func TestImportHosts(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	repo := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepo := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	pluginRepo := func() (*host.Repository, error) {
+		return host.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	plg := host.TestPlugin(t, conn, "test")
+	pluginHc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
+
+	s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
+	require.NoError(t, err, "Couldn't create a new host catalog service.")
+
+	items := []*hostspb.HostImport{
+		{Name: "web", Address: "web.internal", Labels: map[string]string{"env": "prod"}, HostSetNames: []string{"web-servers"}},
+	}
+	cases := []struct {
+		name        string
+		req         *pbs.ImportHostsRequest
+		wantActions []string
+		err         error
+	}{
+		{
+			name:        "Dry run",
+			req:         &pbs.ImportHostsRequest{Id: hc.GetPublicId(), Items: items, DryRun: true},
+			wantActions: []string{"created"},
+		},
+		{
+			name:        "Import",
+			req:         &pbs.ImportHostsRequest{Id: hc.GetPublicId(), Items: items},
+			wantActions: []string{"created"},
+		},
+		{
+			name:        "Import again",
+			req:         &pbs.ImportHostsRequest{Id: hc.GetPublicId(), Items: items},
+			wantActions: []string{"unchanged"},
+		},
+		{
+			name: "Invalid host",
+			req: &pbs.ImportHostsRequest{Id: hc.GetPublicId(), Items: []*hostspb.HostImport{
+				{Name: "db", Address: "db.internal"},
+				{Name: "bad"},
+			}},
+			wantActions: []string{"skipped", "failed"},
+		},
+		{
+			name: "No items",
+			req:  &pbs.ImportHostsRequest{Id: hc.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Plugin host catalog",
+			req:  &pbs.ImportHostsRequest{Id: pluginHc.GetPublicId(), Items: items},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Not found",
+			req:  &pbs.ImportHostsRequest{Id: globals.StaticHostCatalogPrefix + "_doesntexis", Items: items},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ImportHosts(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ImportHosts(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.req.GetDryRun(), got.GetDryRun())
+			var gotActions []string
+			for _, r := range got.GetItems() {
+				gotActions = append(gotActions, r.GetAction())
+			}
+			assert.Equal(tc.wantActions, gotActions)
+		})
+	}
+}
+
+func TestSyncHostCatalog(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	repo := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepo := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	pluginRepo := func() (*host.Repository, error) {
+		return host.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	plg := host.TestPlugin(t, conn, "test")
+	pluginHc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
+
+	s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
+	require.NoError(t, err, "Couldn't create a new host catalog service.")
+
+	cases := []struct {
+		name string
+		req  *pbs.SyncHostCatalogRequest
+		err  error
+	}{
+		{
+			name: "Sync",
+			req:  &pbs.SyncHostCatalogRequest{Id: pluginHc.GetPublicId()},
+		},
+		{
+			name: "Static host catalog",
+			req:  &pbs.SyncHostCatalogRequest{Id: hc.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Not found",
+			req:  &pbs.SyncHostCatalogRequest{Id: globals.PluginHostCatalogPrefix + "_doesntexis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.SyncHostCatalog(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "SyncHostCatalog(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.req.GetId(), got.GetItem().GetId())
+			assert.Equal(plugin.Subtype.String(), got.GetItem().GetType())
+			assert.Equal(testAuthorizedActions[plugin.Subtype], got.GetItem().GetAuthorizedActions())
+		})
+	}
+}
+
 func TestCreate_Static(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -678,6 +882,9 @@ func TestCreate_Static(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
@@ -710,7 +917,7 @@ func TestCreate_Static(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "name"},
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -783,7 +990,7 @@ func TestCreate_Static(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.CreateHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+			s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 			require.NoError(err, "Failed to create a new host catalog service.")
 
 			got, gErr := s.CreateHostCatalog(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -815,6 +1022,117 @@ func TestCreate_Static(t *testing.T) {
 	}
 }
 
+func TestCreate_Dns(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	rw := db.New(conn)
+	repo := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepo := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	pluginRepo := func() (*host.Repository, error) {
+		return host.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.CreateHostCatalogRequest
+		res  *pbs.CreateHostCatalogResponse
+		err  error
+	}{
+		{
+			name: "Create a valid HostCatalog",
+			req: &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+				ScopeId:     proj.GetPublicId(),
+				Name:        &wrappers.StringValue{Value: "name"},
+				Description: &wrappers.StringValue{Value: "desc"},
+				Type:        dns.Subtype.String(),
+				Attrs: &pb.HostCatalog_DnsHostCatalogAttributes{
+					DnsHostCatalogAttributes: &pb.DnsHostCatalogAttributes{
+						Resolvers: []string{"10.0.0.2", "[fd00::2]:5353"},
+					},
+				},
+			}},
+			res: &pbs.CreateHostCatalogResponse{
+				Uri: fmt.Sprintf("host-catalogs/%s_", globals.DnsHostCatalogPrefix),
+				Item: &pb.HostCatalog{
+					ScopeId:     proj.GetPublicId(),
+					Scope:       &scopepb.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: proj.GetParentId()},
+					Name:        &wrappers.StringValue{Value: "name"},
+					Description: &wrappers.StringValue{Value: "desc"},
+					Type:        dns.Subtype.String(),
+					Attrs: &pb.HostCatalog_DnsHostCatalogAttributes{
+						DnsHostCatalogAttributes: &pb.DnsHostCatalogAttributes{
+							Resolvers: []string{"10.0.0.2:53", "[fd00::2]:5353"},
+						},
+					},
+					AuthorizedActions:           testAuthorizedActions[dns.Subtype],
+					AuthorizedCollectionActions: authorizedCollectionActions[dns.Subtype],
+				},
+			},
+		},
+		{
+			name: "Create with hostname resolver",
+			req: &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+				ScopeId: proj.GetPublicId(),
+				Type:    dns.Subtype.String(),
+				Attrs: &pb.HostCatalog_DnsHostCatalogAttributes{
+					DnsHostCatalogAttributes: &pb.DnsHostCatalogAttributes{
+						Resolvers: []string{"dns.example.com"},
+					},
+				},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with plugin id",
+			req: &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+				ScopeId: proj.GetPublicId(),
+				Type:    dns.Subtype.String(),
+				Plugin:  &plugins.PluginInfo{Id: "pl_1234567890"},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
+			require.NoError(err, "Failed to create a new host catalog service.")
+
+			got, gErr := s.CreateHostCatalog(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateHostCatalog(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Contains(got.GetUri(), tc.res.GetUri())
+			assert.True(strings.HasPrefix(got.GetItem().GetId(), globals.DnsHostCatalogPrefix))
+
+			// Clear all values which are hard to compare against.
+			got.Uri, tc.res.Uri = "", ""
+			got.Item.Id, tc.res.Item.Id = "", ""
+			got.Item.CreatedTime, got.Item.UpdatedTime = nil, nil
+			tc.res.Item.Version = 1
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "CreateHostCatalog(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
 func TestCreate_Plugin(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -845,6 +1163,9 @@ func TestCreate_Plugin(t *testing.T) {
 			}),
 		})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	defaultHc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	defaultHcCreated := defaultHc.GetCreateTime().GetTimestamp().AsTime()
 	toMerge := &pbs.CreateHostCatalogRequest{}
@@ -878,7 +1199,7 @@ func TestCreate_Plugin(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "name"},
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					Type:                        plugin.Subtype.String(),
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[plugin.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[plugin.Subtype],
 				},
 			},
@@ -958,7 +1279,7 @@ func TestCreate_Plugin(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.CreateHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, pluginHostRepo, pluginRepo, iamRepoFn)
+			s, err := host_catalogs.NewService(repo, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 			require.NoError(err, "Failed to create a new host catalog service.")
 
 			got, gErr := s.CreateHostCatalog(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -1007,13 +1328,16 @@ func TestUpdate_Static(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	tested, err := host_catalogs.NewService(repoFn, pluginHostRepo, pluginRepo, iamRepoFn)
+	tested, err := host_catalogs.NewService(repoFn, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 	require.NoError(t, err, "Failed to create a new host catalog service.")
 
 	hc, err := static.NewHostCatalog(proj.GetPublicId(), static.WithName("default"), static.WithDescription("default"))
@@ -1066,7 +1390,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1092,7 +1416,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "desc"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1147,7 +1471,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "default"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1170,7 +1494,7 @@ func TestUpdate_Static(t *testing.T) {
 					Name:                        &wrappers.StringValue{Value: "default"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1195,7 +1519,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "default"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1220,7 +1544,7 @@ func TestUpdate_Static(t *testing.T) {
 					Description:                 &wrappers.StringValue{Value: "notignored"},
 					CreatedTime:                 hc.GetCreateTime().GetTimestamp(),
 					Type:                        "static",
-					AuthorizedActions:           testAuthorizedActions,
+					AuthorizedActions:           testAuthorizedActions[static.Subtype],
 					AuthorizedCollectionActions: authorizedCollectionActions[static.Subtype],
 				},
 			},
@@ -1382,13 +1706,16 @@ func TestUpdate_Plugin(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	pluginRepo := func() (*host.Repository, error) {
 		return host.NewRepository(rw, rw, kms)
 	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	tested, err := host_catalogs.NewService(repoFn, pluginHostRepo, pluginRepo, iamRepoFn)
+	tested, err := host_catalogs.NewService(repoFn, pluginHostRepo, dnsHostRepo, pluginRepo, iamRepoFn)
 	require.NoError(t, err, "Failed to create a new host catalog service.")
 
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
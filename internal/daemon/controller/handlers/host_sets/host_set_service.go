@@ -6,6 +6,7 @@ package host_sets
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -13,7 +14,11 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	dnsstore "github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/host/health"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	plugstore "github.com/hashicorp/boundary/internal/host/plugin/store"
 	"github.com/hashicorp/boundary/internal/host/static"
@@ -27,6 +32,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -48,12 +54,29 @@ var (
 			action.AddHosts,
 			action.SetHosts,
 			action.RemoveHosts,
+			action.History,
+			action.Restore,
+			action.SetHealthCheck,
+			action.RemoveHealthCheck,
 		},
 		plugin.Subtype: {
 			action.NoOp,
 			action.Read,
 			action.Update,
 			action.Delete,
+			action.History,
+			action.SetHealthCheck,
+			action.RemoveHealthCheck,
+			action.Sync,
+		},
+		dns.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+			action.History,
+			action.SetHealthCheck,
+			action.RemoveHealthCheck,
 		},
 	}
 
@@ -69,26 +92,32 @@ const domain = "host"
 
 func init() {
 	var err error
-	if maskManager[static.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.HostSet{}, &staticstore.UnimplementedSetFields{}}, handlers.MaskSource{&pb.HostSet{}}); err != nil {
+	if maskManager[static.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.HostSet{}, &staticstore.UnimplementedSetFields{}}, handlers.MaskSource{&pb.HostSet{}, &pb.StaticHostSetAttributes{}}); err != nil {
 		panic(err)
 	}
 	if maskManager[plugin.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&plugstore.HostSet{}}, handlers.MaskSource{&pb.HostSet{}}); err != nil {
 		panic(err)
 	}
+	if maskManager[dns.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&dnsstore.HostSet{}, &dnsstore.UnimplementedSetFields{}}, handlers.MaskSource{&pb.HostSet{}, &pb.DnsHostSetAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 type Service struct {
 	pbs.UnsafeHostSetServiceServer
 
-	staticRepoFn common.StaticRepoFactory
-	pluginRepoFn common.PluginHostRepoFactory
+	staticRepoFn  common.StaticRepoFactory
+	pluginRepoFn  common.PluginHostRepoFactory
+	dnsRepoFn     common.DnsHostRepoFactory
+	historyRepoFn common.HistoryRepoFactory
+	healthRepoFn  common.HostHealthRepoFactory
 }
 
 var _ pbs.HostSetServiceServer = (*Service)(nil)
 
 // NewService returns a host set Service which handles host set related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(staticRepoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory) (Service, error) {
+func NewService(staticRepoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, dnsRepoFn common.DnsHostRepoFactory, historyRepoFn common.HistoryRepoFactory, healthRepoFn common.HostHealthRepoFactory) (Service, error) {
 	const op = "host_sets.NewService"
 	if staticRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static repository")
@@ -96,7 +125,16 @@ func NewService(staticRepoFn common.StaticRepoFactory, pluginRepoFn common.Plugi
 	if pluginRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing plugin repository")
 	}
-	return Service{staticRepoFn: staticRepoFn, pluginRepoFn: pluginRepoFn}, nil
+	if dnsRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing dns repository")
+	}
+	if historyRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing history repository")
+	}
+	if healthRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing host health repository")
+	}
+	return Service{staticRepoFn: staticRepoFn, pluginRepoFn: pluginRepoFn, dnsRepoFn: dnsRepoFn, historyRepoFn: historyRepoFn, healthRepoFn: healthRepoFn}, nil
 }
 
 func (s Service) ListHostSets(ctx context.Context, req *pbs.ListHostSetsRequest) (*pbs.ListHostSetsResponse, error) {
@@ -207,6 +245,11 @@ func (s Service) GetHostSet(ctx context.Context, req *pbs.GetHostSetRequest) (*p
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.HealthCheckField) {
+		if item.HealthCheck, err = s.healthCheckFromRepo(ctx, hs.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetHostSetResponse{Item: item}, nil
 }
@@ -430,6 +473,197 @@ func (s Service) RemoveHostSetHosts(ctx context.Context, req *pbs.RemoveHostSetH
 	return &pbs.RemoveHostSetHostsResponse{Item: item}, nil
 }
 
+// GetHostSetHistory implements the interface pbs.HostSetServiceServer.
+func (s Service) GetHostSetHistory(ctx context.Context, req *pbs.GetHostSetHistoryRequest) (*pbs.GetHostSetHistoryResponse, error) {
+	if err := validateGetHistoryRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.History)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	records, err := repo.ListResourceHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetHostSetHistoryResponse{Items: handlers.HistoryToProto(records)}, nil
+}
+
+// RestoreHostSet implements the interface pbs.HostSetServiceServer.
+func (s Service) RestoreHostSet(ctx context.Context, req *pbs.RestoreHostSetRequest) (*pbs.RestoreHostSetResponse, error) {
+	if err := validateRestoreRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Restore)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	want, current, err := handlers.RestoreStates(ctx, repo, req.GetId(), req.GetTime().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateRestoreDependencies(ctx, want); err != nil {
+		return nil, err
+	}
+	changes := history.Diff(current, want)
+	if !req.GetDryRun() && len(changes) > 0 {
+		if err := s.restoreInRepo(ctx, authResults.Scope.GetId(), want); err != nil {
+			return nil, err
+		}
+	}
+	return &pbs.RestoreHostSetResponse{Changes: handlers.ChangesToProto(changes), DryRun: req.GetDryRun()}, nil
+}
+
+// SetHostSetHealthCheck implements the interface pbs.HostSetServiceServer.
+func (s Service) SetHostSetHealthCheck(ctx context.Context, req *pbs.SetHostSetHealthCheckRequest) (*pbs.SetHostSetHealthCheckResponse, error) {
+	const op = "host_sets.(Service).SetHostSetHealthCheck"
+
+	if err := validateSetHealthCheckRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.SetHealthCheck)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.setHealthCheckInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.healthCheckResponseItem(ctx, req.GetId(), authResults, c)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.SetHostSetHealthCheckResponse{Item: item}, nil
+}
+
+// RemoveHostSetHealthCheck implements the interface pbs.HostSetServiceServer.
+func (s Service) RemoveHostSetHealthCheck(ctx context.Context, req *pbs.RemoveHostSetHealthCheckRequest) (*pbs.RemoveHostSetHealthCheckResponse, error) {
+	const op = "host_sets.(Service).RemoveHostSetHealthCheck"
+
+	if err := validateRemoveHealthCheckRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveHealthCheck)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := repo.DeleteHealthCheck(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to remove health check"))
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Host set %q has no health check.", req.GetId())
+	}
+	item, err := s.healthCheckResponseItem(ctx, req.GetId(), authResults, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.RemoveHostSetHealthCheckResponse{Item: item}, nil
+}
+
+// SyncHostSet implements the interface pbs.HostSetServiceServer.
+func (s Service) SyncHostSet(ctx context.Context, req *pbs.SyncHostSetRequest) (*pbs.SyncHostSetResponse, error) {
+	const op = "host_sets.(Service).SyncHostSet"
+
+	if err := validateSyncRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Sync)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pluginRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	hs, plg, err := repo.SyncSet(ctx, req.GetId())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist.", req.GetId())
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to sync host set"))
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hs.GetPublicId(), idActionsTypeMap[plugin.Subtype]).Strings()))
+	}
+	outputOpts = append(outputOpts, handlers.WithPlugin(toPluginInfo(plg)))
+
+	var hosts []host.Host
+	for _, h := range hs.HostIds {
+		hosts = append(hosts, &plugin.Host{
+			Host: &plugstore.Host{
+				PublicId:  h,
+				CatalogId: hs.CatalogId,
+			},
+		})
+	}
+	item, err := toProto(ctx, hs, hosts, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.SyncHostSetResponse{Item: item}, nil
+}
+
+// healthCheckResponseItem returns the host set id along with its health
+// check c for the response of a health check action.
+func (s Service) healthCheckResponseItem(ctx context.Context, id string, authResults auth.VerifyResults, c *health.HealthCheck) (*pb.HostSet, error) {
+	const op = "host_sets.(Service).healthCheckResponseItem"
+	hs, hosts, plg, err := s.getFromRepo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		idActions := idActionsTypeMap[subtypes.SubtypeFromId(domain, id)]
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, hs.GetPublicId(), idActions).Strings()))
+	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
+
+	item, err := toProto(ctx, hs, hosts, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if outputFields.Has(globals.HealthCheckField) {
+		item.HealthCheck = toHealthCheckProto(c)
+	}
+	return item, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (host.Set, []host.Host, *plugins.PluginInfo, error) {
 	var hs host.Set
 	var hl []host.Host
@@ -473,6 +707,27 @@ func (s Service) getFromRepo(ctx context.Context, id string) (host.Set, []host.H
 				},
 			})
 		}
+	case dns.Subtype:
+		repo, err := s.dnsRepoFn()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		hset, err := repo.LookupSet(ctx, id)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if hset == nil {
+			return nil, nil, nil, handlers.NotFoundErrorf("Host Set %q doesn't exist.", id)
+		}
+		hs = hset
+		for _, h := range hset.HostIds {
+			hl = append(hl, &dns.Host{
+				Host: &dnsstore.Host{
+					PublicId:  h,
+					CatalogId: hset.CatalogId,
+				},
+			})
+		}
 	}
 	return hs, hl, plg, nil
 }
@@ -520,6 +775,23 @@ func (s Service) createInRepo(ctx context.Context, projectId, catalogId string,
 		}
 		hSet = out
 		plg = toPluginInfo(hsplg)
+	case dns.Subtype:
+		h, err := toStorageDnsSet(ctx, catalogId, item)
+		if err != nil {
+			return nil, nil, err
+		}
+		repo, err := s.dnsRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		out, err := repo.CreateSet(ctx, projectId, h)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create host set"))
+		}
+		if out == nil {
+			return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host set but no error returned from repository.")
+		}
+		hSet = out
 	default:
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized catalog type")
 	}
@@ -586,6 +858,36 @@ func (s Service) updatePluginInRepo(ctx context.Context, projectId string, req *
 	return out, hl, toPluginInfo(plg), nil
 }
 
+func (s Service) updateDnsInRepo(ctx context.Context, projectId string, req *pbs.UpdateHostSetRequest) (host.Set, []host.Host, error) {
+	const op = "host_sets.(Service).updateDnsInRepo"
+	item := req.GetItem()
+	h, err := toStorageDnsSet(ctx, "", item)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host set for update"))
+	}
+	h.PublicId = req.GetId()
+	dbMask := maskManager[dns.Subtype].Translate(req.GetUpdateMask().GetPaths())
+	if len(dbMask) == 0 {
+		return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.dnsRepoFn()
+	if err != nil {
+		return nil, nil, err
+	}
+	out, hosts, rowsUpdated, err := repo.UpdateSet(ctx, projectId, h, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update host set"))
+	}
+	if rowsUpdated == 0 {
+		return nil, nil, handlers.NotFoundErrorf("Host Set %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	var hl []host.Host
+	for _, h := range hosts {
+		hl = append(hl, h)
+	}
+	return out, hl, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, projectId, catalogId string, req *pbs.UpdateHostSetRequest) (hs host.Set, hosts []host.Host, plg *plugins.PluginInfo, err error) {
 	const op = "host_sets.(Service).updateInRepo"
 	switch subtypes.SubtypeFromId(domain, req.GetId()) {
@@ -593,6 +895,8 @@ func (s Service) updateInRepo(ctx context.Context, projectId, catalogId string,
 		hs, hosts, err = s.updateStaticInRepo(ctx, projectId, catalogId, req)
 	case plugin.Subtype:
 		hs, hosts, plg, err = s.updatePluginInRepo(ctx, projectId, req)
+	case dns.Subtype:
+		hs, hosts, err = s.updateDnsInRepo(ctx, projectId, req)
 	}
 	return
 }
@@ -619,6 +923,15 @@ func (s Service) deleteFromRepo(ctx context.Context, projectId, id string) (bool
 		if err != nil {
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete host"))
 		}
+	case dns.Subtype:
+		repo, err := s.dnsRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteSet(ctx, projectId, id)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete host"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -653,6 +966,18 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host
 			sets = append(sets, a)
 		}
 		plg = toPluginInfo(hsplg)
+	case dns.Subtype:
+		repo, err := s.dnsRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		sl, err := repo.ListSets(ctx, catalogId)
+		if err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range sl {
+			sets = append(sets, a)
+		}
 	}
 	return sets, plg, nil
 }
@@ -730,6 +1055,39 @@ func (s Service) removeInRepo(ctx context.Context, projectId, setId string, host
 	return out, hl, nil
 }
 
+func (s Service) healthCheckFromRepo(ctx context.Context, setId string) (*pb.HostSetHealthCheck, error) {
+	const op = "host_sets.(Service).healthCheckFromRepo"
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	c, err := repo.LookupHealthCheck(ctx, setId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return toHealthCheckProto(c), nil
+}
+
+func (s Service) setHealthCheckInRepo(ctx context.Context, projectId, setId string, item *pb.HostSetHealthCheck) (*health.HealthCheck, error) {
+	const op = "host_sets.(Service).setHealthCheckInRepo"
+	c, err := health.NewHealthCheck(ctx, setId, health.CheckType(item.GetType()), item.GetPort(),
+		health.WithHttpPath(item.GetHttpPath()),
+		health.WithIntervalSeconds(item.GetIntervalSeconds()),
+		health.WithTimeoutSeconds(item.GetTimeoutSeconds()))
+	if err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{globals.HealthCheckField: err.Error()})
+	}
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.SetHealthCheck(ctx, projectId, c)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set health check"))
+	}
+	return out, nil
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (host.Catalog, auth.VerifyResults) {
 	res := auth.VerifyResults{}
 
@@ -743,6 +1101,11 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	dnsRepo, err := s.dnsRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.HostSet), auth.WithAction(a)}
@@ -758,11 +1121,21 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				res.Error = err
 				return nil, res
 			}
-			if ss == nil {
+			switch {
+			case ss != nil:
+				set = ss
+			case a == action.Restore:
+				// A deleted host set can be restored, so authorize against
+				// the catalog it was last recorded in by the oplog.
+				parentId, err = s.deletedSetCatalogId(ctx, id)
+				if err != nil {
+					res.Error = err
+					return nil, res
+				}
+			default:
 				res.Error = handlers.NotFoundError()
 				return nil, res
 			}
-			set = ss
 		case plugin.Subtype:
 			ps, _, err := pluginRepo.LookupSet(ctx, id)
 			if err != nil {
@@ -774,8 +1147,21 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			set = ps
+		case dns.Subtype:
+			ds, err := dnsRepo.LookupSet(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if ds == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			set = ds
+		}
+		if set != nil {
+			parentId = set.GetCatalogId()
 		}
-		parentId = set.GetCatalogId()
 		opts = append(opts, auth.WithId(id))
 	}
 
@@ -789,6 +1175,9 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		}
 		if cs == nil {
 			res.Error = handlers.NotFoundError()
+			if a == action.Restore {
+				res.Error = handlers.RestoreDependencyError("host catalog", parentId)
+			}
 			return nil, res
 		}
 		cat = cs
@@ -803,6 +1192,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		cat = pc
+	case dns.Subtype:
+		dc, err := dnsRepo.LookupCatalog(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if dc == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		cat = dc
 	}
 	opts = append(opts, auth.WithScopeId(cat.GetProjectId()), auth.WithPin(parentId))
 	return cat, auth.Verify(ctx, opts...)
@@ -840,6 +1240,8 @@ func toProto(ctx context.Context, in host.Set, hosts []host.Host, opt ...handler
 			out.Type = static.Subtype.String()
 		case *plugin.HostSet:
 			out.Type = plugin.Subtype.String()
+		case *dns.HostSet:
+			out.Type = dns.Subtype.String()
 		}
 	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
@@ -873,6 +1275,14 @@ func toProto(ctx context.Context, in host.Set, hosts []host.Host, opt ...handler
 	}
 
 	switch h := in.(type) {
+	case *static.HostSet:
+		if outputFields.Has(globals.AttributesField) && h.GetFilter() != "" {
+			out.Attrs = &pb.HostSet_StaticHostSetAttributes{
+				StaticHostSetAttributes: &pb.StaticHostSetAttributes{
+					Filter: wrapperspb.String(h.GetFilter()),
+				},
+			}
+		}
 	case *plugin.HostSet:
 		if outputFields.Has(globals.PreferredEndpointsField) {
 			out.PreferredEndpoints = h.PreferredEndpoints
@@ -892,11 +1302,58 @@ func toProto(ctx context.Context, in host.Set, hosts []host.Host, opt ...handler
 				}
 			}
 		}
+		if outputFields.Has(globals.SyncStatusField) {
+			out.SyncStatus = toSyncStatusProto(h)
+		}
+	case *dns.HostSet:
+		if outputFields.Has(globals.SyncIntervalSecondsField) && h.GetSyncIntervalSeconds() != 0 {
+			out.SyncIntervalSeconds = &wrapperspb.Int32Value{Value: h.GetSyncIntervalSeconds()}
+		}
+		if outputFields.Has(globals.AttributesField) {
+			out.Attrs = &pb.HostSet_DnsHostSetAttributes{
+				DnsHostSetAttributes: &pb.DnsHostSetAttributes{
+					Names:      h.GetNames(),
+					RecordType: wrapperspb.String(h.GetRecordType()),
+				},
+			}
+		}
 	}
 
 	return &out, nil
 }
 
+func toHealthCheckProto(c *health.HealthCheck) *pb.HostSetHealthCheck {
+	if c == nil {
+		return nil
+	}
+	return &pb.HostSetHealthCheck{
+		Type:            c.GetCheckType(),
+		Port:            c.GetPort(),
+		HttpPath:        c.GetHttpPath(),
+		IntervalSeconds: c.GetIntervalSeconds(),
+		TimeoutSeconds:  c.GetTimeoutSeconds(),
+		CreatedTime:     c.GetCreateTime().GetTimestamp(),
+		UpdatedTime:     c.GetUpdateTime().GetTimestamp(),
+	}
+}
+
+// toSyncStatusProto returns the sync status of the plugin host set h, or nil
+// if no sync of h has been started.
+func toSyncStatusProto(h *plugin.HostSet) *pb.HostSetSyncStatus {
+	st := h.SyncStatus
+	if st == nil {
+		return nil
+	}
+	return &pb.HostSetSyncStatus{
+		LastSyncStartTime: st.LastSyncStartTime.GetTimestamp(),
+		LastSyncEndTime:   st.LastSyncEndTime.GetTimestamp(),
+		LastSyncError:     st.LastSyncError,
+		HostsAdded:        uint32(st.HostsAdded),
+		HostsRemoved:      uint32(st.HostsRemoved),
+		SyncPending:       h.GetNeedSync(),
+	}
+}
+
 func toStorageStaticSet(ctx context.Context, catalogId string, item *pb.HostSet) (*static.HostSet, error) {
 	const op = "host_set_service.toStorageStaticSet"
 	var opts []static.Option
@@ -906,6 +1363,9 @@ func toStorageStaticSet(ctx context.Context, catalogId string, item *pb.HostSet)
 	if item.GetDescription() != nil {
 		opts = append(opts, static.WithDescription(item.GetDescription().GetValue()))
 	}
+	if filter := item.GetStaticHostSetAttributes().GetFilter(); filter != nil {
+		opts = append(opts, static.WithFilter(filter.GetValue()))
+	}
 	hs, err := static.NewHostSet(catalogId, opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host set for creation"))
@@ -938,6 +1398,32 @@ func toStoragePluginSet(ctx context.Context, catalogId string, item *pb.HostSet)
 	return hs, nil
 }
 
+func toStorageDnsSet(ctx context.Context, catalogId string, item *pb.HostSet) (*dns.HostSet, error) {
+	const op = "host_set_service.toStorageDnsSet"
+	var opts []dns.Option
+	if item.GetName() != nil {
+		opts = append(opts, dns.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, dns.WithDescription(item.GetDescription().GetValue()))
+	}
+	if item.GetSyncIntervalSeconds() != nil {
+		opts = append(opts, dns.WithSyncIntervalSeconds(item.GetSyncIntervalSeconds().GetValue()))
+	}
+	attrs := item.GetDnsHostSetAttributes()
+	if len(attrs.GetNames()) > 0 {
+		opts = append(opts, dns.WithNames(attrs.GetNames()))
+	}
+	if attrs.GetRecordType() != nil {
+		opts = append(opts, dns.WithRecordType(dns.RecordType(attrs.GetRecordType().GetValue())))
+	}
+	hs, err := dns.NewHostSet(ctx, catalogId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host set for creation"))
+	}
+	return hs, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -946,13 +1432,13 @@ func toStoragePluginSet(ctx context.Context, catalogId string, item *pb.HostSet)
 //   - The type asserted by the ID and/or field is known
 //   - If relevant, the type derived from the id prefix matches what is claimed by the type field
 func validateGetRequest(req *pbs.GetHostSetRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateHostSetRequest) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		if !handlers.ValidId(handlers.Id(req.GetItem().GetHostCatalogId()), globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix) {
+		if !handlers.ValidId(handlers.Id(req.GetItem().GetHostCatalogId()), globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix, globals.DnsHostCatalogPrefix) {
 			badFields[globals.HostCatalogIdField] = "The field is incorrectly formatted."
 		}
 		if len(req.GetItem().GetPreferredEndpoints()) > 0 {
@@ -969,6 +1455,11 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateHostSetRequest) e
 			if len(req.GetItem().PreferredEndpoints) > 0 {
 				badFields[globals.PreferredEndpointsField] = "This field is not yet supported for static host sets."
 			}
+			if filter := req.GetItem().GetStaticHostSetAttributes().GetFilter(); filter != nil {
+				if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+					badFields[globals.AttributesFilterField] = "Unable to successfully parse filter expression."
+				}
+			}
 		case plugin.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != plugin.Subtype.String() {
 				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
@@ -978,6 +1469,22 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateHostSetRequest) e
 					badFields[globals.SyncIntervalSecondsField] = "Must be -1 or a positive integer."
 				}
 			}
+		case dns.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != dns.Subtype.String() {
+				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
+			}
+			if len(req.GetItem().GetPreferredEndpoints()) > 0 {
+				badFields[globals.PreferredEndpointsField] = "This field is not supported for dns host sets."
+			}
+			if val := req.GetItem().GetSyncIntervalSeconds(); val != nil {
+				if val.GetValue() == 0 || val.GetValue() < -1 {
+					badFields[globals.SyncIntervalSecondsField] = "Must be -1 or a positive integer."
+				}
+			}
+			if len(req.GetItem().GetDnsHostSetAttributes().GetNames()) == 0 {
+				badFields[globals.AttributesNamesField] = "This is a required field."
+			}
+			validateDnsSetAttributes(req.GetItem().GetDnsHostSetAttributes(), badFields)
 		}
 		return badFields
 	})
@@ -997,24 +1504,62 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateHostSetRequest) e
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != static.Subtype.String() {
 				badFields[globals.TypeField] = "Cannot modify the resource type."
 			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.AttributesFilterField) {
+				if filter := req.GetItem().GetStaticHostSetAttributes().GetFilter(); filter != nil {
+					if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+						badFields[globals.AttributesFilterField] = "Unable to successfully parse filter expression."
+					}
+				}
+			}
 		case plugin.Subtype:
 			if val := req.GetItem().GetSyncIntervalSeconds(); val != nil {
 				if val.GetValue() == 0 || val.GetValue() < -1 {
 					badFields[globals.SyncIntervalSecondsField] = "Must be -1 or a positive integer."
 				}
 			}
+		case dns.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != dns.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify the resource type."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.PreferredEndpointsField) {
+				badFields[globals.PreferredEndpointsField] = "This field is not supported for dns host sets."
+			}
+			if val := req.GetItem().GetSyncIntervalSeconds(); val != nil {
+				if val.GetValue() == 0 || val.GetValue() < -1 {
+					badFields[globals.SyncIntervalSecondsField] = "Must be -1 or a positive integer."
+				}
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.AttributesNamesField) &&
+				len(req.GetItem().GetDnsHostSetAttributes().GetNames()) == 0 {
+				badFields[globals.AttributesNamesField] = "This field cannot be empty."
+			}
+			validateDnsSetAttributes(req.GetItem().GetDnsHostSetAttributes(), badFields)
 		}
 		return badFields
-	}, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix)
+	}, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix)
+}
+
+// validateDnsSetAttributes adds an entry to badFields for each of the
+// provided dns host set attributes that is malformed.
+func validateDnsSetAttributes(attrs *pb.DnsHostSetAttributes, badFields map[string]string) {
+	for _, n := range attrs.GetNames() {
+		if strings.TrimSpace(n) == "" || strings.ContainsAny(n, " \t/:") {
+			badFields[globals.AttributesNamesField] = fmt.Sprintf("%q is not a valid DNS name.", n)
+			break
+		}
+	}
+	if rt := attrs.GetRecordType(); rt != nil && !dns.RecordType(rt.GetValue()).Valid() {
+		badFields[globals.AttributesRecordTypeField] = fmt.Sprintf("Must be one of %q, %q, %q or %q.", dns.RecordTypeIp, dns.RecordTypeIp4, dns.RecordTypeIp6, dns.RecordTypeSrv)
+	}
 }
 
 func validateDeleteRequest(req *pbs.DeleteHostSetRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix)
 }
 
 func validateListRequest(req *pbs.ListHostSetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetHostCatalogId()), globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetHostCatalogId()), globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix, globals.DnsHostCatalogPrefix) {
 		badFields[globals.HostCatalogIdField] = "The field is incorrectly formatted."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -1069,6 +1614,14 @@ func validateSetRequest(req *pbs.SetHostSetHostsRequest) error {
 	return nil
 }
 
+func validateGetHistoryRequest(req *pbs.GetHostSetHistoryRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix)
+}
+
+func validateRestoreRequest(req *pbs.RestoreHostSetRequest) error {
+	return handlers.ValidateRestoreRequest(req, globals.StaticHostSetPrefix)
+}
+
 func validateRemoveRequest(req *pbs.RemoveHostSetHostsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), globals.StaticHostSetPrefix) {
@@ -1091,3 +1644,57 @@ func validateRemoveRequest(req *pbs.RemoveHostSetHostsRequest) error {
 	}
 	return nil
 }
+
+func validateSetHealthCheckRequest(req *pbs.SetHostSetHealthCheckRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.DnsHostSetPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	item := req.GetItem()
+	switch {
+	case item == nil:
+		badFields["item"] = "Required field."
+	default:
+		if !health.CheckType(item.GetType()).IsValid() {
+			badFields["item.type"] = "Must be one of \"tcp\", \"tls\" or \"http\"."
+		}
+		if item.GetPort() == 0 || item.GetPort() > 65535 {
+			badFields["item.port"] = "Must be between 1 and 65535."
+		}
+		if item.GetHttpPath() != "" && health.CheckType(item.GetType()) != health.HttpCheck {
+			badFields["item.http_path"] = "Only valid for \"http\" health checks."
+		}
+		if item.GetCreatedTime() != nil {
+			badFields["item.created_time"] = "This is a read only field."
+		}
+		if item.GetUpdatedTime() != nil {
+			badFields["item.updated_time"] = "This is a read only field."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateSyncRequest(req *pbs.SyncHostSetRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateRemoveHealthCheckRequest(req *pbs.RemoveHostSetHealthCheckRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.DnsHostSetPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
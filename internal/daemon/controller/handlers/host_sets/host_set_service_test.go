@@ -15,11 +15,15 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/host_sets"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -28,10 +32,12 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
+	historypb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/history"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -45,8 +51,26 @@ import (
 )
 
 var testAuthorizedActions = map[subtypes.Subtype][]string{
-	static.Subtype: {"no-op", "read", "update", "delete", "add-hosts", "set-hosts", "remove-hosts"},
-	plugin.Subtype: {"no-op", "read", "update", "delete"},
+	static.Subtype: {"no-op", "read", "update", "delete", "add-hosts", "set-hosts", "remove-hosts", "history", "restore", "set-health-check", "remove-health-check"},
+	plugin.Subtype: {"no-op", "read", "update", "delete", "history", "set-health-check", "remove-health-check", "sync"},
+}
+
+func testHistoryRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.HistoryRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*history.Repository, error) {
+		return history.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
+}
+
+func testHealthRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.HostHealthRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*health.Repository, error) {
+		return health.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
 }
 
 func TestGet_Static(t *testing.T) {
@@ -70,6 +94,9 @@ func TestGet_Static(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
@@ -126,7 +153,7 @@ func TestGet_Static(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostSetRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -143,6 +170,104 @@ func TestGet_Static(t *testing.T) {
 	}
 }
 
+func TestGetHistory(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	pluginRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+
+	s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
+	require.NoError(t, err, "Couldn't create a new host set service.")
+
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+	created, err := s.CreateHostSet(ctx, &pbs.CreateHostSetRequest{Item: &pb.HostSet{
+		HostCatalogId: hc.GetPublicId(),
+		Name:          wrapperspb.String("history"),
+		Type:          static.Subtype.String(),
+	}})
+	require.NoError(t, err)
+	id := created.GetItem().GetId()
+	_, err = s.UpdateHostSet(ctx, &pbs.UpdateHostSetRequest{
+		Id: id,
+		Item: &pb.HostSet{
+			Name:    wrapperspb.String("history-updated"),
+			Version: created.GetItem().GetVersion(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	})
+	require.NoError(t, err)
+
+	changeValues := func(r *historypb.Record) []string {
+		var ret []string
+		for _, c := range r.GetChanges() {
+			ret = append(ret, fmt.Sprintf("%s %s %s: %s -> %s", c.GetOperation(), c.GetType(), c.GetField(), c.GetOldValue(), c.GetNewValue()))
+		}
+		return ret
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetHostSetHistoryRequest
+		want [][]string
+		err  error
+	}{
+		{
+			name: "Get the history of an existing Host Set",
+			req:  &pbs.GetHostSetHistoryRequest{Id: id},
+			want: [][]string{
+				{"create static_host_set name: -> history"},
+				{"update static_host_set name: history -> history-updated"},
+			},
+		},
+		{
+			name: "Get the history of a non existing Host Set",
+			req:  &pbs.GetHostSetHistoryRequest{Id: globals.StaticHostSetPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.GetHostSetHistoryRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.GetHostSetHistory(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetHostSetHistory(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.Len(got.GetItems(), len(tc.want))
+			for i, item := range got.GetItems() {
+				assert.Subset(changeValues(item), tc.want[i])
+			}
+		})
+	}
+}
+
 func TestGet_Plugin(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -171,6 +296,9 @@ func TestGet_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	hs := plugin.TestSet(t, conn, kms, sche, hc, plgm, plugin.WithPreferredEndpoints(prefEndpoints), plugin.WithSyncIntervalSeconds(-1))
@@ -242,7 +370,7 @@ func TestGet_Plugin(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostSetRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -281,6 +409,9 @@ func TestList_Static(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hcs := static.TestCatalogs(t, conn, proj.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 
@@ -333,7 +464,7 @@ func TestList_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test with non-anon user
@@ -385,6 +516,9 @@ func TestList_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	hcNoHosts := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	preferredEndpoints := []string{"cidr:1.2.3.4", "dns:*.foobar.com"}
@@ -446,7 +580,7 @@ func TestList_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test with non-anon user
@@ -494,10 +628,13 @@ func TestDelete_Static(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn, pluginRepoFn)
+	s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -565,6 +702,9 @@ func TestDelete_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	name := "test"
 	plg := hostplugin.TestPlugin(t, conn, name)
 	plgm := map[string]plgpb.HostPluginServiceClient{
@@ -574,7 +714,7 @@ func TestDelete_Plugin(t *testing.T) {
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := plugin.TestSet(t, conn, kms, sche, hc, plgm)
 
-	s, err := host_sets.NewService(repoFn, pluginRepoFn)
+	s, err := host_sets.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -643,10 +783,13 @@ func TestDelete_twice(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostSetRequest{
 		Id: h.GetPublicId(),
@@ -680,6 +823,9 @@ func TestCreate_Static(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	prefEndpoints := []string{"cidr:1.2.3.4", "cidr:2.3.4.5/24"}
 
@@ -783,7 +929,7 @@ func TestCreate_Static(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := host_sets.NewService(repoFn, plgRepoFn)
+			s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -844,6 +990,9 @@ func TestCreate_Plugin(t *testing.T) {
 			}),
 		})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 
 	attrs := map[string]any{
@@ -1048,7 +1197,7 @@ func TestCreate_Plugin(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := host_sets.NewService(repoFn, plgRepoFn)
+			s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -1132,7 +1281,10 @@ func TestUpdate_Static(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	tested, err := host_sets.NewService(repoFn, plgRepoFn)
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	tested, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -1485,10 +1637,13 @@ func TestUpdate_Plugin(t *testing.T) {
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepo := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	tested, err := host_sets.NewService(repoFn, pluginHostRepo)
+	tested, err := host_sets.NewService(repoFn, pluginHostRepo, dnsHostRepo, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Failed to create a new host catalog service.")
 
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
@@ -1822,7 +1977,10 @@ func TestAddHostSetHosts(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -1943,7 +2101,10 @@ func TestSetHostSetHosts(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2060,7 +2221,10 @@ func TestRemoveHostSetHosts(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2174,3 +2338,187 @@ func TestRemoveHostSetHosts(t *testing.T) {
 		})
 	}
 }
+
+func TestHostSetHealthCheck(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	plgRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
+	require.NoError(t, err, "Error when getting new host set service.")
+
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	ss := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+
+	set, err := s.SetHostSetHealthCheck(ctx, &pbs.SetHostSetHealthCheckRequest{
+		Id: ss.GetPublicId(),
+		Item: &pb.HostSetHealthCheck{
+			Type: "http",
+			Port: 8080,
+		},
+	})
+	require.NoError(t, err)
+	got := set.GetItem().GetHealthCheck()
+	require.NotNil(t, got)
+	assert.Equal(t, "http", got.GetType())
+	assert.Equal(t, uint32(8080), got.GetPort())
+	assert.Equal(t, "/", got.GetHttpPath())
+	assert.Equal(t, uint32(30), got.GetIntervalSeconds())
+	assert.Equal(t, uint32(5), got.GetTimeoutSeconds())
+	assert.NotNil(t, got.GetCreatedTime())
+
+	set, err = s.SetHostSetHealthCheck(ctx, &pbs.SetHostSetHealthCheckRequest{
+		Id: ss.GetPublicId(),
+		Item: &pb.HostSetHealthCheck{
+			Type:            "tcp",
+			Port:            22,
+			IntervalSeconds: 10,
+			TimeoutSeconds:  2,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tcp", set.GetItem().GetHealthCheck().GetType())
+	assert.Empty(t, set.GetItem().GetHealthCheck().GetHttpPath())
+
+	read, err := s.GetHostSet(ctx, &pbs.GetHostSetRequest{Id: ss.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(set.GetItem().GetHealthCheck(), read.GetItem().GetHealthCheck(), protocmp.Transform()))
+
+	removed, err := s.RemoveHostSetHealthCheck(ctx, &pbs.RemoveHostSetHealthCheckRequest{Id: ss.GetPublicId()})
+	require.NoError(t, err)
+	assert.Nil(t, removed.GetItem().GetHealthCheck())
+	read, err = s.GetHostSet(ctx, &pbs.GetHostSetRequest{Id: ss.GetPublicId()})
+	require.NoError(t, err)
+	assert.Nil(t, read.GetItem().GetHealthCheck())
+
+	_, err = s.RemoveHostSetHealthCheck(ctx, &pbs.RemoveHostSetHealthCheckRequest{Id: ss.GetPublicId()})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+
+	failCases := []struct {
+		name string
+		req  *pbs.SetHostSetHealthCheckRequest
+	}{
+		{
+			name: "Bad Set Id",
+			req:  &pbs.SetHostSetHealthCheckRequest{Id: "bad id", Item: &pb.HostSetHealthCheck{Type: "tcp", Port: 22}},
+		},
+		{
+			name: "Missing Item",
+			req:  &pbs.SetHostSetHealthCheckRequest{Id: ss.GetPublicId()},
+		},
+		{
+			name: "Unknown Type",
+			req:  &pbs.SetHostSetHealthCheckRequest{Id: ss.GetPublicId(), Item: &pb.HostSetHealthCheck{Type: "icmp", Port: 22}},
+		},
+		{
+			name: "Missing Port",
+			req:  &pbs.SetHostSetHealthCheckRequest{Id: ss.GetPublicId(), Item: &pb.HostSetHealthCheck{Type: "tcp"}},
+		},
+		{
+			name: "Path On Tcp Check",
+			req:  &pbs.SetHostSetHealthCheckRequest{Id: ss.GetPublicId(), Item: &pb.HostSetHealthCheck{Type: "tcp", Port: 22, HttpPath: "/"}},
+		},
+		{
+			name: "Timeout Not Less Than Interval",
+			req:  &pbs.SetHostSetHealthCheckRequest{Id: ss.GetPublicId(), Item: &pb.HostSetHealthCheck{Type: "tcp", Port: 22, IntervalSeconds: 10, TimeoutSeconds: 10}},
+		},
+	}
+	for _, tc := range failCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, gErr := s.SetHostSetHealthCheck(ctx, tc.req)
+			require.Error(t, gErr)
+			assert.True(t, errors.Is(gErr, handlers.ApiErrorWithCode(codes.InvalidArgument)), "SetHostSetHealthCheck(%+v) got error %v", tc.req, gErr)
+		})
+	}
+}
+
+func TestSyncHostSet(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	plg := hostplugin.TestPlugin(t, conn, "test")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): plugin.NewWrappingPluginClient(&plugin.TestPluginServer{}),
+	}
+	plgRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, plgm)
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
+	s, err := host_sets.NewService(repoFn, plgRepoFn, dnsHostRepoFn, testHistoryRepoFn(t, conn, wrapper), testHealthRepoFn(t, conn, wrapper))
+	require.NoError(t, err, "Error when getting new host set service.")
+
+	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
+	hs := plugin.TestSet(t, conn, kms, sche, hc, plgm)
+	shc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	ss := static.TestSets(t, conn, shc.GetPublicId(), 1)[0]
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+
+	got, err := s.SyncHostSet(ctx, &pbs.SyncHostSetRequest{Id: hs.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, hs.GetPublicId(), got.GetItem().GetId())
+	assert.Equal(t, plugin.Subtype.String(), got.GetItem().GetType())
+	assert.Equal(t, plg.GetPublicId(), got.GetItem().GetPlugin().GetId())
+	assert.Equal(t, testAuthorizedActions[plugin.Subtype], got.GetItem().GetAuthorizedActions())
+	// The set has not yet been synced by the job.
+	assert.Nil(t, got.GetItem().GetSyncStatus())
+
+	_, err = s.SyncHostSet(ctx, &pbs.SyncHostSetRequest{Id: globals.PluginHostSetPrefix + "_DoesntExis"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.NotFoundError()), "got error %v", err)
+
+	failCases := []struct {
+		name string
+		req  *pbs.SyncHostSetRequest
+	}{
+		{
+			name: "Bad Set Id",
+			req:  &pbs.SyncHostSetRequest{Id: "bad id"},
+		},
+		{
+			name: "Static Set",
+			req:  &pbs.SyncHostSetRequest{Id: ss.GetPublicId()},
+		},
+	}
+	for _, tc := range failCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, gErr := s.SyncHostSet(ctx, tc.req)
+			require.Error(t, gErr)
+			assert.True(t, errors.Is(gErr, handlers.ApiErrorWithCode(codes.InvalidArgument)), "SyncHostSet(%+v) got error %v", tc.req, gErr)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package host_sets
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/host/static"
+	staticstore "github.com/hashicorp/boundary/internal/host/static/store"
+)
+
+// The oplog type names of the items making up a static host set.
+const (
+	staticHostSetType       = "static_host_set"
+	staticHostSetMemberType = "static_host_set_member"
+)
+
+// deletedSetCatalogId returns the id of the catalog a deleted host set
+// belonged to, as last recorded by the oplog.
+func (s Service) deletedSetCatalogId(ctx context.Context, id string) (string, error) {
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return "", err
+	}
+	state, err := repo.ResourceState(ctx, id, time.Now())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", handlers.NotFoundError()
+		}
+		return "", err
+	}
+	hs, ok := state.Resource(staticHostSetType).(*staticstore.HostSet)
+	if !ok {
+		return "", handlers.NotFoundError()
+	}
+	return hs.GetCatalogId(), nil
+}
+
+// validateRestoreDependencies verifies that the catalog and the hosts the
+// host set referenced in the state still exist.
+func (s Service) validateRestoreDependencies(ctx context.Context, want *history.State) error {
+	hs := want.Resource(staticHostSetType).(*staticstore.HostSet)
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return err
+	}
+	cat, err := repo.LookupCatalog(ctx, hs.GetCatalogId())
+	if err != nil {
+		return err
+	}
+	if cat == nil {
+		return handlers.RestoreDependencyError("host catalog", hs.GetCatalogId())
+	}
+	for _, m := range want.Items(staticHostSetMemberType) {
+		id := m.(*staticstore.HostSetMember).GetHostId()
+		h, err := repo.LookupHost(ctx, id)
+		if err != nil {
+			return err
+		}
+		if h == nil {
+			return handlers.RestoreDependencyError("host", id)
+		}
+	}
+	return nil
+}
+
+// restoreInRepo brings the host set to the wanted state. A deleted host set
+// is recreated with its original id. The host set's attributes and hosts are
+// restored in a single repository transaction.
+func (s Service) restoreInRepo(ctx context.Context, projectId string, want *history.State) error {
+	const op = "host_sets.(Service).restoreInRepo"
+	repo, err := s.staticRepoFn()
+	if err != nil {
+		return err
+	}
+	whs := want.Resource(staticHostSetType).(*staticstore.HostSet)
+	hs, err := static.NewHostSet(whs.GetCatalogId(), static.WithName(whs.GetName()), static.WithDescription(whs.GetDescription()))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	hs.PublicId = want.PublicId
+	hs.Filter = whs.GetFilter()
+
+	var ids []string
+	for _, m := range want.Items(staticHostSetMemberType) {
+		ids = append(ids, m.(*staticstore.HostSetMember).GetHostId())
+	}
+	if _, _, err := repo.RestoreSet(ctx, projectId, hs, ids); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to restore host set"))
+	}
+	return nil
+}
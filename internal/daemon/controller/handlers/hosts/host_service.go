@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/host/static/store"
@@ -47,6 +49,10 @@ var (
 			action.NoOp,
 			action.Read,
 		},
+		dns.Subtype: {
+			action.NoOp,
+			action.Read,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -71,13 +77,15 @@ type Service struct {
 
 	staticRepoFn common.StaticRepoFactory
 	pluginRepoFn common.PluginHostRepoFactory
+	dnsRepoFn    common.DnsHostRepoFactory
+	healthRepoFn common.HostHealthRepoFactory
 }
 
 var _ pbs.HostServiceServer = (*Service)(nil)
 
 // NewService returns a host Service which handles host related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory) (Service, error) {
+func NewService(repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, dnsRepoFn common.DnsHostRepoFactory, healthRepoFn common.HostHealthRepoFactory) (Service, error) {
 	const op = "hosts.NewService"
 	if repoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static repository")
@@ -85,7 +93,13 @@ func NewService(repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostR
 	if pluginRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing plugin host repository")
 	}
-	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn}, nil
+	if dnsRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing dns host repository")
+	}
+	if healthRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing host health repository")
+	}
+	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn, dnsRepoFn: dnsRepoFn, healthRepoFn: healthRepoFn}, nil
 }
 
 func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs.ListHostsResponse, error) {
@@ -192,6 +206,11 @@ func (s Service) GetHost(ctx context.Context, req *pbs.GetHostRequest) (*pbs.Get
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.HealthField) {
+		if item.Health, err = s.healthFromRepo(ctx, h.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetHostResponse{Item: item}, nil
 }
@@ -324,6 +343,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (host.Host, *plugin
 		}
 		h = ph
 		plg = toPluginInfo(hPlg)
+	case dns.Subtype:
+		repo, err := s.dnsRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		dh, err := repo.LookupHost(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if dh == nil {
+			return nil, nil, handlers.NotFoundErrorf("Host %q doesn't exist.", id)
+		}
+		h = dh
 	}
 	return h, plg, nil
 }
@@ -335,6 +367,12 @@ func (s Service) createInRepo(ctx context.Context, projectId, catalogId string,
 	if ha.GetAddress() != nil {
 		opts = append(opts, static.WithAddress(ha.GetAddress().GetValue()))
 	}
+	if len(ha.GetLabels()) > 0 {
+		opts = append(opts, static.WithLabels(ha.GetLabels()))
+	}
+	if ha.GetPort() != nil {
+		opts = append(opts, static.WithPort(ha.GetPort().GetValue()))
+	}
 	if item.GetName() != nil {
 		opts = append(opts, static.WithName(item.GetName().GetValue()))
 	}
@@ -373,6 +411,12 @@ func (s Service) updateInRepo(ctx context.Context, projectId, catalogId, id stri
 	if addr := ha.GetAddress(); addr != nil {
 		opts = append(opts, static.WithAddress(addr.GetValue()))
 	}
+	if labels := ha.GetLabels(); len(labels) > 0 {
+		opts = append(opts, static.WithLabels(labels))
+	}
+	if port := ha.GetPort(); port != nil {
+		opts = append(opts, static.WithPort(port.GetValue()))
+	}
 	h, err := static.NewHost(catalogId, opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host for update"))
@@ -438,10 +482,47 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Hos
 			hosts = append(hosts, h)
 		}
 		plg = toPluginInfo(hlPlg)
+	case dns.Subtype:
+		repo, err := s.dnsRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		hl, err := repo.ListHostsByCatalogId(ctx, catalogId)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, h := range hl {
+			hosts = append(hosts, h)
+		}
 	}
 	return hosts, plg, nil
 }
 
+func (s Service) healthFromRepo(ctx context.Context, id string) ([]*pb.HostHealth, error) {
+	const op = "hosts.(Service).healthFromRepo"
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	hh, err := repo.ListHostHealth(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	now := time.Now()
+	var out []*pb.HostHealth
+	for _, h := range hh {
+		out = append(out, &pb.HostHealth{
+			HostSetId:       h.GetHostSetId(),
+			Status:          string(h.CurrentStatus(now)),
+			WorkerId:        h.GetWorkerId(),
+			Error:           h.GetError(),
+			LastCheckTime:   h.GetLastCheckTime().GetTimestamp(),
+			LastHealthyTime: h.GetLastHealthyTime().GetTimestamp(),
+		})
+	}
+	return out, nil
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (host.Catalog, auth.VerifyResults) {
 	res := auth.VerifyResults{}
 	staticRepo, err := s.staticRepoFn()
@@ -454,6 +535,11 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	dnsRepo, err := s.dnsRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Host), auth.WithAction(a)}
@@ -484,6 +570,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = h.GetCatalogId()
+		case dns.Subtype:
+			h, err := dnsRepo.LookupHost(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if h == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = h.GetCatalogId()
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -512,6 +609,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		cat = plcat
+	case dns.Subtype:
+		dcat, err := dnsRepo.LookupCatalog(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if dcat == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		cat = dcat
 	}
 	opts = append(opts, auth.WithScopeId(cat.GetProjectId()), auth.WithPin(parentId))
 	return cat, auth.Verify(ctx, opts...)
@@ -548,6 +656,8 @@ func toProto(ctx context.Context, in host.Host, opt ...handlers.Option) (*pb.Hos
 			out.Type = static.Subtype.String()
 		case *plugin.Host:
 			out.Type = plugin.Subtype.String()
+		case *dns.Host:
+			out.Type = dns.Subtype.String()
 		}
 	}
 	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
@@ -577,10 +687,15 @@ func toProto(ctx context.Context, in host.Host, opt ...handlers.Option) (*pb.Hos
 	if outputFields.Has(globals.AttributesField) {
 		switch h := in.(type) {
 		case *static.Host:
+			attrs := &pb.StaticHostAttributes{
+				Address: wrapperspb.String(h.GetAddress()),
+				Labels:  h.GetLabels(),
+			}
+			if h.GetPort() != 0 {
+				attrs.Port = wrapperspb.UInt32(h.GetPort())
+			}
 			out.Attrs = &pb.Host_StaticHostAttributes{
-				StaticHostAttributes: &pb.StaticHostAttributes{
-					Address: wrapperspb.String(h.GetAddress()),
-				},
+				StaticHostAttributes: attrs,
 			}
 		}
 	}
@@ -598,6 +713,16 @@ func toProto(ctx context.Context, in host.Host, opt ...handlers.Option) (*pb.Hos
 		if outputFields.Has(globals.ExternalIdField) {
 			out.ExternalId = h.ExternalId
 		}
+	case *dns.Host:
+		if outputFields.Has(globals.IpAddressesField) {
+			out.IpAddresses = h.IpAddresses
+		}
+		if outputFields.Has(globals.DnsNamesField) {
+			out.DnsNames = h.DnsNames
+		}
+		if outputFields.Has(globals.ExternalIdField) {
+			out.ExternalId = h.ExternalId
+		}
 	}
 	return &out, nil
 }
@@ -617,7 +742,7 @@ func validateGetRequest(req *pbs.GetHostRequest) error {
 			badFields["id"] = "Improperly formatted identifier used."
 		}
 		return badFields
-	}, req, globals.StaticHostPrefix, globals.PluginHostPrefix, globals.PluginHostPreviousPrefix)
+	}, req, globals.StaticHostPrefix, globals.PluginHostPrefix, globals.PluginHostPreviousPrefix, globals.DnsHostPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateHostRequest) error {
@@ -657,8 +782,14 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 						badFields[globals.AttributesAddressField] = fmt.Sprintf("Error parsing address: %v.", err)
 					}
 				}
+				if msg := validateLabels(attrs.GetLabels()); msg != "" {
+					badFields[globals.AttributesLabelsField] = msg
+				}
+				if port := attrs.GetPort(); port != nil && !validPort(port.GetValue()) {
+					badFields[globals.AttributesPortField] = fmt.Sprintf("Port must be between 1 and %d.", static.MaxHostPort)
+				}
 			}
-		case plugin.Subtype:
+		case plugin.Subtype, dns.Subtype:
 			badFields[globals.HostCatalogIdField] = "Cannot manually create hosts for this type of catalog."
 		}
 		return badFields
@@ -696,7 +827,17 @@ func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 					}
 				}
 			}
-		case plugin.Subtype:
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.AttributesLabelsField) {
+				if msg := validateLabels(req.GetItem().GetStaticHostAttributes().GetLabels()); msg != "" {
+					badFields[globals.AttributesLabelsField] = msg
+				}
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), globals.AttributesPortField) {
+				if port := req.GetItem().GetStaticHostAttributes().GetPort(); port != nil && !validPort(port.GetValue()) {
+					badFields[globals.AttributesPortField] = fmt.Sprintf("Port must be between 1 and %d.", static.MaxHostPort)
+				}
+			}
+		case plugin.Subtype, dns.Subtype:
 			badFields[globals.IdField] = "Cannot modify this type of host."
 		default:
 			badFields["id"] = "Improperly formatted identifier used."
@@ -705,11 +846,32 @@ func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 	}, globals.StaticHostPrefix)
 }
 
+// validateLabels returns a message describing the first invalid label in
+// labels or an empty string if all labels are valid.
+func validateLabels(labels map[string]string) string {
+	for k, v := range labels {
+		switch {
+		case strings.TrimSpace(k) == "":
+			return "Label keys must not be empty."
+		case len(k) > static.MaxHostLabelLength:
+			return fmt.Sprintf("Label key %q must be at most %d characters.", k, static.MaxHostLabelLength)
+		case len(v) > static.MaxHostLabelLength:
+			return fmt.Sprintf("Value of label %q must be at most %d characters.", k, static.MaxHostLabelLength)
+		}
+	}
+	return ""
+}
+
+// validPort reports whether port can be used as the port of a static host.
+func validPort(port uint32) bool {
+	return port > 0 && port <= static.MaxHostPort
+}
+
 func validateDeleteRequest(req *pbs.DeleteHostRequest) error {
 	return handlers.ValidateDeleteRequest(func() map[string]string {
 		badFields := map[string]string{}
 		switch subtypes.SubtypeFromId(domain, req.GetId()) {
-		case plugin.Subtype:
+		case plugin.Subtype, dns.Subtype:
 			badFields[globals.IdField] = "Cannot manually delete this type of host."
 		}
 		return badFields
@@ -718,7 +880,7 @@ func validateDeleteRequest(req *pbs.DeleteHostRequest) error {
 
 func validateListRequest(req *pbs.ListHostsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetHostCatalogId()), globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetHostCatalogId()), globals.StaticHostCatalogPrefix, globals.PluginHostCatalogPrefix, globals.PluginHostCatalogPreviousPrefix, globals.DnsHostCatalogPrefix) {
 		badFields["host_catalog_id"] = "The field is incorrectly formatted."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -10,27 +10,33 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/hosts"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -46,6 +52,15 @@ var testAuthorizedActions = map[subtypes.Subtype][]string{
 	plugin.Subtype: {"no-op", "read"},
 }
 
+func testHealthRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.HostHealthRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*health.Repository, error) {
+		return health.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
+}
+
 func TestGet_Static(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -64,6 +79,9 @@ func TestGet_Static(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -121,7 +139,7 @@ func TestGet_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -138,6 +156,64 @@ func TestGet_Static(t *testing.T) {
 	}
 }
 
+func TestGet_Health(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	pluginRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	healthRepoFn := testHealthRepoFn(t, conn, wrapper)
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+
+	healthRepo, err := healthRepoFn()
+	require.NoError(t, err)
+	check, err := health.NewHealthCheck(ctx, hs.GetPublicId(), health.TcpCheck, 22)
+	require.NoError(t, err)
+	_, err = healthRepo.SetHealthCheck(ctx, proj.GetPublicId(), check)
+	require.NoError(t, err)
+	checkTime := time.Now().Truncate(time.Microsecond)
+	w := server.TestKmsWorker(t, conn, wrapper)
+	result, err := health.NewHostHealth(ctx, hs.GetPublicId(), h.GetPublicId(), w.GetPublicId(), false, checkTime, health.WithError("connection refused"))
+	require.NoError(t, err)
+	require.NoError(t, healthRepo.UpsertHostHealth(ctx, []*health.HostHealth{result}))
+
+	s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, healthRepoFn)
+	require.NoError(t, err, "Couldn't create a new host service.")
+	got, err := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.GetHostRequest{Id: h.GetPublicId()})
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff([]*pb.HostHealth{
+		{
+			HostSetId:     hs.GetPublicId(),
+			Status:        "unhealthy",
+			WorkerId:      w.GetPublicId(),
+			Error:         "connection refused",
+			LastCheckTime: timestamppb.New(checkTime),
+		},
+	}, got.GetItem().GetHealth(), protocmp.Transform()))
+}
+
 func TestGet_Plugin(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -161,6 +237,9 @@ func TestGet_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -232,7 +311,7 @@ func TestGet_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -268,6 +347,9 @@ func TestList_Static(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -340,7 +422,7 @@ func TestList_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -387,6 +469,9 @@ func TestList_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -461,7 +546,7 @@ func TestList_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -509,6 +594,9 @@ func TestDelete(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -519,7 +607,7 @@ func TestDelete(t *testing.T) {
 	pluginHc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	pluginH := plugin.TestHost(t, conn, pluginHc.GetPublicId(), "test")
 
-	s, err := hosts.NewService(repoFn, pluginRepoFn)
+	s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -593,13 +681,16 @@ func TestDelete_twice(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := hosts.NewService(repoFn, pluginRepoFn)
+	s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostRequest{
 		Id: h.GetPublicId(),
@@ -630,6 +721,9 @@ func TestCreate(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -810,7 +904,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -866,6 +960,9 @@ func TestUpdate_Static(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -892,7 +989,7 @@ func TestUpdate_Static(t *testing.T) {
 
 	hCreated := h.GetCreateTime().GetTimestamp().AsTime()
 
-	tested, err := hosts.NewService(repoFn, pluginRepoFn)
+	tested, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -1333,6 +1430,9 @@ func TestUpdate_Plugin(t *testing.T) {
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(context.Background(), rw, rw, kms, sche)
+	}
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
@@ -1341,7 +1441,7 @@ func TestUpdate_Plugin(t *testing.T) {
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := plugin.TestHost(t, conn, hc.GetPublicId(), "test")
 
-	tested, err := hosts.NewService(repoFn, pluginRepoFn)
+	tested, err := hosts.NewService(repoFn, pluginRepoFn, dnsHostRepoFn, testHealthRepoFn(t, conn, wrapper))
 	require.NoError(t, err)
 
 	got, err := tested.UpdateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.UpdateHostRequest{
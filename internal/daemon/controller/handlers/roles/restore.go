@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package roles
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/iam/store"
+)
+
+// The oplog type names of the items making up a role.
+const (
+	roleType             = "iam_role"
+	roleGrantType        = "iam_role_grant"
+	userRoleType         = "iam_user_role"
+	groupRoleType        = "iam_group_role"
+	managedGroupRoleType = "iam_managed_group_role"
+)
+
+// deletedRoleScopeId returns the id of the scope a deleted role was last
+// recorded in by the oplog.
+func (s Service) deletedRoleScopeId(ctx context.Context, id string) (string, error) {
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return "", err
+	}
+	state, err := repo.ResourceState(ctx, id, time.Now())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", handlers.NotFoundError()
+		}
+		return "", err
+	}
+	return state.ScopeId, nil
+}
+
+// principalIds returns the ids of the users, groups and managed groups the
+// role was assigned to in the state.
+func principalIds(state *history.State) []string {
+	var ids []string
+	for _, m := range state.Items(userRoleType) {
+		ids = append(ids, m.(*store.UserRole).GetPrincipalId())
+	}
+	for _, m := range state.Items(groupRoleType) {
+		ids = append(ids, m.(*store.GroupRole).GetPrincipalId())
+	}
+	for _, m := range state.Items(managedGroupRoleType) {
+		ids = append(ids, m.(*store.ManagedGroupRole).GetPrincipalId())
+	}
+	return ids
+}
+
+// validateRestoreDependencies verifies that the scopes, users and groups the
+// role referenced in the state still exist. Managed groups are verified when
+// the principals are set.
+func (s Service) validateRestoreDependencies(ctx context.Context, want *history.State) error {
+	r := want.Resource(roleType).(*store.Role)
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	for _, id := range []string{r.GetScopeId(), r.GetGrantScopeId()} {
+		if id == "" {
+			continue
+		}
+		scp, err := repo.LookupScope(ctx, id)
+		if err != nil {
+			return err
+		}
+		if scp == nil {
+			return handlers.RestoreDependencyError("scope", id)
+		}
+	}
+	for _, m := range want.Items(userRoleType) {
+		id := m.(*store.UserRole).GetPrincipalId()
+		if _, _, err := repo.LookupUser(ctx, id); err != nil {
+			if errors.IsNotFoundError(err) {
+				return handlers.RestoreDependencyError("user", id)
+			}
+			return err
+		}
+	}
+	for _, m := range want.Items(groupRoleType) {
+		id := m.(*store.GroupRole).GetPrincipalId()
+		if _, _, err := repo.LookupGroup(ctx, id); err != nil {
+			if errors.IsNotFoundError(err) {
+				return handlers.RestoreDependencyError("group", id)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreInRepo brings the role to the wanted state. A deleted role is
+// recreated with its original id. The role's attributes, grants and
+// principals are restored in a single repository transaction.
+func (s Service) restoreInRepo(ctx context.Context, want *history.State) error {
+	const op = "roles.(Service).restoreInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	wr := want.Resource(roleType).(*store.Role)
+	r, err := iam.NewRole(wr.GetScopeId(), iam.WithName(wr.GetName()), iam.WithDescription(wr.GetDescription()), iam.WithGrantScopeId(wr.GetGrantScopeId()))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	r.PublicId = want.PublicId
+
+	grants := []string{}
+	for _, m := range want.Items(roleGrantType) {
+		grants = append(grants, m.(*store.RoleGrant).GetRawGrant())
+	}
+	if _, _, _, err := repo.RestoreRole(ctx, r, grants, principalIds(want)); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to restore role"))
+	}
+	return nil
+}
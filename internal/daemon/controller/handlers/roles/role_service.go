@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/perms"
@@ -43,6 +44,8 @@ var (
 		action.AddGrants,
 		action.SetGrants,
 		action.RemoveGrants,
+		action.History,
+		action.Restore,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -64,18 +67,22 @@ func init() {
 type Service struct {
 	pbs.UnsafeRoleServiceServer
 
-	repoFn common.IamRepoFactory
+	repoFn        common.IamRepoFactory
+	historyRepoFn common.HistoryRepoFactory
 }
 
 var _ pbs.RoleServiceServer = (*Service)(nil)
 
 // NewService returns a role service which handles role related requests to boundary.
-func NewService(repo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.IamRepoFactory, historyRepoFn common.HistoryRepoFactory) (Service, error) {
 	const op = "roles.NewService"
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repo}, nil
+	if historyRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing history repository")
+	}
+	return Service{repoFn: repo, historyRepoFn: historyRepoFn}, nil
 }
 
 // ListRoles implements the interface pbs.RoleServiceServer.
@@ -191,6 +198,55 @@ func (s Service) GetRole(ctx context.Context, req *pbs.GetRoleRequest) (*pbs.Get
 	return &pbs.GetRoleResponse{Item: item}, nil
 }
 
+// GetRoleHistory implements the interface pbs.RoleServiceServer.
+func (s Service) GetRoleHistory(ctx context.Context, req *pbs.GetRoleHistoryRequest) (*pbs.GetRoleHistoryResponse, error) {
+	if err := validateGetHistoryRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.History)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	records, err := repo.ListResourceHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetRoleHistoryResponse{Items: handlers.HistoryToProto(records)}, nil
+}
+
+// RestoreRole implements the interface pbs.RoleServiceServer.
+func (s Service) RestoreRole(ctx context.Context, req *pbs.RestoreRoleRequest) (*pbs.RestoreRoleResponse, error) {
+	if err := validateRestoreRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Restore)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	want, current, err := handlers.RestoreStates(ctx, repo, req.GetId(), req.GetTime().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateRestoreDependencies(ctx, want); err != nil {
+		return nil, err
+	}
+	changes := history.Diff(current, want)
+	if !req.GetDryRun() && len(changes) > 0 {
+		if err := s.restoreInRepo(ctx, want); err != nil {
+			return nil, err
+		}
+	}
+	return &pbs.RestoreRoleResponse{Changes: handlers.ChangesToProto(changes), DryRun: req.GetDryRun()}, nil
+}
+
 // CreateRole implements the interface pbs.RoleServiceServer.
 func (s Service) CreateRole(ctx context.Context, req *pbs.CreateRoleRequest) (*pbs.CreateRoleResponse, error) {
 	const op = "roles.(Service).CreateRole"
@@ -782,11 +838,21 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = err
 			return res
 		}
-		if r == nil {
+		switch {
+		case r != nil:
+			parentId = r.GetScopeId()
+		case a == action.Restore:
+			// A deleted role can be restored, so authorize against the
+			// scope it was last recorded in by the oplog.
+			parentId, err = s.deletedRoleScopeId(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+		default:
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-		parentId = r.GetScopeId()
 		opts = append(opts, auth.WithId(id))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
@@ -889,6 +955,14 @@ func validateGetRequest(req *pbs.GetRoleRequest) error {
 	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.RolePrefix)
 }
 
+func validateGetHistoryRequest(req *pbs.GetRoleHistoryRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.RolePrefix)
+}
+
+func validateRestoreRequest(req *pbs.RestoreRoleRequest) error {
+	return handlers.ValidateRestoreRequest(req, globals.RolePrefix)
+}
+
 func validateCreateRequest(req *pbs.CreateRoleRequest) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -14,16 +14,20 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/roles"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	historypb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/history"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	"github.com/kr/pretty"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
@@ -37,9 +41,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals", "add-grants", "set-grants", "remove-grants"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals", "add-grants", "set-grants", "remove-grants", "history", "restore"}
 
-func createDefaultRolesAndRepo(t *testing.T) (*iam.Role, *iam.Role, func() (*iam.Repository, error)) {
+func createDefaultRolesAndRepo(t *testing.T) (*iam.Role, *iam.Role, func() (*iam.Repository, error), common.HistoryRepoFactory) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
@@ -51,7 +55,16 @@ func createDefaultRolesAndRepo(t *testing.T) (*iam.Role, *iam.Role, func() (*iam
 	o, p := iam.TestScopes(t, iamRepo)
 	or := iam.TestRole(t, conn, o.GetPublicId(), iam.WithDescription("default"), iam.WithName("default"), iam.WithGrantScopeId(p.GetPublicId()))
 	pr := iam.TestRole(t, conn, p.GetPublicId(), iam.WithDescription("default"), iam.WithName("default"))
-	return or, pr, repoFn
+	return or, pr, repoFn, testHistoryRepoFn(t, conn, wrap)
+}
+
+func testHistoryRepoFn(t *testing.T, conn *db.DB, wrap wrapping.Wrapper) common.HistoryRepoFactory {
+	t.Helper()
+	rw := db.New(conn)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	return func() (*history.Repository, error) {
+		return history.NewRepository(context.Background(), rw, rw, kmsCache)
+	}
 }
 
 func equalPrincipals(role *pb.Role, principals []string) bool {
@@ -80,7 +93,7 @@ func equalPrincipals(role *pb.Role, principals []string) bool {
 
 func TestGet(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	or, pr, repoFn := createDefaultRolesAndRepo(t)
+	or, pr, repoFn, historyRepoFn := createDefaultRolesAndRepo(t)
 	toMerge := &pbs.GetRoleRequest{
 		Id: or.GetPublicId(),
 	}
@@ -175,7 +188,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetRoleRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := roles.NewService(repoFn)
+			s, err := roles.NewService(repoFn, historyRepoFn)
 			require.NoError(err, "Couldn't create new role service.")
 
 			got, gErr := s.GetRole(auth.DisabledAuthTestContext(repoFn, tc.scopeId), req)
@@ -195,6 +208,7 @@ func TestList(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
 	oNoRoles, pWithRoles := iam.TestScopes(t, iamRepo, iam.WithSkipDefaultRoleCreation(true))
 	oWithRoles, pNoRoles := iam.TestScopes(t, iamRepo, iam.WithSkipDefaultRoleCreation(true))
 	var wantOrgRoles []*pb.Role
@@ -298,7 +312,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := roles.NewService(repoFn)
+			s, err := roles.NewService(repoFn, historyRepoFn)
 			require.NoError(err, "Couldn't create new role service.")
 
 			// Test the non-anon case
@@ -328,10 +342,60 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestGetHistory(t *testing.T) {
+	or, _, repoFn, historyRepoFn := createDefaultRolesAndRepo(t)
+	s, err := roles.NewService(repoFn, historyRepoFn)
+	require.NoError(t, err, "Couldn't create new role service.")
+
+	ctx := auth.DisabledAuthTestContext(repoFn, or.GetScopeId())
+	created, err := s.CreateRole(ctx, &pbs.CreateRoleRequest{Item: &pb.Role{
+		ScopeId: or.GetScopeId(),
+		Name:    wrapperspb.String("history"),
+	}})
+	require.NoError(t, err)
+	id := created.GetItem().GetId()
+	_, err = s.UpdateRole(ctx, &pbs.UpdateRoleRequest{
+		Id: id,
+		Item: &pb.Role{
+			Name:    wrapperspb.String("history-updated"),
+			Version: created.GetItem().GetVersion(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	})
+	require.NoError(t, err)
+
+	changeValues := func(r *historypb.Record) []string {
+		var ret []string
+		for _, c := range r.GetChanges() {
+			ret = append(ret, fmt.Sprintf("%s %s %s: %s -> %s", c.GetOperation(), c.GetType(), c.GetField(), c.GetOldValue(), c.GetNewValue()))
+		}
+		return ret
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.GetRoleHistory(ctx, &pbs.GetRoleHistoryRequest{Id: id})
+		require.NoError(err)
+		require.Len(got.GetItems(), 2)
+		assert.Contains(changeValues(got.GetItems()[0]), "create iam_role name: -> history")
+		assert.Contains(changeValues(got.GetItems()[1]), "update iam_role name: history -> history-updated")
+	})
+	t.Run("not-found", func(t *testing.T) {
+		_, err := s.GetRoleHistory(ctx, &pbs.GetRoleHistoryRequest{Id: globals.RolePrefix + "_DoesntExis"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", err)
+	})
+	t.Run("bad-id", func(t *testing.T) {
+		_, err := s.GetRoleHistory(ctx, &pbs.GetRoleHistoryRequest{Id: "j_1234567890"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
+}
+
 func TestDelete(t *testing.T) {
-	or, pr, repoFn := createDefaultRolesAndRepo(t)
+	or, pr, repoFn, historyRepoFn := createDefaultRolesAndRepo(t)
 
-	s, err := roles.NewService(repoFn)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	cases := []struct {
@@ -395,9 +459,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	or, pr, repoFn := createDefaultRolesAndRepo(t)
+	or, pr, repoFn, historyRepoFn := createDefaultRolesAndRepo(t)
 
-	s, err := roles.NewService(repoFn)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(err, "Error when getting new role service")
 	req := &pbs.DeleteRoleRequest{
 		Id: or.GetPublicId(),
@@ -421,7 +485,7 @@ func TestDelete_twice(t *testing.T) {
 }
 
 func TestCreate(t *testing.T) {
-	defaultOrgRole, defaultProjRole, repoFn := createDefaultRolesAndRepo(t)
+	defaultOrgRole, defaultProjRole, repoFn, historyRepoFn := createDefaultRolesAndRepo(t)
 	defaultCreated := defaultOrgRole.GetCreateTime().GetTimestamp().AsTime()
 	toMerge := &pbs.CreateRoleRequest{}
 
@@ -542,7 +606,7 @@ func TestCreate(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.CreateRoleRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := roles.NewService(repoFn)
+			s, err := roles.NewService(repoFn, historyRepoFn)
 			require.NoError(err, "Error when getting new role service.")
 
 			got, gErr := s.CreateRole(auth.DisabledAuthTestContext(repoFn, tc.req.GetItem().GetScopeId()), req)
@@ -589,6 +653,7 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
 
 	o, p := iam.TestScopes(t, iamRepo)
 	u := iam.TestUser(t, iamRepo, o.GetPublicId())
@@ -610,7 +675,7 @@ func TestUpdate(t *testing.T) {
 	var orVersion uint32 = 1
 	var prVersion uint32 = 1
 
-	tested, err := roles.NewService(repoFn)
+	tested, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	resetRoles := func(proj bool) {
@@ -1017,8 +1082,9 @@ func TestAddPrincipal(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
 	o, p := iam.TestScopes(t, iamRepo)
-	s, err := roles.NewService(repoFn)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	ctx := context.Background()
@@ -1213,7 +1279,8 @@ func TestSetPrincipal(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := roles.NewService(repoFn)
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	o, p := iam.TestScopes(t, iamRepo)
@@ -1403,7 +1470,8 @@ func TestRemovePrincipal(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := roles.NewService(repoFn)
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	o, p := iam.TestScopes(t, iamRepo)
@@ -1653,7 +1721,8 @@ func TestAddGrants(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := roles.NewService(repoFn)
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	addCases := []struct {
@@ -1791,8 +1860,9 @@ func TestSetGrants(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
 
-	s, err := roles.NewService(repoFn)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	setCases := []struct {
@@ -1928,7 +1998,8 @@ func TestRemoveGrants(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	s, err := roles.NewService(repoFn)
+	historyRepoFn := testHistoryRepoFn(t, conn, wrap)
+	s, err := roles.NewService(repoFn, historyRepoFn)
 	require.NoError(t, err, "Error when getting new role service.")
 
 	removeCases := []struct {
@@ -102,6 +102,7 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 			structpb.NewStringValue("list-key-version-destruction-jobs"),
 			structpb.NewStringValue("destroy-key-version"),
+			structpb.NewStringValue("tail-events"),
 		},
 	},
 	"users": {
@@ -153,6 +154,7 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 			structpb.NewStringValue("list-key-version-destruction-jobs"),
 			structpb.NewStringValue("destroy-key-version"),
+			structpb.NewStringValue("tail-events"),
 		},
 	},
 	"users": {
@@ -199,6 +201,7 @@ var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
 			structpb.NewStringValue("rotate-keys"),
 			structpb.NewStringValue("list-key-version-destruction-jobs"),
 			structpb.NewStringValue("destroy-key-version"),
+			structpb.NewStringValue("tail-events"),
 		},
 	},
 	"targets": {
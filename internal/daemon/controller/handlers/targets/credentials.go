@@ -19,6 +19,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // dynamicToWorkerCredential converts the strongly typed credential.Dynamic into
@@ -56,6 +57,17 @@ func dynamicToWorkerCredential(ctx context.Context, cred credential.Dynamic) (se
 				},
 			},
 		}
+	case credential.TlsClientCertificate:
+		workerCred = &serverpb.Credential{
+			Credential: &serverpb.Credential_TlsClientCertificate{
+				TlsClientCertificate: &serverpb.TlsClientCertificate{
+					Certificate:          string(c.Certificate()),
+					PrivateKey:           string(c.PrivateKey()),
+					PrivateKeyPassphrase: string(c.PrivateKeyPassphrase()),
+					CaBundle:             string(c.CaBundle()),
+				},
+			},
+		}
 
 	default:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
@@ -125,8 +137,39 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
 			}
 
+		case credential.TlsClientCertificate:
+			credData, err = handlers.ProtoToStruct(
+				&pb.TlsClientCertificateCredential{
+					Certificate:          string(c.Certificate()),
+					PrivateKey:           string(c.PrivateKey()),
+					PrivateKeyPassphrase: string(c.PrivateKeyPassphrase()),
+					CaBundle:             string(c.CaBundle()),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
+		case credential.AwsCredential:
+			credData, err = handlers.ProtoToStruct(
+				&pb.AwsCredential{
+					AccessKeyId:     c.AccessKeyId(),
+					SecretAccessKey: c.SecretAccessKey(),
+					SessionToken:    c.SessionToken(),
+					Expiration:      timestamppb.New(c.Expiration()),
+					SigninUrl:       c.SigninUrl(),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
 		default:
-			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
+			if l.CredentialType() != credential.JsonType || sSecret == nil {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
+			}
+			// The credential of a json library is the secret itself.
+			credData = sSecret
 		}
 	}
 
@@ -174,6 +217,18 @@ func staticToWorkerCredential(ctx context.Context, cred credential.Static) (sess
 			},
 		}
 
+	case *credstatic.TlsClientCertificateCredential:
+		workerCred = &serverpb.Credential{
+			Credential: &serverpb.Credential_TlsClientCertificate{
+				TlsClientCertificate: &serverpb.TlsClientCertificate{
+					Certificate:          string(c.GetCertificate()),
+					PrivateKey:           string(c.GetPrivateKey()),
+					PrivateKeyPassphrase: string(c.GetPrivateKeyPassphrase()),
+					CaBundle:             string(c.GetCaBundle()),
+				},
+			},
+		}
+
 	default:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 	}
@@ -230,6 +285,31 @@ func staticToSessionCredential(ctx context.Context, cred credential.Static) (*pb
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for ssh private key credential"))
 		}
 
+	case *credstatic.TlsClientCertificateCredential:
+		var err error
+		credType = string(credential.TlsClientCertificateType)
+		credData, err = handlers.ProtoToStruct(
+			&pb.TlsClientCertificateCredential{
+				Certificate:          string(c.GetCertificate()),
+				PrivateKey:           string(c.GetPrivateKey()),
+				PrivateKeyPassphrase: string(c.GetPrivateKeyPassphrase()),
+				CaBundle:             string(c.GetCaBundle()),
+			},
+		)
+		secret = map[string]any{
+			"certificate": string(c.GetCertificate()),
+			"private_key": string(c.GetPrivateKey()),
+		}
+		if len(c.GetPrivateKeyPassphrase()) > 0 {
+			secret["private_key_passphrase"] = string(c.GetPrivateKeyPassphrase())
+		}
+		if len(c.GetCaBundle()) > 0 {
+			secret["ca_bundle"] = string(c.GetCaBundle())
+		}
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for tls client certificate credential"))
+		}
+
 	case *credstatic.JsonCredential:
 		var err error
 		credType = string(credential.JsonType)
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package targets
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/target"
+	targetstore "github.com/hashicorp/boundary/internal/target/store"
+	tcpstore "github.com/hashicorp/boundary/internal/target/tcp/store"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"google.golang.org/grpc/codes"
+)
+
+// The oplog type names of the items making up a target.
+const (
+	tcpTargetType               = "target_tcp"
+	targetAddressType           = "target_address"
+	targetPortType              = "target_port"
+	targetHostSetType           = "target_host_set"
+	targetCredentialLibraryType = "target_credential_library"
+	targetStaticCredentialType  = "target_static_credential"
+)
+
+// deletedTargetProjectId returns the id of the project a deleted target was
+// last recorded in by the oplog.
+func (s Service) deletedTargetProjectId(ctx context.Context, id string) (string, error) {
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return "", err
+	}
+	state, err := repo.ResourceState(ctx, id, time.Now())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return "", handlers.NotFoundError()
+		}
+		return "", err
+	}
+	return state.ScopeId, nil
+}
+
+// validateRestoreDependencies verifies that the project, host sets,
+// credential libraries and credentials the target referenced in the state
+// still exist.
+func (s Service) validateRestoreDependencies(ctx context.Context, want *history.State) error {
+	t, ok := want.Resource(tcpTargetType).(*tcpstore.Target)
+	if !ok {
+		return handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Restore is not supported for this target type.")
+	}
+
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return err
+	}
+	scp, err := iamRepo.LookupScope(ctx, t.GetProjectId())
+	if err != nil {
+		return err
+	}
+	if scp == nil {
+		return handlers.RestoreDependencyError("project", t.GetProjectId())
+	}
+
+	staticHostRepo, err := s.staticHostRepoFn()
+	if err != nil {
+		return err
+	}
+	pluginHostRepo, err := s.pluginHostRepoFn()
+	if err != nil {
+		return err
+	}
+	dnsHostRepo, err := s.dnsHostRepoFn()
+	if err != nil {
+		return err
+	}
+	for _, m := range want.Items(targetHostSetType) {
+		id := m.(*targetstore.TargetHostSet).GetHostSetId()
+		var found bool
+		switch subtypes.SubtypeFromId(hostDomain, id) {
+		case static.Subtype:
+			hs, _, err := staticHostRepo.LookupSet(ctx, id)
+			if err != nil {
+				return err
+			}
+			found = hs != nil
+		case dns.Subtype:
+			hs, err := dnsHostRepo.LookupSet(ctx, id)
+			if err != nil {
+				return err
+			}
+			found = hs != nil
+		default:
+			hs, _, err := pluginHostRepo.LookupSet(ctx, id)
+			if err != nil {
+				return err
+			}
+			found = hs != nil
+		}
+		if !found {
+			return handlers.RestoreDependencyError("host set", id)
+		}
+	}
+
+	vaultRepo, err := s.vaultCredRepoFn()
+	if err != nil {
+		return err
+	}
+	for _, m := range want.Items(targetCredentialLibraryType) {
+		id := m.(*targetstore.CredentialLibrary).GetCredentialLibraryId()
+		var found bool
+		switch subtypes.SubtypeFromId(credentialDomain, id) {
+		case vault.SSHCertificateLibrarySubtype:
+			l, err := vaultRepo.LookupSSHCertificateCredentialLibrary(ctx, id)
+			if err != nil {
+				return err
+			}
+			found = l != nil
+		case vault.PkiLibrarySubtype:
+			l, err := vaultRepo.LookupPkiCredentialLibrary(ctx, id)
+			if err != nil {
+				return err
+			}
+			found = l != nil
+		default:
+			l, err := vaultRepo.LookupCredentialLibrary(ctx, id)
+			if err != nil {
+				return err
+			}
+			found = l != nil
+		}
+		if !found {
+			return handlers.RestoreDependencyError("credential library", id)
+		}
+	}
+
+	staticCredRepo, err := s.staticCredRepoFn()
+	if err != nil {
+		return err
+	}
+	for _, m := range want.Items(targetStaticCredentialType) {
+		id := m.(*targetstore.StaticCredential).GetCredentialId()
+		c, err := staticCredRepo.LookupCredential(ctx, id)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return handlers.RestoreDependencyError("credential", id)
+		}
+	}
+	return nil
+}
+
+// restoreInRepo brings the target to the wanted state. A deleted target is
+// recreated with its original id. The target's attributes, host sources and
+// credential sources are restored in a single repository transaction.
+func (s Service) restoreInRepo(ctx context.Context, want *history.State) error {
+	const op = "targets.(Service).restoreInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return err
+	}
+	wt := want.Resource(tcpTargetType).(*tcpstore.Target)
+	var address string
+	for _, m := range want.Items(targetAddressType) {
+		address = m.(*targetstore.TargetAddress).GetAddress()
+	}
+	var ports map[string]uint32
+	for _, m := range want.Items(targetPortType) {
+		p := m.(*targetstore.TargetPort)
+		if ports == nil {
+			ports = make(map[string]uint32)
+		}
+		ports[p.GetName()] = p.GetPort()
+	}
+
+	opts := []target.Option{
+		target.WithName(wt.GetName()),
+		target.WithDescription(wt.GetDescription()),
+		target.WithDefaultPort(wt.GetDefaultPort()),
+		target.WithSessionMaxSeconds(wt.GetSessionMaxSeconds()),
+		target.WithSessionConnectionLimit(wt.GetSessionConnectionLimit()),
+		target.WithWorkerFilter(wt.GetWorkerFilter()),
+		target.WithEgressWorkerFilter(wt.GetEgressWorkerFilter()),
+		target.WithIngressWorkerFilter(wt.GetIngressWorkerFilter()),
+		target.WithHostSelectionStrategy(wt.GetHostSelectionStrategy()),
+		target.WithWorkerSelectionStrategy(wt.GetWorkerSelectionStrategy()),
+		target.WithWorkerAffinityFilter(wt.GetWorkerAffinityFilter()),
+		target.WithHostExclusionFilter(wt.GetHostExclusionFilter()),
+		target.WithHostPreferenceFilter(wt.GetHostPreferenceFilter()),
+		target.WithAddress(address),
+		target.WithPorts(ports),
+	}
+	t, err := target.New(ctx, target.SubtypeFromId(want.PublicId), wt.GetProjectId(), opts...)
+	if err != nil {
+		return err
+	}
+	if err := t.SetPublicId(ctx, want.PublicId); err != nil {
+		return err
+	}
+
+	var hostSourceIds []string
+	for _, m := range want.Items(targetHostSetType) {
+		hostSourceIds = append(hostSourceIds, m.(*targetstore.TargetHostSet).GetHostSetId())
+	}
+	var sources target.CredentialSources
+	add := func(id, purpose string) {
+		switch credential.Purpose(purpose) {
+		case credential.BrokeredPurpose:
+			sources.BrokeredCredentialIds = append(sources.BrokeredCredentialIds, id)
+		case credential.InjectedApplicationPurpose:
+			sources.InjectedApplicationCredentialIds = append(sources.InjectedApplicationCredentialIds, id)
+		}
+	}
+	for _, m := range want.Items(targetCredentialLibraryType) {
+		l := m.(*targetstore.CredentialLibrary)
+		add(l.GetCredentialLibraryId(), l.GetCredentialPurpose())
+	}
+	for _, m := range want.Items(targetStaticCredentialType) {
+		c := m.(*targetstore.StaticCredential)
+		add(c.GetCredentialId(), c.GetCredentialPurpose())
+	}
+
+	if _, _, _, err := repo.RestoreTarget(ctx, t, hostSourceIds, sources); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to restore target"))
+	}
+	return nil
+}
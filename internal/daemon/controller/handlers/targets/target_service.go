@@ -7,9 +7,9 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -17,14 +17,20 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	credkube "github.com/hashicorp/boundary/internal/credential/kubernetes"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	wl "github.com/hashicorp/boundary/internal/daemon/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/history"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -33,6 +39,7 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/selection"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -85,6 +92,8 @@ var (
 		action.SetCredentialSources,
 		action.RemoveCredentialSources,
 		action.AuthorizeSession,
+		action.History,
+		action.Restore,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -113,9 +122,16 @@ type Service struct {
 	serversRepoFn           common.ServersRepoFactory
 	sessionRepoFn           session.RepositoryFactory
 	pluginHostRepoFn        common.PluginHostRepoFactory
+	dnsHostRepoFn           common.DnsHostRepoFactory
 	staticHostRepoFn        common.StaticRepoFactory
 	vaultCredRepoFn         common.VaultCredentialRepoFactory
 	staticCredRepoFn        common.StaticCredentialRepoFactory
+	pluginCredRepoFn        common.PluginCredentialRepoFactory
+	kubeCredRepoFn          common.KubernetesCredentialRepoFactory
+	awsCredRepoFn           common.AwsCredentialRepoFactory
+	historyRepoFn           common.HistoryRepoFactory
+	selectionRepoFn         common.SelectionRepoFactory
+	hostHealthRepoFn        common.HostHealthRepoFactory
 	downstreams             common.Downstreamers
 	kmsCache                *kms.Kms
 	workerStatusGracePeriod *atomic.Int64
@@ -132,9 +148,16 @@ func NewService(
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn session.RepositoryFactory,
 	pluginHostRepoFn common.PluginHostRepoFactory,
+	dnsHostRepoFn common.DnsHostRepoFactory,
 	staticHostRepoFn common.StaticRepoFactory,
 	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	staticCredRepoFn common.StaticCredentialRepoFactory,
+	pluginCredRepoFn common.PluginCredentialRepoFactory,
+	kubeCredRepoFn common.KubernetesCredentialRepoFactory,
+	awsCredRepoFn common.AwsCredentialRepoFactory,
+	historyRepoFn common.HistoryRepoFactory,
+	selectionRepoFn common.SelectionRepoFactory,
+	hostHealthRepoFn common.HostHealthRepoFactory,
 	downstreams common.Downstreamers,
 	workerStatusGracePeriod *atomic.Int64,
 ) (Service, error) {
@@ -154,6 +177,9 @@ func NewService(
 	if pluginHostRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin host repository")
 	}
+	if dnsHostRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing dns host repository")
+	}
 	if staticHostRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static host repository")
 	}
@@ -163,15 +189,40 @@ func NewService(
 	if staticCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if pluginCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin credential repository")
+	}
+	if kubeCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing kubernetes credential repository")
+	}
+	if awsCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing aws credential repository")
+	}
+	if historyRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing history repository")
+	}
+	if selectionRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing selection repository")
+	}
+	if hostHealthRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing host health repository")
+	}
 	return Service{
 		repoFn:                  repoFn,
 		iamRepoFn:               iamRepoFn,
 		serversRepoFn:           serversRepoFn,
 		sessionRepoFn:           sessionRepoFn,
 		pluginHostRepoFn:        pluginHostRepoFn,
+		dnsHostRepoFn:           dnsHostRepoFn,
 		staticHostRepoFn:        staticHostRepoFn,
 		vaultCredRepoFn:         vaultCredRepoFn,
 		staticCredRepoFn:        staticCredRepoFn,
+		pluginCredRepoFn:        pluginCredRepoFn,
+		kubeCredRepoFn:          kubeCredRepoFn,
+		awsCredRepoFn:           awsCredRepoFn,
+		historyRepoFn:           historyRepoFn,
+		selectionRepoFn:         selectionRepoFn,
+		hostHealthRepoFn:        hostHealthRepoFn,
 		downstreams:             downstreams,
 		kmsCache:                kmsCache,
 		workerStatusGracePeriod: workerStatusGracePeriod,
@@ -300,6 +351,55 @@ func (s Service) GetTarget(ctx context.Context, req *pbs.GetTargetRequest) (*pbs
 	return &pbs.GetTargetResponse{Item: item}, nil
 }
 
+// GetTargetHistory implements the interface pbs.TargetServiceServer.
+func (s Service) GetTargetHistory(ctx context.Context, req *pbs.GetTargetHistoryRequest) (*pbs.GetTargetHistoryResponse, error) {
+	if err := validateGetHistoryRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.History)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	records, err := repo.ListResourceHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetTargetHistoryResponse{Items: handlers.HistoryToProto(records)}, nil
+}
+
+// RestoreTarget implements the interface pbs.TargetServiceServer.
+func (s Service) RestoreTarget(ctx context.Context, req *pbs.RestoreTargetRequest) (*pbs.RestoreTargetResponse, error) {
+	if err := validateRestoreRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Restore)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.historyRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	want, current, err := handlers.RestoreStates(ctx, repo, req.GetId(), req.GetTime().AsTime())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateRestoreDependencies(ctx, want); err != nil {
+		return nil, err
+	}
+	changes := history.Diff(current, want)
+	if !req.GetDryRun() && len(changes) > 0 {
+		if err := s.restoreInRepo(ctx, want); err != nil {
+			return nil, err
+		}
+	}
+	return &pbs.RestoreTargetResponse{Changes: handlers.ChangesToProto(changes), DryRun: req.GetDryRun()}, nil
+}
+
 // CreateTarget implements the interface pbs.TargetServiceServer.
 func (s Service) CreateTarget(ctx context.Context, req *pbs.CreateTargetRequest) (*pbs.CreateTargetResponse, error) {
 	const op = "targets.(Service).CreateTarget"
@@ -659,8 +759,13 @@ func AuthorizeSessionWithWorkerFilter(_ context.Context, t target.Target, select
 	return selectedWorkers, nil
 }
 
-func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSessionRequest) (*pbs.AuthorizeSessionResponse, error) {
+func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSessionRequest) (_ *pbs.AuthorizeSessionResponse, retErr error) {
 	const op = "targets.(Service).AuthorizeSession"
+	defer func() {
+		if retErr != nil {
+			metric.RecordAuthorizeSessionFailure(retErr)
+		}
+	}()
 	if err := validateAuthorizeSessionRequest(req); err != nil {
 		return nil, err
 	}
@@ -702,7 +807,19 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, handlers.ForbiddenError()
 	}
 
-	if t.GetDefaultPort() == 0 {
+	var namedPort uint32
+	if name := req.GetPortName(); name != "" {
+		port, ok := t.GetPorts()[name]
+		if !ok {
+			return nil, handlers.InvalidArgumentErrorf(
+				"Errors in provided fields.",
+				map[string]string{
+					globals.PortNameField: fmt.Sprintf("The target does not have a port named %q.", name),
+				})
+		}
+		namedPort = port
+	}
+	if namedPort == 0 && t.GetDefaultPort() == 0 {
 		return nil, handlers.ConflictErrorf("Target does not have default port defined.")
 	}
 
@@ -736,8 +853,25 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
+	selectionRepo, err := s.selectionRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	selectionReq := selection.Request{
+		TargetId:             t.GetPublicId(),
+		UserId:               authResults.UserId,
+		WorkerAffinityFilter: t.GetWorkerAffinityFilter(),
+		HostExclusionFilter:  t.GetHostExclusionFilter(),
+		HostPreferenceFilter: t.GetHostPreferenceFilter(),
+	}
 
-	p := strconv.FormatUint(uint64(t.GetDefaultPort()), 10)
+	// A named port requested by the client takes precedence over the port
+	// of the chosen host, which in turn takes precedence over the default
+	// port of the target.
+	port := t.GetDefaultPort()
+	if namedPort != 0 {
+		port = namedPort
+	}
 	var h, hostId, hostSetId string
 
 	switch {
@@ -754,8 +888,12 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		if err != nil {
 			return nil, err
 		}
+		dnsHostRepo, err := s.dnsHostRepoFn()
+		if err != nil {
+			return nil, err
+		}
 
-		var pluginHostSetIds []string
+		var pluginHostSetIds, dnsHostSetIds []string
 		var endpoints []*host.Endpoint
 		for _, hSource := range hostSources {
 			hsId := hSource.Id()
@@ -766,6 +904,8 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 					return nil, err
 				}
 				endpoints = append(endpoints, eps...)
+			case dns.Subtype:
+				dnsHostSetIds = append(dnsHostSetIds, hsId)
 			default:
 				// Batch the plugin host set ids since each round trip to the plugin
 				// has the potential to be expensive.
@@ -779,11 +919,48 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 			}
 			endpoints = append(endpoints, eps...)
 		}
+		if len(dnsHostSetIds) > 0 {
+			eps, err := dnsHostRepo.Endpoints(ctx, dnsHostSetIds)
+			if err != nil {
+				return nil, err
+			}
+			endpoints = append(endpoints, eps...)
+		}
 
 		if len(endpoints) == 0 {
 			return nil, handlers.NotFoundErrorf("No host sources or address found for given target.")
 		}
 
+		hostHealthRepo, err := s.hostHealthRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		endpoints, err = hostHealthRepo.HealthyEndpoints(ctx, endpoints)
+		if err != nil {
+			return nil, err
+		}
+		if len(endpoints) == 0 {
+			return nil, handlers.ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				"No healthy hosts are available for the given target.")
+		}
+
+		// A requested host must not be excluded by the target's host rules
+		// but is used even if it is not one of the preferred hosts.
+		rulesReq := selectionReq
+		if requestedId != "" {
+			rulesReq.HostPreferenceFilter = ""
+		}
+		endpoints, err = selection.ApplyHostRules(ctx, rulesReq, endpoints)
+		if err != nil {
+			return nil, err
+		}
+		if len(endpoints) == 0 {
+			return nil, handlers.ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				"All hosts of the given target are excluded by its host exclusion filter.")
+		}
+
 		var chosenEndpoint *host.Endpoint
 		if requestedId != "" {
 			for _, ep := range endpoints {
@@ -802,12 +979,22 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 
 		if chosenEndpoint == nil {
-			chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
+			hostSelector, err := selectionRepo.HostSelector(ctx, selection.HostStrategy(t.GetHostSelectionStrategy()))
+			if err != nil {
+				return nil, err
+			}
+			chosenEndpoint, err = hostSelector.SelectHost(ctx, selectionReq, endpoints)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		hostId = chosenEndpoint.HostId
 		hostSetId = chosenEndpoint.SetId
 		h = chosenEndpoint.Address
+		if namedPort == 0 && chosenEndpoint.Port != 0 {
+			port = chosenEndpoint.Port
+		}
 	}
 
 	if h == "" {
@@ -838,7 +1025,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	// Generate the endpoint URL
 	endpointUrl := &url.URL{
 		Scheme: t.GetType().String(),
-		Host:   net.JoinHostPort(h, p),
+		Host:   net.JoinHostPort(h, strconv.FormatUint(uint64(port), 10)),
 	}
 
 	// Get workers and filter down to ones that can service this request
@@ -852,22 +1039,37 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 
-	// Randomize the workers
-	rand.Shuffle(len(selectedWorkers), func(i, j int) {
-		selectedWorkers[i], selectedWorkers[j] = selectedWorkers[j], selectedWorkers[i]
-	})
+	// Order the workers using the target's worker selection strategy
+	workerSelector, err := selectionRepo.WorkerSelector(ctx, selection.WorkerStrategy(t.GetWorkerSelectionStrategy()))
+	if err != nil {
+		return nil, err
+	}
+	selectedWorkers, err = workerSelector.SelectWorkers(ctx, selectionReq, selectedWorkers)
+	if err != nil {
+		return nil, err
+	}
 
-	var vaultReqs []credential.Request
+	var vaultReqs, pluginReqs, kubeReqs, awsReqs []credential.Request
 	var staticIds []string
 	var dynCreds []*session.DynamicCredential
 	var staticCreds []*session.StaticCredential
 	for _, cs := range credSources {
 		switch cs.Type() {
 		case target.LibraryCredentialSourceType:
-			vaultReqs = append(vaultReqs, credential.Request{
+			req := credential.Request{
 				SourceId: cs.Id(),
 				Purpose:  cs.CredentialPurpose(),
-			})
+			}
+			switch subtypes.SubtypeFromId(credential.Domain, cs.Id()) {
+			case credplugin.Subtype:
+				pluginReqs = append(pluginReqs, req)
+			case credkube.Subtype:
+				kubeReqs = append(kubeReqs, req)
+			case credaws.Subtype:
+				awsReqs = append(awsReqs, req)
+			default:
+				vaultReqs = append(vaultReqs, req)
+			}
 			dynCreds = append(dynCreds, session.NewDynamicCredential(cs.Id(), cs.CredentialPurpose()))
 		case target.StaticCredentialSourceType:
 			staticIds = append(staticIds, cs.Id())
@@ -902,7 +1104,12 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		return nil, err
 	}
 	sess, err = sessionRepo.CreateSession(ctx, wrapper, sess, wl.WorkerList(selectedWorkers).Addresses())
-	if err != nil {
+	switch {
+	case err != nil && strings.Contains(err.Error(), globals.CredentialCheckoutsExhaustedErrStr):
+		return nil, handlers.ApiErrorWithCodeAndMessage(
+			codes.FailedPrecondition,
+			"A static credential used by this target has no available check-outs; it is held by the maximum number of concurrent sessions.")
+	case err != nil:
 		return nil, err
 	}
 
@@ -913,10 +1120,57 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		dynamic, err = credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs, credential.WithTemplateData(authResults.UserData))
+		issueOpts := []credential.Option{credential.WithTemplateData(authResults.UserData)}
+		if sess.ExpirationTime != nil && sess.ExpirationTime.GetTimestamp() != nil {
+			issueOpts = append(issueOpts, credential.WithSessionExpiration(sess.ExpirationTime.GetTimestamp().AsTime()))
+		}
+		issueStart := time.Now()
+		dynamic, err = credRepo.Issue(ctx, sess.GetPublicId(), vaultReqs, issueOpts...)
+		metric.RecordVaultCredentialIssue(time.Since(issueStart), err)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if len(pluginReqs) > 0 {
+		credRepo, err := s.pluginCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		pluginDynamic, err := credRepo.Issue(ctx, sess.GetPublicId(), pluginReqs, credential.WithTemplateData(authResults.UserData))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, pluginDynamic...)
+	}
+	if len(kubeReqs) > 0 {
+		credRepo, err := s.kubeCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		var issueOpts []credential.Option
+		if sess.ExpirationTime != nil && sess.ExpirationTime.GetTimestamp() != nil {
+			issueOpts = append(issueOpts, credential.WithSessionExpiration(sess.ExpirationTime.GetTimestamp().AsTime()))
+		}
+		kubeDynamic, err := credRepo.Issue(ctx, sess.GetPublicId(), kubeReqs, issueOpts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, kubeDynamic...)
+	}
+	if len(awsReqs) > 0 {
+		credRepo, err := s.awsCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		issueOpts := []credential.Option{credential.WithTemplateData(authResults.UserData)}
+		if sess.ExpirationTime != nil && sess.ExpirationTime.GetTimestamp() != nil {
+			issueOpts = append(issueOpts, credential.WithSessionExpiration(sess.ExpirationTime.GetTimestamp().AsTime()))
+		}
+		awsDynamic, err := credRepo.Issue(ctx, sess.GetPublicId(), awsReqs, issueOpts...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
+		dynamic = append(dynamic, awsDynamic...)
 	}
 
 	if len(staticIds) > 0 {
@@ -1068,9 +1322,27 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetIngressWorkerFilter() != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if item.GetHostSelectionStrategy() != nil {
+		opts = append(opts, target.WithHostSelectionStrategy(item.GetHostSelectionStrategy().GetValue()))
+	}
+	if item.GetWorkerSelectionStrategy() != nil {
+		opts = append(opts, target.WithWorkerSelectionStrategy(item.GetWorkerSelectionStrategy().GetValue()))
+	}
+	if item.GetWorkerAffinityFilter() != nil {
+		opts = append(opts, target.WithWorkerAffinityFilter(item.GetWorkerAffinityFilter().GetValue()))
+	}
+	if item.GetHostExclusionFilter() != nil {
+		opts = append(opts, target.WithHostExclusionFilter(item.GetHostExclusionFilter().GetValue()))
+	}
+	if item.GetHostPreferenceFilter() != nil {
+		opts = append(opts, target.WithHostPreferenceFilter(item.GetHostPreferenceFilter().GetValue()))
+	}
 	if item.GetAddress() != nil {
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if len(item.GetPorts()) > 0 {
+		opts = append(opts, target.WithPorts(item.GetPorts()))
+	}
 
 	attr, err := subtypeRegistry.newAttribute(target.SubtypeFromType(item.GetType()), item.GetAttrs())
 	if err != nil {
@@ -1122,10 +1394,29 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if ingressFilter := item.GetIngressWorkerFilter(); ingressFilter != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if strategy := item.GetHostSelectionStrategy(); strategy != nil {
+		opts = append(opts, target.WithHostSelectionStrategy(strategy.GetValue()))
+	}
+	if strategy := item.GetWorkerSelectionStrategy(); strategy != nil {
+		opts = append(opts, target.WithWorkerSelectionStrategy(strategy.GetValue()))
+	}
+	if affinityFilter := item.GetWorkerAffinityFilter(); affinityFilter != nil {
+		opts = append(opts, target.WithWorkerAffinityFilter(affinityFilter.GetValue()))
+	}
+	if exclusionFilter := item.GetHostExclusionFilter(); exclusionFilter != nil {
+		opts = append(opts, target.WithHostExclusionFilter(exclusionFilter.GetValue()))
+	}
+	if preferenceFilter := item.GetHostPreferenceFilter(); preferenceFilter != nil {
+		opts = append(opts, target.WithHostPreferenceFilter(preferenceFilter.GetValue()))
+	}
 	if item.GetAddress() != nil {
 		dbMask = append(dbMask, "Address")
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
+	if handlers.MaskContains(mask, globals.PortsField) {
+		dbMask = append(dbMask, "Ports")
+		opts = append(opts, target.WithPorts(item.GetPorts()))
+	}
 	subtype := target.SubtypeFromId(id)
 
 	attr, err := subtypeRegistry.newAttribute(subtype, item.GetAttrs())
@@ -1390,12 +1681,22 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, looku
 			}
 			return res
 		}
-		if t == nil {
+		switch {
+		case t != nil:
+			id = t.GetPublicId()
+			parentId = t.GetProjectId()
+		case a == action.Restore:
+			// A deleted target can be restored, so authorize against the
+			// project it was last recorded in by the oplog.
+			parentId, err = s.deletedTargetProjectId(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+		default:
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-		id = t.GetPublicId()
-		parentId = t.GetProjectId()
 		opts = append(opts, auth.WithId(id))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
@@ -1452,6 +1753,21 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.IngressWorkerFilterField) && in.GetIngressWorkerFilter() != "" {
 		out.IngressWorkerFilter = wrapperspb.String(in.GetIngressWorkerFilter())
 	}
+	if outputFields.Has(globals.HostSelectionStrategyField) && in.GetHostSelectionStrategy() != "" {
+		out.HostSelectionStrategy = wrapperspb.String(in.GetHostSelectionStrategy())
+	}
+	if outputFields.Has(globals.WorkerSelectionStrategyField) && in.GetWorkerSelectionStrategy() != "" {
+		out.WorkerSelectionStrategy = wrapperspb.String(in.GetWorkerSelectionStrategy())
+	}
+	if outputFields.Has(globals.WorkerAffinityFilterField) && in.GetWorkerAffinityFilter() != "" {
+		out.WorkerAffinityFilter = wrapperspb.String(in.GetWorkerAffinityFilter())
+	}
+	if outputFields.Has(globals.HostExclusionFilterField) && in.GetHostExclusionFilter() != "" {
+		out.HostExclusionFilter = wrapperspb.String(in.GetHostExclusionFilter())
+	}
+	if outputFields.Has(globals.HostPreferenceFilterField) && in.GetHostPreferenceFilter() != "" {
+		out.HostPreferenceFilter = wrapperspb.String(in.GetHostPreferenceFilter())
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1474,6 +1790,9 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.AddressField) {
 		out.Address = wrapperspb.String(in.GetAddress())
 	}
+	if outputFields.Has(globals.PortsField) && len(in.GetPorts()) > 0 {
+		out.Ports = in.GetPorts()
+	}
 
 	var brokeredSources, injectedAppSources []*pb.CredentialSource
 	var brokeredSourceIds, injectedAppSourceIds []string
@@ -1535,6 +1854,14 @@ func validateGetRequest(req *pbs.GetTargetRequest) error {
 	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, target.Prefixes()...)
 }
 
+func validateGetHistoryRequest(req *pbs.GetTargetHistoryRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, target.Prefixes()...)
+}
+
+func validateRestoreRequest(req *pbs.RestoreTargetRequest) error {
+	return handlers.ValidateRestoreRequest(req, target.Prefixes()...)
+}
+
 func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1575,6 +1902,10 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		validateSelectionStrategies(req.GetItem(), badFields)
+		validateWorkerAffinityFilter(req.GetItem(), badFields)
+		validateHostRules(req.GetItem(), badFields)
+		validatePorts(req.GetItem(), badFields)
 		if address := req.GetItem().GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -1607,6 +1938,66 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 	})
 }
 
+// validateSelectionStrategies adds the invalid host and worker selection
+// strategy fields of item to badFields.
+func validateSelectionStrategies(item *pb.Target, badFields map[string]string) {
+	if strategy := item.GetHostSelectionStrategy(); strategy != nil && strategy.GetValue() != "" {
+		if !selection.HostStrategy(strategy.GetValue()).IsValid() {
+			badFields[globals.HostSelectionStrategyField] = fmt.Sprintf("Unknown host selection strategy; must be one of %v.", selection.HostStrategies)
+		}
+	}
+	if strategy := item.GetWorkerSelectionStrategy(); strategy != nil && strategy.GetValue() != "" {
+		if !selection.WorkerStrategy(strategy.GetValue()).IsValid() {
+			badFields[globals.WorkerSelectionStrategyField] = fmt.Sprintf("Unknown worker selection strategy; must be one of %v.", selection.WorkerStrategies)
+		}
+	}
+}
+
+// validateWorkerAffinityFilter adds the worker affinity filter field of item
+// to badFields if it can not be parsed.
+func validateWorkerAffinityFilter(item *pb.Target, badFields map[string]string) {
+	if affinityFilter := item.GetWorkerAffinityFilter(); affinityFilter != nil {
+		if _, err := bexpr.CreateEvaluator(affinityFilter.GetValue()); err != nil {
+			badFields[globals.WorkerAffinityFilterField] = "Unable to successfully parse worker affinity filter expression."
+		}
+	}
+}
+
+// validatePorts adds the ports field of item to badFields if any of its named
+// ports is invalid. The message describes every invalid port, ordered by port
+// name.
+func validatePorts(item *pb.Target, badFields map[string]string) {
+	names := make([]string, 0, len(item.GetPorts()))
+	for name := range item.GetPorts() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var msgs []string
+	for _, name := range names {
+		if err := target.ValidatePort(name, item.GetPorts()[name]); err != nil {
+			msgs = append(msgs, fmt.Sprintf("Invalid port: %v.", err))
+		}
+	}
+	if len(msgs) > 0 {
+		badFields[globals.PortsField] = strings.Join(msgs, " ")
+	}
+}
+
+// validateHostRules adds the host exclusion and preference filter fields of
+// item which can not be parsed to badFields.
+func validateHostRules(item *pb.Target, badFields map[string]string) {
+	if exclusionFilter := item.GetHostExclusionFilter(); exclusionFilter != nil {
+		if _, err := bexpr.CreateEvaluator(exclusionFilter.GetValue()); err != nil {
+			badFields[globals.HostExclusionFilterField] = "Unable to successfully parse host exclusion filter expression."
+		}
+	}
+	if preferenceFilter := item.GetHostPreferenceFilter(); preferenceFilter != nil {
+		if _, err := bexpr.CreateEvaluator(preferenceFilter.GetValue()); err != nil {
+			badFields[globals.HostPreferenceFilterField] = "Unable to successfully parse host preference filter expression."
+		}
+	}
+}
+
 func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1651,6 +2042,10 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		validateSelectionStrategies(req.GetItem(), badFields)
+		validateWorkerAffinityFilter(req.GetItem(), badFields)
+		validateHostRules(req.GetItem(), badFields)
+		validatePorts(req.GetItem(), badFields)
 		if address := req.GetItem().GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -1718,7 +2113,7 @@ func validateAddHostSourcesRequest(req *pbs.AddTargetHostSourcesRequest) error {
 		badFields[globals.HostSourceIdsField] = "Must be non-empty."
 	}
 	for _, id := range req.GetHostSourceIds() {
-		if !handlers.ValidId(handlers.Id(id), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix) {
+		if !handlers.ValidId(handlers.Id(id), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix) {
 			badFields[globals.HostSourceIdsField] = fmt.Sprintf("Incorrectly formatted host source identifier %q.", id)
 			break
 		}
@@ -1738,7 +2133,7 @@ func validateSetHostSourcesRequest(req *pbs.SetTargetHostSourcesRequest) error {
 		badFields[globals.VersionField] = "Required field."
 	}
 	for _, id := range req.GetHostSourceIds() {
-		if !handlers.ValidId(handlers.Id(id), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix) {
+		if !handlers.ValidId(handlers.Id(id), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix) {
 			badFields[globals.HostSourceIdsField] = fmt.Sprintf("Incorrectly formatted host source identifier %q.", id)
 			break
 		}
@@ -1761,7 +2156,7 @@ func validateRemoveHostSourcesRequest(req *pbs.RemoveTargetHostSourcesRequest) e
 		badFields[globals.HostSourceIdsField] = "Must be non-empty."
 	}
 	for _, id := range req.GetHostSourceIds() {
-		if !handlers.ValidId(handlers.Id(id), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix) {
+		if !handlers.ValidId(handlers.Id(id), globals.StaticHostSetPrefix, globals.PluginHostSetPrefix, globals.PluginHostSetPreviousPrefix, globals.DnsHostSetPrefix) {
 			badFields[globals.HostSourceIdsField] = fmt.Sprintf("Incorrectly formatted host source identifier %q.", id)
 			break
 		}
@@ -1788,9 +2183,14 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1799,9 +2199,14 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.BrokeredCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1810,10 +2215,15 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
-			globals.SshPrivateKeyCredentialPrefix) {
+			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix) {
 			badFields[globals.InjectedApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1836,9 +2246,14 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1847,9 +2262,14 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.BrokeredCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1858,10 +2278,15 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
-			globals.SshPrivateKeyCredentialPrefix) {
+			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix) {
 			badFields[globals.InjectedApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1888,9 +2313,14 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1899,9 +2329,14 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.BrokeredCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1910,10 +2345,15 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetInjectedApplicationCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.PluginCredentialLibraryPrefix,
+			globals.KubernetesCredentialLibraryPrefix,
+			globals.AwsCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.VaultPkiCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
+			globals.TlsClientCertificateCredentialPrefix,
 			globals.JsonCredentialPrefix) {
 			badFields[globals.InjectedApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
@@ -1955,7 +2395,7 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	}
 	if req.GetHostId() != "" {
 		switch subtypes.SubtypeFromId(hostDomain, req.GetHostId()) {
-		case static.Subtype, plugin.Subtype:
+		case static.Subtype, plugin.Subtype, dns.Subtype:
 		default:
 			badFields[globals.HostIdField] = "Incorrectly formatted identifier."
 		}
@@ -15,6 +15,7 @@ import (
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
@@ -22,6 +23,9 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credaws "github.com/hashicorp/boundary/internal/credential/aws"
+	credkube "github.com/hashicorp/boundary/internal/credential/kubernetes"
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -32,6 +36,9 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/health"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -42,10 +49,12 @@ import (
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/selection"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	credlibpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
 	credpb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	historypb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/history"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
@@ -77,6 +86,8 @@ var testAuthorizedActions = []string{
 	"set-credential-sources",
 	"remove-credential-sources",
 	"authorize-session",
+	"history",
+	"restore",
 }
 
 // Create a variable that we can overwrite in enterprise tests
@@ -105,13 +116,34 @@ func testService(t *testing.T, ctx context.Context, conn *db.DB, kms *kms.Kms, w
 	pluginHostRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(context.Background(), rw, rw, kms)
 	}
-	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(context.Background(), rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	kubeCredRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(context.Background(), rw, rw, kms)
+	}
+	awsCredRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(context.Background(), rw, rw, kms)
+	}
+	historyRepoFn := func() (*history.Repository, error) {
+		return history.NewRepository(ctx, rw, rw, kms)
+	}
+	selectionRepoFn := func() (*selection.Repository, error) {
+		return selection.NewRepository(ctx, rw, rw)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
+	return targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, dnsHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, historyRepoFn, selectionRepoFn, hostHealthRepoFn, nil, statusGracePeriod)
 }
 
 func TestGet(t *testing.T) {
@@ -244,6 +276,116 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGetHistory(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(rw, rw, kms)
+	}
+
+	o, proj := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
+
+	s, err := testService(t, context.Background(), conn, kms, wrapper)
+	require.NoError(t, err, "Couldn't create a new target service.")
+
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+
+	created, err := s.CreateTarget(ctx, &pbs.CreateTargetRequest{Item: &pb.Target{
+		ScopeId: proj.GetPublicId(),
+		Name:    wrapperspb.String("history"),
+		Type:    tcp.Subtype.String(),
+		Attrs: &pb.Target_TcpTargetAttributes{
+			TcpTargetAttributes: &pb.TcpTargetAttributes{
+				DefaultPort: wrapperspb.UInt32(22),
+			},
+		},
+	}})
+	require.NoError(t, err)
+	id := created.GetItem().GetId()
+	_, err = s.UpdateTarget(ctx, &pbs.UpdateTargetRequest{
+		Id: id,
+		Item: &pb.Target{
+			Name:    wrapperspb.String("history-updated"),
+			Version: created.GetItem().GetVersion(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	})
+	require.NoError(t, err)
+
+	changeValues := func(r *historypb.Record) []string {
+		var ret []string
+		for _, c := range r.GetChanges() {
+			ret = append(ret, fmt.Sprintf("%s %s %s: %s -> %s", c.GetOperation(), c.GetType(), c.GetField(), c.GetOldValue(), c.GetNewValue()))
+		}
+		return ret
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetTargetHistoryRequest
+		want [][]string
+		err  error
+	}{
+		{
+			name: "Get the history of an existing target",
+			req:  &pbs.GetTargetHistoryRequest{Id: id},
+			want: [][]string{
+				{"create target_tcp name: -> history", "create target_tcp default_port: -> 22"},
+				{"update target_tcp name: history -> history-updated"},
+			},
+		},
+		{
+			name: "Get the history of a non existing target",
+			req:  &pbs.GetTargetHistoryRequest{Id: globals.TcpTargetPrefix + "_doesntexis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Bad target id formatting",
+			req:  &pbs.GetTargetHistoryRequest{Id: globals.TcpTargetPrefix + "_bad_format"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.GetTargetHistory(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetTargetHistory(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.Len(got.GetItems(), len(tc.want))
+			for i, item := range got.GetItems() {
+				assert.Equal(at.GetIamUserId(), item.GetUserId())
+				assert.Subset(changeValues(item), tc.want[i])
+			}
+		})
+	}
+}
+
 func TestList(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrapper := db.TestWrapper(t)
@@ -702,6 +844,77 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Unknown host selection strategy",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				HostSelectionStrategy: wrapperspb.String("fastest"),
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: "Unknown host selection strategy",
+		},
+		{
+			name: "Unknown worker selection strategy",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				WorkerSelectionStrategy: wrapperspb.String("fastest"),
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: "Unknown worker selection strategy",
+		},
+		{
+			name: "Invalid worker affinity filter expression",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				WorkerAffinityFilter: wrapperspb.String("bad expression"),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid host exclusion filter expression",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				HostExclusionFilter: wrapperspb.String("bad expression"),
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: "Unable to successfully parse host exclusion filter expression.",
+		},
+		{
+			name: "Invalid host preference filter expression",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				HostPreferenceFilter: wrapperspb.String("bad expression"),
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: "Unable to successfully parse host preference filter expression.",
+		},
+		{
+			name: "Invalid port name",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				Ports: map[string]uint32{"Metrics": 9100},
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: `Invalid port: port name "Metrics" must start with a lowercase letter or digit`,
+		},
+		{
+			name: "Invalid port number",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				Ports: map[string]uint32{"metrics": 70000},
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: `Invalid port: port 70000 of "metrics" must be between 1 and 65535.`,
+		},
+		{
+			name: "Multiple invalid ports",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				Ports: map[string]uint32{"metrics": 70000, "db": 0, "web": 443},
+			}},
+			res:    nil,
+			err:    handlers.ApiErrorWithCode(codes.InvalidArgument),
+			errStr: `Invalid port: port 0 of "db" must be between 1 and 65535. Invalid port: port 70000 of "metrics" must be between 1 and 65535.`,
+		},
 		{
 			name: "Invalid address length",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -1831,6 +2044,7 @@ func TestAddTargetCredentialSources(t *testing.T) {
 
 	storeVault := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
 	cls := vault.TestCredentialLibraries(t, conn, wrapper, storeVault.GetPublicId(), 2)
+	pkiLib := vault.TestPkiCredentialLibraries(t, conn, wrapper, storeVault.GetPublicId(), 1)[0]
 
 	storeStatic := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
 	creds := credstatic.TestUsernamePasswordCredentials(t, conn, wrapper, "user", "pass", storeStatic.GetPublicId(), proj.GetPublicId(), 2)
@@ -1854,6 +2068,12 @@ func TestAddTargetCredentialSources(t *testing.T) {
 			addSources:      []string{creds[1].GetPublicId()},
 			resultSourceIds: []string{creds[1].GetPublicId()},
 		},
+		{
+			name:            "Add pki library on empty target",
+			tar:             tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "empty for pki lib sources"),
+			addSources:      []string{pkiLib.GetPublicId()},
+			resultSourceIds: []string{pkiLib.GetPublicId()},
+		},
 		{
 			name:            "Add library on library populated target",
 			tar:             tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "populated for lib-lib sources", target.WithCredentialLibraries([]*target.CredentialLibrary{target.TestNewCredentialLibrary("", cls[0].GetPublicId(), credential.BrokeredPurpose)})),
@@ -2429,6 +2649,15 @@ func TestAuthorizeSession(t *testing.T) {
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	kubeCredRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(ctx, rw, rw, kms)
+	}
+	awsCredRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -2467,6 +2696,9 @@ func TestAuthorizeSession(t *testing.T) {
 	pluginHostRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	loginName := "foo@bar.com"
 	accountName := "passname"
@@ -2521,7 +2753,16 @@ func TestAuthorizeSession(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "pki"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+	historyRepoFn := func() (*history.Repository, error) {
+		return history.NewRepository(ctx, rw, rw, kms)
+	}
+	selectionRepoFn := func() (*selection.Repository, error) {
+		return selection.NewRepository(ctx, rw, rw)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
+	credService, err := credentiallibraries.NewService(vaultCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, iamRepoFn, historyRepoFn)
 	require.NoError(t, err)
 	clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 		CredentialStoreId: vaultStore.GetPublicId(),
@@ -2577,7 +2818,7 @@ func TestAuthorizeSession(t *testing.T) {
 
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, dnsHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, historyRepoFn, selectionRepoFn, hostHealthRepoFn, nil, statusGracePeriod)
 	require.NoError(t, err)
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2720,12 +2961,24 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	kubeCredRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(ctx, rw, rw, kms)
+	}
+	awsCredRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	pluginHostRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
 
 	org, proj := iam.TestScopes(t, iamRepo)
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
@@ -2744,9 +2997,18 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
 	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
 
+	historyRepoFn := func() (*history.Repository, error) {
+		return history.NewRepository(ctx, rw, rw, kms)
+	}
+	selectionRepoFn := func() (*selection.Repository, error) {
+		return selection.NewRepository(ctx, rw, rw)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, dnsHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, historyRepoFn, selectionRepoFn, hostHealthRepoFn, nil, statusGracePeriod)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2759,7 +3021,7 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	sec, tok := v.CreateToken(t, vault.WithPolicies([]string{"default", "boundary-controller", "secret"}))
 
 	vaultStore := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
-	credLibService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+	credLibService, err := credentiallibraries.NewService(vaultCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, iamRepoFn, historyRepoFn)
 	require.NoError(t, err)
 
 	// Create secret in vault with default username and password fields
@@ -2867,6 +3129,22 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, sshPkWithPassCredResp)
 
+	tlsCert, tlsKey := credstatic.TestTlsClientCertificate(t)
+	tlsCredResp, err := credService.CreateCredential(ctx, &pbs.CreateCredentialRequest{Item: &credpb.Credential{
+		CredentialStoreId: staticStore.GetPublicId(),
+		Type:              credential.TlsClientCertificateSubtype.String(),
+		Name:              wrapperspb.String("Cred TLS Name"),
+		Description:       wrapperspb.String("Cred TLS Description"),
+		Attrs: &credpb.Credential_TlsClientCertificateAttributes{
+			TlsClientCertificateAttributes: &credpb.TlsClientCertificateAttributes{
+				Certificate: wrapperspb.String(string(tlsCert)),
+				PrivateKey:  wrapperspb.String(string(tlsKey)),
+			},
+		},
+	}})
+	require.NoError(t, err)
+	require.NotNil(t, tlsCredResp)
+
 	// Create secret in vault with default username and private key fields
 	defaultSshPrivateKey := v.CreateKVSecret(t, "default-sshpk", []byte(`{"data": {"username": "my-user", "private_key": "my-pk"}}`))
 	require.NotNil(t, defaultSshPrivateKey)
@@ -3082,6 +3360,32 @@ func TestAuthorizeSessionTypedCredentials(t *testing.T) {
 				}(),
 			},
 		},
+		{
+			name:           "static-tls-client-certificate",
+			hostSourceId:   shs.GetPublicId(),
+			credSourceId:   tlsCredResp.GetItem().GetId(),
+			wantedHostId:   h.GetPublicId(),
+			wantedEndpoint: h.GetAddress(),
+			wantedCred: &pb.SessionCredential{
+				CredentialSource: &pb.CredentialSource{
+					Id:                tlsCredResp.GetItem().GetId(),
+					Name:              tlsCredResp.GetItem().GetName().GetValue(),
+					Description:       tlsCredResp.GetItem().GetDescription().GetValue(),
+					CredentialStoreId: staticStore.GetPublicId(),
+					Type:              credstatic.Subtype.String(),
+					CredentialType:    string(credential.TlsClientCertificateType),
+				},
+				Credential: func() *structpb.Struct {
+					data := map[string]any{
+						"certificate": string(tlsCert),
+						"private_key": string(tlsKey),
+					}
+					st, err := structpb.NewStruct(data)
+					require.NoError(t, err)
+					return st
+				}(),
+			},
+		},
 		{
 			name:           "vault-ssh-private-key",
 			hostSourceId:   shs.GetPublicId(),
@@ -3306,20 +3610,41 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	pluginHostRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(ctx, rw, rw, kms, sche)
+	}
 	vaultCredRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
 	staticCredRepoFn := func() (*credstatic.Repository, error) {
 		return credstatic.NewRepository(ctx, rw, rw, kms)
 	}
+	pluginCredRepoFn := func() (*credplugin.Repository, error) {
+		return credplugin.NewRepository(ctx, rw, rw, kms, sche, map[string]plgpb.CredentialPluginServiceClient{})
+	}
+	kubeCredRepoFn := func() (*credkube.Repository, error) {
+		return credkube.NewRepository(ctx, rw, rw, kms)
+	}
+	awsCredRepoFn := func() (*credaws.Repository, error) {
+		return credaws.NewRepository(ctx, rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	org, proj := iam.TestScopes(t, iamRepo)
 
+	historyRepoFn := func() (*history.Repository, error) {
+		return history.NewRepository(ctx, rw, rw, kms)
+	}
+	selectionRepoFn := func() (*selection.Repository, error) {
+		return selection.NewRepository(ctx, rw, rw)
+	}
+	hostHealthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(ctx, rw, rw, kms)
+	}
 	statusGracePeriod := new(atomic.Int64)
 	statusGracePeriod.Store(int64(server.DefaultLiveness))
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, nil, statusGracePeriod)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, dnsHostRepoFn, staticHostRepoFn, vaultCredRepoFn, staticCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, historyRepoFn, selectionRepoFn, hostHealthRepoFn, nil, statusGracePeriod)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -3405,8 +3730,32 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 		return apiTar.GetItem().GetVersion()
 	}
 
+	unhealthyHostExists := func(tar target.Target) (version uint32) {
+		hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+		h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+		hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+		_ = static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+		apiTar, err := s.AddTargetHostSources(ctx, &pbs.AddTargetHostSourcesRequest{
+			Id:            tar.GetPublicId(),
+			Version:       tar.GetVersion(),
+			HostSourceIds: []string{hs.GetPublicId()},
+		})
+		require.NoError(t, err)
+		healthRepo, err := hostHealthRepoFn()
+		require.NoError(t, err)
+		check, err := health.NewHealthCheck(ctx, hs.GetPublicId(), health.TcpCheck, 22)
+		require.NoError(t, err)
+		_, err = healthRepo.SetHealthCheck(ctx, proj.GetPublicId(), check)
+		require.NoError(t, err)
+		w := server.TestKmsWorker(t, conn, wrapper)
+		result, err := health.NewHostHealth(ctx, hs.GetPublicId(), h.GetPublicId(), w.GetPublicId(), false, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, healthRepo.UpsertHostHealth(ctx, []*health.HostHealth{result}))
+		return apiTar.GetItem().GetVersion()
+	}
+
 	libraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(vaultCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, iamRepoFn, historyRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3431,7 +3780,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	misConfiguredlibraryExists := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(vaultCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, iamRepoFn, historyRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: store.GetPublicId(),
@@ -3456,7 +3805,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	}
 
 	expiredTokenLibrary := func(tar target.Target) (version uint32) {
-		credService, err := credentiallibraries.NewService(vaultCredRepoFn, iamRepoFn)
+		credService, err := credentiallibraries.NewService(vaultCredRepoFn, pluginCredRepoFn, kubeCredRepoFn, awsCredRepoFn, iamRepoFn, historyRepoFn)
 		require.NoError(t, err)
 		clsResp, err := credService.CreateCredentialLibrary(ctx, &pbs.CreateCredentialLibraryRequest{Item: &credlibpb.CredentialLibrary{
 			CredentialStoreId: expiredStore.GetPublicId(),
@@ -3504,6 +3853,11 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 			setup: []func(tcpTarget target.Target) uint32{workerExists, hostSetNoHostExists, libraryExists},
 			err:   true,
 		},
+		{
+			name:  "unhealthy hosts",
+			setup: []func(tcpTarget target.Target) uint32{workerExists, unhealthyHostExists, libraryExists},
+			err:   true,
+		},
 		{
 			name:  "bad library configuration",
 			setup: []func(tcpTarget target.Target) uint32{workerExists, hostExists, misConfiguredlibraryExists},
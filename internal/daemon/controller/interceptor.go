@@ -95,22 +95,12 @@ func requestCtxInterceptor(
 			return nil, errors.New(interceptorCtx, errors.Internal, op, fmt.Sprintf("expected 1 value for %s metadata and got %d", requestInfoMdKey, len(values)))
 		}
 
-		decoded, err := base58.FastBase58Decoding(values[0])
+		requestInfo, err := decodeRequestInfo(interceptorCtx, values[0], ticket)
 		if err != nil {
-			return nil, errors.Wrap(interceptorCtx, err, op, errors.WithCode(errors.Internal), errors.WithMsg("unable to decode request info"))
-		}
-		var requestInfo authpb.RequestInfo
-		if err := proto.Unmarshal(decoded, &requestInfo); err != nil {
-			return nil, errors.Wrap(interceptorCtx, err, op, errors.WithCode(errors.Internal), errors.WithMsg("unable to unmarshal request info"))
-		}
-		switch {
-		case requestInfo.Ticket == "":
-			return nil, errors.New(interceptorCtx, errors.Internal, op, "Invalid context (missing ticket)")
-		case requestInfo.Ticket != ticket:
-			return nil, errors.New(interceptorCtx, errors.Internal, op, "Invalid context (bad ticket)")
+			return nil, errors.Wrap(interceptorCtx, err, op)
 		}
 
-		interceptorCtx = auth.NewVerifierContextWithAccounts(interceptorCtx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, kms, &requestInfo)
+		interceptorCtx = auth.NewVerifierContextWithAccounts(interceptorCtx, iamRepoFn, authTokenRepoFn, serversRepoFn, passwordAuthRepoFn, oidcAuthRepoFn, kms, requestInfo)
 
 		// Add general request information to the context. The information from
 		// the auth verifier context is pretty specifically curated to
@@ -149,6 +139,28 @@ func requestCtxInterceptor(
 	}, nil
 }
 
+// decodeRequestInfo decodes the RequestInfo which was marshalled into the
+// requestInfoMdKey header by controller.wrapHandlerWithCommonFuncs, and
+// verifies it carries the expected ticket.
+func decodeRequestInfo(ctx context.Context, encoded, ticket string) (*authpb.RequestInfo, error) {
+	const op = "controller.decodeRequestInfo"
+	decoded, err := base58.FastBase58Decoding(encoded)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Internal), errors.WithMsg("unable to decode request info"))
+	}
+	var requestInfo authpb.RequestInfo
+	if err := proto.Unmarshal(decoded, &requestInfo); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Internal), errors.WithMsg("unable to unmarshal request info"))
+	}
+	switch {
+	case requestInfo.Ticket == "":
+		return nil, errors.New(ctx, errors.Internal, op, "Invalid context (missing ticket)")
+	case requestInfo.Ticket != ticket:
+		return nil, errors.New(ctx, errors.Internal, op, "Invalid context (bad ticket)")
+	}
+	return &requestInfo, nil
+}
+
 func errorInterceptor(
 	_ context.Context,
 ) grpc.UnaryServerInterceptor {
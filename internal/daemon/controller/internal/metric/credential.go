@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const credentialSubsystem = "controller_credential"
+
+// vaultIssueDuration collects measurements of how long it takes to issue the
+// Vault credentials for a session.
+var vaultIssueDuration prometheus.ObserverVec = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: credentialSubsystem,
+		Name:      "vault_issue_duration_seconds",
+		Help:      "Histogram of latencies for issuing Vault credentials for a session.",
+		Buckets:   prometheus.DefBuckets,
+	},
+	[]string{labelStatus},
+)
+
+// vaultIssueFailuresTotal keeps a count of the failed attempts to issue Vault
+// credentials for a session.
+var vaultIssueFailuresTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: credentialSubsystem,
+		Name:      "vault_issue_failures_total",
+		Help:      "Count of failed attempts to issue Vault credentials for a session.",
+	},
+)
+
+// RecordVaultCredentialIssue records how long issuing Vault credentials for a
+// session took and increments the failure counter if err is not nil.
+func RecordVaultCredentialIssue(d time.Duration, err error) {
+	status := statusSuccess
+	if err != nil {
+		status = statusFailure
+		vaultIssueFailuresTotal.Inc()
+	}
+	vaultIssueDuration.With(prometheus.Labels{labelStatus: status}).Observe(d.Seconds())
+}
+
+// InitializeCredentialCollectors registers the credential metrics to the
+// provided prometheus register and initializes them to 0.
+func InitializeCredentialCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(vaultIssueDuration, vaultIssueFailuresTotal)
+	for _, s := range []string{statusSuccess, statusFailure} {
+		vaultIssueDuration.With(prometheus.Labels{labelStatus: s})
+	}
+}
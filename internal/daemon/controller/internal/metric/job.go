@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	jobSubsystem = "controller_job"
+
+	labelJobName = "job_name"
+	labelStatus  = "status"
+
+	statusSuccess = "success"
+	statusFailure = "failure"
+)
+
+// jobRunDuration collects measurements of how long the scheduled jobs run on
+// this controller took to complete.
+var jobRunDuration prometheus.ObserverVec = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: jobSubsystem,
+		Name:      "run_duration_seconds",
+		Help:      "Histogram of the duration of scheduled job runs.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	},
+	[]string{labelJobName, labelStatus},
+)
+
+// jobRunFailuresTotal keeps a count of the scheduled job runs on this
+// controller which returned an error.
+var jobRunFailuresTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: jobSubsystem,
+		Name:      "run_failures_total",
+		Help:      "Count of scheduled job runs which failed.",
+	},
+	[]string{labelJobName},
+)
+
+// RecordJobRun records the duration of a completed scheduled job run and
+// increments the failure counter if the run returned an error. It satisfies
+// scheduler.RunJobObserver.
+func RecordJobRun(jobName string, d time.Duration, err error) {
+	status := statusSuccess
+	if err != nil {
+		status = statusFailure
+		jobRunFailuresTotal.With(prometheus.Labels{labelJobName: jobName}).Inc()
+	}
+	jobRunDuration.With(prometheus.Labels{labelJobName: jobName, labelStatus: status}).Observe(d.Seconds())
+}
+
+// InitializeJobCollectors registers the scheduled job metrics to the provided
+// prometheus register.
+func InitializeJobCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(jobRunDuration, jobRunFailuresTotal)
+}
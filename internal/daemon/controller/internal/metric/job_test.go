@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordJobRun(t *testing.T) {
+	const jobName = "test_record_job_run"
+	failures := jobRunFailuresTotal.With(prometheus.Labels{labelJobName: jobName})
+
+	RecordJobRun(jobName, time.Second, nil)
+	assert.Equal(t, float64(0), testutil.ToFloat64(failures))
+
+	RecordJobRun(jobName, time.Second, errors.New("job failed"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(failures))
+
+	assert.Equal(t, 2, testutil.CollectAndCount(jobRunDuration.(prometheus.Collector), "boundary_controller_job_run_duration_seconds"))
+}
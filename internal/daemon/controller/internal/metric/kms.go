@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	kmsSubsystem = "controller_kms"
+
+	labelKeyId   = "key_id"
+	labelScopeId = "scope_id"
+)
+
+// rewrapJobCompleted is a gauge of the number of rows rewrapped so far by each
+// in-progress data key version destruction job.
+var rewrapJobCompleted = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: kmsSubsystem,
+		Name:      "rewrap_job_completed_rows",
+		Help:      "Gauge of the number of rows rewrapped by in-progress data key version destruction jobs.",
+	},
+	[]string{labelKeyId, labelScopeId, labelStatus},
+)
+
+// rewrapJobTotal is a gauge of the number of rows which need to be rewrapped
+// by each in-progress data key version destruction job.
+var rewrapJobTotal = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: kmsSubsystem,
+		Name:      "rewrap_job_total_rows",
+		Help:      "Gauge of the total number of rows to rewrap for in-progress data key version destruction jobs.",
+	},
+	[]string{labelKeyId, labelScopeId, labelStatus},
+)
+
+// SetRewrapJobProgress replaces the values of the rewrap job gauges with the
+// progress of the provided jobs.
+func SetRewrapJobProgress(jobs []*kms.DataKeyVersionDestructionJobProgress) {
+	rewrapJobCompleted.Reset()
+	rewrapJobTotal.Reset()
+	for _, j := range jobs {
+		l := prometheus.Labels{labelKeyId: j.GetKeyId(), labelScopeId: j.GetScopeId(), labelStatus: j.GetStatus()}
+		rewrapJobCompleted.With(l).Set(float64(j.GetCompletedCount()))
+		rewrapJobTotal.With(l).Set(float64(j.GetTotalCount()))
+	}
+}
+
+// InitializeKmsCollectors registers the kms metrics to the provided
+// prometheus register.
+func InitializeKmsCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(rewrapJobCompleted, rewrapJobTotal)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	"errors"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	sessionSubsystem = "controller_session"
+
+	labelSessionState = "state"
+	labelTargetId     = "target_id"
+	labelReason       = "reason"
+)
+
+// sessionsCount is a gauge of the number of sessions in the database grouped
+// by their current state and the target they were authorized for.
+var sessionsCount = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: sessionSubsystem,
+		Name:      "sessions",
+		Help:      "Gauge of the number of sessions by current state and target.",
+	},
+	[]string{labelSessionState, labelTargetId},
+)
+
+// authorizeSessionFailuresTotal counts the authorize-session requests which
+// did not result in a session, partitioned by the kind of error returned.
+var authorizeSessionFailuresTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: sessionSubsystem,
+		Name:      "authorize_failures_total",
+		Help:      "Count of failed authorize-session requests by reason.",
+	},
+	[]string{labelReason},
+)
+
+// closedConnectionsCount is a gauge of the number of closed session
+// connections, still retained in the database, grouped by closed reason.
+var closedConnectionsCount = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: sessionSubsystem,
+		Name:      "closed_connections",
+		Help:      "Gauge of the number of closed session connections by closed reason.",
+	},
+	[]string{labelReason},
+)
+
+// All the reasons expected to be recorded for a failed authorize-session
+// request.
+var expectedAuthorizeSessionFailureReasons = []codes.Code{
+	codes.InvalidArgument, codes.NotFound, codes.PermissionDenied,
+	codes.Unauthenticated, codes.FailedPrecondition, codes.AlreadyExists,
+	codes.Internal,
+}
+
+// All the closed reasons a connection can have.
+var expectedClosedReasons = []session.ClosedReason{
+	session.UnknownReason, session.ConnectionTimedOut,
+	session.ConnectionClosedByUser, session.ConnectionCanceled,
+	session.ConnectionNetworkError, session.ConnectionSystemError,
+}
+
+// RecordAuthorizeSessionFailure increments the authorize-session failure
+// counter using the kind of the provided error as the reason. Errors which are
+// not api errors are recorded as internal errors.
+func RecordAuthorizeSessionFailure(err error) {
+	if err == nil {
+		return
+	}
+	reason := codes.Internal.String()
+	var apiErr *handlers.ApiError
+	if errors.As(err, &apiErr) && apiErr.Inner.GetKind() != "" {
+		reason = apiErr.Inner.GetKind()
+	}
+	authorizeSessionFailuresTotal.With(prometheus.Labels{labelReason: reason}).Inc()
+}
+
+// SetSessionCounts replaces the values of the sessions gauge with the provided
+// counts.
+func SetSessionCounts(counts []*session.StateTargetCount) {
+	sessionsCount.Reset()
+	for _, c := range counts {
+		sessionsCount.With(prometheus.Labels{labelSessionState: c.State, labelTargetId: c.TargetId}).Set(float64(c.Count))
+	}
+}
+
+// SetClosedConnectionCounts sets the closed connections gauge to the provided
+// counts. Closed reasons without a count are set to 0.
+func SetClosedConnectionCounts(counts []*session.ClosedReasonCount) {
+	for _, r := range expectedClosedReasons {
+		closedConnectionsCount.With(prometheus.Labels{labelReason: r.String()}).Set(0)
+	}
+	for _, c := range counts {
+		closedConnectionsCount.With(prometheus.Labels{labelReason: c.ClosedReason}).Set(float64(c.Count))
+	}
+}
+
+// InitializeSessionCollectors registers the session metrics to the provided
+// prometheus register and initializes the failure and closed reason labels
+// to 0.
+func InitializeSessionCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(sessionsCount, authorizeSessionFailuresTotal, closedConnectionsCount)
+	for _, c := range expectedAuthorizeSessionFailureReasons {
+		authorizeSessionFailuresTotal.With(prometheus.Labels{labelReason: c.String()})
+	}
+	SetClosedConnectionCounts(nil)
+}
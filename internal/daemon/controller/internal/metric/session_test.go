@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRecordAuthorizeSessionFailure(t *testing.T) {
+	cases := []struct {
+		name       string
+		err        error
+		wantReason string
+	}{
+		{
+			name:       "forbidden",
+			err:        handlers.ForbiddenError(),
+			wantReason: codes.PermissionDenied.String(),
+		},
+		{
+			name:       "not found",
+			err:        handlers.NotFoundErrorf("Target %q not found.", "ttcp_1234567890"),
+			wantReason: codes.NotFound.String(),
+		},
+		{
+			name:       "no workers",
+			err:        handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "No workers are available to handle this session."),
+			wantReason: codes.FailedPrecondition.String(),
+		},
+		{
+			name:       "non api error",
+			err:        stderrors.New("unable to issue credentials"),
+			wantReason: codes.Internal.String(),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := authorizeSessionFailuresTotal.With(prometheus.Labels{labelReason: tc.wantReason})
+			before := testutil.ToFloat64(c)
+			RecordAuthorizeSessionFailure(tc.err)
+			assert.Equal(t, before+1, testutil.ToFloat64(c))
+		})
+	}
+
+	t.Run("nil error", func(t *testing.T) {
+		before := testutil.CollectAndCount(authorizeSessionFailuresTotal)
+		RecordAuthorizeSessionFailure(nil)
+		assert.Equal(t, before, testutil.CollectAndCount(authorizeSessionFailuresTotal))
+	})
+}
+
+func TestSetClosedConnectionCounts(t *testing.T) {
+	SetClosedConnectionCounts([]*session.ClosedReasonCount{
+		{ClosedReason: session.ConnectionClosedByUser.String(), Count: 3},
+	})
+	assert.Equal(t, float64(3), testutil.ToFloat64(closedConnectionsCount.With(prometheus.Labels{labelReason: session.ConnectionClosedByUser.String()})))
+	assert.Equal(t, float64(0), testutil.ToFloat64(closedConnectionsCount.With(prometheus.Labels{labelReason: session.ConnectionTimedOut.String()})))
+
+	SetClosedConnectionCounts(nil)
+	assert.Equal(t, float64(0), testutil.ToFloat64(closedConnectionsCount.With(prometheus.Labels{labelReason: session.ConnectionClosedByUser.String()})))
+	assert.Equal(t, len(expectedClosedReasons), testutil.CollectAndCount(closedConnectionsCount))
+}
+
+func TestSetSessionCounts(t *testing.T) {
+	SetSessionCounts([]*session.StateTargetCount{
+		{State: session.StatusActive.String(), TargetId: "ttcp_1234567890", Count: 2},
+		{State: session.StatusPending.String(), TargetId: "ttcp_1234567890", Count: 1},
+	})
+	assert.Equal(t, 2, testutil.CollectAndCount(sessionsCount))
+	assert.Equal(t, float64(2), testutil.ToFloat64(sessionsCount.With(prometheus.Labels{labelSessionState: session.StatusActive.String(), labelTargetId: "ttcp_1234567890"})))
+
+	// Stale label combinations are removed when the counts are replaced.
+	SetSessionCounts(nil)
+	assert.Equal(t, 0, testutil.CollectAndCount(sessionsCount))
+}
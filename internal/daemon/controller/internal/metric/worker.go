@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metric
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	workerSubsystem = "controller_worker"
+
+	labelOperationalState = "operational_state"
+	labelTagKey           = "tag_key"
+	labelTagValue         = "tag_value"
+)
+
+// workersCount is a gauge of the number of workers known to the controller
+// grouped by their operational state.
+var workersCount = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: workerSubsystem,
+		Name:      "workers",
+		Help:      "Gauge of the number of workers by operational state.",
+	},
+	[]string{labelOperationalState},
+)
+
+// workersByTagCount is a gauge of the number of workers which have a given
+// canonical tag key and value.
+var workersByTagCount = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: workerSubsystem,
+		Name:      "workers_by_tag",
+		Help:      "Gauge of the number of workers by tag key and value.",
+	},
+	[]string{labelTagKey, labelTagValue},
+)
+
+// All the operational states a worker can report.
+var expectedOperationalStates = []server.OperationalState{
+	server.ActiveOperationalState, server.ShutdownOperationalState,
+	server.UnknownOperationalState,
+}
+
+// SetWorkerCounts replaces the values of the worker gauges with the counts
+// derived from the provided workers.
+func SetWorkerCounts(workers []*server.Worker) {
+	workersByTagCount.Reset()
+	for _, s := range expectedOperationalStates {
+		workersCount.With(prometheus.Labels{labelOperationalState: s.String()}).Set(0)
+	}
+	for _, w := range workers {
+		state := server.OperationalState(w.GetOperationalState()).String()
+		workersCount.With(prometheus.Labels{labelOperationalState: state}).Inc()
+		for k, vs := range w.CanonicalTags() {
+			for _, v := range vs {
+				workersByTagCount.With(prometheus.Labels{labelTagKey: k, labelTagValue: v}).Inc()
+			}
+		}
+	}
+}
+
+// InitializeWorkerCollectors registers the worker metrics to the provided
+// prometheus register and initializes the operational state labels to 0.
+func InitializeWorkerCollectors(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(workersCount, workersByTagCount)
+	SetWorkerCounts(nil)
+}
@@ -37,7 +37,8 @@ func registerControllerServerCoordinationService(ctx context.Context, c *Control
 	}
 
 	workerService := handlers.NewWorkerServiceServer(c.ServersRepoFn, c.WorkerAuthRepoStorageFn,
-		c.SessionRepoFn, c.ConnectionRepoFn, c.downstreamWorkers, c.workerStatusUpdateTimes, c.kms, c.livenessTimeToStale)
+		c.SessionRepoFn, c.ConnectionRepoFn, c.StaticHostRepoFn, c.PluginHostRepoFn, c.DnsHostRepoFn, c.HostHealthRepoFn,
+		c.downstreamWorkers, c.workerStatusUpdateTimes, c.kms, c.livenessTimeToStale)
 	pbs.RegisterServerCoordinationServiceServer(server, workerService)
 	return nil
 }
@@ -55,7 +56,8 @@ func registerControllerSessionService(ctx context.Context, c *Controller, server
 	}
 
 	workerService := handlers.NewWorkerServiceServer(c.ServersRepoFn, c.WorkerAuthRepoStorageFn,
-		c.SessionRepoFn, c.ConnectionRepoFn, c.downstreamWorkers, c.workerStatusUpdateTimes, c.kms, c.livenessTimeToStale)
+		c.SessionRepoFn, c.ConnectionRepoFn, c.StaticHostRepoFn, c.PluginHostRepoFn, c.DnsHostRepoFn, c.HostHealthRepoFn,
+		c.downstreamWorkers, c.workerStatusUpdateTimes, c.kms, c.livenessTimeToStale)
 	pbs.RegisterSessionServiceServer(server, workerService)
 	return nil
 }
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	tailEventsPathPrefix = "/v1/scopes/"
+	tailEventsPathSuffix = ":tail-events"
+
+	// tailEventsKeepAlive is how often a comment is written to an idle event
+	// stream, so it isn't closed by intermediaries.
+	tailEventsKeepAlive = 30 * time.Second
+)
+
+// isTailEventsRequest reports whether the request is for a scope's
+// tail-events action.
+func isTailEventsRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, tailEventsPathPrefix) && strings.HasSuffix(r.URL.Path, tailEventsPathSuffix)
+}
+
+// droppedEvents is the data of the "dropped" server-sent event, which reports
+// the number of events which weren't delivered because the client wasn't
+// keeping up.
+type droppedEvents struct {
+	Dropped uint64 `json:"dropped"`
+}
+
+// handleTailEvents returns a handler which streams the controller's events to
+// the caller as server-sent events, for the tail-events action of a scope.
+// Callers authorized on the global scope receive every event, while callers
+// authorized on an org or project only receive the audit events for requests
+// in that scope and, for an org, its projects. Other requests are passed to
+// next.
+func handleTailEvents(c *Controller, props HandlerProperties, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const op = "controller.handleTailEvents"
+		if !isTailEventsRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		writeError := func(err error) {
+			handlers.ErrorHandler()(ctx, nil, handlers.JSONMarshaler(), w, r, err)
+		}
+
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		scopeId := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, tailEventsPathPrefix), tailEventsPathSuffix)
+		if scopeId != scope.Global.String() && !handlers.ValidId(handlers.Id(scopeId), scope.Org.Prefix(), scope.Project.Prefix()) {
+			writeError(handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"id": "Incorrectly formatted identifier."}))
+			return
+		}
+
+		requestInfo, err := decodeRequestInfo(ctx, r.Header.Get("Grpc-Metadata-"+requestInfoMdKey), c.apiGrpcGatewayTicket)
+		if err != nil {
+			writeError(err)
+			return
+		}
+		ctx = auth.NewVerifierContextWithAccounts(ctx, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.PasswordAuthRepoFn, c.OidcRepoFn, c.kms, requestInfo)
+		ctx = context.WithValue(ctx, requests.ContextRequestInformationKey, &requests.RequestContext{
+			Path:   requestInfo.Path,
+			Method: requestInfo.Method,
+		})
+
+		authResults := auth.Verify(ctx, auth.WithType(resource.Scope), auth.WithAction(action.TailEvents), auth.WithScopeId(scopeId))
+		if authResults.Error != nil {
+			writeError(authResults.Error)
+			return
+		}
+		if !c.conf.Eventer.StreamingEnabled() {
+			writeError(handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Event streaming is not enabled on this controller."))
+			return
+		}
+		scopeIds, err := tailEventsScopes(ctx, c, scopeId)
+		if err != nil {
+			writeError(err)
+			return
+		}
+
+		opts := []event.Option{event.WithBufferSize(event.DefaultSubscriptionBufferSize)}
+		if f := strings.TrimSpace(r.URL.Query().Get("filter")); f != "" {
+			opts = append(opts, event.WithAllow(f))
+		}
+		if scopeIds != nil {
+			opts = append(opts, event.WithScopeIds(scopeIds...))
+		}
+		sub, err := c.conf.Eventer.Subscribe(opts...)
+		if err != nil {
+			writeError(handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"filter": fmt.Sprintf("Invalid filter: %s", err)}))
+			return
+		}
+		defer sub.Close()
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			writeError(handlers.ApiErrorWithCodeAndMessage(codes.Internal, "streaming is not supported by the response writer"))
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(tailEventsKeepAlive)
+		defer keepAlive.Stop()
+		for {
+			var buf bytes.Buffer
+			select {
+			case <-ctx.Done():
+				return
+			case <-props.CancelCtx.Done():
+				return
+			case data, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				writeDropped(&buf, sub.Dropped())
+				var ce struct {
+					Type string `json:"type"`
+				}
+				if err := json.Unmarshal(data, &ce); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("unable to decode streamed event"))
+					continue
+				}
+				fmt.Fprintf(&buf, "event: %s\ndata: %s\n\n", ce.Type, bytes.TrimSpace(data))
+			case <-keepAlive.C:
+				writeDropped(&buf, sub.Dropped())
+				buf.WriteString(": keep-alive\n\n")
+			}
+			if _, err := w.Write(buf.Bytes()); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+// writeDropped writes a "dropped" server-sent event to buf if any events were
+// dropped.
+func writeDropped(buf *bytes.Buffer, dropped uint64) {
+	if dropped == 0 {
+		return
+	}
+	data, _ := json.Marshal(droppedEvents{Dropped: dropped})
+	fmt.Fprintf(buf, "event: dropped\ndata: %s\n\n", data)
+}
+
+// tailEventsScopes returns the ids of the scopes whose audit events are
+// streamed to callers of the tail-events action on the scope. A nil slice is
+// returned for the global scope, whose callers receive every event.
+func tailEventsScopes(ctx context.Context, c *Controller, scopeId string) ([]string, error) {
+	const op = "controller.tailEventsScopes"
+	if scopeId == scope.Global.String() {
+		return nil, nil
+	}
+	repo, err := c.IamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s, err := repo.LookupScope(ctx, scopeId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return nil, handlers.NotFoundErrorf("Scope %q not found.", scopeId)
+	}
+	ret := []string{scopeId}
+	if s.GetType() == scope.Org.String() {
+		projects, err := repo.ListScopes(ctx, []string{scopeId})
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, p := range projects {
+			ret = append(ret, p.GetPublicId())
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/api/scopes"
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTailEvents(t *testing.T) {
+	ctx := context.Background()
+	eventConfig := event.TestEventerConfig(t, "TestHandleTailEvents", event.TestWithAuditSink(t))
+	eventConfig.EventerConfig.StreamingEnabled = true
+	conf, err := config.DevController()
+	require.NoError(t, err)
+	conf.Eventing = &eventConfig.EventerConfig
+
+	tc := NewTestController(t, &TestControllerOpts{Config: conf})
+	defer tc.Shutdown()
+	conn, kmsCache := tc.DbConn(), tc.Kms()
+
+	client := tc.Client()
+	client.SetToken(tc.Token().Token)
+	scopesClient := scopes.NewClient(client)
+	newScope := func(t *testing.T, parentId string) string {
+		t.Helper()
+		s, err := scopesClient.Create(ctx, parentId)
+		require.NoError(t, err)
+		return s.GetItem().Id
+	}
+	org := newScope(t, scope.Global.String())
+	prj := newScope(t, org)
+	otherOrg := newScope(t, scope.Global.String())
+
+	// newToken returns the token of a new user of an auth method in the
+	// global scope, granted the tail-events action in the grantScopeIds.
+	newToken := func(t *testing.T, grantScopeIds ...string) string {
+		t.Helper()
+		at := authtoken.TestAuthToken(t, conn, kmsCache, scope.Global.String())
+		for _, s := range grantScopeIds {
+			r := iam.TestRole(t, conn, s)
+			iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=scope;actions=tail-events")
+			iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+		}
+		encToken, err := authtoken.EncryptToken(ctx, kmsCache, scope.Global.String(), at.GetPublicId(), at.GetToken())
+		require.NoError(t, err)
+		return at.GetPublicId() + "_" + encToken
+	}
+
+	tail := func(t *testing.T, token, scopeId string) *http.Response {
+		t.Helper()
+		reqCtx, cancel := context.WithCancel(ctx)
+		t.Cleanup(cancel)
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, fmt.Sprintf("%s/v1/scopes/%s:tail-events", tc.ApiAddrs()[0], scopeId), nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	// auditScopes reads the streamed events until an audit event for the
+	// scope until is received, and returns the scope ids of the audit events
+	// received.
+	auditScopes := func(t *testing.T, resp *http.Response, until string) []string {
+		t.Helper()
+		scopeIds := make(chan string)
+		go func() {
+			defer close(scopeIds)
+			scanner := bufio.NewScanner(resp.Body)
+			scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+				if !ok {
+					continue
+				}
+				var ce struct {
+					Type string `json:"type"`
+					Data struct {
+						Auth struct {
+							ScopeId string `json:"scope_id"`
+						} `json:"auth"`
+					} `json:"data"`
+				}
+				if err := json.Unmarshal([]byte(data), &ce); err != nil || ce.Type != string(event.AuditType) {
+					continue
+				}
+				scopeIds <- ce.Data.Auth.ScopeId
+				if ce.Data.Auth.ScopeId == until {
+					return
+				}
+			}
+		}()
+		var got []string
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case s, ok := <-scopeIds:
+				if !ok {
+					return got
+				}
+				got = append(got, s)
+			case <-timeout:
+				require.FailNow(t, "timed out waiting for audit events", "received %v", got)
+			}
+		}
+	}
+
+	// auditEvents makes a request in each scope, which emits an audit event
+	// for the scope. The request in the project is made last.
+	auditEvents := func(t *testing.T) {
+		t.Helper()
+		_, err := scopesClient.List(ctx, otherOrg)
+		require.NoError(t, err)
+		_, err = scopesClient.List(ctx, org)
+		require.NoError(t, err)
+		_, err = targets.NewClient(client).List(ctx, prj)
+		require.NoError(t, err)
+	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		resp := tail(t, "", scope.Global.String())
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("not-authorized", func(t *testing.T) {
+		token := newToken(t, otherOrg)
+		resp := tail(t, token, org)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("org", func(t *testing.T) {
+		resp := tail(t, newToken(t, org), org)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		auditEvents(t)
+		assert.Equal(t, []string{org, prj}, auditScopes(t, resp, prj))
+	})
+
+	t.Run("project", func(t *testing.T) {
+		resp := tail(t, newToken(t, prj), prj)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		auditEvents(t)
+		assert.Equal(t, []string{prj}, auditScopes(t, resp, prj))
+	})
+
+	t.Run("global", func(t *testing.T) {
+		resp := tail(t, newToken(t, scope.Global.String()), scope.Global.String())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		auditEvents(t)
+		assert.Subset(t, auditScopes(t, resp, prj), []string{otherOrg, org, prj})
+	})
+}
+
+func TestHandleTailEvents_StreamingNotEnabled(t *testing.T) {
+	tc := NewTestController(t, nil)
+	defer tc.Shutdown()
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v1/scopes/global:tail-events", tc.ApiAddrs()[0]), nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+tc.Token().Token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -585,7 +585,7 @@ func TestControllerConfig(t testing.TB, ctx context.Context, tc *TestController,
 	tc.b.DevUnprivilegedOidcAccountId = DefaultTestUnprivilegedOidcAccountId
 	tc.b.DevLoopbackHostPluginId = DefaultTestPluginId
 
-	tc.b.EnabledPlugins = append(tc.b.EnabledPlugins, base.EnabledPluginHostLoopback)
+	tc.b.EnabledPlugins = append(tc.b.EnabledPlugins, base.EnabledPluginHostLoopback, base.EnabledPluginCredentialLoopback)
 
 	// Start a logger
 	tc.b.Logger = opts.Logger
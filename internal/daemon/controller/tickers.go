@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/internal/metric"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/server"
@@ -23,6 +24,7 @@ const (
 	workerConnectionMaintenanceInterval = 3 * time.Second
 	statusInterval                      = 10 * time.Second
 	terminationInterval                 = 1 * time.Minute
+	domainMetricsInterval               = 30 * time.Second
 )
 
 // This is exported so it can be tweaked in tests
@@ -164,6 +166,69 @@ func (c *Controller) startCloseExpiredPendingTokens(cancelCtx context.Context) {
 	}
 }
 
+// startDomainMetricsTicking periodically refreshes the gauges which are
+// derived from the state of the database, such as the number of sessions by
+// state and the number of workers by operational state.
+func (c *Controller) startDomainMetricsTicking(cancelCtx context.Context) {
+	const op = "controller.(Controller).startDomainMetricsTicking"
+	timer := time.NewTimer(0)
+	for {
+		select {
+		case <-cancelCtx.Done():
+			event.WriteSysEvent(cancelCtx, op, "domain metrics ticking shutting down")
+			return
+
+		case <-timer.C:
+			if err := c.updateDomainMetrics(cancelCtx); err != nil {
+				event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error updating domain metrics"))
+			}
+			timer.Reset(domainMetricsInterval)
+		}
+	}
+}
+
+func (c *Controller) updateDomainMetrics(ctx context.Context) error {
+	const op = "controller.(Controller).updateDomainMetrics"
+	sessionRepo, err := c.SessionRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error fetching session repository"))
+	}
+	sessionCounts, err := sessionRepo.CountSessionsByStateAndTarget(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	metric.SetSessionCounts(sessionCounts)
+
+	connectionRepo, err := c.ConnectionRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error fetching connection repository"))
+	}
+	closedCounts, err := connectionRepo.CountClosedConnectionsByReason(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	metric.SetClosedConnectionCounts(closedCounts)
+
+	serversRepo, err := c.ServersRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error fetching servers repository"))
+	}
+	workers, err := serversRepo.ListWorkers(ctx, []string{scope.Global.String()},
+		server.WithLiveness(time.Duration(c.workerStatusGracePeriod.Load())),
+		server.WithLimit(-1))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	metric.SetWorkerCounts(workers)
+
+	jobs, err := c.kms.ListAllDataKeyVersionDestructionJobs(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	metric.SetRewrapJobProgress(jobs)
+	return nil
+}
+
 func (c *Controller) startWorkerConnectionMaintenanceTicking(cancelCtx context.Context, wg *sync.WaitGroup, m *cluster.DownstreamManager) error {
 	const op = "controller.(Controller).startWorkerConnectionMaintenanceTicking"
 	switch {
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// tickInterval is how often the Checker looks for health checks which are
+// due to run. It is a variable so that tests can override it.
+var tickInterval = time.Second
+
+type checkKey struct {
+	hostSetId string
+	hostId    string
+}
+
+func keyOf(hostSetId, hostId string) checkKey {
+	return checkKey{hostSetId: hostSetId, hostId: hostId}
+}
+
+// A Checker runs host health checks at the interval of each check. It is
+// safe for concurrent use.
+type Checker struct {
+	mu      sync.Mutex
+	checks  map[checkKey]*pbs.HostHealthCheck
+	lastRun map[checkKey]time.Time
+	running map[checkKey]bool
+	results map[checkKey]*pbs.HostHealthResult
+}
+
+// New creates a new Checker without any health checks.
+func New() *Checker {
+	return &Checker{
+		checks:  make(map[checkKey]*pbs.HostHealthCheck),
+		lastRun: make(map[checkKey]time.Time),
+		running: make(map[checkKey]bool),
+		results: make(map[checkKey]*pbs.HostHealthResult),
+	}
+}
+
+// SetChecks replaces the health checks run by c. Results of health checks
+// which are no longer assigned are discarded.
+func (c *Checker) SetChecks(checks []*pbs.HostHealthCheck) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	newChecks := make(map[checkKey]*pbs.HostHealthCheck, len(checks))
+	for _, hc := range checks {
+		newChecks[keyOf(hc.GetHostSetId(), hc.GetHostId())] = hc
+	}
+	for k := range c.lastRun {
+		if _, ok := newChecks[k]; !ok {
+			delete(c.lastRun, k)
+			delete(c.results, k)
+		}
+	}
+	c.checks = newChecks
+}
+
+// Results returns the results of the health checks completed since the
+// previous call to Results.
+func (c *Checker) Results() []*pbs.HostHealthResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.results) == 0 {
+		return nil
+	}
+	ret := make([]*pbs.HostHealthResult, 0, len(c.results))
+	for k, r := range c.results {
+		ret = append(ret, r)
+		delete(c.results, k)
+	}
+	return ret
+}
+
+// Requeue puts results returned by Results which could not be delivered back
+// so they are returned by the next call to Results. A result is dropped if
+// its check is no longer assigned or a newer result for the host is pending.
+func (c *Checker) Requeue(results []*pbs.HostHealthResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, r := range results {
+		k := keyOf(r.GetHostSetId(), r.GetHostId())
+		if _, ok := c.checks[k]; !ok {
+			continue
+		}
+		if _, ok := c.results[k]; ok {
+			continue
+		}
+		c.results[k] = r
+	}
+}
+
+// Start runs the health checks of c until ctx is done.
+func (c *Checker) Start(ctx context.Context) {
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, hc := range c.due(now) {
+				wg.Add(1)
+				go func(hc *pbs.HostHealthCheck) {
+					defer wg.Done()
+					c.run(ctx, hc)
+				}(hc)
+			}
+		}
+	}
+}
+
+// due returns the health checks which are not running and whose interval
+// has passed since they last ran, and marks them as running.
+func (c *Checker) due(now time.Time) []*pbs.HostHealthCheck {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ret []*pbs.HostHealthCheck
+	for k, hc := range c.checks {
+		if c.running[k] {
+			continue
+		}
+		interval := time.Duration(hc.GetIntervalSeconds()) * time.Second
+		if last, ok := c.lastRun[k]; ok && now.Sub(last) < interval {
+			continue
+		}
+		c.running[k] = true
+		c.lastRun[k] = now
+		ret = append(ret, hc)
+	}
+	return ret
+}
+
+func (c *Checker) run(ctx context.Context, hc *pbs.HostHealthCheck) {
+	k := keyOf(hc.GetHostSetId(), hc.GetHostId())
+	checkTime := time.Now()
+	err := Check(ctx, hc)
+	r := &pbs.HostHealthResult{
+		HostSetId: hc.GetHostSetId(),
+		HostId:    hc.GetHostId(),
+		Healthy:   err == nil,
+		CheckTime: timestamppb.New(checkTime),
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.running, k)
+	if ctx.Err() != nil {
+		// The result of a canceled check says nothing about the host.
+		return
+	}
+	if _, ok := c.checks[k]; ok {
+		c.results[k] = r
+	}
+}
+
+// Check runs the health check hc once and returns an error if the host is
+// not healthy.
+func Check(ctx context.Context, hc *pbs.HostHealthCheck) error {
+	timeout := time.Duration(hc.GetTimeoutSeconds()) * time.Second
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	address := net.JoinHostPort(hc.GetAddress(), strconv.FormatUint(uint64(hc.GetPort()), 10))
+
+	switch hc.GetCheckType() {
+	case "tcp":
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+
+	case "tls":
+		// Only the handshake is checked. The certificate of the host is not
+		// verified since the worker does not have its trust anchors.
+		d := tls.Dialer{Config: &tls.Config{
+			InsecureSkipVerify: true,
+			ServerName:         hc.GetAddress(),
+		}}
+		conn, err := d.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+
+	case "http":
+		u := url.URL{
+			Scheme: "http",
+			Host:   address,
+			Path:   hc.GetHttpPath(),
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return err
+		}
+		client := &http.Client{
+			// A redirect counts as healthy so it is not followed.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown health check type %q", hc.GetCheckType())
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hostcheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func hostPort(t *testing.T, address string) (string, uint32) {
+	t.Helper()
+	h, p, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+	port, err := strconv.ParseUint(p, 10, 32)
+	require.NoError(t, err)
+	return h, uint32(port)
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+
+	httpSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(httpSrv.Close)
+	httpHost, httpPort := hostPort(t, httpSrv.Listener.Addr().String())
+
+	tlsSrv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(tlsSrv.Close)
+	tlsHost, tlsPort := hostPort(t, tlsSrv.Listener.Addr().String())
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedHost, closedPort := hostPort(t, closed.Addr().String())
+	require.NoError(t, closed.Close())
+
+	tests := []struct {
+		name    string
+		hc      *pbs.HostHealthCheck
+		wantErr bool
+	}{
+		{
+			name: "tcp",
+			hc:   &pbs.HostHealthCheck{CheckType: "tcp", Address: httpHost, Port: httpPort, TimeoutSeconds: 1},
+		},
+		{
+			name:    "tcp-closed",
+			hc:      &pbs.HostHealthCheck{CheckType: "tcp", Address: closedHost, Port: closedPort, TimeoutSeconds: 1},
+			wantErr: true,
+		},
+		{
+			name: "tls",
+			hc:   &pbs.HostHealthCheck{CheckType: "tls", Address: tlsHost, Port: tlsPort, TimeoutSeconds: 1},
+		},
+		{
+			name:    "tls-without-tls",
+			hc:      &pbs.HostHealthCheck{CheckType: "tls", Address: httpHost, Port: httpPort, TimeoutSeconds: 1},
+			wantErr: true,
+		},
+		{
+			name: "http",
+			hc:   &pbs.HostHealthCheck{CheckType: "http", Address: httpHost, Port: httpPort, HttpPath: "/ok", TimeoutSeconds: 1},
+		},
+		{
+			name: "http-redirect",
+			hc:   &pbs.HostHealthCheck{CheckType: "http", Address: httpHost, Port: httpPort, HttpPath: "/redirect", TimeoutSeconds: 1},
+		},
+		{
+			name:    "http-unavailable",
+			hc:      &pbs.HostHealthCheck{CheckType: "http", Address: httpHost, Port: httpPort, HttpPath: "/down", TimeoutSeconds: 1},
+			wantErr: true,
+		},
+		{
+			name:    "unknown-type",
+			hc:      &pbs.HostHealthCheck{CheckType: "icmp", Address: httpHost, Port: httpPort, TimeoutSeconds: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(ctx, tt.hc)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestChecker(t *testing.T) {
+	oldTick := tickInterval
+	tickInterval = 10 * time.Millisecond
+	t.Cleanup(func() { tickInterval = oldTick })
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	h, p := hostPort(t, l.Addr().String())
+
+	c := New()
+	c.SetChecks([]*pbs.HostHealthCheck{
+		{HostSetId: "hsst_1", HostId: "hst_1", CheckType: "tcp", Address: h, Port: p, IntervalSeconds: 5, TimeoutSeconds: 1},
+	})
+	assert.Empty(t, c.Results())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Start(ctx)
+	}()
+
+	var results []*pbs.HostHealthResult
+	require.Eventually(t, func() bool {
+		results = c.Results()
+		return len(results) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, results, 1)
+	assert.Equal(t, "hsst_1", results[0].GetHostSetId())
+	assert.Equal(t, "hst_1", results[0].GetHostId())
+	assert.True(t, results[0].GetHealthy())
+	assert.Empty(t, results[0].GetError())
+	assert.NotNil(t, results[0].GetCheckTime())
+
+	// The check does not run again before its interval has passed.
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, c.Results())
+
+	// Unassigned checks no longer run.
+	c.SetChecks(nil)
+	assert.Empty(t, c.due(time.Now().Add(time.Hour)))
+
+	cancel()
+	<-done
+}
+
+func TestChecker_Requeue(t *testing.T) {
+	c := New()
+	c.SetChecks([]*pbs.HostHealthCheck{
+		{HostSetId: "hsst_1", HostId: "hst_1", CheckType: "tcp"},
+		{HostSetId: "hsst_1", HostId: "hst_2", CheckType: "tcp"},
+	})
+	old := &pbs.HostHealthResult{HostSetId: "hsst_1", HostId: "hst_1", Healthy: true}
+	newer := &pbs.HostHealthResult{HostSetId: "hsst_1", HostId: "hst_2", Healthy: false}
+	stale := &pbs.HostHealthResult{HostSetId: "hsst_1", HostId: "hst_2", Healthy: true}
+	unassigned := &pbs.HostHealthResult{HostSetId: "hsst_1", HostId: "hst_3", Healthy: true}
+	c.results[keyOf("hsst_1", "hst_2")] = newer
+
+	c.Requeue([]*pbs.HostHealthResult{old, stale, unassigned})
+	assert.ElementsMatch(t, []*pbs.HostHealthResult{old, newer}, c.Results())
+	assert.Empty(t, c.Results())
+}
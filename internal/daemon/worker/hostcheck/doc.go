@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package hostcheck runs the host health checks a controller assigns to a
+// worker in its status response. The results are reported back to the
+// controller in the next status request.
+package hostcheck
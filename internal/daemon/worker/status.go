@@ -172,6 +172,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 	versionInfo := version.Get()
 	connectionState := w.pkiConnManager.Connected()
+	hostHealthResults := w.hostChecker.Results()
 	result, err := client.Status(statusCtx, &pbs.StatusRequest{
 		Jobs: activeJobs,
 		WorkerStatus: &pb.ServerWorkerStatus{
@@ -187,9 +188,13 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 		ConnectedUnmappedWorkerKeyIdentifiers: connectionState.UnmappedKeyIds(),
 		ConnectedWorkerPublicIds:              connectionState.WorkerIds(),
 		UpdateTags:                            w.updateTags.Load(),
+		HostHealthResults:                     hostHealthResults,
 	})
 	if err != nil {
 		event.WriteError(cancelCtx, op, err, event.WithInfoMsg("error making status request to controller"))
+		// The controller did not receive the host health results, so send
+		// them with the next status request.
+		w.hostChecker.Requeue(hostHealthResults)
 		// Check for last successful status. Ignore nil last status, this probably
 		// means that we've never connected to a controller, and as such probably
 		// don't have any sessions to worry about anyway.
@@ -250,6 +255,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context, sessionManager sess
 	}
 
 	w.updateTags.Store(false)
+	w.hostChecker.SetChecks(result.GetHostHealthChecks())
 
 	if authorized := result.GetAuthorizedDownstreamWorkers(); authorized != nil {
 		connectionState.DisconnectMissingWorkers(authorized.GetWorkerPublicIds())
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
 	"github.com/hashicorp/boundary/internal/daemon/worker/common"
+	"github.com/hashicorp/boundary/internal/daemon/worker/hostcheck"
 	"github.com/hashicorp/boundary/internal/daemon/worker/internal/metric"
 	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
 	"github.com/hashicorp/boundary/internal/daemon/worker/session"
@@ -102,6 +103,9 @@ type Worker struct {
 
 	sessionManager session.Manager
 
+	// hostChecker runs the host health checks assigned by the controller.
+	hostChecker *hostcheck.Checker
+
 	controllerStatusConn *atomic.Value
 	everAuthenticated    *ua.Uint32
 	lastStatusSuccess    *atomic.Value
@@ -171,6 +175,7 @@ func New(conf *Config) (*Worker, error) {
 		pkiConnManager:              cluster.NewDownstreamManager(),
 		successfulStatusGracePeriod: new(atomic.Int64),
 		statusCallTimeoutDuration:   new(atomic.Int64),
+		hostChecker:                 hostcheck.New(),
 	}
 
 	if reverseConnReceiverFactory != nil {
@@ -430,7 +435,7 @@ func (w *Worker) Start() error {
 	// Rather than deal with some of the potential error conditions for Add on
 	// the waitgroup vs. Done (in case a function exits immediately), we will
 	// always start rotation and simply exit early if we're using KMS
-	w.tickerWg.Add(2)
+	w.tickerWg.Add(3)
 	go func() {
 		defer w.tickerWg.Done()
 		w.startStatusTicking(w.baseContext, w.sessionManager, &w.addressReceivers)
@@ -439,6 +444,10 @@ func (w *Worker) Start() error {
 		defer w.tickerWg.Done()
 		w.startAuthRotationTicking(w.baseContext)
 	}()
+	go func() {
+		defer w.tickerWg.Done()
+		w.hostChecker.Start(w.baseContext)
+	}()
 
 	if w.downstreamReceiver != nil {
 		w.tickerWg.Add(2)
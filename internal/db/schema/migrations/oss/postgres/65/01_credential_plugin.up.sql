@@ -0,0 +1,488 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+/*
+  ┌──────────────────┐
+  │      plugin      │
+  ├──────────────────┤
+  │public_id (pk)    │
+  │scope_id (fk)     │
+  │name              │
+  └──────────────────┘
+            ┼
+            ┼
+            │
+            ┼
+            ┼
+  ┌──────────────────┐
+  │plugin_credential │
+  ├──────────────────┤
+  │public_id (pk)    │
+  │scope_id (fk)     │
+  │name              │
+  │description       │
+  │version           │
+  └──────────────────┘
+*/
+  create table plugin_credential (
+    public_id wt_plugin_id primary key,
+    scope_id wt_scope_id not null
+    constraint iam_scope_global_fkey
+      references iam_scope_global(scope_id)
+      on delete cascade
+      on update cascade,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint plugin_fkey
+    foreign key (scope_id, public_id)
+      references plugin(scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint plugin_credential_scope_id_name_uq
+    unique(scope_id, name)
+  );
+  comment on table plugin_credential is
+    'plugin_credential is a table where each row is a plugin which provides credential stores.';
+
+  create trigger update_version_column after update on plugin_credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on plugin_credential
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_credential
+    for each row execute procedure immutable_columns('public_id', 'create_time');
+
+  create trigger insert_plugin_subtype before insert on plugin_credential
+    for each row execute procedure insert_plugin_subtype();
+
+  create trigger update_plugin_subtype before update on plugin_credential
+    for each row execute procedure update_plugin_subtype();
+
+  create trigger delete_plugin_subtype after delete on plugin_credential
+    for each row execute procedure delete_plugin_subtype();
+
+/*
+                               ┌───────────────────┐
+                               │ plugin_credential │
+                               ├───────────────────┤
+                               │public_id (pk)     │
+                               └───────────────────┘
+                                         ┼
+                                         ┼
+                                         ○
+                                        ╱│╲
+                            ┌─────────────────────────┐      ┌──────────────────────────────┐
+    ┌──────────────────┐    │ credential_plugin_store │      │credential_plugin_store_secret│
+    │ credential_store │    ├─────────────────────────┤      ├──────────────────────────────┤
+    ├──────────────────┤    │public_id (pk)           │      │store_id (pk, fk)             │
+    │public_id         │┼┼○┼│plugin_id (fk)           │┼┼──○┼│secret                        │
+    │project_id        │    │project_id (fk)          │      │key_id (fk)                   │
+    └──────────────────┘    │attributes               │      └──────────────────────────────┘
+                            └─────────────────────────┘
+                                         ┼
+                                         ┼
+                                         ○
+                                        ╱│╲
+    ┌──────────────────┐    ┌─────────────────────────┐      ┌──────────────────────────────┐
+    │credential_library│    │credential_plugin_library│      │ credential_plugin_credential │
+    ├──────────────────┤    ├─────────────────────────┤      ├──────────────────────────────┤
+    │public_id         │┼┼○┼│public_id (pk)           │┼○──○┼│public_id (pk)                │
+    │store_id          │    │store_id (fk)            │      │library_id (fk)               │
+    └──────────────────┘    │attributes               │      │session_id (fk)               │
+                            └─────────────────────────┘      │external_id                   │
+                                                             │status                        │
+                                                             └──────────────────────────────┘
+*/
+
+  create table credential_plugin_store (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    plugin_id wt_plugin_id not null
+      constraint plugin_credential_fkey
+        references plugin_credential (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea not null,
+    secrets_hmac bytea,
+    constraint credential_store_fkey
+      foreign key (project_id, public_id)
+        references credential_store (project_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint credential_plugin_store_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table credential_plugin_store is
+    'credential_plugin_store is a table where each row is a resource that represents a plugin credential store. '
+    'It is a credential_store subtype.';
+
+  create trigger update_version_column after update on credential_plugin_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_plugin_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_plugin_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_store
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'plugin_id', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_plugin_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_plugin_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  create table credential_plugin_store_secret (
+    store_id wt_public_id primary key
+      constraint credential_plugin_store_fkey
+        references credential_plugin_store (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null  -- encrypted value
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table credential_plugin_store_secret is
+    'credential_plugin_store_secret is a table where each row contains the encrypted secrets persisted for a plugin credential store.';
+
+  create trigger update_time_column before update on credential_plugin_store_secret
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_plugin_store_secret
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_store_secret
+    for each row execute procedure immutable_columns('store_id', 'create_time');
+
+  create view credential_plugin_store_with_secret as
+  select
+    s.public_id,
+    s.project_id,
+    s.plugin_id,
+    s.name,
+    s.description,
+    s.create_time,
+    s.update_time,
+    s.version,
+    s.secrets_hmac,
+    s.attributes,
+    sec.secret,
+    sec.key_id,
+    sec.create_time as persisted_create_time,
+    sec.update_time as persisted_update_time
+  from
+    credential_plugin_store s
+    left join credential_plugin_store_secret sec on s.public_id = sec.store_id;
+  comment on view credential_plugin_store_with_secret is
+    'credential_plugin_store_with_secret is a view where each row contains a plugin credential store and its encrypted secret. '
+    'This view should not be used to retrieve data which will be returned external to boundary.';
+
+  create table credential_plugin_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_plugin_store_fkey
+        references credential_plugin_store (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea not null,
+    credential_type text not null
+      constraint credential_type_enm_fkey
+        references credential_type_enm (name)
+        on delete restrict
+        on update cascade,
+    constraint credential_library_fkey
+      foreign key (project_id, store_id, public_id, credential_type)
+        references credential_library (project_id, store_id, public_id, credential_type)
+        on delete cascade
+        on update cascade,
+    constraint credential_plugin_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_plugin_library_store_id_public_id_uq
+      unique(store_id, public_id)
+  );
+  comment on table credential_plugin_library is
+    'credential_plugin_library is a table where each row is a resource that represents a plugin credential library. '
+    'It is a credential_library subtype and a child table of credential_plugin_store.';
+
+  create trigger update_version_column after update on credential_plugin_library
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_plugin_library
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_plugin_library
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'credential_type', 'create_time');
+
+  create trigger insert_credential_library_subtype before insert on credential_plugin_library
+    for each row execute procedure insert_credential_library_subtype();
+
+  create trigger delete_credential_library_subtype after delete on credential_plugin_library
+    for each row execute procedure delete_credential_library_subtype();
+
+  create table credential_plugin_credential (
+    public_id wt_public_id primary key,
+    library_id wt_public_id
+      constraint credential_plugin_library_fkey
+        references credential_plugin_library (public_id)
+        on delete set null
+        on update cascade,
+    session_id wt_public_id
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    external_id wt_sentinel not null,
+    expiration_time timestamp with time zone,
+    is_revocable boolean not null,
+    status text not null
+      constraint credential_vault_credential_status_enm_fkey
+        references credential_vault_credential_status_enm (name)
+        on delete restrict
+        on update cascade,
+    constraint credential_dynamic_fkey
+      foreign key (library_id, public_id)
+      references credential_dynamic (library_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_plugin_credential_library_id_public_id_uq
+      unique(library_id, public_id)
+  );
+  comment on table credential_plugin_credential is
+    'credential_plugin_credential is a table where each row contains the information for a single credential issued by a credential plugin for a session.';
+
+  create trigger update_version_column after update on credential_plugin_credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_plugin_credential
+    for each row execute procedure update_time_column();
+
+  create trigger update_credential_status_column before update on credential_plugin_credential
+    for each row execute procedure update_credential_status_column();
+
+  create trigger not_null_columns before insert on credential_plugin_credential
+    for each row execute procedure not_null_columns('library_id', 'session_id');
+
+  create trigger default_create_time_column before insert on credential_plugin_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_plugin_credential
+    for each row execute procedure immutable_columns('external_id', 'create_time');
+
+  create trigger insert_credential_dynamic_subtype before insert on credential_plugin_credential
+    for each row execute procedure insert_credential_dynamic_subtype();
+
+  create trigger delete_credential_dynamic_subtype after delete on credential_plugin_credential
+    for each row execute procedure delete_credential_dynamic_subtype();
+
+  create index credential_plugin_credential_status_ix
+    on credential_plugin_credential(status);
+  comment on index credential_plugin_credential_status_ix is
+    'the credential_plugin_credential_status_ix is used by the credential revocation job';
+
+  insert into oplog_ticket (name, version)
+  values
+    ('plugin_credential', 1),
+    ('credential_plugin_store', 1),
+    ('credential_plugin_library', 1),
+    ('credential_plugin_credential', 1);
+
+  -- revoke_credentials revokes any active credentials for a session when the
+  -- session enters the canceling or terminated states.
+  -- Replaces the revoke_credentials function defined in 10/06_session.up.sql
+  create or replace function revoke_credentials() returns trigger
+  as $$
+  begin
+    if new.state in ('canceling', 'terminated') then
+      update credential_vault_credential
+         set status = 'revoke'
+       where session_id = new.session_id
+         and status = 'active';
+
+      update credential_plugin_credential
+         set status = 'revoke'
+       where session_id = new.session_id
+         and status = 'active'
+         and is_revocable;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- The whx_credential_dimension_source view shows the current values in the
+  -- operational tables of the credential dimension.
+  -- Replaces whx_credential_dimension_source defined in oss/64/01_ssh_targets.up.sql
+  drop view whx_credential_dimension_source;
+  create view whx_credential_dimension_source as
+    with vault_generic_library as (
+      select vcl.public_id                                        as public_id,
+             'vault generic credential library'                   as type,
+             coalesce(vcl.name,        'None')                    as name,
+             coalesce(vcl.description, 'None')                    as description,
+             vcl.vault_path                                       as vault_path,
+             vcl.http_method                                      as http_method,
+             case
+               when vcl.http_method = 'GET' then 'Not Applicable'
+               else coalesce(vcl.http_request_body::text, 'None')
+             end                                                  as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_vault_library as vcl
+    ),
+    vault_ssh_cert_library as (
+      select vsccl.public_id                                      as public_id,
+             'vault ssh certificate credential library'           as type,
+             coalesce(vsccl.name,        'None')                  as name,
+             coalesce(vsccl.description, 'None')                  as description,
+             vsccl.vault_path                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             vsccl.username                                       as username,
+             case
+               when vsccl.key_type = 'ed25519' then vsccl.key_type
+               else vsccl.key_type || '-' || vsccl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_vault_ssh_cert_library as vsccl
+    ),
+    plugin_library as (
+      select pcl.public_id                                        as public_id,
+             'plugin credential library'                          as type,
+             coalesce(pcl.name,        'None')                    as name,
+             coalesce(pcl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_plugin_library as pcl
+    ),
+    final as (
+          select s.public_id                                                      as session_id,
+                 scd.credential_purpose                                           as credential_purpose,
+                 cl.public_id                                                     as credential_library_id,
+                 coalesce(vcl.type,              vsccl.type,              pcl.type)              as credential_library_type,
+                 coalesce(vcl.name,              vsccl.name,              pcl.name)              as credential_library_name,
+                 coalesce(vcl.description,       vsccl.description,       pcl.description)       as credential_library_description,
+                 coalesce(vcl.vault_path,        vsccl.vault_path,        pcl.vault_path)        as credential_library_vault_path,
+                 coalesce(vcl.http_method,       vsccl.http_method,       pcl.http_method)       as credential_library_vault_http_method,
+                 coalesce(vcl.http_request_body, vsccl.http_request_body, pcl.http_request_body) as credential_library_vault_http_request_body,
+                 coalesce(vcl.username,          vsccl.username,          pcl.username)          as credential_library_username,
+                 coalesce(vcl.key_type_and_bits, vsccl.key_type_and_bits, pcl.key_type_and_bits) as credential_library_key_type_and_bits,
+                 cs.public_id                                                     as credential_store_id,
+                 case
+                   when vcs is not null then 'vault credential store'
+                   when pcs is not null then 'plugin credential store'
+                   else 'None'
+                 end                                                              as credential_store_type,
+                 coalesce(vcs.name,              pcs.name,        'None')         as credential_store_name,
+                 coalesce(vcs.description,       pcs.description, 'None')         as credential_store_description,
+                 case
+                   when pcs is not null then 'Not Applicable'
+                   else coalesce(vcs.namespace, 'None')
+                 end                                                              as credential_store_vault_namespace,
+                 case
+                   when pcs is not null then 'Not Applicable'
+                   else coalesce(vcs.vault_address, 'None')
+                 end                                                              as credential_store_vault_address,
+                 t.public_id                                                      as target_id,
+                 case
+                   when tt.type = 'tcp' then 'tcp target'
+                   when tt.type = 'ssh' then 'ssh target'
+                   else 'Unknown'
+                 end                                                              as target_type,
+                 coalesce(tt.name,               'None')                          as target_name,
+                 coalesce(tt.description,        'None')                          as target_description,
+                 coalesce(tt.default_port,       0)                               as target_default_port_number,
+                 tt.session_max_seconds                                           as target_session_max_seconds,
+                 tt.session_connection_limit                                      as target_session_connection_limit,
+                 p.public_id                                                      as project_id,
+                 coalesce(p.name,                'None')                          as project_name,
+                 coalesce(p.description,         'None')                          as project_description,
+                 o.public_id                                                      as organization_id,
+                 coalesce(o.name,                'None')                          as organization_name,
+                 coalesce(o.description,         'None')                          as organization_description
+            from session_credential_dynamic as scd
+            join session                 as s     on scd.session_id = s.public_id
+            join credential_library      as cl    on scd.library_id = cl.public_id
+            join credential_store        as cs    on cl.store_id    = cs.public_id
+            join target                  as t     on s.target_id    = t.public_id
+            join iam_scope               as p     on p.public_id    = t.project_id and p.type = 'project'
+            join iam_scope               as o     on p.parent_id    = o.public_id  and o.type = 'org'
+       left join vault_generic_library   as vcl   on cl.public_id   = vcl.public_id
+       left join vault_ssh_cert_library  as vsccl on cl.public_id   = vsccl.public_id
+       left join plugin_library          as pcl   on cl.public_id   = pcl.public_id
+       left join credential_vault_store  as vcs   on cs.public_id   = vcs.public_id
+       left join credential_plugin_store as pcs   on cs.public_id   = pcs.public_id
+       left join target_all_subtypes     as tt    on t.public_id    = tt.public_id
+    )
+    select session_id,
+           credential_purpose,
+           credential_library_id,
+           credential_library_type,
+           credential_library_name,
+           credential_library_description,
+           credential_library_vault_path,
+           credential_library_vault_http_method,
+           credential_library_vault_http_request_body,
+           credential_library_username,
+           credential_library_key_type_and_bits,
+           credential_store_id,
+           credential_store_type,
+           credential_store_name,
+           credential_store_description,
+           credential_store_vault_namespace,
+           credential_store_vault_address,
+           target_id,
+           target_type,
+           target_name,
+           target_description,
+           target_default_port_number,
+           target_session_max_seconds,
+           target_session_connection_limit,
+           project_id,
+           project_name,
+           project_description,
+           organization_id,
+           organization_name,
+           organization_description
+      from final;
+
+commit;
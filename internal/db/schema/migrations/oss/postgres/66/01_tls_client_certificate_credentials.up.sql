@@ -0,0 +1,308 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table credential_type_enm
+    drop constraint only_predefined_credential_types_allowed;
+
+  -- Add new constraint that only allows known types
+  -- This replaces the constraint defined in 63/01_credential_vault_ssh_cert_library.up.sql
+  alter table credential_type_enm
+    add constraint only_predefined_credential_types_allowed
+      check (
+        name in (
+          'unspecified',
+          'username_password',
+          'ssh_private_key',
+          'ssh_certificate',
+          'tls_client_certificate'
+        )
+      );
+
+  insert into credential_type_enm (name)
+   values ('tls_client_certificate');
+
+  create table credential_static_tls_client_certificate_credential (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_static_store_fkey
+        references credential_static_store (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+
+    certificate bytea not null
+      constraint certificate_must_not_be_empty
+        check(length(certificate) > 0),
+    private_key_encrypted bytea not null
+      constraint private_key_encrypted_must_not_be_empty
+        check(length(private_key_encrypted) > 0),
+    private_key_hmac bytea not null
+      constraint private_key_hmac_must_not_be_empty
+        check(length(private_key_hmac) > 0),
+    private_key_passphrase_encrypted bytea,
+    private_key_passphrase_hmac bytea,
+    ca_bundle bytea
+      constraint ca_bundle_must_not_be_empty
+        check(length(ca_bundle) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint private_key_passphrase_both_null_or_not_null
+      check(
+        (private_key_passphrase_encrypted is null and private_key_passphrase_hmac is null)
+        or
+        (private_key_passphrase_encrypted is not null and private_key_passphrase_hmac is not null)
+      ),
+    constraint credential_static_fkey
+      foreign key (project_id, store_id, public_id)
+        references credential_static (project_id, store_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint credential_static_tls_client_certificate_credential_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_static_tls_client_certificate_credential_store_id_public_id_uq
+      unique(store_id, public_id)
+  );
+  comment on table credential_static_tls_client_certificate_credential is
+    'credential_static_tls_client_certificate_credential is a table where each row is a resource that represents a static tls client certificate credential. '
+    'It is a credential_static subtype and an aggregate root.';
+
+  create trigger update_version_column after update on credential_static_tls_client_certificate_credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_static_tls_client_certificate_credential
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_static_tls_client_certificate_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_tls_client_certificate_credential
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'create_time');
+
+  create trigger insert_credential_static_subtype before insert on credential_static_tls_client_certificate_credential
+    for each row execute procedure insert_credential_static_subtype();
+
+  create trigger delete_credential_static_subtype after delete on credential_static_tls_client_certificate_credential
+    for each row execute procedure delete_credential_static_subtype();
+
+  insert into oplog_ticket (name, version)
+    values
+      ('credential_static_tls_client_certificate_credential', 1);
+
+  create table credential_vault_library_tls_client_certificate_mapping_override (
+    library_id wt_public_id primary key
+      constraint credential_vault_library_fkey
+        references credential_vault_library (public_id)
+        on delete cascade
+        on update cascade
+      constraint credential_vault_library_mapping_override_fkey
+        references credential_vault_library_mapping_override (library_id)
+        on delete cascade
+        on update cascade,
+    certificate_attribute wt_sentinel default wt_to_sentinel('no override') not null,
+    private_key_attribute wt_sentinel default wt_to_sentinel('no override') not null,
+    private_key_passphrase_attribute wt_sentinel default wt_to_sentinel('no override') not null,
+    ca_bundle_attribute wt_sentinel default wt_to_sentinel('no override') not null
+  );
+  comment on table credential_vault_library_tls_client_certificate_mapping_override is
+    'credential_vault_library_tls_client_certificate_mapping_override is a table '
+    'where each row represents a mapping that overrides the default mapping '
+    'from a generic vault secret to a tls client certificate credential type '
+    'for a vault credential library.';
+
+  create trigger insert_credential_vault_library_mapping_override_subtype before insert on credential_vault_library_tls_client_certificate_mapping_override
+    for each row execute procedure insert_credential_vault_library_mapping_override_subtype();
+
+  create trigger delete_credential_vault_library_mapping_override_subtype after delete on credential_vault_library_tls_client_certificate_mapping_override
+    for each row execute procedure delete_credential_vault_library_mapping_override_subtype();
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  drop view credential_vault_library_list_lookup;
+  create view credential_vault_library_list_lookup as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    ),
+    tls_client_certificate_override (library_id, certificate_attribute, private_key_attribute, private_key_passphrase_attribute, ca_bundle_attribute) as (
+      select library_id,
+        nullif(certificate_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override')),
+        nullif(ca_bundle_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_tls_client_certificate_mapping_override
+    )
+  select library.public_id         as public_id,
+         library.store_id          as store_id,
+         library.name              as name,
+         library.description       as description,
+         library.create_time       as create_time,
+         library.update_time       as update_time,
+         library.version           as version,
+         library.vault_path        as vault_path,
+         library.http_method       as http_method,
+         library.http_request_body as http_request_body,
+         library.credential_type   as credential_type,
+         coalesce(upasso.username_attribute,sshpk.username_attribute)
+                                   as username_attribute,
+         upasso.password_attribute as password_attribute,
+         coalesce(sshpk.private_key_attribute,tlscc.private_key_attribute)
+                                   as private_key_attribute,
+         coalesce(sshpk.private_key_passphrase_attribute,tlscc.private_key_passphrase_attribute)
+                                   as private_key_passphrase_attribute,
+         tlscc.certificate_attribute as certificate_attribute,
+         tlscc.ca_bundle_attribute   as ca_bundle_attribute
+    from credential_vault_library library
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id
+    left join tls_client_certificate_override tlscc
+      on library.public_id = tlscc.library_id;
+  comment on view credential_vault_library_list_lookup is
+    'credential_vault_library_list_lookup is a view where each row contains a credential library and any of library''s credential mapping overrides. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 63/02_add_ssh_cert_to_vault_cred_library_view.up.sql
+  drop view credential_vault_library_issue_credentials;
+  create view credential_vault_library_issue_credentials as
+  with
+    password_override (library_id, username_attribute, password_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(password_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_username_password_mapping_override
+    ),
+    ssh_private_key_override (library_id, username_attribute, private_key_attribute, private_key_passphrase_attribute) as (
+      select library_id,
+        nullif(username_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_ssh_private_key_mapping_override
+    ),
+    tls_client_certificate_override (library_id, certificate_attribute, private_key_attribute, private_key_passphrase_attribute, ca_bundle_attribute) as (
+      select library_id,
+        nullif(certificate_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_attribute, wt_to_sentinel('no override')),
+        nullif(private_key_passphrase_attribute, wt_to_sentinel('no override')),
+        nullif(ca_bundle_attribute, wt_to_sentinel('no override'))
+      from credential_vault_library_tls_client_certificate_mapping_override
+    )
+  select library.public_id    as public_id,
+    library.store_id          as store_id,
+    library.name              as name,
+    library.description       as description,
+    library.create_time       as create_time,
+    library.update_time       as update_time,
+    library.version           as version,
+    library.vault_path        as vault_path,
+    library.http_method       as http_method,
+    library.http_request_body as http_request_body,
+    library.credential_type   as credential_type,
+    null                      as key_type,
+    null                      as key_bits,
+    null                      as username,
+    null                      as ttl,
+    null                      as key_id,
+    null                      as critical_options,
+    null                      as extensions,
+    store.project_id          as project_id,
+    store.vault_address       as vault_address,
+    store.namespace           as namespace,
+    store.ca_cert             as ca_cert,
+    store.tls_server_name     as tls_server_name,
+    store.tls_skip_verify     as tls_skip_verify,
+    store.worker_filter       as worker_filter,
+    store.ct_token            as ct_token, -- encrypted
+    store.token_hmac          as token_hmac,
+    store.token_status        as token_status,
+    store.token_key_id        as token_key_id,
+    store.client_cert         as client_cert,
+    store.ct_client_key       as ct_client_key, -- encrypted
+    store.client_key_id       as client_key_id,
+    coalesce(upasso.username_attribute,sshpk.username_attribute)
+      as username_attribute,
+    upasso.password_attribute as password_attribute,
+    coalesce(sshpk.private_key_attribute,tlscc.private_key_attribute)
+      as private_key_attribute,
+    coalesce(sshpk.private_key_passphrase_attribute,tlscc.private_key_passphrase_attribute)
+      as private_key_passphrase_attribute,
+    tlscc.certificate_attribute as certificate_attribute,
+    tlscc.ca_bundle_attribute   as ca_bundle_attribute,
+    'generic'                   as cred_lib_type -- used to switch on
+    from credential_vault_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id
+    left join password_override upasso
+      on library.public_id = upasso.library_id
+    left join ssh_private_key_override sshpk
+      on library.public_id = sshpk.library_id
+    left join tls_client_certificate_override tlscc
+      on library.public_id = tlscc.library_id
+  union
+  select library.public_id   as public_id,
+    library.store_id         as store_id,
+    library.name             as name,
+    library.description      as description,
+    library.create_time      as create_time,
+    library.update_time      as update_time,
+    library.version          as version,
+    library.vault_path       as vault_path,
+    null                     as http_method,
+    null                     as http_request_body,
+    library.credential_type  as credential_type,
+    library.key_type         as key_type,
+    library.key_bits         as key_bits,
+    library.username         as username,
+    library.ttl              as ttl,
+    library.key_id           as key_id,
+    library.critical_options as critical_options,
+    library.extensions       as extensions,
+    store.project_id         as project_id,
+    store.vault_address      as vault_address,
+    store.namespace          as namespace,
+    store.ca_cert            as ca_cert,
+    store.tls_server_name    as tls_server_name,
+    store.tls_skip_verify    as tls_skip_verify,
+    store.worker_filter      as worker_filter,
+    store.ct_token           as ct_token, -- encrypted
+    store.token_hmac         as token_hmac,
+    store.token_status       as token_status,
+    store.token_key_id       as token_key_id,
+    store.client_cert        as client_cert,
+    store.ct_client_key      as ct_client_key, -- encrypted
+    store.client_key_id      as client_key_id,
+    null                     as username_attribute,
+    null                     as password_attribute,
+    null                     as private_key_attribute,
+    null                     as private_key_passphrase_attribute,
+    null                     as certificate_attribute,
+    null                     as ca_bundle_attribute,
+    'ssh-signed-cert'        as cred_lib_type -- used to switch on
+    from credential_vault_ssh_cert_library library
+    join credential_vault_store_client store
+      on library.store_id = store.public_id;
+  comment on view credential_vault_library_issue_credentials is
+    'credential_vault_library_issue_credentials is a view where each row contains a credential library and the credential library''s data needed to connect to Vault. '
+    'This view should only be used when issuing credentials from a Vault credential library. Each row may contain encrypted data. '
+    'This view should not be used to retrieve data which will be returned external to boundary.';
+
+commit;
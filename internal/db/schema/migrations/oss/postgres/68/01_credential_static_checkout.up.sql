@@ -0,0 +1,182 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- max_concurrent_checkouts is set on each credential_static subtype and
+  -- copied to credential_static, where it is used to enforce the limit for
+  -- all subtypes. A null value means the credential may be checked out by any
+  -- number of sessions.
+  alter table credential_static
+    add column max_concurrent_checkouts integer
+      constraint max_concurrent_checkouts_must_be_positive
+        check(max_concurrent_checkouts > 0);
+
+  alter table credential_static_username_password_credential
+    add column max_concurrent_checkouts integer
+      constraint max_concurrent_checkouts_must_be_positive
+        check(max_concurrent_checkouts > 0);
+
+  alter table credential_static_ssh_private_key_credential
+    add column max_concurrent_checkouts integer
+      constraint max_concurrent_checkouts_must_be_positive
+        check(max_concurrent_checkouts > 0);
+
+  alter table credential_static_json_credential
+    add column max_concurrent_checkouts integer
+      constraint max_concurrent_checkouts_must_be_positive
+        check(max_concurrent_checkouts > 0);
+
+  alter table credential_static_tls_client_certificate_credential
+    add column max_concurrent_checkouts integer
+      constraint max_concurrent_checkouts_must_be_positive
+        check(max_concurrent_checkouts > 0);
+
+  -- insert_credential_static_subtype() is a before insert trigger
+  -- function for subtypes of credential_static
+  -- Replaces the insert_credential_static_subtype function defined in 46/01_credentials.up.sql
+  create or replace function insert_credential_static_subtype() returns trigger
+  as $$
+  begin
+
+    select project_id into new.project_id
+      from credential_store
+     where credential_store.public_id = new.store_id;
+
+    insert into credential_static
+      (public_id, store_id, project_id, max_concurrent_checkouts)
+    values
+      (new.public_id, new.store_id, new.project_id, new.max_concurrent_checkouts);
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- update_credential_static_max_concurrent_checkouts() is an after update
+  -- trigger function for subtypes of credential_static which copies the
+  -- subtype's max_concurrent_checkouts to credential_static.
+  create function update_credential_static_max_concurrent_checkouts() returns trigger
+  as $$
+  begin
+    update credential_static
+       set max_concurrent_checkouts = new.max_concurrent_checkouts
+     where public_id = new.public_id;
+    return null; -- result is ignored since this is an after trigger
+  end;
+  $$ language plpgsql;
+
+  create trigger update_credential_static_max_concurrent_checkouts after update of max_concurrent_checkouts on credential_static_username_password_credential
+    for each row execute function update_credential_static_max_concurrent_checkouts();
+  create trigger update_credential_static_max_concurrent_checkouts after update of max_concurrent_checkouts on credential_static_ssh_private_key_credential
+    for each row execute function update_credential_static_max_concurrent_checkouts();
+  create trigger update_credential_static_max_concurrent_checkouts after update of max_concurrent_checkouts on credential_static_json_credential
+    for each row execute function update_credential_static_max_concurrent_checkouts();
+  create trigger update_credential_static_max_concurrent_checkouts after update of max_concurrent_checkouts on credential_static_tls_client_certificate_credential
+    for each row execute function update_credential_static_max_concurrent_checkouts();
+
+  create table credential_static_checkout (
+    credential_static_id wt_public_id not null
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    session_id wt_public_id not null
+      constraint session_fkey
+        references session (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    primary key(credential_static_id, session_id)
+  );
+  comment on table credential_static_checkout is
+    'credential_static_checkout is a table where each row is a check-out of a static credential held by a session. '
+    'Rows are inserted when a session using the credential is created and deleted when the session is terminated.';
+
+  create index credential_static_checkout_session_id_ix
+    on credential_static_checkout (session_id);
+
+  create trigger default_create_time_column before insert on credential_static_checkout
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_checkout
+    for each row execute procedure immutable_columns('credential_static_id', 'session_id', 'create_time');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_static_checkout', 1);
+
+  -- checkout_credential_static() is an after insert trigger function for
+  -- session_credential_static which checks out the static credential for the
+  -- session. The credential_static row is locked so that concurrent
+  -- check-outs of the same credential are serialized. An exception is raised
+  -- if the credential's max_concurrent_checkouts are all held by other
+  -- sessions. The exception message must match
+  -- globals.CredentialCheckoutsExhaustedErrStr.
+  create function checkout_credential_static() returns trigger
+  as $$
+  declare
+    checkout_limit integer;
+    checkout_count integer;
+  begin
+    select max_concurrent_checkouts into checkout_limit
+      from credential_static
+     where public_id = new.credential_static_id
+       for update;
+
+    -- a session may use the same credential for more than one purpose
+    perform
+       from credential_static_checkout
+      where credential_static_id = new.credential_static_id
+        and session_id = new.session_id;
+    if found then
+      return null;
+    end if;
+
+    if checkout_limit is not null then
+      select count(*) into checkout_count
+        from credential_static_checkout
+       where credential_static_id = new.credential_static_id;
+      if checkout_count >= checkout_limit then
+        raise exception 'no available credential check-outs: %', new.credential_static_id;
+      end if;
+    end if;
+
+    insert into credential_static_checkout
+      (credential_static_id, session_id)
+    values
+      (new.credential_static_id, new.session_id);
+    return null; -- result is ignored since this is an after trigger
+  end;
+  $$ language plpgsql;
+
+  create trigger checkout_credential_static after insert on session_credential_static
+    for each row execute function checkout_credential_static();
+
+  -- checkin_credential_static() checks in all static credentials held by a
+  -- session when the session enters the terminated state.
+  create function checkin_credential_static() returns trigger
+  as $$
+  begin
+    if new.state = 'terminated' then
+      delete from credential_static_checkout
+       where session_id = new.session_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger checkin_credential_static after insert on session_state
+    for each row execute procedure checkin_credential_static();
+
+  -- Check out the static credentials of existing sessions which have not
+  -- been terminated.
+  insert into credential_static_checkout
+    (credential_static_id, session_id)
+  select distinct scs.credential_static_id, scs.session_id
+    from session_credential_static scs
+    join session_state ss
+      on ss.session_id = scs.session_id
+     and ss.end_time is null
+   where scs.credential_static_id is not null
+     and ss.state != 'terminated';
+
+commit;
@@ -0,0 +1,199 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table credential_static_rotator_enm (
+    name text primary key
+      constraint only_predefined_rotators_allowed
+        check (
+          name in (
+            'postgres',
+            'ssh',
+            'ldap'
+          )
+        )
+  );
+  comment on table credential_static_rotator_enm is
+    'credential_static_rotator_enm is an enumeration table for the rotators used to change the password of a static credential. '
+    'It contains rows for representing the postgres, ssh and ldap rotators.';
+
+  insert into credential_static_rotator_enm (name)
+  values
+    ('postgres'),
+    ('ssh'),
+    ('ldap');
+
+  create table credential_static_rotation_policy (
+    credential_id wt_public_id primary key,
+    store_id wt_public_id not null,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    rotator text not null
+      constraint credential_static_rotator_enm_fkey
+        references credential_static_rotator_enm (name)
+        on delete restrict
+        on update cascade,
+    address text not null
+      constraint address_must_not_be_empty
+        check(length(trim(address)) > 0),
+    admin_credential_id wt_public_id null,
+    -- host_key is the public key, in authorized_keys format, the ssh rotator
+    -- pins the server's host key to.
+    host_key text null
+      constraint host_key_must_not_be_empty
+        check(length(trim(host_key)) > 0),
+    rotate_after_session boolean not null default false,
+    rotation_interval_hours integer null
+      constraint rotation_interval_hours_must_be_positive
+        check(rotation_interval_hours > 0),
+    rotation_pending boolean not null default false,
+    last_rotation_time timestamp with time zone null,
+    last_failure_time timestamp with time zone null,
+    constraint rotation_schedule_required
+      check(rotate_after_session or rotation_interval_hours is not null),
+    constraint ssh_host_key_required
+      check(rotator != 'ssh' or host_key is not null),
+    constraint credential_static_username_password_credential_fkey
+      foreign key (store_id, credential_id)
+      references credential_static_username_password_credential (store_id, public_id)
+      on delete cascade
+      on update cascade,
+    -- The admin credential must belong to the same store. Deleting it is
+    -- restricted so that a policy never silently falls back to
+    -- authenticating with the credential being rotated.
+    constraint credential_static_username_password_credential_admin_fkey
+      foreign key (store_id, admin_credential_id)
+      references credential_static_username_password_credential (store_id, public_id)
+      on delete restrict
+      on update cascade
+  );
+  comment on table credential_static_rotation_policy is
+    'credential_static_rotation_policy is a table where each row is the policy for rotating the password of a static username password credential.';
+
+  create trigger update_time_column before update on credential_static_rotation_policy
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_static_rotation_policy
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_rotation_policy
+    for each row execute procedure immutable_columns('credential_id', 'store_id', 'create_time');
+
+  create table credential_static_rotation_pending (
+    credential_id wt_public_id primary key
+      constraint credential_static_rotation_policy_fkey
+        references credential_static_rotation_policy (credential_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    password_encrypted bytea not null
+      constraint password_encrypted_must_not_be_empty
+        check(length(password_encrypted) > 0),
+    key_id text not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table credential_static_rotation_pending is
+    'credential_static_rotation_pending is a table where each row is a password generated by the rotation job which has not yet been committed to the credential. '
+    'It is stored before the password is changed on the target system so that it is not lost if the rotation is interrupted.';
+
+  create trigger default_create_time_column before insert on credential_static_rotation_pending
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_rotation_pending
+    for each row execute procedure immutable_columns('credential_id', 'create_time');
+
+  create table credential_static_rotation_trigger_enm (
+    name text primary key
+      constraint only_predefined_rotation_triggers_allowed
+        check (
+          name in (
+            'session',
+            'interval'
+          )
+        )
+  );
+  comment on table credential_static_rotation_trigger_enm is
+    'credential_static_rotation_trigger_enm is an enumeration table for the causes of a static credential rotation.';
+
+  insert into credential_static_rotation_trigger_enm (name)
+  values
+    ('session'),
+    ('interval');
+
+  create table credential_static_rotation_status_enm (
+    name text primary key
+      constraint only_predefined_rotation_statuses_allowed
+        check (
+          name in (
+            'succeeded',
+            'failed'
+          )
+        )
+  );
+  comment on table credential_static_rotation_status_enm is
+    'credential_static_rotation_status_enm is an enumeration table for the outcomes of a static credential rotation.';
+
+  insert into credential_static_rotation_status_enm (name)
+  values
+    ('succeeded'),
+    ('failed');
+
+  create table credential_static_rotation (
+    private_id wt_private_id primary key,
+    credential_id wt_public_id not null
+      constraint credential_static_username_password_credential_fkey
+        references credential_static_username_password_credential (public_id)
+        on delete cascade
+        on update cascade,
+    trigger text not null
+      constraint credential_static_rotation_trigger_enm_fkey
+        references credential_static_rotation_trigger_enm (name)
+        on delete restrict
+        on update cascade,
+    status text not null
+      constraint credential_static_rotation_status_enm_fkey
+        references credential_static_rotation_status_enm (name)
+        on delete restrict
+        on update cascade,
+    error text null,
+    start_time timestamp with time zone not null,
+    end_time wt_timestamp
+  );
+  comment on table credential_static_rotation is
+    'credential_static_rotation is a table where each row is an attempt to rotate the password of a static username password credential.';
+
+  create index credential_static_rotation_credential_id_start_time_ix
+    on credential_static_rotation (credential_id, start_time desc);
+
+  create trigger immutable_columns before update on credential_static_rotation
+    for each row execute procedure immutable_columns('private_id', 'credential_id', 'trigger', 'status', 'error', 'start_time', 'end_time');
+
+  -- mark_credential_static_rotation_pending() is an after delete trigger
+  -- function for credential_static_checkout. When a session checks a
+  -- credential back in, a rotation is marked pending if the credential's
+  -- policy rotates it after sessions. The rotation job waits until no other
+  -- session holds the credential before rotating it.
+  create function mark_credential_static_rotation_pending() returns trigger
+  as $$
+  begin
+    update credential_static_rotation_policy
+       set rotation_pending = true
+     where credential_id = old.credential_static_id
+       and rotate_after_session
+       and not rotation_pending;
+    return null; -- result is ignored since this is an after trigger
+  end;
+  $$ language plpgsql;
+
+  create trigger mark_credential_static_rotation_pending after delete on credential_static_checkout
+    for each row execute function mark_credential_static_rotation_pending();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_static_rotation_policy', 1);
+
+commit;
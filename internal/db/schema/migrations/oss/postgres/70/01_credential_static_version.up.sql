@@ -0,0 +1,73 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table credential_static_version (
+    credential_id wt_public_id not null
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    version wt_version,
+    create_time wt_timestamp,
+    data_encrypted bytea not null
+      constraint data_encrypted_must_not_be_empty
+        check(length(data_encrypted) > 0),
+    key_id text not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    primary key(credential_id, version)
+  );
+  comment on table credential_static_version is
+    'credential_static_version is a table where each row is a version of a static credential. '
+    'A version is recorded each time a static credential is created or updated. '
+    'The state of the credential, including its secret, is stored encrypted in data_encrypted.';
+
+  create trigger default_create_time_column before insert on credential_static_version
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_version
+    for each row execute procedure immutable_columns('credential_id', 'version', 'create_time');
+
+  -- credential_version is the version of the static credential at the time it
+  -- was brokered to the session. It is null for sessions created before
+  -- versions were recorded.
+  alter table session_credential_static
+    add column credential_version integer;
+
+  drop trigger immutable_columns on session_credential_static;
+  create trigger immutable_columns before update on session_credential_static
+    for each row execute procedure immutable_columns('session_id', 'credential_static_id', 'credential_purpose', 'credential_version', 'create_time');
+
+  -- set_session_credential_static_version() is a before insert trigger
+  -- function for session_credential_static which records the current
+  -- version of the static credential.
+  create function set_session_credential_static_version() returns trigger
+  as $$
+  begin
+    select c.version into new.credential_version
+      from (
+        select public_id, version
+          from credential_static_username_password_credential
+        union all
+        select public_id, version
+          from credential_static_ssh_private_key_credential
+        union all
+        select public_id, version
+          from credential_static_json_credential
+        union all
+        select public_id, version
+          from credential_static_tls_client_certificate_credential
+      ) c
+     where c.public_id = new.credential_static_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger set_session_credential_static_version before insert on session_credential_static
+    for each row execute function set_session_credential_static_version();
+
+commit;
@@ -0,0 +1,445 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+/*
+                            ┌───────────────────────────┐
+    ┌──────────────────┐    │   credential_aws_store    │
+    │ credential_store │    ├───────────────────────────┤
+    ├──────────────────┤    │public_id (pk)             │
+    │public_id         │┼┼○┼│project_id (fk)            │
+    │project_id        │    │region                     │
+    └──────────────────┘    │sts_endpoint               │
+                            │signin_endpoint            │
+                            │access_key_id              │
+                            │secret_access_key          │
+                            │secret_access_key_hmac     │
+                            │key_id (fk)                │
+                            └───────────────────────────┘
+                                          ┼
+                                          ┼
+                                          ○
+                                         ╱│╲
+    ┌──────────────────┐    ┌───────────────────────────┐      ┌───────────────────────────┐
+    │credential_library│    │  credential_aws_library   │      │ credential_aws_credential │
+    ├──────────────────┤    ├───────────────────────────┤      ├───────────────────────────┤
+    │public_id         │┼┼○┼│public_id (pk)             │┼○──○┼│public_id (pk)             │
+    │store_id          │    │store_id (fk)              │      │library_id (fk)            │
+    └──────────────────┘    │role_arn                   │      │session_id (fk)            │
+                            │session_name               │      │expiration_time            │
+                            │session_policy             │      └───────────────────────────┘
+                            │duration_seconds           │
+                            │federated_signin           │
+                            └───────────────────────────┘
+*/
+
+  -- drop constraint so we can add aws_credential
+  alter table credential_type_enm
+    drop constraint only_predefined_credential_types_allowed;
+
+  -- Add new constraint that only allows known types
+  -- This replaces the constraint defined in 71/01_credential_kubernetes.up.sql
+  alter table credential_type_enm
+    add constraint only_predefined_credential_types_allowed
+      check (
+        name in (
+          'unspecified',
+          'username_password',
+          'ssh_private_key',
+          'ssh_certificate',
+          'tls_client_certificate',
+          'json',
+          'aws_credential'
+        )
+      );
+
+  insert into credential_type_enm (name)
+   values ('aws_credential');
+
+  create table credential_aws_store (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null
+      constraint iam_scope_project_fkey
+        references iam_scope_project (scope_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    region text not null
+      constraint region_must_not_be_empty
+        check(length(trim(region)) > 0),
+    sts_endpoint text
+      constraint sts_endpoint_must_not_be_empty
+        check(length(trim(sts_endpoint)) > 0),
+    signin_endpoint text
+      constraint signin_endpoint_must_not_be_empty
+        check(length(trim(signin_endpoint)) > 0),
+    access_key_id text not null
+      constraint access_key_id_must_not_be_empty
+        check(length(trim(access_key_id)) > 0),
+    secret_access_key bytea not null -- encrypted value
+      constraint secret_access_key_must_not_be_empty
+        check(length(secret_access_key) > 0),
+    secret_access_key_hmac bytea not null
+      constraint secret_access_key_hmac_must_not_be_empty
+        check(length(secret_access_key_hmac) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint credential_store_fkey
+      foreign key (project_id, public_id)
+        references credential_store (project_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint credential_aws_store_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table credential_aws_store is
+    'credential_aws_store is a table where each row is a resource that represents an aws credential store. '
+    'It is a credential_store subtype.';
+
+  create trigger update_version_column after update on credential_aws_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_aws_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_aws_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_aws_store
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_aws_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_aws_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  create table credential_aws_library (
+    public_id wt_public_id primary key,
+    store_id wt_public_id not null
+      constraint credential_aws_store_fkey
+        references credential_aws_store (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    role_arn text not null
+      constraint role_arn_must_be_an_iam_role_arn
+        check(role_arn ~ '^arn:[a-z-]+:iam::[0-9]{12}:role/.+$'),
+    session_name text
+      constraint session_name_must_not_be_empty
+        check(length(trim(session_name)) > 0),
+    session_policy text
+      constraint session_policy_must_not_be_empty
+        check(length(trim(session_policy)) > 0),
+    duration_seconds integer
+      constraint duration_seconds_must_be_between_900_and_43200
+        check(duration_seconds between 900 and 43200),
+    federated_signin boolean not null default false,
+    credential_type text not null
+      constraint credential_type_enm_fkey
+        references credential_type_enm (name)
+        on delete restrict
+        on update cascade,
+    constraint credential_library_fkey
+      foreign key (project_id, store_id, public_id, credential_type)
+        references credential_library (project_id, store_id, public_id, credential_type)
+        on delete cascade
+        on update cascade,
+    constraint credential_aws_library_store_id_name_uq
+      unique(store_id, name),
+    constraint credential_aws_library_store_id_public_id_uq
+      unique(store_id, public_id)
+  );
+  comment on table credential_aws_library is
+    'credential_aws_library is a table where each row is a resource that represents an aws credential library. '
+    'It is a credential_library subtype and a child table of credential_aws_store.';
+
+  create function default_aws_credential_type() returns trigger
+  as $$
+  begin
+    if new.credential_type is distinct from 'aws_credential' then
+      raise warning 'credential_aws_library only supports aws_credential credentials';
+      new.credential_type = 'aws_credential';
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function default_aws_credential_type is
+    'default_aws_credential_type ensures the credential_type is set to aws_credential';
+
+  create trigger default_aws_credential_type before insert on credential_aws_library
+    for each row execute procedure default_aws_credential_type();
+
+  create trigger update_version_column after update on credential_aws_library
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_aws_library
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_aws_library
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_aws_library
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'project_id', 'credential_type', 'create_time');
+
+  create trigger insert_credential_library_subtype before insert on credential_aws_library
+    for each row execute procedure insert_credential_library_subtype();
+
+  create trigger delete_credential_library_subtype after delete on credential_aws_library
+    for each row execute procedure delete_credential_library_subtype();
+
+  create table credential_aws_credential (
+    public_id wt_public_id primary key,
+    library_id wt_public_id
+      constraint credential_aws_library_fkey
+        references credential_aws_library (public_id)
+        on delete set null
+        on update cascade,
+    session_id wt_public_id
+      constraint session_fkey
+        references session (public_id)
+        on delete set null
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    access_key_id text not null
+      constraint access_key_id_must_not_be_empty
+        check(length(trim(access_key_id)) > 0),
+    expiration_time timestamp with time zone not null,
+    constraint credential_dynamic_fkey
+      foreign key (library_id, public_id)
+      references credential_dynamic (library_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_aws_credential_library_id_public_id_uq
+      unique(library_id, public_id)
+  );
+  comment on table credential_aws_credential is
+    'credential_aws_credential is a table where each row contains the information for a single set of temporary security credentials '
+    'issued by an aws credential library for a session. Only the access key id is stored, the secret access key and session token are not.';
+
+  create trigger update_version_column after update on credential_aws_credential
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_aws_credential
+    for each row execute procedure update_time_column();
+
+  create trigger not_null_columns before insert on credential_aws_credential
+    for each row execute procedure not_null_columns('library_id', 'session_id');
+
+  create trigger default_create_time_column before insert on credential_aws_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_aws_credential
+    for each row execute procedure immutable_columns('access_key_id', 'expiration_time', 'create_time');
+
+  create trigger insert_credential_dynamic_subtype before insert on credential_aws_credential
+    for each row execute procedure insert_credential_dynamic_subtype();
+
+  create trigger delete_credential_dynamic_subtype after delete on credential_aws_credential
+    for each row execute procedure delete_credential_dynamic_subtype();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_aws_store', 1),
+    ('credential_aws_library', 1);
+
+  -- The whx_credential_dimension_source view shows the current values in the
+  -- operational tables of the credential dimension.
+  -- Replaces whx_credential_dimension_source defined in oss/71/01_credential_kubernetes.up.sql
+  drop view whx_credential_dimension_source;
+  create view whx_credential_dimension_source as
+    with vault_generic_library as (
+      select vcl.public_id                                        as public_id,
+             'vault generic credential library'                   as type,
+             coalesce(vcl.name,        'None')                    as name,
+             coalesce(vcl.description, 'None')                    as description,
+             vcl.vault_path                                       as vault_path,
+             vcl.http_method                                      as http_method,
+             case
+               when vcl.http_method = 'GET' then 'Not Applicable'
+               else coalesce(vcl.http_request_body::text, 'None')
+             end                                                  as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_vault_library as vcl
+    ),
+    vault_ssh_cert_library as (
+      select vsccl.public_id                                      as public_id,
+             'vault ssh certificate credential library'           as type,
+             coalesce(vsccl.name,        'None')                  as name,
+             coalesce(vsccl.description, 'None')                  as description,
+             vsccl.vault_path                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             vsccl.username                                       as username,
+             case
+               when vsccl.key_type = 'ed25519' then vsccl.key_type
+               else vsccl.key_type || '-' || vsccl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_vault_ssh_cert_library as vsccl
+    ),
+    vault_pki_library as (
+      select vpl.public_id                                        as public_id,
+             'vault pki credential library'                       as type,
+             coalesce(vpl.name,        'None')                    as name,
+             coalesce(vpl.description, 'None')                    as description,
+             vpl.vault_path                                       as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             'Not Applicable'                                     as username,
+             case
+               when vpl.key_type = 'ed25519' then vpl.key_type
+               else vpl.key_type || '-' || vpl.key_bits::text
+             end                                                  as key_type_and_bits
+        from credential_vault_pki_library as vpl
+    ),
+    plugin_library as (
+      select pcl.public_id                                        as public_id,
+             'plugin credential library'                          as type,
+             coalesce(pcl.name,        'None')                    as name,
+             coalesce(pcl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             'Not Applicable'                                     as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_plugin_library as pcl
+    ),
+    kubernetes_library as (
+      select kcl.public_id                                        as public_id,
+             'kubernetes credential library'                      as type,
+             coalesce(kcl.name,        'None')                    as name,
+             coalesce(kcl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             kcl.namespace || '/' || kcl.service_account          as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_kubernetes_library as kcl
+    ),
+    aws_library as (
+      select acl.public_id                                        as public_id,
+             'aws credential library'                             as type,
+             coalesce(acl.name,        'None')                    as name,
+             coalesce(acl.description, 'None')                    as description,
+             'Not Applicable'                                     as vault_path,
+             'Not Applicable'                                     as http_method,
+             'Not Applicable'                                     as http_request_body,
+             acl.role_arn                                         as username,
+             'Not Applicable'                                     as key_type_and_bits
+        from credential_aws_library as acl
+    ),
+    final as (
+          select s.public_id                                                      as session_id,
+                 scd.credential_purpose                                           as credential_purpose,
+                 cl.public_id                                                     as credential_library_id,
+                 coalesce(vcl.type,              vsccl.type,              vpl.type,              pcl.type,              kcl.type,              acl.type)              as credential_library_type,
+                 coalesce(vcl.name,              vsccl.name,              vpl.name,              pcl.name,              kcl.name,              acl.name)              as credential_library_name,
+                 coalesce(vcl.description,       vsccl.description,       vpl.description,       pcl.description,       kcl.description,       acl.description)       as credential_library_description,
+                 coalesce(vcl.vault_path,        vsccl.vault_path,        vpl.vault_path,        pcl.vault_path,        kcl.vault_path,        acl.vault_path)        as credential_library_vault_path,
+                 coalesce(vcl.http_method,       vsccl.http_method,       vpl.http_method,       pcl.http_method,       kcl.http_method,       acl.http_method)       as credential_library_vault_http_method,
+                 coalesce(vcl.http_request_body, vsccl.http_request_body, vpl.http_request_body, pcl.http_request_body, kcl.http_request_body, acl.http_request_body) as credential_library_vault_http_request_body,
+                 coalesce(vcl.username,          vsccl.username,          vpl.username,          pcl.username,          kcl.username,          acl.username)          as credential_library_username,
+                 coalesce(vcl.key_type_and_bits, vsccl.key_type_and_bits, vpl.key_type_and_bits, pcl.key_type_and_bits, kcl.key_type_and_bits, acl.key_type_and_bits) as credential_library_key_type_and_bits,
+                 cs.public_id                                                     as credential_store_id,
+                 case
+                   when vcs is not null then 'vault credential store'
+                   when pcs is not null then 'plugin credential store'
+                   when kcs is not null then 'kubernetes credential store'
+                   when acs is not null then 'aws credential store'
+                   else 'None'
+                 end                                                              as credential_store_type,
+                 coalesce(vcs.name,              pcs.name,        kcs.name,        acs.name,        'None') as credential_store_name,
+                 coalesce(vcs.description,       pcs.description, kcs.description, acs.description, 'None') as credential_store_description,
+                 case
+                   when pcs is not null or kcs is not null or acs is not null then 'Not Applicable'
+                   else coalesce(vcs.namespace, 'None')
+                 end                                                              as credential_store_vault_namespace,
+                 case
+                   when pcs is not null or kcs is not null or acs is not null then 'Not Applicable'
+                   else coalesce(vcs.vault_address, 'None')
+                 end                                                              as credential_store_vault_address,
+                 t.public_id                                                      as target_id,
+                 case
+                   when tt.type = 'tcp' then 'tcp target'
+                   when tt.type = 'ssh' then 'ssh target'
+                   else 'Unknown'
+                 end                                                              as target_type,
+                 coalesce(tt.name,               'None')                          as target_name,
+                 coalesce(tt.description,        'None')                          as target_description,
+                 coalesce(tt.default_port,       0)                               as target_default_port_number,
+                 tt.session_max_seconds                                           as target_session_max_seconds,
+                 tt.session_connection_limit                                      as target_session_connection_limit,
+                 p.public_id                                                      as project_id,
+                 coalesce(p.name,                'None')                          as project_name,
+                 coalesce(p.description,         'None')                          as project_description,
+                 o.public_id                                                      as organization_id,
+                 coalesce(o.name,                'None')                          as organization_name,
+                 coalesce(o.description,         'None')                          as organization_description
+            from session_credential_dynamic as scd
+            join session                     as s     on scd.session_id = s.public_id
+            join credential_library          as cl    on scd.library_id = cl.public_id
+            join credential_store            as cs    on cl.store_id    = cs.public_id
+            join target                      as t     on s.target_id    = t.public_id
+            join iam_scope                   as p     on p.public_id    = t.project_id and p.type = 'project'
+            join iam_scope                   as o     on p.parent_id    = o.public_id  and o.type = 'org'
+       left join vault_generic_library       as vcl   on cl.public_id   = vcl.public_id
+       left join vault_ssh_cert_library      as vsccl on cl.public_id   = vsccl.public_id
+       left join vault_pki_library           as vpl   on cl.public_id   = vpl.public_id
+       left join plugin_library              as pcl   on cl.public_id   = pcl.public_id
+       left join kubernetes_library          as kcl   on cl.public_id   = kcl.public_id
+       left join aws_library                 as acl   on cl.public_id   = acl.public_id
+       left join credential_vault_store      as vcs   on cs.public_id   = vcs.public_id
+       left join credential_plugin_store     as pcs   on cs.public_id   = pcs.public_id
+       left join credential_kubernetes_store as kcs   on cs.public_id   = kcs.public_id
+       left join credential_aws_store        as acs   on cs.public_id   = acs.public_id
+       left join target_all_subtypes         as tt    on t.public_id    = tt.public_id
+    )
+    select session_id,
+           credential_purpose,
+           credential_library_id,
+           credential_library_type,
+           credential_library_name,
+           credential_library_description,
+           credential_library_vault_path,
+           credential_library_vault_http_method,
+           credential_library_vault_http_request_body,
+           credential_library_username,
+           credential_library_key_type_and_bits,
+           credential_store_id,
+           credential_store_type,
+           credential_store_name,
+           credential_store_description,
+           credential_store_vault_namespace,
+           credential_store_vault_address,
+           target_id,
+           target_type,
+           target_name,
+           target_description,
+           target_default_port_number,
+           target_session_max_seconds,
+           target_session_connection_limit,
+           project_id,
+           project_name,
+           project_description,
+           organization_id,
+           organization_name,
+           organization_description
+      from final;
+
+commit;
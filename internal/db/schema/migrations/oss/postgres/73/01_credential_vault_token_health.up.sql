@@ -0,0 +1,80 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table credential_vault_token_health_status_enm (
+    name text primary key
+      constraint only_predefined_token_health_statuses_allowed
+      check (
+        name in (
+          'healthy',
+          'warning',
+          'error'
+        )
+      )
+  );
+  comment on table credential_vault_token_health_status_enm is
+    'credential_vault_token_health_status_enm is an enumeration table for the health status of vault tokens. '
+    'It contains rows for representing the healthy, warning, and error states.';
+
+  insert into credential_vault_token_health_status_enm (name)
+  values
+    ('healthy'),
+    ('warning'),
+    ('error');
+
+  alter table credential_vault_token
+    add column expiration_capped boolean not null default false,
+    add column health_status text
+      constraint credential_vault_token_health_status_enm_fkey
+        references credential_vault_token_health_status_enm (name)
+        on delete restrict
+        on update cascade,
+    add column health_check_time timestamp with time zone,
+    add column missing_capabilities text;
+  comment on column credential_vault_token.expiration_capped is
+    'expiration_capped is set to true when the last renewal of the token in Vault returned a shorter ttl than the renewal before it. '
+    'A capped token has reached its maximum ttl in Vault and will expire at expiration_time no matter how often it is renewed.';
+  comment on column credential_vault_token.missing_capabilities is
+    'missing_capabilities contains the required Vault capabilities the token was missing at health_check_time, one path per line.';
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  drop view credential_vault_store_list_lookup;
+  create view credential_vault_store_list_lookup as
+  select store.public_id                   as public_id,
+         store.project_id                  as project_id,
+         store.name                        as name,
+         store.description                 as description,
+         store.create_time                 as create_time,
+         store.update_time                 as update_time,
+         store.delete_time                 as delete_time,
+         store.version                     as version,
+         store.vault_address               as vault_address,
+         store.namespace                   as namespace,
+         store.ca_cert                     as ca_cert,
+         store.tls_server_name             as tls_server_name,
+         store.tls_skip_verify             as tls_skip_verify,
+         store.worker_filter               as worker_filter,
+         token.token_hmac                  as token_hmac,
+         coalesce(token.status, 'expired') as token_status,
+         token.last_renewal_time           as token_last_renewal_time,
+         token.expiration_time             as token_expiration_time,
+         token.health_status               as token_health_status,
+         token.health_check_time           as token_health_check_time,
+         token.missing_capabilities        as token_missing_capabilities,
+         cert.certificate                  as client_cert,
+         cert.certificate_key_hmac         as client_cert_key_hmac
+    from credential_vault_store store
+    left join credential_vault_token token
+      on store.public_id = token.store_id
+     and token.status = 'current'
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+   where store.delete_time is null;
+  comment on view credential_vault_store_list_lookup is
+    'credential_vault_store_list_lookup is a view where each row contains a credential store. '
+    'If the Vault token has expired this view will return an empty token_hmac and a token_status of ''expired'' '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+commit;
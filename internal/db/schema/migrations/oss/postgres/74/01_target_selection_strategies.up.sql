@@ -0,0 +1,146 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table target_host_selection_strategy_enm (
+    name text primary key
+      constraint only_predefined_host_selection_strategies_allowed
+      check (
+        name in (
+          'random',
+          'round-robin',
+          'least-active-sessions',
+          'sticky-per-user'
+        )
+      )
+  );
+  comment on table target_host_selection_strategy_enm is
+    'target_host_selection_strategy_enm is an enumeration table for the strategies used to choose the host of a new session. '
+    'It contains rows for representing the random, round-robin, least-active-sessions, and sticky-per-user strategies.';
+
+  insert into target_host_selection_strategy_enm (name)
+  values
+    ('random'),
+    ('round-robin'),
+    ('least-active-sessions'),
+    ('sticky-per-user');
+
+  create table target_worker_selection_strategy_enm (
+    name text primary key
+      constraint only_predefined_worker_selection_strategies_allowed
+      check (
+        name in (
+          'random',
+          'least-connections',
+          'tag-affinity'
+        )
+      )
+  );
+  comment on table target_worker_selection_strategy_enm is
+    'target_worker_selection_strategy_enm is an enumeration table for the strategies used to order the workers of a new session. '
+    'It contains rows for representing the random, least-connections, and tag-affinity strategies.';
+
+  insert into target_worker_selection_strategy_enm (name)
+  values
+    ('random'),
+    ('least-connections'),
+    ('tag-affinity');
+
+  alter table target_tcp
+    add column host_selection_strategy text
+      constraint target_host_selection_strategy_enm_fkey
+        references target_host_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    add column worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    add column worker_affinity_filter wt_bexprfilter;
+
+  alter table target_ssh
+    add column host_selection_strategy text
+      constraint target_host_selection_strategy_enm_fkey
+        references target_host_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    add column worker_selection_strategy text
+      constraint target_worker_selection_strategy_enm_fkey
+        references target_worker_selection_strategy_enm (name)
+        on delete restrict
+        on update cascade,
+    add column worker_affinity_filter wt_bexprfilter;
+
+  -- Replaces view from 64/01_ssh_targets.up.sql. The new columns are
+  -- appended so the views depending on target_all_subtypes do not need to be
+  -- recreated.
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'tcp' as type,
+    host_selection_strategy,
+    worker_selection_strategy,
+    worker_affinity_filter
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'ssh' as type,
+    host_selection_strategy,
+    worker_selection_strategy,
+    worker_affinity_filter
+  from
+    target_ssh;
+
+  create table target_host_selection_state (
+    target_id wt_public_id primary key
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    selection_count bigint not null default 0
+      constraint selection_count_must_not_be_negative
+        check(selection_count >= 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table target_host_selection_state is
+    'target_host_selection_state contains the state shared by all controllers for the host selection strategy of a target. '
+    'selection_count is the number of hosts chosen for the target by the round-robin strategy.';
+
+  create trigger update_time_column before update on target_host_selection_state
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_host_selection_state
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on target_host_selection_state
+    for each row execute procedure immutable_columns('target_id', 'create_time');
+
+commit;
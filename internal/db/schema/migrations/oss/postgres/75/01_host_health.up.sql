@@ -0,0 +1,145 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table host_set_health_check_type_enm (
+    name text primary key
+      constraint only_predefined_health_check_types_allowed
+      check (
+        name in (
+          'tcp',
+          'tls',
+          'http'
+        )
+      )
+  );
+  comment on table host_set_health_check_type_enm is
+    'host_set_health_check_type_enm is an enumeration table for the types of host health checks. '
+    'It contains rows for representing TCP connect, TLS handshake and HTTP GET checks.';
+
+  insert into host_set_health_check_type_enm (name)
+  values
+    ('tcp'),
+    ('tls'),
+    ('http');
+
+  create table host_set_health_check (
+    host_set_id wt_public_id primary key
+      constraint host_set_fkey
+        references host_set (public_id)
+        on delete cascade
+        on update cascade,
+    check_type text not null
+      constraint host_set_health_check_type_enm_fkey
+        references host_set_health_check_type_enm (name)
+        on delete restrict
+        on update cascade,
+    port integer not null
+      constraint port_must_be_valid
+        check (port > 0 and port <= 65535),
+    http_path text
+      constraint http_path_must_be_absolute
+        check (left(http_path, 1) = '/')
+      constraint http_path_only_allowed_for_http_checks
+        check (http_path is null or check_type = 'http'),
+    interval_seconds integer not null default 30
+      constraint interval_seconds_must_be_at_least_5
+        check (interval_seconds >= 5),
+    timeout_seconds integer not null default 5
+      constraint timeout_seconds_must_be_greater_than_0
+        check (timeout_seconds > 0),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint timeout_seconds_must_be_less_than_interval_seconds
+      check (timeout_seconds < interval_seconds)
+  );
+  comment on table host_set_health_check is
+    'host_set_health_check is a table where each row is the health check run by workers against the hosts of a host set.';
+
+  create trigger update_time_column before update on host_set_health_check
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on host_set_health_check
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on host_set_health_check
+    for each row execute procedure immutable_columns('host_set_id', 'create_time');
+
+  create table host_health_status_enm (
+    name text primary key
+      constraint only_predefined_host_health_statuses_allowed
+      check (
+        name in (
+          'healthy',
+          'unhealthy'
+        )
+      )
+  );
+  comment on table host_health_status_enm is
+    'host_health_status_enm is an enumeration table for the result of a host health check.';
+
+  insert into host_health_status_enm (name)
+  values
+    ('healthy'),
+    ('unhealthy');
+
+  create table host_health (
+    host_set_id wt_public_id not null
+      constraint host_set_health_check_fkey
+        references host_set_health_check (host_set_id)
+        on delete cascade
+        on update cascade,
+    host_id wt_public_id not null
+      constraint host_fkey
+        references host (public_id)
+        on delete cascade
+        on update cascade,
+    status text not null
+      constraint host_health_status_enm_fkey
+        references host_health_status_enm (name)
+        on delete restrict
+        on update cascade,
+    worker_id wt_public_id
+      constraint server_worker_fkey
+        references server_worker (public_id)
+        on delete set null
+        on update cascade,
+    error text,
+    last_check_time timestamp with time zone not null,
+    last_healthy_time timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key (host_set_id, host_id)
+  );
+  comment on table host_health is
+    'host_health is a table where each row is the result of the most recent health check of a host of a host set, '
+    'as reported by a worker.';
+
+  create trigger update_time_column before update on host_health
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on host_health
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on host_health
+    for each row execute procedure immutable_columns('host_set_id', 'host_id', 'create_time');
+
+  -- set_host_health_last_healthy_time() is a before insert or update trigger
+  -- function for host_health which records the time of the last healthy
+  -- check.
+  create function set_host_health_last_healthy_time() returns trigger
+  as $$
+  begin
+    if new.status = 'healthy' then
+      new.last_healthy_time = new.last_check_time;
+    elsif tg_op = 'UPDATE' then
+      new.last_healthy_time = old.last_healthy_time;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function set_host_health_last_healthy_time is
+    'set_host_health_last_healthy_time sets last_healthy_time to last_check_time when a healthy result is recorded '
+    'and otherwise keeps the previous last_healthy_time.';
+
+  create trigger set_host_health_last_healthy_time before insert or update on host_health
+    for each row execute procedure set_host_health_last_healthy_time();
+
+commit;
@@ -0,0 +1,83 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table static_host_label (
+    host_id wt_public_id not null
+      constraint static_host_fkey
+        references static_host (public_id)
+        on delete cascade
+        on update cascade,
+    key text not null
+      constraint key_must_not_be_empty
+        check (length(trim(key)) > 0)
+      constraint key_must_be_less_than_256_characters
+        check (length(key) < 256),
+    value text not null
+      constraint value_must_be_less_than_256_characters
+        check (length(value) < 256),
+    primary key (host_id, key)
+  );
+  comment on table static_host_label is
+    'static_host_label is a table where each row is a key/value label of a static host. '
+    'Labels are used by the filters of dynamic static host sets.';
+
+  create trigger immutable_columns before update on static_host_label
+    for each row execute procedure immutable_columns('host_id', 'key', 'value');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('static_host_label', 1);
+
+  alter table static_host_set
+    add column filter text
+      constraint filter_must_not_be_empty
+        check (length(trim(filter)) > 0);
+
+  -- insert_static_host_set_member_not_dynamic() is a before insert trigger
+  -- function for static_host_set_member which prevents hosts from being
+  -- added to a host set whose membership is defined by a filter.
+  create function insert_static_host_set_member_not_dynamic() returns trigger
+  as $$
+  begin
+    perform
+       from static_host_set
+      where public_id = new.set_id
+        and filter is not null;
+    if found then
+      raise exception 'hosts cannot be added to static host set % which has a filter', new.set_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function insert_static_host_set_member_not_dynamic is
+    'insert_static_host_set_member_not_dynamic prevents hosts from being added to a static host set with a filter.';
+
+  create trigger insert_static_host_set_member_not_dynamic before insert on static_host_set_member
+    for each row execute procedure insert_static_host_set_member_not_dynamic();
+
+  -- update_static_host_set_filter_no_members() is a before update trigger
+  -- function for static_host_set which prevents a filter from being set on a
+  -- host set which has hosts added to it.
+  create function update_static_host_set_filter_no_members() returns trigger
+  as $$
+  begin
+    if new.filter is not null and old.filter is null then
+      perform
+         from static_host_set_member
+        where set_id = new.public_id;
+      if found then
+        raise exception 'filter cannot be set on static host set % which has hosts', new.public_id;
+      end if;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function update_static_host_set_filter_no_members is
+    'update_static_host_set_filter_no_members prevents a filter from being set on a static host set with hosts.';
+
+  create trigger update_static_host_set_filter_no_members before update of filter on static_host_set
+    for each row execute procedure update_static_host_set_filter_no_members();
+
+commit;
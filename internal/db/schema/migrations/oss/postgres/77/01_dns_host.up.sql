@@ -0,0 +1,405 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+/*
+                                 ┌──────────────────────────┐
+                                 │host_dns_catalog_resolver │
+                                 ├──────────────────────────┤
+                                 │catalog_id (pk, fk)       │
+                                 │priority (pk)             │
+                                 │address                   │
+                                 └──────────────────────────┘
+                                              ╲│╱
+                                               ○
+                                               ┼
+    ┌────────────────┐            ┌─────────────────────┐         ┌────────────────────┐
+    │  host_catalog  │            │  host_dns_catalog   │         │   host_dns_host    │
+    ├────────────────┤            ├─────────────────────┤         ├────────────────────┤
+    │public_id       │┼┼────────○┼│public_id (pk)       │┼┼─────○<│public_id (pk)      │
+    │project_id      │            │project_id (fk)      │         │catalog_id (fk)     │
+    └────────────────┘            │name                 │         │external_id         │
+                                  │description          │         │name                │
+                                  └─────────────────────┘         └────────────────────┘
+                                             ┼                               ┼
+                                             ┼                               ┼
+                                             ○                               ○
+                                            ╱│╲                             ╱│╲
+    ┌────────────────┐            ┌─────────────────────┐         ┌────────────────────┐
+    │    host_set    │            │    host_dns_set     │         │host_dns_set_member │
+    ├────────────────┤            ├─────────────────────┤         ├────────────────────┤
+    │public_id       │┼┼────────○┼│public_id (pk)       │┼┼─────○<│set_id (pk, fk)     │
+    │catalog_id      │            │catalog_id (fk)      │         │host_id (pk, fk)    │
+    └────────────────┘            │record_type          │         │catalog_id (fk)     │
+                                  │sync_interval_seconds│         └────────────────────┘
+                                  └─────────────────────┘
+                                             ┼
+                                             ┼
+                                             ○
+                                            ╱│╲
+                                  ┌─────────────────────┐
+                                  │  host_dns_set_name  │
+                                  ├─────────────────────┤
+                                  │set_id (pk, fk)      │
+                                  │name (pk)            │
+                                  └─────────────────────┘
+*/
+
+  create table host_dns_catalog (
+    public_id wt_public_id primary key,
+    project_id wt_scope_id not null,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint host_catalog_fkey
+      foreign key (project_id, public_id)
+        references host_catalog (project_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint host_dns_catalog_project_id_name_uq
+      unique(project_id, name)
+  );
+  comment on table host_dns_catalog is
+    'host_dns_catalog is a table where each row is a host catalog whose hosts are found by resolving the dns names of its host sets.';
+
+  create trigger update_version_column after update on host_dns_catalog
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on host_dns_catalog
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on host_dns_catalog
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_dns_catalog
+    for each row execute procedure immutable_columns('public_id', 'project_id', 'create_time');
+
+  create trigger insert_host_catalog_subtype before insert on host_dns_catalog
+    for each row execute procedure insert_host_catalog_subtype();
+
+  create trigger update_host_catalog_subtype before update on host_dns_catalog
+    for each row execute procedure update_host_catalog_subtype();
+
+  create trigger delete_host_catalog_subtype after delete on host_dns_catalog
+    for each row execute procedure delete_host_catalog_subtype();
+
+  -- host_dns_catalog_resolver contains the dns servers used to resolve the
+  -- names of the host sets of a dns host catalog, one per row, in the order
+  -- given by priority.
+  create table host_dns_catalog_resolver (
+    catalog_id wt_public_id not null
+      constraint host_dns_catalog_fkey
+        references host_dns_catalog (public_id)
+        on delete cascade
+        on update cascade,
+    priority wt_priority,
+    address text not null
+      constraint address_must_not_be_empty
+        check(length(trim(address)) > 0)
+      constraint address_must_not_be_too_long
+        check(length(trim(address)) < 255),
+    create_time wt_timestamp,
+    primary key(catalog_id, priority),
+    constraint host_dns_catalog_resolver_catalog_id_address_uq
+      unique(catalog_id, address)
+  );
+  comment on table host_dns_catalog_resolver is
+    'host_dns_catalog_resolver entries are the dns servers of a dns host catalog.';
+
+  create trigger default_create_time_column before insert on host_dns_catalog_resolver
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_dns_catalog_resolver
+    for each row execute procedure immutable_columns('catalog_id', 'priority', 'address', 'create_time');
+
+  create table host_dns_set_record_type_enm (
+    name text primary key
+      constraint only_predefined_record_types_allowed
+      check (
+        name in (
+          'ip',
+          'ip4',
+          'ip6',
+          'srv'
+        )
+      )
+  );
+  comment on table host_dns_set_record_type_enm is
+    'host_dns_set_record_type_enm is an enumeration table for the types of dns lookups of dns host sets. '
+    'It contains rows for looking up A and AAAA records, only A records, only AAAA records and SRV records.';
+
+  insert into host_dns_set_record_type_enm (name)
+  values
+    ('ip'),
+    ('ip4'),
+    ('ip6'),
+    ('srv');
+
+  create table host_dns_set (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      constraint host_dns_catalog_fkey
+        references host_dns_catalog (public_id)
+        on delete cascade
+        on update cascade,
+    project_id wt_public_id not null,
+    name wt_name,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    last_sync_time wt_timestamp,
+    need_sync bool not null,
+    sync_interval_seconds int
+      constraint sync_interval_seconds_not_equal_zero
+        check(sync_interval_seconds != 0)
+      constraint sync_interval_seconds_not_less_then_negative_one
+        check(sync_interval_seconds >= -1),
+    record_type text not null
+      constraint host_dns_set_record_type_enm_fkey
+        references host_dns_set_record_type_enm (name)
+        on delete restrict
+        on update cascade,
+    version wt_version,
+    constraint host_set_fkey
+      foreign key (project_id, catalog_id, public_id)
+        references host_set (project_id, catalog_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint host_dns_set_catalog_id_name_uq
+      unique(catalog_id, name),
+    constraint host_dns_set_catalog_id_public_id_uq
+      unique(catalog_id, public_id)
+  );
+  comment on table host_dns_set is
+    'host_dns_set is a table where each row is a host set whose hosts are found by resolving its dns names.';
+
+  create trigger update_version_column after update on host_dns_set
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on host_dns_set
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on host_dns_set
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_dns_set
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'project_id', 'create_time');
+
+  create trigger insert_host_set_subtype before insert on host_dns_set
+    for each row execute procedure insert_host_set_subtype();
+
+  create trigger delete_host_set_subtype after delete on host_dns_set
+    for each row execute procedure delete_host_set_subtype();
+
+  -- host_dns_set_name contains the dns names resolved to find the hosts of a
+  -- dns host set, one per row.
+  create table host_dns_set_name (
+    set_id wt_public_id not null
+      constraint host_dns_set_fkey
+        references host_dns_set (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_dns_name,
+    create_time wt_timestamp,
+    primary key(set_id, name)
+  );
+  comment on table host_dns_set_name is
+    'host_dns_set_name entries are the dns names of a dns host set.';
+
+  create trigger default_create_time_column before insert on host_dns_set_name
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_dns_set_name
+    for each row execute procedure immutable_columns('set_id', 'name', 'create_time');
+
+  -- host_dns_host captures the hosts found by resolving the dns names of dns
+  -- host sets. It is only written to by the controller and is not mutable
+  -- directly by actions from the end user. The ip addresses and dns names of
+  -- a host are stored in host_ip_address and host_dns_name.
+  create table host_dns_host (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      constraint host_dns_catalog_fkey
+        references host_dns_catalog (public_id)
+        on delete cascade
+        on update cascade,
+    external_id text not null
+      constraint external_id_must_not_be_empty
+        check(length(trim(external_id)) > 0),
+    name text,
+    create_time wt_timestamp,
+    -- update_time is the last time the data was changed by a sync.
+    update_time wt_timestamp,
+    version wt_version,
+    constraint host_fkey
+      foreign key (catalog_id, public_id)
+        references host (catalog_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint host_dns_host_catalog_id_external_id_uq
+      unique(catalog_id, external_id),
+    constraint host_dns_host_catalog_id_public_id_uq
+      unique(catalog_id, public_id)
+  );
+  comment on table host_dns_host is
+    'host_dns_host is a table where each row is a host found by resolving the dns names of a dns host set.';
+
+  create trigger update_version_column after update on host_dns_host
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on host_dns_host
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on host_dns_host
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_dns_host
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'external_id', 'create_time');
+
+  -- insert_host_dns_host_subtype is intended as a before insert trigger on
+  -- host_dns_host. Like insert_host_plugin_host_subtype, it does nothing on
+  -- conflicts with the base host to allow the upsert-style workflow of
+  -- syncing.
+  create function insert_host_dns_host_subtype() returns trigger
+  as $$
+  begin
+    insert into host
+      (public_id, catalog_id)
+    values
+      (new.public_id, new.catalog_id)
+    on conflict do nothing;
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_host_dns_host_subtype before insert on host_dns_host
+    for each row execute procedure insert_host_dns_host_subtype();
+
+  create trigger delete_host_subtype after delete on host_dns_host
+    for each row execute procedure delete_host_subtype();
+
+  create table host_dns_set_member (
+    host_id wt_public_id not null,
+    set_id wt_public_id not null,
+    catalog_id wt_public_id not null,
+    create_time wt_timestamp,
+    primary key(host_id, set_id),
+    constraint host_dns_host_fkey
+      foreign key (catalog_id, host_id)
+        references host_dns_host (catalog_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint host_dns_set_fkey
+      foreign key (catalog_id, set_id)
+        references host_dns_set (catalog_id, public_id)
+        on delete cascade
+        on update cascade
+  );
+  comment on table host_dns_set_member is
+    'host_dns_set_member entries are the membership relationships from dns hosts in dns sets.';
+
+  create trigger default_create_time_column before insert on host_dns_set_member
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on host_dns_set_member
+    for each row execute procedure immutable_columns('host_id', 'set_id', 'catalog_id', 'create_time');
+
+  create function insert_host_dns_set_member() returns trigger
+  as $$
+  begin
+    select host_dns_set.catalog_id
+      into new.catalog_id
+    from host_dns_set
+    where host_dns_set.public_id = new.set_id;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function insert_host_dns_set_member is
+    'insert_host_dns_set_member sets the catalog_id of a new host_dns_set_member to the catalog_id of its set.';
+
+  create trigger insert_host_dns_set_member before insert on host_dns_set_member
+    for each row execute procedure insert_host_dns_set_member();
+
+  -- host_dns_catalog_with_resolvers is useful for reading a dns host catalog
+  -- with its resolvers as a column of '|' delimited values in priority order.
+  create view host_dns_catalog_with_resolvers as
+  select
+    hc.public_id,
+    hc.project_id,
+    hc.name,
+    hc.description,
+    hc.create_time,
+    hc.update_time,
+    hc.version,
+    -- the string_agg(..) column will be null if there are no resolvers
+    string_agg(hcr.address, '|' order by hcr.priority) as resolvers
+  from
+    host_dns_catalog hc
+      left outer join host_dns_catalog_resolver hcr on hc.public_id = hcr.catalog_id
+  group by hc.public_id;
+  comment on view host_dns_catalog_with_resolvers is
+    'host dns catalog with its associated resolvers';
+
+  -- host_dns_set_with_value_obj is useful for reading a dns host set with its
+  -- names and members as columns of '|' delimited values.
+  create view host_dns_set_with_value_obj as
+  select
+    hs.public_id,
+    hs.catalog_id,
+    hc.project_id,
+    hs.name,
+    hs.description,
+    hs.create_time,
+    hs.update_time,
+    hs.last_sync_time,
+    hs.need_sync,
+    hs.sync_interval_seconds,
+    hs.record_type,
+    hs.version,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct hsn.name, '|') as names,
+    string_agg(distinct hsm.host_id, '|') as host_ids
+  from
+    host_dns_set hs
+      join host_dns_catalog hc                   on hs.catalog_id = hc.public_id
+      left outer join host_dns_set_name hsn      on hs.public_id = hsn.set_id
+      left outer join host_dns_set_member hsm    on hs.public_id = hsm.set_id
+  group by hs.public_id, hc.project_id;
+  comment on view host_dns_set_with_value_obj is
+    'host dns set with its associated value objects';
+
+  create view host_dns_host_with_value_obj_and_set_memberships as
+  select
+    h.public_id,
+    h.catalog_id,
+    h.external_id,
+    hc.project_id,
+    h.name,
+    h.create_time,
+    h.update_time,
+    h.version,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct host(hip.address), '|') as ip_addresses,
+    string_agg(distinct hdns.name, '|') as dns_names,
+    string_agg(distinct hsm.set_id, '|') as set_ids
+  from
+    host_dns_host h
+      join host_dns_catalog hc                   on h.catalog_id = hc.public_id
+      left outer join host_ip_address hip        on h.public_id = hip.host_id
+      left outer join host_dns_name hdns         on h.public_id = hdns.host_id
+      left outer join host_dns_set_member hsm    on h.public_id = hsm.host_id
+  group by h.public_id, hc.project_id;
+  comment on view host_dns_host_with_value_obj_and_set_memberships is
+    'host dns host with its associated value objects';
+
+  insert into oplog_ticket (name, version)
+  values
+    ('host_dns_catalog', 1),
+    ('host_dns_set', 1),
+    ('host_dns_host', 1);
+
+commit;
@@ -0,0 +1,68 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- host_plugin_set_sync_status records the outcome of the most recent sync of
+  -- a plugin host set. It is only written to by the set sync job and is not
+  -- versioned or recorded in the oplog.
+  create table host_plugin_set_sync_status (
+    set_id wt_public_id primary key
+      constraint host_plugin_set_fkey
+        references host_plugin_set (public_id)
+        on delete cascade
+        on update cascade,
+    last_sync_start_time timestamp with time zone,
+    last_sync_end_time timestamp with time zone,
+    last_sync_error text
+      constraint last_sync_error_must_not_be_empty
+        check (length(trim(last_sync_error)) > 0),
+    hosts_added int not null default 0
+      constraint hosts_added_must_not_be_negative
+        check (hosts_added >= 0),
+    hosts_removed int not null default 0
+      constraint hosts_removed_must_not_be_negative
+        check (hosts_removed >= 0)
+  );
+  comment on table host_plugin_set_sync_status is
+    'host_plugin_set_sync_status is a table where each row is the outcome of the most recent sync of a plugin host set.';
+
+  create trigger immutable_columns before update on host_plugin_set_sync_status
+    for each row execute procedure immutable_columns('set_id');
+
+  -- Replaces the view created in 20/06_plugin_host_views.up.sql to add the
+  -- sync status of the host set.
+  drop view host_plugin_host_set_with_value_obj;
+  create view host_plugin_host_set_with_value_obj as
+    select
+      hs.public_id,
+      hs.catalog_id,
+      hc.plugin_id,
+      hs.name,
+      hs.description,
+      hs.create_time,
+      hs.update_time,
+      hs.last_sync_time,
+      hs.need_sync,
+      hs.sync_interval_seconds,
+      hs.version,
+      hs.attributes,
+      hss.last_sync_start_time,
+      hss.last_sync_end_time,
+      hss.last_sync_error,
+      hss.hosts_added,
+      hss.hosts_removed,
+      -- the string_agg(..) column will be null if there are no associated value objects
+      string_agg(distinct concat_ws('=', hspe.priority, hspe.condition), '|') as preferred_endpoints,
+      string_agg(distinct hpsm.host_id, '|') as host_ids
+    from
+      host_plugin_set hs
+      join host_plugin_catalog hc                        on hs.catalog_id = hc.public_id
+      left outer join host_plugin_set_sync_status hss    on hs.public_id = hss.set_id
+      left outer join host_set_preferred_endpoint hspe   on hs.public_id = hspe.host_set_id
+      left outer join host_plugin_set_member hpsm        on hs.public_id = hpsm.set_id
+    group by hs.public_id, hc.plugin_id, hss.set_id;
+  comment on view host_plugin_host_set_with_value_obj is
+    'host plugin host set with its associated value objects and sync status';
+
+commit;
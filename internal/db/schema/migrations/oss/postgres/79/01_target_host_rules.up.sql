@@ -0,0 +1,63 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table target_tcp
+    add column host_exclusion_filter wt_bexprfilter,
+    add column host_preference_filter wt_bexprfilter;
+
+  alter table target_ssh
+    add column host_exclusion_filter wt_bexprfilter,
+    add column host_preference_filter wt_bexprfilter;
+
+  -- Replaces view from 74/01_target_selection_strategies.up.sql. The new
+  -- columns are appended so the views depending on target_all_subtypes do not
+  -- need to be recreated.
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'tcp' as type,
+    host_selection_strategy,
+    worker_selection_strategy,
+    worker_affinity_filter,
+    host_exclusion_filter,
+    host_preference_filter
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    'ssh' as type,
+    host_selection_strategy,
+    worker_selection_strategy,
+    worker_affinity_filter,
+    host_exclusion_filter,
+    host_preference_filter
+  from
+    target_ssh;
+
+commit;
@@ -0,0 +1,34 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  alter table static_host
+    add column port integer
+      constraint port_must_be_in_range
+        check (port > 0 and port <= 65535);
+  comment on column static_host.port is
+    'port is the port used to connect to the host instead of the default port of the target. It is null if the host does not override the port.';
+
+  -- Replaces view from 25/01_static_host_view.up.sql to add the port column.
+  create or replace view static_host_with_set_memberships as
+  select
+    h.public_id,
+    h.create_time,
+    h.update_time,
+    h.name,
+    h.description,
+    h.catalog_id,
+    h.address,
+    h.version,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct hsm.set_id, '|') as set_ids,
+    h.port
+  from
+    static_host h
+      left outer join static_host_set_member hsm on h.public_id = hsm.host_id
+  group by h.public_id;
+  comment on view static_host_with_set_memberships is
+    'static host with its associated host sets';
+
+commit;
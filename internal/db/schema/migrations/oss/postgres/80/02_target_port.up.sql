@@ -0,0 +1,33 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table target_port (
+    target_id wt_public_id not null
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    name text not null
+      constraint name_must_be_valid
+        check (name ~ '^[a-z0-9][a-z0-9_-]*$')
+      constraint name_must_be_less_than_64_characters
+        check (length(name) < 64),
+    port integer not null
+      constraint port_must_be_in_range
+        check (port > 0 and port <= 65535),
+    primary key (target_id, name)
+  );
+  comment on table target_port is
+    'target_port is a table where each row is a named port of a target. '
+    'A session can be authorized for one of the named ports of a target instead of its default port.';
+
+  create trigger immutable_columns before update on target_port
+    for each row execute procedure immutable_columns('target_id', 'name', 'port');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('target_port', 1);
+
+commit;
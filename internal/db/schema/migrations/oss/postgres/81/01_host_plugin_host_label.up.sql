@@ -0,0 +1,33 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  create table host_plugin_host_label (
+    host_id wt_public_id not null
+      constraint host_plugin_host_fkey
+        references host_plugin_host (public_id)
+        on delete cascade
+        on update cascade,
+    key text not null
+      constraint key_must_not_be_empty
+        check (length(trim(key)) > 0)
+      constraint key_must_be_less_than_256_characters
+        check (length(key) < 256),
+    value text not null
+      constraint value_must_be_less_than_256_characters
+        check (length(value) < 256),
+    primary key (host_id, key)
+  );
+  comment on table host_plugin_host_label is
+    'host_plugin_host_label is a table where each row is a key/value label of a plugin host. '
+    'Labels are provided by the plugin and are used by the host rules of targets.';
+
+  create trigger immutable_columns before update on host_plugin_host_label
+    for each row execute procedure immutable_columns('host_id', 'key', 'value');
+
+  insert into oplog_ticket (name, version)
+  values
+    ('host_plugin_host_label', 1);
+
+commit;
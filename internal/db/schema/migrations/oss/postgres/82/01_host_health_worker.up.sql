@@ -0,0 +1,29 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+begin;
+
+  -- The result of a health check is stored for each worker which ran it, so
+  -- results reported by different workers no longer overwrite each other.
+  -- Results without a worker can't be attributed and are removed.
+  delete from host_health where worker_id is null;
+
+  alter table host_health
+    drop constraint host_health_pkey,
+    drop constraint server_worker_fkey,
+    alter column worker_id set not null,
+    add constraint server_worker_fkey
+      foreign key (worker_id)
+        references server_worker (public_id)
+        on delete cascade
+        on update cascade,
+    add primary key (host_set_id, host_id, worker_id);
+  comment on table host_health is
+    'host_health is a table where each row is the result of the most recent health check of a host of a host set '
+    'run by a worker.';
+
+  drop trigger immutable_columns on host_health;
+  create trigger immutable_columns before update on host_health
+    for each row execute procedure immutable_columns('host_set_id', 'host_id', 'worker_id', 'create_time');
+
+commit;
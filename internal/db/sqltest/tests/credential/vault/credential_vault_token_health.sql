@@ -0,0 +1,44 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- credential_vault_token_health tests the health columns of the
+-- credential_vault_token table and the credential_vault_store_list_lookup view
+
+begin;
+
+  select plan(4);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets', 'credentials');
+
+  insert into credential_vault_token
+    (token_hmac,   token,   store_id,        last_renewal_time, expiration_time,          key_id,          status)
+  values
+    ('cvs_token1', 'token', 'vs_______cvs1', now(),             wt_add_seconds_to_now(1), 'kdkv___widget', 'current');
+
+  select is(expiration_capped, false) from credential_vault_token where token_hmac = 'cvs_token1';
+
+  prepare invalid_health_status as
+    update credential_vault_token
+       set health_status = 'unknown'
+     where token_hmac = 'cvs_token1';
+  select throws_ok('invalid_health_status', '23503');
+
+  update credential_vault_token
+     set health_status        = 'warning',
+         health_check_time    = now(),
+         missing_capabilities = 'sys/leases/renew: ["update"]'
+   where token_hmac = 'cvs_token1';
+
+  prepare select_health as
+    select token_health_status, token_missing_capabilities
+      from credential_vault_store_list_lookup
+     where public_id = 'vs_______cvs1';
+  select results_eq(
+    'select_health',
+    $$VALUES ('warning', 'sys/leases/renew: ["update"]')$$
+  );
+
+  select isnt(token_health_check_time, null) from credential_vault_store_list_lookup where public_id = 'vs_______cvs1';
+
+  select * from finish();
+
+rollback;
@@ -0,0 +1,100 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- host_health tests the host_set_health_check and host_health tables.
+
+begin;
+  select plan(14);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  insert into server_worker
+    (public_id, scope_id, type)
+  values
+    ('w_____wb__01', 'global', 'pki'),
+    ('w_____wb__02', 'global', 'pki');
+
+  insert into host_set_health_check
+    (host_set_id, check_type, port)
+  values
+    ('s___1wb-sths', 'tcp', 22);
+  select is(interval_seconds, 30) from host_set_health_check where host_set_id = 's___1wb-sths';
+  select is(timeout_seconds,  5)  from host_set_health_check where host_set_id = 's___1wb-sths';
+
+  prepare http_path_on_tcp_check as
+    update host_set_health_check
+       set http_path = '/health'
+     where host_set_id = 's___1wb-sths';
+  select throws_ok('http_path_on_tcp_check', '23514');
+
+  prepare timeout_not_less_than_interval as
+    update host_set_health_check
+       set timeout_seconds = 30
+     where host_set_id = 's___1wb-sths';
+  select throws_ok('timeout_not_less_than_interval', '23514');
+
+  prepare invalid_check_type as
+    update host_set_health_check
+       set check_type = 'icmp'
+     where host_set_id = 's___1wb-sths';
+  select throws_ok('invalid_check_type', '23503');
+
+  insert into host_health
+    (host_set_id, host_id, worker_id, status, last_check_time)
+  values
+    ('s___1wb-sths', 'h_____wb__01', 'w_____wb__01', 'healthy', '2023-01-01 00:00:00+00');
+  select is(last_healthy_time, '2023-01-01 00:00:00+00'::timestamptz)
+    from host_health where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01' and worker_id = 'w_____wb__01';
+
+  prepare missing_worker as
+    insert into host_health
+      (host_set_id, host_id, status, last_check_time)
+    values
+      ('s___1wb-sths', 'h_____wb__02', 'healthy', now());
+  select throws_ok('missing_worker', '23502');
+
+  -- Each worker has its own result for a host.
+  insert into host_health
+    (host_set_id, host_id, worker_id, status, error, last_check_time)
+  values
+    ('s___1wb-sths', 'h_____wb__01', 'w_____wb__02', 'unhealthy', 'connection refused', '2023-01-01 00:00:00+00');
+  select is(count(*), 2::bigint)
+    from host_health where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01';
+
+  update host_health
+     set status          = 'unhealthy',
+         error           = 'connection refused',
+         last_check_time = '2023-01-01 00:01:00+00'
+   where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01' and worker_id = 'w_____wb__01';
+  select is(last_healthy_time, '2023-01-01 00:00:00+00'::timestamptz)
+    from host_health where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01' and worker_id = 'w_____wb__01';
+
+  update host_health
+     set status          = 'healthy',
+         error           = null,
+         last_check_time = '2023-01-01 00:02:00+00'
+   where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01' and worker_id = 'w_____wb__01';
+  select is(last_healthy_time, '2023-01-01 00:02:00+00'::timestamptz)
+    from host_health where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01' and worker_id = 'w_____wb__01';
+
+  prepare invalid_status as
+    update host_health
+       set status = 'degraded'
+     where host_set_id = 's___1wb-sths' and host_id = 'h_____wb__01';
+  select throws_ok('invalid_status', '23503');
+
+  prepare host_set_without_health_check as
+    insert into host_health
+      (host_set_id, host_id, worker_id, status, last_check_time)
+    values
+      ('s___2wb-sths', 'h_____wb__01', 'w_____wb__01', 'healthy', now());
+  select throws_ok('host_set_without_health_check', '23503');
+
+  delete from server_worker where public_id = 'w_____wb__02';
+  select is(count(*), 1::bigint) from host_health where host_set_id = 's___1wb-sths';
+
+  delete from host_set_health_check where host_set_id = 's___1wb-sths';
+  select is(count(*), 0::bigint) from host_health where host_set_id = 's___1wb-sths';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,42 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- host_plugin_host_label tests the host_plugin_host_label table.
+
+begin;
+  select plan(5);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  insert into host_plugin_host_label
+    (host_id, key, value)
+  values
+    ('h_____wb__01-plgh', 'zone', 'us-east-1a'),
+    ('h_____wb__01-plgh', 'tier', '');
+  select is(count(*), 2::bigint) from host_plugin_host_label where host_id = 'h_____wb__01-plgh';
+
+  prepare empty_key as
+    insert into host_plugin_host_label
+      (host_id, key, value)
+    values
+      ('h_____wb__01-plgh', ' ', 'us-east-1a');
+  select throws_ok('empty_key', '23514');
+
+  prepare duplicate_key as
+    insert into host_plugin_host_label
+      (host_id, key, value)
+    values
+      ('h_____wb__01-plgh', 'zone', 'us-east-1b');
+  select throws_ok('duplicate_key', '23505');
+
+  prepare update_value as
+    update host_plugin_host_label
+       set value = 'us-east-1b'
+     where host_id = 'h_____wb__01-plgh' and key = 'zone';
+  select throws_ok('update_value');
+
+  delete from host_plugin_host where public_id = 'h_____wb__01-plgh';
+  select is(count(*), 0::bigint) from host_plugin_host_label where host_id = 'h_____wb__01-plgh';
+
+  select * from finish();
+rollback;
@@ -0,0 +1,64 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- static_host_label tests the static_host_label table and the filter of
+-- static host sets.
+
+begin;
+  select plan(8);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  insert into static_host_label
+    (host_id, key, value)
+  values
+    ('h_____wb__01', 'env', 'prod'),
+    ('h_____wb__01', 'tier', '');
+  select is(count(*), 2::bigint) from static_host_label where host_id = 'h_____wb__01';
+
+  prepare empty_key as
+    insert into static_host_label
+      (host_id, key, value)
+    values
+      ('h_____wb__01', ' ', 'prod');
+  select throws_ok('empty_key', '23514');
+
+  prepare duplicate_key as
+    insert into static_host_label
+      (host_id, key, value)
+    values
+      ('h_____wb__01', 'env', 'dev');
+  select throws_ok('duplicate_key', '23505');
+
+  prepare update_value as
+    update static_host_label
+       set value = 'dev'
+     where host_id = 'h_____wb__01' and key = 'env';
+  select throws_ok('update_value');
+
+  -- A filter cannot be set on a host set with members.
+  prepare filter_on_set_with_members as
+    update static_host_set
+       set filter = '"/labels/env" == "prod"'
+     where public_id = 's___1wb-sths';
+  select throws_ok('filter_on_set_with_members');
+
+  delete from static_host_set_member where set_id = 's___1wb-sths';
+  update static_host_set
+     set filter = '"/labels/env" == "prod"'
+   where public_id = 's___1wb-sths';
+  select is(filter, '"/labels/env" == "prod"') from static_host_set where public_id = 's___1wb-sths';
+
+  -- Hosts cannot be added to a host set with a filter.
+  prepare member_of_dynamic_set as
+    insert into static_host_set_member
+      (host_id, set_id)
+    values
+      ('h_____wb__01', 's___1wb-sths');
+  select throws_ok('member_of_dynamic_set');
+
+  delete from static_host where public_id = 'h_____wb__01';
+  select is(count(*), 0::bigint) from static_host_label where host_id = 'h_____wb__01';
+
+  select * from finish();
+rollback;
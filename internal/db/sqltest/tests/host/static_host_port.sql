@@ -0,0 +1,31 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- static_host_port tests the port column of static hosts.
+
+begin;
+  select plan(4);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  select is(port, null) from static_host_with_set_memberships where public_id = 'h_____wb__01';
+
+  update static_host
+     set port = 2222
+   where public_id = 'h_____wb__01';
+  select is(port, 2222) from static_host_with_set_memberships where public_id = 'h_____wb__01';
+
+  prepare zero_port as
+    update static_host
+       set port = 0
+     where public_id = 'h_____wb__01';
+  select throws_ok('zero_port', '23514');
+
+  prepare large_port as
+    update static_host
+       set port = 65536
+     where public_id = 'h_____wb__01';
+  select throws_ok('large_port', '23514');
+
+  select * from finish();
+rollback;
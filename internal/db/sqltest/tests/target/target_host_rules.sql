@@ -0,0 +1,30 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- target_host_rules tests the host exclusion and preference filter columns
+-- of the target subtype tables.
+
+begin;
+  select plan(5);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  select is(host_exclusion_filter,  null) from target_all_subtypes where public_id = 't_________wb';
+  select is(host_preference_filter, null) from target_all_subtypes where public_id = 't_________wb';
+
+  update target_tcp
+     set host_exclusion_filter  = '"10.0.0.0/8" in "/cidrs"',
+         host_preference_filter = '"/labels/zone" == "us-east-1a"'
+   where public_id = 't_________wb';
+
+  select is(host_exclusion_filter,  '"10.0.0.0/8" in "/cidrs"')         from target_all_subtypes where public_id = 't_________wb';
+  select is(host_preference_filter, '"/labels/zone" == "us-east-1a"') from target_all_subtypes where public_id = 't_________wb';
+
+  prepare empty_exclusion_filter as
+    update target_tcp
+       set host_exclusion_filter = '  '
+     where public_id = 't_________wb';
+  select throws_ok('empty_exclusion_filter', '23514');
+
+  select * from finish();
+rollback;
@@ -0,0 +1,49 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- target_port tests the target_port table.
+
+begin;
+  select plan(6);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  insert into target_port
+    (target_id, name, port)
+  values
+    ('t_________wb', 'primary', 5432),
+    ('t_________wb', 'metrics', 9187);
+  select is(count(*), 2::bigint) from target_port where target_id = 't_________wb';
+
+  prepare duplicate_name as
+    insert into target_port
+      (target_id, name, port)
+    values
+      ('t_________wb', 'primary', 5433);
+  select throws_ok('duplicate_name', '23505');
+
+  prepare invalid_name as
+    insert into target_port
+      (target_id, name, port)
+    values
+      ('t_________wb', 'Primary Port', 5433);
+  select throws_ok('invalid_name', '23514');
+
+  prepare invalid_port as
+    insert into target_port
+      (target_id, name, port)
+    values
+      ('t_________wb', 'replica', 0);
+  select throws_ok('invalid_port', '23514');
+
+  prepare update_port as
+    update target_port
+       set port = 5433
+     where target_id = 't_________wb' and name = 'primary';
+  select throws_ok('update_port');
+
+  delete from target_tcp where public_id = 't_________wb';
+  select is(count(*), 0::bigint) from target_port where target_id = 't_________wb';
+
+  select * from finish();
+rollback;
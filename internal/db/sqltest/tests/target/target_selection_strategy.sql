@@ -0,0 +1,54 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: MPL-2.0
+
+-- target_selection_strategy tests the host and worker selection strategy
+-- columns of the target subtype tables and the target_host_selection_state
+-- table.
+
+begin;
+  select plan(10);
+
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets');
+
+  select is(host_selection_strategy,   null) from target_all_subtypes where public_id = 't_________wb';
+  select is(worker_selection_strategy, null) from target_all_subtypes where public_id = 't_________wb';
+
+  update target_tcp
+     set host_selection_strategy   = 'round-robin',
+         worker_selection_strategy = 'tag-affinity',
+         worker_affinity_filter    = '"us-east" in "/tags/region"'
+   where public_id = 't_________wb';
+
+  select is(host_selection_strategy,   'round-robin')                 from target_all_subtypes where public_id = 't_________wb';
+  select is(worker_selection_strategy, 'tag-affinity')                from target_all_subtypes where public_id = 't_________wb';
+  select is(worker_affinity_filter,    '"us-east" in "/tags/region"') from target_all_subtypes where public_id = 't_________wb';
+
+  prepare invalid_host_strategy as
+    update target_tcp
+       set host_selection_strategy = 'fastest'
+     where public_id = 't_________wb';
+  select throws_ok('invalid_host_strategy', '23503');
+
+  prepare invalid_worker_strategy as
+    update target_tcp
+       set worker_selection_strategy = 'fastest'
+     where public_id = 't_________wb';
+  select throws_ok('invalid_worker_strategy', '23503');
+
+  insert into target_host_selection_state
+    (target_id)
+  values
+    ('t_________wb');
+  select is(selection_count, 0::bigint) from target_host_selection_state where target_id = 't_________wb';
+
+  prepare negative_selection_count as
+    update target_host_selection_state
+       set selection_count = -1
+     where target_id = 't_________wb';
+  select throws_ok('negative_selection_count', '23514');
+
+  delete from target_tcp where public_id = 't_________wb';
+  select is(count(*), 0::bigint) from target_host_selection_state where target_id = 't_________wb';
+
+  select * from finish();
+rollback;
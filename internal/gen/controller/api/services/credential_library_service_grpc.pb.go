@@ -44,6 +44,17 @@ type CredentialLibraryServiceClient interface {
 	// DeleteCredentialLibrary removes an Credential Library from Boundary. If the Credential Library id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialLibrary(ctx context.Context, in *DeleteCredentialLibraryRequest, opts ...grpc.CallOption) (*DeleteCredentialLibraryResponse, error)
+	// GetCredentialLibraryHistory returns the ordered change history of the
+	// specified Credential Library, derived from the oplog. Each record
+	// includes the field-level changes made, the time they were made and the
+	// acting User, if known.
+	GetCredentialLibraryHistory(ctx context.Context, in *GetCredentialLibraryHistoryRequest, opts ...grpc.CallOption) (*GetCredentialLibraryHistoryResponse, error)
+	// RestoreCredentialLibrary restores the specified Credential Library to its state at the
+	// provided time, as reconstructed from the oplog. A Credential Library which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Credential Library depended on no longer exists.
+	RestoreCredentialLibrary(ctx context.Context, in *RestoreCredentialLibraryRequest, opts ...grpc.CallOption) (*RestoreCredentialLibraryResponse, error)
 }
 
 type credentialLibraryServiceClient struct {
@@ -99,6 +110,24 @@ func (c *credentialLibraryServiceClient) DeleteCredentialLibrary(ctx context.Con
 	return out, nil
 }
 
+func (c *credentialLibraryServiceClient) GetCredentialLibraryHistory(ctx context.Context, in *GetCredentialLibraryHistoryRequest, opts ...grpc.CallOption) (*GetCredentialLibraryHistoryResponse, error) {
+	out := new(GetCredentialLibraryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialLibraryService/GetCredentialLibraryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialLibraryServiceClient) RestoreCredentialLibrary(ctx context.Context, in *RestoreCredentialLibraryRequest, opts ...grpc.CallOption) (*RestoreCredentialLibraryResponse, error) {
+	out := new(RestoreCredentialLibraryResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialLibraryService/RestoreCredentialLibrary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialLibraryServiceServer is the server API for CredentialLibraryService service.
 // All implementations must embed UnimplementedCredentialLibraryServiceServer
 // for forward compatibility
@@ -129,6 +158,17 @@ type CredentialLibraryServiceServer interface {
 	// DeleteCredentialLibrary removes an Credential Library from Boundary. If the Credential Library id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialLibrary(context.Context, *DeleteCredentialLibraryRequest) (*DeleteCredentialLibraryResponse, error)
+	// GetCredentialLibraryHistory returns the ordered change history of the
+	// specified Credential Library, derived from the oplog. Each record
+	// includes the field-level changes made, the time they were made and the
+	// acting User, if known.
+	GetCredentialLibraryHistory(context.Context, *GetCredentialLibraryHistoryRequest) (*GetCredentialLibraryHistoryResponse, error)
+	// RestoreCredentialLibrary restores the specified Credential Library to its state at the
+	// provided time, as reconstructed from the oplog. A Credential Library which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Credential Library depended on no longer exists.
+	RestoreCredentialLibrary(context.Context, *RestoreCredentialLibraryRequest) (*RestoreCredentialLibraryResponse, error)
 	mustEmbedUnimplementedCredentialLibraryServiceServer()
 }
 
@@ -151,6 +191,12 @@ func (UnimplementedCredentialLibraryServiceServer) UpdateCredentialLibrary(conte
 func (UnimplementedCredentialLibraryServiceServer) DeleteCredentialLibrary(context.Context, *DeleteCredentialLibraryRequest) (*DeleteCredentialLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredentialLibrary not implemented")
 }
+func (UnimplementedCredentialLibraryServiceServer) GetCredentialLibraryHistory(context.Context, *GetCredentialLibraryHistoryRequest) (*GetCredentialLibraryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredentialLibraryHistory not implemented")
+}
+func (UnimplementedCredentialLibraryServiceServer) RestoreCredentialLibrary(context.Context, *RestoreCredentialLibraryRequest) (*RestoreCredentialLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCredentialLibrary not implemented")
+}
 func (UnimplementedCredentialLibraryServiceServer) mustEmbedUnimplementedCredentialLibraryServiceServer() {
 }
 
@@ -255,6 +301,42 @@ func _CredentialLibraryService_DeleteCredentialLibrary_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialLibraryService_GetCredentialLibraryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialLibraryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialLibraryServiceServer).GetCredentialLibraryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialLibraryService/GetCredentialLibraryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialLibraryServiceServer).GetCredentialLibraryHistory(ctx, req.(*GetCredentialLibraryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialLibraryService_RestoreCredentialLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCredentialLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialLibraryServiceServer).RestoreCredentialLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialLibraryService/RestoreCredentialLibrary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialLibraryServiceServer).RestoreCredentialLibrary(ctx, req.(*RestoreCredentialLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialLibraryService_ServiceDesc is the grpc.ServiceDesc for CredentialLibraryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +364,14 @@ var CredentialLibraryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredentialLibrary",
 			Handler:    _CredentialLibraryService_DeleteCredentialLibrary_Handler,
 		},
+		{
+			MethodName: "GetCredentialLibraryHistory",
+			Handler:    _CredentialLibraryService_GetCredentialLibraryHistory_Handler,
+		},
+		{
+			MethodName: "RestoreCredentialLibrary",
+			Handler:    _CredentialLibraryService_RestoreCredentialLibrary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_library_service.proto",
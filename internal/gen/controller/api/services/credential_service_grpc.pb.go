@@ -44,6 +44,30 @@ type CredentialServiceClient interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error)
+	// ListCredentialCheckouts returns the check-outs of the specified Credential
+	// currently held by Sessions, along with the User each Session belongs to.
+	ListCredentialCheckouts(ctx context.Context, in *ListCredentialCheckoutsRequest, opts ...grpc.CallOption) (*ListCredentialCheckoutsResponse, error)
+	// CheckInCredential forcibly releases the check-out of the specified
+	// Credential held by a Session, making it available to other Sessions. The
+	// Session is canceled. The remaining check-outs are returned.
+	CheckInCredential(ctx context.Context, in *CheckInCredentialRequest, opts ...grpc.CallOption) (*CheckInCredentialResponse, error)
+	// SetCredentialRotationPolicy sets the rotation policy of the specified
+	// username_password Credential, replacing any existing policy.
+	SetCredentialRotationPolicy(ctx context.Context, in *SetCredentialRotationPolicyRequest, opts ...grpc.CallOption) (*SetCredentialRotationPolicyResponse, error)
+	// RemoveCredentialRotationPolicy removes the rotation policy of the
+	// specified Credential. Its password is no longer rotated.
+	RemoveCredentialRotationPolicy(ctx context.Context, in *RemoveCredentialRotationPolicyRequest, opts ...grpc.CallOption) (*RemoveCredentialRotationPolicyResponse, error)
+	// ListCredentialRotations returns the rotation policy of the specified
+	// Credential along with its rotation history, most recent first.
+	ListCredentialRotations(ctx context.Context, in *ListCredentialRotationsRequest, opts ...grpc.CallOption) (*ListCredentialRotationsResponse, error)
+	// ListCredentialVersions returns the retained versions of the specified
+	// Credential, most recent first. A version is recorded each time the
+	// Credential is created or updated.
+	ListCredentialVersions(ctx context.Context, in *ListCredentialVersionsRequest, opts ...grpc.CallOption) (*ListCredentialVersionsResponse, error)
+	// RollbackCredential restores the specified Credential, including its
+	// secret, to a previous version. The restored state is recorded as a new
+	// version. A Credential with a rotation policy can not be rolled back.
+	RollbackCredential(ctx context.Context, in *RollbackCredentialRequest, opts ...grpc.CallOption) (*RollbackCredentialResponse, error)
 }
 
 type credentialServiceClient struct {
@@ -99,6 +123,69 @@ func (c *credentialServiceClient) DeleteCredential(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *credentialServiceClient) ListCredentialCheckouts(ctx context.Context, in *ListCredentialCheckoutsRequest, opts ...grpc.CallOption) (*ListCredentialCheckoutsResponse, error) {
+	out := new(ListCredentialCheckoutsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/ListCredentialCheckouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) CheckInCredential(ctx context.Context, in *CheckInCredentialRequest, opts ...grpc.CallOption) (*CheckInCredentialResponse, error) {
+	out := new(CheckInCredentialResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/CheckInCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) SetCredentialRotationPolicy(ctx context.Context, in *SetCredentialRotationPolicyRequest, opts ...grpc.CallOption) (*SetCredentialRotationPolicyResponse, error) {
+	out := new(SetCredentialRotationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/SetCredentialRotationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) RemoveCredentialRotationPolicy(ctx context.Context, in *RemoveCredentialRotationPolicyRequest, opts ...grpc.CallOption) (*RemoveCredentialRotationPolicyResponse, error) {
+	out := new(RemoveCredentialRotationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/RemoveCredentialRotationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) ListCredentialRotations(ctx context.Context, in *ListCredentialRotationsRequest, opts ...grpc.CallOption) (*ListCredentialRotationsResponse, error) {
+	out := new(ListCredentialRotationsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/ListCredentialRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) ListCredentialVersions(ctx context.Context, in *ListCredentialVersionsRequest, opts ...grpc.CallOption) (*ListCredentialVersionsResponse, error) {
+	out := new(ListCredentialVersionsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/ListCredentialVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) RollbackCredential(ctx context.Context, in *RollbackCredentialRequest, opts ...grpc.CallOption) (*RollbackCredentialResponse, error) {
+	out := new(RollbackCredentialResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialService/RollbackCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialServiceServer is the server API for CredentialService service.
 // All implementations must embed UnimplementedCredentialServiceServer
 // for forward compatibility
@@ -129,6 +216,30 @@ type CredentialServiceServer interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error)
+	// ListCredentialCheckouts returns the check-outs of the specified Credential
+	// currently held by Sessions, along with the User each Session belongs to.
+	ListCredentialCheckouts(context.Context, *ListCredentialCheckoutsRequest) (*ListCredentialCheckoutsResponse, error)
+	// CheckInCredential forcibly releases the check-out of the specified
+	// Credential held by a Session, making it available to other Sessions. The
+	// Session is canceled. The remaining check-outs are returned.
+	CheckInCredential(context.Context, *CheckInCredentialRequest) (*CheckInCredentialResponse, error)
+	// SetCredentialRotationPolicy sets the rotation policy of the specified
+	// username_password Credential, replacing any existing policy.
+	SetCredentialRotationPolicy(context.Context, *SetCredentialRotationPolicyRequest) (*SetCredentialRotationPolicyResponse, error)
+	// RemoveCredentialRotationPolicy removes the rotation policy of the
+	// specified Credential. Its password is no longer rotated.
+	RemoveCredentialRotationPolicy(context.Context, *RemoveCredentialRotationPolicyRequest) (*RemoveCredentialRotationPolicyResponse, error)
+	// ListCredentialRotations returns the rotation policy of the specified
+	// Credential along with its rotation history, most recent first.
+	ListCredentialRotations(context.Context, *ListCredentialRotationsRequest) (*ListCredentialRotationsResponse, error)
+	// ListCredentialVersions returns the retained versions of the specified
+	// Credential, most recent first. A version is recorded each time the
+	// Credential is created or updated.
+	ListCredentialVersions(context.Context, *ListCredentialVersionsRequest) (*ListCredentialVersionsResponse, error)
+	// RollbackCredential restores the specified Credential, including its
+	// secret, to a previous version. The restored state is recorded as a new
+	// version. A Credential with a rotation policy can not be rolled back.
+	RollbackCredential(context.Context, *RollbackCredentialRequest) (*RollbackCredentialResponse, error)
 	mustEmbedUnimplementedCredentialServiceServer()
 }
 
@@ -151,6 +262,27 @@ func (UnimplementedCredentialServiceServer) UpdateCredential(context.Context, *U
 func (UnimplementedCredentialServiceServer) DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
 }
+func (UnimplementedCredentialServiceServer) ListCredentialCheckouts(context.Context, *ListCredentialCheckoutsRequest) (*ListCredentialCheckoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialCheckouts not implemented")
+}
+func (UnimplementedCredentialServiceServer) CheckInCredential(context.Context, *CheckInCredentialRequest) (*CheckInCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) SetCredentialRotationPolicy(context.Context, *SetCredentialRotationPolicyRequest) (*SetCredentialRotationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCredentialRotationPolicy not implemented")
+}
+func (UnimplementedCredentialServiceServer) RemoveCredentialRotationPolicy(context.Context, *RemoveCredentialRotationPolicyRequest) (*RemoveCredentialRotationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCredentialRotationPolicy not implemented")
+}
+func (UnimplementedCredentialServiceServer) ListCredentialRotations(context.Context, *ListCredentialRotationsRequest) (*ListCredentialRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialRotations not implemented")
+}
+func (UnimplementedCredentialServiceServer) ListCredentialVersions(context.Context, *ListCredentialVersionsRequest) (*ListCredentialVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialVersions not implemented")
+}
+func (UnimplementedCredentialServiceServer) RollbackCredential(context.Context, *RollbackCredentialRequest) (*RollbackCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCredential not implemented")
+}
 func (UnimplementedCredentialServiceServer) mustEmbedUnimplementedCredentialServiceServer() {}
 
 // UnsafeCredentialServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +386,132 @@ func _CredentialService_DeleteCredential_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialService_ListCredentialCheckouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialCheckoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListCredentialCheckouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/ListCredentialCheckouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListCredentialCheckouts(ctx, req.(*ListCredentialCheckoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_CheckInCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).CheckInCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/CheckInCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).CheckInCredential(ctx, req.(*CheckInCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_SetCredentialRotationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCredentialRotationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).SetCredentialRotationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/SetCredentialRotationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).SetCredentialRotationPolicy(ctx, req.(*SetCredentialRotationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_RemoveCredentialRotationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCredentialRotationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).RemoveCredentialRotationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/RemoveCredentialRotationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).RemoveCredentialRotationPolicy(ctx, req.(*RemoveCredentialRotationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_ListCredentialRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListCredentialRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/ListCredentialRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListCredentialRotations(ctx, req.(*ListCredentialRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_ListCredentialVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredentialVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).ListCredentialVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/ListCredentialVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).ListCredentialVersions(ctx, req.(*ListCredentialVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_RollbackCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).RollbackCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialService/RollbackCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).RollbackCredential(ctx, req.(*RollbackCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialService_ServiceDesc is the grpc.ServiceDesc for CredentialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +539,34 @@ var CredentialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredential",
 			Handler:    _CredentialService_DeleteCredential_Handler,
 		},
+		{
+			MethodName: "ListCredentialCheckouts",
+			Handler:    _CredentialService_ListCredentialCheckouts_Handler,
+		},
+		{
+			MethodName: "CheckInCredential",
+			Handler:    _CredentialService_CheckInCredential_Handler,
+		},
+		{
+			MethodName: "SetCredentialRotationPolicy",
+			Handler:    _CredentialService_SetCredentialRotationPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveCredentialRotationPolicy",
+			Handler:    _CredentialService_RemoveCredentialRotationPolicy_Handler,
+		},
+		{
+			MethodName: "ListCredentialRotations",
+			Handler:    _CredentialService_ListCredentialRotations_Handler,
+		},
+		{
+			MethodName: "ListCredentialVersions",
+			Handler:    _CredentialService_ListCredentialVersions_Handler,
+		},
+		{
+			MethodName: "RollbackCredential",
+			Handler:    _CredentialService_RollbackCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_service.proto",
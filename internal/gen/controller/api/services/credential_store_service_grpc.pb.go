@@ -44,6 +44,12 @@ type CredentialStoreServiceClient interface {
 	// DeleteCredentialStore removes a Credential Store from Boundary. If the Credential Store id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialStore(ctx context.Context, in *DeleteCredentialStoreRequest, opts ...grpc.CallOption) (*DeleteCredentialStoreResponse, error)
+	// RotateCredentialStoreToken replaces the Vault token of a Vault Credential
+	// Store. The new token is validated before it is used. The replaced token is
+	// revoked once all credentials issued with it have been revoked or have
+	// expired. If the Credential Store is not a Vault Credential Store an error
+	// is returned.
+	RotateCredentialStoreToken(ctx context.Context, in *RotateCredentialStoreTokenRequest, opts ...grpc.CallOption) (*RotateCredentialStoreTokenResponse, error)
 }
 
 type credentialStoreServiceClient struct {
@@ -99,6 +105,15 @@ func (c *credentialStoreServiceClient) DeleteCredentialStore(ctx context.Context
 	return out, nil
 }
 
+func (c *credentialStoreServiceClient) RotateCredentialStoreToken(ctx context.Context, in *RotateCredentialStoreTokenRequest, opts ...grpc.CallOption) (*RotateCredentialStoreTokenResponse, error) {
+	out := new(RotateCredentialStoreTokenResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.CredentialStoreService/RotateCredentialStoreToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialStoreServiceServer is the server API for CredentialStoreService service.
 // All implementations must embed UnimplementedCredentialStoreServiceServer
 // for forward compatibility
@@ -129,6 +144,12 @@ type CredentialStoreServiceServer interface {
 	// DeleteCredentialStore removes a Credential Store from Boundary. If the Credential Store id
 	// is malformed or not provided an error is returned.
 	DeleteCredentialStore(context.Context, *DeleteCredentialStoreRequest) (*DeleteCredentialStoreResponse, error)
+	// RotateCredentialStoreToken replaces the Vault token of a Vault Credential
+	// Store. The new token is validated before it is used. The replaced token is
+	// revoked once all credentials issued with it have been revoked or have
+	// expired. If the Credential Store is not a Vault Credential Store an error
+	// is returned.
+	RotateCredentialStoreToken(context.Context, *RotateCredentialStoreTokenRequest) (*RotateCredentialStoreTokenResponse, error)
 	mustEmbedUnimplementedCredentialStoreServiceServer()
 }
 
@@ -151,6 +172,9 @@ func (UnimplementedCredentialStoreServiceServer) UpdateCredentialStore(context.C
 func (UnimplementedCredentialStoreServiceServer) DeleteCredentialStore(context.Context, *DeleteCredentialStoreRequest) (*DeleteCredentialStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredentialStore not implemented")
 }
+func (UnimplementedCredentialStoreServiceServer) RotateCredentialStoreToken(context.Context, *RotateCredentialStoreTokenRequest) (*RotateCredentialStoreTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredentialStoreToken not implemented")
+}
 func (UnimplementedCredentialStoreServiceServer) mustEmbedUnimplementedCredentialStoreServiceServer() {
 }
 
@@ -255,6 +279,24 @@ func _CredentialStoreService_DeleteCredentialStore_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialStoreService_RotateCredentialStoreToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialStoreTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialStoreServiceServer).RotateCredentialStoreToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.CredentialStoreService/RotateCredentialStoreToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialStoreServiceServer).RotateCredentialStoreToken(ctx, req.(*RotateCredentialStoreTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialStoreService_ServiceDesc is the grpc.ServiceDesc for CredentialStoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +324,10 @@ var CredentialStoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredentialStore",
 			Handler:    _CredentialStoreService_DeleteCredentialStore_Handler,
 		},
+		{
+			MethodName: "RotateCredentialStoreToken",
+			Handler:    _CredentialStoreService_RotateCredentialStoreToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_store_service.proto",
@@ -44,6 +44,18 @@ type HostCatalogServiceClient interface {
 	// sets from Boundary. If the provided Host Catalog IDs is malformed or not
 	// provided DeleteHostCatalog returns an error.
 	DeleteHostCatalog(ctx context.Context, in *DeleteHostCatalogRequest, opts ...grpc.CallOption) (*DeleteHostCatalogResponse, error)
+	// ImportHosts creates or updates Hosts of a static Host Catalog and adds
+	// them to Host Sets in a single transaction. Hosts are matched to the
+	// existing Hosts of the Host Catalog by name. If any of the Hosts cannot be
+	// imported no changes are made. The result of each Host is returned. If the
+	// Host Catalog is not a static Host Catalog an error is returned.
+	ImportHosts(ctx context.Context, in *ImportHostsRequest, opts ...grpc.CallOption) (*ImportHostsResponse, error)
+	// SyncHostCatalog requests an immediate sync of the Hosts of all the Host
+	// Sets of the specified plugin Host Catalog. The syncs run in the
+	// background and their outcome is reported in the sync_status of each Host
+	// Set. If the Host Catalog is not a plugin Host Catalog an error is
+	// returned.
+	SyncHostCatalog(ctx context.Context, in *SyncHostCatalogRequest, opts ...grpc.CallOption) (*SyncHostCatalogResponse, error)
 }
 
 type hostCatalogServiceClient struct {
@@ -99,6 +111,24 @@ func (c *hostCatalogServiceClient) DeleteHostCatalog(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *hostCatalogServiceClient) ImportHosts(ctx context.Context, in *ImportHostsRequest, opts ...grpc.CallOption) (*ImportHostsResponse, error) {
+	out := new(ImportHostsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostCatalogService/ImportHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostCatalogServiceClient) SyncHostCatalog(ctx context.Context, in *SyncHostCatalogRequest, opts ...grpc.CallOption) (*SyncHostCatalogResponse, error) {
+	out := new(SyncHostCatalogResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostCatalogService/SyncHostCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HostCatalogServiceServer is the server API for HostCatalogService service.
 // All implementations must embed UnimplementedHostCatalogServiceServer
 // for forward compatibility
@@ -129,6 +159,18 @@ type HostCatalogServiceServer interface {
 	// sets from Boundary. If the provided Host Catalog IDs is malformed or not
 	// provided DeleteHostCatalog returns an error.
 	DeleteHostCatalog(context.Context, *DeleteHostCatalogRequest) (*DeleteHostCatalogResponse, error)
+	// ImportHosts creates or updates Hosts of a static Host Catalog and adds
+	// them to Host Sets in a single transaction. Hosts are matched to the
+	// existing Hosts of the Host Catalog by name. If any of the Hosts cannot be
+	// imported no changes are made. The result of each Host is returned. If the
+	// Host Catalog is not a static Host Catalog an error is returned.
+	ImportHosts(context.Context, *ImportHostsRequest) (*ImportHostsResponse, error)
+	// SyncHostCatalog requests an immediate sync of the Hosts of all the Host
+	// Sets of the specified plugin Host Catalog. The syncs run in the
+	// background and their outcome is reported in the sync_status of each Host
+	// Set. If the Host Catalog is not a plugin Host Catalog an error is
+	// returned.
+	SyncHostCatalog(context.Context, *SyncHostCatalogRequest) (*SyncHostCatalogResponse, error)
 	mustEmbedUnimplementedHostCatalogServiceServer()
 }
 
@@ -151,6 +193,12 @@ func (UnimplementedHostCatalogServiceServer) UpdateHostCatalog(context.Context,
 func (UnimplementedHostCatalogServiceServer) DeleteHostCatalog(context.Context, *DeleteHostCatalogRequest) (*DeleteHostCatalogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostCatalog not implemented")
 }
+func (UnimplementedHostCatalogServiceServer) ImportHosts(context.Context, *ImportHostsRequest) (*ImportHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHosts not implemented")
+}
+func (UnimplementedHostCatalogServiceServer) SyncHostCatalog(context.Context, *SyncHostCatalogRequest) (*SyncHostCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncHostCatalog not implemented")
+}
 func (UnimplementedHostCatalogServiceServer) mustEmbedUnimplementedHostCatalogServiceServer() {}
 
 // UnsafeHostCatalogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +302,42 @@ func _HostCatalogService_DeleteHostCatalog_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostCatalogService_ImportHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogServiceServer).ImportHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostCatalogService/ImportHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogServiceServer).ImportHosts(ctx, req.(*ImportHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostCatalogService_SyncHostCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncHostCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogServiceServer).SyncHostCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostCatalogService/SyncHostCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogServiceServer).SyncHostCatalog(ctx, req.(*SyncHostCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HostCatalogService_ServiceDesc is the grpc.ServiceDesc for HostCatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +365,14 @@ var HostCatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHostCatalog",
 			Handler:    _HostCatalogService_DeleteHostCatalog_Handler,
 		},
+		{
+			MethodName: "ImportHosts",
+			Handler:    _HostCatalogService_ImportHosts_Handler,
+		},
+		{
+			MethodName: "SyncHostCatalog",
+			Handler:    _HostCatalogService_SyncHostCatalog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/host_catalog_service.proto",
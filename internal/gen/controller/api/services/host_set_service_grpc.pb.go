@@ -66,6 +66,30 @@ type HostSetServiceClient interface {
 	// or references a non-existing scope or catalog, or if a Host id is included
 	// which is not in the provided Host Set.
 	RemoveHostSetHosts(ctx context.Context, in *RemoveHostSetHostsRequest, opts ...grpc.CallOption) (*RemoveHostSetHostsResponse, error)
+	// GetHostSetHistory returns the ordered change history of the specified
+	// Host Set, derived from the oplog. Each record includes the field-level
+	// changes made, the time they were made and the acting User, if known.
+	GetHostSetHistory(ctx context.Context, in *GetHostSetHistoryRequest, opts ...grpc.CallOption) (*GetHostSetHistoryResponse, error)
+	// RestoreHostSet restores the specified Host Set to its state at the
+	// provided time, as reconstructed from the oplog. A Host Set which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Host Set depended on no longer exists.
+	RestoreHostSet(ctx context.Context, in *RestoreHostSetRequest, opts ...grpc.CallOption) (*RestoreHostSetResponse, error)
+	// SetHostSetHealthCheck sets the health check run by workers against the
+	// Hosts of the specified Host Set, replacing any existing health check.
+	// The checks are run by the workers which can handle Sessions for the
+	// Targets using the Host Set, and Hosts which fail them are not used for
+	// new Sessions.
+	SetHostSetHealthCheck(ctx context.Context, in *SetHostSetHealthCheckRequest, opts ...grpc.CallOption) (*SetHostSetHealthCheckResponse, error)
+	// RemoveHostSetHealthCheck removes the health check of the specified Host
+	// Set along with the health of its Hosts.
+	RemoveHostSetHealthCheck(ctx context.Context, in *RemoveHostSetHealthCheckRequest, opts ...grpc.CallOption) (*RemoveHostSetHealthCheckResponse, error)
+	// SyncHostSet requests an immediate sync of the Hosts of the specified
+	// plugin Host Set from its plugin. The sync runs in the background and its
+	// outcome is reported in the sync_status of the Host Set. If the Host Set
+	// is not a plugin Host Set an error is returned.
+	SyncHostSet(ctx context.Context, in *SyncHostSetRequest, opts ...grpc.CallOption) (*SyncHostSetResponse, error)
 }
 
 type hostSetServiceClient struct {
@@ -148,6 +172,51 @@ func (c *hostSetServiceClient) RemoveHostSetHosts(ctx context.Context, in *Remov
 	return out, nil
 }
 
+func (c *hostSetServiceClient) GetHostSetHistory(ctx context.Context, in *GetHostSetHistoryRequest, opts ...grpc.CallOption) (*GetHostSetHistoryResponse, error) {
+	out := new(GetHostSetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostSetService/GetHostSetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostSetServiceClient) RestoreHostSet(ctx context.Context, in *RestoreHostSetRequest, opts ...grpc.CallOption) (*RestoreHostSetResponse, error) {
+	out := new(RestoreHostSetResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostSetService/RestoreHostSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostSetServiceClient) SetHostSetHealthCheck(ctx context.Context, in *SetHostSetHealthCheckRequest, opts ...grpc.CallOption) (*SetHostSetHealthCheckResponse, error) {
+	out := new(SetHostSetHealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostSetService/SetHostSetHealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostSetServiceClient) RemoveHostSetHealthCheck(ctx context.Context, in *RemoveHostSetHealthCheckRequest, opts ...grpc.CallOption) (*RemoveHostSetHealthCheckResponse, error) {
+	out := new(RemoveHostSetHealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostSetService/RemoveHostSetHealthCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostSetServiceClient) SyncHostSet(ctx context.Context, in *SyncHostSetRequest, opts ...grpc.CallOption) (*SyncHostSetResponse, error) {
+	out := new(SyncHostSetResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.HostSetService/SyncHostSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HostSetServiceServer is the server API for HostSetService service.
 // All implementations must embed UnimplementedHostSetServiceServer
 // for forward compatibility
@@ -200,6 +269,30 @@ type HostSetServiceServer interface {
 	// or references a non-existing scope or catalog, or if a Host id is included
 	// which is not in the provided Host Set.
 	RemoveHostSetHosts(context.Context, *RemoveHostSetHostsRequest) (*RemoveHostSetHostsResponse, error)
+	// GetHostSetHistory returns the ordered change history of the specified
+	// Host Set, derived from the oplog. Each record includes the field-level
+	// changes made, the time they were made and the acting User, if known.
+	GetHostSetHistory(context.Context, *GetHostSetHistoryRequest) (*GetHostSetHistoryResponse, error)
+	// RestoreHostSet restores the specified Host Set to its state at the
+	// provided time, as reconstructed from the oplog. A Host Set which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Host Set depended on no longer exists.
+	RestoreHostSet(context.Context, *RestoreHostSetRequest) (*RestoreHostSetResponse, error)
+	// SetHostSetHealthCheck sets the health check run by workers against the
+	// Hosts of the specified Host Set, replacing any existing health check.
+	// The checks are run by the workers which can handle Sessions for the
+	// Targets using the Host Set, and Hosts which fail them are not used for
+	// new Sessions.
+	SetHostSetHealthCheck(context.Context, *SetHostSetHealthCheckRequest) (*SetHostSetHealthCheckResponse, error)
+	// RemoveHostSetHealthCheck removes the health check of the specified Host
+	// Set along with the health of its Hosts.
+	RemoveHostSetHealthCheck(context.Context, *RemoveHostSetHealthCheckRequest) (*RemoveHostSetHealthCheckResponse, error)
+	// SyncHostSet requests an immediate sync of the Hosts of the specified
+	// plugin Host Set from its plugin. The sync runs in the background and its
+	// outcome is reported in the sync_status of the Host Set. If the Host Set
+	// is not a plugin Host Set an error is returned.
+	SyncHostSet(context.Context, *SyncHostSetRequest) (*SyncHostSetResponse, error)
 	mustEmbedUnimplementedHostSetServiceServer()
 }
 
@@ -231,6 +324,21 @@ func (UnimplementedHostSetServiceServer) SetHostSetHosts(context.Context, *SetHo
 func (UnimplementedHostSetServiceServer) RemoveHostSetHosts(context.Context, *RemoveHostSetHostsRequest) (*RemoveHostSetHostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveHostSetHosts not implemented")
 }
+func (UnimplementedHostSetServiceServer) GetHostSetHistory(context.Context, *GetHostSetHistoryRequest) (*GetHostSetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostSetHistory not implemented")
+}
+func (UnimplementedHostSetServiceServer) RestoreHostSet(context.Context, *RestoreHostSetRequest) (*RestoreHostSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreHostSet not implemented")
+}
+func (UnimplementedHostSetServiceServer) SetHostSetHealthCheck(context.Context, *SetHostSetHealthCheckRequest) (*SetHostSetHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHostSetHealthCheck not implemented")
+}
+func (UnimplementedHostSetServiceServer) RemoveHostSetHealthCheck(context.Context, *RemoveHostSetHealthCheckRequest) (*RemoveHostSetHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHostSetHealthCheck not implemented")
+}
+func (UnimplementedHostSetServiceServer) SyncHostSet(context.Context, *SyncHostSetRequest) (*SyncHostSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncHostSet not implemented")
+}
 func (UnimplementedHostSetServiceServer) mustEmbedUnimplementedHostSetServiceServer() {}
 
 // UnsafeHostSetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -388,6 +496,96 @@ func _HostSetService_RemoveHostSetHosts_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostSetService_GetHostSetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostSetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostSetServiceServer).GetHostSetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostSetService/GetHostSetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostSetServiceServer).GetHostSetHistory(ctx, req.(*GetHostSetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostSetService_RestoreHostSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreHostSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostSetServiceServer).RestoreHostSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostSetService/RestoreHostSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostSetServiceServer).RestoreHostSet(ctx, req.(*RestoreHostSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostSetService_SetHostSetHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHostSetHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostSetServiceServer).SetHostSetHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostSetService/SetHostSetHealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostSetServiceServer).SetHostSetHealthCheck(ctx, req.(*SetHostSetHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostSetService_RemoveHostSetHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHostSetHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostSetServiceServer).RemoveHostSetHealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostSetService/RemoveHostSetHealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostSetServiceServer).RemoveHostSetHealthCheck(ctx, req.(*RemoveHostSetHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostSetService_SyncHostSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncHostSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostSetServiceServer).SyncHostSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.HostSetService/SyncHostSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostSetServiceServer).SyncHostSet(ctx, req.(*SyncHostSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HostSetService_ServiceDesc is the grpc.ServiceDesc for HostSetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -427,6 +625,26 @@ var HostSetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveHostSetHosts",
 			Handler:    _HostSetService_RemoveHostSetHosts_Handler,
 		},
+		{
+			MethodName: "GetHostSetHistory",
+			Handler:    _HostSetService_GetHostSetHistory_Handler,
+		},
+		{
+			MethodName: "RestoreHostSet",
+			Handler:    _HostSetService_RestoreHostSet_Handler,
+		},
+		{
+			MethodName: "SetHostSetHealthCheck",
+			Handler:    _HostSetService_SetHostSetHealthCheck_Handler,
+		},
+		{
+			MethodName: "RemoveHostSetHealthCheck",
+			Handler:    _HostSetService_RemoveHostSetHealthCheck_Handler,
+		},
+		{
+			MethodName: "SyncHostSet",
+			Handler:    _HostSetService_SyncHostSet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/host_set_service.proto",
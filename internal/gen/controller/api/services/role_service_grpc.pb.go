@@ -79,6 +79,16 @@ type RoleServiceClient interface {
 	// grants will be removed. If missing, malformed, or references a non-existing
 	// resource, an error is returned.
 	RemoveRoleGrants(ctx context.Context, in *RemoveRoleGrantsRequest, opts ...grpc.CallOption) (*RemoveRoleGrantsResponse, error)
+	// GetRoleHistory returns the ordered change history of the specified Role,
+	// derived from the oplog. Each record includes the field-level changes
+	// made, the time they were made and the acting User, if known.
+	GetRoleHistory(ctx context.Context, in *GetRoleHistoryRequest, opts ...grpc.CallOption) (*GetRoleHistoryResponse, error)
+	// RestoreRole restores the specified Role to its state at the
+	// provided time, as reconstructed from the oplog. A Role which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Role depended on no longer exists.
+	RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...grpc.CallOption) (*RestoreRoleResponse, error)
 }
 
 type roleServiceClient struct {
@@ -188,6 +198,24 @@ func (c *roleServiceClient) RemoveRoleGrants(ctx context.Context, in *RemoveRole
 	return out, nil
 }
 
+func (c *roleServiceClient) GetRoleHistory(ctx context.Context, in *GetRoleHistoryRequest, opts ...grpc.CallOption) (*GetRoleHistoryResponse, error) {
+	out := new(GetRoleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.RoleService/GetRoleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) RestoreRole(ctx context.Context, in *RestoreRoleRequest, opts ...grpc.CallOption) (*RestoreRoleResponse, error) {
+	out := new(RestoreRoleResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.RoleService/RestoreRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility
@@ -253,6 +281,16 @@ type RoleServiceServer interface {
 	// grants will be removed. If missing, malformed, or references a non-existing
 	// resource, an error is returned.
 	RemoveRoleGrants(context.Context, *RemoveRoleGrantsRequest) (*RemoveRoleGrantsResponse, error)
+	// GetRoleHistory returns the ordered change history of the specified Role,
+	// derived from the oplog. Each record includes the field-level changes
+	// made, the time they were made and the acting User, if known.
+	GetRoleHistory(context.Context, *GetRoleHistoryRequest) (*GetRoleHistoryResponse, error)
+	// RestoreRole restores the specified Role to its state at the
+	// provided time, as reconstructed from the oplog. A Role which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Role depended on no longer exists.
+	RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -293,6 +331,12 @@ func (UnimplementedRoleServiceServer) SetRoleGrants(context.Context, *SetRoleGra
 func (UnimplementedRoleServiceServer) RemoveRoleGrants(context.Context, *RemoveRoleGrantsRequest) (*RemoveRoleGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleGrants not implemented")
 }
+func (UnimplementedRoleServiceServer) GetRoleHistory(context.Context, *GetRoleHistoryRequest) (*GetRoleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleHistory not implemented")
+}
+func (UnimplementedRoleServiceServer) RestoreRole(context.Context, *RestoreRoleRequest) (*RestoreRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRole not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 
 // UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +548,42 @@ func _RoleService_RemoveRoleGrants_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_GetRoleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).GetRoleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.RoleService/GetRoleHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).GetRoleHistory(ctx, req.(*GetRoleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_RestoreRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RestoreRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.RoleService/RestoreRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RestoreRole(ctx, req.(*RestoreRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -555,6 +635,14 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRoleGrants",
 			Handler:    _RoleService_RemoveRoleGrants_Handler,
 		},
+		{
+			MethodName: "GetRoleHistory",
+			Handler:    _RoleService_GetRoleHistory_Handler,
+		},
+		{
+			MethodName: "RestoreRole",
+			Handler:    _RoleService_RestoreRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/role_service.proto",
@@ -97,6 +97,16 @@ type TargetServiceClient interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(ctx context.Context, in *RemoveTargetCredentialSourcesRequest, opts ...grpc.CallOption) (*RemoveTargetCredentialSourcesResponse, error)
+	// GetTargetHistory returns the ordered change history of the specified
+	// Target, derived from the oplog. Each record includes the field-level
+	// changes made, the time they were made and the acting User, if known.
+	GetTargetHistory(ctx context.Context, in *GetTargetHistoryRequest, opts ...grpc.CallOption) (*GetTargetHistoryResponse, error)
+	// RestoreTarget restores the specified Target to its state at the
+	// provided time, as reconstructed from the oplog. A Target which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Target depended on no longer exists.
+	RestoreTarget(ctx context.Context, in *RestoreTargetRequest, opts ...grpc.CallOption) (*RestoreTargetResponse, error)
 }
 
 type targetServiceClient struct {
@@ -215,6 +225,24 @@ func (c *targetServiceClient) RemoveTargetCredentialSources(ctx context.Context,
 	return out, nil
 }
 
+func (c *targetServiceClient) GetTargetHistory(ctx context.Context, in *GetTargetHistoryRequest, opts ...grpc.CallOption) (*GetTargetHistoryResponse, error) {
+	out := new(GetTargetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/GetTargetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *targetServiceClient) RestoreTarget(ctx context.Context, in *RestoreTargetRequest, opts ...grpc.CallOption) (*RestoreTargetResponse, error) {
+	out := new(RestoreTargetResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.TargetService/RestoreTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TargetServiceServer is the server API for TargetService service.
 // All implementations must embed UnimplementedTargetServiceServer
 // for forward compatibility
@@ -298,6 +326,16 @@ type TargetServiceServer interface {
 	// Credential Source is attempted to be removed from the Target when the
 	// Target does not have the Credential Source.
 	RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error)
+	// GetTargetHistory returns the ordered change history of the specified
+	// Target, derived from the oplog. Each record includes the field-level
+	// changes made, the time they were made and the acting User, if known.
+	GetTargetHistory(context.Context, *GetTargetHistoryRequest) (*GetTargetHistoryResponse, error)
+	// RestoreTarget restores the specified Target to its state at the
+	// provided time, as reconstructed from the oplog. A Target which has
+	// since been deleted is recreated with the same id. The changes needed
+	// to restore it are returned and, if dry_run is set, not applied. An error
+	// is returned if a resource the Target depended on no longer exists.
+	RestoreTarget(context.Context, *RestoreTargetRequest) (*RestoreTargetResponse, error)
 	mustEmbedUnimplementedTargetServiceServer()
 }
 
@@ -341,6 +379,12 @@ func (UnimplementedTargetServiceServer) SetTargetCredentialSources(context.Conte
 func (UnimplementedTargetServiceServer) RemoveTargetCredentialSources(context.Context, *RemoveTargetCredentialSourcesRequest) (*RemoveTargetCredentialSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTargetCredentialSources not implemented")
 }
+func (UnimplementedTargetServiceServer) GetTargetHistory(context.Context, *GetTargetHistoryRequest) (*GetTargetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetHistory not implemented")
+}
+func (UnimplementedTargetServiceServer) RestoreTarget(context.Context, *RestoreTargetRequest) (*RestoreTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTarget not implemented")
+}
 func (UnimplementedTargetServiceServer) mustEmbedUnimplementedTargetServiceServer() {}
 
 // UnsafeTargetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -570,6 +614,42 @@ func _TargetService_RemoveTargetCredentialSources_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TargetService_GetTargetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).GetTargetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/GetTargetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).GetTargetHistory(ctx, req.(*GetTargetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TargetService_RestoreTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TargetServiceServer).RestoreTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.TargetService/RestoreTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TargetServiceServer).RestoreTarget(ctx, req.(*RestoreTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TargetService_ServiceDesc is the grpc.ServiceDesc for TargetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -625,6 +705,14 @@ var TargetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTargetCredentialSources",
 			Handler:    _TargetService_RemoveTargetCredentialSources_Handler,
 		},
+		{
+			MethodName: "GetTargetHistory",
+			Handler:    _TargetService_GetTargetHistory_Handler,
+		},
+		{
+			MethodName: "RestoreTarget",
+			Handler:    _TargetService_RestoreTarget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/target_service.proto",
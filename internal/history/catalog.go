@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history
+
+import (
+	"context"
+
+	awsstore "github.com/hashicorp/boundary/internal/credential/aws/store"
+	kubernetesstore "github.com/hashicorp/boundary/internal/credential/kubernetes/store"
+	credpluginstore "github.com/hashicorp/boundary/internal/credential/plugin/store"
+	vaultstore "github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	dnsstore "github.com/hashicorp/boundary/internal/host/dns/store"
+	hostpluginstore "github.com/hashicorp/boundary/internal/host/plugin/store"
+	staticstore "github.com/hashicorp/boundary/internal/host/static/store"
+	hoststore "github.com/hashicorp/boundary/internal/host/store"
+	iamstore "github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	targetstore "github.com/hashicorp/boundary/internal/target/store"
+	tcpstore "github.com/hashicorp/boundary/internal/target/tcp/store"
+)
+
+// catalogTypes are the oplog types which can be written for the resources
+// supporting history. The names are the table names used as the oplog type
+// name when the entries were written.
+var catalogTypes = []oplog.Type{
+	// targets
+	{Interface: new(tcpstore.Target), Name: "target_tcp"},
+	{Interface: new(targetstore.TargetHostSet), Name: "target_host_set"},
+	{Interface: new(targetstore.TargetAddress), Name: "target_address"},
+	{Interface: new(targetstore.TargetPort), Name: "target_port"},
+	{Interface: new(targetstore.CredentialLibrary), Name: "target_credential_library"},
+	{Interface: new(targetstore.StaticCredential), Name: "target_static_credential"},
+
+	// roles
+	{Interface: new(iamstore.Role), Name: "iam_role"},
+	{Interface: new(iamstore.RoleGrant), Name: "iam_role_grant"},
+	{Interface: new(iamstore.UserRole), Name: "iam_user_role"},
+	{Interface: new(iamstore.GroupRole), Name: "iam_group_role"},
+	{Interface: new(iamstore.ManagedGroupRole), Name: "iam_managed_group_role"},
+
+	// host sets
+	{Interface: new(staticstore.HostSet), Name: "static_host_set"},
+	{Interface: new(staticstore.HostSetMember), Name: "static_host_set_member"},
+	{Interface: new(hostpluginstore.HostSet), Name: "host_plugin_set"},
+	{Interface: new(hoststore.PreferredEndpoint), Name: "host_set_preferred_endpoint"},
+	{Interface: new(dnsstore.HostSet), Name: "host_dns_set"},
+	{Interface: new(dnsstore.HostSetName), Name: "host_dns_set_name"},
+
+	// vault credential libraries
+	{Interface: new(vaultstore.CredentialLibrary), Name: "credential_vault_library"},
+	{Interface: new(vaultstore.UsernamePasswordOverride), Name: "credential_vault_library_username_password_mapping_override"},
+	{Interface: new(vaultstore.SshPrivateKeyOverride), Name: "credential_vault_library_ssh_private_key_mapping_override"},
+	{Interface: new(vaultstore.TlsClientCertificateOverride), Name: "credential_vault_library_tls_client_certificate_mapping_override"},
+	{Interface: new(vaultstore.SSHCertificateCredentialLibrary), Name: "credential_vault_ssh_cert_library"},
+	{Interface: new(vaultstore.PkiCredentialLibrary), Name: "credential_vault_pki_library"},
+
+	// other credential libraries
+	{Interface: new(credpluginstore.CredentialLibrary), Name: "credential_plugin_library"},
+	{Interface: new(kubernetesstore.CredentialLibrary), Name: "credential_kubernetes_library"},
+	{Interface: new(awsstore.CredentialLibrary), Name: "credential_aws_library"},
+}
+
+// newTypeCatalog returns a type catalog for decoding the oplog entries of
+// the resources supporting history.
+func newTypeCatalog(ctx context.Context) (*oplog.TypeCatalog, error) {
+	const op = "history.newTypeCatalog"
+	types, err := oplog.NewTypeCatalog(ctx, catalogTypes...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return types, nil
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package history provides the ability to derive the change history of a
+// resource from the oplog. Oplog entries written for a resource are
+// decrypted with the oplog key of their scope, decoded with a type catalog
+// and replayed in memory to produce ordered, field-level changes.
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// RedactedValue is used in place of the value of fields which may
+	// contain secret material, such as encrypted values.
+	RedactedValue = "<redacted>"
+
+	publicIdField = "public_id"
+)
+
+// keyFields are the fields, in addition to those ending in "_id", which are
+// used to identify items that don't have a public id.
+var keyFields = map[string]bool{
+	"credential_purpose": true,
+	"canonical_grant":    true,
+	"condition":          true,
+	"name":               true,
+}
+
+// Record is the set of changes made to a resource by a single oplog entry.
+type Record struct {
+	// Id is the id of the oplog entry.
+	Id string
+	// CreateTime is the time the oplog entry was written.
+	CreateTime time.Time
+	// UserId is the id of the user that made the change, if known.
+	UserId string
+	// Changes are the ordered field-level changes made by the entry.
+	Changes []*Change
+}
+
+// Change is a single field-level change made to an item.
+type Change struct {
+	// Type is the oplog type name of the item, which is its table name.
+	Type string
+	// Key identifies the item; typically its public id.
+	Key string
+	// Operation is one of "create", "update" or "delete".
+	Operation string
+	// Field is the name of the changed field.
+	Field string
+	// OldValue is the value before the change, if known.
+	OldValue string
+	// NewValue is the value after the change.
+	NewValue string
+}
+
+// differ replays oplog messages in memory, tracking the last known state of
+// every item so that updates and deletes can report their previous values.
+// Items are tracked by their type name and key.
+type differ struct {
+	// root is the public id of the resource being replayed. When the root
+	// item is deleted the state of all other items is discarded, since the
+	// database cascades the delete to them without writing oplog entries.
+	// The last known state of the root item itself is kept so that a
+	// deleted resource can be identified.
+	root        string
+	rootDeleted bool
+	state       map[string]map[string]proto.Message
+}
+
+func newDiffer(root string) *differ {
+	return &differ{
+		root:  root,
+		state: map[string]map[string]proto.Message{},
+	}
+}
+
+func (d *differ) get(typeName, key string) proto.Message {
+	return d.state[typeName][key]
+}
+
+func (d *differ) set(typeName, key string, m proto.Message) {
+	if d.state[typeName] == nil {
+		d.state[typeName] = map[string]proto.Message{}
+	}
+	d.state[typeName][key] = m
+}
+
+// apply returns the changes made by msg and records its effect on the
+// known state.
+func (d *differ) apply(ctx context.Context, msg oplog.Message) ([]*Change, error) {
+	const op = "history.(differ).apply"
+	if msg.Message == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing message")
+	}
+	key := itemKey(msg.Message)
+	prev := d.get(msg.TypeName, key)
+	operation := operationName(msg.OpType)
+
+	newChange := func(fd protoreflect.FieldDescriptor) *Change {
+		return &Change{
+			Type:      msg.TypeName,
+			Key:       key,
+			Operation: operation,
+			Field:     string(fd.Name()),
+		}
+	}
+
+	var changes []*Change
+	fields := msg.Message.ProtoReflect().Descriptor().Fields()
+	switch msg.OpType {
+	case oplog.OpType_OP_TYPE_CREATE:
+		m := msg.Message.ProtoReflect()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !m.Has(fd) {
+				continue
+			}
+			c := newChange(fd)
+			c.NewValue = formatValue(fd, m.Get(fd))
+			changes = append(changes, c)
+		}
+		d.set(msg.TypeName, key, proto.Clone(msg.Message))
+		if d.root != "" && key == d.root {
+			d.rootDeleted = false
+		}
+
+	case oplog.OpType_OP_TYPE_UPDATE:
+		m := msg.Message.ProtoReflect()
+		paths := make([]string, 0, len(msg.FieldMaskPaths)+len(msg.SetToNullPaths))
+		paths = append(paths, msg.FieldMaskPaths...)
+		paths = append(paths, msg.SetToNullPaths...)
+		if len(paths) == 0 {
+			// Without a mask every populated field is considered updated.
+			for i := 0; i < fields.Len(); i++ {
+				if m.Has(fields.Get(i)) {
+					paths = append(paths, string(fields.Get(i).Name()))
+				}
+			}
+		}
+		var next protoreflect.Message
+		if prev != nil {
+			next = proto.Clone(prev).ProtoReflect()
+		} else {
+			next = proto.Clone(msg.Message).ProtoReflect()
+		}
+		for _, p := range paths {
+			fd := findField(fields, p)
+			if fd == nil {
+				continue
+			}
+			c := newChange(fd)
+			if prev != nil && prev.ProtoReflect().Has(fd) {
+				c.OldValue = formatValue(fd, prev.ProtoReflect().Get(fd))
+			}
+			if m.Has(fd) {
+				c.NewValue = formatValue(fd, m.Get(fd))
+				next.Set(fd, m.Get(fd))
+			} else {
+				next.Clear(fd)
+			}
+			if prev != nil && c.OldValue == c.NewValue {
+				continue
+			}
+			changes = append(changes, c)
+		}
+		d.set(msg.TypeName, key, next.Interface())
+
+	case oplog.OpType_OP_TYPE_DELETE:
+		old := msg.Message.ProtoReflect()
+		if prev != nil {
+			old = prev.ProtoReflect()
+		}
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !old.Has(fd) {
+				continue
+			}
+			c := newChange(fd)
+			c.OldValue = formatValue(fd, old.Get(fd))
+			changes = append(changes, c)
+		}
+		if d.root != "" && key == d.root {
+			d.state = map[string]map[string]proto.Message{
+				msg.TypeName: {key: old.Interface()},
+			}
+			d.rootDeleted = true
+			break
+		}
+		delete(d.state[msg.TypeName], key)
+
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported op type %q for %s", msg.OpType.String(), msg.TypeName))
+	}
+	return changes, nil
+}
+
+// itemKey returns the public id of m or, when it doesn't have one, the
+// colon-separated values of its key fields in field number order.
+func itemKey(m proto.Message) string {
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	if fd := fields.ByName(publicIdField); fd != nil && r.Has(fd) {
+		return r.Get(fd).String()
+	}
+	var parts []string
+	sorted := make([]protoreflect.FieldDescriptor, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		sorted = append(sorted, fields.Get(i))
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number() < sorted[j].Number() })
+	for _, fd := range sorted {
+		if fd.Kind() != protoreflect.StringKind || fd.IsList() || !r.Has(fd) {
+			continue
+		}
+		name := string(fd.Name())
+		if strings.HasSuffix(name, "_id") || keyFields[name] {
+			parts = append(parts, r.Get(fd).String())
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// findField returns the field matching the field mask path p. Repositories
+// use Go field names for masks (e.g. "SessionMaxSeconds"), so the comparison
+// ignores case and underscores.
+func findField(fields protoreflect.FieldDescriptors, p string) protoreflect.FieldDescriptor {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+	}
+	want := normalize(p)
+	for i := 0; i < fields.Len(); i++ {
+		if normalize(string(fields.Get(i).Name())) == want {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+// formatValue renders v for display. Bytes fields are always redacted since
+// they are used to store encrypted values and key material.
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch {
+	case fd.Kind() == protoreflect.BytesKind:
+		return RedactedValue
+	case fd.IsList(), fd.IsMap():
+		return fmt.Sprint(v.Interface())
+	case fd.Kind() == protoreflect.MessageKind:
+		if ts, ok := v.Message().Interface().(*timestamp.Timestamp); ok {
+			return ts.AsTime().Format(time.RFC3339Nano)
+		}
+		b, err := protojson.Marshal(v.Message().Interface())
+		if err != nil {
+			return fmt.Sprint(v.Interface())
+		}
+		return string(b)
+	default:
+		return v.String()
+	}
+}
+
+// operationName returns the short, lower-case name of an oplog op type.
+func operationName(t oplog.OpType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "OP_TYPE_"))
+}
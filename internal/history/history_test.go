@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	hoststore "github.com/hashicorp/boundary/internal/host/store"
+	iamstore "github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	targetstore "github.com/hashicorp/boundary/internal/target/store"
+	tcpstore "github.com/hashicorp/boundary/internal/target/tcp/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDiffer_apply(t *testing.T) {
+	ctx := context.Background()
+	d := newDiffer("ttcp_1234567890")
+
+	create := oplog.Message{
+		Message: &tcpstore.Target{
+			PublicId:    "ttcp_1234567890",
+			ProjectId:   "p_1234567890",
+			Name:        "web",
+			DefaultPort: 22,
+		},
+		TypeName: "target_tcp",
+		OpType:   oplog.OpType_OP_TYPE_CREATE,
+	}
+	got, err := d.apply(ctx, create)
+	require.NoError(t, err)
+	assert.Equal(t, []*Change{
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "create", Field: "public_id", NewValue: "ttcp_1234567890"},
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "create", Field: "project_id", NewValue: "p_1234567890"},
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "create", Field: "name", NewValue: "web"},
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "create", Field: "default_port", NewValue: "22"},
+	}, got)
+
+	_, err = d.apply(ctx, oplog.Message{
+		Message:  &targetstore.TargetHostSet{TargetId: "ttcp_1234567890", HostSetId: "hsst_1234567890"},
+		TypeName: "target_host_set",
+		OpType:   oplog.OpType_OP_TYPE_CREATE,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, d.get("target_host_set", "ttcp_1234567890:hsst_1234567890"))
+
+	update := oplog.Message{
+		Message: &tcpstore.Target{
+			PublicId:    "ttcp_1234567890",
+			ProjectId:   "p_1234567890",
+			Name:        "web",
+			DefaultPort: 2222,
+		},
+		TypeName:       "target_tcp",
+		OpType:         oplog.OpType_OP_TYPE_UPDATE,
+		FieldMaskPaths: []string{"Name", "DefaultPort"},
+		SetToNullPaths: []string{"Description"},
+	}
+	got, err = d.apply(ctx, update)
+	require.NoError(t, err)
+	assert.Equal(t, []*Change{
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "update", Field: "default_port", OldValue: "22", NewValue: "2222"},
+	}, got, "unchanged fields should be omitted")
+
+	del := oplog.Message{
+		Message:  &tcpstore.Target{PublicId: "ttcp_1234567890"},
+		TypeName: "target_tcp",
+		OpType:   oplog.OpType_OP_TYPE_DELETE,
+	}
+	got, err = d.apply(ctx, del)
+	require.NoError(t, err)
+	assert.Equal(t, []*Change{
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "delete", Field: "public_id", OldValue: "ttcp_1234567890"},
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "delete", Field: "project_id", OldValue: "p_1234567890"},
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "delete", Field: "name", OldValue: "web"},
+		{Type: "target_tcp", Key: "ttcp_1234567890", Operation: "delete", Field: "default_port", OldValue: "2222"},
+	}, got, "delete should report the last known values")
+	assert.Nil(t, d.get("target_host_set", "ttcp_1234567890:hsst_1234567890"), "deleting the root should discard associated items")
+
+	_, err = d.apply(ctx, oplog.Message{Message: &tcpstore.Target{}, TypeName: "target_tcp"})
+	require.Error(t, err)
+}
+
+func TestItemKey(t *testing.T) {
+	tests := []struct {
+		name string
+		in   proto.Message
+		want string
+	}{
+		{
+			name: "public-id",
+			in:   &iamstore.Role{PublicId: "r_1234567890", ScopeId: "global"},
+			want: "r_1234567890",
+		},
+		{
+			name: "association",
+			in:   &targetstore.TargetHostSet{TargetId: "ttcp_1234567890", HostSetId: "hsst_1234567890"},
+			want: "ttcp_1234567890:hsst_1234567890",
+		},
+		{
+			name: "key-fields",
+			in:   &iamstore.RoleGrant{RoleId: "r_1234567890", CanonicalGrant: "id=*;type=*;actions=*", RawGrant: "id=*;type=*;actions=*"},
+			want: "r_1234567890:id=*;type=*;actions=*",
+		},
+		{
+			name: "named-item",
+			in:   &targetstore.TargetPort{TargetId: "ttcp_1234567890", Name: "db", Port: 5432},
+			want: "ttcp_1234567890:db",
+		},
+		{
+			name: "preferred-endpoint",
+			in:   &hoststore.PreferredEndpoint{HostSetId: "hsplg_1234567890", Priority: 1, Condition: "cidr:10.0.0.0/8"},
+			want: "hsplg_1234567890:cidr:10.0.0.0/8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, itemKey(tt.in))
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	m := (&iamstore.Role{Name: "admin", Version: 2}).ProtoReflect()
+	fields := m.Descriptor().Fields()
+	assert.Equal(t, "admin", formatValue(fields.ByName("name"), m.Get(fields.ByName("name"))))
+	assert.Equal(t, "2", formatValue(fields.ByName("version"), m.Get(fields.ByName("version"))))
+
+	fd := findField(fields, "GrantScopeId")
+	require.NotNil(t, fd)
+	assert.Equal(t, "grant_scope_id", string(fd.Name()))
+	assert.Nil(t, findField(fields, "Unknown"))
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history
+
+const (
+	// entriesForResourceWhere selects the oplog entries written for a
+	// resource, identified by its public id.
+	entriesForResourceWhere = `
+id in (
+  select entry_id
+    from oplog_metadata
+   where key = 'resource-public-id'
+     and value = ?
+)
+`
+)
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/oplog/store"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// Repository derives resource history from the oplog.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	types  *oplog.TypeCatalog
+
+	// defaultLimit provides a default for limiting the number of results returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new history Repository. Supports the options:
+//   - WithLimit, which sets a default limit on results returned by repo operations.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
+	const op = "history.NewRepository"
+	switch {
+	case util.IsNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil reader")
+	case util.IsNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil kms")
+	}
+	types, err := newTypeCatalog(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		types:        types,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
+
+// ListResourceHistory returns the change history of the resource with the
+// given public id, oldest first. Only the most recent oplog entries, up to
+// the limit, are read and decrypted. Since the entries are replayed in
+// memory, an update or delete only reports the previous value of a field
+// when that value was written by one of the returned entries. Supports the
+// WithLimit option.
+func (r *Repository) ListResourceHistory(ctx context.Context, publicId string, opt ...Option) ([]*Record, error) {
+	const op = "history.(Repository).ListResourceHistory"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	entries, err := r.listEntries(ctx, publicId, limit)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	d := newDiffer(publicId)
+	records := make([]*Record, 0, len(entries))
+	for _, e := range entries {
+		msgs, err := r.decodeEntry(ctx, e)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rec := &Record{
+			Id:         strconv.FormatUint(uint64(e.GetId()), 10),
+			CreateTime: e.GetCreateTime().AsTime(),
+		}
+		for _, md := range e.GetMetadata() {
+			if md.GetKey() == oplog.UserIdMetadataKey {
+				rec.UserId = md.GetValue()
+			}
+		}
+		for _, m := range msgs {
+			changes, err := d.apply(ctx, m)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			rec.Changes = append(rec.Changes, changes...)
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// listEntries returns the encrypted oplog entries, with their metadata, for
+// the resource with the public id in the order they were written. If limit
+// is greater than zero only the most recent entries, up to the limit, are
+// returned.
+func (r *Repository) listEntries(ctx context.Context, publicId string, limit int) ([]*store.Entry, error) {
+	const op = "history.(Repository).listEntries"
+	order := "id asc"
+	if limit > 0 {
+		order = "id desc"
+	} else {
+		limit = -1
+	}
+	var entries []*store.Entry
+	if err := r.reader.SearchWhere(ctx, &entries, entriesForResourceWhere, []any{publicId}, db.WithOrder(order), db.WithLimit(limit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if limit > 0 {
+		// The most recent entries were selected; return them oldest first.
+		sort.Slice(entries, func(i, j int) bool { return entries[i].GetId() < entries[j].GetId() })
+	}
+	ids := make([]uint32, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, e.GetId())
+	}
+	var metadata []*store.Metadata
+	if err := r.reader.SearchWhere(ctx, &metadata, "entry_id in (?)", []any{ids}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	byEntry := make(map[uint32][]*store.Metadata, len(entries))
+	for _, md := range metadata {
+		byEntry[md.GetEntryId()] = append(byEntry[md.GetEntryId()], md)
+	}
+	for _, e := range entries {
+		e.Metadata = byEntry[e.GetId()]
+	}
+	return entries, nil
+}
+
+// decodeEntry decrypts the entry with the oplog key it was written with and
+// returns its messages.
+func (r *Repository) decodeEntry(ctx context.Context, e *store.Entry) ([]oplog.Message, error) {
+	const op = "history.(Repository).decodeEntry"
+	wrapper, err := r.kms.GetWrapper(ctx, e.GetScopeId(), kms.KeyPurposeOplog, kms.WithKeyId(e.GetKeyId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	entry := &oplog.Entry{
+		Entry:   e,
+		Wrapper: wrapper,
+	}
+	if err := entry.DecryptData(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	msgs, err := entry.UnmarshalData(ctx, r.types)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return msgs, nil
+}
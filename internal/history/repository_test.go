@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/history"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	tcpstore "github.com/hashicorp/boundary/internal/target/tcp/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		r             db.Reader
+		w             db.Writer
+		k             *kms.Kms
+		wantErrString string
+	}{
+		{
+			name: "valid",
+			r:    rw,
+			w:    rw,
+			k:    testKms,
+		},
+		{
+			name:          "nil-reader",
+			w:             rw,
+			k:             testKms,
+			wantErrString: "history.NewRepository: nil reader: parameter violation: error #100",
+		},
+		{
+			name:          "nil-writer",
+			r:             rw,
+			k:             testKms,
+			wantErrString: "history.NewRepository: nil writer: parameter violation: error #100",
+		},
+		{
+			name:          "nil-kms",
+			r:             rw,
+			w:             rw,
+			wantErrString: "history.NewRepository: nil kms: parameter violation: error #100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := history.NewRepository(ctx, tt.r, tt.w, tt.k)
+			if tt.wantErrString != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErrString, err.Error())
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestRepository_ListResourceHistory(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+	user := iam.TestUser(t, iamRepo, proj.GetParentId())
+
+	ctx := requests.NewRequestContext(context.Background(), requests.WithUserId(user.GetPublicId()))
+	targetRepo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	repo, err := history.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	tar, err := target.New(ctx, tcp.Subtype, proj.GetPublicId(), target.WithName("web"), target.WithDefaultPort(22))
+	require.NoError(t, err)
+	tar, _, _, err = targetRepo.CreateTarget(ctx, tar)
+	require.NoError(t, err)
+
+	upd := tar.Clone()
+	upd.SetName("web-updated")
+	_, _, _, _, err = targetRepo.UpdateTarget(ctx, upd, tar.GetVersion(), []string{"Name"})
+	require.NoError(t, err)
+
+	got, err := repo.ListResourceHistory(ctx, tar.GetPublicId())
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for _, r := range got {
+		assert.Equal(t, user.GetPublicId(), r.UserId)
+		assert.False(t, r.CreateTime.IsZero())
+	}
+	assert.Contains(t, got[0].Changes, &history.Change{
+		Type:      "target_tcp",
+		Key:       tar.GetPublicId(),
+		Operation: "create",
+		Field:     "name",
+		NewValue:  "web",
+	})
+	assert.Contains(t, got[1].Changes, &history.Change{
+		Type:      "target_tcp",
+		Key:       tar.GetPublicId(),
+		Operation: "update",
+		Field:     "name",
+		OldValue:  "web",
+		NewValue:  "web-updated",
+	})
+
+	limited, err := repo.ListResourceHistory(ctx, tar.GetPublicId(), history.WithLimit(1))
+	require.NoError(t, err)
+	require.Len(t, limited, 1)
+	assert.Equal(t, got[1].Id, limited[0].Id)
+	// The entry which created the target is not read, so the previous name
+	// is unknown.
+	assert.Contains(t, limited[0].Changes, &history.Change{
+		Type:      "target_tcp",
+		Key:       tar.GetPublicId(),
+		Operation: "update",
+		Field:     "name",
+		NewValue:  "web-updated",
+	})
+
+	_, err = repo.ListResourceHistory(ctx, "")
+	require.Error(t, err)
+
+	none, err := repo.ListResourceHistory(ctx, "ttcp_doesnotexist")
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestRepository_ResourceState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	targetRepo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	repo, err := history.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	tar, err := target.New(ctx, tcp.Subtype, proj.GetPublicId(), target.WithName("web"), target.WithDefaultPort(22))
+	require.NoError(t, err)
+	tar, _, _, err = targetRepo.CreateTarget(ctx, tar)
+	require.NoError(t, err)
+	// The oplog entry is written in the same transaction as the target, so
+	// it shares its create time.
+	created := tar.GetCreateTime().AsTime()
+
+	upd := tar.Clone()
+	upd.SetName("web-updated")
+	_, _, _, _, err = targetRepo.UpdateTarget(ctx, upd, tar.GetVersion(), []string{"Name"})
+	require.NoError(t, err)
+	_, err = targetRepo.DeleteTarget(ctx, tar.GetPublicId())
+	require.NoError(t, err)
+
+	want, err := repo.ResourceState(ctx, tar.GetPublicId(), created)
+	require.NoError(t, err)
+	assert.True(t, want.Exists())
+	assert.Equal(t, proj.GetPublicId(), want.ScopeId)
+	assert.Equal(t, "web", want.Resource("target_tcp").(*tcpstore.Target).GetName())
+
+	current, err := repo.ResourceState(ctx, tar.GetPublicId(), time.Now())
+	require.NoError(t, err)
+	assert.False(t, current.Exists())
+	assert.Equal(t, "web-updated", current.Resource("target_tcp").(*tcpstore.Target).GetName())
+	assert.Contains(t, history.Diff(current, want), &history.Change{
+		Type:      "target_tcp",
+		Key:       tar.GetPublicId(),
+		Operation: "create",
+		Field:     "name",
+		NewValue:  "web",
+	})
+
+	_, err = repo.ResourceState(ctx, tar.GetPublicId(), created.Add(-time.Hour))
+	require.Error(t, err)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	_, err = repo.ResourceState(ctx, "", time.Now())
+	require.Error(t, err)
+	_, err = repo.ResourceState(ctx, tar.GetPublicId(), time.Time{})
+	require.Error(t, err)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// managedFields are maintained by the database and are not compared when
+// diffing states.
+var managedFields = map[string]bool{
+	"create_time": true,
+	"update_time": true,
+	"version":     true,
+}
+
+// State is the state of a resource, and of the items associated with it,
+// reconstructed from the oplog at a point in time.
+type State struct {
+	// PublicId is the public id of the resource.
+	PublicId string
+	// ScopeId is the id of the scope the last oplog entry for the resource
+	// was written in.
+	ScopeId string
+	// Time is the point in time the state was reconstructed for.
+	Time time.Time
+
+	deleted bool
+	items   map[string]map[string]proto.Message
+}
+
+// Exists reports whether the resource existed at the time of the state.
+func (s *State) Exists() bool {
+	if s == nil || s.deleted {
+		return false
+	}
+	for _, items := range s.items {
+		if _, ok := items[s.PublicId]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource returns the item of type typeName whose key is the public id of
+// the resource, or nil if it isn't in the state. If the resource had been
+// deleted by the time of the state, its last known value is returned.
+func (s *State) Resource(typeName string) proto.Message {
+	if s == nil {
+		return nil
+	}
+	return s.items[typeName][s.PublicId]
+}
+
+// Items returns the items of type typeName which existed at the time of the
+// state, ordered by their key. The returned messages must not be modified.
+func (s *State) Items(typeName string) []proto.Message {
+	items := s.liveItems()[typeName]
+	keys := sortedKeys(items)
+	ret := make([]proto.Message, 0, len(keys))
+	for _, k := range keys {
+		ret = append(ret, items[k])
+	}
+	return ret
+}
+
+// liveItems returns the items which existed at the time of the state,
+// excluding the resource itself if it had been deleted.
+func (s *State) liveItems() map[string]map[string]proto.Message {
+	if s == nil {
+		return nil
+	}
+	if !s.deleted {
+		return s.items
+	}
+	ret := make(map[string]map[string]proto.Message, len(s.items))
+	for typeName, items := range s.items {
+		ret[typeName] = make(map[string]proto.Message, len(items))
+		for k, m := range items {
+			if k != s.PublicId {
+				ret[typeName][k] = m
+			}
+		}
+	}
+	return ret
+}
+
+// ResourceState reconstructs the state of the resource with the given public
+// id at the point in time at, by replaying the oplog entries written for it
+// up to and including that time. A RecordNotFound error is returned if no
+// entries were written for the resource by then.
+func (r *Repository) ResourceState(ctx context.Context, publicId string, at time.Time) (*State, error) {
+	const op = "history.(Repository).ResourceState"
+	switch {
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case at.IsZero():
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing time")
+	}
+
+	entries, err := r.listEntries(ctx, publicId, -1)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	d := newDiffer(publicId)
+	state := &State{
+		PublicId: publicId,
+		Time:     at,
+	}
+	for _, e := range entries {
+		if e.GetCreateTime().AsTime().After(at) {
+			break
+		}
+		msgs, err := r.decodeEntry(ctx, e)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, m := range msgs {
+			if _, err := d.apply(ctx, m); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		state.ScopeId = e.GetScopeId()
+	}
+	if state.ScopeId == "" {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "no oplog entries found for resource at time")
+	}
+	state.items = d.state
+	state.deleted = d.rootDeleted
+	return state, nil
+}
+
+// Diff returns the changes needed to bring the items of from to those of to.
+// Fields maintained by the database, such as the version, are ignored. A nil
+// state is treated as having no items.
+func Diff(from, to *State) []*Change {
+	fromItems, toItems := from.liveItems(), to.liveItems()
+
+	typeNames := map[string]bool{}
+	for n := range fromItems {
+		typeNames[n] = true
+	}
+	for n := range toItems {
+		typeNames[n] = true
+	}
+	sortedTypes := make([]string, 0, len(typeNames))
+	for n := range typeNames {
+		sortedTypes = append(sortedTypes, n)
+	}
+	sort.Strings(sortedTypes)
+
+	var changes []*Change
+	for _, typeName := range sortedTypes {
+		keys := map[string]bool{}
+		for k := range fromItems[typeName] {
+			keys[k] = true
+		}
+		for k := range toItems[typeName] {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+
+		for _, key := range sortedKeys {
+			changes = append(changes, diffItem(typeName, key, fromItems[typeName][key], toItems[typeName][key])...)
+		}
+	}
+	return changes
+}
+
+// diffItem returns the field-level changes needed to bring the item old to
+// new. Either may be nil, in which case the item is created or deleted.
+func diffItem(typeName, key string, old, new proto.Message) []*Change {
+	var operation string
+	var desc protoreflect.MessageDescriptor
+	switch {
+	case old == nil && new == nil:
+		return nil
+	case old == nil:
+		operation = "create"
+		desc = new.ProtoReflect().Descriptor()
+	case new == nil:
+		operation = "delete"
+		desc = old.ProtoReflect().Descriptor()
+	default:
+		operation = "update"
+		desc = new.ProtoReflect().Descriptor()
+	}
+
+	var changes []*Change
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if managedFields[string(fd.Name())] {
+			continue
+		}
+		c := &Change{
+			Type:      typeName,
+			Key:       key,
+			Operation: operation,
+			Field:     string(fd.Name()),
+		}
+		var oldHas, newHas bool
+		if old != nil && old.ProtoReflect().Has(fd) {
+			oldHas = true
+			c.OldValue = formatValue(fd, old.ProtoReflect().Get(fd))
+		}
+		if new != nil && new.ProtoReflect().Has(fd) {
+			newHas = true
+			c.NewValue = formatValue(fd, new.ProtoReflect().Get(fd))
+		}
+		if !oldHas && !newHas {
+			continue
+		}
+		if oldHas && newHas && valuesEqual(fd, old.ProtoReflect().Get(fd), new.ProtoReflect().Get(fd)) {
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// valuesEqual reports whether the values a and b of the field fd are equal.
+// Bytes are compared directly since their formatted values are redacted.
+func valuesEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	if fd.Kind() == protoreflect.BytesKind && !fd.IsList() && !fd.IsMap() {
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	}
+	return formatValue(fd, a) == formatValue(fd, b)
+}
+
+func sortedKeys(m map[string]proto.Message) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/oplog"
+	targetstore "github.com/hashicorp/boundary/internal/target/store"
+	tcpstore "github.com/hashicorp/boundary/internal/target/tcp/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testState(t *testing.T, publicId string, msgs ...oplog.Message) *State {
+	t.Helper()
+	d := newDiffer(publicId)
+	for _, m := range msgs {
+		_, err := d.apply(context.Background(), m)
+		require.NoError(t, err)
+	}
+	return &State{
+		PublicId: publicId,
+		deleted:  d.rootDeleted,
+		items:    d.state,
+	}
+}
+
+func TestState(t *testing.T) {
+	const id = "ttcp_1234567890"
+	create := oplog.Message{
+		Message:  &tcpstore.Target{PublicId: id, ProjectId: "p_1234567890", Name: "web", Version: 1},
+		TypeName: "target_tcp",
+		OpType:   oplog.OpType_OP_TYPE_CREATE,
+	}
+	addHostSet := oplog.Message{
+		Message:  &targetstore.TargetHostSet{TargetId: id, HostSetId: "hsst_1234567890"},
+		TypeName: "target_host_set",
+		OpType:   oplog.OpType_OP_TYPE_CREATE,
+	}
+	del := oplog.Message{
+		Message:  &tcpstore.Target{PublicId: id},
+		TypeName: "target_tcp",
+		OpType:   oplog.OpType_OP_TYPE_DELETE,
+	}
+
+	var nilState *State
+	assert.False(t, nilState.Exists())
+	assert.Nil(t, nilState.Resource("target_tcp"))
+	assert.Empty(t, nilState.Items("target_host_set"))
+
+	s := testState(t, id, create, addHostSet)
+	assert.True(t, s.Exists())
+	assert.True(t, proto.Equal(create.Message, s.Resource("target_tcp")))
+	require.Len(t, s.Items("target_host_set"), 1)
+	assert.Equal(t, "hsst_1234567890", s.Items("target_host_set")[0].(*targetstore.TargetHostSet).GetHostSetId())
+
+	s = testState(t, id, create, addHostSet, del)
+	assert.False(t, s.Exists())
+	require.NotNil(t, s.Resource("target_tcp"), "the last known value of a deleted resource should be kept")
+	assert.Equal(t, "p_1234567890", s.Resource("target_tcp").(*tcpstore.Target).GetProjectId())
+	assert.Empty(t, s.Items("target_tcp"))
+	assert.Empty(t, s.Items("target_host_set"))
+}
+
+func TestDiff(t *testing.T) {
+	const id = "ttcp_1234567890"
+	create := oplog.Message{
+		Message:  &tcpstore.Target{PublicId: id, ProjectId: "p_1234567890", Name: "web", DefaultPort: 22, Version: 1},
+		TypeName: "target_tcp",
+		OpType:   oplog.OpType_OP_TYPE_CREATE,
+	}
+	update := oplog.Message{
+		Message:        &tcpstore.Target{PublicId: id, ProjectId: "p_1234567890", Name: "web", DefaultPort: 2222, Version: 2},
+		TypeName:       "target_tcp",
+		OpType:         oplog.OpType_OP_TYPE_UPDATE,
+		FieldMaskPaths: []string{"DefaultPort", "Version"},
+	}
+	addHostSet := oplog.Message{
+		Message:  &targetstore.TargetHostSet{TargetId: id, HostSetId: "hsst_1234567890"},
+		TypeName: "target_host_set",
+		OpType:   oplog.OpType_OP_TYPE_CREATE,
+	}
+	del := oplog.Message{
+		Message:  &tcpstore.Target{PublicId: id},
+		TypeName: "target_tcp",
+		OpType:   oplog.OpType_OP_TYPE_DELETE,
+	}
+
+	t.Run("update", func(t *testing.T) {
+		want := testState(t, id, create)
+		current := testState(t, id, create, update, addHostSet)
+		assert.Equal(t, []*Change{
+			{Type: "target_host_set", Key: id + ":hsst_1234567890", Operation: "delete", Field: "target_id", OldValue: id},
+			{Type: "target_host_set", Key: id + ":hsst_1234567890", Operation: "delete", Field: "host_set_id", OldValue: "hsst_1234567890"},
+			{Type: "target_tcp", Key: id, Operation: "update", Field: "default_port", OldValue: "2222", NewValue: "22"},
+		}, Diff(current, want), "the version should be ignored")
+	})
+	t.Run("recreate", func(t *testing.T) {
+		want := testState(t, id, create, addHostSet)
+		current := testState(t, id, create, addHostSet, del)
+		assert.Equal(t, []*Change{
+			{Type: "target_host_set", Key: id + ":hsst_1234567890", Operation: "create", Field: "target_id", NewValue: id},
+			{Type: "target_host_set", Key: id + ":hsst_1234567890", Operation: "create", Field: "host_set_id", NewValue: "hsst_1234567890"},
+			{Type: "target_tcp", Key: id, Operation: "create", Field: "public_id", NewValue: id},
+			{Type: "target_tcp", Key: id, Operation: "create", Field: "project_id", NewValue: "p_1234567890"},
+			{Type: "target_tcp", Key: id, Operation: "create", Field: "name", NewValue: "web"},
+			{Type: "target_tcp", Key: id, Operation: "create", Field: "default_port", NewValue: "22"},
+		}, Diff(current, want))
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		want := testState(t, id, create, addHostSet)
+		assert.Empty(t, Diff(want, want))
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A Host is a host found by resolving the DNS names of a dns host set. It is
+// only ever written by the set sync job; the DNS records are the source of
+// truth of the data contained here.
+type Host struct {
+	*store.Host
+	SetIds    []string `gorm:"-"`
+	tableName string   `gorm:"-"`
+}
+
+// NewHost creates a new in memory Host assigned to catalogId with externalId.
+// Supported options: WithName, WithPublicId, withIpAddresses, withDnsNames.
+// Others ignored.
+func NewHost(ctx context.Context, catalogId, externalId string, opt ...Option) *Host {
+	opts := getOpts(opt...)
+
+	h := &Host{
+		Host: &store.Host{
+			PublicId:   opts.withPublicId,
+			CatalogId:  catalogId,
+			ExternalId: externalId,
+			Name:       opts.withName,
+		},
+	}
+	if len(opts.withIpAddresses) > 0 {
+		h.IpAddresses = make([]string, 0, len(opts.withIpAddresses))
+		h.IpAddresses = append(h.IpAddresses, opts.withIpAddresses...)
+	}
+	if len(opts.withDnsNames) > 0 {
+		h.DnsNames = make([]string, 0, len(opts.withDnsNames))
+		h.DnsNames = append(h.DnsNames, opts.withDnsNames...)
+	}
+	return h
+}
+
+// For compatibility with the general Host type
+func (h *Host) GetAddress() string {
+	return ""
+}
+
+// For compatibility with the general Host type
+func (h *Host) GetDescription() string {
+	return ""
+}
+
+// TableName returns the table name for the host.
+func (h *Host) TableName() string {
+	if h.tableName != "" {
+		return h.tableName
+	}
+	return "host_dns_host"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (h *Host) SetTableName(n string) {
+	h.tableName = n
+}
+
+func allocHost() *Host {
+	return &Host{
+		Host: &store.Host{},
+	}
+}
+
+func (h *Host) clone() *Host {
+	cp := proto.Clone(h.Host)
+	nh := &Host{
+		Host: cp.(*store.Host),
+	}
+	if h.SetIds != nil {
+		nh.SetIds = make([]string, len(h.SetIds))
+		copy(nh.SetIds, h.SetIds)
+	}
+	return nh
+}
+
+func (h *Host) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{h.PublicId},
+		"resource-type":      []string{"dns-host"},
+		"op-type":            []string{op.String()},
+	}
+	if h.CatalogId != "" {
+		metadata["catalog-id"] = []string{h.CatalogId}
+	}
+	return metadata
+}
+
+// GetSetIds returns host set ids
+func (h *Host) GetSetIds() []string {
+	return h.SetIds
+}
+
+// hostAgg is a view that aggregates the host's value objects in to
+// string fields delimited with the aggregateDelimiter of "|"
+type hostAgg struct {
+	PublicId    string `gorm:"primary_key"`
+	CatalogId   string
+	ProjectId   string
+	ExternalId  string
+	Name        string
+	CreateTime  *timestamp.Timestamp
+	UpdateTime  *timestamp.Timestamp
+	Version     uint32
+	IpAddresses string
+	DnsNames    string
+	SetIds      string
+}
+
+func (agg *hostAgg) toHost() *Host {
+	const aggregateDelimiter = "|"
+	h := allocHost()
+	h.PublicId = agg.PublicId
+	h.CatalogId = agg.CatalogId
+	h.ExternalId = agg.ExternalId
+	h.Name = agg.Name
+	h.CreateTime = agg.CreateTime
+	h.UpdateTime = agg.UpdateTime
+	h.Version = agg.Version
+	if agg.IpAddresses != "" {
+		h.IpAddresses = strings.Split(agg.IpAddresses, aggregateDelimiter)
+		sort.Strings(h.IpAddresses)
+	}
+	if agg.DnsNames != "" {
+		h.DnsNames = strings.Split(agg.DnsNames, aggregateDelimiter)
+		sort.Strings(h.DnsNames)
+	}
+	if agg.SetIds != "" {
+		h.SetIds = strings.Split(agg.SetIds, aggregateDelimiter)
+		sort.Strings(h.SetIds)
+	}
+	return h
+}
+
+// TableName returns the table name for gorm
+func (agg *hostAgg) TableName() string {
+	return "host_dns_host_with_value_obj_and_set_memberships"
+}
+
+// GetPublicId returns the host public id as a string
+func (agg *hostAgg) GetPublicId() string {
+	return agg.PublicId
+}
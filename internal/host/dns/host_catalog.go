@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dns provides a dns host catalog and dns host set resource. The
+// hosts of a dns host set are found by periodically resolving its DNS names,
+// using either the resolvers of its catalog or the system resolver. The
+// package also provides a repository to perform CRUDL on these resources.
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A HostCatalog contains dns host sets. It is owned by a project.
+type HostCatalog struct {
+	*store.HostCatalog
+	tableName string `gorm:"-"`
+}
+
+// NewHostCatalog creates a new in memory HostCatalog assigned to projectId.
+// Name, description and resolvers are the only valid options. All other
+// options are ignored.
+func NewHostCatalog(ctx context.Context, projectId string, opt ...Option) (*HostCatalog, error) {
+	const op = "dns.NewHostCatalog"
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	opts := getOpts(opt...)
+	hc := &HostCatalog{
+		HostCatalog: &store.HostCatalog{
+			ProjectId:   projectId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+		},
+	}
+	if len(opts.withResolvers) > 0 {
+		hc.Resolvers = make([]string, 0, len(opts.withResolvers))
+		hc.Resolvers = append(hc.Resolvers, opts.withResolvers...)
+	}
+	return hc, nil
+}
+
+func allocHostCatalog() *HostCatalog {
+	return &HostCatalog{
+		HostCatalog: &store.HostCatalog{},
+	}
+}
+
+func (c *HostCatalog) clone() *HostCatalog {
+	cp := proto.Clone(c.HostCatalog)
+	return &HostCatalog{
+		HostCatalog: cp.(*store.HostCatalog),
+	}
+}
+
+// TableName returns the table name for the host catalog.
+func (c *HostCatalog) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "host_dns_catalog"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (c *HostCatalog) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *HostCatalog) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.PublicId},
+		"resource-type":      []string{"dns-host-catalog"},
+		"op-type":            []string{op.String()},
+	}
+	if c.ProjectId != "" {
+		metadata["project-id"] = []string{c.ProjectId}
+	}
+	return metadata
+}
+
+// resolvers returns the HostCatalogResolvers of the catalog in priority
+// order.
+func (c *HostCatalog) resolvers(ctx context.Context) ([]any, error) {
+	const op = "dns.(HostCatalog).resolvers"
+	rs := make([]any, 0, len(c.Resolvers))
+	for i, addr := range c.Resolvers {
+		r, err := NewHostCatalogResolver(ctx, c.PublicId, uint32(i+1), addr)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// A HostCatalogResolver is the address of a DNS server used to resolve the
+// names of the host sets of a catalog.
+type HostCatalogResolver struct {
+	*store.HostCatalogResolver
+	tableName string `gorm:"-"`
+}
+
+// NewHostCatalogResolver creates a new in memory HostCatalogResolver for
+// catalogId with the given priority and address.
+func NewHostCatalogResolver(ctx context.Context, catalogId string, priority uint32, address string) (*HostCatalogResolver, error) {
+	const op = "dns.NewHostCatalogResolver"
+	switch {
+	case catalogId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	case priority == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "priority must be greater than zero")
+	case strings.TrimSpace(address) == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no address")
+	}
+	return &HostCatalogResolver{
+		HostCatalogResolver: &store.HostCatalogResolver{
+			CatalogId: catalogId,
+			Priority:  priority,
+			Address:   address,
+		},
+	}, nil
+}
+
+// VetForWrite implements db.VetForWrite() interface for host catalog
+// resolvers.
+func (r *HostCatalogResolver) VetForWrite(ctx context.Context, _ db.Reader, _ db.OpType, _ ...db.Option) error {
+	const op = "dns.(HostCatalogResolver).VetForWrite"
+	switch {
+	case r.CatalogId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing catalog id")
+	case r.Priority == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing priority")
+	case r.Address == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing address")
+	}
+	return nil
+}
+
+// TableName returns the table name for the host catalog resolver.
+func (r *HostCatalogResolver) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return "host_dns_catalog_resolver"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (r *HostCatalogResolver) SetTableName(n string) {
+	r.tableName = n
+}
+
+// catalogAgg is a view that aggregates the host catalog's resolvers in to a
+// string field delimited with the aggregateDelimiter of "|"
+type catalogAgg struct {
+	PublicId    string `gorm:"primary_key"`
+	ProjectId   string
+	Name        string
+	Description string
+	CreateTime  *timestamp.Timestamp
+	UpdateTime  *timestamp.Timestamp
+	Version     uint32
+	Resolvers   string
+}
+
+func (agg *catalogAgg) toCatalog() *HostCatalog {
+	const aggregateDelimiter = "|"
+	c := allocHostCatalog()
+	c.PublicId = agg.PublicId
+	c.ProjectId = agg.ProjectId
+	c.Name = agg.Name
+	c.Description = agg.Description
+	c.CreateTime = agg.CreateTime
+	c.UpdateTime = agg.UpdateTime
+	c.Version = agg.Version
+	if agg.Resolvers != "" {
+		c.Resolvers = strings.Split(agg.Resolvers, aggregateDelimiter)
+	}
+	return c
+}
+
+// TableName returns the table name for gorm
+func (agg *catalogAgg) TableName() string {
+	return "host_dns_catalog_with_resolvers"
+}
+
+func (agg *catalogAgg) GetPublicId() string {
+	return agg.PublicId
+}
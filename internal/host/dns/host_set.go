@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A RecordType is the type of DNS lookup used to resolve the names of a
+// host set.
+type RecordType string
+
+const (
+	// RecordTypeIp looks up both A and AAAA records. Each address found
+	// becomes a host.
+	RecordTypeIp RecordType = "ip"
+	// RecordTypeIp4 looks up A records only.
+	RecordTypeIp4 RecordType = "ip4"
+	// RecordTypeIp6 looks up AAAA records only.
+	RecordTypeIp6 RecordType = "ip6"
+	// RecordTypeSrv looks up SRV records. Each target found becomes a host
+	// with the addresses the target resolves to.
+	RecordTypeSrv RecordType = "srv"
+)
+
+// Valid reports whether t is a supported record type.
+func (t RecordType) Valid() bool {
+	switch t {
+	case RecordTypeIp, RecordTypeIp4, RecordTypeIp6, RecordTypeSrv:
+		return true
+	}
+	return false
+}
+
+// A HostSet is a collection of the hosts found by resolving the DNS names of
+// the set.
+type HostSet struct {
+	*store.HostSet
+	HostIds   []string `gorm:"-"`
+	tableName string   `gorm:"-"`
+}
+
+// NewHostSet creates a new in memory HostSet assigned to catalogId. Name,
+// description, names, record type and sync interval are the only valid
+// options. All other options are ignored. If no record type is provided
+// RecordTypeIp is used.
+func NewHostSet(ctx context.Context, catalogId string, opt ...Option) (*HostSet, error) {
+	const op = "dns.NewHostSet"
+	if catalogId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	}
+	opts := getOpts(opt...)
+	recordType := RecordType(opts.withRecordType)
+	if recordType == "" {
+		recordType = RecordTypeIp
+	}
+	if !recordType.Valid() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unknown record type")
+	}
+	set := &HostSet{
+		HostSet: &store.HostSet{
+			CatalogId:           catalogId,
+			Name:                opts.withName,
+			Description:         opts.withDescription,
+			RecordType:          string(recordType),
+			SyncIntervalSeconds: opts.withSyncIntervalSeconds,
+		},
+	}
+	if len(opts.withNames) > 0 {
+		set.Names = make([]string, 0, len(opts.withNames))
+		set.Names = append(set.Names, opts.withNames...)
+	}
+	return set, nil
+}
+
+// TableName returns the table name for the host set.
+func (s *HostSet) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return "host_dns_set"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (s *HostSet) SetTableName(n string) {
+	s.tableName = n
+}
+
+func allocHostSet() *HostSet {
+	return &HostSet{
+		HostSet: &store.HostSet{},
+	}
+}
+
+func (s *HostSet) clone() *HostSet {
+	cp := proto.Clone(s.HostSet)
+	hs := &HostSet{
+		HostSet: cp.(*store.HostSet),
+	}
+	if s.HostIds != nil {
+		hs.HostIds = make([]string, len(s.HostIds))
+		copy(hs.HostIds, s.HostIds)
+	}
+	return hs
+}
+
+func (s *HostSet) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{s.PublicId},
+		"resource-type":      []string{"dns-host-set"},
+		"op-type":            []string{op.String()},
+	}
+	if s.CatalogId != "" {
+		metadata["catalog-id"] = []string{s.CatalogId}
+	}
+	return metadata
+}
+
+// names returns the HostSetNames of the set.
+func (s *HostSet) names(ctx context.Context) ([]any, error) {
+	const op = "dns.(HostSet).names"
+	ns := make([]any, 0, len(s.Names))
+	for _, n := range s.Names {
+		hsn, err := NewHostSetName(ctx, s.PublicId, n)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ns = append(ns, hsn)
+	}
+	return ns, nil
+}
+
+// A HostSetName is a DNS name resolved to find the hosts of a host set.
+type HostSetName struct {
+	*store.HostSetName
+	tableName string `gorm:"-"`
+}
+
+// NewHostSetName creates a new in memory HostSetName for setId.
+func NewHostSetName(ctx context.Context, setId, name string) (*HostSetName, error) {
+	const op = "dns.NewHostSetName"
+	switch {
+	case setId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no set id")
+	case strings.TrimSpace(name) == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no name")
+	}
+	return &HostSetName{
+		HostSetName: &store.HostSetName{
+			SetId: setId,
+			Name:  name,
+		},
+	}, nil
+}
+
+// VetForWrite implements db.VetForWrite() interface for host set names.
+func (n *HostSetName) VetForWrite(ctx context.Context, _ db.Reader, _ db.OpType, _ ...db.Option) error {
+	const op = "dns.(HostSetName).VetForWrite"
+	switch {
+	case n.SetId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing set id")
+	case n.Name == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	return nil
+}
+
+// TableName returns the table name for the host set name.
+func (n *HostSetName) TableName() string {
+	if n.tableName != "" {
+		return n.tableName
+	}
+	return "host_dns_set_name"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (n *HostSetName) SetTableName(name string) {
+	n.tableName = name
+}
+
+// hostSetAgg is a view that aggregates the host set's value objects in to
+// string fields delimited with the aggregateDelimiter of "|"
+type hostSetAgg struct {
+	PublicId            string `gorm:"primary_key"`
+	CatalogId           string
+	ProjectId           string
+	Name                string
+	Description         string
+	CreateTime          *timestamp.Timestamp
+	UpdateTime          *timestamp.Timestamp
+	LastSyncTime        *timestamp.Timestamp
+	NeedSync            bool
+	SyncIntervalSeconds int32
+	RecordType          string
+	Version             uint32
+	Names               string
+	HostIds             string
+}
+
+func (agg *hostSetAgg) toHostSet() *HostSet {
+	const aggregateDelimiter = "|"
+	hs := allocHostSet()
+	hs.PublicId = agg.PublicId
+	hs.CatalogId = agg.CatalogId
+	hs.Name = agg.Name
+	hs.Description = agg.Description
+	hs.CreateTime = agg.CreateTime
+	hs.UpdateTime = agg.UpdateTime
+	hs.LastSyncTime = agg.LastSyncTime
+	hs.NeedSync = agg.NeedSync
+	hs.SyncIntervalSeconds = agg.SyncIntervalSeconds
+	hs.RecordType = agg.RecordType
+	hs.Version = agg.Version
+	if agg.Names != "" {
+		hs.Names = strings.Split(agg.Names, aggregateDelimiter)
+		sort.Strings(hs.Names)
+	}
+	if agg.HostIds != "" {
+		hs.HostIds = strings.Split(agg.HostIds, aggregateDelimiter)
+		sort.Strings(hs.HostIds)
+	}
+	return hs
+}
+
+func (agg *hostSetAgg) GetPublicId() string {
+	return agg.PublicId
+}
+
+// TableName returns the table name for gorm
+func (agg *hostSetAgg) TableName() string {
+	return "host_dns_set_with_value_obj"
+}
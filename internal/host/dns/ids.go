@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := subtypes.Register(host.Domain, Subtype, globals.DnsHostCatalogPrefix, globals.DnsHostSetPrefix, globals.DnsHostPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the dns package.
+const (
+	Subtype = subtypes.Subtype("dns")
+)
+
+func newHostCatalogId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(globals.DnsHostCatalogPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "dns.newHostCatalogId")
+	}
+	return id, nil
+}
+
+func newHostSetId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(globals.DnsHostSetPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "dns.newHostSetId")
+	}
+	return id, nil
+}
+
+// newHostId returns the id of the host with externalId in catalogId. The id
+// is derived from both so that syncs of different sets which find the same
+// host agree on its id.
+func newHostId(ctx context.Context, catalogId, externalId string) (string, error) {
+	const op = "dns.newHostId"
+	if catalogId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing catalog id")
+	}
+	if externalId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing external id")
+	}
+	id, err := db.NewPublicId(globals.DnsHostPrefix, db.WithPrngValues([]string{catalogId, externalId}))
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
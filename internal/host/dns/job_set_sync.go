@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	setSyncJobName        = "dns_host_set_sync"
+	setSyncJobRunInterval = 10 * time.Minute
+)
+
+// SetSyncJob is the recurring job that resolves the names of dns host sets
+// and updates their hosts. The SetSyncJob is not thread safe, an attempt to
+// Run the job concurrently will result in an JobAlreadyRunning error.
+type SetSyncJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	limit  int
+
+	running      ua.Bool
+	numSets      int
+	numProcessed int
+}
+
+// newSetSyncJob creates a new in-memory SetSyncJob.
+//
+// WithLimit is the only supported option.
+func newSetSyncJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*SetSyncJob, error) {
+	const op = "dns.newSetSyncJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &SetSyncJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the set sync job. Total is the total
+// number of sets that are to be synced. Completed is the number of sets
+// already synced.
+func (r *SetSyncJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: r.numProcessed,
+		Total:     r.numSets,
+	}
+}
+
+// Run queries the dns host repo for sets that need to be synced and syncs
+// each of them. Can not be run in parallel, if Run is invoked while already
+// running an error with code JobAlreadyRunning will be returned.
+func (r *SetSyncJob) Run(ctx context.Context) error {
+	const op = "dns.(SetSyncJob).Run"
+	if !r.running.CAS(r.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer r.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	var setAggs []*hostSetAgg
+	// Fetch all sets that will reach their sync point within the syncWindow.
+	// This is done to avoid constantly scheduling the set sync job when there
+	// are multiple sets to sync in sequence.
+	err := r.reader.SearchWhere(ctx, &setAggs, setSyncJobQuery, []any{-1 * setSyncJobRunInterval.Seconds()}, db.WithLimit(r.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numSets for status report
+	r.numProcessed, r.numSets = 0, len(setAggs)
+	if len(setAggs) == 0 {
+		// Nothing to do, return early
+		return nil
+	}
+
+	return r.syncSets(ctx, setAggs)
+}
+
+// NextRunIn queries the dns host set db to determine when the next set
+// should be synced.
+func (r *SetSyncJob) NextRunIn(ctx context.Context) (time.Duration, error) {
+	const op = "dns.(SetSyncJob).NextRunIn"
+	rows, err := r.reader.Query(ctx, setSyncNextRunInQuery, []any{setSyncJobRunInterval})
+	if err != nil {
+		return setSyncJobRunInterval, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return setSyncJobRunInterval, nil
+	}
+
+	type NextResync struct {
+		SyncNow             bool
+		SyncIntervalSeconds int32
+		ResyncIn            time.Duration
+	}
+	var n NextResync
+	if err := r.reader.ScanRows(ctx, rows, &n); err != nil {
+		return setSyncJobRunInterval, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case n.SyncNow:
+		// Immediate
+		return 0, nil
+	case n.SyncIntervalSeconds < 0:
+		// Automatic syncing is disabled; the job is scheduled at the default
+		// cadence only to recalculate the next run time.
+		return setSyncJobRunInterval, nil
+	case n.ResyncIn < 0:
+		// Immediate
+		return 0, nil
+	}
+	return n.ResyncIn * time.Second, nil
+}
+
+// Name is the unique name of the job.
+func (r *SetSyncJob) Name() string {
+	return setSyncJobName
+}
+
+// Description is the human readable description of the job.
+func (r *SetSyncJob) Description() string {
+	return "Periodically resolves the names of dns host sets and updates their hosts."
+}
+
+// syncSets resolves the names of the provided host sets and updates their
+// hosts and memberships in the database. A failure to sync one set is
+// reported and does not prevent the other sets from being synced.
+func (r *SetSyncJob) syncSets(ctx context.Context, setAggs []*hostSetAgg) error {
+	const op = "dns.(SetSyncJob).syncSets"
+	catIds := make([]string, 0, len(setAggs))
+	for _, ag := range setAggs {
+		catIds = append(catIds, ag.CatalogId)
+	}
+	var catAggs []*catalogAgg
+	if err := r.reader.SearchWhere(ctx, &catAggs, "public_id in (?)", []any{catIds}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("can't retrieve catalogs %v", catIds)))
+	}
+	catalogs := make(map[string]*HostCatalog, len(catAggs))
+	for _, ca := range catAggs {
+		catalogs[ca.PublicId] = ca.toCatalog()
+	}
+
+	for _, ag := range setAggs {
+		s := ag.toHostSet()
+		c, ok := catalogs[s.CatalogId]
+		if !ok {
+			event.WriteError(ctx, op, errors.New(ctx, errors.NotSpecificIntegrity, op, "no catalog for set"), event.WithInfoMsg("syncing set", "set id", s.PublicId))
+			r.numProcessed++
+			continue
+		}
+		if err := r.syncSet(ctx, c, s); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("syncing set", "set id", s.PublicId))
+		}
+		r.numProcessed++
+	}
+	return nil
+}
+
+// syncSet resolves the names of s and makes the hosts found the members of
+// s. Hosts which were removed from s and are not in any other set of the
+// catalog are deleted. If any name of s can not be resolved, s is left
+// unchanged.
+func (r *SetSyncJob) syncSet(ctx context.Context, c *HostCatalog, s *HostSet) error {
+	const op = "dns.(SetSyncJob).syncSet"
+	res := newResolver(c.Resolvers)
+	found := make(map[string]*Host)
+	for _, name := range s.Names {
+		infos, err := res.lookup(ctx, RecordType(s.RecordType), name)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		for _, hi := range infos {
+			id, err := newHostId(ctx, c.PublicId, hi.externalId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			h, ok := found[id]
+			if !ok {
+				h = NewHost(ctx, c.PublicId, hi.externalId, WithPublicId(id), WithName(hi.name))
+				found[id] = h
+			}
+			h.IpAddresses = mergeStrings(h.IpAddresses, hi.ipAddresses)
+			h.DnsNames = mergeStrings(h.DnsNames, hi.dnsNames)
+		}
+	}
+
+	foundIds := make([]string, 0, len(found))
+	for id := range found {
+		foundIds = append(foundIds, id)
+	}
+	current := make(map[string]*Host, len(found))
+	if len(foundIds) > 0 {
+		var aggs []*hostAgg
+		if err := r.reader.SearchWhere(ctx, &aggs, "public_id in (?)", []any{foundIds}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("looking up current hosts"))
+		}
+		for _, ha := range aggs {
+			current[ha.PublicId] = ha.toHost()
+		}
+	}
+	var members []*HostSetMember
+	if err := r.reader.SearchWhere(ctx, &members, "set_id = ?", []any{s.PublicId}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("looking up current members"))
+	}
+	var removedIds []string
+	currentMembers := make(map[string]bool, len(members))
+	for _, m := range members {
+		currentMembers[m.HostId] = true
+		if _, ok := found[m.HostId]; !ok {
+			removedIds = append(removedIds, m.HostId)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var msgs []*oplog.Message
+			for _, id := range foundIds {
+				hMsgs, err := upsertHost(ctx, w, found[id], current[id])
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, hMsgs...)
+
+				if currentMembers[id] {
+					continue
+				}
+				m, err := NewHostSetMember(ctx, s.PublicId, id)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				var mOplogMsg oplog.Message
+				if err := w.Create(ctx, m, db.NewOplogMsg(&mOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, &mOplogMsg)
+			}
+
+			for _, id := range removedIds {
+				m, err := NewHostSetMember(ctx, s.PublicId, id)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				var mOplogMsg oplog.Message
+				rows, err := w.Delete(ctx, m, db.NewOplogMsg(&mOplogMsg))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rows != 1 {
+					return errors.New(ctx, errors.RecordNotFound, op, "record not found when deleting set membership")
+				}
+				msgs = append(msgs, &mOplogMsg)
+			}
+			orphanMsgs, err := deleteOrphanedHosts(ctx, reader, w, removedIds)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, orphanMsgs...)
+
+			if len(msgs) > 0 {
+				ticket, err := w.GetTicket(ctx, s)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+				}
+				metadata := s.oplog(oplog.OpType_OP_TYPE_UPDATE)
+				if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+				}
+			}
+
+			numRows, err := w.Exec(ctx, updateSyncDataQuery, []any{s.PublicId})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("updating last sync time"))
+			}
+			if numRows != 1 {
+				return errors.New(ctx, errors.Internal, op, fmt.Sprintf("host set (%v) synced, but failed to update repo", s.PublicId))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// upsertHost writes h, and its ip addresses and dns names, if it differs
+// from current. current is nil if h does not exist yet. It returns the
+// oplog messages of the writes.
+func upsertHost(ctx context.Context, w db.Writer, h, current *Host) ([]*oplog.Message, error) {
+	const op = "dns.upsertHost"
+	var ipsToAdd, ipsToRemove, namesToAdd, namesToRemove []string
+	if current == nil {
+		current = allocHost()
+	}
+	ipsToAdd, ipsToRemove = diffStrings(current.IpAddresses, h.IpAddresses)
+	namesToAdd, namesToRemove = diffStrings(current.DnsNames, h.DnsNames)
+	if current.PublicId != "" &&
+		current.Name == h.Name &&
+		len(ipsToAdd) == 0 && len(ipsToRemove) == 0 &&
+		len(namesToAdd) == 0 && len(namesToRemove) == 0 {
+		return nil, nil
+	}
+
+	var msgs []*oplog.Message
+	ret := h.clone()
+	var hOplogMsg oplog.Message
+	onConflict := &db.OnConflict{
+		Target: db.Constraint("host_dns_host_pkey"),
+		Action: db.SetColumns([]string{"name", "version"}),
+	}
+	var rowsAffected int64
+	dbOpts := []db.Option{
+		db.NewOplogMsg(&hOplogMsg),
+		db.WithOnConflict(onConflict),
+		db.WithReturnRowsAffected(&rowsAffected),
+	}
+	// We always write the host itself as we need to update version for
+	// optimistic locking for any value object update.
+	if version := current.Version; version > 0 {
+		dbOpts = append(dbOpts, db.WithVersion(&version))
+		ret.Version = version + 1
+	}
+	if err := w.Create(ctx, ret, dbOpts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rowsAffected != 1 {
+		return nil, errors.New(ctx, errors.UnexpectedRowsAffected, op, "no rows affected during upsert")
+	}
+	msgs = append(msgs, &hOplogMsg)
+
+	if len(ipsToRemove) > 0 {
+		items := make([]any, 0, len(ipsToRemove))
+		for _, a := range ipsToRemove {
+			ip, err := host.NewIpAddress(ctx, h.PublicId, a)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			items = append(items, ip)
+		}
+		oplogMsgs := make([]*oplog.Message, 0, len(items))
+		if _, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&oplogMsgs)); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		msgs = append(msgs, oplogMsgs...)
+	}
+	if len(ipsToAdd) > 0 {
+		items := make([]any, 0, len(ipsToAdd))
+		for _, a := range ipsToAdd {
+			ip, err := host.NewIpAddress(ctx, h.PublicId, a)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			items = append(items, ip)
+		}
+		oplogMsgs := make([]*oplog.Message, 0, len(items))
+		if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&oplogMsgs)); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("adding ips %v for host %q", ipsToAdd, h.PublicId)))
+		}
+		msgs = append(msgs, oplogMsgs...)
+	}
+
+	if len(namesToRemove) > 0 {
+		items := make([]any, 0, len(namesToRemove))
+		for _, n := range namesToRemove {
+			dn, err := host.NewDnsName(ctx, h.PublicId, n)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			items = append(items, dn)
+		}
+		oplogMsgs := make([]*oplog.Message, 0, len(items))
+		if _, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&oplogMsgs)); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		msgs = append(msgs, oplogMsgs...)
+	}
+	if len(namesToAdd) > 0 {
+		items := make([]any, 0, len(namesToAdd))
+		for _, n := range namesToAdd {
+			dn, err := host.NewDnsName(ctx, h.PublicId, n)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			items = append(items, dn)
+		}
+		oplogMsgs := make([]*oplog.Message, 0, len(items))
+		if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&oplogMsgs)); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("adding dns names %v for host %q", namesToAdd, h.PublicId)))
+		}
+		msgs = append(msgs, oplogMsgs...)
+	}
+	return msgs, nil
+}
+
+// diffStrings returns the values of want which are not in have and the
+// values of have which are not in want.
+func diffStrings(have, want []string) (add, remove []string) {
+	haveSet := make(map[string]bool, len(have))
+	for _, v := range have {
+		haveSet[v] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, v := range want {
+		wantSet[v] = true
+		if !haveSet[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range have {
+		if !wantSet[v] {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
+
+// mergeStrings returns the sorted union of a and b.
+func mergeStrings(a, b []string) []string {
+	if len(b) == 0 {
+		return a
+	}
+	seen := make(map[string]bool, len(a)+len(b))
+	out := make([]string, 0, len(a)+len(b))
+	for _, v := range append(append([]string{}, a...), b...) {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSetSyncJob(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	_, err := newSetSyncJob(ctx, nil, rw, kmsCache)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = newSetSyncJob(ctx, rw, nil, kmsCache)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = newSetSyncJob(ctx, rw, rw, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	got, err := newSetSyncJob(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	assert.Equal(t, db.DefaultLimit, got.limit)
+	assert.Equal(t, setSyncJobName, got.Name())
+
+	got, err = newSetSyncJob(ctx, rw, rw, kmsCache, WithLimit(100))
+	require.NoError(t, err)
+	assert.Equal(t, 100, got.limit)
+}
+
+func TestSetSyncJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	srv := NewTestDnsServer(t, TestDnsRecords{
+		A: map[string][]string{
+			"web.boundary.test": {"10.0.0.1", "10.0.0.2"},
+			"db1.boundary.test": {"10.0.1.1"},
+		},
+		SRV: map[string][]string{
+			"_db._tcp.boundary.test": {"db1.boundary.test"},
+		},
+	})
+	cat := TestCatalog(t, conn, prj.GetPublicId(), WithResolvers([]string{srv.Addr}))
+	web := TestSet(t, conn, cat.GetPublicId(), []string{"web.boundary.test"})
+	dbs := TestSet(t, conn, cat.GetPublicId(), []string{"_db._tcp.boundary.test"}, WithRecordType(RecordTypeSrv))
+
+	job, err := newSetSyncJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	require.NoError(job.Run(ctx))
+	assert.Equal(2, job.Status().Total)
+	assert.Equal(2, job.Status().Completed)
+
+	webHosts, err := listHostBySetIds(ctx, rw, []string{web.GetPublicId()})
+	require.NoError(err)
+	var ips []string
+	for _, h := range webHosts {
+		assert.Equal("web.boundary.test", h.Name)
+		ips = append(ips, h.IpAddresses...)
+	}
+	assert.ElementsMatch([]string{"10.0.0.1", "10.0.0.2"}, ips)
+
+	dbHosts, err := listHostBySetIds(ctx, rw, []string{dbs.GetPublicId()})
+	require.NoError(err)
+	require.Len(dbHosts, 1)
+	assert.Equal("db1.boundary.test", dbHosts[0].ExternalId)
+	assert.Equal([]string{"db1.boundary.test"}, dbHosts[0].DnsNames)
+	assert.Equal([]string{"10.0.1.1"}, dbHosts[0].IpAddresses)
+
+	// Nothing needs a sync right after one.
+	next, err := job.NextRunIn(ctx)
+	require.NoError(err)
+	assert.Greater(next, time.Minute)
+	require.NoError(job.Run(ctx))
+	assert.Equal(0, job.Status().Total)
+
+	// Records changing are picked up by the next sync and hosts no longer
+	// found are removed.
+	srv.SetRecords(TestDnsRecords{
+		A: map[string][]string{
+			"web.boundary.test": {"10.0.0.2", "10.0.0.3"},
+			"db1.boundary.test": {"10.0.1.2"},
+		},
+		SRV: map[string][]string{
+			"_db._tcp.boundary.test": {"db1.boundary.test"},
+		},
+	})
+	_, err = rw.Exec(ctx, "update host_dns_set set need_sync = true", nil)
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+
+	webHosts, err = listHostBySetIds(ctx, rw, []string{web.GetPublicId()})
+	require.NoError(err)
+	ips = nil
+	for _, h := range webHosts {
+		ips = append(ips, h.IpAddresses...)
+	}
+	assert.ElementsMatch([]string{"10.0.0.2", "10.0.0.3"}, ips)
+
+	dbHosts, err = listHostBySetIds(ctx, rw, []string{dbs.GetPublicId()})
+	require.NoError(err)
+	require.Len(dbHosts, 1)
+	assert.Equal([]string{"10.0.1.2"}, dbHosts[0].IpAddresses)
+
+	var all []*Host
+	require.NoError(rw.SearchWhere(ctx, &all, "catalog_id = ?", []any{cat.GetPublicId()}))
+	assert.Len(all, 3, "the host for 10.0.0.1 must have been deleted")
+
+	// A set whose names can not be resolved keeps its hosts.
+	unreachableCat := TestCatalog(t, conn, prj.GetPublicId(), WithResolvers([]string{"127.0.0.1:1"}))
+	unreachable := TestSet(t, conn, unreachableCat.GetPublicId(), []string{"web.boundary.test"})
+	require.NoError(job.Run(ctx))
+	s := allocHostSet()
+	s.PublicId = unreachable.GetPublicId()
+	require.NoError(rw.LookupByPublicId(ctx, s))
+	assert.True(s.NeedSync)
+}
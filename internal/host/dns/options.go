@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withPublicId            string
+	withName                string
+	withDescription         string
+	withResolvers           []string
+	withNames               []string
+	withRecordType          string
+	withSyncIntervalSeconds int32
+	withIpAddresses         []string
+	withDnsNames            []string
+	withLimit               int
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(with string) Option {
+	return func(o *options) {
+		o.withPublicId = with
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithResolvers provides the optional addresses, as host:port, of the DNS
+// servers of a host catalog.
+func WithResolvers(with []string) Option {
+	return func(o *options) {
+		o.withResolvers = with
+	}
+}
+
+// WithNames provides the DNS names of a host set.
+func WithNames(with []string) Option {
+	return func(o *options) {
+		o.withNames = with
+	}
+}
+
+// WithRecordType provides the optional record type of a host set. If not
+// set, RecordTypeIp is used.
+func WithRecordType(with RecordType) Option {
+	return func(o *options) {
+		o.withRecordType = string(with)
+	}
+}
+
+// WithSyncIntervalSeconds provides an optional sync interval, in seconds.
+func WithSyncIntervalSeconds(with int32) Option {
+	return func(o *options) {
+		o.withSyncIntervalSeconds = with
+	}
+}
+
+// withIpAddresses provides an optional list of ip addresses.
+func withIpAddresses(with []string) Option {
+	return func(o *options) {
+		o.withIpAddresses = with
+	}
+}
+
+// withDnsNames provides an optional list of dns names.
+func withDnsNames(with []string) Option {
+	return func(o *options) {
+		o.withDnsNames = with
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithResolvers", func(t *testing.T) {
+		opts := getOpts(WithResolvers([]string{"127.0.0.1:53"}))
+		testOpts := getDefaultOptions()
+		testOpts.withResolvers = []string{"127.0.0.1:53"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithNames", func(t *testing.T) {
+		opts := getOpts(WithNames([]string{"web.example.com"}))
+		testOpts := getDefaultOptions()
+		testOpts.withNames = []string{"web.example.com"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRecordType", func(t *testing.T) {
+		opts := getOpts(WithRecordType(RecordTypeSrv))
+		testOpts := getDefaultOptions()
+		testOpts.withRecordType = "srv"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSyncIntervalSeconds", func(t *testing.T) {
+		opts := getOpts(WithSyncIntervalSeconds(5))
+		testOpts := getDefaultOptions()
+		testOpts.withSyncIntervalSeconds = 5
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withIpAddresses", func(t *testing.T) {
+		opts := getOpts(withIpAddresses([]string{"10.0.0.1"}))
+		testOpts := getDefaultOptions()
+		testOpts.withIpAddresses = []string{"10.0.0.1"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("withDnsNames", func(t *testing.T) {
+		opts := getOpts(withDnsNames([]string{"web.example.com"}))
+		testOpts := getDefaultOptions()
+		testOpts.withDnsNames = []string{"web.example.com"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
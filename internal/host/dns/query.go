@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+const (
+	setSyncNextRunInQuery = `
+select
+  need_sync as sync_now,
+  sync_interval_seconds,
+  case
+    when sync_interval_seconds is null
+      then
+        extract(epoch from (least(now(), last_sync_time) + ?) - now())::int
+    when sync_interval_seconds > 0
+      then
+        extract(epoch from (least(now(), last_sync_time)) - now())::int + sync_interval_seconds
+    else
+      0
+  end resync_in
+from host_dns_set
+order by need_sync desc, last_sync_time desc
+limit 1;
+`
+
+	setSyncJobQuery = `
+need_sync
+  or
+sync_interval_seconds is null and last_sync_time <= wt_add_seconds_to_now(?)
+  or
+sync_interval_seconds > 0 and wt_add_seconds(sync_interval_seconds, last_sync_time) <= current_timestamp
+`
+
+	updateSyncDataQuery = `
+update host_dns_set
+set
+  last_sync_time = current_timestamp,
+  need_sync = false
+where public_id = ?
+`
+
+	setNeedSyncForCatalogQuery = `
+update host_dns_set
+set
+  need_sync = true
+where catalog_id = ?
+`
+
+	orphanedHostsQuery = `
+public_id in (?)
+  and public_id not in
+    (select host_id from host_dns_set_member)
+`
+)
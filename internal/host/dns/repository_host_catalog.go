@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+// CreateCatalog inserts c into the repository and returns a new
+// HostCatalog containing the catalog's PublicId. c is not changed. c must
+// contain a valid ProjectId. c must not contain a PublicId. The PublicId is
+// generated and assigned by this method. WithPublicId is the only option
+// supported.
+//
+// Both c.Name and c.Description are optional. If c.Name is set, it must be
+// unique within c.ProjectId. c.Resolvers are normalized to host:port and
+// stored in the order given.
+//
+// Both c.CreateTime and c.UpdateTime are ignored.
+func (r *Repository) CreateCatalog(ctx context.Context, c *HostCatalog, opt ...Option) (*HostCatalog, error) {
+	const op = "dns.(Repository).CreateCatalog"
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil HostCatalog")
+	}
+	if c.HostCatalog == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded HostCatalog")
+	}
+	if c.ProjectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if c.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	c = c.clone()
+
+	opts := getOpts(opt...)
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.DnsHostCatalogPrefix+"_") {
+			return nil, errors.New(ctx,
+				errors.InvalidPublicId,
+				op,
+				fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.DnsHostCatalogPrefix),
+			)
+		}
+		c.PublicId = opts.withPublicId
+	} else {
+		id, err := newHostCatalogId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		c.PublicId = id
+	}
+
+	var err error
+	if c.Resolvers, err = normalizeResolvers(ctx, c.Resolvers); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	resolvers, err := c.resolvers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newHostCatalog *HostCatalog
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, len(resolvers)+1)
+			ticket, err := w.GetTicket(ctx, c)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			newHostCatalog = c.clone()
+			var cOplogMsg oplog.Message
+			if err := w.Create(ctx, newHostCatalog, db.NewOplogMsg(&cOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, &cOplogMsg)
+
+			if len(resolvers) > 0 {
+				rOplogMsgs := make([]*oplog.Message, 0, len(resolvers))
+				if err := w.CreateItems(ctx, resolvers, db.NewOplogMsgs(&rOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, rOplogMsgs...)
+			}
+
+			metadata := c.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s: name %s already exists", c.ProjectId, c.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in project: %s", c.ProjectId)))
+	}
+	return newHostCatalog, nil
+}
+
+// UpdateCatalog updates the repository entry for c.PublicId with the
+// values in c for the fields listed in fieldMask. It returns a new
+// HostCatalog containing the updated values and a count of the number of
+// records updated. c is not changed.
+//
+// c must contain a valid PublicId. Only c.Name, c.Description and
+// c.Resolvers can be updated. If c.Name is set to a non-empty string, it
+// must be unique within c.ProjectId. Updating the resolvers of a catalog
+// causes all of its host sets to be synced.
+//
+// An attribute of c will be set to NULL in the database if the attribute
+// in c is the zero value and it is included in fieldMask.
+func (r *Repository) UpdateCatalog(ctx context.Context, c *HostCatalog, version uint32, fieldMask []string, _ ...Option) (*HostCatalog, int, error) {
+	const op = "dns.(Repository).UpdateCatalog"
+	if c == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil HostCatalog")
+	}
+	if c.HostCatalog == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded HostCatalog")
+	}
+	if c.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if c.ProjectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if len(fieldMask) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	var updateResolvers bool
+	var dbMask, nullFields []string
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold("name", f) && c.Name == "":
+			nullFields = append(nullFields, "name")
+		case strings.EqualFold("name", f) && c.Name != "":
+			dbMask = append(dbMask, "name")
+		case strings.EqualFold("description", f) && c.Description == "":
+			nullFields = append(nullFields, "description")
+		case strings.EqualFold("description", f) && c.Description != "":
+			dbMask = append(dbMask, "description")
+		case strings.EqualFold("Resolvers", f):
+			updateResolvers = true
+
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	current, err := r.LookupCatalog(ctx, c.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("host catalog id %q not found", c.PublicId))
+	}
+	if current.ProjectId != c.ProjectId {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("catalog id %q not in project id %q", c.PublicId, c.ProjectId))
+	}
+	if current.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("catalog version mismatch, want=%d, got=%d", current.Version, version))
+	}
+
+	c = c.clone()
+	var resolvers []any
+	if updateResolvers {
+		if c.Resolvers, err = normalizeResolvers(ctx, c.Resolvers); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if resolvers, err = c.resolvers(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCatalog *HostCatalog
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, len(resolvers)+len(current.Resolvers)+1)
+			ticket, err := w.GetTicket(ctx, c)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			updated := c.clone()
+			if updateResolvers {
+				if len(current.Resolvers) > 0 {
+					oldResolvers, err := current.resolvers(ctx)
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					deleteOplogMsgs := make([]*oplog.Message, 0, len(oldResolvers))
+					if _, err := w.DeleteItems(ctx, oldResolvers, db.NewOplogMsgs(&deleteOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					msgs = append(msgs, deleteOplogMsgs...)
+				}
+				if len(resolvers) > 0 {
+					createOplogMsgs := make([]*oplog.Message, 0, len(resolvers))
+					if err := w.CreateItems(ctx, resolvers, db.NewOplogMsgs(&createOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					msgs = append(msgs, createOplogMsgs...)
+				}
+				// The version of the catalog must change along with its
+				// resolvers even if none of its own columns did.
+				if len(dbMask) == 0 && len(nullFields) == 0 {
+					updated.Version = version + 1
+					dbMask = append(dbMask, "version")
+				}
+			}
+
+			if len(dbMask) != 0 || len(nullFields) != 0 {
+				var cOplogMsg oplog.Message
+				rowsUpdated, err = w.Update(
+					ctx,
+					updated,
+					dbMask,
+					nullFields,
+					db.NewOplogMsg(&cOplogMsg),
+					db.WithVersion(&version),
+				)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+				}
+				msgs = append(msgs, &cOplogMsg)
+			}
+
+			if len(msgs) != 0 {
+				metadata := c.oplog(oplog.OpType_OP_TYPE_UPDATE)
+				if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+				}
+			}
+
+			agg := &catalogAgg{PublicId: c.PublicId}
+			if err := reader.LookupByPublicId(ctx, agg); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("looking up catalog after update"))
+			}
+			returnedCatalog = agg.toCatalog()
+
+			if updateResolvers {
+				// Sync every set of the catalog with the new resolvers.
+				if _, err := w.Exec(ctx, setNeedSyncForCatalogQuery, []any{c.PublicId}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("flagging host sets for sync"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: name %s already exists", c.PublicId, c.Name)))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", c.PublicId)))
+	}
+
+	if updateResolvers {
+		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, setSyncJobName, 0, scheduler.WithRunNow(true))
+	}
+
+	return returnedCatalog, rowsUpdated, nil
+}
+
+// LookupCatalog returns the HostCatalog for id. Returns nil, nil if no
+// HostCatalog is found for id.
+func (r *Repository) LookupCatalog(ctx context.Context, id string, _ ...Option) (*HostCatalog, error) {
+	const op = "dns.(Repository).LookupCatalog"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	agg := &catalogAgg{PublicId: id}
+	if err := r.reader.LookupByPublicId(ctx, agg); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	return agg.toCatalog(), nil
+}
+
+// ListCatalogs returns a slice of HostCatalogs for the project IDs. WithLimit
+// is the only option supported.
+func (r *Repository) ListCatalogs(ctx context.Context, projectIds []string, opt ...Option) ([]*HostCatalog, error) {
+	const op = "dns.(Repository).ListCatalogs"
+	if len(projectIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var aggs []*catalogAgg
+	if err := r.reader.SearchWhere(ctx, &aggs, "project_id in (?)", []any{projectIds}, db.WithLimit(limit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	catalogs := make([]*HostCatalog, 0, len(aggs))
+	for _, agg := range aggs {
+		catalogs = append(catalogs, agg.toCatalog())
+	}
+	return catalogs, nil
+}
+
+// DeleteCatalog deletes id from the repository returning a count of the
+// number of records deleted. The host sets and hosts of the catalog are
+// deleted with it.
+func (r *Repository) DeleteCatalog(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "dns.(Repository).DeleteCatalog"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	c := allocHostCatalog()
+	c.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+		if errors.IsNotFoundError(err) {
+			return db.NoRowsAffected, nil
+		}
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", id)))
+	}
+	if c.ProjectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, c.ProjectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dc := c.clone()
+			rowsDeleted, err = w.Delete(ctx, dc, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", c.PublicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+func normalizeResolvers(ctx context.Context, in []string) ([]string, error) {
+	const op = "dns.normalizeResolvers"
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make([]string, 0, len(in))
+	seen := make(map[string]bool, len(in))
+	for _, a := range in {
+		n, err := NormalizeResolver(ctx, a)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if seen[n] {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("duplicate resolver %q", n))
+		}
+		seen[n] = true
+		out = append(out, n)
+	}
+	return out, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCatalog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		wantResolvers []string
+		wantIsErr     errors.Code
+	}{
+		{
+			name: "no-resolvers",
+		},
+		{
+			name:          "resolvers-in-order-with-default-port",
+			opts:          []Option{WithResolvers([]string{"10.0.0.2", "10.0.0.1:5353"})},
+			wantResolvers: []string{"10.0.0.2:53", "10.0.0.1:5353"},
+		},
+		{
+			name:      "invalid-resolver",
+			opts:      []Option{WithResolvers([]string{"dns.example.com"})},
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "duplicate-resolver",
+			opts:      []Option{WithResolvers([]string{"10.0.0.1", "10.0.0.1:53"})},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			in, err := NewHostCatalog(ctx, prj.GetPublicId(), append([]Option{WithName(tt.name)}, tt.opts...)...)
+			require.NoError(err)
+			got, err := repo.CreateCatalog(ctx, in)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10)))
+
+			found, err := repo.LookupCatalog(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(tt.name, found.Name)
+			assert.Equal(tt.wantResolvers, found.Resolvers)
+		})
+	}
+}
+
+func TestRepository_UpdateCatalog_Resolvers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(err)
+
+	cat := TestCatalog(t, conn, prj.GetPublicId(), WithResolvers([]string{"10.0.0.1"}))
+	set := TestSet(t, conn, cat.GetPublicId(), []string{"web.boundary.test"})
+	_, err = rw.Exec(ctx, "update host_dns_set set need_sync = false where public_id = ?", []any{set.GetPublicId()})
+	require.NoError(err)
+
+	cat.Resolvers = []string{"10.0.0.3", "10.0.0.2"}
+	got, n, err := repo.UpdateCatalog(ctx, cat, 1, []string{"Resolvers"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(uint32(2), got.Version)
+	assert.Equal([]string{"10.0.0.3:53", "10.0.0.2:53"}, got.Resolvers)
+
+	s, err := repo.LookupSet(ctx, set.GetPublicId())
+	require.NoError(err)
+	assert.True(s.NeedSync, "updating resolvers must flag the catalog's sets for sync")
+
+	cat.Resolvers = nil
+	got, _, err = repo.UpdateCatalog(ctx, cat, 2, []string{"Resolvers"})
+	require.NoError(err)
+	assert.Empty(got.Resolvers)
+
+	_, _, err = repo.UpdateCatalog(ctx, cat, 2, []string{"Resolvers"})
+	assert.Truef(errors.Match(errors.T(errors.VersionMismatch), err), "unexpected error: %v", err)
+}
+
+func TestRepository_ListCatalogs_DeleteCatalog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(err)
+
+	c1 := TestCatalog(t, conn, prj.GetPublicId(), WithResolvers([]string{"10.0.0.1"}))
+	c2 := TestCatalog(t, conn, prj.GetPublicId())
+
+	got, err := repo.ListCatalogs(ctx, []string{prj.GetPublicId()})
+	require.NoError(err)
+	assert.Len(got, 2)
+
+	got, err = repo.ListCatalogs(ctx, []string{prj.GetPublicId()}, WithLimit(1))
+	require.NoError(err)
+	assert.Len(got, 1)
+
+	n, err := repo.DeleteCatalog(ctx, c1.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.NoError(db.TestVerifyOplog(t, rw, c1.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_DELETE), db.WithCreateNotBefore(10)))
+
+	got, err = repo.ListCatalogs(ctx, []string{prj.GetPublicId()})
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(c2.GetPublicId(), got[0].GetPublicId())
+
+	n, err = repo.DeleteCatalog(ctx, c1.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, n)
+}
@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/endpoint"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+// CreateSet inserts s into the repository and returns a new HostSet
+// containing the host set's PublicId. s is not changed. s must contain a
+// valid CatalogId and at least one name. s must not contain a PublicId. The
+// PublicId is generated and assigned by this method. WithPublicId is the
+// only option supported.
+//
+// Both s.Name and s.Description are optional. If s.Name is set, it must be
+// unique within s.CatalogId. The new set is synced immediately.
+func (r *Repository) CreateSet(ctx context.Context, projectId string, s *HostSet, opt ...Option) (*HostSet, error) {
+	const op = "dns.(Repository).CreateSet"
+	if s == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil HostSet")
+	}
+	if s.HostSet == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded HostSet")
+	}
+	if s.CatalogId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	}
+	if s.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if len(s.Names) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no names")
+	}
+	if !RecordType(s.RecordType).Valid() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown record type %q", s.RecordType))
+	}
+	if s.SyncIntervalSeconds < -1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid sync interval")
+	}
+	s = s.clone()
+
+	opts := getOpts(opt...)
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.DnsHostSetPrefix+"_") {
+			return nil, errors.New(ctx,
+				errors.InvalidPublicId,
+				op,
+				fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.DnsHostSetPrefix),
+			)
+		}
+		s.PublicId = opts.withPublicId
+	} else {
+		id, err := newHostSetId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		s.PublicId = id
+	}
+	s.LastSyncTime = timestamp.New(time.Unix(0, 0))
+	s.NeedSync = true
+
+	names, err := s.names(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedHostSet *HostSet
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, len(names)+1)
+			ticket, err := w.GetTicket(ctx, s)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			returnedHostSet = s.clone()
+			var hsOplogMsg oplog.Message
+			if err := w.Create(ctx, returnedHostSet, db.NewOplogMsg(&hsOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, &hsOplogMsg)
+
+			nOplogMsgs := make([]*oplog.Message, 0, len(names))
+			if err := w.CreateItems(ctx, names, db.NewOplogMsgs(&nOplogMsgs)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, nOplogMsgs...)
+
+			metadata := s.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s: name %s already exists", s.CatalogId, s.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s", s.CatalogId)))
+	}
+
+	// Find the hosts of the new set immediately.
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, setSyncJobName, 0, scheduler.WithRunNow(true))
+
+	return returnedHostSet, nil
+}
+
+// UpdateSet updates the repository entry for s.PublicId with the values in
+// s for the fields listed in fieldMask. It returns a new HostSet containing
+// the updated values, the hosts currently in the set and a count of the
+// number of records updated. s is not changed.
+//
+// s must contain a valid PublicId. Only s.Name, s.Description, s.Names,
+// s.RecordType and s.SyncIntervalSeconds can be updated. If s.Name is set
+// to a non-empty string, it must be unique within s.CatalogId. Changing
+// the names or the record type of a set causes it to be synced.
+func (r *Repository) UpdateSet(ctx context.Context, projectId string, s *HostSet, version uint32, fieldMask []string, opt ...Option) (*HostSet, []*Host, int, error) {
+	const op = "dns.(Repository).UpdateSet"
+	if s == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil HostSet")
+	}
+	if s.HostSet == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded HostSet")
+	}
+	if s.PublicId == "" {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if projectId == "" {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if len(fieldMask) == 0 {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	currentSet, err := r.LookupSet(ctx, s.PublicId)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if currentSet == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("host set id %q not found", s.PublicId))
+	}
+	if currentSet.GetVersion() != version {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("set version mismatch, want=%d, got=%d", currentSet.GetVersion(), version))
+	}
+
+	newSet := currentSet.clone()
+	var updateNames, updateSyncInterval bool
+	var dbMask, nullFields []string
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold("name", f) && s.Name == "":
+			nullFields = append(nullFields, "name")
+			newSet.Name = s.Name
+		case strings.EqualFold("name", f) && s.Name != "":
+			dbMask = append(dbMask, "name")
+			newSet.Name = s.Name
+		case strings.EqualFold("description", f) && s.Description == "":
+			nullFields = append(nullFields, "description")
+			newSet.Description = s.Description
+		case strings.EqualFold("description", f) && s.Description != "":
+			dbMask = append(dbMask, "description")
+			newSet.Description = s.Description
+		case strings.EqualFold("SyncIntervalSeconds", f) && s.SyncIntervalSeconds == 0:
+			nullFields = append(nullFields, "SyncIntervalSeconds")
+			newSet.SyncIntervalSeconds = s.SyncIntervalSeconds
+			updateSyncInterval = true
+		case strings.EqualFold("SyncIntervalSeconds", f) && s.SyncIntervalSeconds != 0:
+			if s.SyncIntervalSeconds < -1 {
+				return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "invalid sync interval")
+			}
+			dbMask = append(dbMask, "SyncIntervalSeconds")
+			newSet.SyncIntervalSeconds = s.SyncIntervalSeconds
+			updateSyncInterval = true
+		case strings.EqualFold("RecordType", f):
+			recordType := RecordType(s.RecordType)
+			if recordType == "" {
+				recordType = RecordTypeIp
+			}
+			if !recordType.Valid() {
+				return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown record type %q", s.RecordType))
+			}
+			dbMask = append(dbMask, "RecordType", "NeedSync")
+			newSet.RecordType = string(recordType)
+			newSet.NeedSync = true
+		case strings.EqualFold("Names", f):
+			if len(s.Names) == 0 {
+				return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no names")
+			}
+			updateNames = true
+			newSet.Names = s.Names
+			newSet.NeedSync = true
+
+		default:
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	if updateNames && !strutil.StrListContains(dbMask, "NeedSync") {
+		dbMask = append(dbMask, "NeedSync")
+	}
+
+	var names []any
+	if updateNames {
+		if names, err = newSet.names(ctx); err != nil {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedSet *HostSet
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, len(names)+len(currentSet.Names)+1)
+			ticket, err := w.GetTicket(ctx, s)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			if updateNames {
+				oldNames, err := currentSet.names(ctx)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				deleteOplogMsgs := make([]*oplog.Message, 0, len(oldNames))
+				if _, err := w.DeleteItems(ctx, oldNames, db.NewOplogMsgs(&deleteOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, deleteOplogMsgs...)
+
+				createOplogMsgs := make([]*oplog.Message, 0, len(names))
+				if err := w.CreateItems(ctx, names, db.NewOplogMsgs(&createOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, createOplogMsgs...)
+			}
+
+			updated := newSet.clone()
+			var hsOplogMsg oplog.Message
+			rowsUpdated, err = w.Update(
+				ctx,
+				updated,
+				dbMask,
+				nullFields,
+				db.NewOplogMsg(&hsOplogMsg),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 set to be updated, got %d", rowsUpdated))
+			}
+			msgs = append(msgs, &hsOplogMsg)
+
+			metadata := s.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+
+			agg := &hostSetAgg{PublicId: s.PublicId}
+			if err := reader.LookupByPublicId(ctx, agg); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("looking up host set after update"))
+			}
+			returnedSet = agg.toHostSet()
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: name %s already exists", newSet.PublicId, newSet.Name)))
+		}
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", newSet.PublicId)))
+	}
+
+	hosts, err := listHostBySetIds(ctx, r.reader, []string{returnedSet.PublicId}, opt...)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	switch {
+	case returnedSet.NeedSync:
+		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, setSyncJobName, 0, scheduler.WithRunNow(true))
+	case updateSyncInterval:
+		var schOpt []scheduler.Option
+		tilNextSync := time.Until(returnedSet.LastSyncTime.AsTime().Add(time.Duration(returnedSet.SyncIntervalSeconds) * time.Second))
+		if tilNextSync <= 0 {
+			tilNextSync = 0
+			schOpt = append(schOpt, scheduler.WithRunNow(true))
+		}
+		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, setSyncJobName, tilNextSync, schOpt...)
+	}
+
+	return returnedSet, hosts, rowsUpdated, nil
+}
+
+// LookupSet will look up a host set in the repository and return the host
+// set with the ids of its hosts. If the host set is not found, it will
+// return nil, nil. All options are ignored.
+func (r *Repository) LookupSet(ctx context.Context, publicId string, _ ...Option) (*HostSet, error) {
+	const op = "dns.(Repository).LookupSet"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	agg := &hostSetAgg{PublicId: publicId}
+	if err := r.reader.LookupByPublicId(ctx, agg); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	return agg.toHostSet(), nil
+}
+
+// ListSets returns a slice of HostSets for the catalogId. WithLimit is the
+// only option supported.
+func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Option) ([]*HostSet, error) {
+	const op = "dns.(Repository).ListSets"
+	if catalogId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing catalog id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var aggs []*hostSetAgg
+	if err := r.reader.SearchWhere(ctx, &aggs, "catalog_id = ?", []any{catalogId}, db.WithLimit(limit)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sets := make([]*HostSet, 0, len(aggs))
+	for _, agg := range aggs {
+		sets = append(sets, agg.toHostSet())
+	}
+	return sets, nil
+}
+
+// DeleteSet deletes the host set for the provided id from the repository
+// returning a count of the number of records deleted. Hosts which are no
+// longer in any set of the catalog are deleted with it. All options are
+// ignored.
+func (r *Repository) DeleteSet(ctx context.Context, projectId string, publicId string, _ ...Option) (int, error) {
+	const op = "dns.(Repository).DeleteSet"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if projectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	s, err := r.LookupSet(ctx, publicId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return db.NoRowsAffected, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) (err error) {
+			msgs := make([]*oplog.Message, 0, len(s.HostIds)+1)
+			ticket, err := w.GetTicket(ctx, s)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			ds := s.clone()
+			var hsOplogMsg oplog.Message
+			rowsDeleted, err = w.Delete(ctx, ds, db.NewOplogMsg(&hsOplogMsg))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			msgs = append(msgs, &hsOplogMsg)
+
+			hostMsgs, err := deleteOrphanedHosts(ctx, reader, w, s.HostIds)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, hostMsgs...)
+
+			metadata := s.oplog(oplog.OpType_OP_TYPE_DELETE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", s.PublicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+// Endpoints provides all the endpoints available for the given set ids. If
+// a host does not contain an addressable endpoint it is not included in the
+// resulting slice of endpoints.
+func (r *Repository) Endpoints(ctx context.Context, setIds []string) ([]*host.Endpoint, error) {
+	const op = "dns.(Repository).Endpoints"
+	if len(setIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no set ids")
+	}
+
+	var setMembers []*HostSetMember
+	if err := r.reader.SearchWhere(ctx, &setMembers, "set_id in (?)", []any{setIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("can't retrieve set members for sets %v", setIds)))
+	}
+	if len(setMembers) == 0 {
+		return nil, nil
+	}
+
+	hostIdToSetIds := make(map[string][]string)
+	for _, m := range setMembers {
+		hostIdToSetIds[m.GetHostId()] = append(hostIdToSetIds[m.GetHostId()], m.GetSetId())
+	}
+	hostIds := make([]string, 0, len(hostIdToSetIds))
+	for hid := range hostIdToSetIds {
+		hostIds = append(hostIds, hid)
+	}
+	var hostAggs []*hostAgg
+	if err := r.reader.SearchWhere(ctx, &hostAggs, "public_id in (?)", []any{hostIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("can't retrieve hosts %v", hostIds)))
+	}
+	if len(hostAggs) == 0 {
+		return nil, nil
+	}
+
+	pref, err := endpoint.NewPreferencer(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("getting preferencer"))
+	}
+	var es []*host.Endpoint
+	for _, ha := range hostAggs {
+		h := ha.toHost()
+		var opts []endpoint.Option
+		if len(h.GetIpAddresses()) > 0 {
+			opts = append(opts, endpoint.WithIpAddrs(h.GetIpAddresses()))
+		}
+		if len(h.GetDnsNames()) > 0 {
+			opts = append(opts, endpoint.WithDnsNames(h.GetDnsNames()))
+		}
+		addr, err := pref.Choose(ctx, opts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if addr == "" {
+			continue
+		}
+		for _, sId := range hostIdToSetIds[h.GetPublicId()] {
+			es = append(es, &host.Endpoint{
+				HostId:     h.GetPublicId(),
+				SetId:      sId,
+				Address:    addr,
+				Name:       h.GetName(),
+				ExternalId: h.GetExternalId(),
+				// DNS records carry no labels.
+				Labels: map[string]string{},
+			})
+		}
+	}
+
+	return es, nil
+}
+
+// deleteOrphanedHosts deletes the hosts in hostIds which are no longer a
+// member of any set and returns the oplog messages for the deletions.
+func deleteOrphanedHosts(ctx context.Context, reader db.Reader, w db.Writer, hostIds []string) ([]*oplog.Message, error) {
+	const op = "dns.deleteOrphanedHosts"
+	if len(hostIds) == 0 {
+		return nil, nil
+	}
+	var orphans []*Host
+	if err := reader.SearchWhere(ctx, &orphans, orphanedHostsQuery, []any{hostIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(orphans) == 0 {
+		return nil, nil
+	}
+	items := make([]any, 0, len(orphans))
+	for _, h := range orphans {
+		items = append(items, h)
+	}
+	msgs := make([]*oplog.Message, 0, len(items))
+	if _, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&msgs)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return msgs, nil
+}
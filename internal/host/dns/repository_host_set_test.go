@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalog(t, conn, prj.GetPublicId())
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		opts           []Option
+		wantRecordType string
+		wantIsErr      errors.Code
+	}{
+		{
+			name:           "default-record-type",
+			opts:           []Option{WithNames([]string{"web.boundary.test"})},
+			wantRecordType: "ip",
+		},
+		{
+			name:           "srv",
+			opts:           []Option{WithNames([]string{"_db._tcp.boundary.test"}), WithRecordType(RecordTypeSrv), WithSyncIntervalSeconds(30)},
+			wantRecordType: "srv",
+		},
+		{
+			name:      "no-names",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "invalid-sync-interval",
+			opts:      []Option{WithNames([]string{"web.boundary.test"}), WithSyncIntervalSeconds(-2)},
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			in, err := NewHostSet(ctx, cat.GetPublicId(), append([]Option{WithName(tt.name)}, tt.opts...)...)
+			require.NoError(err)
+			got, err := repo.CreateSet(ctx, prj.GetPublicId(), in)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.True(got.NeedSync)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10)))
+
+			found, err := repo.LookupSet(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(tt.wantRecordType, found.RecordType)
+			assert.Equal(in.Names, found.Names)
+			assert.Equal(in.SyncIntervalSeconds, found.SyncIntervalSeconds)
+		})
+	}
+}
+
+func TestRepository_UpdateSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalog(t, conn, prj.GetPublicId())
+	assert, require := assert.New(t), require.New(t)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(err)
+
+	set := TestSet(t, conn, cat.GetPublicId(), []string{"web.boundary.test"})
+	_, err = rw.Exec(ctx, "update host_dns_set set need_sync = false where public_id = ?", []any{set.GetPublicId()})
+	require.NoError(err)
+	set, err = repo.LookupSet(ctx, set.GetPublicId())
+	require.NoError(err)
+
+	set.Name = "updated"
+	got, _, n, err := repo.UpdateSet(ctx, prj.GetPublicId(), set, set.Version, []string{"name"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("updated", got.Name)
+	assert.False(got.NeedSync, "updating the name must not require a sync")
+
+	got.Names = []string{"api.boundary.test", "web.boundary.test"}
+	got.RecordType = string(RecordTypeIp4)
+	got, _, n, err = repo.UpdateSet(ctx, prj.GetPublicId(), got, got.Version, []string{"Names", "RecordType"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal([]string{"api.boundary.test", "web.boundary.test"}, got.Names)
+	assert.Equal("ip4", got.RecordType)
+	assert.True(got.NeedSync)
+
+	got.Names = nil
+	_, _, _, err = repo.UpdateSet(ctx, prj.GetPublicId(), got, got.Version, []string{"Names"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	got.RecordType = "mx"
+	_, _, _, err = repo.UpdateSet(ctx, prj.GetPublicId(), got, got.Version, []string{"RecordType"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	_, _, _, err = repo.UpdateSet(ctx, prj.GetPublicId(), got, got.Version, []string{"attributes.foo"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "unexpected error: %v", err)
+}
+
+func TestRepository_DeleteSet_Endpoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	assert, require := assert.New(t), require.New(t)
+
+	srv := NewTestDnsServer(t, TestDnsRecords{
+		A: map[string][]string{
+			"web.boundary.test": {"10.0.0.1", "10.0.0.2"},
+			"api.boundary.test": {"10.0.0.2"},
+		},
+	})
+	cat := TestCatalog(t, conn, prj.GetPublicId(), WithResolvers([]string{srv.Addr}))
+	web := TestSet(t, conn, cat.GetPublicId(), []string{"web.boundary.test"})
+	api := TestSet(t, conn, cat.GetPublicId(), []string{"api.boundary.test"})
+
+	job, err := newSetSyncJob(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache, sched)
+	require.NoError(err)
+
+	eps, err := repo.Endpoints(ctx, []string{web.GetPublicId(), api.GetPublicId()})
+	require.NoError(err)
+	var addrs []string
+	for _, ep := range eps {
+		addrs = append(addrs, ep.SetId+"="+ep.Address)
+		assert.NotNil(ep.Labels)
+		assert.Empty(ep.Labels)
+	}
+	assert.ElementsMatch([]string{
+		web.GetPublicId() + "=10.0.0.1",
+		web.GetPublicId() + "=10.0.0.2",
+		api.GetPublicId() + "=10.0.0.2",
+	}, addrs)
+
+	// Deleting a set deletes the hosts which are only in that set.
+	n, err := repo.DeleteSet(ctx, prj.GetPublicId(), web.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, n)
+
+	hosts, err := repo.ListHostsByCatalogId(ctx, cat.GetPublicId())
+	require.NoError(err)
+	require.Len(hosts, 1)
+	assert.Equal([]string{"10.0.0.2"}, hosts[0].IpAddresses)
+	assert.Equal([]string{api.GetPublicId()}, hosts[0].SetIds)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// defaultResolverPort is used for resolvers which do not include a port.
+const defaultResolverPort = "53"
+
+// NormalizeResolver returns address in the host:port form used to store the
+// resolvers of a catalog. The default DNS port is added if address does not
+// include one. An error is returned if the host of address is not an ip
+// address.
+func NormalizeResolver(ctx context.Context, address string) (string, error) {
+	const op = "dns.NormalizeResolver"
+	address = strings.TrimSpace(address)
+	if address == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "empty resolver address")
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		// Either no port was given or the address is malformed. Trimming the
+		// brackets allows IPv6 addresses without a port to be given as
+		// "[::1]".
+		host, port = strings.TrimSuffix(strings.TrimPrefix(address, "["), "]"), defaultResolverPort
+	}
+	if net.ParseIP(host) == nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("resolver %q is not an ip address", address))
+	}
+	if port == "" {
+		port = defaultResolverPort
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// hostInfo is a host found by a lookup.
+type hostInfo struct {
+	externalId  string
+	name        string
+	ipAddresses []string
+	dnsNames    []string
+}
+
+// A resolver looks up the hosts for the names of a host set. The servers of
+// a resolver are tried in order until one of them answers. If a resolver
+// has no servers, the system resolver is used.
+type resolver struct {
+	servers []string
+}
+
+func newResolver(servers []string) *resolver {
+	return &resolver{servers: servers}
+}
+
+// lookup returns the hosts found for name using the given record type.
+// A name which does not exist is not an error; no hosts are returned for it.
+func (r *resolver) lookup(ctx context.Context, recordType RecordType, name string) ([]hostInfo, error) {
+	const op = "dns.(resolver).lookup"
+	if len(r.servers) == 0 {
+		hosts, err := lookupWith(ctx, net.DefaultResolver, recordType, name)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("looking up %q", name)))
+		}
+		return hosts, nil
+	}
+
+	var lastErr error
+	for _, server := range r.servers {
+		hosts, err := lookupWith(ctx, serverResolver(server), recordType, name)
+		if err == nil {
+			return hosts, nil
+		}
+		lastErr = fmt.Errorf("%s: %w", server, err)
+	}
+	return nil, errors.Wrap(ctx, lastErr, op, errors.WithMsg(fmt.Sprintf("looking up %q", name)))
+}
+
+// serverResolver returns a net.Resolver which sends all of its queries to
+// server.
+func serverResolver(server string) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, server)
+		},
+	}
+}
+
+func lookupWith(ctx context.Context, res *net.Resolver, recordType RecordType, name string) ([]hostInfo, error) {
+	switch recordType {
+	case RecordTypeSrv:
+		_, srvs, err := res.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		hosts := make([]hostInfo, 0, len(srvs))
+		seen := make(map[string]bool, len(srvs))
+		for _, srv := range srvs {
+			target := strings.TrimSuffix(srv.Target, ".")
+			if target == "" || seen[target] {
+				continue
+			}
+			seen[target] = true
+			ips, err := res.LookupIP(ctx, "ip", target)
+			if err != nil && !isNotFound(err) {
+				return nil, err
+			}
+			hosts = append(hosts, hostInfo{
+				externalId:  target,
+				name:        target,
+				ipAddresses: ipStrings(ips),
+				dnsNames:    []string{target},
+			})
+		}
+		return hosts, nil
+	default:
+		network := "ip"
+		switch recordType {
+		case RecordTypeIp4:
+			network = "ip4"
+		case RecordTypeIp6:
+			network = "ip6"
+		}
+		ips, err := res.LookupIP(ctx, network, name)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		hostName := strings.TrimSuffix(name, ".")
+		hosts := make([]hostInfo, 0, len(ips))
+		for _, ip := range ipStrings(ips) {
+			hosts = append(hosts, hostInfo{
+				externalId:  ip,
+				name:        hostName,
+				ipAddresses: []string{ip},
+			})
+		}
+		return hosts, nil
+	}
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return stderrors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// ipStrings returns the sorted, de-duplicated string forms of ips.
+func ipStrings(ips []net.IP) []string {
+	if len(ips) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(ips))
+	out := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		s := ip.String()
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
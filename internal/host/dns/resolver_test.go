@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeResolver(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "10.0.0.1", want: "10.0.0.1:53"},
+		{in: "10.0.0.1:5353", want: "10.0.0.1:5353"},
+		{in: " 10.0.0.1 ", want: "10.0.0.1:53"},
+		{in: "::1", want: "[::1]:53"},
+		{in: "[::1]", want: "[::1]:53"},
+		{in: "[::1]:5353", want: "[::1]:5353"},
+		{in: "", wantErr: true},
+		{in: "dns.example.com", wantErr: true},
+		{in: "dns.example.com:53", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := NormalizeResolver(context.Background(), tt.in)
+			if tt.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolver_Lookup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	srv := NewTestDnsServer(t, TestDnsRecords{
+		A: map[string][]string{
+			"web.boundary.test":   {"10.0.0.2", "10.0.0.1"},
+			"db1.boundary.test":   {"10.0.1.1"},
+			"db2.boundary.test":   {"10.0.1.2"},
+			"dual.boundary.test":  {"10.0.2.1"},
+			"empty.boundary.test": {},
+		},
+		AAAA: map[string][]string{
+			"dual.boundary.test": {"fd00::1"},
+			"db2.boundary.test":  {"fd00::2"},
+		},
+		SRV: map[string][]string{
+			"_db._tcp.boundary.test": {"db1.boundary.test", "db2.boundary.test", "gone.boundary.test"},
+		},
+	})
+
+	tests := []struct {
+		name       string
+		servers    []string
+		recordType RecordType
+		lookup     string
+		want       []hostInfo
+		wantErr    bool
+	}{
+		{
+			name:       "ip",
+			recordType: RecordTypeIp,
+			lookup:     "web.boundary.test",
+			want: []hostInfo{
+				{externalId: "10.0.0.1", name: "web.boundary.test", ipAddresses: []string{"10.0.0.1"}},
+				{externalId: "10.0.0.2", name: "web.boundary.test", ipAddresses: []string{"10.0.0.2"}},
+			},
+		},
+		{
+			name:       "ip-both-families",
+			recordType: RecordTypeIp,
+			lookup:     "dual.boundary.test",
+			want: []hostInfo{
+				{externalId: "10.0.2.1", name: "dual.boundary.test", ipAddresses: []string{"10.0.2.1"}},
+				{externalId: "fd00::1", name: "dual.boundary.test", ipAddresses: []string{"fd00::1"}},
+			},
+		},
+		{
+			name:       "ip4",
+			recordType: RecordTypeIp4,
+			lookup:     "dual.boundary.test",
+			want: []hostInfo{
+				{externalId: "10.0.2.1", name: "dual.boundary.test", ipAddresses: []string{"10.0.2.1"}},
+			},
+		},
+		{
+			name:       "ip6",
+			recordType: RecordTypeIp6,
+			lookup:     "dual.boundary.test.",
+			want: []hostInfo{
+				{externalId: "fd00::1", name: "dual.boundary.test", ipAddresses: []string{"fd00::1"}},
+			},
+		},
+		{
+			name:       "srv",
+			recordType: RecordTypeSrv,
+			lookup:     "_db._tcp.boundary.test",
+			want: []hostInfo{
+				{externalId: "db1.boundary.test", name: "db1.boundary.test", ipAddresses: []string{"10.0.1.1"}, dnsNames: []string{"db1.boundary.test"}},
+				{externalId: "db2.boundary.test", name: "db2.boundary.test", ipAddresses: []string{"10.0.1.2", "fd00::2"}, dnsNames: []string{"db2.boundary.test"}},
+				{externalId: "gone.boundary.test", name: "gone.boundary.test", dnsNames: []string{"gone.boundary.test"}},
+			},
+		},
+		{
+			name:       "not-found",
+			recordType: RecordTypeIp,
+			lookup:     "missing.boundary.test",
+		},
+		{
+			name:       "no-records",
+			recordType: RecordTypeIp,
+			lookup:     "empty.boundary.test",
+		},
+		{
+			name:       "fallback-to-second-server",
+			servers:    []string{"127.0.0.1:1", srv.Addr},
+			recordType: RecordTypeIp4,
+			lookup:     "db1.boundary.test",
+			want: []hostInfo{
+				{externalId: "10.0.1.1", name: "db1.boundary.test", ipAddresses: []string{"10.0.1.1"}},
+			},
+		},
+		{
+			name:       "no-server-answers",
+			servers:    []string{"127.0.0.1:1"},
+			recordType: RecordTypeIp4,
+			lookup:     "db1.boundary.test",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			servers := tt.servers
+			if servers == nil {
+				servers = []string{srv.Addr}
+			}
+			got, err := newResolver(servers).lookup(ctx, tt.recordType, tt.lookup)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.ElementsMatch(tt.want, got)
+		})
+	}
+}
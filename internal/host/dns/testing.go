@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// TestCatalog creates a dns host catalog in the provided DB with the
+// provided project id. If any errors are encountered during the creation of
+// the host catalog, the test will fail.
+func TestCatalog(t testing.TB, conn *db.DB, projectId string, opt ...Option) *HostCatalog {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cat, err := NewHostCatalog(ctx, projectId, opt...)
+	require.NoError(t, err)
+	id, err := newHostCatalogId(ctx)
+	require.NoError(t, err)
+	cat.PublicId = id
+	cat.Resolvers, err = normalizeResolvers(ctx, cat.Resolvers)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Create(ctx, cat))
+	resolvers, err := cat.resolvers(ctx)
+	require.NoError(t, err)
+	if len(resolvers) > 0 {
+		require.NoError(t, w.CreateItems(ctx, resolvers))
+	}
+	return cat
+}
+
+// TestSet creates a dns host set in the provided DB with the provided
+// catalog id which resolves names. The catalog must have been created
+// previously. The test will fail if any errors are encountered.
+func TestSet(t testing.TB, conn *db.DB, catalogId string, names []string, opt ...Option) *HostSet {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	set, err := NewHostSet(ctx, catalogId, append([]Option{WithNames(names)}, opt...)...)
+	require.NoError(t, err)
+	id, err := newHostSetId(ctx)
+	require.NoError(t, err)
+	set.PublicId = id
+	set.LastSyncTime = timestamp.New(time.Unix(0, 0))
+	set.NeedSync = true
+
+	require.NoError(t, w.Create(ctx, set))
+	setNames, err := set.names(ctx)
+	require.NoError(t, err)
+	require.NoError(t, w.CreateItems(ctx, setNames))
+	return set
+}
+
+// TestDnsRecords are the records served by a TestDnsServer. The keys of
+// each map are DNS names. The values of A and AAAA are addresses and the
+// values of SRV are target names.
+type TestDnsRecords struct {
+	A    map[string][]string
+	AAAA map[string][]string
+	SRV  map[string][]string
+}
+
+// A TestDnsServer is a DNS server, listening on UDP on the loopback
+// interface, which answers queries from a fixed set of records.
+type TestDnsServer struct {
+	// Addr is the host:port the server is listening on.
+	Addr string
+
+	mu      sync.Mutex
+	records TestDnsRecords
+}
+
+// NewTestDnsServer starts a TestDnsServer serving records. The server is
+// stopped when the test finishes.
+func NewTestDnsServer(t testing.TB, records TestDnsRecords) *TestDnsServer {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { pc.Close() })
+
+	s := &TestDnsServer{
+		Addr: pc.LocalAddr().String(),
+	}
+	s.SetRecords(records)
+	go s.serve(pc)
+	return s
+}
+
+// SetRecords replaces the records served by s.
+func (s *TestDnsServer) SetRecords(records TestDnsRecords) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = TestDnsRecords{
+		A:    fqdnKeys(records.A),
+		AAAA: fqdnKeys(records.AAAA),
+		SRV:  fqdnKeys(records.SRV),
+	}
+}
+
+func fqdnKeys(in map[string][]string) map[string][]string {
+	out := make(map[string][]string, len(in))
+	for k, v := range in {
+		out[fqdn(k)] = v
+	}
+	return out
+}
+
+func fqdn(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+func (s *TestDnsServer) serve(pc net.PacketConn) {
+	buf := make([]byte, 1500)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		resp, err := s.answer(buf[:n])
+		if err != nil {
+			continue
+		}
+		_, _ = pc.WriteTo(resp, addr)
+	}
+}
+
+func (s *TestDnsServer) answer(req []byte) ([]byte, error) {
+	var p dnsmessage.Parser
+	h, err := p.Start(req)
+	if err != nil {
+		return nil, err
+	}
+	q, err := p.Question()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := strings.ToLower(q.Name.String())
+	_, isA := s.records.A[name]
+	_, isAAAA := s.records.AAAA[name]
+	_, isSRV := s.records.SRV[name]
+
+	rh := dnsmessage.Header{
+		ID:            h.ID,
+		Response:      true,
+		Authoritative: true,
+	}
+	if !isA && !isAAAA && !isSRV {
+		rh.RCode = dnsmessage.RCodeNameError
+	}
+	b := dnsmessage.NewBuilder(nil, rh)
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(q); err != nil {
+		return nil, err
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+	rrh := dnsmessage.ResourceHeader{Name: q.Name, Type: q.Type, Class: dnsmessage.ClassINET, TTL: 1}
+	switch q.Type {
+	case dnsmessage.TypeA:
+		for _, a := range s.records.A[name] {
+			var r dnsmessage.AResource
+			copy(r.A[:], net.ParseIP(a).To4())
+			if err := b.AResource(rrh, r); err != nil {
+				return nil, err
+			}
+		}
+	case dnsmessage.TypeAAAA:
+		for _, a := range s.records.AAAA[name] {
+			var r dnsmessage.AAAAResource
+			copy(r.AAAA[:], net.ParseIP(a).To16())
+			if err := b.AAAAResource(rrh, r); err != nil {
+				return nil, err
+			}
+		}
+	case dnsmessage.TypeSRV:
+		for _, target := range s.records.SRV[name] {
+			tn, err := dnsmessage.NewName(fqdn(target))
+			if err != nil {
+				return nil, err
+			}
+			if err := b.SRVResource(rrh, dnsmessage.SRVResource{Target: tn, Port: 22}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b.Finish()
+}
@@ -5,8 +5,21 @@ package host
 
 // Endpoint is a struct which identifies an address provided by a host and
 // selected as the priority address by the specified host set.
+//
+// Name, ExternalId and Labels describe the host the address belongs to. They
+// are set when the host source provides them and are used to evaluate the
+// host rules of a target. Labels are set by the user for static hosts and by
+// the plugin, in the labels attribute of a host, for plugin hosts. DNS hosts
+// have no labels.
+//
+// Port, when non-zero, overrides the default port of the target for
+// connections to this host.
 type Endpoint struct {
-	HostId  string
-	SetId   string
-	Address string
+	HostId     string
+	SetId      string
+	Address    string
+	Name       string
+	ExternalId string
+	Labels     map[string]string
+	Port       uint32
 }
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package health stores the health checks of host sets and the health of
+// their hosts as reported by workers.
+//
+// A HealthCheck belongs to a host set. The workers which can handle sessions
+// for the targets using the host set are asked, in the response to their
+// status requests, to run the health check against the hosts of the set.
+// They report the results in their next status request and the most recent
+// result for each host of the set is stored as a HostHealth.
+//
+// A host whose most recent result is unhealthy is not used for new sessions.
+// A result is only considered current for three intervals of its health
+// check; after that the health of the host is unknown and the host is used
+// again.
+package health
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/health/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// CheckType is the type of a health check.
+type CheckType string
+
+const (
+	// TcpCheck connects to the port of a host.
+	TcpCheck CheckType = "tcp"
+	// TlsCheck completes a TLS handshake on the port of a host.
+	TlsCheck CheckType = "tls"
+	// HttpCheck sends a GET request to the port of a host and expects a 2xx
+	// or 3xx response.
+	HttpCheck CheckType = "http"
+)
+
+// CheckTypes contains all of the supported health check types.
+var CheckTypes = []CheckType{
+	TcpCheck,
+	TlsCheck,
+	HttpCheck,
+}
+
+// IsValid reports if t is a supported health check type.
+func (t CheckType) IsValid() bool {
+	for _, v := range CheckTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// DefaultIntervalSeconds is the number of seconds between health checks
+	// of a host if an interval is not provided.
+	DefaultIntervalSeconds = 30
+	// MinIntervalSeconds is the smallest number of seconds allowed between
+	// health checks of a host.
+	MinIntervalSeconds = 5
+	// DefaultTimeoutSeconds is the number of seconds after which a health
+	// check fails if a timeout is not provided.
+	DefaultTimeoutSeconds = 5
+	// DefaultHttpPath is the path requested by http health checks if a path
+	// is not provided.
+	DefaultHttpPath = "/"
+
+	// staleIntervals is the number of intervals of a health check after
+	// which a result is no longer current.
+	staleIntervals = 3
+)
+
+// A HealthCheck is run by workers against the hosts of a host set.
+type HealthCheck struct {
+	*store.HealthCheck
+	tableName string `gorm:"-"`
+}
+
+// NewHealthCheck creates a new in memory HealthCheck of type checkType for
+// the host set hostSetId which connects to port on its hosts. WithHttpPath,
+// WithIntervalSeconds and WithTimeoutSeconds are the only valid options. All
+// other options are ignored. Http health checks without a path request
+// DefaultHttpPath.
+func NewHealthCheck(ctx context.Context, hostSetId string, checkType CheckType, port uint32, opt ...Option) (*HealthCheck, error) {
+	const op = "health.NewHealthCheck"
+	opts := getOpts(opt...)
+	c := &HealthCheck{
+		HealthCheck: &store.HealthCheck{
+			HostSetId:       hostSetId,
+			CheckType:       string(checkType),
+			Port:            port,
+			HttpPath:        opts.withHttpPath,
+			IntervalSeconds: opts.withIntervalSeconds,
+			TimeoutSeconds:  opts.withTimeoutSeconds,
+		},
+	}
+	if checkType == HttpCheck && c.HttpPath == "" {
+		c.HttpPath = DefaultHttpPath
+	}
+	if err := c.validate(ctx, op); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *HealthCheck) validate(ctx context.Context, op errors.Op) error {
+	switch {
+	case c.HostSetId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing host set id")
+	case !CheckType(c.CheckType).IsValid():
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown health check type %q", c.CheckType))
+	case c.Port == 0 || c.Port > 65535:
+		return errors.New(ctx, errors.InvalidParameter, op, "port must be between 1 and 65535")
+	case c.HttpPath != "" && CheckType(c.CheckType) != HttpCheck:
+		return errors.New(ctx, errors.InvalidParameter, op, "http path is only valid for http health checks")
+	case c.HttpPath != "" && !strings.HasPrefix(c.HttpPath, "/"):
+		return errors.New(ctx, errors.InvalidParameter, op, "http path must start with /")
+	case c.IntervalSeconds < MinIntervalSeconds:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("interval must be at least %d seconds", MinIntervalSeconds))
+	case c.TimeoutSeconds == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing timeout")
+	case c.TimeoutSeconds >= c.IntervalSeconds:
+		return errors.New(ctx, errors.InvalidParameter, op, "timeout must be less than interval")
+	}
+	return nil
+}
+
+// staleAfter returns how long a result of the health check stays current.
+func (c *HealthCheck) staleAfter() time.Duration {
+	return staleIntervals * time.Duration(c.IntervalSeconds) * time.Second
+}
+
+func allocHealthCheck() *HealthCheck {
+	return &HealthCheck{
+		HealthCheck: &store.HealthCheck{},
+	}
+}
+
+func (c *HealthCheck) clone() *HealthCheck {
+	cp := proto.Clone(c.HealthCheck)
+	return &HealthCheck{
+		HealthCheck: cp.(*store.HealthCheck),
+	}
+}
+
+// TableName returns the table name.
+func (c *HealthCheck) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "host_set_health_check"
+}
+
+// SetTableName sets the table name.
+func (c *HealthCheck) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *HealthCheck) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{c.HostSetId},
+		"resource-type":      []string{"host-set-health-check"},
+		"op-type":            []string{op.String()},
+	}
+}
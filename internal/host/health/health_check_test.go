@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHealthCheck(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		hostSetId string
+		checkType CheckType
+		port      uint32
+		opts      []Option
+		wantPath  string
+		wantErr   bool
+	}{
+		{
+			name:      "tcp",
+			hostSetId: "hsst_1234567890",
+			checkType: TcpCheck,
+			port:      22,
+		},
+		{
+			name:      "http-default-path",
+			hostSetId: "hsst_1234567890",
+			checkType: HttpCheck,
+			port:      80,
+			wantPath:  DefaultHttpPath,
+		},
+		{
+			name:      "http-path",
+			hostSetId: "hsst_1234567890",
+			checkType: HttpCheck,
+			port:      80,
+			opts:      []Option{WithHttpPath("/health")},
+			wantPath:  "/health",
+		},
+		{
+			name:      "missing-host-set-id",
+			checkType: TcpCheck,
+			port:      22,
+			wantErr:   true,
+		},
+		{
+			name:      "unknown-type",
+			hostSetId: "hsst_1234567890",
+			checkType: "icmp",
+			port:      22,
+			wantErr:   true,
+		},
+		{
+			name:      "missing-port",
+			hostSetId: "hsst_1234567890",
+			checkType: TcpCheck,
+			wantErr:   true,
+		},
+		{
+			name:      "port-too-large",
+			hostSetId: "hsst_1234567890",
+			checkType: TcpCheck,
+			port:      65536,
+			wantErr:   true,
+		},
+		{
+			name:      "path-on-tls-check",
+			hostSetId: "hsst_1234567890",
+			checkType: TlsCheck,
+			port:      443,
+			opts:      []Option{WithHttpPath("/health")},
+			wantErr:   true,
+		},
+		{
+			name:      "relative-path",
+			hostSetId: "hsst_1234567890",
+			checkType: HttpCheck,
+			port:      80,
+			opts:      []Option{WithHttpPath("health")},
+			wantErr:   true,
+		},
+		{
+			name:      "interval-too-small",
+			hostSetId: "hsst_1234567890",
+			checkType: TcpCheck,
+			port:      22,
+			opts:      []Option{WithIntervalSeconds(4), WithTimeoutSeconds(1)},
+			wantErr:   true,
+		},
+		{
+			name:      "timeout-not-less-than-interval",
+			hostSetId: "hsst_1234567890",
+			checkType: TcpCheck,
+			port:      22,
+			opts:      []Option{WithIntervalSeconds(10), WithTimeoutSeconds(10)},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewHealthCheck(ctx, tt.hostSetId, tt.checkType, tt.port, tt.opts...)
+			if tt.wantErr {
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Equal(tt.hostSetId, got.HostSetId)
+			assert.Equal(string(tt.checkType), got.CheckType)
+			assert.Equal(tt.port, got.Port)
+			assert.Equal(tt.wantPath, got.HttpPath)
+			assert.Equal(uint32(DefaultIntervalSeconds), got.IntervalSeconds)
+			assert.Equal(uint32(DefaultTimeoutSeconds), got.TimeoutSeconds)
+		})
+	}
+}
+
+func TestHostHealth_CurrentStatus(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	checkTime := time.Now().Add(-time.Minute)
+
+	healthy, err := NewHostHealth(ctx, "hsst_1234567890", "hst_1234567890", "w_1234567890", true, checkTime, WithError("ignored"))
+	require.NoError(t, err)
+	assert.Empty(t, healthy.Error)
+	assert.Equal(t, Healthy, healthy.CurrentStatus(time.Now()))
+
+	unhealthy, err := NewHostHealth(ctx, "hsst_1234567890", "hst_1234567890", "w_1234567890", false, checkTime, WithError("connection refused"))
+	require.NoError(t, err)
+	assert.Equal(t, "connection refused", unhealthy.Error)
+	assert.Equal(t, "w_1234567890", unhealthy.WorkerId)
+	assert.Equal(t, Unhealthy, unhealthy.CurrentStatus(time.Now()))
+
+	unhealthy.staleAfter = 3 * 10 * time.Second
+	assert.Equal(t, Unknown, unhealthy.CurrentStatus(time.Now()))
+	unhealthy.staleAfter = 3 * 30 * time.Second
+	assert.Equal(t, Unhealthy, unhealthy.CurrentStatus(time.Now()))
+
+	_, err = NewHostHealth(ctx, "", "hst_1234567890", "w_1234567890", true, checkTime)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = NewHostHealth(ctx, "hsst_1234567890", "", "w_1234567890", true, checkTime)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = NewHostHealth(ctx, "hsst_1234567890", "hst_1234567890", "", true, checkTime)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = NewHostHealth(ctx, "hsst_1234567890", "hst_1234567890", "w_1234567890", true, time.Time{})
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/health/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// Status is the health of a host.
+type Status string
+
+const (
+	// Healthy hosts passed their most recent health check.
+	Healthy Status = "healthy"
+	// Unhealthy hosts failed their most recent health check.
+	Unhealthy Status = "unhealthy"
+	// Unknown hosts have not been checked within three intervals of their
+	// health check.
+	Unknown Status = "unknown"
+)
+
+// A HostHealth is the result of the most recent health check of a host run
+// by a worker for one of its host sets.
+type HostHealth struct {
+	*store.HostHealth
+
+	// staleAfter is how long the result stays current. It is set by the
+	// repository from the interval of the health check.
+	staleAfter time.Duration `gorm:"-"`
+	tableName  string        `gorm:"-"`
+}
+
+// NewHostHealth creates a new in memory HostHealth of the host hostId
+// checked at checkTime by the worker workerId running the health check of
+// the host set hostSetId. WithError is the only valid option. All other
+// options are ignored.
+func NewHostHealth(ctx context.Context, hostSetId, hostId, workerId string, healthy bool, checkTime time.Time, opt ...Option) (*HostHealth, error) {
+	const op = "health.NewHostHealth"
+	switch {
+	case hostSetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host set id")
+	case hostId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host id")
+	case workerId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+	case checkTime.IsZero():
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing check time")
+	}
+	opts := getOpts(opt...)
+	status := Unhealthy
+	if healthy {
+		status = Healthy
+		opts.withError = ""
+	}
+	return &HostHealth{
+		HostHealth: &store.HostHealth{
+			HostSetId:     hostSetId,
+			HostId:        hostId,
+			Status:        string(status),
+			WorkerId:      workerId,
+			Error:         opts.withError,
+			LastCheckTime: timestamp.New(checkTime),
+		},
+	}, nil
+}
+
+// CurrentStatus returns the status of the host at now. It is Unknown if the
+// result is no longer current.
+func (h *HostHealth) CurrentStatus(now time.Time) Status {
+	if h.staleAfter > 0 && now.Sub(h.GetLastCheckTime().AsTime()) > h.staleAfter {
+		return Unknown
+	}
+	return Status(h.Status)
+}
+
+func allocHostHealth() *HostHealth {
+	return &HostHealth{
+		HostHealth: &store.HostHealth{},
+	}
+}
+
+func (h *HostHealth) clone() *HostHealth {
+	cp := proto.Clone(h.HostHealth)
+	return &HostHealth{
+		HostHealth: cp.(*store.HostHealth),
+		staleAfter: h.staleAfter,
+	}
+}
+
+// TableName returns the table name.
+func (h *HostHealth) TableName() string {
+	if h.tableName != "" {
+		return h.tableName
+	}
+	return "host_health"
+}
+
+// SetTableName sets the table name.
+func (h *HostHealth) SetTableName(n string) {
+	h.tableName = n
+}
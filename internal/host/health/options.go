@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withHttpPath        string
+	withIntervalSeconds uint32
+	withTimeoutSeconds  uint32
+	withError           string
+}
+
+func getDefaultOptions() options {
+	return options{
+		withIntervalSeconds: DefaultIntervalSeconds,
+		withTimeoutSeconds:  DefaultTimeoutSeconds,
+	}
+}
+
+// WithHttpPath provides an optional path requested by http health checks.
+func WithHttpPath(p string) Option {
+	return func(o *options) {
+		o.withHttpPath = p
+	}
+}
+
+// WithIntervalSeconds provides an optional number of seconds between health
+// checks. A value of 0 is ignored.
+func WithIntervalSeconds(s uint32) Option {
+	return func(o *options) {
+		if s != 0 {
+			o.withIntervalSeconds = s
+		}
+	}
+}
+
+// WithTimeoutSeconds provides an optional number of seconds after which a
+// health check fails. A value of 0 is ignored.
+func WithTimeoutSeconds(s uint32) Option {
+	return func(o *options) {
+		if s != 0 {
+			o.withTimeoutSeconds = s
+		}
+	}
+}
+
+// WithError provides an optional reason a health check failed.
+func WithError(e string) Option {
+	return func(o *options) {
+		o.withError = e
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("defaults", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		testOpts := getDefaultOptions()
+		assert.Equal(opts, testOpts)
+		assert.Equal(uint32(DefaultIntervalSeconds), opts.withIntervalSeconds)
+		assert.Equal(uint32(DefaultTimeoutSeconds), opts.withTimeoutSeconds)
+	})
+	t.Run("WithHttpPath", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithHttpPath("/health"))
+		testOpts := getDefaultOptions()
+		testOpts.withHttpPath = "/health"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithIntervalSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithIntervalSeconds(60))
+		testOpts := getDefaultOptions()
+		testOpts.withIntervalSeconds = 60
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithIntervalSeconds(0))
+		assert.Equal(getDefaultOptions(), opts)
+	})
+	t.Run("WithTimeoutSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTimeoutSeconds(10))
+		testOpts := getDefaultOptions()
+		testOpts.withTimeoutSeconds = 10
+		assert.Equal(opts, testOpts)
+
+		opts = getOpts(WithTimeoutSeconds(0))
+		assert.Equal(getDefaultOptions(), opts)
+	})
+	t.Run("WithError", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithError("connection refused"))
+		testOpts := getDefaultOptions()
+		testOpts.withError = "connection refused"
+		assert.Equal(opts, testOpts)
+	})
+}
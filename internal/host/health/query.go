@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+const (
+	upsertHostHealthQuery = `
+insert into host_health
+  (host_set_id, host_id, status, worker_id, error, last_check_time)
+select @host_set_id, @host_id, @status, @worker_id, nullif(@error, ''), least(@last_check_time, now())
+ where exists (select 1 from host_set_health_check where host_set_id = @host_set_id)
+   and exists (select 1 from host where public_id = @host_id)
+   and exists (select 1 from server_worker where public_id = @worker_id)
+on conflict (host_set_id, host_id, worker_id) do update
+   set status          = excluded.status,
+       error           = excluded.error,
+       last_check_time = excluded.last_check_time
+ where host_health.last_check_time < excluded.last_check_time;
+`
+
+	unhealthyHostsQuery = `
+select hh.host_set_id, hh.host_id
+  from host_health hh
+  join host_set_health_check hc
+    on hc.host_set_id = hh.host_set_id
+ where hh.host_set_id in (?)
+   and hh.last_check_time > now() - make_interval(secs => hc.interval_seconds * 3)
+ group by hh.host_set_id, hh.host_id
+having bool_and(hh.status = 'unhealthy');
+`
+
+	healthCheckTargetFiltersQuery = `
+select distinct hc.host_set_id,
+       coalesce(nullif(t.egress_worker_filter, ''), t.worker_filter, '') as worker_filter
+  from host_set_health_check hc
+  join target_host_set ths
+    on ths.host_set_id = hc.host_set_id
+  join target_all_subtypes t
+    on t.public_id = ths.target_id;
+`
+)
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// A Repository stores and retrieves the health checks of host sets and the
+// health of their hosts.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms) (*Repository, error) {
+	const op = "health.NewRepository"
+	switch {
+	case util.IsNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil reader")
+	case util.IsNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil kms")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+		kms:    kms,
+	}, nil
+}
+
+// SetHealthCheck sets the health check of the host set c.HostSetId,
+// replacing any existing health check, and returns the health check as
+// stored in the repository. c is not changed. projectId is the id of the
+// project the host set belongs to.
+func (r *Repository) SetHealthCheck(ctx context.Context, projectId string, c *HealthCheck, _ ...Option) (*HealthCheck, error) {
+	const op = "health.(Repository).SetHealthCheck"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing health check")
+	case c.HealthCheck == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing embedded health check")
+	case projectId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	if err := c.validate(ctx, op); err != nil {
+		return nil, err
+	}
+	c = c.clone()
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedCheck *HealthCheck
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			returnedCheck = c.clone()
+			existing := allocHealthCheck()
+			err := reader.LookupWhere(ctx, existing, "host_set_id = ?", []any{c.HostSetId})
+			switch {
+			case errors.IsNotFoundError(err):
+				if err := w.Create(ctx, returnedCheck, db.WithOplog(oplogWrapper, returnedCheck.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			default:
+				dbMask := []string{"CheckType", "Port", "IntervalSeconds", "TimeoutSeconds"}
+				var nullFields []string
+				if returnedCheck.HttpPath != "" {
+					dbMask = append(dbMask, "HttpPath")
+				} else {
+					nullFields = append(nullFields, "HttpPath")
+				}
+				rowsUpdated, err := w.Update(ctx, returnedCheck, dbMask, nullFields,
+					db.WithOplog(oplogWrapper, returnedCheck.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 health check would have been updated")
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", c.HostSetId)))
+	}
+	return returnedCheck, nil
+}
+
+// LookupHealthCheck returns the health check of the host set hostSetId.
+// Returns nil, nil if the host set has no health check.
+func (r *Repository) LookupHealthCheck(ctx context.Context, hostSetId string, _ ...Option) (*HealthCheck, error) {
+	const op = "health.(Repository).LookupHealthCheck"
+	if hostSetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host set id")
+	}
+	c := allocHealthCheck()
+	if err := r.reader.LookupWhere(ctx, c, "host_set_id = ?", []any{hostSetId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", hostSetId)))
+	}
+	return c, nil
+}
+
+// ListHealthChecks returns the health checks of the host sets hostSetIds.
+// Host sets without a health check are not included.
+func (r *Repository) ListHealthChecks(ctx context.Context, hostSetIds []string, _ ...Option) ([]*HealthCheck, error) {
+	const op = "health.(Repository).ListHealthChecks"
+	if len(hostSetIds) == 0 {
+		return nil, nil
+	}
+	var checks []*HealthCheck
+	if err := r.reader.SearchWhere(ctx, &checks, "host_set_id in (?)", []any{hostSetIds}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return checks, nil
+}
+
+// DeleteHealthCheck deletes the health check of the host set hostSetId
+// along with the health of its hosts. It returns the number of health
+// checks deleted. projectId is the id of the project the host set belongs
+// to.
+func (r *Repository) DeleteHealthCheck(ctx context.Context, projectId, hostSetId string, _ ...Option) (int, error) {
+	const op = "health.(Repository).DeleteHealthCheck"
+	switch {
+	case projectId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case hostSetId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing host set id")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	c := allocHealthCheck()
+	c.HostSetId = hostSetId
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			dc := c.clone()
+			var err error
+			rowsDeleted, err = w.Delete(ctx, dc, db.WithOplog(oplogWrapper, dc.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 health check would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", hostSetId)))
+	}
+	return rowsDeleted, nil
+}
+
+type healthCheckTargetFilter struct {
+	HostSetId    string
+	WorkerFilter string
+}
+
+// ListHealthCheckWorkerFilters returns the worker filters of the targets
+// using each host set with a health check, keyed by host set id. A target
+// without a worker filter is represented by an empty filter. Host sets with
+// a health check which are not used by any target are not included.
+func (r *Repository) ListHealthCheckWorkerFilters(ctx context.Context, _ ...Option) (map[string][]string, error) {
+	const op = "health.(Repository).ListHealthCheckWorkerFilters"
+	rows, err := r.reader.Query(ctx, healthCheckTargetFiltersQuery, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	filters := make(map[string][]string)
+	for rows.Next() {
+		var f healthCheckTargetFilter
+		if err := r.reader.ScanRows(ctx, rows, &f); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		filters[f.HostSetId] = append(filters[f.HostSetId], f.WorkerFilter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return filters, nil
+}
+
+// UpsertHostHealth stores the results of health checks reported by
+// workers. The result of each worker is stored separately. A result older
+// than the stored result of the same host, host set and worker is ignored,
+// as are results for hosts or workers which no longer exist or host sets
+// which no longer have a health check. A check time in the future is stored
+// as the current time of the database.
+func (r *Repository) UpsertHostHealth(ctx context.Context, results []*HostHealth, _ ...Option) error {
+	const op = "health.(Repository).UpsertHostHealth"
+	if len(results) == 0 {
+		return nil
+	}
+	for _, h := range results {
+		switch {
+		case h == nil, h.HostHealth == nil:
+			return errors.New(ctx, errors.InvalidParameter, op, "missing host health")
+		case h.HostSetId == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing host set id")
+		case h.HostId == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing host id")
+		case h.WorkerId == "":
+			return errors.New(ctx, errors.InvalidParameter, op, "missing worker id")
+		case h.Status != string(Healthy) && h.Status != string(Unhealthy):
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid status %q", h.Status))
+		case h.GetLastCheckTime() == nil:
+			return errors.New(ctx, errors.InvalidParameter, op, "missing last check time")
+		}
+	}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, h := range results {
+				args := []any{
+					sql.Named("host_set_id", h.HostSetId),
+					sql.Named("host_id", h.HostId),
+					sql.Named("status", h.Status),
+					sql.Named("worker_id", h.WorkerId),
+					sql.Named("error", h.Error),
+					sql.Named("last_check_time", h.GetLastCheckTime().AsTime()),
+				}
+				if _, err := w.Exec(ctx, upsertHostHealthQuery, args); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for host %s of host set %s", h.HostId, h.HostSetId)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// ListHostHealth returns the health of the host hostId reported by each
+// worker for each of the host sets whose health check has checked it.
+func (r *Repository) ListHostHealth(ctx context.Context, hostId string, _ ...Option) ([]*HostHealth, error) {
+	const op = "health.(Repository).ListHostHealth"
+	if hostId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host id")
+	}
+	var hs []*HostHealth
+	if err := r.reader.SearchWhere(ctx, &hs, "host_id = ?", []any{hostId}, db.WithOrder("host_set_id asc, worker_id asc")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(hs) == 0 {
+		return nil, nil
+	}
+	setIds := make([]string, 0, len(hs))
+	for _, h := range hs {
+		setIds = append(setIds, h.HostSetId)
+	}
+	checks, err := r.ListHealthChecks(ctx, setIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	staleAfter := make(map[string]time.Duration, len(checks))
+	for _, c := range checks {
+		staleAfter[c.HostSetId] = c.staleAfter()
+	}
+	for _, h := range hs {
+		h.staleAfter = staleAfter[h.HostSetId]
+	}
+	return hs, nil
+}
+
+type unhealthyHost struct {
+	HostSetId string
+	HostId    string
+}
+
+// HealthyEndpoints returns the endpoints whose host has not failed the
+// current health check of the endpoint's host set. A host has failed the
+// check only if every worker which checked it recently reports it
+// unhealthy, so a worker which can't reach a host doesn't exclude it for
+// the others. Endpoints of host sets without a health check, and endpoints
+// whose host has not been checked recently, are always included.
+func (r *Repository) HealthyEndpoints(ctx context.Context, endpoints []*host.Endpoint, _ ...Option) ([]*host.Endpoint, error) {
+	const op = "health.(Repository).HealthyEndpoints"
+	if len(endpoints) == 0 {
+		return endpoints, nil
+	}
+	setIds := make([]string, 0, len(endpoints))
+	seen := make(map[string]bool, len(endpoints))
+	for _, ep := range endpoints {
+		if !seen[ep.SetId] {
+			seen[ep.SetId] = true
+			setIds = append(setIds, ep.SetId)
+		}
+	}
+	rows, err := r.reader.Query(ctx, unhealthyHostsQuery, []any{setIds})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	unhealthy := make(map[unhealthyHost]bool)
+	for rows.Next() {
+		var u unhealthyHost
+		if err := r.reader.ScanRows(ctx, rows, &u); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		unhealthy[u] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(unhealthy) == 0 {
+		return endpoints, nil
+	}
+	healthy := make([]*host.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		if !unhealthy[unhealthyHost{HostSetId: ep.SetId, HostId: ep.HostId}] {
+			healthy = append(healthy, ep)
+		}
+	}
+	return healthy, nil
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	assert.NotNil(t, repo)
+
+	_, err = NewRepository(ctx, nil, rw, kmsCache)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = NewRepository(ctx, rw, nil, kmsCache)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+	_, err = NewRepository(ctx, rw, rw, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "got: %q", err)
+}
+
+func TestRepository_HealthCheck(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	set := static.TestSets(t, conn, cat.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	got, err := repo.LookupHealthCheck(ctx, set.GetPublicId())
+	require.NoError(err)
+	assert.Nil(got)
+
+	c, err := NewHealthCheck(ctx, set.GetPublicId(), HttpCheck, 8080, WithHttpPath("/health"))
+	require.NoError(err)
+	created, err := repo.SetHealthCheck(ctx, prj.GetPublicId(), c)
+	require.NoError(err)
+	assert.Equal("/health", created.HttpPath)
+	assert.NotNil(created.CreateTime)
+	assert.NoError(db.TestVerifyOplog(t, rw, set.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+	c, err = NewHealthCheck(ctx, set.GetPublicId(), TcpCheck, 22, WithIntervalSeconds(10), WithTimeoutSeconds(2))
+	require.NoError(err)
+	updated, err := repo.SetHealthCheck(ctx, prj.GetPublicId(), c)
+	require.NoError(err)
+	assert.Equal(string(TcpCheck), updated.CheckType)
+
+	got, err = repo.LookupHealthCheck(ctx, set.GetPublicId())
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(string(TcpCheck), got.CheckType)
+	assert.Equal(uint32(22), got.Port)
+	assert.Empty(got.HttpPath)
+	assert.Equal(uint32(10), got.IntervalSeconds)
+	assert.Equal(uint32(2), got.TimeoutSeconds)
+
+	checks, err := repo.ListHealthChecks(ctx, []string{set.GetPublicId(), "hsst_doesnotexist"})
+	require.NoError(err)
+	assert.Len(checks, 1)
+
+	deleted, err := repo.DeleteHealthCheck(ctx, prj.GetPublicId(), set.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, deleted)
+	deleted, err = repo.DeleteHealthCheck(ctx, prj.GetPublicId(), set.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, deleted)
+	got, err = repo.LookupHealthCheck(ctx, set.GetPublicId())
+	require.NoError(err)
+	assert.Nil(got)
+}
+
+func TestRepository_HostHealth(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	sets := static.TestSets(t, conn, cat.GetPublicId(), 2)
+	hosts := static.TestHosts(t, conn, cat.GetPublicId(), 2)
+	static.TestSetMembers(t, conn, sets[0].GetPublicId(), hosts)
+	static.TestSetMembers(t, conn, sets[1].GetPublicId(), hosts)
+	w := server.TestKmsWorker(t, conn, wrapper)
+	w2 := server.TestKmsWorker(t, conn, wrapper)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	c, err := NewHealthCheck(ctx, sets[0].GetPublicId(), TcpCheck, 22)
+	require.NoError(err)
+	_, err = repo.SetHealthCheck(ctx, prj.GetPublicId(), c)
+	require.NoError(err)
+
+	now := time.Now()
+	newWorkerHealth := func(workerId, setId, hostId string, healthy bool, checkTime time.Time) *HostHealth {
+		h, err := NewHostHealth(ctx, setId, hostId, workerId, healthy, checkTime, WithError("connection refused"))
+		require.NoError(err)
+		return h
+	}
+	newHealth := func(setId, hostId string, healthy bool, checkTime time.Time) *HostHealth {
+		return newWorkerHealth(w.GetPublicId(), setId, hostId, healthy, checkTime)
+	}
+
+	require.NoError(repo.UpsertHostHealth(ctx, []*HostHealth{
+		newHealth(sets[0].GetPublicId(), hosts[0].GetPublicId(), true, now.Add(-time.Second)),
+		newHealth(sets[0].GetPublicId(), hosts[1].GetPublicId(), false, now.Add(-time.Second)),
+		// The second set has no health check so its result is ignored.
+		newHealth(sets[1].GetPublicId(), hosts[1].GetPublicId(), false, now.Add(-time.Second)),
+	}))
+	// An older result does not replace a newer one.
+	require.NoError(repo.UpsertHostHealth(ctx, []*HostHealth{
+		newHealth(sets[0].GetPublicId(), hosts[1].GetPublicId(), true, now.Add(-time.Minute)),
+	}))
+
+	got, err := repo.ListHostHealth(ctx, hosts[0].GetPublicId())
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(Healthy, got[0].CurrentStatus(now))
+	assert.Empty(got[0].Error)
+	assert.Equal(w.GetPublicId(), got[0].WorkerId)
+	assert.NotNil(got[0].LastHealthyTime)
+
+	// A check time in the future is clamped to the current time.
+	require.NoError(repo.UpsertHostHealth(ctx, []*HostHealth{
+		newHealth(sets[0].GetPublicId(), hosts[0].GetPublicId(), true, now.Add(time.Hour)),
+	}))
+	got, err = repo.ListHostHealth(ctx, hosts[0].GetPublicId())
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.True(got[0].GetLastCheckTime().AsTime().Before(now.Add(time.Minute)))
+
+	got, err = repo.ListHostHealth(ctx, hosts[1].GetPublicId())
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(sets[0].GetPublicId(), got[0].HostSetId)
+	assert.Equal(Unhealthy, got[0].CurrentStatus(now))
+	assert.Equal("connection refused", got[0].Error)
+	assert.Nil(got[0].LastHealthyTime)
+	assert.Equal(Unknown, got[0].CurrentStatus(now.Add(4*DefaultIntervalSeconds*time.Second)))
+
+	endpoints := []*host.Endpoint{
+		{HostId: hosts[0].GetPublicId(), SetId: sets[0].GetPublicId(), Address: "10.0.0.1"},
+		{HostId: hosts[1].GetPublicId(), SetId: sets[0].GetPublicId(), Address: "10.0.0.2"},
+		{HostId: hosts[1].GetPublicId(), SetId: sets[1].GetPublicId(), Address: "10.0.0.2"},
+	}
+	healthy, err := repo.HealthyEndpoints(ctx, endpoints)
+	require.NoError(err)
+	assert.ElementsMatch([]*host.Endpoint{endpoints[0], endpoints[2]}, healthy)
+
+	// The results of each worker are stored separately, and a host is only
+	// unhealthy when every worker which checked it reports it unhealthy.
+	require.NoError(repo.UpsertHostHealth(ctx, []*HostHealth{
+		newWorkerHealth(w2.GetPublicId(), sets[0].GetPublicId(), hosts[1].GetPublicId(), true, now.Add(-time.Second)),
+		// Results of unknown workers are ignored.
+		newWorkerHealth("w_1234567890", sets[0].GetPublicId(), hosts[1].GetPublicId(), false, now.Add(-time.Second)),
+	}))
+	got, err = repo.ListHostHealth(ctx, hosts[1].GetPublicId())
+	require.NoError(err)
+	require.Len(got, 2)
+	byWorker := map[string]Status{got[0].WorkerId: got[0].CurrentStatus(now), got[1].WorkerId: got[1].CurrentStatus(now)}
+	assert.Equal(map[string]Status{w.GetPublicId(): Unhealthy, w2.GetPublicId(): Healthy}, byWorker)
+	healthy, err = repo.HealthyEndpoints(ctx, endpoints)
+	require.NoError(err)
+	assert.ElementsMatch(endpoints, healthy)
+
+	require.NoError(repo.UpsertHostHealth(ctx, []*HostHealth{
+		newWorkerHealth(w2.GetPublicId(), sets[0].GetPublicId(), hosts[1].GetPublicId(), false, now),
+	}))
+	healthy, err = repo.HealthyEndpoints(ctx, endpoints)
+	require.NoError(err)
+	assert.ElementsMatch([]*host.Endpoint{endpoints[0], endpoints[2]}, healthy)
+
+	// Removing the health check removes the health of its hosts.
+	_, err = repo.DeleteHealthCheck(ctx, prj.GetPublicId(), sets[0].GetPublicId())
+	require.NoError(err)
+	got, err = repo.ListHostHealth(ctx, hosts[1].GetPublicId())
+	require.NoError(err)
+	assert.Empty(got)
+	healthy, err = repo.HealthyEndpoints(ctx, endpoints)
+	require.NoError(err)
+	assert.ElementsMatch(endpoints, healthy)
+}
+
+func TestRepository_ListHealthCheckWorkerFilters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	sets := static.TestSets(t, conn, cat.GetPublicId(), 3)
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+	for _, s := range sets[:2] {
+		c, err := NewHealthCheck(ctx, s.GetPublicId(), TcpCheck, 22)
+		require.NoError(err)
+		_, err = repo.SetHealthCheck(ctx, prj.GetPublicId(), c)
+		require.NoError(err)
+	}
+
+	tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "no-filter",
+		target.WithHostSources([]string{sets[0].GetPublicId(), sets[2].GetPublicId()}))
+	tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "egress-filter",
+		target.WithHostSources([]string{sets[0].GetPublicId()}),
+		target.WithEgressWorkerFilter(`"east" in "/tags/region"`))
+
+	filters, err := repo.ListHealthCheckWorkerFilters(ctx)
+	require.NoError(err)
+	// The second set is not used by a target and the third set has no
+	// health check.
+	require.Len(filters, 1)
+	assert.ElementsMatch([]string{"", `"east" in "/tags/region"`}, filters[sets[0].GetPublicId()])
+}
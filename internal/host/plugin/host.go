@@ -27,7 +27,7 @@ type Host struct {
 
 // NewHost creates a new in memory Host assigned to catalogId with an address.
 // Supported options: WithName, WithDescription, WithIpAddresses, WithDnsNames,
-// WithPluginId, WithPublicId. Others ignored.
+// withLabels, WithPluginId, WithPublicId. Others ignored.
 func NewHost(ctx context.Context, catalogId, externalId string, opt ...Option) *Host {
 	opts := getOpts(opt...)
 
@@ -49,6 +49,12 @@ func NewHost(ctx context.Context, catalogId, externalId string, opt ...Option) *
 		h.DnsNames = make([]string, 0, len(opts.withDnsNames))
 		h.DnsNames = append(h.DnsNames, opts.withDnsNames...)
 	}
+	if len(opts.withLabels) > 0 {
+		h.Labels = make(map[string]string, len(opts.withLabels))
+		for k, v := range opts.withLabels {
+			h.Labels[k] = v
+		}
+	}
 
 	return h
 }
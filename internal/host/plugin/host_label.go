@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/plugin/store"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// MaxHostLabelLength is the maximum length of the key and of the value
+	// of a host label.
+	MaxHostLabelLength = 255
+
+	// labelsAttrField is the attribute of a host returned by a plugin which
+	// holds the labels of the host.
+	labelsAttrField = "labels"
+)
+
+// A HostLabel is a key/value label of a host provided by the plugin.
+type HostLabel struct {
+	*store.HostLabel
+	tableName string `gorm:"-"`
+}
+
+// NewHostLabel creates a new in memory HostLabel for hostId. The key must
+// not be empty.
+func NewHostLabel(ctx context.Context, hostId, key, value string) (*HostLabel, error) {
+	const op = "plugin.NewHostLabel"
+	switch {
+	case hostId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no host id")
+	case !validLabel(key, value):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid label %q", key))
+	}
+	return &HostLabel{
+		HostLabel: &store.HostLabel{
+			HostId: hostId,
+			Key:    key,
+			Value:  value,
+		},
+	}, nil
+}
+
+// TableName returns the table name for the host label.
+func (l *HostLabel) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "host_plugin_host_label"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (l *HostLabel) SetTableName(n string) {
+	l.tableName = n
+}
+
+func validLabel(key, value string) bool {
+	return strings.TrimSpace(key) != "" && len(key) <= MaxHostLabelLength && len(value) <= MaxHostLabelLength
+}
+
+// labelsFromAttributes returns the labels in the labels attribute of a host
+// returned by a plugin. Labels which are not strings or which are not valid
+// are ignored, so a plugin can not fail the sync of a host set with labels
+// Boundary can not store. It returns nil if the host has no labels.
+func labelsFromAttributes(attrs *structpb.Struct) map[string]string {
+	v, ok := attrs.GetFields()[labelsAttrField]
+	if !ok {
+		return nil
+	}
+	var labels map[string]string
+	for k, lv := range v.GetStructValue().GetFields() {
+		s, ok := lv.GetKind().(*structpb.Value_StringValue)
+		if !ok || !validLabel(k, s.StringValue) {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[k] = s.StringValue
+	}
+	return labels
+}
+
+// setLabels reads the labels of hosts from the repository and sets them on
+// hosts.
+func setLabels(ctx context.Context, reader db.Reader, hosts []*Host) error {
+	const op = "plugin.setLabels"
+	if len(hosts) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.GetPublicId())
+	}
+	var labels []*HostLabel
+	if err := reader.SearchWhere(ctx, &labels, "host_id in (?)", []any{ids}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	byHost := make(map[string]map[string]string, len(hosts))
+	for _, l := range labels {
+		if byHost[l.GetHostId()] == nil {
+			byHost[l.GetHostId()] = make(map[string]string)
+		}
+		byHost[l.GetHostId()][l.GetKey()] = l.GetValue()
+	}
+	for _, h := range hosts {
+		h.Labels = byHost[h.GetPublicId()]
+	}
+	return nil
+}
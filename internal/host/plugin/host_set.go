@@ -5,6 +5,7 @@ package plugin
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"sort"
 	"strconv"
@@ -20,10 +21,25 @@ import (
 // A HostSet is a collection of hosts from the set's catalog.
 type HostSet struct {
 	*store.HostSet
-	PluginId           string   `gorm:"-"`
-	HostIds            []string `gorm:"-"`
-	PreferredEndpoints []string `gorm:"-"`
-	tableName          string   `gorm:"-"`
+	PluginId           string         `gorm:"-"`
+	HostIds            []string       `gorm:"-"`
+	PreferredEndpoints []string       `gorm:"-"`
+	SyncStatus         *SetSyncStatus `gorm:"-"`
+	tableName          string         `gorm:"-"`
+}
+
+// SetSyncStatus is the outcome of the most recent sync of a host set. A sync
+// which has started but not yet ended has a LastSyncStartTime after its
+// LastSyncEndTime.
+type SetSyncStatus struct {
+	LastSyncStartTime *timestamp.Timestamp
+	LastSyncEndTime   *timestamp.Timestamp
+	// LastSyncError is the error returned by the plugin, or encountered while
+	// storing the hosts it returned, during the most recent sync. It is empty
+	// if the sync succeeded.
+	LastSyncError string
+	HostsAdded    int
+	HostsRemoved  int
 }
 
 // NewHostSet creates a new in memory HostSet assigned to catalogId. Attributes,
@@ -76,6 +92,7 @@ func (s *HostSet) clone() *HostSet {
 	hs := &HostSet{
 		HostSet:            cp.(*store.HostSet),
 		PreferredEndpoints: s.PreferredEndpoints,
+		SyncStatus:         s.SyncStatus,
 	}
 	if s.Attributes != nil && len(s.Attributes) == 0 && hs.Attributes == nil {
 		hs.Attributes = []byte{}
@@ -110,6 +127,11 @@ type hostSetAgg struct {
 	SyncIntervalSeconds int32
 	Version             uint32
 	Attributes          []byte
+	LastSyncStartTime   *timestamp.Timestamp
+	LastSyncEndTime     *timestamp.Timestamp
+	LastSyncError       sql.NullString
+	HostsAdded          sql.NullInt32
+	HostsRemoved        sql.NullInt32
 	PreferredEndpoints  string
 	HostIds             string
 }
@@ -131,6 +153,15 @@ func (agg *hostSetAgg) toHostSet(ctx context.Context) (*HostSet, error) {
 	hs.SyncIntervalSeconds = agg.SyncIntervalSeconds
 	hs.Version = agg.Version
 	hs.Attributes = agg.Attributes
+	if agg.LastSyncStartTime != nil || agg.LastSyncEndTime != nil {
+		hs.SyncStatus = &SetSyncStatus{
+			LastSyncStartTime: agg.LastSyncStartTime,
+			LastSyncEndTime:   agg.LastSyncEndTime,
+			LastSyncError:     agg.LastSyncError.String,
+			HostsAdded:        int(agg.HostsAdded.Int32),
+			HostsRemoved:      int(agg.HostsRemoved.Int32),
+		}
+	}
 	if agg.HostIds != "" {
 		hs.HostIds = strings.Split(agg.HostIds, aggregateDelimiter)
 	}
@@ -282,6 +282,10 @@ func (r *SetSyncJob) syncSets(ctx context.Context, setAggs []*hostSetAgg) error
 			catSetIds = append(catSetIds, id)
 		}
 
+		if _, err := r.writer.Exec(ctx, startSetSyncQuery, []any{catSetIds}); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("recording sync start", "catalog id", ci.publicId))
+		}
+
 		resp, err := ci.plg.ListHosts(ctx, &plgpb.ListHostsRequest{
 			Catalog:   ci.plgCat,
 			Sets:      sets,
@@ -289,12 +293,14 @@ func (r *SetSyncJob) syncSets(ctx context.Context, setAggs []*hostSetAgg) error
 		})
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("listing hosts", "catalog id", ci.publicId))
+			r.recordSyncError(ctx, catSetIds, err)
 			r.numProcessed += len(catSetIds)
 			continue
 		}
 
 		if _, err := r.upsertAndCleanHosts(ctx, ci.storeCat, catSetIds, resp.GetHosts()); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("upserting hosts", "catalog id", ci.publicId))
+			r.recordSyncError(ctx, catSetIds, err)
 			r.numProcessed += len(catSetIds)
 			continue
 		}
@@ -303,6 +309,16 @@ func (r *SetSyncJob) syncSets(ctx context.Context, setAggs []*hostSetAgg) error
 	return nil
 }
 
+// recordSyncError records syncErr as the outcome of the most recent sync of
+// the sets. A failure to record it is only logged since the sync is retried on
+// the next run of the job.
+func (r *SetSyncJob) recordSyncError(ctx context.Context, setIds []string, syncErr error) {
+	const op = "plugin.(SetSyncJob).recordSyncError"
+	if _, err := r.writer.Exec(ctx, endSetSyncQuery, []any{syncErr.Error(), 0, 0, setIds}); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("recording sync error", "set ids", setIds))
+	}
+}
+
 // upsertAndCleanHosts inserts phs into the repository or updates its current
 // attributes/set memberships and returns Hosts. h is not changed. hc must
 // contain a valid public ID and project ID. Each ph in phs must not contain a
@@ -353,6 +369,9 @@ func (r *SetSyncJob) upsertAndCleanHosts(
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("looking up current hosts for returned sets"))
 		}
+		if err := setLabels(ctx, r.reader, currentHosts); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("looking up labels of current hosts"))
+		}
 
 		currentHostMap = make(map[string]*Host, len(currentHosts))
 		for _, h := range currentHosts {
@@ -376,7 +395,9 @@ func (r *SetSyncJob) upsertAndCleanHosts(
 			len(hi.ipsToAdd) == 0 &&
 			len(hi.ipsToRemove) == 0 &&
 			len(hi.dnsNamesToAdd) == 0 &&
-			len(hi.dnsNamesToRemove) == 0 {
+			len(hi.dnsNamesToRemove) == 0 &&
+			len(hi.labelsToAdd) == 0 &&
+			len(hi.labelsToRemove) == 0 {
 			returnedHosts = append(returnedHosts, ret)
 			continue
 		}
@@ -469,6 +490,28 @@ func (r *SetSyncJob) upsertAndCleanHosts(
 					}
 				}
 
+				// Label handling
+				{
+					if len(hi.labelsToRemove) > 0 {
+						oplogMsgs := make([]*oplog.Message, 0, len(hi.labelsToRemove))
+						count, err := w.DeleteItems(ctx, hi.labelsToRemove.toArray(), db.NewOplogMsgs(&oplogMsgs))
+						if err != nil {
+							return err
+						}
+						if count != len(hi.labelsToRemove) {
+							return errors.New(ctx, errors.UnexpectedRowsAffected, op, fmt.Sprintf("expected to remove %d labels from host %s, removed %d", len(hi.labelsToRemove), ret.PublicId, count))
+						}
+						msgs = append(msgs, oplogMsgs...)
+					}
+					if len(hi.labelsToAdd) > 0 {
+						oplogMsgs := make([]*oplog.Message, 0, len(hi.labelsToAdd))
+						if err := w.CreateItems(ctx, hi.labelsToAdd.toArray(), db.NewOplogMsgs(&oplogMsgs)); err != nil {
+							return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("adding labels for host %q", ret.GetPublicId())))
+						}
+						msgs = append(msgs, oplogMsgs...)
+					}
+				}
+
 				metadata := ret.oplog(oplog.OpType_OP_TYPE_UPDATE)
 				if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -555,11 +598,18 @@ func (r *SetSyncJob) upsertAndCleanHosts(
 					return errors.New(ctx, errors.Internal, op, fmt.Sprintf("host set (%v) synced, but failed to update repo", setId))
 				}
 
+				// Record the outcome of the sync
+				added, removed := len(setMembershipsToAdd[hs.PublicId]), len(setMembershipsToRemove[hs.PublicId])
+				if _, err := w.Exec(ctx, endSetSyncQuery, []any{"", added, removed, []string{setId}}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("updating sync status"))
+				}
+
 				return nil
 			},
 		)
 		if err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("failed to update membership", "set id", setId))
+			r.recordSyncError(ctx, []string{setId}, err)
 		}
 	}
 
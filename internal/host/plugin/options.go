@@ -29,6 +29,7 @@ type options struct {
 	withSyncIntervalSeconds int32
 	withIpAddresses         []string
 	withDnsNames            []string
+	withLabels              map[string]string
 	withLimit               int
 	withSetIds              []string
 	withSecretsHmac         []byte
@@ -110,6 +111,13 @@ func withDnsNames(with []string) Option {
 	}
 }
 
+// withLabels provides an optional map of labels.
+func withLabels(with map[string]string) Option {
+	return func(o *options) {
+		o.withLabels = with
+	}
+}
+
 // WithLimit provides an option to provide a limit. Intentionally allowing
 // negative integers. If WithLimit < 0, then unlimited results are
 // returned. If WithLimit == 0, then default limits are used for results.
@@ -65,6 +65,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withIpAddresses = []string{"foo"}
 		assert.EqualValues(t, opts, testOpts)
 	})
+	t.Run("withLabels", func(t *testing.T) {
+		opts := getOpts(withLabels(map[string]string{"foo": "bar"}))
+		testOpts := getDefaultOptions()
+		testOpts.withLabels = map[string]string{"foo": "bar"}
+		assert.EqualValues(t, opts, testOpts)
+	})
 	t.Run("withSetIds", func(t *testing.T) {
 		opts := getOpts(WithSetIds([]string{"foo"}))
 		testOpts := getDefaultOptions()
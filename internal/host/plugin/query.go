@@ -53,5 +53,42 @@ set
   last_sync_time = current_timestamp,
   need_sync = false
 where public_id = ?
+`
+
+	requestSetSyncQuery = `
+update host_plugin_set
+set
+  need_sync = true
+where public_id = ?
+`
+
+	requestCatalogSyncQuery = `
+update host_plugin_set
+set
+  need_sync = true
+where catalog_id = ?
+`
+
+	startSetSyncQuery = `
+insert into host_plugin_set_sync_status
+  (set_id, last_sync_start_time)
+select public_id, current_timestamp
+  from host_plugin_set
+ where public_id in (?)
+on conflict (set_id) do update
+  set last_sync_start_time = excluded.last_sync_start_time;
+`
+
+	endSetSyncQuery = `
+insert into host_plugin_set_sync_status
+  (set_id, last_sync_end_time, last_sync_error, hosts_added, hosts_removed)
+select public_id, current_timestamp, nullif(?, ''), ?, ?
+  from host_plugin_set
+ where public_id in (?)
+on conflict (set_id) do update
+  set last_sync_end_time = excluded.last_sync_end_time,
+      last_sync_error    = excluded.last_sync_error,
+      hosts_added        = excluded.hosts_added,
+      hosts_removed      = excluded.hosts_removed;
 `
 )
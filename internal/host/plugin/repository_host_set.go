@@ -835,10 +835,16 @@ func (r *Repository) Endpoints(ctx context.Context, setIds []string) ([]*host.En
 	if len(hostAggs) == 0 {
 		return nil, nil
 	}
+	hosts := make([]*Host, 0, len(hostAggs))
+	for _, ha := range hostAggs {
+		hosts = append(hosts, ha.toHost())
+	}
+	if err := setLabels(ctx, r.reader, hosts); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("can't retrieve labels of hosts %v", hostIds)))
+	}
 
 	var es []*host.Endpoint
-	for _, ha := range hostAggs {
-		h := ha.toHost()
+	for _, h := range hosts {
 		for _, sId := range hostIdToSetIds[h.GetPublicId()] {
 			s := setIdToSet[sId]
 			pref, err := endpoint.NewPreferencer(ctx, endpoint.WithPreferenceOrder(s.PreferredEndpoints))
@@ -860,9 +866,12 @@ func (r *Repository) Endpoints(ctx context.Context, setIds []string) ([]*host.En
 				continue
 			}
 			es = append(es, &host.Endpoint{
-				HostId:  h.GetPublicId(),
-				SetId:   sId,
-				Address: addr,
+				HostId:     h.GetPublicId(),
+				SetId:      sId,
+				Address:    addr,
+				Name:       h.GetName(),
+				ExternalId: h.GetExternalId(),
+				Labels:     h.GetLabels(),
 			})
 		}
 	}
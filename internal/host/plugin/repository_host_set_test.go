@@ -1432,7 +1432,7 @@ func TestRepository_Endpoints(t *testing.T) {
 	hostSetDNS := TestSet(t, conn, kms, sched, catalog, plgm, WithName("hostSetDNS"), WithPreferredEndpoints([]string{"dns:*"}))
 	hostlessSet := TestSet(t, conn, kms, sched, hostlessCatalog, plgm)
 
-	h1 := TestHost(t, conn, catalog.GetPublicId(), "test", withIpAddresses([]string{"10.0.0.5", "192.168.0.5"}), withDnsNames([]string{"example.com"}))
+	h1 := TestHost(t, conn, catalog.GetPublicId(), "test", withIpAddresses([]string{"10.0.0.5", "192.168.0.5"}), withDnsNames([]string{"example.com"}), withLabels(map[string]string{"zone": "us-east-1a"}))
 	TestSetMembers(t, conn, hostSet10.GetPublicId(), []*Host{h1})
 	TestSetMembers(t, conn, hostSet192.GetPublicId(), []*Host{h1})
 	TestSetMembers(t, conn, hostSet100.GetPublicId(), []*Host{h1})
@@ -1458,8 +1458,10 @@ func TestRepository_Endpoints(t *testing.T) {
 						require.NoError(t, err)
 						return s
 					}(),
-					SetId:   hostSet10.GetPublicId(),
-					Address: "10.0.0.5",
+					SetId:      hostSet10.GetPublicId(),
+					Address:    "10.0.0.5",
+					ExternalId: "test",
+					Labels:     map[string]string{"zone": "us-east-1a"},
 				},
 			},
 		},
@@ -1473,8 +1475,10 @@ func TestRepository_Endpoints(t *testing.T) {
 						require.NoError(t, err)
 						return s
 					}(),
-					SetId:   hostSet192.GetPublicId(),
-					Address: "192.168.0.5",
+					SetId:      hostSet192.GetPublicId(),
+					Address:    "192.168.0.5",
+					ExternalId: "test",
+					Labels:     map[string]string{"zone": "us-east-1a"},
 				},
 			},
 		},
@@ -1498,8 +1502,10 @@ func TestRepository_Endpoints(t *testing.T) {
 						require.NoError(t, err)
 						return s
 					}(),
-					SetId:   hostSetDNS.GetPublicId(),
-					Address: "example.com",
+					SetId:      hostSetDNS.GetPublicId(),
+					Address:    "example.com",
+					ExternalId: "test",
+					Labels:     map[string]string{"zone": "us-east-1a"},
 				},
 			},
 		},
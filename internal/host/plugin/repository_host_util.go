@@ -41,6 +41,8 @@ type hostInfo struct {
 	ipsToRemove      valueToInterfaceMap
 	dnsNamesToAdd    valueToInterfaceMap
 	dnsNamesToRemove valueToInterfaceMap
+	labelsToAdd      valueToInterfaceMap
+	labelsToRemove   valueToInterfaceMap
 	dirtyHost        bool
 }
 
@@ -61,6 +63,7 @@ func createNewHostMap(ctx context.Context,
 			WithDescription(ph.GetDescription()),
 			withIpAddresses(ph.GetIpAddresses()),
 			withDnsNames(ph.GetDnsNames()),
+			withLabels(labelsFromAttributes(ph.GetAttributes())),
 			withPluginId(catalog.GetPluginId()))
 		newHost.PublicId, err = newHostId(ctx, catalog.GetPublicId(), ph.GetExternalId())
 		if err != nil {
@@ -94,9 +97,11 @@ func createNewHostMap(ctx context.Context,
 		// and they will have been sorted before insertion.
 		var currHostIps []string
 		var currHostDnsNames []string
+		var currHostLabels map[string]string
 		if currHost != nil {
 			currHostIps = currHost.IpAddresses
 			currHostDnsNames = currHost.DnsNames
+			currHostLabels = currHost.Labels
 		}
 
 		// Sort these here before comparison. We always use a priority order
@@ -194,6 +199,37 @@ func createNewHostMap(ctx context.Context,
 				}
 			}
 		}
+
+		// Labels. The value of a label can not be updated, so a label whose
+		// value changed is removed and added again.
+		{
+			for k, v := range currHostLabels {
+				if nv, ok := newHost.GetLabels()[k]; ok && nv == v {
+					continue
+				}
+				if hi.labelsToRemove == nil {
+					hi.labelsToRemove = make(valueToInterfaceMap, len(currHostLabels))
+				}
+				obj, err := NewHostLabel(ctx, newHost.PublicId, k, v)
+				if err != nil {
+					return nil, errors.Wrap(ctx, err, op)
+				}
+				hi.labelsToRemove[k] = obj
+			}
+			for k, v := range newHost.GetLabels() {
+				if cv, ok := currHostLabels[k]; ok && cv == v {
+					continue
+				}
+				if hi.labelsToAdd == nil {
+					hi.labelsToAdd = make(valueToInterfaceMap, len(newHost.GetLabels()))
+				}
+				obj, err := NewHostLabel(ctx, newHost.PublicId, k, v)
+				if err != nil {
+					return nil, errors.Wrap(ctx, err, op)
+				}
+				hi.labelsToAdd[k] = obj
+			}
+		}
 	}
 
 	return newHostMap, nil
@@ -10,11 +10,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/plugin/store"
 	hoststore "github.com/hashicorp/boundary/internal/host/store"
 	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestUtilFunctions(t *testing.T) {
@@ -36,6 +38,13 @@ func TestUtilFunctions(t *testing.T) {
 		IpAddresses: []string{"1.2.3.4", "5.6.7.8"},
 		DnsNames:    []string{"a.b.c", "x.y.z"},
 		SetIds:      []string{"set1", "set2"},
+		Attributes: func() *structpb.Struct {
+			attrs, err := structpb.NewStruct(map[string]any{
+				"labels": map[string]any{"zone": "us-east-1a", "tier": "web"},
+			})
+			require.NoError(t, err)
+			return attrs
+		}(),
 	}
 	baseIpsIfaces := valueToInterfaceMap{}
 	for _, v := range baseResponseHost.IpAddresses {
@@ -49,11 +58,29 @@ func TestUtilFunctions(t *testing.T) {
 		require.NoError(t, err)
 		baseDnsNamesIfaces[v] = name
 	}
+	baseLabels := map[string]string{"zone": "us-east-1a", "tier": "web"}
+	baseLabelsIfaces := valueToInterfaceMap{}
+	for k, v := range baseLabels {
+		l, err := NewHostLabel(ctx, baseHostId, k, v)
+		require.NoError(t, err)
+		baseLabelsIfaces[k] = l
+	}
+	newLabel := func(k, v string) *HostLabel {
+		l, err := NewHostLabel(ctx, baseHostId, k, v)
+		require.NoError(t, err)
+		return l
+	}
+	setLabelsAttr := func(in *plgpb.ListHostsResponseHost, labels map[string]any) {
+		v, err := structpb.NewValue(labels)
+		require.NoError(t, err)
+		in.Attributes.Fields["labels"] = v
+	}
 	baseHost := NewHost(ctx, catalog.PublicId, externalId)
 	baseHost.Name = baseResponseHost.Name
 	baseHost.Description = baseResponseHost.Description
 	baseHost.IpAddresses = baseResponseHost.IpAddresses
 	baseHost.DnsNames = baseResponseHost.DnsNames
+	baseHost.Labels = baseLabels
 	baseHost.PluginId = pluginId
 	baseHost.SetIds = baseResponseHost.SetIds
 
@@ -87,6 +114,7 @@ func TestUtilFunctions(t *testing.T) {
 					dirtyHost:     true,
 					ipsToAdd:      baseIpsIfaces,
 					dnsNamesToAdd: baseDnsNamesIfaces,
+					labelsToAdd:   baseLabelsIfaces,
 				}
 				return in, hi
 			},
@@ -184,6 +212,53 @@ func TestUtilFunctions(t *testing.T) {
 				return in, hi
 			},
 		},
+		{
+			name: "extra-label",
+			host: defaultHostFunc,
+			sets: defaultSetsFunc,
+			in: func(in *plgpb.ListHostsResponseHost) (*plgpb.ListHostsResponseHost, *hostInfo) {
+				setLabelsAttr(in, map[string]any{"zone": "us-east-1a", "tier": "web", "os": "linux"})
+				hi := &hostInfo{
+					labelsToAdd: valueToInterfaceMap{"os": newLabel("os", "linux")},
+				}
+				return in, hi
+			},
+		},
+		{
+			name: "remove-label",
+			host: defaultHostFunc,
+			sets: defaultSetsFunc,
+			in: func(in *plgpb.ListHostsResponseHost) (*plgpb.ListHostsResponseHost, *hostInfo) {
+				setLabelsAttr(in, map[string]any{"zone": "us-east-1a"})
+				hi := &hostInfo{
+					labelsToRemove: valueToInterfaceMap{"tier": baseLabelsIfaces["tier"]},
+				}
+				return in, hi
+			},
+		},
+		{
+			name: "changed-label",
+			host: defaultHostFunc,
+			sets: defaultSetsFunc,
+			in: func(in *plgpb.ListHostsResponseHost) (*plgpb.ListHostsResponseHost, *hostInfo) {
+				setLabelsAttr(in, map[string]any{"zone": "us-east-1b", "tier": "web"})
+				hi := &hostInfo{
+					labelsToAdd:    valueToInterfaceMap{"zone": newLabel("zone", "us-east-1b")},
+					labelsToRemove: valueToInterfaceMap{"zone": baseLabelsIfaces["zone"]},
+				}
+				return in, hi
+			},
+		},
+		{
+			name: "invalid-labels-ignored",
+			host: defaultHostFunc,
+			sets: defaultSetsFunc,
+			in: func(in *plgpb.ListHostsResponseHost) (*plgpb.ListHostsResponseHost, *hostInfo) {
+				setLabelsAttr(in, map[string]any{"zone": "us-east-1a", "tier": "web", "count": 3, " ": "empty"})
+				hi := &hostInfo{}
+				return in, hi
+			},
+		},
 		{
 			name: "add-sets",
 			host: defaultHostFunc,
@@ -259,6 +334,7 @@ func TestUtilFunctions(t *testing.T) {
 				assert.ElementsMatch(h.IpAddresses, got.h.IpAddresses)
 				assert.ElementsMatch(h.DnsNames, got.h.DnsNames)
 				assert.ElementsMatch(h.SetIds, got.h.SetIds)
+				assert.Equal(labelsFromAttributes(h.Attributes), got.h.Labels)
 
 				assert.Equal(hi.dirtyHost, got.dirtyHost)
 				assert.Empty(
@@ -301,6 +377,8 @@ func TestUtilFunctions(t *testing.T) {
 						}),
 					),
 				)
+				assert.Empty(cmp.Diff(hi.labelsToAdd, got.labelsToAdd, cmpopts.IgnoreUnexported(HostLabel{}, store.HostLabel{})))
+				assert.Empty(cmp.Diff(hi.labelsToRemove, got.labelsToRemove, cmpopts.IgnoreUnexported(HostLabel{}, store.HostLabel{})))
 			}
 
 			// Run through the sets function
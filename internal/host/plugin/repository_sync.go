@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+// SyncSet flags the host set for publicId to be synced and requests the set
+// sync job to run immediately. The host set is returned as it was when the
+// sync was requested; its SyncStatus is updated by the job. If the host set
+// is not found a RecordNotFound error is returned. All options are ignored.
+func (r *Repository) SyncSet(ctx context.Context, publicId string, _ ...Option) (*HostSet, *hostplugin.Plugin, error) {
+	const op = "plugin.(Repository).SyncSet"
+	if publicId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	n, err := r.writer.Exec(ctx, requestSetSyncQuery, []any{publicId})
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("flagging host set %s for synchronization", publicId)))
+	}
+	if n == 0 {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("host set %s not found", publicId))
+	}
+	_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, setSyncJobName, 0, scheduler.WithRunNow(true))
+
+	s, plg, err := r.LookupSet(ctx, publicId)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("host set %s not found", publicId))
+	}
+	return s, plg, nil
+}
+
+// SyncCatalog flags all the host sets of the host catalog for id to be
+// synced and requests the set sync job to run immediately. If the host
+// catalog is not found a RecordNotFound error is returned. All options are
+// ignored.
+func (r *Repository) SyncCatalog(ctx context.Context, id string, _ ...Option) (*HostCatalog, *hostplugin.Plugin, error) {
+	const op = "plugin.(Repository).SyncCatalog"
+	if id == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	c, plg, err := r.LookupCatalog(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if c == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("host catalog %s not found", id))
+	}
+
+	n, err := r.writer.Exec(ctx, requestCatalogSyncQuery, []any{id})
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("flagging host sets of %s for synchronization", id)))
+	}
+	if n > 0 {
+		_ = r.scheduler.UpdateJobNextRunInAtLeast(ctx, setSyncJobName, 0, scheduler.WithRunNow(true))
+	}
+	return c, plg, nil
+}
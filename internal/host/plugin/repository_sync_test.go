@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	hostplg "github.com/hashicorp/boundary/internal/plugin/host"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SyncSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	plgServer := &TestPluginServer{}
+	plg := hostplg.TestPlugin(t, conn, "sync")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): NewWrappingPluginClient(plgServer),
+	}
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalog(t, conn, prj.GetPublicId(), plg.GetPublicId())
+	set := TestSet(t, conn, kmsCache, sched, cat, plgm)
+
+	repo, err := NewRepository(rw, rw, kmsCache, sched, plgm)
+	require.NoError(t, err)
+	job, err := newSetSyncJob(ctx, rw, rw, kmsCache, plgm)
+	require.NoError(t, err)
+
+	t.Run("missing-id", func(t *testing.T) {
+		_, _, err := repo.SyncSet(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+	t.Run("not-found", func(t *testing.T) {
+		_, _, err := repo.SyncSet(ctx, "hsplg_doesntexist")
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+	})
+
+	// Sync the set so it no longer needs to be synced
+	plgServer.ListHostsFn = func(_ context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+		return &plgpb.ListHostsResponse{}, nil
+	}
+	require.NoError(t, job.Run(ctx))
+	got, _, err := repo.LookupSet(ctx, set.GetPublicId())
+	require.NoError(t, err)
+	require.False(t, got.GetNeedSync())
+	require.NotNil(t, got.SyncStatus)
+	assert.Empty(t, got.SyncStatus.LastSyncError)
+	assert.Equal(t, 0, got.SyncStatus.HostsAdded)
+
+	got, gotPlg, err := repo.SyncSet(ctx, set.GetPublicId())
+	require.NoError(t, err)
+	assert.True(t, got.GetNeedSync())
+	assert.Equal(t, plg.GetPublicId(), gotPlg.GetPublicId())
+
+	plgServer.ListHostsFn = func(_ context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+		var setIds []string
+		for _, s := range req.GetSets() {
+			setIds = append(setIds, s.GetId())
+		}
+		return &plgpb.ListHostsResponse{
+			Hosts: []*plgpb.ListHostsResponseHost{
+				{ExternalId: "first", IpAddresses: []string{"10.0.0.1"}, SetIds: setIds},
+				{ExternalId: "second", IpAddresses: []string{"10.0.0.2"}, SetIds: setIds},
+			},
+		}, nil
+	}
+	require.NoError(t, job.Run(ctx))
+	assert.Equal(t, 1, job.numProcessed)
+	got, _, err = repo.LookupSet(ctx, set.GetPublicId())
+	require.NoError(t, err)
+	assert.False(t, got.GetNeedSync())
+	require.NotNil(t, got.SyncStatus)
+	assert.Empty(t, got.SyncStatus.LastSyncError)
+	assert.Equal(t, 2, got.SyncStatus.HostsAdded)
+	assert.Equal(t, 0, got.SyncStatus.HostsRemoved)
+	assert.False(t, got.SyncStatus.LastSyncEndTime.AsTime().Before(got.SyncStatus.LastSyncStartTime.AsTime()))
+
+	_, _, err = repo.SyncSet(ctx, set.GetPublicId())
+	require.NoError(t, err)
+	plgServer.ListHostsFn = func(_ context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+		return nil, fmt.Errorf("credentials expired")
+	}
+	require.NoError(t, job.Run(ctx))
+	got, _, err = repo.LookupSet(ctx, set.GetPublicId())
+	require.NoError(t, err)
+	// A failed sync is retried on the next run.
+	assert.True(t, got.GetNeedSync())
+	require.NotNil(t, got.SyncStatus)
+	assert.Contains(t, got.SyncStatus.LastSyncError, "credentials expired")
+	assert.Equal(t, 0, got.SyncStatus.HostsAdded)
+	assert.Len(t, got.HostIds, 2)
+}
+
+func TestRepository_SyncCatalog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sched := scheduler.TestScheduler(t, conn, wrapper)
+
+	plgServer := &TestPluginServer{
+		ListHostsFn: func(_ context.Context, _ *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+			return &plgpb.ListHostsResponse{}, nil
+		},
+	}
+	plg := hostplg.TestPlugin(t, conn, "sync")
+	plgm := map[string]plgpb.HostPluginServiceClient{
+		plg.GetPublicId(): NewWrappingPluginClient(plgServer),
+	}
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalog(t, conn, prj.GetPublicId(), plg.GetPublicId())
+	otherCat := TestCatalog(t, conn, prj.GetPublicId(), plg.GetPublicId())
+	set1 := TestSet(t, conn, kmsCache, sched, cat, plgm)
+	set2 := TestSet(t, conn, kmsCache, sched, cat, plgm)
+	otherSet := TestSet(t, conn, kmsCache, sched, otherCat, plgm)
+
+	repo, err := NewRepository(rw, rw, kmsCache, sched, plgm)
+	require.NoError(t, err)
+	job, err := newSetSyncJob(ctx, rw, rw, kmsCache, plgm)
+	require.NoError(t, err)
+	require.NoError(t, job.Run(ctx))
+
+	t.Run("missing-id", func(t *testing.T) {
+		_, _, err := repo.SyncCatalog(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+	t.Run("not-found", func(t *testing.T) {
+		_, _, err := repo.SyncCatalog(ctx, "hcplg_doesntexist")
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got: %q", errors.RecordNotFound, err)
+	})
+
+	got, _, err := repo.SyncCatalog(ctx, cat.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, cat.GetPublicId(), got.GetPublicId())
+
+	for _, s := range []*HostSet{set1, set2} {
+		got, _, err := repo.LookupSet(ctx, s.GetPublicId())
+		require.NoError(t, err)
+		assert.True(t, got.GetNeedSync())
+	}
+	gotOther, _, err := repo.LookupSet(ctx, otherSet.GetPublicId())
+	require.NoError(t, err)
+	assert.False(t, gotOther.GetNeedSync())
+}
@@ -148,6 +148,16 @@ func TestHost(t testing.TB, conn *db.DB, catId, externId string, opt ...Option)
 		}
 		require.NoError(t, w.CreateItems(ctx, dnsNames))
 	}
+
+	if len(host1.GetLabels()) > 0 {
+		labels := make([]any, 0, len(host1.GetLabels()))
+		for k, v := range host1.GetLabels() {
+			obj, err := NewHostLabel(ctx, host1.PublicId, k, v)
+			require.NoError(t, err)
+			labels = append(labels, obj)
+		}
+		require.NoError(t, w.CreateItems(ctx, labels))
+	}
 	return host1
 }
 
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// newFilterEvaluator returns an evaluator for the filter of a host set.
+func newFilterEvaluator(ctx context.Context, op errors.Op, filter string) (*bexpr.Evaluator, error) {
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "error evaluating filter expression", errors.WithWrap(err))
+	}
+	return eval, nil
+}
+
+// filterInput returns the data the filter of a host set is evaluated
+// against. Filters can select on the name, the labels and the attributes of
+// a host, for example:
+//
+//	"/labels/env" == "prod" and "/attributes/address" matches "\.internal$"
+func (h *Host) filterInput() map[string]any {
+	labels := h.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return map[string]any{
+		"name":   h.GetName(),
+		"labels": labels,
+		"attributes": map[string]any{
+			"address": h.GetAddress(),
+		},
+	}
+}
+
+// matches reports whether h matches eval. A filter selecting a label or
+// attribute the host does not have does not match.
+func (h *Host) matches(ctx context.Context, eval *bexpr.Evaluator) (bool, error) {
+	const op = "static.(Host).matches"
+	ok, err := eval.Evaluate(h.filterInput())
+	if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("evaluating filter for host %s", h.GetPublicId())))
+	}
+	return ok, nil
+}
+
+// filterHosts returns the hosts of the catalog of s matching the filter of
+// s, ordered by public id. If limit is not unlimited, at most limit hosts
+// are returned.
+func filterHosts(ctx context.Context, reader db.Reader, s *HostSet, limit int) ([]*Host, error) {
+	const op = "static.filterHosts"
+	eval, err := newFilterEvaluator(ctx, op, s.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*Host
+	if err := reader.SearchWhere(ctx, &candidates, "catalog_id = ?", []any{s.GetCatalogId()},
+		db.WithLimit(unlimited), db.WithOrder("public_id")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := setLabels(ctx, reader, candidates); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var hosts []*Host
+	for _, h := range candidates {
+		if limit > 0 && len(hosts) >= limit {
+			break
+		}
+		ok, err := h.matches(ctx, eval)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if ok {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts, nil
+}
+
+// setHosts returns the hosts of s. If limit is not unlimited, at most limit
+// hosts are returned.
+func setHosts(ctx context.Context, reader db.Reader, s *HostSet, limit int) ([]*Host, error) {
+	const op = "static.setHosts"
+	if s.GetFilter() != "" {
+		hosts, err := filterHosts(ctx, reader, s, limit)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return hosts, nil
+	}
+	hosts, err := getHosts(ctx, reader, s.GetPublicId(), limit)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := setLabels(ctx, reader, hosts); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return hosts, nil
+}
+
+// addFilteredSetIds adds the ids of the host sets with a filter matching
+// each host to the SetIds of the host. The hosts must belong to catalogId
+// and have their labels set.
+func addFilteredSetIds(ctx context.Context, reader db.Reader, catalogId string, hosts []*Host) error {
+	const op = "static.addFilteredSetIds"
+	if len(hosts) == 0 {
+		return nil
+	}
+	var sets []*HostSet
+	if err := reader.SearchWhere(ctx, &sets, "catalog_id = ? and filter is not null", []any{catalogId}, db.WithLimit(unlimited)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	for _, s := range sets {
+		eval, err := newFilterEvaluator(ctx, op, s.GetFilter())
+		if err != nil {
+			return err
+		}
+		for _, h := range hosts {
+			ok, err := h.matches(ctx, eval)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if ok {
+				h.SetIds = append(h.SetIds, s.GetPublicId())
+			}
+		}
+	}
+	for _, h := range hosts {
+		sort.Strings(h.SetIds)
+	}
+	return nil
+}
+
+// checkNoFilter returns an error if the host set setId has a filter. Hosts
+// cannot be added to a host set with a filter.
+func checkNoFilter(ctx context.Context, reader db.Reader, setId string) error {
+	const op = "static.checkNoFilter"
+	s := allocHostSet()
+	s.PublicId = setId
+	if err := reader.LookupByPublicId(ctx, s); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", setId)))
+	}
+	if s.GetFilter() != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hosts cannot be added to host set %s which has a filter", setId))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHost_matches(t *testing.T) {
+	ctx := context.Background()
+	h := &Host{
+		Host: &store.Host{
+			PublicId: "hst_1234567890",
+			Name:     "web",
+			Address:  "web.internal",
+			Labels:   map[string]string{"env": "prod", "tier": "web"},
+		},
+	}
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{filter: `"/labels/env" == "prod"`, want: true},
+		{filter: `"/labels/env" == "dev"`, want: false},
+		{filter: `"/labels/env" == "prod" and "/labels/tier" == "web"`, want: true},
+		{filter: `"/labels/missing" == "prod"`, want: false},
+		{filter: `"/attributes/address" matches "\\.internal$"`, want: true},
+		{filter: `"/name" == "web"`, want: true},
+		{filter: `"env" in "/labels"`, want: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filter, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			eval, err := newFilterEvaluator(ctx, "test", tt.filter)
+			require.NoError(err)
+			got, err := h.matches(ctx, eval)
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+
+	t.Run("invalid-filter", func(t *testing.T) {
+		_, err := newFilterEvaluator(ctx, "test", `"/labels/env" ==`)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+	})
+}
+
+func TestRepository_FilteredSet(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	newHost := func(t *testing.T, address string, labels map[string]string) *Host {
+		t.Helper()
+		h, err := NewHost(catalog.PublicId, WithAddress(address), WithLabels(labels))
+		require.NoError(t, err)
+		h, err = repo.CreateHost(ctx, prj.PublicId, h)
+		require.NoError(t, err)
+		return h
+	}
+	prod := newHost(t, "prod.internal", map[string]string{"env": "prod"})
+	dev := newHost(t, "dev.internal", map[string]string{"env": "dev"})
+	newHost(t, "unlabeled.internal", nil)
+
+	t.Run("invalid-filter", func(t *testing.T) {
+		s, err := NewHostSet(catalog.PublicId, WithFilter(`"/labels/env" ==`))
+		require.NoError(t, err)
+		_, err = repo.CreateSet(ctx, prj.PublicId, s)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+	})
+
+	s, err := NewHostSet(catalog.PublicId, WithFilter(`"/labels/env" == "prod"`))
+	require.NoError(t, err)
+	s, err = repo.CreateSet(ctx, prj.PublicId, s)
+	require.NoError(t, err)
+	assert.Equal(t, `"/labels/env" == "prod"`, s.GetFilter())
+
+	t.Run("lookup", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, hosts, err := repo.LookupSet(ctx, s.PublicId)
+		require.NoError(err)
+		require.Len(hosts, 1)
+		assert.Equal(prod.PublicId, hosts[0].PublicId)
+		assert.Equal(map[string]string{"env": "prod"}, hosts[0].Labels)
+
+		h, err := repo.LookupHost(ctx, prod.PublicId)
+		require.NoError(err)
+		assert.Equal([]string{s.PublicId}, h.SetIds)
+	})
+
+	t.Run("endpoints", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		es, err := repo.Endpoints(ctx, s.PublicId)
+		require.NoError(err)
+		require.Len(es, 1)
+		assert.Equal(prod.PublicId, es[0].HostId)
+		assert.Equal(s.PublicId, es[0].SetId)
+		assert.Equal("prod.internal", es[0].Address)
+		assert.Equal(map[string]string{"env": "prod"}, es[0].Labels)
+	})
+
+	t.Run("add-members", func(t *testing.T) {
+		_, err := repo.AddSetMembers(ctx, prj.PublicId, s.PublicId, s.Version, []string{dev.PublicId})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+	})
+
+	t.Run("relabel-host", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		h := dev.clone()
+		h.Labels = map[string]string{"env": "prod", "tier": "web"}
+		got, rows, err := repo.UpdateHost(ctx, prj.PublicId, h, dev.Version, []string{"Labels"})
+		require.NoError(err)
+		assert.Equal(1, rows)
+		assert.Equal(dev.Version+1, got.Version)
+		assert.Equal(h.Labels, got.Labels)
+		assert.Equal([]string{s.PublicId}, got.SetIds)
+
+		es, err := repo.Endpoints(ctx, s.PublicId)
+		require.NoError(err)
+		assert.Len(es, 2)
+	})
+
+	t.Run("update-filter", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		us := s.clone()
+		us.Filter = `"/labels/tier" == "web"`
+		got, hosts, rows, err := repo.UpdateSet(ctx, prj.PublicId, us, s.Version, []string{"Filter"})
+		require.NoError(err)
+		assert.Equal(1, rows)
+		assert.Equal(us.Filter, got.Filter)
+		require.Len(hosts, 1)
+		assert.Equal(dev.PublicId, hosts[0].PublicId)
+	})
+}
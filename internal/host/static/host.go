@@ -17,6 +17,7 @@ import (
 const (
 	MinHostAddressLength = 3
 	MaxHostAddressLength = 255
+	MaxHostPort          = 65535
 )
 
 // A Host contains a static address.
@@ -27,8 +28,8 @@ type Host struct {
 }
 
 // NewHost creates a new in memory Host for address assigned to catalogId.
-// Name and description are the only valid options. All other options are
-// ignored.
+// Name, description, address, labels and port are the only valid options. All
+// other options are ignored.
 func NewHost(catalogId string, opt ...Option) (*Host, error) {
 	if catalogId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, "static.NewHost", "no catalog id")
@@ -41,6 +42,8 @@ func NewHost(catalogId string, opt ...Option) (*Host, error) {
 			Address:     opts.withAddress,
 			Name:        opts.withName,
 			Description: opts.withDescription,
+			Labels:      opts.withLabels,
+			Port:        opts.withPort,
 		},
 	}
 	return host, nil
@@ -119,6 +122,7 @@ type hostAgg struct {
 	Version     uint32
 	Address     string
 	SetIds      string
+	Port        uint32
 }
 
 func (agg *hostAgg) toHost() *Host {
@@ -132,6 +136,7 @@ func (agg *hostAgg) toHost() *Host {
 	h.Version = agg.Version
 	h.Address = agg.Address
 	h.SetIds = agg.getSetIds()
+	h.Port = agg.Port
 	return h
 }
 
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+)
+
+const (
+	// MaxHostLabelLength is the maximum length of the key and of the value
+	// of a host label.
+	MaxHostLabelLength = 255
+)
+
+// A HostLabel is a key/value label of a host.
+type HostLabel struct {
+	*store.HostLabel
+	tableName string `gorm:"-"`
+}
+
+// NewHostLabel creates a new in memory HostLabel for hostId. The key must
+// not be empty.
+func NewHostLabel(ctx context.Context, hostId, key, value string) (*HostLabel, error) {
+	const op = "static.NewHostLabel"
+	if hostId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no host id")
+	}
+	if err := validateLabel(ctx, op, key, value); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return &HostLabel{
+		HostLabel: &store.HostLabel{
+			HostId: hostId,
+			Key:    key,
+			Value:  value,
+		},
+	}, nil
+}
+
+// TableName returns the table name for the host label.
+func (l *HostLabel) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "static_host_label"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (l *HostLabel) SetTableName(n string) {
+	l.tableName = n
+}
+
+func validateLabel(ctx context.Context, op errors.Op, key, value string) error {
+	switch {
+	case strings.TrimSpace(key) == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "empty label key")
+	case len(key) > MaxHostLabelLength:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("label key %q is longer than %d characters", key, MaxHostLabelLength))
+	case len(value) > MaxHostLabelLength:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("value of label %q is longer than %d characters", key, MaxHostLabelLength))
+	}
+	return nil
+}
+
+func validateLabels(ctx context.Context, op errors.Op, labels map[string]string) error {
+	for k, v := range labels {
+		if err := validateLabel(ctx, op, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newHostLabels returns the labels of hostId ordered by key.
+func newHostLabels(ctx context.Context, hostId string, labels map[string]string) ([]any, error) {
+	const op = "static.newHostLabels"
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ls := make([]any, 0, len(keys))
+	for _, k := range keys {
+		l, err := NewHostLabel(ctx, hostId, k, labels[k])
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ls = append(ls, l)
+	}
+	return ls, nil
+}
+
+// setLabels reads the labels of hosts from the repository and sets them on
+// hosts.
+func setLabels(ctx context.Context, reader db.Reader, hosts []*Host) error {
+	const op = "static.setLabels"
+	if len(hosts) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.GetPublicId())
+	}
+	var labels []*HostLabel
+	if err := reader.SearchWhere(ctx, &labels, "host_id in (?)", []any{ids}, db.WithLimit(unlimited)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	byHost := make(map[string]map[string]string, len(hosts))
+	for _, l := range labels {
+		if byHost[l.GetHostId()] == nil {
+			byHost[l.GetHostId()] = make(map[string]string)
+		}
+		byHost[l.GetHostId()][l.GetKey()] = l.GetValue()
+	}
+	for _, h := range hosts {
+		h.Labels = byHost[h.GetPublicId()]
+	}
+	return nil
+}
@@ -10,15 +10,17 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// A HostSet is a collection of hosts from the set's catalog.
+// A HostSet is a collection of hosts from the set's catalog. If the set has
+// a filter, its hosts are the hosts of the catalog matching the filter.
+// Otherwise its hosts are the hosts added to it.
 type HostSet struct {
 	*store.HostSet
 	tableName string `gorm:"-"`
 }
 
 // NewHostSet creates a new in memory HostSet assigned to catalogId.
-// Name and description are the only valid options. All other options are
-// ignored.
+// Name, description and filter are the only valid options. All other
+// options are ignored.
 func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
 	if catalogId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, "static.NewHostSet", "no catalog id")
@@ -30,6 +32,7 @@ func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
 			CatalogId:   catalogId,
 			Name:        opts.withName,
 			Description: opts.withDescription,
+			Filter:      opts.withFilter,
 		},
 	}
 	return set, nil
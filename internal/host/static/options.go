@@ -22,6 +22,10 @@ type options struct {
 	withLimit       int
 	withAddress     string
 	withPublicId    string
+	withLabels      map[string]string
+	withFilter      string
+	withDryRun      bool
+	withPort        uint32
 }
 
 func getDefaultOptions() options {
@@ -68,3 +72,34 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithLabels provides optional key/value labels for a host.
+func WithLabels(labels map[string]string) Option {
+	return func(o *options) {
+		o.withLabels = labels
+	}
+}
+
+// WithFilter provides an optional filter for a host set. A host set with a
+// filter contains the hosts of its catalog which match the filter.
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = filter
+	}
+}
+
+// WithDryRun provides an option to report the changes an import would make
+// without making them.
+func WithDryRun(dryRun bool) Option {
+	return func(o *options) {
+		o.withDryRun = dryRun
+	}
+}
+
+// WithPort provides an optional port for a host. A host's port overrides the
+// default port of the targets the host is used by.
+func WithPort(port uint32) Option {
+	return func(o *options) {
+		o.withPort = port
+	}
+}
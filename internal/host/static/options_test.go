@@ -41,4 +41,28 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPublicId = "test"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithLabels", func(t *testing.T) {
+		opts := getOpts(WithLabels(map[string]string{"env": "prod"}))
+		testOpts := getDefaultOptions()
+		testOpts.withLabels = map[string]string{"env": "prod"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithFilter", func(t *testing.T) {
+		opts := getOpts(WithFilter(`"/labels/env" == "prod"`))
+		testOpts := getDefaultOptions()
+		testOpts.withFilter = `"/labels/env" == "prod"`
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDryRun", func(t *testing.T) {
+		opts := getOpts(WithDryRun(true))
+		testOpts := getDefaultOptions()
+		testOpts.withDryRun = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPort", func(t *testing.T) {
+		opts := getOpts(WithPort(8080))
+		testOpts := getDefaultOptions()
+		testOpts.withPort = 8080
+		assert.Equal(t, opts, testOpts)
+	})
 }
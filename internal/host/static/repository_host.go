@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/go-dbw"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
 
 // CreateHost inserts h into the repository and returns a new Host
@@ -24,7 +25,8 @@ import (
 // h must contain a valid Address.
 //
 // Both h.Name and h.Description are optional. If h.Name is set, it must be
-// unique within h.CatalogId.
+// unique within h.CatalogId. h.Labels is optional. Label keys must not be
+// empty. h.Port is optional and, if set, must not be greater than 65535.
 func (r *Repository) CreateHost(ctx context.Context, projectId string, h *Host, opt ...Option) (*Host, error) {
 	const op = "static.(Repository).CreateHost"
 	if h == nil {
@@ -46,6 +48,12 @@ func (r *Repository) CreateHost(ctx context.Context, projectId string, h *Host,
 	if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
 		return nil, errors.New(ctx, errors.InvalidAddress, op, "invalid address")
 	}
+	if err := validateLabels(ctx, op, h.Labels); err != nil {
+		return nil, err
+	}
+	if h.Port > MaxHostPort {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid port")
+	}
 	h = h.clone()
 
 	opts := getOpts(opt...)
@@ -72,12 +80,16 @@ func (r *Repository) CreateHost(ctx context.Context, projectId string, h *Host,
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
 	}
 
+	labels, err := newHostLabels(ctx, h.PublicId, h.Labels)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
 	var newHost *Host
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(_ db.Reader, w db.Writer) error {
 			newHost = h.clone()
-			err := w.Create(ctx, newHost, db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_CREATE)))
-			if err != nil {
+			if err := insertHost(ctx, w, oplogWrapper, newHost, labels); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
 			return nil
@@ -101,15 +113,38 @@ func (r *Repository) CreateHost(ctx context.Context, projectId string, h *Host,
 	return newHost, nil
 }
 
+// insertHost writes h and its labels to w.
+func insertHost(ctx context.Context, w db.Writer, wrapper wrapping.Wrapper, h *Host, labels []any) error {
+	const op = "static.insertHost"
+	if len(labels) == 0 {
+		if err := w.Create(ctx, h, db.WithOplog(wrapper, h.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	}
+
+	hostMsg := new(oplog.Message)
+	if err := w.Create(ctx, h, db.NewOplogMsg(hostMsg)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	var labelMsgs []*oplog.Message
+	if err := w.CreateItems(ctx, labels, db.NewOplogMsgs(&labelMsgs)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	msgs := append([]*oplog.Message{hostMsg}, labelMsgs...)
+	return writeHostOplog(ctx, w, wrapper, h, h.oplog(oplog.OpType_OP_TYPE_CREATE), msgs)
+}
+
 // UpdateHost updates the repository entry for h.PublicId with the values
 // in h for the fields listed in fieldMaskPaths. It returns a new Host
 // containing the updated values and a count of the number of records
 // updated. h is not changed.
 //
-// h must contain a valid PublicId. Only h.Name, h.Description, and
-// h.Address can be updated. If h.Name is set to a non-empty string, it
-// must be unique within h.CatalogId. If h.Address is set, it must contain
-// a valid address.
+// h must contain a valid PublicId. Only h.Name, h.Description, h.Address,
+// h.Labels and h.Port can be updated. If h.Name is set to a non-empty
+// string, it must be unique within h.CatalogId. If h.Address is set, it
+// must contain a valid address. Updating h.Labels replaces all labels of
+// the host.
 //
 // An attribute of h will be set to NULL in the database if the attribute
 // in h is the zero value and it is included in fieldMaskPaths.
@@ -131,6 +166,7 @@ func (r *Repository) UpdateHost(ctx context.Context, projectId string, h *Host,
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
 	}
 
+	var updateLabels bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("Name", f):
@@ -140,6 +176,15 @@ func (r *Repository) UpdateHost(ctx context.Context, projectId string, h *Host,
 			if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
 				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidAddress, op, "invalid address")
 			}
+		case strings.EqualFold("Labels", f):
+			if err := validateLabels(ctx, op, h.Labels); err != nil {
+				return nil, db.NoRowsAffected, err
+			}
+			updateLabels = true
+		case strings.EqualFold("Port", f):
+			if h.Port > MaxHostPort {
+				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "invalid port")
+			}
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -150,11 +195,12 @@ func (r *Repository) UpdateHost(ctx context.Context, projectId string, h *Host,
 			"Name":        h.Name,
 			"Description": h.Description,
 			"Address":     h.Address,
+			"Port":        h.Port,
 		},
 		fieldMaskPaths,
 		nil,
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updateLabels {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
 	}
 
@@ -166,12 +212,16 @@ func (r *Repository) UpdateHost(ctx context.Context, projectId string, h *Host,
 	var rowsUpdated int
 	var returnedHost *Host
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedHost = h.clone()
 			var err error
-			rowsUpdated, err = w.Update(ctx, returnedHost, dbMask, nullFields,
-				db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_UPDATE)),
-				db.WithVersion(&version))
+			if !updateLabels {
+				rowsUpdated, err = w.Update(ctx, returnedHost, dbMask, nullFields,
+					db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+					db.WithVersion(&version))
+			} else {
+				rowsUpdated, err = r.updateHostWithLabels(ctx, reader, w, oplogWrapper, returnedHost, version, dbMask, nullFields)
+			}
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -181,10 +231,17 @@ func (r *Repository) UpdateHost(ctx context.Context, projectId string, h *Host,
 			ha := &hostAgg{
 				PublicId: h.PublicId,
 			}
-			if err := r.reader.LookupByPublicId(ctx, ha); err != nil {
+			if err := reader.LookupByPublicId(ctx, ha); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to lookup host after update"))
 			}
 			returnedHost.SetIds = ha.getSetIds()
+			hosts := []*Host{returnedHost}
+			if err := setLabels(ctx, reader, hosts); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if err := addFilteredSetIds(ctx, reader, ha.CatalogId, hosts); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 			return nil
 		},
 	)
@@ -207,6 +264,83 @@ func (r *Repository) UpdateHost(ctx context.Context, projectId string, h *Host,
 	return returnedHost, rowsUpdated, nil
 }
 
+// updateHostWithLabels updates the fields of h in dbMask and nullFields and
+// replaces the labels of h with h.Labels. The version of h is always
+// incremented.
+func (r *Repository) updateHostWithLabels(ctx context.Context, reader db.Reader, w db.Writer, wrapper wrapping.Wrapper, h *Host, version uint32, dbMask, nullFields []string) (int, error) {
+	const op = "static.(Repository).updateHostWithLabels"
+	var current []*HostLabel
+	if err := reader.SearchWhere(ctx, &current, "host_id = ?", []any{h.PublicId}, db.WithLimit(unlimited)); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	var deletions []any
+	existing := make(map[string]string, len(current))
+	for _, l := range current {
+		existing[l.GetKey()] = l.GetValue()
+		if v, ok := h.Labels[l.GetKey()]; !ok || v != l.GetValue() {
+			deletions = append(deletions, l)
+		}
+	}
+	added := make(map[string]string, len(h.Labels))
+	for k, v := range h.Labels {
+		if ev, ok := existing[k]; !ok || ev != v {
+			added[k] = v
+		}
+	}
+	additions, err := newHostLabels(ctx, h.PublicId, added)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	// The version is updated even if only the labels change.
+	h.Version = version + 1
+	hostMsg := new(oplog.Message)
+	rowsUpdated, err := w.Update(ctx, h, append(dbMask, "Version"), nullFields, db.NewOplogMsg(hostMsg), db.WithVersion(&version))
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if rowsUpdated == 0 {
+		return db.NoRowsAffected, nil
+	}
+	msgs := []*oplog.Message{hostMsg}
+
+	if len(deletions) > 0 {
+		var deleteMsgs []*oplog.Message
+		rowsDeleted, err := w.DeleteItems(ctx, deletions, db.NewOplogMsgs(&deleteMsgs))
+		if err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if rowsDeleted != len(deletions) {
+			return db.NoRowsAffected, errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("labels deleted %d did not match request for %d", rowsDeleted, len(deletions)))
+		}
+		msgs = append(msgs, deleteMsgs...)
+	}
+	if len(additions) > 0 {
+		var createMsgs []*oplog.Message
+		if err := w.CreateItems(ctx, additions, db.NewOplogMsgs(&createMsgs)); err != nil {
+			return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		msgs = append(msgs, createMsgs...)
+	}
+
+	if err := writeHostOplog(ctx, w, wrapper, h, h.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return rowsUpdated, nil
+}
+
+func writeHostOplog(ctx context.Context, w db.Writer, wrapper wrapping.Wrapper, h *Host, metadata oplog.Metadata, msgs []*oplog.Message) error {
+	const op = "static.writeHostOplog"
+	ticket, err := w.GetTicket(ctx, h)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+	}
+	if err := w.WriteOplogEntryWith(ctx, wrapper, ticket, metadata, msgs); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+	}
+	return nil
+}
+
 // LookupHost will look up a host in the repository. If the host is not
 // found, it will return nil, nil. All options are ignored.
 func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Option) (*Host, error) {
@@ -223,7 +357,15 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 		}
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
 	}
-	return ha.toHost(), nil
+	h := ha.toHost()
+	hosts := []*Host{h}
+	if err := setLabels(ctx, r.reader, hosts); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := addFilteredSetIds(ctx, r.reader, h.CatalogId, hosts); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return h, nil
 }
 
 // ListHosts returns a slice of Hosts for the catalogId.
@@ -248,6 +390,12 @@ func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Opt
 	for _, ha := range aggs {
 		hosts = append(hosts, ha.toHost())
 	}
+	if err := setLabels(ctx, r.reader, hosts); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := addFilteredSetIds(ctx, r.reader, catalogId, hosts); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	return hosts, nil
 }
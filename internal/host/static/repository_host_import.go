@@ -0,0 +1,427 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// ImportAction is the change ImportHosts made, or would make, to an imported
+// host.
+type ImportAction string
+
+const (
+	ImportCreated   ImportAction = "created"
+	ImportUpdated   ImportAction = "updated"
+	ImportUnchanged ImportAction = "unchanged"
+	ImportSkipped   ImportAction = "skipped"
+	ImportFailed    ImportAction = "failed"
+)
+
+// ImportHost is a host to import into a static host catalog with
+// ImportHosts.
+type ImportHost struct {
+	// Name identifies the host within the catalog. An existing host with
+	// the same name is updated, otherwise a new host is created.
+	Name string
+	// Description replaces the description of an existing host if it is not
+	// empty.
+	Description string
+	Address     string
+	// Labels replace the labels of an existing host if they are not empty.
+	Labels map[string]string
+	// SetNames are the names of the host sets the host is added to. Host
+	// sets which do not exist are created. Hosts are never removed from a
+	// host set by an import.
+	SetNames []string
+}
+
+// ImportResult is the result of importing a single ImportHost.
+type ImportResult struct {
+	// Row is the index of the ImportHost in the hosts passed to ImportHosts.
+	Row    int
+	Name   string
+	HostId string
+	Action ImportAction
+	// SetIds are the ids of the host sets named by the ImportHost. In a dry
+	// run host sets which do not exist yet are not included.
+	SetIds []string
+	// Error is the reason the ImportHost could not be imported if Action
+	// is ImportFailed.
+	Error string
+}
+
+// importPlan is the set of changes to make to a catalog for an import.
+type importPlan struct {
+	catalogId  string
+	newSets    []*HostSet
+	setsByName map[string]*HostSet
+	creates    []*Host
+	updates    []*importUpdate
+	// rowHosts and rowSets are the host and the host sets of each imported
+	// host.
+	rowHosts []*Host
+	rowSets  [][]*HostSet
+	// isMember is keyed by set id and host id.
+	isMember map[[2]string]bool
+}
+
+type importUpdate struct {
+	host    *Host
+	version uint32
+	dbMask  []string
+	labels  bool
+}
+
+// ImportHosts creates or updates the hosts in catalogId to match hosts and
+// adds them to the named host sets, creating missing host sets. Hosts are
+// matched to the existing hosts of the catalog by name, so importing the
+// same hosts again does not change the catalog. It returns a result for
+// each of hosts in the same order.
+//
+// All changes are made in a single transaction. If any of hosts cannot be
+// imported no changes are made: the result of each host that could not be
+// imported has the ImportFailed action and the results of all other hosts
+// have the ImportSkipped action.
+//
+// The WithDryRun option reports the changes the import would make without
+// making them. All other options are ignored.
+func (r *Repository) ImportHosts(ctx context.Context, projectId, catalogId string, hosts []*ImportHost, opt ...Option) ([]*ImportResult, error) {
+	const op = "static.(Repository).ImportHosts"
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if catalogId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	}
+	if len(hosts) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no hosts")
+	}
+	opts := getOpts(opt...)
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var results []*ImportResult
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			c := allocCatalog()
+			c.PublicId = catalogId
+			if err := reader.LookupByPublicId(ctx, c); err != nil {
+				if errors.IsNotFoundError(err) {
+					return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("catalog %s not found", catalogId))
+				}
+				return errors.Wrap(ctx, err, op)
+			}
+			if c.ProjectId != projectId {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("catalog %s is not in project %s", catalogId, projectId))
+			}
+
+			var plan *importPlan
+			var err error
+			plan, results, err = planImport(ctx, reader, catalogId, hosts)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch {
+			case plan == nil:
+				if !opts.withDryRun {
+					for _, res := range results {
+						if res.Action != ImportFailed {
+							res.Action = ImportSkipped
+						}
+					}
+				}
+				return nil
+			case opts.withDryRun:
+				return nil
+			}
+
+			if err := r.applyImport(ctx, reader, w, oplogWrapper, plan); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			for i, res := range results {
+				res.HostId = plan.rowHosts[i].GetPublicId()
+				res.SetIds = setIds(plan.rowSets[i])
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s: name already exists", catalogId)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s", catalogId)))
+	}
+	return results, nil
+}
+
+// planImport compares hosts to the hosts and host sets of catalogId and
+// returns the changes needed to import hosts with a result for each of
+// hosts. The returned plan is nil if any of hosts cannot be imported.
+func planImport(ctx context.Context, reader db.Reader, catalogId string, hosts []*ImportHost) (*importPlan, []*ImportResult, error) {
+	const op = "static.planImport"
+	var existingHosts []*Host
+	if err := reader.SearchWhere(ctx, &existingHosts, "catalog_id = ?", []any{catalogId}, db.WithLimit(unlimited)); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if err := setLabels(ctx, reader, existingHosts); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	hostsByName := make(map[string]*Host, len(existingHosts))
+	for _, h := range existingHosts {
+		if h.GetName() != "" {
+			hostsByName[h.GetName()] = h
+		}
+	}
+
+	var existingSets []*HostSet
+	if err := reader.SearchWhere(ctx, &existingSets, "catalog_id = ?", []any{catalogId}, db.WithLimit(unlimited)); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	plan := &importPlan{
+		catalogId:  catalogId,
+		setsByName: make(map[string]*HostSet, len(existingSets)),
+		rowHosts:   make([]*Host, len(hosts)),
+		rowSets:    make([][]*HostSet, len(hosts)),
+	}
+	for _, s := range existingSets {
+		if s.GetName() != "" {
+			plan.setsByName[s.GetName()] = s
+		}
+	}
+
+	const memberWhere = `set_id in
+       ( select public_id
+           from static_host_set
+          where catalog_id = ?
+       )`
+	var existingMembers []*HostSetMember
+	if err := reader.SearchWhere(ctx, &existingMembers, memberWhere, []any{catalogId}, db.WithLimit(unlimited)); err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	plan.isMember = make(map[[2]string]bool, len(existingMembers))
+	for _, m := range existingMembers {
+		plan.isMember[[2]string{m.GetSetId(), m.GetHostId()}] = true
+	}
+
+	results := make([]*ImportResult, 0, len(hosts))
+	names := make(map[string]struct{}, len(hosts))
+	var failed bool
+	for i, ih := range hosts {
+		res := &ImportResult{Row: i}
+		results = append(results, res)
+		var msg string
+		if ih == nil {
+			msg = "empty host"
+		} else {
+			res.Name = strings.TrimSpace(ih.Name)
+			if _, ok := names[res.Name]; ok && res.Name != "" {
+				msg = fmt.Sprintf("duplicate name %q", res.Name)
+			} else {
+				names[res.Name] = struct{}{}
+				msg = plan.addHost(ctx, hostsByName, i, ih, res)
+			}
+		}
+		if msg != "" {
+			failed = true
+			res.Action = ImportFailed
+			res.Error = msg
+		}
+	}
+	if failed {
+		return nil, results, nil
+	}
+	return plan, results, nil
+}
+
+// addHost adds the changes needed to import ih as row to p and sets the
+// action of res. It returns the reason ih cannot be imported, if it cannot.
+func (p *importPlan) addHost(ctx context.Context, hostsByName map[string]*Host, row int, ih *ImportHost, res *ImportResult) string {
+	const op = "static.(importPlan).addHost"
+	address := strings.TrimSpace(ih.Address)
+	switch {
+	case res.Name == "":
+		return "no name"
+	case len(address) < MinHostAddressLength || len(address) > MaxHostAddressLength:
+		return fmt.Sprintf("invalid address %q", address)
+	}
+	if err := validateLabels(ctx, op, ih.Labels); err != nil {
+		return fmt.Sprintf("invalid labels: %s", errors.Convert(err).Msg)
+	}
+
+	var sets []*HostSet
+	for _, n := range ih.SetNames {
+		n = strings.TrimSpace(n)
+		if n == "" {
+			return "empty host set name"
+		}
+		s, ok := p.setsByName[n]
+		switch {
+		case !ok:
+			s = allocHostSet()
+			s.CatalogId = p.catalogId
+			s.Name = n
+			p.setsByName[n] = s
+			p.newSets = append(p.newSets, s)
+		case s.GetFilter() != "":
+			return fmt.Sprintf("host set %q has a filter", n)
+		}
+		sets = append(sets, s)
+	}
+
+	h, ok := hostsByName[res.Name]
+	switch {
+	case !ok:
+		h = allocHost()
+		h.CatalogId = p.catalogId
+		h.Name = res.Name
+		h.Description = ih.Description
+		h.Address = address
+		h.Labels = ih.Labels
+		p.creates = append(p.creates, h)
+		res.Action = ImportCreated
+	default:
+		res.HostId = h.GetPublicId()
+		res.Action = ImportUnchanged
+		u := &importUpdate{host: h.clone(), version: h.GetVersion()}
+		u.host.Labels = h.Labels
+		if address != h.GetAddress() {
+			u.host.Address = address
+			u.dbMask = append(u.dbMask, "Address")
+		}
+		if ih.Description != "" && ih.Description != h.GetDescription() {
+			u.host.Description = ih.Description
+			u.dbMask = append(u.dbMask, "Description")
+		}
+		if len(ih.Labels) > 0 && !reflect.DeepEqual(ih.Labels, h.Labels) {
+			u.host.Labels = ih.Labels
+			u.labels = true
+		}
+		h = u.host
+		if len(u.dbMask) > 0 || u.labels {
+			p.updates = append(p.updates, u)
+			res.Action = ImportUpdated
+		}
+	}
+	p.rowHosts[row] = h
+	p.rowSets[row] = sets
+
+	for _, s := range sets {
+		if res.Action == ImportUnchanged && !p.isMember[[2]string{s.GetPublicId(), h.GetPublicId()}] {
+			res.Action = ImportUpdated
+		}
+	}
+	res.SetIds = setIds(sets)
+	return ""
+}
+
+// applyImport writes the changes in plan to w.
+func (r *Repository) applyImport(ctx context.Context, reader db.Reader, w db.Writer, wrapper wrapping.Wrapper, plan *importPlan) error {
+	const op = "static.(Repository).applyImport"
+	for _, s := range plan.newSets {
+		id, err := newHostSetId()
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		s.PublicId = id
+		if err := w.Create(ctx, s, db.WithOplog(wrapper, s.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
+	for _, h := range plan.creates {
+		id, err := newHostId()
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		h.PublicId = id
+		labels, err := newHostLabels(ctx, h.PublicId, h.Labels)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if err := insertHost(ctx, w, wrapper, h, labels); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
+	for _, u := range plan.updates {
+		var rowsUpdated int
+		var err error
+		if u.labels {
+			rowsUpdated, err = r.updateHostWithLabels(ctx, reader, w, wrapper, u.host, u.version, u.dbMask, nil)
+		} else {
+			rowsUpdated, err = w.Update(ctx, u.host, u.dbMask, nil,
+				db.WithOplog(wrapper, u.host.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&u.version))
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if rowsUpdated != 1 {
+			return errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("host %s changed during the import", u.host.GetPublicId()))
+		}
+	}
+
+	// Collect the new members of each host set in the order the host sets
+	// were first named.
+	var sets []*HostSet
+	hostIds := make(map[string][]string)
+	for i, h := range plan.rowHosts {
+		for _, s := range plan.rowSets[i] {
+			key := [2]string{s.GetPublicId(), h.GetPublicId()}
+			if plan.isMember[key] {
+				continue
+			}
+			plan.isMember[key] = true
+			if _, ok := hostIds[s.GetPublicId()]; !ok {
+				sets = append(sets, s)
+			}
+			hostIds[s.GetPublicId()] = append(hostIds[s.GetPublicId()], h.GetPublicId())
+		}
+	}
+	for _, s := range sets {
+		members, err := r.newMembers(ctx, s.GetPublicId(), hostIds[s.GetPublicId()])
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		msgs, err := createMembers(ctx, w, members)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		version := s.GetVersion()
+		if version == 0 {
+			version = 1
+		}
+		set := newHostSetForMembers(s.GetPublicId(), version)
+		if err := updateVersion(ctx, w, wrapper, set.oplog(oplog.OpType_OP_TYPE_CREATE), msgs, set, version); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	return nil
+}
+
+// setIds returns the sorted ids of sets which have an id.
+func setIds(sets []*HostSet) []string {
+	var ids []string
+	for _, s := range sets {
+		if s.GetPublicId() != "" {
+			ids = append(ids, s.GetPublicId())
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
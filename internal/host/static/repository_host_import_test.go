@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ImportHosts(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	actions := func(results []*ImportResult) []ImportAction {
+		var got []ImportAction
+		for _, r := range results {
+			got = append(got, r.Action)
+		}
+		return got
+	}
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		_, err := repo.ImportHosts(ctx, "", catalog.PublicId, []*ImportHost{{Name: "web", Address: "web.internal"}})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+		_, err = repo.ImportHosts(ctx, prj.PublicId, "", []*ImportHost{{Name: "web", Address: "web.internal"}})
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+		_, err = repo.ImportHosts(ctx, prj.PublicId, catalog.PublicId, nil)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+	})
+
+	t.Run("catalog-not-found", func(t *testing.T) {
+		_, err := repo.ImportHosts(ctx, prj.PublicId, "hcst_1234567890", []*ImportHost{{Name: "web", Address: "web.internal"}})
+		assert.Truef(t, errors.Match(errors.T(errors.RecordNotFound), err), "want err code: %q got err: %q", errors.RecordNotFound, err)
+	})
+
+	existing, err := NewHost(catalog.PublicId, WithName("db"), WithAddress("db.old.internal"))
+	require.NoError(t, err)
+	existing, err = repo.CreateHost(ctx, prj.PublicId, existing)
+	require.NoError(t, err)
+
+	rows := []*ImportHost{
+		{Name: "web", Address: "web.internal", Labels: map[string]string{"env": "prod"}, SetNames: []string{"web-servers"}},
+		{Name: "db", Address: "db.internal", Description: "primary", SetNames: []string{"db-servers"}},
+	}
+
+	t.Run("dry-run", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		results, err := repo.ImportHosts(ctx, prj.PublicId, catalog.PublicId, rows, WithDryRun(true))
+		require.NoError(err)
+		assert.Equal([]ImportAction{ImportCreated, ImportUpdated}, actions(results))
+		assert.Empty(results[0].HostId)
+		assert.Equal(existing.PublicId, results[1].HostId)
+
+		hosts, err := repo.ListHosts(ctx, catalog.PublicId)
+		require.NoError(err)
+		assert.Len(hosts, 1)
+		sets, err := repo.ListSets(ctx, catalog.PublicId)
+		require.NoError(err)
+		assert.Empty(sets)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		failing := append([]*ImportHost{}, rows...)
+		failing = append(failing,
+			&ImportHost{Name: "bad", Address: "x"},
+			&ImportHost{Name: "web", Address: "web2.internal"},
+		)
+		results, err := repo.ImportHosts(ctx, prj.PublicId, catalog.PublicId, failing)
+		require.NoError(err)
+		assert.Equal([]ImportAction{ImportSkipped, ImportSkipped, ImportFailed, ImportFailed}, actions(results))
+		assert.NotEmpty(results[2].Error)
+		assert.NotEmpty(results[3].Error)
+
+		hosts, err := repo.ListHosts(ctx, catalog.PublicId)
+		require.NoError(err)
+		assert.Len(hosts, 1)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		results, err := repo.ImportHosts(ctx, prj.PublicId, catalog.PublicId, rows)
+		require.NoError(err)
+		assert.Equal([]ImportAction{ImportCreated, ImportUpdated}, actions(results))
+		require.Len(results[0].SetIds, 1)
+		require.Len(results[1].SetIds, 1)
+
+		web, err := repo.LookupHost(ctx, results[0].HostId)
+		require.NoError(err)
+		assert.Equal("web.internal", web.Address)
+		assert.Equal(map[string]string{"env": "prod"}, web.Labels)
+		assert.Equal(results[0].SetIds, web.SetIds)
+
+		dbHost, err := repo.LookupHost(ctx, existing.PublicId)
+		require.NoError(err)
+		assert.Equal("db.internal", dbHost.Address)
+		assert.Equal("primary", dbHost.Description)
+		assert.Equal(existing.Version+1, dbHost.Version)
+		assert.Equal(results[1].SetIds, dbHost.SetIds)
+	})
+
+	t.Run("reimport", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		results, err := repo.ImportHosts(ctx, prj.PublicId, catalog.PublicId, rows)
+		require.NoError(err)
+		assert.Equal([]ImportAction{ImportUnchanged, ImportUnchanged}, actions(results))
+
+		sets, err := repo.ListSets(ctx, catalog.PublicId)
+		require.NoError(err)
+		assert.Len(sets, 2)
+	})
+
+	t.Run("filtered-set", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := NewHostSet(catalog.PublicId, WithName("prod"), WithFilter(`"/labels/env" == "prod"`))
+		require.NoError(err)
+		_, err = repo.CreateSet(ctx, prj.PublicId, s)
+		require.NoError(err)
+
+		results, err := repo.ImportHosts(ctx, prj.PublicId, catalog.PublicId, []*ImportHost{
+			{Name: "web", Address: "web.internal", SetNames: []string{"prod"}},
+		})
+		require.NoError(err)
+		assert.Equal([]ImportAction{ImportFailed}, actions(results))
+	})
+}
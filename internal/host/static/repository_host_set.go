@@ -23,7 +23,8 @@ import (
 // generated and assigned by this method. opt is ignored.
 //
 // Both s.Name and s.Description are optional. If s.Name is set, it must be
-// unique within s.CatalogId.
+// unique within s.CatalogId. s.Filter is optional. If s.Filter is set, it
+// must be a valid filter expression.
 func (r *Repository) CreateSet(ctx context.Context, projectId string, s *HostSet, opt ...Option) (*HostSet, error) {
 	const op = "static.(Repository).CreateSet"
 	if s == nil {
@@ -41,6 +42,11 @@ func (r *Repository) CreateSet(ctx context.Context, projectId string, s *HostSet
 	if projectId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
 	}
+	if s.Filter != "" {
+		if _, err := newFilterEvaluator(ctx, op, s.Filter); err != nil {
+			return nil, err
+		}
+	}
 	s = s.clone()
 
 	opts := getOpts(opt...)
@@ -93,9 +99,11 @@ func (r *Repository) CreateSet(ctx context.Context, projectId string, s *HostSet
 // containing the updated values, the hosts assigned to the host set, and a
 // count of the number of records updated. s is not changed.
 //
-// s must contain a valid PublicId. Only s.Name and s.Description can be
-// updated. If s.Name is set to a non-empty string, it must be unique
-// within s.CatalogId.
+// s must contain a valid PublicId. Only s.Name, s.Description and
+// s.Filter can be updated. If s.Name is set to a non-empty string, it must
+// be unique within s.CatalogId. If s.Filter is set to a non-empty string,
+// it must be a valid filter expression and the set must not have any hosts
+// added to it.
 //
 // An attribute of s will be set to NULL in the database if the attribute
 // in s is the zero value and it is included in fieldMaskPaths.
@@ -124,6 +132,12 @@ func (r *Repository) UpdateSet(ctx context.Context, projectId string, s *HostSet
 		switch {
 		case strings.EqualFold("Name", f):
 		case strings.EqualFold("Description", f):
+		case strings.EqualFold("Filter", f):
+			if s.Filter != "" {
+				if _, err := newFilterEvaluator(ctx, op, s.Filter); err != nil {
+					return nil, nil, db.NoRowsAffected, err
+				}
+			}
 		default:
 			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -133,6 +147,7 @@ func (r *Repository) UpdateSet(ctx context.Context, projectId string, s *HostSet
 		map[string]any{
 			"Name":        s.Name,
 			"Description": s.Description,
+			"Filter":      s.Filter,
 		},
 		fieldMaskPaths,
 		nil,
@@ -169,7 +184,7 @@ func (r *Repository) UpdateSet(ctx context.Context, projectId string, s *HostSet
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
-			hosts, err = getHosts(ctx, reader, s.PublicId, limit)
+			hosts, err = setHosts(ctx, reader, returnedHostSet, limit)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -188,7 +203,9 @@ func (r *Repository) UpdateSet(ctx context.Context, projectId string, s *HostSet
 }
 
 // Endpoints returns a slice of host.Endpoint for the provided set id.
-// If there are no hosts in the provided set id the slice is empty.
+// If there are no hosts in the provided set id the slice is empty. If the
+// set has a filter, the endpoints are the hosts of the catalog matching the
+// filter at the time of the call.
 // If the set does not exist an error is returned.
 func (r *Repository) Endpoints(ctx context.Context, setId string) ([]*host.Endpoint, error) {
 	const op = "static.(Repository).Endpoints"
@@ -202,15 +219,20 @@ func (r *Repository) Endpoints(ctx context.Context, setId string) ([]*host.Endpo
 			HostId:  h.GetPublicId(),
 			SetId:   setId,
 			Address: h.GetAddress(),
+			Name:    h.GetName(),
+			Labels:  h.GetLabels(),
+			Port:    h.GetPort(),
 		})
 	}
 	return es, err
 }
 
 // LookupSet will look up a host set in the repository and return the host
-// set and the hosts assigned to the host set. If the host set is not
-// found, it will return nil, nil, nil. The WithLimit option can be used to
-// limit the number of hosts returned. All other options are ignored.
+// set and the hosts of the host set. If the host set has a filter, the
+// hosts are the hosts of the catalog matching the filter. If the host set
+// is not found, it will return nil, nil, nil. The WithLimit option can be
+// used to limit the number of hosts returned. All other options are
+// ignored.
 func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Option) (*HostSet, []*Host, error) {
 	const op = "static.(Repository).LookupSet"
 	if publicId == "" {
@@ -239,7 +261,7 @@ func (r *Repository) lookupSet(ctx context.Context, publicId string, opt ...Opti
 	}
 	var hosts []*Host
 	var err error
-	if hosts, err = getHosts(ctx, r.reader, s.PublicId, limit); err != nil {
+	if hosts, err = setHosts(ctx, r.reader, s, limit); err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	if err != nil {
@@ -19,7 +19,7 @@ import (
 // AddSetMembers adds hostIds to setId in the repository. It returns a
 // slice of all hosts in setId. A host must belong to the same catalog as
 // the set to be added. The version must match the current version of the
-// setId in the repository.
+// setId in the repository. Hosts cannot be added to a set with a filter.
 func (r *Repository) AddSetMembers(ctx context.Context, projectId string, setId string, version uint32, hostIds []string, opt ...Option) ([]*Host, error) {
 	const op = "static.(Repository).AddSetMembers"
 	if projectId == "" {
@@ -51,6 +51,10 @@ func (r *Repository) AddSetMembers(ctx context.Context, projectId string, setId
 		set := newHostSetForMembers(setId, version)
 		metadata := set.oplog(oplog.OpType_OP_TYPE_CREATE)
 
+		if err := checkNoFilter(ctx, reader, setId); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
 		// Create host set members
 		msgs, err := createMembers(ctx, w, members)
 		if err != nil {
@@ -299,6 +303,9 @@ func (r *Repository) SetSetMembers(ctx context.Context, projectId string, setId
 
 			// Add host set members
 			if len(additions) > 0 {
+				if err := checkNoFilter(ctx, reader, setId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
 				createdMsgs, err := createMembers(ctx, w, additions)
 				if err != nil {
 					return errors.Wrap(ctx, err, op)
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+// RestoreSet brings the host set with the public id of s to the state
+// described by s and hostIds. If the host set does not exist it is created
+// with its public id, otherwise its name, description, filter and hosts are
+// replaced. All of the changes are made in a single transaction and written
+// to the oplog as a single entry. It returns the restored host set and its
+// hosts. s is not changed. opt is ignored.
+func (r *Repository) RestoreSet(ctx context.Context, projectId string, s *HostSet, hostIds []string, opt ...Option) (*HostSet, []*Host, error) {
+	const op = "static.(Repository).RestoreSet"
+	switch {
+	case s == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil HostSet")
+	case s.HostSet == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded HostSet")
+	case s.CatalogId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no catalog id")
+	case s.PublicId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case !strings.HasPrefix(s.PublicId, globals.StaticHostSetPrefix+"_"):
+		return nil, nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("public ID %q has wrong prefix, should be %q", s.PublicId, globals.StaticHostSetPrefix))
+	case projectId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if s.Filter != "" {
+		if len(hostIds) > 0 {
+			return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "hosts cannot be added to a host set which has a filter")
+		}
+		if _, err := newFilterEvaluator(ctx, op, s.Filter); err != nil {
+			return nil, nil, err
+		}
+	}
+	s = s.clone()
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			"Name":        s.Name,
+			"Description": s.Description,
+			"Filter":      s.Filter,
+		},
+		[]string{"Name", "Description", "Filter"},
+		nil,
+	)
+	// The version is always updated because the hosts are child objects of
+	// the host set.
+	dbMask = append(dbMask, "Version")
+
+	wrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedHostSet *HostSet
+	var hosts []*Host
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		cur := allocHostSet()
+		cur.PublicId = s.PublicId
+		exists := true
+		if err := reader.LookupByPublicId(ctx, cur); err != nil {
+			if !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op)
+			}
+			exists = false
+		}
+		if exists && cur.CatalogId != s.CatalogId {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("host set is in catalog %s", cur.CatalogId))
+		}
+
+		ticket, err := w.GetTicket(ctx, s)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+		}
+		var metadata oplog.Metadata
+		var msgs []*oplog.Message
+		setMsg := new(oplog.Message)
+		returnedHostSet = s.clone()
+		if !exists {
+			metadata = s.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.Create(ctx, returnedHostSet, db.NewOplogMsg(setMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+		} else {
+			metadata = s.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			version := cur.Version
+			returnedHostSet.Version = version + 1
+			rowsUpdated, err := w.Update(ctx, returnedHostSet, dbMask, nullFields, db.NewOplogMsg(setMsg), db.WithVersion(&version))
+			switch {
+			case err != nil:
+				return errors.Wrap(ctx, err, op)
+			case rowsUpdated != 1:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated host set and %d rows updated", rowsUpdated))
+			}
+		}
+		msgs = append(msgs, setMsg)
+
+		var current []*HostSetMember
+		if err := reader.SearchWhere(ctx, &current, "set_id = ?", []any{s.PublicId}, db.WithLimit(unlimited)); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		want := make(map[string]bool, len(hostIds))
+		for _, id := range hostIds {
+			want[id] = true
+		}
+		var deletions []any
+		for _, m := range current {
+			if want[m.HostId] {
+				delete(want, m.HostId)
+				continue
+			}
+			deletions = append(deletions, m)
+		}
+		var wantIds []string
+		for _, id := range hostIds {
+			if want[id] {
+				delete(want, id)
+				wantIds = append(wantIds, id)
+			}
+		}
+		additions, err := r.newMembers(ctx, s.PublicId, wantIds)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+		if len(deletions) > 0 {
+			deletedMsgs, err := deleteMembers(ctx, w, deletions)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, deletedMsgs...)
+			metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+		}
+		if len(additions) > 0 {
+			createdMsgs, err := createMembers(ctx, w, additions)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, createdMsgs...)
+			metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+		}
+
+		if err := w.WriteOplogEntryWith(ctx, wrapper, ticket, metadata, msgs); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+		}
+
+		hosts, err = setHosts(ctx, reader, returnedHostSet, unlimited)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in catalog: %s: name %s already exists", s.CatalogId, s.Name)))
+		}
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", s.PublicId)))
+	}
+	return returnedHostSet, hosts, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RestoreSet(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	c := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	hosts := TestHosts(t, conn, c.PublicId, 3)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	hostIds := func(hosts []*Host) []string {
+		var ids []string
+		for _, h := range hosts {
+			ids = append(ids, h.PublicId)
+		}
+		return ids
+	}
+
+	t.Run("recreate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id, err := newHostSetId()
+		require.NoError(err)
+		want, err := NewHostSet(c.PublicId, WithName("recreate"))
+		require.NoError(err)
+		want.PublicId = id
+
+		got, gotHosts, err := repo.RestoreSet(ctx, prj.PublicId, want, []string{hosts[0].PublicId})
+		require.NoError(err)
+		assert.Equal(id, got.PublicId)
+		assert.Equal("recreate", got.Name)
+		assert.Equal([]string{hosts[0].PublicId}, hostIds(gotHosts))
+		assert.NoError(db.TestVerifyOplog(t, rw, id, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := TestSets(t, conn, c.PublicId, 1)[0]
+		TestSetMembers(t, conn, cur.PublicId, hosts[:2])
+
+		want := cur.clone()
+		want.Name = "restored"
+		got, gotHosts, err := repo.RestoreSet(ctx, prj.PublicId, want, []string{hosts[1].PublicId, hosts[2].PublicId})
+		require.NoError(err)
+		assert.Equal("restored", got.Name)
+		assert.Equal(cur.Version+1, got.Version)
+		assert.ElementsMatch([]string{hosts[1].PublicId, hosts[2].PublicId}, hostIds(gotHosts))
+		assert.NoError(db.TestVerifyOplog(t, rw, cur.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+	})
+
+	t.Run("failure-leaves-set-unchanged", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := TestSets(t, conn, c.PublicId, 1)[0]
+		TestSetMembers(t, conn, cur.PublicId, hosts[:1])
+
+		want := cur.clone()
+		want.Name = "changed"
+		_, _, err := repo.RestoreSet(ctx, prj.PublicId, want, []string{hosts[0].PublicId, "hst_doesnotexist"})
+		require.Error(err)
+
+		found, foundHosts, err := repo.LookupSet(ctx, cur.PublicId)
+		require.NoError(err)
+		assert.Equal(cur.Name, found.Name)
+		assert.Equal(cur.Version, found.Version)
+		assert.Equal([]string{hosts[0].PublicId}, hostIds(foundHosts))
+	})
+}
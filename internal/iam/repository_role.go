@@ -8,14 +8,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/go-dbw"
 )
 
 // CreateRole will create a role in the repository and return the written
-// role.  No options are currently supported.
-func (r *Repository) CreateRole(ctx context.Context, role *Role, _ ...Option) (*Role, error) {
+// role. Supported options: WithPublicId.
+func (r *Repository) CreateRole(ctx context.Context, role *Role, opt ...Option) (*Role, error) {
 	const op = "iam.(Repository).CreateRole"
 	if role == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role")
@@ -29,12 +30,22 @@ func (r *Repository) CreateRole(ctx context.Context, role *Role, _ ...Option) (*
 	if role.ScopeId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
-	id, err := newRoleId()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
 	c := role.Clone().(*Role)
-	c.PublicId = id
+
+	opts := getOpts(opt...)
+
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, globals.RolePrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", opts.withPublicId, globals.RolePrefix))
+		}
+		c.PublicId = opts.withPublicId
+	} else {
+		id, err := newRoleId()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		c.PublicId = id
+	}
 	resource, err := r.create(ctx, c)
 	if err != nil {
 		if errors.IsUniqueError(err) {
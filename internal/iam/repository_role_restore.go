@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/go-dbw"
+)
+
+// RestoreRole brings the role with the public id of role to the state
+// described by role, grants and principalIds. If the role does not exist it
+// is created with its public id, otherwise its name, description, grant scope
+// id, grants and principals are replaced. All of the changes are made in a
+// single transaction and written to the oplog as a single entry. No options
+// are currently supported.
+func (r *Repository) RestoreRole(ctx context.Context, role *Role, grants []string, principalIds []string, _ ...Option) (*Role, []*PrincipalRole, []*RoleGrant, error) {
+	const op = "iam.(Repository).RestoreRole"
+	switch {
+	case role == nil:
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing role")
+	case role.Role == nil:
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing role store")
+	case role.PublicId == "":
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	case !strings.HasPrefix(role.PublicId, globals.RolePrefix+"_"):
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("public ID %q has wrong prefix, should be %q", role.PublicId, globals.RolePrefix))
+	case role.ScopeId == "":
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	roleId := role.PublicId
+
+	canonicalGrants := make(map[string]string, len(grants))
+	for _, grant := range grants {
+		// Use a fake scope, just want to get out a canonical string
+		perm, err := perms.Parse("o_abcd1234", grant, perms.WithSkipFinalValidation(true))
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("error parsing grant string"))
+		}
+		canonicalGrants[perm.CanonicalString()] = grant
+	}
+	userIds, groupIds, managedGroupIds, err := splitPrincipals(ctx, principalIds)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			"name":         role.Name,
+			"description":  role.Description,
+			"GrantScopeId": role.GrantScopeId,
+		},
+		[]string{"name", "description", "GrantScopeId"},
+		nil,
+	)
+	// The version is always updated because grants and principals are child
+	// objects of the role.
+	dbMask = append(dbMask, "Version")
+
+	metadata, err := r.stdMetadata(ctx, role)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("error getting metadata"))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, role.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedRole *Role
+	var currentPrincipals []*PrincipalRole
+	var currentGrants []*RoleGrant
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			// we need a new repo, that's using the same reader/writer as this TxHandler
+			txRepo := &Repository{
+				reader: reader,
+				writer: w,
+				kms:    r.kms,
+				// intentionally not setting the defaultLimit, so we'll get all
+				// the principal roles and grants without a limit
+			}
+
+			cur := allocRole()
+			cur.PublicId = roleId
+			exists := true
+			if err := reader.LookupByPublicId(ctx, &cur); err != nil {
+				if !errors.IsNotFoundError(err) {
+					return errors.Wrap(ctx, err, op)
+				}
+				exists = false
+			}
+			if exists && cur.ScopeId != role.ScopeId {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("role is in scope %s", cur.ScopeId))
+			}
+
+			roleTicket, err := w.GetTicket(ctx, role)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket for role"))
+			}
+			msgs := make([]*oplog.Message, 0, 5)
+			var roleOplogMsg oplog.Message
+			returnedRole = role.Clone().(*Role)
+			if !exists {
+				metadata["op-type"] = []string{oplog.OpType_OP_TYPE_CREATE.String()}
+				if err := w.Create(ctx, returnedRole, db.NewOplogMsg(&roleOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create role"))
+				}
+			} else {
+				metadata["op-type"] = []string{oplog.OpType_OP_TYPE_UPDATE.String()}
+				version := cur.Version
+				returnedRole.Version = version + 1
+				rowsUpdated, err := w.Update(ctx, returnedRole, dbMask, nullFields, db.NewOplogMsg(&roleOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update role"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated role and %d rows updated", rowsUpdated))
+				}
+			}
+			msgs = append(msgs, &roleOplogMsg)
+
+			var existingGrants []*RoleGrant
+			if err := reader.SearchWhere(ctx, &existingGrants, "role_id = ?", []any{roleId}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to search for grants"))
+			}
+			var addGrants, deleteGrants []any
+			for _, rg := range existingGrants {
+				if _, ok := canonicalGrants[rg.CanonicalGrant]; ok {
+					delete(canonicalGrants, rg.CanonicalGrant)
+					continue
+				}
+				deleteGrants = append(deleteGrants, rg)
+			}
+			for _, grant := range canonicalGrants {
+				rg, err := NewRoleGrant(roleId, grant)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role grant"))
+				}
+				addGrants = append(addGrants, rg)
+			}
+
+			toSet, err := txRepo.PrincipalsToSet(ctx, returnedRole, userIds, groupIds, managedGroupIds)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			deletions := [][]any{deleteGrants, toSet.DeleteUserRoles, toSet.DeleteGroupRoles, toSet.DeleteManagedGroupRoles}
+			additions := [][]any{addGrants, toSet.AddUserRoles, toSet.AddGroupRoles, toSet.AddManagedGroupRoles}
+			var deleted, created bool
+			for _, items := range deletions {
+				if len(items) == 0 {
+					continue
+				}
+				itemMsgs := make([]*oplog.Message, 0, len(items))
+				rowsDeleted, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&itemMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete role grants or principals"))
+				}
+				if rowsDeleted != len(items) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("role grants or principals deleted %d did not match request for %d", rowsDeleted, len(items)))
+				}
+				msgs = append(msgs, itemMsgs...)
+				deleted = true
+			}
+			if deleted {
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+			}
+			for _, items := range additions {
+				if len(items) == 0 {
+					continue
+				}
+				itemMsgs := make([]*oplog.Message, 0, len(items))
+				if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&itemMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add role grants or principals"))
+				}
+				msgs = append(msgs, itemMsgs...)
+				created = true
+			}
+			if created {
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+			}
+
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, roleTicket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+
+			if currentPrincipals, err = txRepo.ListPrincipalRoles(ctx, roleId); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current principal roles after restore"))
+			}
+			if currentGrants, err = txRepo.ListRoleGrants(ctx, roleId); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve current role grants after restore"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("role %s already exists in scope %s", role.Name, role.ScopeId))
+		}
+		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", roleId)))
+	}
+	return returnedRole, currentPrincipals, currentGrants, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RestoreRole(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+	org, _ := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	otherUser := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, org.PublicId)
+
+	principals := func(prs []*PrincipalRole) []string {
+		var ids []string
+		for _, pr := range prs {
+			ids = append(ids, pr.PrincipalId)
+		}
+		return ids
+	}
+	rawGrants := func(rgs []*RoleGrant) []string {
+		var grants []string
+		for _, rg := range rgs {
+			grants = append(grants, rg.RawGrant)
+		}
+		return grants
+	}
+
+	t.Run("recreate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id, err := newRoleId()
+		require.NoError(err)
+		want, err := NewRole(org.PublicId, WithName("recreate"))
+		require.NoError(err)
+		want.PublicId = id
+
+		got, gotPrincipals, gotGrants, err := repo.RestoreRole(ctx, want, []string{"id=*;type=*;actions=read"}, []string{user.PublicId, group.PublicId})
+		require.NoError(err)
+		assert.Equal(id, got.PublicId)
+		assert.Equal("recreate", got.Name)
+		assert.ElementsMatch([]string{user.PublicId, group.PublicId}, principals(gotPrincipals))
+		assert.Equal([]string{"id=*;type=*;actions=read"}, rawGrants(gotGrants))
+		assert.NoError(db.TestVerifyOplog(t, rw, id, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := TestRole(t, conn, org.PublicId)
+		TestRoleGrant(t, conn, cur.PublicId, "id=*;type=*;actions=read")
+		TestRoleGrant(t, conn, cur.PublicId, "id=*;type=*;actions=update")
+		TestUserRole(t, conn, cur.PublicId, user.PublicId)
+
+		want := cur.Clone().(*Role)
+		want.Name = "restored"
+		got, gotPrincipals, gotGrants, err := repo.RestoreRole(ctx, want, []string{"id=*;type=*;actions=read"}, []string{otherUser.PublicId})
+		require.NoError(err)
+		assert.Equal("restored", got.Name)
+		assert.Equal(cur.Version+1, got.Version)
+		assert.Equal([]string{otherUser.PublicId}, principals(gotPrincipals))
+		assert.Equal([]string{"id=*;type=*;actions=read"}, rawGrants(gotGrants))
+		assert.NoError(db.TestVerifyOplog(t, rw, cur.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+	})
+
+	t.Run("failure-leaves-role-unchanged", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := TestRole(t, conn, org.PublicId)
+		TestUserRole(t, conn, cur.PublicId, user.PublicId)
+
+		want := cur.Clone().(*Role)
+		want.Name = "changed"
+		_, _, _, err := repo.RestoreRole(ctx, want, nil, []string{"u_doesnotexist"})
+		require.Error(err)
+
+		found, foundPrincipals, _, err := repo.LookupRole(ctx, cur.PublicId)
+		require.NoError(err)
+		assert.Equal(cur.Name, found.Name)
+		assert.Equal(cur.Version, found.Version)
+		assert.Equal([]string{user.PublicId}, principals(foundPrincipals))
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/db"
 	dbassert "github.com/hashicorp/boundary/internal/db/assert"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -141,6 +142,32 @@ func TestRepository_CreateRole(t *testing.T) {
 			wantDup: true,
 			wantErr: false,
 		},
+		{
+			name: "with-public-id",
+			args: args{
+				role: func() *Role {
+					r, err := NewRole(proj.PublicId, WithName("with-public-id"+id))
+					assert.NoError(t, err)
+					return r
+				}(),
+				opt: []Option{WithPublicId(globals.RolePrefix + "_" + id)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "with-public-id-bad-prefix",
+			args: args{
+				role: func() *Role {
+					r, err := NewRole(proj.PublicId, WithName("with-public-id-bad-prefix"+id))
+					assert.NoError(t, err)
+					return r
+				}(),
+				opt: []Option{WithPublicId("u_" + id)},
+			},
+			wantErr:     true,
+			wantErrMsg:  "iam.(Repository).CreateRole: passed-in public ID",
+			wantIsError: errors.InvalidParameter,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -324,6 +324,17 @@ func (k *Kms) ListDataKeyVersionDestructionJobs(ctx context.Context, scopeId str
 	return jobs, nil
 }
 
+// ListAllDataKeyVersionDestructionJobs lists any in-progress data key
+// destruction jobs across all scopes.
+func (k *Kms) ListAllDataKeyVersionDestructionJobs(ctx context.Context) ([]*DataKeyVersionDestructionJobProgress, error) {
+	const op = "kms.(Kms).ListAllDataKeyVersionDestructionJobs"
+	var jobs []*DataKeyVersionDestructionJobProgress
+	if err := k.reader.SearchWhere(ctx, &jobs, "", nil); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return jobs, nil
+}
+
 // MonitorTableRewrappingRuns checks for pending rewrapping job runs for the
 // specified table name, and attempts to execute each job run and start rewrapping
 // data in the specified table. This may be a long running operation.
@@ -419,6 +419,55 @@ func Test_ListDataKeyVersionDestructionJobs(t *testing.T) {
 	})
 }
 
+func Test_ListAllDataKeyVersionDestructionJobs(t *testing.T) {
+	t.Parallel()
+	testCtx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	extWrapper := db.TestWrapper(t)
+	kmsCache := TestKms(t, conn, extWrapper)
+	err := kmsCache.CreateKeys(testCtx, "global")
+	require.NoError(t, err)
+	err = kmsCache.RotateKeys(testCtx, "global")
+	require.NoError(t, err)
+	keys, err := kmsCache.ListKeys(testCtx, "global")
+	require.NoError(t, err)
+
+	t.Run("lists-no-jobs-when-there-are-none", func(t *testing.T) {
+		jobs, err := kmsCache.ListAllDataKeyVersionDestructionJobs(testCtx)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+	t.Run("lists-jobs-when-there-are-some", func(t *testing.T) {
+		var kvToDestroy wrappingKms.KeyVersion
+		for _, key := range keys {
+			if key.Purpose == wrappingKms.KeyPurpose(KeyPurposeDatabase.String()) {
+				kvToDestroy = key.Versions[0]
+			}
+		}
+		sqldb, err := conn.SqlDB(testCtx)
+		require.NoError(t, err)
+		_, err = sqldb.ExecContext(testCtx, "insert into kms_data_key_version_destruction_job(key_id) values ($1)", kvToDestroy.Id)
+		require.NoError(t, err)
+		_, err = sqldb.ExecContext(testCtx, "insert into kms_data_key_version_destruction_job_run(key_id, table_name, total_count) values ($1, 'auth_token', 100)", kvToDestroy.Id)
+		require.NoError(t, err)
+		_, err = sqldb.ExecContext(testCtx, "insert into kms_data_key_version_destruction_job_run(key_id, table_name, total_count, completed_count) values ($1, 'auth_oidc_method', 200, 200)", kvToDestroy.Id)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, err = sqldb.ExecContext(testCtx, "truncate kms_data_key_version_destruction_job, kms_data_key_version_destruction_job_run CASCADE")
+			require.NoError(t, err)
+		})
+		jobs, err := kmsCache.ListAllDataKeyVersionDestructionJobs(testCtx)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		job := jobs[0]
+		assert.Equal(t, 200, int(job.CompletedCount))
+		assert.Equal(t, 300, int(job.TotalCount))
+		assert.Equal(t, "pending", job.Status)
+		assert.Equal(t, kvToDestroy.Id, job.KeyId)
+		assert.Equal(t, "global", job.ScopeId)
+	})
+}
+
 func TestMonitorTableRewrappingRuns(t *testing.T) {
 	t.Parallel()
 	testCtx := context.Background()
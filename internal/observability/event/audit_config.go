@@ -16,13 +16,17 @@ type AuditConfig struct {
 	FilterOverrides    AuditFilterOperations `hcl:"-"`
 	FilterOverridesHCL map[string]string     `hcl:"audit_filter_overrides"`
 
+	// RedactionProfiles are the names of the eventer's redaction profiles to
+	// apply to the sink's audit events, in order.
+	RedactionProfiles []string `hcl:"redaction_profiles"`
+
 	// wrapper to use for audit event crypto operations.
 	wrapper wrapping.Wrapper
 }
 
 // NewAuditConfig creates a new config starting with the DefaultAuditConfig()
-// and applying options. Supported options are: WithWrapper and
-// WithFilterOperations.
+// and applying options. Supported options are: WithWrapper,
+// WithFilterOperations and WithRedactionProfiles.
 func NewAuditConfig(opt ...Option) (*AuditConfig, error) {
 	const op = "event.NewAuditConfig"
 	opts := getOpts(opt...)
@@ -33,6 +37,9 @@ func NewAuditConfig(opt ...Option) (*AuditConfig, error) {
 	if opts.withFilterOperations != nil {
 		c.FilterOverrides = opts.withFilterOperations
 	}
+	if opts.withRedactionProfiles != nil {
+		c.RedactionProfiles = opts.withRedactionProfiles
+	}
 	if err := c.Validate(); err != nil {
 		return nil, fmt.Errorf("%s: invalid configuration: %w", op, err)
 	}
@@ -48,6 +55,11 @@ func (ac *AuditConfig) Validate() error {
 	if err := ac.FilterOverrides.Validate(); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	for _, name := range ac.RedactionProfiles {
+		if name == "" {
+			return fmt.Errorf("%s: empty redaction profile name: %w", op, ErrInvalidParameter)
+		}
+	}
 
 	// Note: we don't validate the wrapper here because it may not be set yet.
 
@@ -41,6 +41,7 @@ type Grant struct {
 type Auth struct {
 	DisabledAuthEntirely *bool       `json:"disabled_auth_entirely,omitempty" class:"public"`
 	AuthTokenId          string      `json:"auth_token_id" class:"public"`
+	ScopeId              string      `json:"scope_id,omitempty" class:"public"`
 	UserInfo             *UserInfo   `json:"user_info,omitempty"` // boundary field
 	GrantsInfo           *GrantsInfo `json:"grants_info,omitempty"`
 	UserEmail            string      `json:"email,omitempty" class:"sensitive"`
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/hashicorp/eventlogger/filters/gated"
+	"go.uber.org/atomic"
+)
+
+const (
+	// DefaultSubscriptionBufferSize is the number of events buffered for a
+	// subscription before further events are dropped for it.
+	DefaultSubscriptionBufferSize = 256
+
+	streamPipeline = "stream-pipeline" // streamPipeline is a pipeline which fans events out to subscriptions
+)
+
+// eventStream fans the events sent to the stream pipelines of an Eventer out
+// to its subscriptions.
+type eventStream struct {
+	l    sync.RWMutex
+	subs map[string]*Subscription
+}
+
+func newEventStream() *eventStream {
+	return &eventStream{
+		subs: map[string]*Subscription{},
+	}
+}
+
+func (s *eventStream) hasSubscriptions() bool {
+	s.l.RLock()
+	defer s.l.RUnlock()
+	return len(s.subs) > 0
+}
+
+func (s *eventStream) add(sub *Subscription) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.subs[sub.id] = sub
+}
+
+func (s *eventStream) remove(sub *Subscription) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if _, ok := s.subs[sub.id]; !ok {
+		return
+	}
+	delete(s.subs, sub.id)
+	// the channel is closed while holding the lock, so the sink can't be
+	// sending to it.
+	close(sub.events)
+}
+
+// publish delivers the formatted event to every matching subscription without
+// blocking. Events are dropped for subscriptions whose buffer is full.
+func (s *eventStream) publish(data []byte) error {
+	const op = "event.(eventStream).publish"
+	var ce streamedEvent
+	if err := json.Unmarshal(data, &ce.raw); err != nil {
+		return fmt.Errorf("%s: unable to decode event: %w", op, err)
+	}
+	ce.typ, _ = ce.raw["type"].(string)
+	if ce.typ == string(AuditType) {
+		if d, ok := ce.raw["data"].(map[string]any); ok {
+			if a, ok := d["auth"].(map[string]any); ok {
+				ce.scopeId, _ = a["scope_id"].(string)
+			}
+		}
+	}
+
+	s.l.RLock()
+	defer s.l.RUnlock()
+	for _, sub := range s.subs {
+		if !sub.match(&ce) {
+			continue
+		}
+		select {
+		case sub.events <- data:
+		default:
+			sub.dropped.Inc()
+		}
+	}
+	return nil
+}
+
+// streamedEvent is a formatted event decoded for matching against
+// subscriptions.
+type streamedEvent struct {
+	raw     map[string]any
+	typ     string
+	scopeId string
+}
+
+// Subscription is a subscription to the events sent by an Eventer, created via
+// Eventer.Subscribe. Events are delivered formatted as cloudevents JSON, after
+// the data classification filtering applied to audit events.
+type Subscription struct {
+	id      string
+	stream  *eventStream
+	events  chan []byte
+	allow   []*filter
+	deny    []*filter
+	scopes  map[string]bool
+	dropped atomic.Uint64
+}
+
+// Events returns the channel the subscription's events are delivered on. The
+// channel is closed when the subscription is closed.
+func (s *Subscription) Events() <-chan []byte {
+	return s.events
+}
+
+// Dropped returns the number of events which were dropped for the
+// subscription because its buffer was full, and resets the count.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// Close ends the subscription. It's safe to call Close more than once.
+func (s *Subscription) Close() {
+	s.stream.remove(s)
+}
+
+// match reports whether the event should be delivered to the subscription.
+// Subscriptions restricted to scopes only receive audit events for requests
+// in those scopes.
+func (s *Subscription) match(ce *streamedEvent) bool {
+	if s.scopes != nil && (ce.typ != string(AuditType) || !s.scopes[ce.scopeId]) {
+		return false
+	}
+	ok, _ := newPredicate(s.allow, s.deny)(context.Background(), ce.raw)
+	return ok
+}
+
+// StreamingEnabled reports whether the eventer was created with
+// WithStreaming, so its events can be subscribed to.
+func (e *Eventer) StreamingEnabled() bool {
+	return e.stream != nil
+}
+
+// Subscribe creates a subscription to the events sent by the eventer. The
+// eventer must have been created with WithStreaming. Supports the options:
+// WithAllow, WithDeny, WithScopeIds and WithBufferSize.
+func (e *Eventer) Subscribe(opt ...Option) (*Subscription, error) {
+	const op = "event.(Eventer).Subscribe"
+	if e.stream == nil {
+		return nil, fmt.Errorf("%s: event streaming is not enabled: %w", op, ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	if opts.withBufferSize < 0 {
+		return nil, fmt.Errorf("%s: invalid buffer size %d: %w", op, opts.withBufferSize, ErrInvalidParameter)
+	}
+	bufferSize := opts.withBufferSize
+	if bufferSize == 0 {
+		bufferSize = DefaultSubscriptionBufferSize
+	}
+	id, err := NewId("sub")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	sub := &Subscription{
+		id:     id,
+		stream: e.stream,
+		events: make(chan []byte, bufferSize),
+	}
+	for _, a := range opts.withAllow {
+		f, err := newFilter(a)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid allow filter '%s': %w", op, a, err)
+		}
+		sub.allow = append(sub.allow, f)
+	}
+	for _, d := range opts.withDeny {
+		f, err := newFilter(d)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid deny filter '%s': %w", op, d, err)
+		}
+		sub.deny = append(sub.deny, f)
+	}
+	if opts.withScopeIds != nil {
+		sub.scopes = make(map[string]bool, len(opts.withScopeIds))
+		for _, s := range opts.withScopeIds {
+			sub.scopes[s] = true
+		}
+	}
+	e.stream.add(sub)
+	return sub, nil
+}
+
+// registerStreamPipelines registers a pipeline for every event type which
+// fans the events out to the eventer's subscriptions, and returns the id of
+// their shared sink node. The rules of the redaction profiles are applied to
+// audit events, then they're filtered using the default audit filter
+// operations, which redact sensitive and secret fields, before they're
+// published.
+func (e *Eventer) registerStreamPipelines(serverName string, profiles []*RedactionProfile, opt ...Option) (eventlogger.NodeID, error) {
+	const op = "event.(Eventer).registerStreamPipelines"
+	opts := getOpts(opt...)
+	e.stream = newEventStream()
+
+	register := func(prefix string, n eventlogger.Node) (eventlogger.NodeID, error) {
+		id, err := NewId(prefix)
+		if err != nil {
+			return "", err
+		}
+		if err := e.broker.RegisterNode(eventlogger.NodeID(id), n); err != nil {
+			return "", fmt.Errorf("unable to register %s node: %w", prefix, err)
+		}
+		return eventlogger.NodeID(id), nil
+	}
+
+	filterId, err := register("stream-filter", &streamFilter{stream: e.stream})
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	sinkId, err := register("stream-sink", &streamSink{stream: e.stream})
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	fmtId, fmtNode, err := newFmtFilterNode(serverName, SinkConfig{Format: JSONSinkFormat}, opt...)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if err := e.broker.RegisterNode(fmtId, fmtNode); err != nil {
+		return "", fmt.Errorf("%s: unable to register fmt/filter node: %w", op, err)
+	}
+	e.auditWrapperNodes = append(e.auditWrapperNodes, fmtNode)
+
+	var redactFilterId eventlogger.NodeID
+	if len(profiles) > 0 {
+		redactFilter, err := newRedactionFilter(profiles, opts.withAuditWrapper)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+		e.auditWrapperNodes = append(e.auditWrapperNodes, redactFilter)
+		redactFilterId, err = register("stream-redact-audit", redactFilter)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	encryptFilter, err := NewAuditEncryptFilter(opt...)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	overrides := encrypt.DefaultFilterOperations()
+	for k, v := range DefaultAuditFilterOperations() {
+		overrides[encrypt.DataClassification(k)] = encrypt.FilterOperation(v)
+	}
+	encryptFilter.FilterOperationOverrides = overrides
+	e.auditWrapperNodes = append(e.auditWrapperNodes, encryptFilter)
+	encryptFilterId, err := register("stream-encrypt-audit", encryptFilter)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// audit and observation events are aggregated by a gate of their own,
+	// since a gate is stateful and can't be shared with the sink pipelines.
+	newGate := func(prefix string) (eventlogger.NodeID, error) {
+		gatedFilterNode := gated.Filter{
+			Broker: e.broker,
+		}
+		e.flushableNodes = append(e.flushableNodes, &gatedFilterNode)
+		return register(prefix, &gatedFilterNode)
+	}
+	auditGateId, err := newGate("stream-gated-audit")
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	observationGateId, err := newGate("stream-gated-observation")
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// order of nodes is important! drop when there are no subscriptions, then
+	// gate (aggregate), then redact, then encrypt, then format, then publish
+	auditNodeIds := []eventlogger.NodeID{filterId, auditGateId}
+	if redactFilterId != "" {
+		auditNodeIds = append(auditNodeIds, redactFilterId)
+	}
+	auditNodeIds = append(auditNodeIds, encryptFilterId, fmtId, sinkId)
+	pipelines := map[Type][]eventlogger.NodeID{
+		AuditType:       auditNodeIds,
+		ObservationType: {filterId, observationGateId, fmtId, sinkId},
+		ErrorType:       {filterId, fmtId, sinkId},
+		SystemType:      {filterId, fmtId, sinkId},
+	}
+	for typ, nodeIds := range pipelines {
+		pipeId, err := NewId(streamPipeline)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
+			EventType:  eventlogger.EventType(typ),
+			PipelineID: eventlogger.PipelineID(pipeId),
+			NodeIDs:    nodeIds,
+		})
+		if err != nil {
+			return "", fmt.Errorf("%s: failed to register %s stream pipeline: %w", op, typ, err)
+		}
+	}
+	return sinkId, nil
+}
+
+// streamFilter is the first node of the stream pipelines. It drops events
+// when there are no subscriptions, so they're not needlessly formatted.
+type streamFilter struct {
+	stream *eventStream
+}
+
+var _ eventlogger.Node = &streamFilter{}
+
+// Process will drop the event if there are no subscriptions.
+func (f *streamFilter) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	if !f.stream.hasSubscriptions() {
+		return nil, nil
+	}
+	return e, nil
+}
+
+// Reopen is a no op for streamFilters.
+func (f *streamFilter) Reopen() error { return nil }
+
+// Type describes the type of the node as a Filter.
+func (f *streamFilter) Type() eventlogger.NodeType { return eventlogger.NodeTypeFilter }
+
+// streamSink is the last node of the stream pipelines. It publishes the
+// formatted events to the subscriptions.
+type streamSink struct {
+	stream *eventStream
+}
+
+var _ eventlogger.Node = &streamSink{}
+
+// Process will publish the formatted event to the subscriptions. It never
+// blocks on slow subscriptions.
+func (s *streamSink) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(streamSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	data, ok := e.Format(string(JSONSinkFormat))
+	if !ok {
+		return nil, fmt.Errorf("%s: event is not formatted as %s: %w", op, JSONSinkFormat, ErrInvalidParameter)
+	}
+	if err := s.stream.publish(data); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return nil, nil
+}
+
+// Reopen is a no op for streamSinks.
+func (s *streamSink) Reopen() error { return nil }
+
+// Type describes the type of the node as a Sink.
+func (s *streamSink) Type() eventlogger.NodeType { return eventlogger.NodeTypeSink }
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventer_Subscribe(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testSetup := TestEventerConfig(t, "TestEventer_Subscribe")
+	testLock := &sync.Mutex{}
+	testLogger := testLogger(t, testLock)
+
+	t.Run("streaming-not-enabled", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		e, err := NewEventer(testLogger, testLock, "TestEventer_Subscribe", testSetup.EventerConfig)
+		require.NoError(err)
+		assert.False(e.StreamingEnabled())
+		_, err = e.Subscribe()
+		require.Error(err)
+		assert.ErrorIs(err, ErrInvalidParameter)
+	})
+
+	e, err := NewEventer(testLogger, testLock, "TestEventer_Subscribe", testSetup.EventerConfig, WithStreaming(true), WithAuditWrapper(encrypt.TestWrapper(t)))
+	require.NoError(t, err)
+	assert.True(t, e.StreamingEnabled())
+
+	t.Run("invalid-options", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := e.Subscribe(WithBufferSize(-1))
+		require.Error(err)
+		assert.ErrorIs(err, ErrInvalidParameter)
+
+		_, err = e.Subscribe(WithAllow("bad filter ("))
+		require.Error(err)
+		assert.Contains(err.Error(), "invalid allow filter")
+	})
+
+	sysEvent := func(t *testing.T, op Op) {
+		t.Helper()
+		require.NoError(t, e.writeSysEvent(ctx, &sysEvent{Version: sysVersion, Op: op, Data: map[string]any{"msg": "test"}}))
+	}
+	auditEvent := func(t *testing.T, scopeId string) {
+		t.Helper()
+		a := testAuth(t)
+		a.ScopeId = scopeId
+		ae, err := newAudit("TestEventer_Subscribe", WithRequestInfo(TestRequestInfo(t)), WithAuth(a), WithFlush())
+		require.NoError(t, err)
+		require.NoError(t, e.writeAudit(ctx, ae))
+	}
+	receive := func(t *testing.T, sub *Subscription) map[string]any {
+		t.Helper()
+		select {
+		case data, ok := <-sub.Events():
+			require.True(t, ok)
+			var ce map[string]any
+			require.NoError(t, json.Unmarshal(data, &ce))
+			return ce
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for event")
+			return nil
+		}
+	}
+
+	t.Run("fan-out", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		all, err := e.Subscribe()
+		require.NoError(err)
+		defer all.Close()
+		scoped, err := e.Subscribe(WithScopeIds("o_1234567890"))
+		require.NoError(err)
+		defer scoped.Close()
+		filtered, err := e.Subscribe(WithAllow(`"/data/op" == "wanted"`))
+		require.NoError(err)
+		defer filtered.Close()
+
+		sysEvent(t, "unwanted")
+		got := receive(t, all)
+		assert.Equal(string(SystemType), got["type"])
+		assert.Empty(scoped.Events(), "scoped subscriptions only receive audit events")
+		assert.Empty(filtered.Events())
+
+		sysEvent(t, "wanted")
+		assert.Equal("wanted", receive(t, all)["data"].(map[string]any)["op"])
+		assert.Equal("wanted", receive(t, filtered)["data"].(map[string]any)["op"])
+
+		auditEvent(t, "o_1234567890")
+		got = receive(t, scoped)
+		assert.Equal(string(AuditType), got["type"])
+		auth := got["data"].(map[string]any)["auth"].(map[string]any)
+		assert.Equal("o_1234567890", auth["scope_id"])
+		assert.Equal(encrypt.RedactedData, auth["email"], "audit events should be filtered by data classification")
+		assert.Equal(string(AuditType), receive(t, all)["type"])
+
+		auditEvent(t, "o_0987654321")
+		assert.Equal(string(AuditType), receive(t, all)["type"])
+		assert.Empty(scoped.Events())
+	})
+
+	t.Run("backpressure", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sub, err := e.Subscribe(WithBufferSize(1))
+		require.NoError(err)
+		defer sub.Close()
+
+		for i := 0; i < 3; i++ {
+			sysEvent(t, "backpressure")
+		}
+		assert.Len(sub.Events(), 1)
+		assert.Equal(uint64(2), sub.Dropped())
+		assert.Equal(uint64(0), sub.Dropped(), "the dropped count should be reset")
+	})
+
+	t.Run("close", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		sub, err := e.Subscribe()
+		require.NoError(err)
+		assert.True(e.stream.hasSubscriptions())
+		sub.Close()
+		sub.Close()
+		_, ok := <-sub.Events()
+		assert.False(ok, "the events channel should be closed")
+		assert.False(e.stream.hasSubscriptions())
+
+		// events are dropped before formatting when there are no subscriptions
+		sysEvent(t, "unsubscribed")
+	})
+}
+
+func TestEventer_SubscribeRedactionProfiles(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testLock := &sync.Mutex{}
+	testLogger := testLogger(t, testLock)
+	profiles := []*RedactionProfile{
+		{
+			Name:  "methods",
+			Rules: []*RedactionRule{{Path: "request_info.method", Operation: RedactOperation}},
+		},
+		{
+			Name:  "paths",
+			Rules: []*RedactionRule{{Path: "request_info.path", Operation: DropOperation}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		streamProfiles []string
+		wantMethod     string
+		wantPath       bool
+	}{
+		{
+			name:       "every-profile",
+			wantMethod: encrypt.RedactedData,
+		},
+		{
+			name:           "stream-profiles",
+			streamProfiles: []string{"paths"},
+			wantMethod:     TestRequestInfo(t).Method,
+		},
+		{
+			name:           "stream-profile-methods",
+			streamProfiles: []string{"methods"},
+			wantMethod:     encrypt.RedactedData,
+			wantPath:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			testSetup := TestEventerConfig(t, "TestEventer_SubscribeRedactionProfiles")
+			c := testSetup.EventerConfig
+			c.RedactionProfiles = profiles
+			c.StreamRedactionProfiles = tt.streamProfiles
+			require.NoError(c.Validate())
+			e, err := NewEventer(testLogger, testLock, "TestEventer_SubscribeRedactionProfiles", c, WithStreaming(true), WithAuditWrapper(encrypt.TestWrapper(t)))
+			require.NoError(err)
+
+			sub, err := e.Subscribe()
+			require.NoError(err)
+			defer sub.Close()
+
+			ae, err := newAudit("TestEventer_SubscribeRedactionProfiles", WithRequestInfo(TestRequestInfo(t)), WithAuth(testAuth(t)), WithFlush())
+			require.NoError(err)
+			require.NoError(e.writeAudit(ctx, ae))
+
+			var got map[string]any
+			select {
+			case data := <-sub.Events():
+				require.NoError(json.Unmarshal(data, &got))
+			case <-time.After(5 * time.Second):
+				require.FailNow("timed out waiting for event")
+			}
+			requestInfo := got["data"].(map[string]any)["request_info"].(map[string]any)
+			assert.Equal(tt.wantMethod, requestInfo["method"])
+			_, ok := requestInfo["path"]
+			assert.Equal(tt.wantPath, ok)
+		})
+	}
+}
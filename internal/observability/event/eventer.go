@@ -81,6 +81,7 @@ type Eventer struct {
 	observationPipelines []pipeline
 	errPipelines         []pipeline
 	auditWrapperNodes    []any
+	stream               *eventStream
 
 	// Gating is used to delay output of events until after we have a chance to
 	// render startup info, similar to what was done for hclog before eventing
@@ -96,6 +97,7 @@ type pipeline struct {
 	sinkId          eventlogger.NodeID
 	gateId          eventlogger.NodeID
 	encryptFilterId eventlogger.NodeID
+	redactFilterId  eventlogger.NodeID
 	sinkConfig      *SinkConfig
 }
 
@@ -209,7 +211,7 @@ func NewAuditEncryptFilter(opt ...Option) (*encrypt.Filter, error) {
 
 // NewEventer creates a new Eventer using the config.  Supports options:
 // WithNow, WithSerializationLock, WithBroker, WithAuditWrapper,
-// WithNoDefaultSink
+// WithNoDefaultSink, WithStreaming
 func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName string, c EventerConfig, opt ...Option) (*Eventer, error) {
 	const op = "event.NewEventer"
 	if log == nil {
@@ -352,13 +354,31 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		}
 		if addToAudit {
 			var fop AuditFilterOperations
+			var profileNames []string
 			if s.AuditConfig != nil {
 				fop = s.AuditConfig.FilterOverrides
+				profileNames = s.AuditConfig.RedactionProfiles
 			}
-			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop))
+			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop), WithRedactionProfiles(profileNames))
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
+			var redactFilterId eventlogger.NodeID
+			if len(s.AuditConfig.RedactionProfiles) > 0 {
+				redactFilter, err := newRedactionFilter(c.redactionProfiles(s.AuditConfig.RedactionProfiles), opts.withAuditWrapper)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				e.auditWrapperNodes = append(e.auditWrapperNodes, redactFilter)
+				id, err := NewId("redact-audit")
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				redactFilterId = eventlogger.NodeID(id)
+				if err := b.RegisterNode(redactFilterId, redactFilter); err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+			}
 			encryptFilter, err := NewAuditEncryptFilter(opt...)
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
@@ -384,6 +404,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				fmtId:           fmtId,
 				sinkId:          sinkId,
 				encryptFilterId: encryptFilterId,
+				redactFilterId:  redactFilterId,
 				sinkConfig:      s,
 			})
 		}
@@ -440,11 +461,17 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		// order of nodes is important!  gate (aggregate), then redact, then
+		// encrypt, then filter/format, then write to sink
+		nodeIds := []eventlogger.NodeID{p.gateId}
+		if p.redactFilterId != "" {
+			nodeIds = append(nodeIds, p.redactFilterId)
+		}
+		nodeIds = append(nodeIds, p.encryptFilterId, p.fmtId, p.sinkId)
 		err = e.broker.RegisterPipeline(eventlogger.Pipeline{
 			EventType:  eventlogger.EventType(p.eventType),
 			PipelineID: eventlogger.PipelineID(pipeId),
-			// order of nodes is important!  gate (aggregate), then filter/format, then encrypt, then write to sink
-			NodeIDs: []eventlogger.NodeID{p.gateId, p.encryptFilterId, p.fmtId, p.sinkId},
+			NodeIDs:    nodeIds,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to register audit pipeline: %w", op, err)
@@ -517,6 +544,20 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		sysNodeIds = append(sysNodeIds, p.sinkId)
 	}
 
+	if opts.withStreaming {
+		// the stream pipelines always complete, even when they're filtered
+		// out because there are no subscriptions, so they're included in the
+		// success thresholds rather than weakening them.
+		streamSinkId, err := e.registerStreamPipelines(serverName, c.streamRedactionProfiles(), opt...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		auditNodeIds = append(auditNodeIds, streamSinkId)
+		observationNodeIds = append(observationNodeIds, streamSinkId)
+		errNodeIds = append(errNodeIds, streamSinkId)
+		sysNodeIds = append(sysNodeIds, streamSinkId)
+	}
+
 	err := e.broker.SetSuccessThreshold(eventlogger.EventType(ObservationType), len(observationNodeIds))
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to set success threshold for observation events: %w", op, err)
@@ -622,6 +663,8 @@ func (e *Eventer) RotateAuditWrapper(ctx context.Context, newWrapper wrapping.Wr
 			w.Rotate(newWrapper)
 		case *encrypt.Filter:
 			w.Rotate(encrypt.WithWrapper(newWrapper))
+		case *redactionFilter:
+			w.Rotate(newWrapper)
 		default:
 			return fmt.Errorf("%s: unsupported node type (%s): %w", op, reflect.TypeOf(w), ErrInvalidParameter)
 		}
@@ -13,16 +13,74 @@ type EventerConfig struct {
 	ObservationsEnabled bool          `hcl:"observations_enabled"` // ObservationsEnabled specifies if observation events should be emitted.
 	SysEventsEnabled    bool          `hcl:"sysevents_enabled"`    // SysEventsEnabled specifies if sysevents should be emitted.
 	Sinks               []*SinkConfig `hcl:"-"`                    // Sinks are all the configured sinks
+	StreamingEnabled    bool          `hcl:"streaming_enabled"`    // StreamingEnabled specifies if events can be streamed to subscribers.
+
+	// RedactionProfiles are the named redaction profiles which sinks can
+	// apply to audit events via their AuditConfig.
+	RedactionProfiles []*RedactionProfile `hcl:"-"`
+
+	// StreamRedactionProfiles are the names of the redaction profiles applied
+	// to audit events streamed to subscribers. If empty, every redaction
+	// profile is applied.
+	StreamRedactionProfiles []string `hcl:"stream_redaction_profiles"`
 }
 
 // Validate will Validate the config. A config isn't required to have any
 // sinks to be valid.
 func (c *EventerConfig) Validate() error {
 	const op = "event.(EventerConfig).Validate"
+	profiles := make(map[string]bool, len(c.RedactionProfiles))
+	for i, p := range c.RedactionProfiles {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("%s: redaction profile %d is invalid: %w", op, i, err)
+		}
+		if profiles[p.Name] {
+			return fmt.Errorf("%s: duplicate redaction profile '%s': %w", op, p.Name, ErrInvalidParameter)
+		}
+		profiles[p.Name] = true
+	}
+	for _, name := range c.StreamRedactionProfiles {
+		if !profiles[name] {
+			return fmt.Errorf("%s: stream references unknown redaction profile '%s': %w", op, name, ErrInvalidParameter)
+		}
+	}
 	for i, s := range c.Sinks {
 		if err := s.Validate(); err != nil {
 			return fmt.Errorf("%s: sink %d is invalid: %w", op, i, err)
 		}
+		if s.AuditConfig == nil {
+			continue
+		}
+		for _, name := range s.AuditConfig.RedactionProfiles {
+			if !profiles[name] {
+				return fmt.Errorf("%s: sink %d references unknown redaction profile '%s': %w", op, i, name, ErrInvalidParameter)
+			}
+		}
 	}
 	return nil
 }
+
+// redactionProfiles returns the redaction profiles with the given names, in
+// the order of the names.
+func (c *EventerConfig) redactionProfiles(names []string) []*RedactionProfile {
+	byName := make(map[string]*RedactionProfile, len(c.RedactionProfiles))
+	for _, p := range c.RedactionProfiles {
+		byName[p.Name] = p
+	}
+	ret := make([]*RedactionProfile, 0, len(names))
+	for _, n := range names {
+		if p, ok := byName[n]; ok {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// streamRedactionProfiles returns the redaction profiles applied to streamed
+// audit events.
+func (c *EventerConfig) streamRedactionProfiles() []*RedactionProfile {
+	if len(c.StreamRedactionProfiles) == 0 {
+		return c.RedactionProfiles
+	}
+	return c.redactionProfiles(c.StreamRedactionProfiles)
+}
@@ -29,6 +29,20 @@ func TestEventerConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: "is not a valid sink type",
 		},
+		{
+			name: "unknown-stream-redaction-profile",
+			c: EventerConfig{
+				RedactionProfiles: []*RedactionProfile{
+					{
+						Name:  "names",
+						Rules: []*RedactionRule{{Path: "auth.name", Operation: RedactOperation}},
+					},
+				},
+				StreamRedactionProfiles: []string{"missing"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "stream references unknown redaction profile 'missing'",
+		},
 		{
 			name: "valid-with-all-defaults",
 			c:    EventerConfig{},
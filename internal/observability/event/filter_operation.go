@@ -16,6 +16,7 @@ const (
 	RedactOperation     FilterOperation = "redact"      // RedactOperation specifies an redaction operation
 	EncryptOperation    FilterOperation = "encrypt"     // EncryptOperation specifies an encryption operation.
 	HmacSha256Operation FilterOperation = "hmac-sha256" // HmacSha256Operation specifies an hmac-sha256 operation
+	DropOperation       FilterOperation = "drop"        // DropOperation specifies removing the value entirely. It's only supported by redaction rules.
 )
 
 // Validate the FilterOperation
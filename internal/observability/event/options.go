@@ -29,25 +29,29 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withId               string
-	withDetails          map[string]any
-	withHeader           map[string]any
-	withFlush            bool
-	withInfo             map[string]any
-	withRequestInfo      *RequestInfo
-	withNow              time.Time
-	withRequest          *Request
-	withResponse         *Response
-	withAuth             *Auth
-	withEventer          *Eventer
-	withEventerConfig    *EventerConfig
-	withAllow            []string
-	withDeny             []string
-	withSchema           *url.URL
-	withAuditWrapper     wrapping.Wrapper
-	withFilterOperations AuditFilterOperations
-	withGating           bool
-	withNoGateLocking    bool
+	withId                string
+	withDetails           map[string]any
+	withHeader            map[string]any
+	withFlush             bool
+	withInfo              map[string]any
+	withRequestInfo       *RequestInfo
+	withNow               time.Time
+	withRequest           *Request
+	withResponse          *Response
+	withAuth              *Auth
+	withEventer           *Eventer
+	withEventerConfig     *EventerConfig
+	withAllow             []string
+	withDeny              []string
+	withSchema            *url.URL
+	withAuditWrapper      wrapping.Wrapper
+	withFilterOperations  AuditFilterOperations
+	withRedactionProfiles []string
+	withGating            bool
+	withNoGateLocking     bool
+	withStreaming         bool
+	withScopeIds          []string
+	withBufferSize        int
 
 	// These options are related to the hclog adapter
 	withHclogLevel hclog.Level
@@ -207,6 +211,13 @@ func WithFilterOperations(fop AuditFilterOperations) Option {
 	}
 }
 
+// WithRedactionProfiles is an optional list of redaction profile names
+func WithRedactionProfiles(names []string) Option {
+	return func(o *options) {
+		o.withRedactionProfiles = names
+	}
+}
+
 // WithHclogLevel is an option to specify a log level if using the adapter
 func WithHclogLevel(with hclog.Level) Option {
 	return func(o *options) {
@@ -221,6 +232,29 @@ func WithGating(with bool) Option {
 	}
 }
 
+// WithStreaming enables subscribing to the eventer's events via
+// Eventer.Subscribe
+func WithStreaming(with bool) Option {
+	return func(o *options) {
+		o.withStreaming = with
+	}
+}
+
+// WithScopeIds is an optional set of scope ids which restricts a subscription
+// to audit events for requests in those scopes
+func WithScopeIds(ids ...string) Option {
+	return func(o *options) {
+		o.withScopeIds = ids
+	}
+}
+
+// WithBufferSize is an optional number of events to buffer for a subscription
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		o.withBufferSize = size
+	}
+}
+
 // WithNoGateLocking is used when trawling through the existing queue to ensure we don't deadlock
 func WithNoGateLocking(with bool) Option {
 	return func(o *options) {
@@ -175,6 +175,34 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withFilterOperations = overrides
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRedactionProfiles", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRedactionProfiles([]string{"passwords"}))
+		testOpts := getDefaultOptions()
+		testOpts.withRedactionProfiles = []string{"passwords"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithStreaming", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStreaming(true))
+		testOpts := getDefaultOptions()
+		testOpts.withStreaming = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithScopeIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithScopeIds("global", "o_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withScopeIds = []string{"global", "o_1234567890"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBufferSize", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithBufferSize(10))
+		testOpts := getDefaultOptions()
+		testOpts.withBufferSize = 10
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithHclogLevel", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithHclogLevel(hclog.Info))
@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/mitchellh/copystructure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// redactionRoots are the top level fields of an audit event which redaction
+// rule paths can start with.
+var redactionRoots = map[string]bool{
+	"request_info": true,
+	"auth":         true,
+	"request":      true,
+	"response":     true,
+}
+
+// RedactionProfile defines a named set of redaction rules for audit events.
+// Profiles are defined once in the eventer config and can be referenced by
+// any number of sinks via their AuditConfig.
+type RedactionProfile struct {
+	Name  string           `hcl:"name"` // Name of the profile, referenced by sinks.
+	Rules []*RedactionRule `hcl:"-"`    // Rules to apply, in order.
+}
+
+// RedactionRule applies a filter operation to the value at a path of an audit
+// event.
+type RedactionRule struct {
+	// Path is a dot separated list of field names, starting with one of
+	// request_info, auth, request or response. Request and response details
+	// use the proto field names of the API messages, for example
+	// "request.details.attributes.password". A rule is applied to every
+	// element of any repeated field along its path, and paths which don't
+	// exist in an event are ignored.
+	Path string `hcl:"path"`
+
+	// Operation is applied to the value at Path. Supported operations are
+	// redact, encrypt, hmac-sha256 and drop. Values which can't hold a
+	// string, such as numbers, are dropped by the redact, encrypt and
+	// hmac-sha256 operations.
+	Operation FilterOperation `hcl:"operation"`
+}
+
+// Validate the RedactionRule
+func (r *RedactionRule) Validate() error {
+	const op = "event.(RedactionRule).Validate"
+	if r == nil {
+		return fmt.Errorf("%s: missing rule: %w", op, ErrInvalidParameter)
+	}
+	if r.Path == "" {
+		return fmt.Errorf("%s: missing path: %w", op, ErrInvalidParameter)
+	}
+	segments := strings.Split(r.Path, ".")
+	for _, s := range segments {
+		if s == "" {
+			return fmt.Errorf("%s: invalid path '%s': empty field name: %w", op, r.Path, ErrInvalidParameter)
+		}
+	}
+	if !redactionRoots[segments[0]] {
+		return fmt.Errorf("%s: invalid path '%s': must start with one of request_info, auth, request or response: %w", op, r.Path, ErrInvalidParameter)
+	}
+	switch r.Operation {
+	case RedactOperation, EncryptOperation, HmacSha256Operation, DropOperation:
+	default:
+		return fmt.Errorf("%s: invalid operation '%s' for path '%s': %w", op, r.Operation, r.Path, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// Validate the RedactionProfile
+func (p *RedactionProfile) Validate() error {
+	const op = "event.(RedactionProfile).Validate"
+	if p == nil {
+		return fmt.Errorf("%s: missing profile: %w", op, ErrInvalidParameter)
+	}
+	if p.Name == "" {
+		return fmt.Errorf("%s: missing profile name: %w", op, ErrInvalidParameter)
+	}
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("%s: profile '%s' has no rules: %w", op, p.Name, ErrInvalidParameter)
+	}
+	for i, r := range p.Rules {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("%s: profile '%s' rule %d is invalid: %w", op, p.Name, i, err)
+		}
+	}
+	return nil
+}
+
+// redactionFilter is an eventlogger filter node which applies the rules of a
+// sink's redaction profiles to audit events. It must be placed before the
+// sink's formatter node.
+type redactionFilter struct {
+	rules []*RedactionRule
+
+	l       sync.RWMutex
+	wrapper wrapping.Wrapper
+}
+
+var _ eventlogger.Node = (*redactionFilter)(nil)
+
+// newRedactionFilter returns a filter node applying the rules of the given
+// profiles, in order. The wrapper is required by the encrypt and
+// hmac-sha256 operations.
+func newRedactionFilter(profiles []*RedactionProfile, w wrapping.Wrapper) (*redactionFilter, error) {
+	const op = "event.newRedactionFilter"
+	f := &redactionFilter{wrapper: w}
+	for _, p := range profiles {
+		if err := p.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		f.rules = append(f.rules, p.Rules...)
+	}
+	return f, nil
+}
+
+// Type describes the type of the node as a filter.
+func (f *redactionFilter) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFilter
+}
+
+// Reopen is a no op for redaction filters.
+func (f *redactionFilter) Reopen() error {
+	return nil
+}
+
+// Rotate the filter's wrapper.
+func (f *redactionFilter) Rotate(w wrapping.Wrapper) {
+	f.l.Lock()
+	defer f.l.Unlock()
+	f.wrapper = w
+}
+
+// Process applies the filter's rules to audit events. Other events are
+// passed through unchanged. The event is copied before being modified since
+// it is shared with the other pipelines.
+func (f *redactionFilter) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(redactionFilter).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	switch p := e.Payload.(type) {
+	case *audit:
+		if p == nil {
+			return e, nil
+		}
+	case audit:
+	default:
+		return e, nil
+	}
+
+	dup, err := copystructure.Copy(e)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	e = dup.(*eventlogger.Event)
+
+	var a *audit
+	switch p := e.Payload.(type) {
+	case *audit:
+		a = p
+	case audit:
+		a = &p
+		defer func() { e.Payload = *a }()
+	}
+	for _, r := range f.rules {
+		if err := f.applyGo(ctx, reflect.ValueOf(a), strings.Split(r.Path, "."), r.Operation); err != nil {
+			return nil, fmt.Errorf("%s: unable to apply rule for path '%s': %w", op, r.Path, err)
+		}
+	}
+	return e, nil
+}
+
+// applyGo applies the operation to the value at path within the Go value v,
+// matching field names against json tags.
+func (f *redactionFilter) applyGo(ctx context.Context, v reflect.Value, path []string, fop FilterOperation) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if m, ok := v.Interface().(proto.Message); ok {
+			return f.applyProto(ctx, m.ProtoReflect(), path, fop)
+		}
+		return f.applyGo(ctx, v.Elem(), path, fop)
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := f.applyGo(ctx, v.Index(i), path, fop); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != path[0] {
+			continue
+		}
+		fv := v.Field(i)
+		if len(path) > 1 {
+			return f.applyGo(ctx, fv, path[1:], fop)
+		}
+		switch {
+		case fop == DropOperation:
+			fv.Set(reflect.Zero(fv.Type()))
+		case fv.Kind() == reflect.String:
+			s, err := f.transform(ctx, fv.String(), fop)
+			if err != nil {
+				return err
+			}
+			fv.SetString(s)
+		default:
+			fv.Set(reflect.Zero(fv.Type()))
+		}
+		return nil
+	}
+	return nil
+}
+
+// applyProto applies the operation to the value at path within the message
+// m, matching field names against proto field names.
+func (f *redactionFilter) applyProto(ctx context.Context, m protoreflect.Message, path []string, fop FilterOperation) error {
+	if s, ok := m.Interface().(*structpb.Struct); ok {
+		return f.applyStruct(ctx, s, path, fop)
+	}
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || !m.Has(fd) {
+		return nil
+	}
+
+	if len(path) > 1 {
+		switch {
+		case fd.IsMap() || fd.Message() == nil:
+			return nil
+		case fd.IsList():
+			l := m.Mutable(fd).List()
+			for i := 0; i < l.Len(); i++ {
+				if err := f.applyProto(ctx, l.Get(i).Message(), path[1:], fop); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
+			return f.applyProto(ctx, m.Mutable(fd).Message(), path[1:], fop)
+		}
+	}
+
+	switch {
+	case fop == DropOperation || fd.IsMap():
+		m.Clear(fd)
+	case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+		l := m.Mutable(fd).List()
+		for i := 0; i < l.Len(); i++ {
+			s, err := f.transform(ctx, l.Get(i).String(), fop)
+			if err != nil {
+				return err
+			}
+			l.Set(i, protoreflect.ValueOfString(s))
+		}
+	case fd.IsList():
+		m.Clear(fd)
+	case fd.Kind() == protoreflect.StringKind:
+		s, err := f.transform(ctx, m.Get(fd).String(), fop)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, protoreflect.ValueOfString(s))
+	case fd.Message() != nil && fd.Message().FullName() == "google.protobuf.Value":
+		v, err := f.transformValue(ctx, m.Get(fd).Message().Interface().(*structpb.Value), fop)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, protoreflect.ValueOfMessage(v.ProtoReflect()))
+	case fd.Message() != nil && fd.Message().FullName() == "google.protobuf.StringValue":
+		sv := m.Mutable(fd).Message()
+		vfd := sv.Descriptor().Fields().ByName("value")
+		s, err := f.transform(ctx, sv.Get(vfd).String(), fop)
+		if err != nil {
+			return err
+		}
+		sv.Set(vfd, protoreflect.ValueOfString(s))
+	default:
+		m.Clear(fd)
+	}
+	return nil
+}
+
+// applyStruct applies the operation to the value at path within the struct
+// s, matching field names against its keys.
+func (f *redactionFilter) applyStruct(ctx context.Context, s *structpb.Struct, path []string, fop FilterOperation) error {
+	v, ok := s.GetFields()[path[0]]
+	if !ok {
+		return nil
+	}
+	if len(path) == 1 {
+		if fop == DropOperation {
+			delete(s.Fields, path[0])
+			return nil
+		}
+		nv, err := f.transformValue(ctx, v, fop)
+		if err != nil {
+			return err
+		}
+		s.Fields[path[0]] = nv
+		return nil
+	}
+	switch {
+	case v.GetStructValue() != nil:
+		return f.applyStruct(ctx, v.GetStructValue(), path[1:], fop)
+	case v.GetListValue() != nil:
+		for _, lv := range v.GetListValue().GetValues() {
+			if lv.GetStructValue() == nil {
+				continue
+			}
+			if err := f.applyStruct(ctx, lv.GetStructValue(), path[1:], fop); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// transformValue returns a string value holding the result of the
+// operation. Values which aren't strings are transformed using their JSON
+// encoding.
+func (f *redactionFilter) transformValue(ctx context.Context, v *structpb.Value, fop FilterOperation) (*structpb.Value, error) {
+	const op = "event.(redactionFilter).transformValue"
+	data := v.GetStringValue()
+	if _, ok := v.GetKind().(*structpb.Value_StringValue); !ok {
+		b, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		data = string(b)
+	}
+	s, err := f.transform(ctx, data, fop)
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewStringValue(s), nil
+}
+
+// transform returns the result of the operation on data, using the same
+// encoding as the audit encrypt filter.
+func (f *redactionFilter) transform(ctx context.Context, data string, fop FilterOperation) (string, error) {
+	const op = "event.(redactionFilter).transform"
+	switch fop {
+	case RedactOperation:
+		return encrypt.RedactedData, nil
+	case EncryptOperation, HmacSha256Operation:
+	default:
+		return "", fmt.Errorf("%s: unsupported operation '%s': %w", op, fop, ErrInvalidParameter)
+	}
+
+	f.l.RLock()
+	w := f.wrapper
+	f.l.RUnlock()
+	if w == nil {
+		return "", fmt.Errorf("%s: missing wrapper and configured %s operation requires a wrapper: %w", op, fop, ErrInvalidParameter)
+	}
+
+	if fop == EncryptOperation {
+		blobInfo, err := w.Encrypt(ctx, []byte(data), nil)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+		marshaledBlob, err := proto.Marshal(blobInfo)
+		if err != nil {
+			return "", fmt.Errorf("%s: error marshaling encrypted blob: %w", op, err)
+		}
+		return "encrypted:" + base64.RawURLEncoding.EncodeToString(marshaledBlob), nil
+	}
+
+	reader, err := encrypt.NewDerivedReader(ctx, w, 32, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return "hmac-sha256:" + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
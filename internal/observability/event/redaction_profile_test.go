@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package event
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/testing/event"
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/filters/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRedactionProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		p               *RedactionProfile
+		wantErrContains string
+	}{
+		{
+			name:            "nil",
+			wantErrContains: "missing profile",
+		},
+		{
+			name:            "missing-name",
+			p:               &RedactionProfile{Rules: []*RedactionRule{{Path: "request.details", Operation: RedactOperation}}},
+			wantErrContains: "missing profile name",
+		},
+		{
+			name:            "no-rules",
+			p:               &RedactionProfile{Name: "test"},
+			wantErrContains: "profile 'test' has no rules",
+		},
+		{
+			name:            "missing-path",
+			p:               &RedactionProfile{Name: "test", Rules: []*RedactionRule{{Operation: RedactOperation}}},
+			wantErrContains: "missing path",
+		},
+		{
+			name:            "empty-path-segment",
+			p:               &RedactionProfile{Name: "test", Rules: []*RedactionRule{{Path: "request..details", Operation: RedactOperation}}},
+			wantErrContains: "empty field name",
+		},
+		{
+			name:            "invalid-root",
+			p:               &RedactionProfile{Name: "test", Rules: []*RedactionRule{{Path: "timestamp", Operation: RedactOperation}}},
+			wantErrContains: "must start with one of",
+		},
+		{
+			name:            "invalid-operation",
+			p:               &RedactionProfile{Name: "test", Rules: []*RedactionRule{{Path: "request.details", Operation: "invalid"}}},
+			wantErrContains: "invalid operation 'invalid'",
+		},
+		{
+			name: "valid",
+			p: &RedactionProfile{Name: "test", Rules: []*RedactionRule{
+				{Path: "request.details.attributes.password", Operation: RedactOperation},
+				{Path: "response.details.items.authorized_actions", Operation: DropOperation},
+				{Path: "auth.email", Operation: HmacSha256Operation},
+				{Path: "auth.name", Operation: EncryptOperation},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			err := tt.p.Validate()
+			if tt.wantErrContains != "" {
+				require.Error(err)
+				assert.ErrorIs(err, ErrInvalidParameter)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func TestEventerConfig_ValidateRedactionProfiles(t *testing.T) {
+	profile := &RedactionProfile{Name: "test", Rules: []*RedactionRule{{Path: "request.details", Operation: RedactOperation}}}
+	sink := func(profiles ...string) *SinkConfig {
+		s := DefaultSink()
+		s.AuditConfig = &AuditConfig{RedactionProfiles: profiles}
+		return s
+	}
+
+	c := EventerConfig{RedactionProfiles: []*RedactionProfile{profile}, Sinks: []*SinkConfig{sink("test")}}
+	require.NoError(t, c.Validate())
+
+	c = EventerConfig{RedactionProfiles: []*RedactionProfile{profile, profile}}
+	err := c.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate redaction profile 'test'")
+
+	c = EventerConfig{Sinks: []*SinkConfig{sink("missing")}}
+	err = c.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown redaction profile 'missing'")
+}
+
+func TestRedactionFilter_Process(t *testing.T) {
+	ctx := context.Background()
+	testAudit := func() *audit {
+		return &audit{
+			Id:      "test-id",
+			Version: auditVersion,
+			Type:    string(ApiRequest),
+			Auth: &Auth{
+				AuthTokenId: "at_1234567890",
+				UserEmail:   "alice@example.com",
+				UserName:    "alice",
+			},
+			Request: &Request{
+				Operation: "POST",
+				Details: &pbs.TestAuthenticateRequest{
+					AuthMethodId: "ampw_1234567890",
+					Command:      "login",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"login_name": structpb.NewStringValue("alice"),
+						"password":   structpb.NewStringValue("fido"),
+					}},
+				},
+			},
+			Response: &Response{
+				StatusCode: 200,
+				Details: &pbs.TestAuthenticateResponse{
+					Command: "login",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"token":      structpb.NewStringValue("test-token"),
+						"expiration": structpb.NewNumberValue(3600),
+					}},
+				},
+			},
+		}
+	}
+	profiles := []*RedactionProfile{
+		{
+			Name: "credentials",
+			Rules: []*RedactionRule{
+				{Path: "request.details.attributes.password", Operation: RedactOperation},
+				{Path: "request.details.command", Operation: HmacSha256Operation},
+				{Path: "response.details.attributes.token", Operation: EncryptOperation},
+				{Path: "response.details.attributes.expiration", Operation: RedactOperation},
+			},
+		},
+		{
+			Name: "users",
+			Rules: []*RedactionRule{
+				{Path: "auth.email", Operation: DropOperation},
+				{Path: "auth.name", Operation: RedactOperation},
+				{Path: "response.details.command", Operation: DropOperation},
+				{Path: "request.details.missing.field", Operation: RedactOperation},
+			},
+		},
+	}
+
+	assertRedacted := func(t *testing.T, got *audit) {
+		t.Helper()
+		assert, require := assert.New(t), require.New(t)
+		req := got.Request.Details.(*pbs.TestAuthenticateRequest)
+		assert.Equal(encrypt.RedactedData, req.GetAttributes().GetFields()["password"].GetStringValue())
+		assert.Equal("alice", req.GetAttributes().GetFields()["login_name"].GetStringValue())
+		assert.True(strings.HasPrefix(req.GetCommand(), "hmac-sha256:"), req.GetCommand())
+		assert.Equal("ampw_1234567890", req.GetAuthMethodId())
+
+		resp := got.Response.Details.(*pbs.TestAuthenticateResponse)
+		assert.True(strings.HasPrefix(resp.GetAttributes().GetFields()["token"].GetStringValue(), "encrypted:"))
+		assert.Equal(encrypt.RedactedData, resp.GetAttributes().GetFields()["expiration"].GetStringValue())
+		assert.Empty(resp.GetCommand())
+
+		require.NotNil(got.Auth)
+		assert.Empty(got.Auth.UserEmail)
+		assert.Equal(encrypt.RedactedData, got.Auth.UserName)
+		assert.Equal("at_1234567890", got.Auth.AuthTokenId)
+	}
+
+	t.Run("pointer-payload", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := newRedactionFilter(profiles, encrypt.TestWrapper(t))
+		require.NoError(err)
+
+		orig := testAudit()
+		e := &eventlogger.Event{Type: eventlogger.EventType(AuditType), Payload: orig}
+		got, err := f.Process(ctx, e)
+		require.NoError(err)
+		require.NotNil(got)
+		assertRedacted(t, got.Payload.(*audit))
+		assert.True(proto.Equal(testAudit().Request.Details, orig.Request.Details), "the original event should not be modified")
+		assert.Equal("alice@example.com", orig.Auth.UserEmail, "the original event should not be modified")
+	})
+	t.Run("gated-payload", func(t *testing.T) {
+		require := require.New(t)
+		f, err := newRedactionFilter(profiles, encrypt.TestWrapper(t))
+		require.NoError(err)
+
+		e := &eventlogger.Event{Type: eventlogger.EventType(AuditType), Payload: *testAudit()}
+		got, err := f.Process(ctx, e)
+		require.NoError(err)
+		a := got.Payload.(audit)
+		assertRedacted(t, &a)
+	})
+	t.Run("other-payload", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := newRedactionFilter(profiles, nil)
+		require.NoError(err)
+
+		e := &eventlogger.Event{Type: eventlogger.EventType(ObservationType), Payload: "not an audit event"}
+		got, err := f.Process(ctx, e)
+		require.NoError(err)
+		assert.Equal(e, got)
+	})
+	t.Run("missing-wrapper", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		f, err := newRedactionFilter(profiles, nil)
+		require.NoError(err)
+
+		_, err = f.Process(ctx, &eventlogger.Event{Type: eventlogger.EventType(AuditType), Payload: testAudit()})
+		require.Error(err)
+		assert.ErrorIs(err, ErrInvalidParameter)
+		assert.Contains(err.Error(), "requires a wrapper")
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/oplog/store"
+	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/util"
 	"github.com/hashicorp/go-dbw"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
@@ -27,6 +28,10 @@ import (
 //	v2: adds the new Message.Opts
 const Version = "v2"
 
+// UserIdMetadataKey is the metadata key used to record the id of the user
+// whose request caused an entry to be written.
+const UserIdMetadataKey = "user-id"
+
 // Message wraps a proto.Message with some other bits like operation type,
 // paths and options.
 type Message struct {
@@ -81,6 +86,13 @@ func NewEntry(ctx context.Context, aggregateName string, metadata Metadata, wrap
 		}
 		entry.Metadata = storeMD
 	}
+	if _, ok := metadata[UserIdMetadataKey]; !ok {
+		// record the acting user, when known, so the entry can be attributed
+		// when presenting resource history.
+		if reqCtx, ok := requests.RequestContextFromCtx(ctx); ok && reqCtx.UserId != "" {
+			entry.Metadata = append(entry.Metadata, &store.Metadata{Key: UserIdMetadataKey, Value: reqCtx.UserId})
+		}
+	}
 	if err := entry.validate(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package credential provides a plugin type used to interface with boundary's
+// credential related resources.  Additionally it provides a repository for
+// performing CRUDL and custom operations on this plugin type.
+package credential
@@ -32,6 +32,7 @@ type options struct {
 	withMonitorInterval    time.Duration
 	withInterruptThreshold time.Duration
 	withRunNow             bool
+	withRunJobObserver     RunJobObserver
 }
 
 func getDefaultOptions() options {
@@ -109,3 +110,12 @@ func WithRunNow(b bool) Option {
 		o.withRunNow = b
 	}
 }
+
+// WithRunJobObserver provides an option to provide a RunJobObserver which the
+// scheduler will call each time a job run completes, whether it succeeded or
+// failed.
+func WithRunJobObserver(o RunJobObserver) Option {
+	return func(opts *options) {
+		opts.withRunJobObserver = o
+	}
+}
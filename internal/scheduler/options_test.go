@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test_GetOpts provides unit tests for GetOpts and all the options
@@ -77,4 +78,14 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRunNow = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRunJobObserver", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.Nil(opts.withRunJobObserver)
+		var called bool
+		opts = getOpts(WithRunJobObserver(func(string, time.Duration, error) { called = true }))
+		require.NotNil(t, opts.withRunJobObserver)
+		opts.withRunJobObserver("test", time.Second, nil)
+		assert.True(called)
+	})
 }
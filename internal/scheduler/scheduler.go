@@ -17,6 +17,11 @@ import (
 
 type jobRepoFactory func() (*job.Repository, error)
 
+// RunJobObserver is called by the scheduler once a job run has completed. The
+// name of the job, the duration of the run and the error returned by the job,
+// if any, are provided.
+type RunJobObserver func(jobName string, d time.Duration, err error)
+
 type runningJob struct {
 	runId     string
 	cancelCtx context.CancelFunc
@@ -37,6 +42,7 @@ type Scheduler struct {
 	monitorInterval    time.Duration
 	interruptThreshold time.Duration
 	runNow             chan struct{}
+	runJobObserver     RunJobObserver
 }
 
 // New creates a new Scheduler
@@ -45,8 +51,8 @@ type Scheduler struct {
 //
 // • jobRepoFn must be provided and is a function that returns the job repository
 //
-// WithRunJobsLimit, WithRunJobsInterval, WithMonitorInterval and WithInterruptThreshold are
-// the only valid options.
+// WithRunJobsLimit, WithRunJobsInterval, WithMonitorInterval, WithInterruptThreshold and
+// WithRunJobObserver are the only valid options.
 func New(serverId string, jobRepoFn jobRepoFactory, opt ...Option) (*Scheduler, error) {
 	const op = "scheduler.New"
 	if serverId == "" {
@@ -67,6 +73,7 @@ func New(serverId string, jobRepoFn jobRepoFactory, opt ...Option) (*Scheduler,
 		monitorInterval:    opts.withMonitorInterval,
 		interruptThreshold: opts.withInterruptThreshold,
 		runNow:             make(chan struct{}, 1),
+		runJobObserver:     opts.withRunJobObserver,
 	}, nil
 }
 
@@ -260,7 +267,11 @@ func (s *Scheduler) runJob(ctx context.Context, wg *sync.WaitGroup, r *job.Run)
 	go func() {
 		defer rj.cancelCtx()
 		defer wg.Done()
+		start := time.Now()
 		runErr := j.Run(jobContext)
+		if s.runJobObserver != nil {
+			s.runJobObserver(j.Name(), time.Since(start), runErr)
+		}
 
 		// Get final status report to update run progress with
 		status := j.Status()
@@ -412,6 +412,29 @@ and
 and
 	session_state.start_time < wt_sub_seconds_from_now(@threshold_seconds)
 ;
+`
+	sessionCountsByStateAndTarget = `
+select
+	ss.state,
+	coalesce(s.target_id, '') as target_id,
+	count(*) as count
+from
+	session s,
+	session_state ss
+where
+	s.public_id = ss.session_id and
+	ss.end_time is null
+group by ss.state, s.target_id;
+`
+	closedConnectionCountsByReason = `
+select
+	closed_reason,
+	count(*) as count
+from
+	session_connection
+where
+	closed_reason is not null
+group by closed_reason;
 `
 	sessionCredentialRewrapQuery = `
 select distinct
@@ -286,6 +286,37 @@ func (r *ConnectionRepository) ConnectConnection(ctx context.Context, c ConnectW
 	return &connection, connectionStates, nil
 }
 
+// ClosedReasonCount is the number of closed connections for a closed reason.
+type ClosedReasonCount struct {
+	ClosedReason string
+	Count        int64
+}
+
+// CountClosedConnectionsByReason returns the number of closed connections
+// grouped by the reason they were closed. Only connections which have not yet
+// been deleted along with their session are counted.
+func (r *ConnectionRepository) CountClosedConnectionsByReason(ctx context.Context) ([]*ClosedReasonCount, error) {
+	const op = "session.(ConnectionRepository).CountClosedConnectionsByReason"
+	rows, err := r.reader.Query(ctx, closedConnectionCountsByReason, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var counts []*ClosedReasonCount
+	for rows.Next() {
+		var c ClosedReasonCount
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		counts = append(counts, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
+
 // closeConnectionResp is just a wrapper for the response from CloseConnections.
 // It wraps the connection and its states for each connection closed.
 type closeConnectionResp struct {
@@ -524,6 +524,52 @@ func TestRepository_CloseConnections(t *testing.T) {
 	}
 }
 
+func TestRepository_CountClosedConnectionsByReason(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	connRepo, err := NewConnectionRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	assert, require := assert.New(t), require.New(t)
+	counts, err := connRepo.CountClosedConnectionsByReason(ctx)
+	require.NoError(err)
+	assert.Empty(counts)
+
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	s, _, err = repo.ActivateSession(ctx, s.PublicId, s.Version, TestTofu(t))
+	require.NoError(err)
+	var cw []CloseWith
+	for _, reason := range []ClosedReason{ConnectionClosedByUser, ConnectionClosedByUser, ConnectionNetworkError} {
+		c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+		cw = append(cw, CloseWith{
+			ConnectionId: c.PublicId,
+			ClosedReason: reason,
+		})
+	}
+	// An open connection is not counted.
+	TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+	_, err = connRepo.closeConnections(ctx, cw)
+	require.NoError(err)
+
+	counts, err = connRepo.CountClosedConnectionsByReason(ctx)
+	require.NoError(err)
+	got := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		got[c.ClosedReason] = c.Count
+	}
+	assert.Equal(map[string]int64{
+		ConnectionClosedByUser.String(): 2,
+		ConnectionNetworkError.String(): 1,
+	}, got)
+}
+
 func TestUpdateBytesUpDown(t *testing.T) {
 	t.Parallel()
 
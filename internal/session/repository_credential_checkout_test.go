@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	cred "github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateSession_StaticCredentialCheckout(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	credRepo, err := credstatic.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+	targetRepo, err := target.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	params := TestSessionParams(t, conn, wrapper, iamRepo)
+	staticStore := credstatic.TestCredentialStore(t, conn, wrapper, params.ProjectId)
+	limited := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "u", "p", staticStore.GetPublicId(), params.ProjectId, credstatic.WithMaxConcurrentCheckouts(1))
+	unlimited := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "u", "p", staticStore.GetPublicId(), params.ProjectId)
+
+	tar, _, _, err := targetRepo.LookupTarget(ctx, params.TargetId)
+	require.NoError(t, err)
+	_, _, _, err = targetRepo.AddTargetCredentialSources(ctx, tar.GetPublicId(), tar.GetVersion(), target.CredentialSources{
+		BrokeredCredentialIds: []string{limited.GetPublicId(), unlimited.GetPublicId()},
+	})
+	require.NoError(t, err)
+
+	newSession := func(credIds ...string) (*Session, error) {
+		c := params
+		c.StaticCredentials = nil
+		for _, id := range credIds {
+			c.StaticCredentials = append(c.StaticCredentials, NewStaticCredential(id, cred.BrokeredPurpose))
+		}
+		s, err := New(c)
+		require.NoError(t, err)
+		return repo.CreateSession(ctx, wrapper, s, []string{"1.2.3.4"})
+	}
+
+	assert, require := assert.New(t), require.New(t)
+
+	first, err := newSession(limited.GetPublicId(), unlimited.GetPublicId())
+	require.NoError(err)
+
+	checkouts, err := credRepo.ListCheckouts(ctx, limited.GetPublicId())
+	require.NoError(err)
+	require.Len(checkouts, 1)
+	assert.Equal(limited.GetPublicId(), checkouts[0].CredentialId)
+	assert.Equal(first.GetPublicId(), checkouts[0].SessionId)
+	assert.Equal(params.UserId, checkouts[0].UserId)
+	assert.NotNil(checkouts[0].CreateTime)
+	assert.NotNil(checkouts[0].ExpirationTime)
+
+	// the limited credential is exhausted, the unlimited one is not
+	_, err = newSession(limited.GetPublicId())
+	require.Error(err)
+	assert.Contains(err.Error(), globals.CredentialCheckoutsExhaustedErrStr)
+	second, err := newSession(unlimited.GetPublicId())
+	require.NoError(err)
+	checkouts, err = credRepo.ListCheckouts(ctx, unlimited.GetPublicId())
+	require.NoError(err)
+	assert.Len(checkouts, 2)
+
+	// terminating the session checks the credentials back in
+	TestState(t, conn, first.GetPublicId(), StatusTerminated)
+	checkouts, err = credRepo.ListCheckouts(ctx, limited.GetPublicId())
+	require.NoError(err)
+	assert.Empty(checkouts)
+	checkouts, err = credRepo.ListCheckouts(ctx, unlimited.GetPublicId())
+	require.NoError(err)
+	require.Len(checkouts, 1)
+	assert.Equal(second.GetPublicId(), checkouts[0].SessionId)
+
+	third, err := newSession(limited.GetPublicId())
+	require.NoError(err)
+
+	// a forced check-in releases the check-out and cancels the session
+	rows, err := credRepo.CheckIn(ctx, params.ProjectId, limited.GetPublicId(), third.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, rows)
+	assert.NoError(db.TestVerifyOplog(t, rw, limited.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_DELETE), db.WithCreateNotBefore(10*time.Second)))
+	rows, err = credRepo.CheckIn(ctx, params.ProjectId, limited.GetPublicId(), third.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, rows)
+	found, _, err := repo.LookupSession(ctx, third.GetPublicId())
+	require.NoError(err)
+	assert.Equal(StatusCanceling, found.States[0].Status)
+
+	_, err = newSession(limited.GetPublicId())
+	require.NoError(err)
+}
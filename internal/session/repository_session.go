@@ -474,6 +474,39 @@ func (r *Repository) sessionAuthzSummary(ctx context.Context, sessionId string)
 	return info, nil
 }
 
+// StateTargetCount is the number of sessions for a target which are currently
+// in the given state.
+type StateTargetCount struct {
+	State    string
+	TargetId string
+	Count    int64
+}
+
+// CountSessionsByStateAndTarget returns the number of sessions grouped by their
+// current state and the target they were created for. Sessions whose target
+// has been deleted are reported with an empty target id.
+func (r *Repository) CountSessionsByStateAndTarget(ctx context.Context) ([]*StateTargetCount, error) {
+	const op = "session.(Repository).CountSessionsByStateAndTarget"
+	rows, err := r.reader.Query(ctx, sessionCountsByStateAndTarget, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var counts []*StateTargetCount
+	for rows.Next() {
+		var c StateTargetCount
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		counts = append(counts, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
+
 // Lookup an activated session. Must run in a transaction.
 func (r *Repository) lookupActivatedSessionTx(ctx context.Context, reader db.Reader, writer db.Writer, sessionId string,
 	tofuToken []byte, activatedSession *Session,
@@ -492,6 +492,7 @@ func TestRepository_CreateSession(t *testing.T) {
 			assert.Equal(s.StaticCredentials, foundSession.StaticCredentials)
 			for _, cred := range foundSession.StaticCredentials {
 				assert.NotEmpty(cred.CredentialStaticId)
+				assert.NotZero(cred.CredentialVersion)
 				assert.NotEmpty(cred.SessionId)
 				assert.NotEmpty(cred.CredentialPurpose)
 			}
@@ -1606,6 +1607,50 @@ func TestRepository_deleteTargetFKey(t *testing.T) {
 	}
 }
 
+func TestRepository_CountSessionsByStateAndTarget(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+	targetRepo, err := target.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	assert, require := assert.New(t), require.New(t)
+	counts, err := repo.CountSessionsByStateAndTarget(ctx)
+	require.NoError(err)
+	assert.Empty(counts)
+
+	c1 := TestSessionParams(t, conn, wrapper, iamRepo)
+	TestSession(t, conn, wrapper, c1)
+	TestSession(t, conn, wrapper, c1)
+	s := TestSession(t, conn, wrapper, c1)
+	TestState(t, conn, s.PublicId, StatusActive)
+
+	c2 := TestSessionParams(t, conn, wrapper, iamRepo)
+	s = TestSession(t, conn, wrapper, c2)
+	TestState(t, conn, s.PublicId, StatusTerminated)
+	rows, err := targetRepo.DeleteTarget(ctx, c2.TargetId)
+	require.NoError(err)
+	require.Equal(1, rows)
+
+	counts, err = repo.CountSessionsByStateAndTarget(ctx)
+	require.NoError(err)
+	got := make(map[StateTargetCount]bool, len(counts))
+	for _, c := range counts {
+		got[*c] = true
+	}
+	assert.Equal(map[StateTargetCount]bool{
+		{State: StatusPending.String(), TargetId: c1.TargetId, Count: 2}: true,
+		{State: StatusActive.String(), TargetId: c1.TargetId, Count: 1}:  true,
+		{State: StatusTerminated.String(), TargetId: "", Count: 1}:       true,
+	}, got)
+}
+
 func TestRepository_deleteTerminated(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -24,51 +24,62 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	WithName                   string
-	WithDescription            string
-	WithDefaultPort            uint32
-	WithLimit                  int
-	WithProjectId              string
-	WithProjectIds             []string
-	WithProjectName            string
-	WithUserId                 string
-	WithType                   subtypes.Subtype
-	WithHostSources            []string
-	WithCredentialLibraries    []*CredentialLibrary
-	WithStaticCredentials      []*StaticCredential
-	WithSessionMaxSeconds      uint32
-	WithSessionConnectionLimit int32
-	WithPermissions            []perms.Permission
-	WithPublicId               string
-	WithWorkerFilter           string
-	WithEgressWorkerFilter     string
-	WithIngressWorkerFilter    string
-	WithTargetIds              []string
-	WithAddress                string
+	WithName                    string
+	WithDescription             string
+	WithDefaultPort             uint32
+	WithLimit                   int
+	WithProjectId               string
+	WithProjectIds              []string
+	WithProjectName             string
+	WithUserId                  string
+	WithType                    subtypes.Subtype
+	WithHostSources             []string
+	WithCredentialLibraries     []*CredentialLibrary
+	WithStaticCredentials       []*StaticCredential
+	WithSessionMaxSeconds       uint32
+	WithSessionConnectionLimit  int32
+	WithPermissions             []perms.Permission
+	WithPublicId                string
+	WithWorkerFilter            string
+	WithEgressWorkerFilter      string
+	WithIngressWorkerFilter     string
+	WithHostSelectionStrategy   string
+	WithWorkerSelectionStrategy string
+	WithWorkerAffinityFilter    string
+	WithHostExclusionFilter     string
+	WithHostPreferenceFilter    string
+	WithTargetIds               []string
+	WithAddress                 string
+	WithPorts                   map[string]uint32
 }
 
 func getDefaultOptions() options {
 	return options{
-		WithName:                   "",
-		WithDescription:            "",
-		WithLimit:                  0,
-		WithDefaultPort:            0,
-		WithProjectId:              "",
-		WithProjectIds:             nil,
-		WithProjectName:            "",
-		WithUserId:                 "",
-		WithType:                   "",
-		WithHostSources:            nil,
-		WithCredentialLibraries:    nil,
-		WithStaticCredentials:      nil,
-		WithSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
-		WithSessionConnectionLimit: -1,
-		WithPermissions:            nil,
-		WithPublicId:               "",
-		WithWorkerFilter:           "",
-		WithEgressWorkerFilter:     "",
-		WithIngressWorkerFilter:    "",
-		WithAddress:                "",
+		WithName:                    "",
+		WithDescription:             "",
+		WithLimit:                   0,
+		WithDefaultPort:             0,
+		WithProjectId:               "",
+		WithProjectIds:              nil,
+		WithProjectName:             "",
+		WithUserId:                  "",
+		WithType:                    "",
+		WithHostSources:             nil,
+		WithCredentialLibraries:     nil,
+		WithStaticCredentials:       nil,
+		WithSessionMaxSeconds:       uint32((8 * time.Hour).Seconds()),
+		WithSessionConnectionLimit:  -1,
+		WithPermissions:             nil,
+		WithPublicId:                "",
+		WithWorkerFilter:            "",
+		WithEgressWorkerFilter:      "",
+		WithIngressWorkerFilter:     "",
+		WithHostSelectionStrategy:   "",
+		WithWorkerSelectionStrategy: "",
+		WithWorkerAffinityFilter:    "",
+		WithHostExclusionFilter:     "",
+		WithHostPreferenceFilter:    "",
+		WithAddress:                 "",
 	}
 }
 
@@ -198,6 +209,41 @@ func WithIngressWorkerFilter(filter string) Option {
 	}
 }
 
+// WithHostSelectionStrategy provides an optional host selection strategy
+func WithHostSelectionStrategy(strategy string) Option {
+	return func(o *options) {
+		o.WithHostSelectionStrategy = strategy
+	}
+}
+
+// WithWorkerSelectionStrategy provides an optional worker selection strategy
+func WithWorkerSelectionStrategy(strategy string) Option {
+	return func(o *options) {
+		o.WithWorkerSelectionStrategy = strategy
+	}
+}
+
+// WithWorkerAffinityFilter provides an optional worker affinity filter
+func WithWorkerAffinityFilter(filter string) Option {
+	return func(o *options) {
+		o.WithWorkerAffinityFilter = filter
+	}
+}
+
+// WithHostExclusionFilter provides an optional host exclusion filter
+func WithHostExclusionFilter(filter string) Option {
+	return func(o *options) {
+		o.WithHostExclusionFilter = filter
+	}
+}
+
+// WithHostPreferenceFilter provides an optional host preference filter
+func WithHostPreferenceFilter(filter string) Option {
+	return func(o *options) {
+		o.WithHostPreferenceFilter = filter
+	}
+}
+
 // WithTargetIds provides an option to search by specific target IDs
 func WithTargetIds(with []string) Option {
 	return func(o *options) {
@@ -213,6 +259,13 @@ func WithPermissions(perms []perms.Permission) Option {
 	}
 }
 
+// WithPorts provides optional named ports
+func WithPorts(ports map[string]uint32) Option {
+	return func(o *options) {
+		o.WithPorts = ports
+	}
+}
+
 // WithAddress provides an optional network address
 func WithAddress(address string) Option {
 	return func(o *options) {
@@ -126,6 +126,48 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithIngressWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithHostSelectionStrategy", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithHostSelectionStrategy("round-robin"))
+		testOpts := getDefaultOptions()
+		testOpts.WithHostSelectionStrategy = "round-robin"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithWorkerSelectionStrategy", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithWorkerSelectionStrategy("least-connections"))
+		testOpts := getDefaultOptions()
+		testOpts.WithWorkerSelectionStrategy = "least-connections"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithWorkerAffinityFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithWorkerAffinityFilter(`"/foo" == "bar"`))
+		testOpts := getDefaultOptions()
+		testOpts.WithWorkerAffinityFilter = `"/foo" == "bar"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHostExclusionFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithHostExclusionFilter(`"10.0.0.0/8" in "/cidrs"`))
+		testOpts := getDefaultOptions()
+		testOpts.WithHostExclusionFilter = `"10.0.0.0/8" in "/cidrs"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHostPreferenceFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithHostPreferenceFilter(`"/labels/zone" == "a"`))
+		testOpts := getDefaultOptions()
+		testOpts.WithHostPreferenceFilter = `"/labels/zone" == "a"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPorts", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithPorts(map[string]uint32{"db": 5432, "metrics": 9187}))
+		testOpts := getDefaultOptions()
+		testOpts.WithPorts = map[string]uint32{"db": 5432, "metrics": 9187}
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithPermissions", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithPermissions([]perms.Permission{{ScopeId: "test1"}, {ScopeId: "test2"}}))
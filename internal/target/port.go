@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultTargetPortTableName = "target_port"
+
+	// MaxPortNameLength is the maximum length of the name of a target port.
+	MaxPortNameLength = 63
+	// MaxPort is the largest valid port number.
+	MaxPort = 65535
+)
+
+var portNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// A Port is a named port of a target. A client selects a named port when
+// authorizing a session instead of using the default port of the target.
+type Port struct {
+	*store.TargetPort
+	tableName string `gorm:"-"`
+}
+
+// Ensure Port implements interfaces
+var (
+	_ db.VetForWriter         = (*Port)(nil)
+	_ oplog.ReplayableMessage = (*Port)(nil)
+)
+
+// NewPort creates a new in memory named port for targetId. No options are
+// currently supported.
+func NewPort(ctx context.Context, targetId, name string, port uint32, _ ...Option) (*Port, error) {
+	const op = "target.NewPort"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	if err := ValidatePort(name, port); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, err.Error())
+	}
+	return &Port{
+		TargetPort: &store.TargetPort{
+			TargetId: targetId,
+			Name:     name,
+			Port:     port,
+		},
+	}, nil
+}
+
+// ValidatePort returns an error describing why name and port can not be used
+// as a named port of a target or nil if they can.
+func ValidatePort(name string, port uint32) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("missing port name")
+	case len(name) > MaxPortNameLength:
+		return fmt.Errorf("port name %q is longer than %d characters", name, MaxPortNameLength)
+	case !portNameRegexp.MatchString(name):
+		return fmt.Errorf("port name %q must start with a lowercase letter or digit and contain only lowercase letters, digits, '-' and '_'", name)
+	case port == 0 || port > MaxPort:
+		return fmt.Errorf("port %d of %q must be between 1 and %d", port, name, MaxPort)
+	}
+	return nil
+}
+
+// Clone creates a clone of the target port
+func (p *Port) Clone() any {
+	cp := proto.Clone(p.TargetPort)
+	return &Port{
+		TargetPort: cp.(*store.TargetPort),
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the target
+// port before it's written.
+func (p *Port) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "target.(Port).VetForWrite"
+	if opType == db.CreateOp {
+		if p.GetTargetId() == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+		}
+		if err := ValidatePort(p.GetName(), p.GetPort()); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, err.Error())
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (p *Port) TableName() string {
+	if p.tableName != "" {
+		return p.tableName
+	}
+	return DefaultTargetPortTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (p *Port) SetTableName(n string) {
+	p.tableName = n
+}
+
+func portsOplog(targetId string, op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{targetId},
+		"resource-type":      []string{"target port"},
+		"op-type":            []string{op.String()},
+	}
+}
+
+// newPorts returns the named ports of targetId ordered by name.
+func newPorts(ctx context.Context, targetId string, ports map[string]uint32) ([]any, error) {
+	const op = "target.newPorts"
+	names := make([]string, 0, len(ports))
+	for n := range ports {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	ps := make([]any, 0, len(names))
+	for _, n := range names {
+		p, err := NewPort(ctx, targetId, n, ports[n])
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
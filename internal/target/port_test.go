@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPort_New(t *testing.T) {
+	type args struct {
+		targetId string
+		name     string
+		port     uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *target.Port
+		wantErr errors.Code
+	}{
+		{
+			name: "no-target_id",
+			args: args{
+				name: "db",
+				port: 5432,
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "no-name",
+			args: args{
+				targetId: "targ_0000000",
+				port:     5432,
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "uppercase-name",
+			args: args{
+				targetId: "targ_0000000",
+				name:     "DB",
+				port:     5432,
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "name-starts-with-dash",
+			args: args{
+				targetId: "targ_0000000",
+				name:     "-db",
+				port:     5432,
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "name-too-long",
+			args: args{
+				targetId: "targ_0000000",
+				name:     strings.Repeat("a", target.MaxPortNameLength+1),
+				port:     5432,
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "zero-port",
+			args: args{
+				targetId: "targ_0000000",
+				name:     "db",
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "port-too-large",
+			args: args{
+				targetId: "targ_0000000",
+				name:     "db",
+				port:     target.MaxPort + 1,
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			args: args{
+				targetId: "targ_0000000",
+				name:     "db-primary_1",
+				port:     5432,
+			},
+			want: &target.Port{
+				TargetPort: &store.TargetPort{
+					TargetId: "targ_0000000",
+					Name:     "db-primary_1",
+					Port:     5432,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.NewPort(context.Background(), tt.args.targetId, tt.args.name, tt.args.port)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.EqualValues(tt.want, got)
+		})
+	}
+}
@@ -129,6 +129,7 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	target := allocTargetView()
 	target.PublicId = publicIdOrName
 	var address string
+	var ports map[string]uint32
 	var hostSources []HostSource
 	var credSources []CredentialSource
 	_, err := r.writer.DoTx(
@@ -161,6 +162,11 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 			if targetAddress != nil {
 				address = targetAddress.GetAddress()
 			}
+			targetPorts, err := fetchPorts(ctx, read, target.PublicId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			ports = targetPorts[target.PublicId]
 			return nil
 		},
 	)
@@ -174,6 +180,7 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(ctx, err, op)
 	}
+	subtype.SetPorts(ports)
 	return subtype, hostSources, credSources, nil
 }
 
@@ -267,6 +274,10 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	for _, addr := range foundAddresses {
 		addresses[addr.TargetId()] = addr.Address()
 	}
+	ports, err := fetchPorts(ctx, r.reader, targetIds...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 
 	targets := make([]Target, 0, len(foundTargets))
 	for _, t := range foundTargets {
@@ -284,6 +295,7 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
+		subtype.SetPorts(ports[t.GetPublicId()])
 		targets = append(targets, subtype)
 	}
 
@@ -426,6 +438,10 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 			return nil, nil, nil, errors.Wrap(ctx, err, op)
 		}
 	}
+	ports, err := newPorts(ctx, t.GetPublicId(), t.GetPorts())
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
 
 	oplogWrapper, err := r.kms.GetWrapper(ctx, target.GetProjectId(), kms.KeyPurposeOplog)
 	if err != nil {
@@ -461,6 +477,14 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 				msgs = append(msgs, &targetAddressOplogMsg)
 			}
 
+			if len(ports) > 0 {
+				var portOplogMsgs []*oplog.Message
+				if err := w.CreateItems(ctx, ports, db.NewOplogMsgs(&portOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target ports"))
+				}
+				msgs = append(msgs, portOplogMsgs...)
+			}
+
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -479,7 +503,8 @@ func (r *Repository) CreateTarget(ctx context.Context, target Target, opt ...Opt
 // be updated.  Fields will be set to NULL if the field is a zero value and
 // included in fieldMask. Name, Description, and WorkerFilter are the only
 // updatable fields. If no updatable fields are included in the fieldMaskPaths,
-// then an error is returned.
+// then an error is returned. Updating Ports replaces all named ports of the
+// target.
 func (r *Repository) UpdateTarget(ctx context.Context, target Target, version uint32, fieldMaskPaths []string, _ ...Option) (Target, []HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).UpdateTarget"
 	if target == nil {
@@ -503,6 +528,7 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	}
 
 	var addressEndpoint string
+	var updatePorts bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("name", f):
@@ -513,9 +539,21 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("workerfilter", f):
 		case strings.EqualFold("egressworkerfilter", f):
 		case strings.EqualFold("ingressworkerfilter", f):
+		case strings.EqualFold("hostselectionstrategy", f):
+		case strings.EqualFold("workerselectionstrategy", f):
+		case strings.EqualFold("workeraffinityfilter", f):
+		case strings.EqualFold("hostexclusionfilter", f):
+		case strings.EqualFold("hostpreferencefilter", f):
 		case strings.EqualFold("address", f):
 			target.SetAddress(strings.TrimSpace(target.GetAddress()))
 			addressEndpoint = target.GetAddress()
+		case strings.EqualFold("ports", f):
+			for name, port := range target.GetPorts() {
+				if err := ValidatePort(name, port); err != nil {
+					return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, err.Error())
+				}
+			}
+			updatePorts = true
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -524,20 +562,25 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
 		map[string]any{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
-			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
-			"Address":                target.GetAddress(),
+			"Name":                    target.GetName(),
+			"Description":             target.GetDescription(),
+			"DefaultPort":             target.GetDefaultPort(),
+			"SessionMaxSeconds":       target.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":  target.GetSessionConnectionLimit(),
+			"WorkerFilter":            target.GetWorkerFilter(),
+			"EgressWorkerFilter":      target.GetEgressWorkerFilter(),
+			"IngressWorkerFilter":     target.GetIngressWorkerFilter(),
+			"HostSelectionStrategy":   target.GetHostSelectionStrategy(),
+			"WorkerSelectionStrategy": target.GetWorkerSelectionStrategy(),
+			"WorkerAffinityFilter":    target.GetWorkerAffinityFilter(),
+			"HostExclusionFilter":     target.GetHostExclusionFilter(),
+			"HostPreferenceFilter":    target.GetHostPreferenceFilter(),
+			"Address":                 target.GetAddress(),
 		},
 		fieldMaskPaths,
 		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !updatePorts {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
 	}
 
@@ -563,10 +606,10 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		}
 	}
 
-	// If the Address field is the only present change, then we must still
-	// update the target's version because a target address is a child object
-	// of the target.
-	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress) {
+	// If the Address or Ports fields are the only present change, then we
+	// must still update the target's version because target addresses and
+	// ports are child objects of the target.
+	if (len(filteredDbMask) == 0 && len(filteredNullFields) == 0) && (updateAddress || deleteAddress || updatePorts) {
 		target.SetVersion(version + 1)
 		filteredDbMask = append(filteredDbMask, "Version")
 	}
@@ -646,6 +689,17 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 			if address != nil {
 				t.SetAddress(address.GetAddress())
 			}
+
+			if updatePorts && rowsUpdated > 0 {
+				if err := replacePorts(ctx, read, w, oplogWrapper, t.GetPublicId(), t.GetPorts()); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			ports, err := fetchPorts(ctx, read, t.GetPublicId())
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target ports"))
+			}
+			t.SetPorts(ports[t.GetPublicId()])
 			returnedTarget = t.Clone()
 
 			return nil
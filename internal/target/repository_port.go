@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// fetchPorts returns the named ports of the targets with targetIds keyed by
+// target id.
+func fetchPorts(ctx context.Context, r db.Reader, targetIds ...string) (map[string]map[string]uint32, error) {
+	const op = "target.fetchPorts"
+	if len(targetIds) == 0 {
+		return nil, nil
+	}
+	var ports []*Port
+	if err := r.SearchWhere(ctx, &ports, "target_id in (?)", []any{targetIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	byTarget := make(map[string]map[string]uint32, len(targetIds))
+	for _, p := range ports {
+		if byTarget[p.GetTargetId()] == nil {
+			byTarget[p.GetTargetId()] = make(map[string]uint32)
+		}
+		byTarget[p.GetTargetId()][p.GetName()] = p.GetPort()
+	}
+	return byTarget, nil
+}
+
+// replacePorts replaces the named ports of targetId with ports.
+func replacePorts(ctx context.Context, r db.Reader, w db.Writer, wrapper wrapping.Wrapper, targetId string, ports map[string]uint32) error {
+	const op = "target.replacePorts"
+	var current []*Port
+	if err := r.SearchWhere(ctx, &current, "target_id = ?", []any{targetId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(current) > 0 {
+		deletions := make([]any, 0, len(current))
+		for _, p := range current {
+			deletions = append(deletions, p)
+		}
+		if _, err := w.DeleteItems(ctx, deletions, db.WithOplog(wrapper, portsOplog(targetId, oplog.OpType_OP_TYPE_DELETE))); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target ports"))
+		}
+	}
+	additions, err := newPorts(ctx, targetId, ports)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(additions) > 0 {
+		if err := w.CreateItems(ctx, additions, db.WithOplog(wrapper, portsOplog(targetId, oplog.OpType_OP_TYPE_CREATE))); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target ports"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+// RestoreTarget brings the target with the public id of target to the state
+// described by target, hostSourceIds and credSources. If the target does not
+// exist it is created with its public id, otherwise its attributes, address,
+// named ports, host sources and credential sources are replaced. All of the
+// changes are made in a single transaction and written to the oplog as a
+// single entry. No options are currently supported.
+func (r *Repository) RestoreTarget(ctx context.Context, target Target, hostSourceIds []string, credSources CredentialSources, _ ...Option) (Target, []HostSource, []CredentialSource, error) {
+	const op = "target.(Repository).RestoreTarget"
+	switch {
+	case target == nil:
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	case target.GetPublicId() == "":
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing target public id")
+	case target.GetProjectId() == "":
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case target.GetName() == "":
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing name")
+	}
+	vet, ok := subtypeRegistry.vetFunc(target.GetType())
+	if !ok {
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported target type %s", target.GetType()))
+	}
+	if err := vet(ctx, target); err != nil {
+		return nil, nil, nil, err
+	}
+
+	t := target.Clone()
+	targetId := t.GetPublicId()
+	t.SetAddress(strings.TrimSpace(t.GetAddress()))
+	if t.GetAddress() != "" && len(hostSourceIds) > 0 {
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "unable to restore both a network address and host sources")
+	}
+	for name, port := range t.GetPorts() {
+		if err := ValidatePort(name, port); err != nil {
+			return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, err.Error())
+		}
+	}
+
+	// The type of a credential source can not change, so it is safe to look
+	// the wanted sources up outside of the write transaction.
+	var wantCredLibs []*CredentialLibrary
+	var wantStaticCreds []*StaticCredential
+	if len(credSources.BrokeredCredentialIds)+len(credSources.InjectedApplicationCredentialIds) > 0 {
+		var err error
+		wantCredLibs, wantStaticCreds, err = r.createSources(ctx, targetId, t.GetType(), credSources)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbw.BuildUpdatePaths(
+		map[string]any{
+			"Name":                    t.GetName(),
+			"Description":             t.GetDescription(),
+			"DefaultPort":             t.GetDefaultPort(),
+			"SessionMaxSeconds":       t.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":  t.GetSessionConnectionLimit(),
+			"WorkerFilter":            t.GetWorkerFilter(),
+			"EgressWorkerFilter":      t.GetEgressWorkerFilter(),
+			"IngressWorkerFilter":     t.GetIngressWorkerFilter(),
+			"HostSelectionStrategy":   t.GetHostSelectionStrategy(),
+			"WorkerSelectionStrategy": t.GetWorkerSelectionStrategy(),
+			"WorkerAffinityFilter":    t.GetWorkerAffinityFilter(),
+			"HostExclusionFilter":     t.GetHostExclusionFilter(),
+			"HostPreferenceFilter":    t.GetHostPreferenceFilter(),
+		},
+		[]string{
+			"Name", "Description", "DefaultPort", "SessionMaxSeconds", "SessionConnectionLimit",
+			"WorkerFilter", "EgressWorkerFilter", "IngressWorkerFilter", "HostSelectionStrategy",
+			"WorkerSelectionStrategy", "WorkerAffinityFilter", "HostExclusionFilter", "HostPreferenceFilter",
+		},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+	)
+	// The version is always updated because the address, ports and sources
+	// are child objects of the target.
+	dbMask = append(dbMask, "Version")
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, t.GetProjectId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedTarget Target
+	var hostSources []HostSource
+	var credentialSources []CredentialSource
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(read db.Reader, w db.Writer) error {
+			cur := allocTargetView()
+			cur.PublicId = targetId
+			exists := true
+			if err := read.LookupByPublicId(ctx, &cur); err != nil {
+				if !errors.IsNotFoundError(err) {
+					return errors.Wrap(ctx, err, op)
+				}
+				exists = false
+			}
+			if exists && cur.GetProjectId() != t.GetProjectId() {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("target is in project %s", cur.GetProjectId()))
+			}
+
+			targetTicket, err := w.GetTicket(ctx, t)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			msgs := make([]*oplog.Message, 0, 2)
+			var metadata oplog.Metadata
+			var targetOplogMsg oplog.Message
+			returnedTarget = t.Clone()
+			if !exists {
+				metadata = t.Oplog(oplog.OpType_OP_TYPE_CREATE)
+				if err := w.Create(ctx, returnedTarget, db.NewOplogMsg(&targetOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target"))
+				}
+			} else {
+				metadata = t.Oplog(oplog.OpType_OP_TYPE_UPDATE)
+				version := cur.GetVersion()
+				returnedTarget.SetVersion(version + 1)
+				rowsUpdated, err := w.Update(ctx, returnedTarget, dbMask, nullFields, db.NewOplogMsg(&targetOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update target"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated target and %d rows updated", rowsUpdated))
+				}
+			}
+			msgs = append(msgs, &targetOplogMsg)
+
+			var deletions, additions [][]any
+			var deleteAddress, addAddress []any
+			curAddress, err := fetchAddress(ctx, read, targetId)
+			if err != nil && !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target address"))
+			}
+			if curAddress != nil && curAddress.GetAddress() != t.GetAddress() {
+				deleteAddress = append(deleteAddress, curAddress)
+			}
+			if t.GetAddress() != "" && (curAddress == nil || curAddress.GetAddress() != t.GetAddress()) {
+				address, err := NewAddress(targetId, t.GetAddress())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				addAddress = append(addAddress, address)
+			}
+			deletions = append(deletions, deleteAddress)
+
+			var curPorts []*Port
+			if err := read.SearchWhere(ctx, &curPorts, "target_id = ?", []any{targetId}, db.WithLimit(-1)); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target ports"))
+			}
+			wantPorts := make(map[string]uint32, len(t.GetPorts()))
+			for name, port := range t.GetPorts() {
+				wantPorts[name] = port
+			}
+			var deletePorts []any
+			for _, p := range curPorts {
+				if port, ok := wantPorts[p.GetName()]; ok && port == p.GetPort() {
+					delete(wantPorts, p.GetName())
+					continue
+				}
+				deletePorts = append(deletePorts, p)
+			}
+			addPorts, err := newPorts(ctx, targetId, wantPorts)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			deletions = append(deletions, deletePorts)
+
+			curHostSources, err := fetchHostSources(ctx, read, targetId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target host sources"))
+			}
+			wantHostSources := make(map[string]bool, len(hostSourceIds))
+			for _, id := range hostSourceIds {
+				wantHostSources[id] = true
+			}
+			var deleteHostSources, addHostSources []any
+			for _, hs := range curHostSources {
+				if wantHostSources[hs.Id()] {
+					delete(wantHostSources, hs.Id())
+					continue
+				}
+				ths, err := NewTargetHostSet(targetId, hs.Id())
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				deleteHostSources = append(deleteHostSources, ths)
+			}
+			for _, id := range hostSourceIds {
+				if !wantHostSources[id] {
+					continue
+				}
+				delete(wantHostSources, id)
+				ths, err := NewTargetHostSet(targetId, id)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				addHostSources = append(addHostSources, ths)
+			}
+			deletions = append(deletions, deleteHostSources)
+
+			curCredSources, err := fetchCredentialSources(ctx, read, targetId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target credential sources"))
+			}
+			sourceKey := func(id, purpose string) string { return id + ":" + purpose }
+			wantCredSources := make(map[string]bool, len(wantCredLibs)+len(wantStaticCreds))
+			for _, l := range wantCredLibs {
+				wantCredSources[sourceKey(l.GetCredentialLibraryId(), l.GetCredentialPurpose())] = true
+			}
+			for _, c := range wantStaticCreds {
+				wantCredSources[sourceKey(c.GetCredentialId(), c.GetCredentialPurpose())] = true
+			}
+			var deleteCredLibs, deleteStaticCreds, addCredLibs, addStaticCreds []any
+			for _, cs := range curCredSources {
+				k := sourceKey(cs.Id(), string(cs.CredentialPurpose()))
+				if wantCredSources[k] {
+					delete(wantCredSources, k)
+					continue
+				}
+				switch cs.Type() {
+				case LibraryCredentialSourceType:
+					l, err := NewCredentialLibrary(targetId, cs.Id(), cs.CredentialPurpose())
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					deleteCredLibs = append(deleteCredLibs, l)
+				case StaticCredentialSourceType:
+					c, err := NewStaticCredential(targetId, cs.Id(), cs.CredentialPurpose())
+					if err != nil {
+						return errors.Wrap(ctx, err, op)
+					}
+					deleteStaticCreds = append(deleteStaticCreds, c)
+				}
+			}
+			for _, l := range wantCredLibs {
+				if wantCredSources[sourceKey(l.GetCredentialLibraryId(), l.GetCredentialPurpose())] {
+					addCredLibs = append(addCredLibs, l)
+				}
+			}
+			for _, c := range wantStaticCreds {
+				if wantCredSources[sourceKey(c.GetCredentialId(), c.GetCredentialPurpose())] {
+					addStaticCreds = append(addStaticCreds, c)
+				}
+			}
+			deletions = append(deletions, deleteCredLibs, deleteStaticCreds)
+			additions = append(additions, addAddress, addPorts, addHostSources, addCredLibs, addStaticCreds)
+
+			// Deletions are written first so the address and the host
+			// sources of the target are never both set.
+			var deleted, created bool
+			for _, items := range deletions {
+				if len(items) == 0 {
+					continue
+				}
+				itemMsgs := make([]*oplog.Message, 0, len(items))
+				rowsDeleted, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&itemMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target child objects"))
+				}
+				if rowsDeleted != len(items) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("target child objects deleted %d did not match request for %d", rowsDeleted, len(items)))
+				}
+				msgs = append(msgs, itemMsgs...)
+				deleted = true
+			}
+			if deleted {
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+			}
+			for _, items := range additions {
+				if len(items) == 0 {
+					continue
+				}
+				itemMsgs := make([]*oplog.Message, 0, len(items))
+				if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&itemMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target child objects"))
+				}
+				msgs = append(msgs, itemMsgs...)
+				created = true
+			}
+			if created {
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+			}
+
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+
+			ports, err := fetchPorts(ctx, read, targetId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch target ports"))
+			}
+			returnedTarget.SetPorts(ports[targetId])
+			if hostSources, err = fetchHostSources(ctx, read, targetId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if credentialSources, err = fetchCredentialSources(ctx, read, targetId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("target %s already exists in project %s", t.GetName(), t.GetProjectId()))
+		}
+		return nil, nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", targetId)))
+	}
+	return returnedTarget, hostSources, credentialSources, nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+Package selection provides the strategies used by a target to choose the host
+of a new session and to order the workers that can handle it.
+
+A target's host selection strategy is used when a session is authorized
+without a requested host id:
+
+  - random chooses one of the target's hosts at random.
+  - round-robin cycles through the target's hosts in a fixed order. The
+    position in the cycle is stored in the database so controllers share it.
+  - least-active-sessions chooses the host with the fewest pending or active
+    sessions.
+  - sticky-per-user uses rendezvous hashing of the user id to choose the same
+    host for a user for as long as that host remains available.
+
+A target's worker selection strategy orders the workers that passed the
+target's worker filters. The session is proxied by the first available worker
+in that order:
+
+  - random shuffles the workers.
+  - least-connections orders the workers by their active connection count.
+  - tag-affinity moves the workers matching the target's worker affinity
+    filter to the front and shuffles both groups.
+
+The zero value of either strategy is the random strategy.
+
+Before the host selection strategy is applied, ApplyHostRules narrows the
+target's hosts using the target's host exclusion and preference filters.
+Hosts matching the exclusion filter are never chosen. If any of the remaining
+hosts match the preference filter, the strategy chooses among those hosts
+only.
+*/
+package selection
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+)
+
+// randomHost chooses a random endpoint.
+type randomHost struct{}
+
+func (randomHost) SelectHost(ctx context.Context, _ Request, endpoints []*host.Endpoint) (*host.Endpoint, error) {
+	const op = "selection.(randomHost).SelectHost"
+	if len(endpoints) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no endpoints")
+	}
+	return endpoints[rand.Intn(len(endpoints))], nil
+}
+
+// roundRobinHost chooses the endpoints of a target in turn. The endpoints
+// are sorted so every controller cycles through them in the same order.
+type roundRobinHost struct {
+	repo *Repository
+}
+
+func (s *roundRobinHost) SelectHost(ctx context.Context, req Request, endpoints []*host.Endpoint) (*host.Endpoint, error) {
+	const op = "selection.(roundRobinHost).SelectHost"
+	if len(endpoints) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no endpoints")
+	}
+	count, err := s.repo.nextSelectionCount(ctx, req.TargetId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sorted := sortedEndpoints(endpoints)
+	return sorted[(count-1)%uint64(len(sorted))], nil
+}
+
+// leastActiveSessionsHost chooses the endpoint whose host has the fewest
+// pending or active sessions. Ties are broken randomly.
+type leastActiveSessionsHost struct {
+	repo *Repository
+}
+
+func (s *leastActiveSessionsHost) SelectHost(ctx context.Context, _ Request, endpoints []*host.Endpoint) (*host.Endpoint, error) {
+	const op = "selection.(leastActiveSessionsHost).SelectHost"
+	if len(endpoints) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no endpoints")
+	}
+	hostIds := make([]string, 0, len(endpoints))
+	for _, ep := range endpoints {
+		hostIds = append(hostIds, ep.HostId)
+	}
+	counts, err := s.repo.activeSessionCounts(ctx, hostIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return leastActive(endpoints, counts), nil
+}
+
+// leastActive returns the endpoint of endpoints with the lowest count in
+// counts, choosing randomly between endpoints with the same count.
+func leastActive(endpoints []*host.Endpoint, counts map[string]int64) *host.Endpoint {
+	var least []*host.Endpoint
+	var min int64
+	for _, ep := range endpoints {
+		c := counts[ep.HostId]
+		switch {
+		case len(least) == 0 || c < min:
+			least = []*host.Endpoint{ep}
+			min = c
+		case c == min:
+			least = append(least, ep)
+		}
+	}
+	return least[rand.Intn(len(least))]
+}
+
+// stickyPerUserHost chooses an endpoint using rendezvous hashing of the user
+// id and host id. A user is sent to the same host for as long as it is one
+// of the endpoints, and adding or removing a host only moves the users of
+// that host.
+type stickyPerUserHost struct{}
+
+func (stickyPerUserHost) SelectHost(ctx context.Context, req Request, endpoints []*host.Endpoint) (*host.Endpoint, error) {
+	const op = "selection.(stickyPerUserHost).SelectHost"
+	if len(endpoints) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no endpoints")
+	}
+	if req.UserId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	var chosen *host.Endpoint
+	var max uint64
+	for _, ep := range sortedEndpoints(endpoints) {
+		if w := rendezvousWeight(req.UserId, ep.HostId); chosen == nil || w > max {
+			chosen, max = ep, w
+		}
+	}
+	return chosen, nil
+}
+
+func rendezvousWeight(userId, hostId string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(userId))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(hostId))
+	return h.Sum64()
+}
+
+// sortedEndpoints returns a copy of endpoints sorted by host id and set id.
+func sortedEndpoints(endpoints []*host.Endpoint) []*host.Endpoint {
+	sorted := make([]*host.Endpoint, len(endpoints))
+	copy(sorted, endpoints)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].HostId != sorted[j].HostId {
+			return sorted[i].HostId < sorted[j].HostId
+		}
+		return sorted[i].SetId < sorted[j].SetId
+	})
+	return sorted
+}
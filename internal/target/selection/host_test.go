@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEndpoints(n int) []*host.Endpoint {
+	eps := make([]*host.Endpoint, 0, n)
+	for i := 0; i < n; i++ {
+		eps = append(eps, &host.Endpoint{
+			HostId:  fmt.Sprintf("hst_%d", i),
+			SetId:   "hsst_1",
+			Address: fmt.Sprintf("10.0.0.%d", i),
+		})
+	}
+	return eps
+}
+
+func TestRandomHost_SelectHost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	eps := testEndpoints(3)
+
+	got, err := randomHost{}.SelectHost(ctx, Request{}, eps)
+	require.NoError(t, err)
+	assert.Contains(t, eps, got)
+
+	got, err = randomHost{}.SelectHost(ctx, Request{}, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	assert.Nil(t, got)
+}
+
+func TestStickyPerUserHost_SelectHost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	eps := testEndpoints(5)
+	req := Request{UserId: "u_1234567890"}
+
+	first, err := stickyPerUserHost{}.SelectHost(ctx, req, eps)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		got, err := stickyPerUserHost{}.SelectHost(ctx, req, eps)
+		require.NoError(t, err)
+		assert.Equal(t, first, got)
+	}
+
+	// The order of the endpoints does not matter.
+	reversed := make([]*host.Endpoint, 0, len(eps))
+	for i := len(eps) - 1; i >= 0; i-- {
+		reversed = append(reversed, eps[i])
+	}
+	got, err := stickyPerUserHost{}.SelectHost(ctx, req, reversed)
+	require.NoError(t, err)
+	assert.Equal(t, first, got)
+
+	// Adding a host only moves the user if the new host is chosen.
+	more := append(testEndpoints(5), &host.Endpoint{HostId: "hst_new", SetId: "hsst_1"})
+	got, err = stickyPerUserHost{}.SelectHost(ctx, req, more)
+	require.NoError(t, err)
+	if got.HostId != "hst_new" {
+		assert.Equal(t, first.HostId, got.HostId)
+	}
+
+	// Removing a host other than the chosen host does not move the user.
+	var fewer []*host.Endpoint
+	for _, ep := range eps {
+		if ep.HostId != first.HostId {
+			fewer = append(fewer, ep)
+			break
+		}
+	}
+	fewer = append(fewer, first)
+	got, err = stickyPerUserHost{}.SelectHost(ctx, req, fewer)
+	require.NoError(t, err)
+	assert.Equal(t, first, got)
+
+	_, err = stickyPerUserHost{}.SelectHost(ctx, Request{}, eps)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = stickyPerUserHost{}.SelectHost(ctx, req, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
+
+func TestLeastActive(t *testing.T) {
+	t.Parallel()
+	eps := testEndpoints(3)
+
+	got := leastActive(eps, map[string]int64{"hst_0": 3, "hst_1": 1, "hst_2": 2})
+	assert.Equal(t, eps[1], got)
+
+	// Hosts without sessions are not in counts.
+	got = leastActive(eps, map[string]int64{"hst_0": 3, "hst_1": 1})
+	assert.Equal(t, eps[2], got)
+
+	got = leastActive(eps, map[string]int64{"hst_0": 1, "hst_1": 1, "hst_2": 2})
+	assert.Contains(t, eps[:2], got)
+}
+
+func TestSortedEndpoints(t *testing.T) {
+	t.Parallel()
+	eps := []*host.Endpoint{
+		{HostId: "hst_2", SetId: "hsst_1"},
+		{HostId: "hst_1", SetId: "hsst_2"},
+		{HostId: "hst_1", SetId: "hsst_1"},
+	}
+	got := sortedEndpoints(eps)
+	assert.Equal(t, []*host.Endpoint{eps[2], eps[1], eps[0]}, got)
+	// The input is not modified.
+	assert.Equal(t, "hst_2", eps[0].HostId)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+const (
+	nextSelectionCountQuery = `
+insert into target_host_selection_state
+	(target_id, selection_count)
+values
+	(@target_id, 1)
+on conflict (target_id) do update
+	set selection_count = target_host_selection_state.selection_count + 1
+returning selection_count;
+`
+
+	activeSessionCountsQuery = `
+select
+	s.host_id,
+	count(*) as count
+from
+	session s,
+	session_state ss
+where
+	s.public_id = ss.session_id and
+	ss.end_time is null and
+	ss.state in ('pending', 'active') and
+	s.host_id in (?)
+group by s.host_id;
+`
+)
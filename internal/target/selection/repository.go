@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// RepositoryFactory returns a new Repository.
+type RepositoryFactory func() (*Repository, error)
+
+// A Repository stores and retrieves the state of the selection strategies
+// which is shared by all controllers.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer) (*Repository, error) {
+	const op = "selection.NewRepository"
+	switch {
+	case util.IsNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil reader")
+	case util.IsNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil writer")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+	}, nil
+}
+
+// HostSelector returns the HostSelector for strategy. An empty strategy
+// returns the random strategy.
+func (r *Repository) HostSelector(ctx context.Context, strategy HostStrategy) (HostSelector, error) {
+	const op = "selection.(Repository).HostSelector"
+	switch strategy {
+	case "", RandomHost:
+		return randomHost{}, nil
+	case RoundRobinHost:
+		return &roundRobinHost{repo: r}, nil
+	case LeastActiveSessionsHost:
+		return &leastActiveSessionsHost{repo: r}, nil
+	case StickyPerUserHost:
+		return stickyPerUserHost{}, nil
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown host selection strategy %q", strategy))
+	}
+}
+
+// WorkerSelector returns the WorkerSelector for strategy. An empty strategy
+// returns the random strategy.
+func (r *Repository) WorkerSelector(ctx context.Context, strategy WorkerStrategy) (WorkerSelector, error) {
+	const op = "selection.(Repository).WorkerSelector"
+	switch strategy {
+	case "", RandomWorker:
+		return randomWorker{}, nil
+	case LeastConnectionsWorker:
+		return leastConnectionsWorker{}, nil
+	case TagAffinityWorker:
+		return tagAffinityWorker{}, nil
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown worker selection strategy %q", strategy))
+	}
+}
+
+// nextSelectionCount increments and returns the number of hosts chosen for
+// targetId by the round-robin strategy.
+func (r *Repository) nextSelectionCount(ctx context.Context, targetId string) (uint64, error) {
+	const op = "selection.(Repository).nextSelectionCount"
+	if targetId == "" {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+
+	var count uint64
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rows, err := w.Query(ctx, nextSelectionCountQuery, []any{sql.Named("target_id", targetId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			defer rows.Close()
+			if !rows.Next() {
+				if err := rows.Err(); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				return errors.New(ctx, errors.NotSpecificIntegrity, op, "no selection count returned")
+			}
+			if err := rows.Scan(&count); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+type hostSessionCount struct {
+	HostId string
+	Count  int64
+}
+
+// activeSessionCounts returns the number of pending and active sessions of
+// each of hostIds. Hosts without sessions are not included.
+func (r *Repository) activeSessionCounts(ctx context.Context, hostIds []string) (map[string]int64, error) {
+	const op = "selection.(Repository).activeSessionCounts"
+	counts := make(map[string]int64, len(hostIds))
+	if len(hostIds) == 0 {
+		return counts, nil
+	}
+	rows, err := r.reader.Query(ctx, activeSessionCountsQuery, []any{hostIds})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c hostSessionCount
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		counts[c.HostId] = c.Count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+
+	got, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+	assert.Equal(t, &Repository{reader: rw, writer: rw}, got)
+
+	got, err = NewRepository(ctx, nil, rw)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	assert.Nil(t, got)
+
+	got, err = NewRepository(ctx, rw, nil)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	assert.Nil(t, got)
+}
+
+func TestRepository_Selectors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	for _, s := range append(HostStrategies, "") {
+		hs, err := repo.HostSelector(ctx, s)
+		assert.NoError(t, err)
+		assert.NotNil(t, hs)
+	}
+	hs, err := repo.HostSelector(ctx, "fastest")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	assert.Nil(t, hs)
+
+	for _, s := range append(WorkerStrategies, "") {
+		ws, err := repo.WorkerSelector(ctx, s)
+		assert.NoError(t, err)
+		assert.NotNil(t, ws)
+	}
+	ws, err := repo.WorkerSelector(ctx, "fastest")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	assert.Nil(t, ws)
+}
+
+func TestRoundRobinHost_SelectHost(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "round robin")
+
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+	hs, err := repo.HostSelector(ctx, RoundRobinHost)
+	require.NoError(t, err)
+
+	endpoints := []*host.Endpoint{
+		{HostId: "hst_3", SetId: "hsst_1"},
+		{HostId: "hst_1", SetId: "hsst_1"},
+		{HostId: "hst_2", SetId: "hsst_1"},
+	}
+	req := Request{TargetId: tar.GetPublicId()}
+	var got []string
+	for i := 0; i < 6; i++ {
+		ep, err := hs.SelectHost(ctx, req, endpoints)
+		require.NoError(t, err)
+		got = append(got, ep.HostId)
+	}
+	assert.Equal(t, []string{"hst_1", "hst_2", "hst_3", "hst_1", "hst_2", "hst_3"}, got)
+
+	// A new selector shares the state stored in the database.
+	repo2, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+	hs2, err := repo2.HostSelector(ctx, RoundRobinHost)
+	require.NoError(t, err)
+	ep, err := hs2.SelectHost(ctx, req, endpoints)
+	require.NoError(t, err)
+	assert.Equal(t, "hst_1", ep.HostId)
+
+	_, err = hs.SelectHost(ctx, Request{}, endpoints)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
+
+func TestRepository_activeSessionCounts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	repo, err := NewRepository(ctx, rw, rw)
+	require.NoError(t, err)
+
+	pending := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	active := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	session.TestState(t, conn, active.PublicId, session.StatusActive)
+	terminated := session.TestDefaultSession(t, conn, wrapper, iamRepo)
+	session.TestState(t, conn, terminated.PublicId, session.StatusTerminated)
+
+	got, err := repo.activeSessionCounts(ctx, []string{pending.HostId, active.HostId, terminated.HostId, "hst_unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		pending.HostId: 1,
+		active.HostId:  1,
+	}, got)
+
+	got, err = repo.activeSessionCounts(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
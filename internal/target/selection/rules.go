@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// ApplyHostRules returns the endpoints which remain eligible after applying
+// the host exclusion and preference filters of req. Endpoints matching the
+// exclusion filter are removed. If any of the remaining endpoints match the
+// preference filter, only those endpoints are returned, otherwise all of the
+// remaining endpoints are. The order of endpoints is preserved.
+//
+// The filters are evaluated against each endpoint, for example:
+//
+//	"10.0.0.0/8" in "/cidrs"
+//	"/external_id" matches "^i-0"
+//	"/labels/zone" == "us-east-1a"
+//
+// cidrs contains every network containing the address of the endpoint, so a
+// network must be written in its canonical form, with the host bits cleared.
+// It is empty if the address is not an IP address. A filter selecting a
+// label the host does not have does not match.
+func ApplyHostRules(ctx context.Context, req Request, endpoints []*host.Endpoint) ([]*host.Endpoint, error) {
+	const op = "selection.ApplyHostRules"
+	ret := endpoints
+	if req.HostExclusionFilter != "" {
+		eval, err := bexpr.CreateEvaluator(req.HostExclusionFilter)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse host exclusion filter: %s", err))
+		}
+		ret = make([]*host.Endpoint, 0, len(endpoints))
+		for _, ep := range endpoints {
+			ok, err := matchesEndpoint(eval, ep)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("host exclusion filter evaluation failed"))
+			}
+			if !ok {
+				ret = append(ret, ep)
+			}
+		}
+	}
+	if req.HostPreferenceFilter != "" {
+		eval, err := bexpr.CreateEvaluator(req.HostPreferenceFilter)
+		if err != nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse host preference filter: %s", err))
+		}
+		var preferred []*host.Endpoint
+		for _, ep := range ret {
+			ok, err := matchesEndpoint(eval, ep)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("host preference filter evaluation failed"))
+			}
+			if ok {
+				preferred = append(preferred, ep)
+			}
+		}
+		if len(preferred) > 0 {
+			ret = preferred
+		}
+	}
+	return ret, nil
+}
+
+func matchesEndpoint(eval *bexpr.Evaluator, ep *host.Endpoint) (bool, error) {
+	labels := ep.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	filterInput := map[string]any{
+		"id":          ep.HostId,
+		"set_id":      ep.SetId,
+		"address":     ep.Address,
+		"cidrs":       cidrs(ep.Address),
+		"name":        ep.Name,
+		"external_id": ep.ExternalId,
+		"labels":      labels,
+	}
+	ok, err := eval.Evaluate(filterInput)
+	if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
+		return false, err
+	}
+	return ok, nil
+}
+
+// cidrs returns every network containing address, from the network of the
+// address itself to the network containing all addresses. It returns an
+// empty slice if address is not an IP address.
+func cidrs(address string) []string {
+	if h, _, err := net.SplitHostPort(address); err == nil {
+		address = h
+	}
+	ip, err := netip.ParseAddr(address)
+	if err != nil {
+		return []string{}
+	}
+	ip = ip.WithZone("").Unmap()
+	ret := make([]string, 0, ip.BitLen()+1)
+	for bits := ip.BitLen(); bits >= 0; bits-- {
+		ret = append(ret, netip.PrefixFrom(ip, bits).Masked().String())
+	}
+	return ret
+}
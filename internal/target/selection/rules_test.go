@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyHostRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	eps := []*host.Endpoint{
+		{HostId: "hst_1", SetId: "hsst_1", Address: "10.0.0.1", Name: "web-1", Labels: map[string]string{"zone": "us-east-1a"}},
+		{HostId: "hst_2", SetId: "hsst_1", Address: "10.1.0.2", Name: "web-2", Labels: map[string]string{"zone": "us-east-1b"}},
+		{HostId: "hplg_3", SetId: "hsplg_1", Address: "192.168.0.3", Name: "web-3", ExternalId: "i-0123"},
+		{HostId: "hplg_4", SetId: "hsplg_1", Address: "web-4.example.com", Name: "web-4", ExternalId: "i-0456"},
+	}
+
+	tests := []struct {
+		name       string
+		exclusion  string
+		preference string
+		want       []string
+		wantErr    errors.Code
+	}{
+		{
+			name: "no-rules",
+			want: []string{"hst_1", "hst_2", "hplg_3", "hplg_4"},
+		},
+		{
+			name:      "exclude-cidr",
+			exclusion: `"10.0.0.0/16" in "/cidrs"`,
+			want:      []string{"hst_2", "hplg_3", "hplg_4"},
+		},
+		{
+			name:      "exclude-wide-cidr",
+			exclusion: `"10.0.0.0/8" in "/cidrs"`,
+			want:      []string{"hplg_3", "hplg_4"},
+		},
+		{
+			name:      "exclude-set",
+			exclusion: `"/set_id" == "hsst_1"`,
+			want:      []string{"hplg_3", "hplg_4"},
+		},
+		{
+			name:       "prefer-external-id",
+			preference: `"/external_id" matches "^i-04"`,
+			want:       []string{"hplg_4"},
+		},
+		{
+			name:       "prefer-label",
+			preference: `"/labels/zone" == "us-east-1a"`,
+			want:       []string{"hst_1"},
+		},
+		{
+			name:       "no-preferred-hosts",
+			preference: `"/labels/zone" == "eu-west-1a"`,
+			want:       []string{"hst_1", "hst_2", "hplg_3", "hplg_4"},
+		},
+		{
+			name:       "preferred-host-excluded",
+			exclusion:  `"/name" == "web-1"`,
+			preference: `"/labels/zone" == "us-east-1a"`,
+			want:       []string{"hst_2", "hplg_3", "hplg_4"},
+		},
+		{
+			name:      "exclude-all",
+			exclusion: `"/id" != ""`,
+			want:      []string{},
+		},
+		{
+			name:      "invalid-exclusion",
+			exclusion: `"/name" ==`,
+			wantErr:   errors.InvalidParameter,
+		},
+		{
+			name:       "invalid-preference",
+			preference: `"/name" ==`,
+			wantErr:    errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ApplyHostRules(ctx, Request{
+				HostExclusionFilter:  tt.exclusion,
+				HostPreferenceFilter: tt.preference,
+			}, eps)
+			if tt.wantErr != 0 {
+				assert.Truef(t, errors.Match(errors.T(tt.wantErr), err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			ids := []string{}
+			for _, ep := range got {
+				ids = append(ids, ep.HostId)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestCidrs(t *testing.T) {
+	t.Parallel()
+	got := cidrs("10.1.2.3")
+	assert.Len(t, got, 33)
+	assert.Equal(t, "10.1.2.3/32", got[0])
+	assert.Contains(t, got, "10.1.2.0/24")
+	assert.Contains(t, got, "10.0.0.0/8")
+	assert.Equal(t, "0.0.0.0/0", got[32])
+
+	got = cidrs("[fd00::1]:22")
+	assert.Len(t, got, 129)
+	assert.Contains(t, got, "fd00::/16")
+
+	assert.Contains(t, cidrs("::ffff:10.0.0.1"), "10.0.0.0/8")
+	assert.Empty(t, cidrs("web.example.com"))
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/server"
+)
+
+// A HostStrategy is the name of a strategy used to choose the host of a
+// session.
+type HostStrategy string
+
+const (
+	RandomHost              HostStrategy = "random"
+	RoundRobinHost          HostStrategy = "round-robin"
+	LeastActiveSessionsHost HostStrategy = "least-active-sessions"
+	StickyPerUserHost       HostStrategy = "sticky-per-user"
+)
+
+// HostStrategies contains all of the supported host selection strategies.
+var HostStrategies = []HostStrategy{
+	RandomHost,
+	RoundRobinHost,
+	LeastActiveSessionsHost,
+	StickyPerUserHost,
+}
+
+// IsValid reports if s is a supported host selection strategy.
+func (s HostStrategy) IsValid() bool {
+	for _, v := range HostStrategies {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// A WorkerStrategy is the name of a strategy used to order the workers that
+// can handle a session.
+type WorkerStrategy string
+
+const (
+	RandomWorker           WorkerStrategy = "random"
+	LeastConnectionsWorker WorkerStrategy = "least-connections"
+	TagAffinityWorker      WorkerStrategy = "tag-affinity"
+)
+
+// WorkerStrategies contains all of the supported worker selection
+// strategies.
+var WorkerStrategies = []WorkerStrategy{
+	RandomWorker,
+	LeastConnectionsWorker,
+	TagAffinityWorker,
+}
+
+// IsValid reports if s is a supported worker selection strategy.
+func (s WorkerStrategy) IsValid() bool {
+	for _, v := range WorkerStrategies {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Request contains the details of the session being authorized that are
+// used by the selection strategies.
+type Request struct {
+	// TargetId is the public id of the target of the session.
+	TargetId string
+
+	// UserId is the public id of the user requesting the session.
+	UserId string
+
+	// WorkerAffinityFilter is the target's boolean expression identifying
+	// the workers preferred by the tag-affinity strategy.
+	WorkerAffinityFilter string
+
+	// HostExclusionFilter is the target's boolean expression identifying
+	// the hosts which must not be chosen. See ApplyHostRules.
+	HostExclusionFilter string
+
+	// HostPreferenceFilter is the target's boolean expression identifying
+	// the hosts which are chosen in preference to the others. See
+	// ApplyHostRules.
+	HostPreferenceFilter string
+}
+
+// A HostSelector chooses the endpoint of a session.
+type HostSelector interface {
+	// SelectHost returns one of endpoints. endpoints must not be empty.
+	SelectHost(ctx context.Context, req Request, endpoints []*host.Endpoint) (*host.Endpoint, error)
+}
+
+// A WorkerSelector orders the workers that can handle a session.
+type WorkerSelector interface {
+	// SelectWorkers returns workers ordered from the most to the least
+	// preferred worker. The returned slice contains the same workers as
+	// workers.
+	SelectWorkers(ctx context.Context, req Request, workers []*server.Worker) ([]*server.Worker, error)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
+)
+
+// randomWorker shuffles the workers.
+type randomWorker struct{}
+
+func (randomWorker) SelectWorkers(_ context.Context, _ Request, workers []*server.Worker) ([]*server.Worker, error) {
+	return shuffled(workers), nil
+}
+
+// leastConnectionsWorker orders the workers by their active connection
+// count, fewest first. Workers with the same count are shuffled.
+type leastConnectionsWorker struct{}
+
+func (leastConnectionsWorker) SelectWorkers(_ context.Context, _ Request, workers []*server.Worker) ([]*server.Worker, error) {
+	ret := shuffled(workers)
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].ActiveConnectionCount() < ret[j].ActiveConnectionCount()
+	})
+	return ret, nil
+}
+
+// tagAffinityWorker orders the workers matching the worker affinity filter
+// of the request before the workers which do not. Both groups are shuffled.
+// Without a worker affinity filter it behaves like randomWorker.
+type tagAffinityWorker struct{}
+
+func (tagAffinityWorker) SelectWorkers(ctx context.Context, req Request, workers []*server.Worker) ([]*server.Worker, error) {
+	const op = "selection.(tagAffinityWorker).SelectWorkers"
+	if req.WorkerAffinityFilter == "" {
+		return shuffled(workers), nil
+	}
+	eval, err := bexpr.CreateEvaluator(req.WorkerAffinityFilter)
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to parse worker affinity filter: %s", err))
+	}
+	preferred := make([]*server.Worker, 0, len(workers))
+	var others []*server.Worker
+	for _, w := range shuffled(workers) {
+		filterInput := map[string]any{
+			"name": w.GetName(),
+			"tags": w.CanonicalTags(),
+		}
+		ok, err := eval.Evaluate(filterInput)
+		if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("worker affinity filter evaluation failed"))
+		}
+		if ok {
+			preferred = append(preferred, w)
+			continue
+		}
+		others = append(others, w)
+	}
+	return append(preferred, others...), nil
+}
+
+// shuffled returns a shuffled copy of workers.
+func shuffled(workers []*server.Worker) []*server.Worker {
+	ret := make([]*server.Worker, len(workers))
+	copy(ret, workers)
+	rand.Shuffle(len(ret), func(i, j int) {
+		ret[i], ret[j] = ret[j], ret[i]
+	})
+	return ret
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package selection
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWorkers(n int) []*server.Worker {
+	workers := make([]*server.Worker, 0, n)
+	for i := 0; i < n; i++ {
+		workers = append(workers, server.NewWorker("global", server.WithName(fmt.Sprintf("w%d", i))))
+	}
+	return workers
+}
+
+func TestRandomWorker_SelectWorkers(t *testing.T) {
+	t.Parallel()
+	workers := testWorkers(5)
+	got, err := randomWorker{}.SelectWorkers(context.Background(), Request{}, workers)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, workers, got)
+}
+
+func TestLeastConnectionsWorker_SelectWorkers(t *testing.T) {
+	t.Parallel()
+	workers := testWorkers(5)
+	got, err := leastConnectionsWorker{}.SelectWorkers(context.Background(), Request{}, workers)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, workers, got)
+	for i := 1; i < len(got); i++ {
+		assert.LessOrEqual(t, got[i-1].ActiveConnectionCount(), got[i].ActiveConnectionCount())
+	}
+}
+
+func TestTagAffinityWorker_SelectWorkers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	workers := testWorkers(5)
+
+	tests := []struct {
+		name          string
+		filter        string
+		wantPreferred []string
+		wantErr       errors.Code
+	}{
+		{
+			name: "no-filter",
+		},
+		{
+			name:          "one-preferred",
+			filter:        `"/name" == "w3"`,
+			wantPreferred: []string{"w3"},
+		},
+		{
+			name:          "two-preferred",
+			filter:        `"/name" == "w1" or "/name" == "w4"`,
+			wantPreferred: []string{"w1", "w4"},
+		},
+		{
+			name:   "missing-tag",
+			filter: `"us-east" in "/tags/region"`,
+		},
+		{
+			name:    "invalid-filter",
+			filter:  `"/name" ==`,
+			wantErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := tagAffinityWorker{}.SelectWorkers(ctx, Request{WorkerAffinityFilter: tt.filter}, workers)
+			if tt.wantErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErr), err), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.ElementsMatch(workers, got)
+			var gotPreferred []string
+			for _, w := range got[:len(tt.wantPreferred)] {
+				gotPreferred = append(gotPreferred, w.GetName())
+			}
+			assert.ElementsMatch(tt.wantPreferred, gotPreferred)
+		})
+	}
+}
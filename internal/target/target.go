@@ -32,7 +32,13 @@ type Target interface {
 	GetWorkerFilter() string
 	GetEgressWorkerFilter() string
 	GetIngressWorkerFilter() string
+	GetHostSelectionStrategy() string
+	GetWorkerSelectionStrategy() string
+	GetWorkerAffinityFilter() string
+	GetHostExclusionFilter() string
+	GetHostPreferenceFilter() string
 	GetAddress() string
+	GetPorts() map[string]uint32
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetProjectId(string)
@@ -47,7 +53,13 @@ type Target interface {
 	SetWorkerFilter(string)
 	SetEgressWorkerFilter(string)
 	SetIngressWorkerFilter(string)
+	SetHostSelectionStrategy(string)
+	SetWorkerSelectionStrategy(string)
+	SetWorkerAffinityFilter(string)
+	SetHostExclusionFilter(string)
+	SetHostPreferenceFilter(string)
 	SetAddress(string)
+	SetPorts(map[string]uint32)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
@@ -146,6 +158,11 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetWorkerFilter(t.WorkerFilter)
 	tt.SetEgressWorkerFilter(t.EgressWorkerFilter)
 	tt.SetIngressWorkerFilter(t.IngressWorkerFilter)
+	tt.SetHostSelectionStrategy(t.HostSelectionStrategy)
+	tt.SetWorkerSelectionStrategy(t.WorkerSelectionStrategy)
+	tt.SetWorkerAffinityFilter(t.WorkerAffinityFilter)
+	tt.SetHostExclusionFilter(t.HostExclusionFilter)
+	tt.SetHostPreferenceFilter(t.HostPreferenceFilter)
 	tt.SetAddress(address)
 	return tt, nil
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/target/targettest/store"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -30,8 +31,9 @@ const (
 // Target is a target.Target used for tests.
 type Target struct {
 	*store.Target
-	Address   string `gorm:"-"`
-	tableName string `gorm:"-"`
+	Address   string            `gorm:"-"`
+	Ports     map[string]uint32 `gorm:"-"`
+	tableName string            `gorm:"-"`
 }
 
 var (
@@ -133,10 +135,15 @@ func (t *Target) GetAddress() string {
 	return t.Address
 }
 
+func (t *Target) GetPorts() map[string]uint32 {
+	return t.Ports
+}
+
 func (t *Target) Clone() target.Target {
 	cp := proto.Clone(t.Target)
 	return &Target{
 		Address: t.Address,
+		Ports:   maps.Clone(t.Ports),
 		Target:  cp.(*store.Target),
 	}
 }
@@ -194,10 +201,34 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetHostSelectionStrategy(strategy string) {
+	t.HostSelectionStrategy = strategy
+}
+
+func (t *Target) SetWorkerSelectionStrategy(strategy string) {
+	t.WorkerSelectionStrategy = strategy
+}
+
+func (t *Target) SetWorkerAffinityFilter(filter string) {
+	t.WorkerAffinityFilter = filter
+}
+
+func (t *Target) SetHostExclusionFilter(filter string) {
+	t.HostExclusionFilter = filter
+}
+
+func (t *Target) SetHostPreferenceFilter(filter string) {
+	t.HostPreferenceFilter = filter
+}
+
 func (t *Target) SetAddress(a string) {
 	t.Address = a
 }
 
+func (t *Target) SetPorts(p map[string]uint32) {
+	t.Ports = p
+}
+
 func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
 	return oplog.Metadata{
 		"resource-public-id": []string{t.PublicId},
@@ -273,16 +304,22 @@ func New(projectId string, opt ...target.Option) (target.Target, error) {
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:               projectId,
+			Name:                    opts.WithName,
+			Description:             opts.WithDescription,
+			DefaultPort:             opts.WithDefaultPort,
+			SessionConnectionLimit:  opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:       opts.WithSessionMaxSeconds,
+			WorkerFilter:            opts.WithWorkerFilter,
+			EgressWorkerFilter:      opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:     opts.WithIngressWorkerFilter,
+			HostSelectionStrategy:   opts.WithHostSelectionStrategy,
+			WorkerSelectionStrategy: opts.WithWorkerSelectionStrategy,
+			WorkerAffinityFilter:    opts.WithWorkerAffinityFilter,
+			HostExclusionFilter:     opts.WithHostExclusionFilter,
+			HostPreferenceFilter:    opts.WithHostPreferenceFilter,
 		},
+		Ports: opts.WithPorts,
 	}
 	return t, nil
 }
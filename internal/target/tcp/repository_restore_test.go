@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tcp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RestoreTarget(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+
+	ctx := context.Background()
+	repo, err := target.NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	cats := static.TestCatalogs(t, conn, proj.PublicId, 1)
+	hsets := static.TestSets(t, conn, cats[0].GetPublicId(), 3)
+
+	hostSourceIds := func(sources []target.HostSource) []string {
+		var ids []string
+		for _, s := range sources {
+			ids = append(ids, s.Id())
+		}
+		return ids
+	}
+
+	t.Run("recreate", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		id, err := db.NewPublicId(tcp.TargetPrefix)
+		require.NoError(err)
+		want, err := target.New(ctx, tcp.Subtype, proj.PublicId,
+			target.WithName("recreate"),
+			target.WithDefaultPort(22),
+			target.WithPorts(map[string]uint32{"http": 80}))
+		require.NoError(err)
+		require.NoError(want.SetPublicId(ctx, id))
+
+		got, gotHostSources, gotCredSources, err := repo.RestoreTarget(ctx, want, []string{hsets[0].PublicId}, target.CredentialSources{})
+		require.NoError(err)
+		assert.Equal(id, got.GetPublicId())
+		assert.Equal("recreate", got.GetName())
+		assert.Equal(map[string]uint32{"http": 80}, got.GetPorts())
+		assert.Equal([]string{hsets[0].PublicId}, hostSourceIds(gotHostSources))
+		assert.Empty(gotCredSources)
+		assert.NoError(db.TestVerifyOplog(t, rw, id, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := tcp.TestTarget(ctx, t, conn, proj.PublicId, "replace",
+			target.WithHostSources([]string{hsets[0].PublicId, hsets[1].PublicId}))
+		_, err := rw.Exec(ctx, "insert into target_port (target_id, name, port) values (?, 'http', 80), (?, 'ssh', 22)",
+			[]any{cur.GetPublicId(), cur.GetPublicId()})
+		require.NoError(err)
+
+		want := cur.Clone()
+		want.SetName("restored")
+		want.SetDescription("restored description")
+		want.SetPorts(map[string]uint32{"http": 8080})
+		got, gotHostSources, _, err := repo.RestoreTarget(ctx, want, []string{hsets[1].PublicId, hsets[2].PublicId}, target.CredentialSources{})
+		require.NoError(err)
+		assert.Equal("restored", got.GetName())
+		assert.Equal("restored description", got.GetDescription())
+		assert.Equal(cur.GetVersion()+1, got.GetVersion())
+		assert.Equal(map[string]uint32{"http": 8080}, got.GetPorts())
+		assert.ElementsMatch([]string{hsets[1].PublicId, hsets[2].PublicId}, hostSourceIds(gotHostSources))
+		assert.NoError(db.TestVerifyOplog(t, rw, cur.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+
+		found, foundHostSources, _, err := repo.LookupTarget(ctx, cur.GetPublicId())
+		require.NoError(err)
+		assert.Equal(got.GetVersion(), found.GetVersion())
+		assert.Equal(map[string]uint32{"http": 8080}, found.GetPorts())
+		assert.ElementsMatch([]string{hsets[1].PublicId, hsets[2].PublicId}, hostSourceIds(foundHostSources))
+	})
+
+	t.Run("failure-leaves-target-unchanged", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := tcp.TestTarget(ctx, t, conn, proj.PublicId, "unchanged",
+			target.WithHostSources([]string{hsets[0].PublicId}))
+
+		want := cur.Clone()
+		want.SetName("changed")
+		_, _, _, err := repo.RestoreTarget(ctx, want, []string{hsets[0].PublicId, "hsst_doesnotexist"}, target.CredentialSources{})
+		require.Error(err)
+
+		found, foundHostSources, _, err := repo.LookupTarget(ctx, cur.GetPublicId())
+		require.NoError(err)
+		assert.Equal("unchanged", found.GetName())
+		assert.Equal(cur.GetVersion(), found.GetVersion())
+		assert.Equal([]string{hsets[0].PublicId}, hostSourceIds(foundHostSources))
+	})
+
+	t.Run("address-and-host-sources", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cur := tcp.TestTarget(ctx, t, conn, proj.PublicId, "address")
+		want := cur.Clone()
+		want.SetAddress("8.8.8.8")
+		_, _, _, err := repo.RestoreTarget(ctx, want, []string{hsets[0].PublicId}, target.CredentialSources{})
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+}
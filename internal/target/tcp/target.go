@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp/store"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -31,8 +32,10 @@ const (
 type Target struct {
 	*store.Target
 	// Network address assigned to the Target.
-	Address   string `json:"address,omitempty" gorm:"-"`
-	tableName string `gorm:"-"`
+	Address string `json:"address,omitempty" gorm:"-"`
+	// Named ports of the Target.
+	Ports     map[string]uint32 `json:"ports,omitempty" gorm:"-"`
+	tableName string            `gorm:"-"`
 }
 
 // Ensure Target implements interfaces
@@ -52,17 +55,23 @@ func (h targetHooks) NewTarget(projectId string, opt ...target.Option) (target.T
 	}
 	t := &Target{
 		Target: &store.Target{
-			ProjectId:              projectId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
-			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
-			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ProjectId:               projectId,
+			Name:                    opts.WithName,
+			Description:             opts.WithDescription,
+			DefaultPort:             opts.WithDefaultPort,
+			SessionConnectionLimit:  opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:       opts.WithSessionMaxSeconds,
+			WorkerFilter:            opts.WithWorkerFilter,
+			EgressWorkerFilter:      opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:     opts.WithIngressWorkerFilter,
+			HostSelectionStrategy:   opts.WithHostSelectionStrategy,
+			WorkerSelectionStrategy: opts.WithWorkerSelectionStrategy,
+			WorkerAffinityFilter:    opts.WithWorkerAffinityFilter,
+			HostExclusionFilter:     opts.WithHostExclusionFilter,
+			HostPreferenceFilter:    opts.WithHostPreferenceFilter,
 		},
 		Address: opts.WithAddress,
+		Ports:   opts.WithPorts,
 	}
 	return t, nil
 }
@@ -80,6 +89,7 @@ func (t *Target) Clone() target.Target {
 	return &Target{
 		Target:  cp.(*store.Target),
 		Address: t.Address,
+		Ports:   maps.Clone(t.Ports),
 	}
 }
 
@@ -135,6 +145,10 @@ func (t *Target) GetAddress() string {
 	return t.Address
 }
 
+func (t *Target) GetPorts() map[string]uint32 {
+	return t.Ports
+}
+
 func (t *Target) SetPublicId(ctx context.Context, publicId string) error {
 	const op = "tcp.(Target).SetPublicId"
 	if !strings.HasPrefix(publicId, TargetPrefix+"_") {
@@ -193,6 +207,30 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetHostSelectionStrategy(strategy string) {
+	t.HostSelectionStrategy = strategy
+}
+
+func (t *Target) SetWorkerSelectionStrategy(strategy string) {
+	t.WorkerSelectionStrategy = strategy
+}
+
+func (t *Target) SetWorkerAffinityFilter(filter string) {
+	t.WorkerAffinityFilter = filter
+}
+
+func (t *Target) SetHostExclusionFilter(filter string) {
+	t.HostExclusionFilter = filter
+}
+
+func (t *Target) SetHostPreferenceFilter(filter string) {
+	t.HostPreferenceFilter = filter
+}
+
 func (t *Target) SetAddress(address string) {
 	t.Address = address
 }
+
+func (t *Target) SetPorts(ports map[string]uint32) {
+	t.Ports = ports
+}
@@ -67,6 +67,21 @@ const (
 	RotateScopeKeys                    Type = 53
 	ListScopeKeyVersionDestructionJobs Type = 54
 	DestroyScopeKeyVersion             Type = 55
+	History                            Type = 56
+	Restore                            Type = 57
+	TailEvents                         Type = 58
+	ListCheckouts                      Type = 59
+	CheckIn                            Type = 60
+	SetRotationPolicy                  Type = 61
+	RemoveRotationPolicy               Type = 62
+	ListRotations                      Type = 63
+	ListVersions                       Type = 64
+	Rollback                           Type = 65
+	RotateToken                        Type = 66
+	SetHealthCheck                     Type = 67
+	RemoveHealthCheck                  Type = 68
+	ImportHosts                        Type = 69
+	Sync                               Type = 70
 
 	// When adding new actions, be sure to update:
 	//
@@ -130,6 +145,21 @@ var Map = map[string]Type{
 	RotateScopeKeys.String():                    RotateScopeKeys,
 	ListScopeKeyVersionDestructionJobs.String(): ListScopeKeyVersionDestructionJobs,
 	DestroyScopeKeyVersion.String():             DestroyScopeKeyVersion,
+	History.String():                            History,
+	Restore.String():                            Restore,
+	TailEvents.String():                         TailEvents,
+	ListCheckouts.String():                      ListCheckouts,
+	CheckIn.String():                            CheckIn,
+	SetRotationPolicy.String():                  SetRotationPolicy,
+	RemoveRotationPolicy.String():               RemoveRotationPolicy,
+	ListRotations.String():                      ListRotations,
+	ListVersions.String():                       ListVersions,
+	Rollback.String():                           Rollback,
+	RotateToken.String():                        RotateToken,
+	SetHealthCheck.String():                     SetHealthCheck,
+	RemoveHealthCheck.String():                  RemoveHealthCheck,
+	ImportHosts.String():                        ImportHosts,
+	Sync.String():                               Sync,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -199,6 +229,21 @@ func (a Type) String() string {
 		"rotate-keys",
 		"list-key-version-destruction-jobs",
 		"destroy-key-version",
+		"history",
+		"restore",
+		"tail-events",
+		"list-checkouts",
+		"check-in",
+		"set-rotation-policy",
+		"remove-rotation-policy",
+		"list-rotations",
+		"list-versions",
+		"rollback",
+		"rotate-token",
+		"set-health-check",
+		"remove-health-check",
+		"import-hosts",
+		"sync",
 	}[a]
 }
 
@@ -134,6 +134,66 @@ func TestAction(t *testing.T) {
 			action: DestroyScopeKeyVersion,
 			want:   "destroy-key-version",
 		},
+		{
+			action: History,
+			want:   "history",
+		},
+		{
+			action: Restore,
+			want:   "restore",
+		},
+		{
+			action: TailEvents,
+			want:   "tail-events",
+		},
+		{
+			action: ListCheckouts,
+			want:   "list-checkouts",
+		},
+		{
+			action: CheckIn,
+			want:   "check-in",
+		},
+		{
+			action: SetRotationPolicy,
+			want:   "set-rotation-policy",
+		},
+		{
+			action: RemoveRotationPolicy,
+			want:   "remove-rotation-policy",
+		},
+		{
+			action: ListRotations,
+			want:   "list-rotations",
+		},
+		{
+			action: ListVersions,
+			want:   "list-versions",
+		},
+		{
+			action: Rollback,
+			want:   "rollback",
+		},
+		{
+			action: RotateToken,
+			want:   "rotate-token",
+		},
+		{
+			action: SetHealthCheck,
+			want:   "set-health-check",
+		},
+		{
+			action: RemoveHealthCheck,
+			want:   "remove-health-check",
+		},
+		{
+			action: ImportHosts,
+			want:   "import-hosts",
+		},
+		{
+			action: Sync,
+			want:   "sync",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
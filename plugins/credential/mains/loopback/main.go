@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command loopback serves the loopback credential plugin over go-plugin. It
+// is used to test loading external credential plugins.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	credplugin "github.com/hashicorp/boundary/internal/credential/plugin"
+	cp "github.com/hashicorp/boundary/sdk/plugins/credential"
+)
+
+func main() {
+	if err := cp.ServeCredentialPlugin(credplugin.NewLoopbackPlugin()); err != nil {
+		fmt.Println("Error serving plugin", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
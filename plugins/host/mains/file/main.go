@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command file serves the file host plugin, the reference host plugin of the
+// sdk, over go-plugin. The -allowed-dir flag is the directory the hosts files
+// of catalogs must be in.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	hp "github.com/hashicorp/boundary/sdk/plugins/host"
+	filehp "github.com/hashicorp/boundary/sdk/plugins/host/file"
+)
+
+func main() {
+	allowedDir := flag.String("allowed-dir", "", "the directory the hosts files of catalogs must be in")
+	flag.Parse()
+	if err := hp.ServeHostPlugin(filehp.NewPlugin(filehp.WithAllowedDir(*allowedDir))); err != nil {
+		fmt.Println("Error serving plugin", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
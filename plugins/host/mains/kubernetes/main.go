@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command kubernetes serves the kubernetes host plugin over go-plugin.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	k8shp "github.com/hashicorp/boundary/plugins/host/mains/kubernetes/plugin"
+	hp "github.com/hashicorp/boundary/sdk/plugins/host"
+)
+
+func main() {
+	if err := hp.ServeHostPlugin(k8shp.NewPlugin()); err != nil {
+		fmt.Println("Error serving plugin", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
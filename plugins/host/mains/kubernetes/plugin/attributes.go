@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The kinds of Kubernetes objects a host set can discover.
+const (
+	ResourceTypePod     = "pod"
+	ResourceTypeService = "service"
+	ResourceTypeNode    = "node"
+)
+
+const defaultClusterDomain = "cluster.local"
+
+var namespaceRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// catalogAttributes are the attributes of a kubernetes host catalog.
+type catalogAttributes struct {
+	// ApiUrl is the URL of the API server of the cluster.
+	ApiUrl string `mapstructure:"api_url"`
+	// CaCert is a PEM encoded CA certificate used to verify the certificate
+	// of the API server. If empty the system roots are used.
+	CaCert string `mapstructure:"ca_cert"`
+	// TlsServerName overrides the name used to verify the certificate of the
+	// API server.
+	TlsServerName string `mapstructure:"tls_server_name"`
+	// TlsSkipVerify disables verification of the certificate of the API
+	// server. It should only be used for testing.
+	TlsSkipVerify bool `mapstructure:"tls_skip_verify"`
+	// ClusterDomain is the DNS domain of the cluster, used to build the DNS
+	// names of pods and services. Defaults to "cluster.local".
+	ClusterDomain string `mapstructure:"cluster_domain"`
+}
+
+// catalogSecrets are the credentials used to authenticate to the API server
+// of the cluster. Either a bearer token or a client certificate and key must
+// be provided.
+type catalogSecrets struct {
+	Token                string `mapstructure:"token"`
+	ClientCertificate    string `mapstructure:"client_certificate"`
+	ClientCertificateKey string `mapstructure:"client_certificate_key"`
+}
+
+// setAttributes are the attributes of a kubernetes host set.
+type setAttributes struct {
+	// ResourceType is the kind of object the set discovers: pod, service or
+	// node. Defaults to pod.
+	ResourceType string `mapstructure:"resource_type"`
+	// Namespace limits the set to the objects of a namespace. If empty,
+	// objects in all namespaces are discovered. Must be empty for nodes.
+	Namespace string `mapstructure:"namespace"`
+	// LabelSelector is a Kubernetes label selector, such as
+	// "app=web,tier!=cache", which objects must match to be in the set.
+	LabelSelector string `mapstructure:"label_selector"`
+}
+
+func decode(in *structpb.Struct, out any) error {
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      out,
+	})
+	if err != nil {
+		return err
+	}
+	return dec.Decode(in.AsMap())
+}
+
+func getCatalogAttributes(in *structpb.Struct) (*catalogAttributes, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "attributes are required")
+	}
+	attrs := new(catalogAttributes)
+	if err := decode(in, attrs); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error reading catalog attributes: %s", err)
+	}
+
+	var badFields []string
+	switch u, err := url.Parse(attrs.ApiUrl); {
+	case attrs.ApiUrl == "":
+		badFields = append(badFields, "attributes.api_url: missing value")
+	case err != nil:
+		badFields = append(badFields, fmt.Sprintf("attributes.api_url: %s", err))
+	case u.Scheme != "http" && u.Scheme != "https", u.Host == "":
+		badFields = append(badFields, "attributes.api_url: must be an http or https URL")
+	}
+	if attrs.CaCert != "" {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(attrs.CaCert)) {
+			badFields = append(badFields, "attributes.ca_cert: no PEM encoded certificates found")
+		}
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("Invalid catalog attributes", badFields)
+	}
+	attrs.ApiUrl = strings.TrimRight(attrs.ApiUrl, "/")
+	if attrs.ClusterDomain == "" {
+		attrs.ClusterDomain = defaultClusterDomain
+	}
+	return attrs, nil
+}
+
+func getCatalogSecrets(in *structpb.Struct) (*catalogSecrets, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "secrets are required")
+	}
+	secrets := new(catalogSecrets)
+	if err := decode(in, secrets); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error reading catalog secrets: %s", err)
+	}
+
+	var badFields []string
+	hasCert := secrets.ClientCertificate != "" || secrets.ClientCertificateKey != ""
+	switch {
+	case secrets.Token == "" && !hasCert:
+		badFields = append(badFields, "secrets: either token or client_certificate and client_certificate_key must be set")
+	case secrets.Token != "" && hasCert:
+		badFields = append(badFields, "secrets: only one of token or client_certificate and client_certificate_key may be set")
+	case hasCert:
+		if _, err := tls.X509KeyPair([]byte(secrets.ClientCertificate), []byte(secrets.ClientCertificateKey)); err != nil {
+			badFields = append(badFields, fmt.Sprintf("secrets.client_certificate: %s", err))
+		}
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("Invalid catalog secrets", badFields)
+	}
+	return secrets, nil
+}
+
+func getSetAttributes(in *structpb.Struct) (*setAttributes, error) {
+	attrs := new(setAttributes)
+	if in != nil {
+		if err := decode(in, attrs); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error reading set attributes: %s", err)
+		}
+	}
+	if attrs.ResourceType == "" {
+		attrs.ResourceType = ResourceTypePod
+	}
+
+	var badFields []string
+	switch attrs.ResourceType {
+	case ResourceTypePod, ResourceTypeService:
+		if attrs.Namespace != "" && (len(attrs.Namespace) > 63 || !namespaceRegexp.MatchString(attrs.Namespace)) {
+			badFields = append(badFields, "attributes.namespace: not a valid namespace name")
+		}
+	case ResourceTypeNode:
+		if attrs.Namespace != "" {
+			badFields = append(badFields, "attributes.namespace: nodes are not namespaced")
+		}
+	default:
+		badFields = append(badFields, fmt.Sprintf("attributes.resource_type: must be one of %q, %q or %q", ResourceTypePod, ResourceTypeService, ResourceTypeNode))
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("Invalid set attributes", badFields)
+	}
+	return attrs, nil
+}
+
+func invalidArgumentError(msg string, badFields []string) error {
+	return status.Errorf(codes.InvalidArgument, "%s: %s", msg, strings.Join(badFields, "; "))
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// listPageSize is the number of objects requested per page when listing.
+	listPageSize = 500
+	// maxErrorBodySize bounds how much of an error response is read.
+	maxErrorBodySize = 64 * 1024
+)
+
+// client is a minimal client of the Kubernetes REST API, supporting only the
+// read-only calls needed to discover hosts.
+type client struct {
+	apiUrl string
+	token  string
+	http   *http.Client
+}
+
+func newClient(attrs *catalogAttributes, secrets *catalogSecrets) (*client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         attrs.TlsServerName,
+		InsecureSkipVerify: attrs.TlsSkipVerify,
+	}
+	if attrs.CaCert != "" {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM([]byte(attrs.CaCert))
+		tlsConfig.RootCAs = pool
+	}
+	if secrets.ClientCertificate != "" {
+		cert, err := tls.X509KeyPair([]byte(secrets.ClientCertificate), []byte(secrets.ClientCertificateKey))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &client{
+		apiUrl: attrs.ApiUrl,
+		token:  secrets.Token,
+		http: &http.Client{
+			Transport: transport,
+			Timeout:   30 * time.Second,
+		},
+	}, nil
+}
+
+// objectList is a page of a list of pods, services or nodes.
+type objectList struct {
+	Metadata struct {
+		Continue string `json:"continue"`
+	} `json:"metadata"`
+	Items []*object `json:"items"`
+}
+
+// object holds the fields of pods, services and nodes which are used to build
+// hosts. Fields which do not apply to an object's kind are left empty.
+type object struct {
+	Metadata struct {
+		Uid       string            `json:"uid"`
+		Name      string            `json:"name"`
+		Namespace string            `json:"namespace"`
+		Labels    map[string]string `json:"labels"`
+	} `json:"metadata"`
+	Spec struct {
+		// Pod fields
+		Hostname  string `json:"hostname"`
+		Subdomain string `json:"subdomain"`
+
+		// Service fields
+		Type         string   `json:"type"`
+		ClusterIP    string   `json:"clusterIP"`
+		ClusterIPs   []string `json:"clusterIPs"`
+		ExternalIPs  []string `json:"externalIPs"`
+		ExternalName string   `json:"externalName"`
+	} `json:"spec"`
+	Status struct {
+		// Pod fields
+		Phase  string `json:"phase"`
+		PodIP  string `json:"podIP"`
+		PodIPs []struct {
+			Ip string `json:"ip"`
+		} `json:"podIPs"`
+
+		// Service fields
+		LoadBalancer struct {
+			Ingress []struct {
+				Ip       string `json:"ip"`
+				Hostname string `json:"hostname"`
+			} `json:"ingress"`
+		} `json:"loadBalancer"`
+
+		// Node fields
+		Addresses []struct {
+			Type    string `json:"type"`
+			Address string `json:"address"`
+		} `json:"addresses"`
+	} `json:"status"`
+}
+
+// apiStatus is the body of an unsuccessful Kubernetes API response.
+type apiStatus struct {
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+}
+
+// checkAccess verifies that the API server is reachable and accepts the
+// client's credentials.
+func (c *client) checkAccess(ctx context.Context) error {
+	return c.get(ctx, "/version", nil, nil)
+}
+
+// list returns the objects matching the host set attributes. If limit is
+// greater than zero, at most a single page of limit objects is returned.
+func (c *client) list(ctx context.Context, attrs *setAttributes, limit int) ([]*object, error) {
+	var path string
+	switch {
+	case attrs.ResourceType == ResourceTypeNode:
+		path = "/api/v1/nodes"
+	case attrs.Namespace != "":
+		path = fmt.Sprintf("/api/v1/namespaces/%s/%ss", url.PathEscape(attrs.Namespace), attrs.ResourceType)
+	default:
+		path = fmt.Sprintf("/api/v1/%ss", attrs.ResourceType)
+	}
+
+	pageSize := listPageSize
+	if limit > 0 {
+		pageSize = limit
+	}
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(pageSize))
+	if attrs.LabelSelector != "" {
+		query.Set("labelSelector", attrs.LabelSelector)
+	}
+
+	var objects []*object
+	for {
+		page := new(objectList)
+		if err := c.get(ctx, path, query, page); err != nil {
+			return nil, err
+		}
+		objects = append(objects, page.Items...)
+		if limit > 0 || page.Metadata.Continue == "" {
+			return objects, nil
+		}
+		query.Set("continue", page.Metadata.Continue)
+	}
+}
+
+func (c *client) get(ctx context.Context, path string, query url.Values, out any) error {
+	u := c.apiUrl + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error creating request: %s", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "error calling kubernetes api: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg := resp.Status
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		var st apiStatus
+		if json.Unmarshal(body, &st) == nil && st.Message != "" {
+			msg = st.Message
+		}
+		return status.Errorf(httpStatusToCode(resp.StatusCode), "kubernetes api returned %d for %s: %s", resp.StatusCode, path, msg)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return status.Errorf(codes.Internal, "error decoding kubernetes api response for %s: %s", path, err)
+	}
+	return nil
+}
+
+func httpStatusToCode(s int) codes.Code {
+	switch s {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Unavailable
+	}
+}
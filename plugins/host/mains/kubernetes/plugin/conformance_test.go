@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"testing"
+
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/boundary/sdk/plugins/host/hostplugintest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlugin_Conformance(t *testing.T) {
+	srv := newFakeApiServer(t, false)
+	web := map[string]string{"app": "web"}
+	srv.add(ResourceTypePod, "default", "web-0", "pod-1", web, nil, map[string]any{"phase": "Running", "podIP": "10.1.0.5"})
+	srv.add(ResourceTypePod, "default", "web-1", "pod-2", web, nil, map[string]any{"phase": "Running", "podIP": "10.1.0.6"})
+	srv.add(ResourceTypePod, "default", "db-0", "pod-3", map[string]string{"app": "db"}, nil, map[string]any{"phase": "Running", "podIP": "10.1.0.7"})
+	srv.add(ResourceTypeService, "default", "web", "svc-1", web, map[string]any{"clusterIP": "10.96.0.10"}, nil)
+
+	hostplugintest.Run(t, hostplugintest.Config{
+		Plugin:                NewPlugin(),
+		CatalogAttributes:     map[string]any{"api_url": srv.URL},
+		CatalogSecrets:        map[string]any{"token": testToken},
+		UpdatedCatalogSecrets: map[string]any{"token": testToken},
+		Sets: []map[string]any{
+			{"namespace": "default", "label_selector": "app=web"},
+			{"resource_type": "pod"},
+			{"resource_type": "service", "namespace": "default"},
+		},
+		CheckHosts: func(t *testing.T, setIds []string, hosts []*plgpb.ListHostsResponseHost) {
+			got := make(map[string][]string)
+			for _, h := range hosts {
+				got[h.GetExternalId()] = h.GetSetIds()
+			}
+			assert.Equal(t, map[string][]string{
+				"pod-1": {setIds[0], setIds[1]},
+				"pod-2": {setIds[0], setIds[1]},
+				"pod-3": {setIds[1]},
+				"svc-1": {setIds[2]},
+			}, got)
+		},
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testToken = "test-token"
+
+// fakeApiServer is a fake Kubernetes API server serving the list endpoints
+// of pods, services and nodes. It supports equality based label selectors,
+// pagination and bearer token authentication.
+type fakeApiServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string][]map[string]any // keyed by resource type
+	// requests records the path and query of each list request.
+	requests []string
+}
+
+func newFakeApiServer(t *testing.T, tls bool) *fakeApiServer {
+	t.Helper()
+	f := &fakeApiServer{objects: make(map[string][]map[string]any)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		if !f.authorized(w, r) {
+			return
+		}
+		writeJson(w, http.StatusOK, map[string]any{"major": "1", "minor": "26"})
+	})
+	mux.HandleFunc("/api/v1/", f.list)
+	if tls {
+		f.Server = httptest.NewTLSServer(mux)
+	} else {
+		f.Server = httptest.NewServer(mux)
+	}
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeApiServer) add(resourceType, namespace, name, uid string, labels map[string]string, spec, status map[string]any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	meta := map[string]any{"name": name, "uid": uid, "labels": labels}
+	if namespace != "" {
+		meta["namespace"] = namespace
+	}
+	f.objects[resourceType] = append(f.objects[resourceType], map[string]any{
+		"metadata": meta,
+		"spec":     spec,
+		"status":   status,
+	})
+}
+
+func (f *fakeApiServer) authorized(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		writeJson(w, http.StatusUnauthorized, map[string]any{"kind": "Status", "message": "Unauthorized", "reason": "Unauthorized"})
+		return false
+	}
+	return true
+}
+
+func (f *fakeApiServer) list(w http.ResponseWriter, r *http.Request) {
+	if !f.authorized(w, r) {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.URL.RequestURI())
+
+	var namespace, resource string
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	switch {
+	case len(parts) == 1:
+		resource = parts[0]
+	case len(parts) == 3 && parts[0] == "namespaces" && parts[2] != "nodes":
+		namespace, resource = parts[1], parts[2]
+	default:
+		writeJson(w, http.StatusNotFound, map[string]any{"kind": "Status", "message": "the server could not find the requested resource"})
+		return
+	}
+	resourceType := strings.TrimSuffix(resource, "s")
+
+	selector := map[string]string{}
+	if ls := r.URL.Query().Get("labelSelector"); ls != "" {
+		for _, term := range strings.Split(ls, ",") {
+			k, v, ok := strings.Cut(term, "=")
+			if !ok {
+				writeJson(w, http.StatusBadRequest, map[string]any{"kind": "Status", "message": fmt.Sprintf("unable to parse requirement: %q", term)})
+				return
+			}
+			selector[k] = v
+		}
+	}
+
+	var matched []map[string]any
+	for _, obj := range f.objects[resourceType] {
+		meta := obj["metadata"].(map[string]any)
+		if namespace != "" && meta["namespace"] != namespace {
+			continue
+		}
+		labels := meta["labels"].(map[string]string)
+		match := true
+		for k, v := range selector {
+			if labels[k] != v {
+				match = false
+			}
+		}
+		if match {
+			matched = append(matched, obj)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i]["metadata"].(map[string]any)["uid"].(string) < matched[j]["metadata"].(map[string]any)["uid"].(string)
+	})
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("continue"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	end := len(matched)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	var cont string
+	if end < len(matched) {
+		cont = strconv.Itoa(end)
+	}
+	writeJson(w, http.StatusOK, map[string]any{
+		"metadata": map[string]any{"continue": cont},
+		"items":    matched[start:end],
+	})
+}
+
+func writeJson(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// toHost builds the host for a pod, service or node. It returns nil for
+// objects which have no addresses, such as pods which have not been
+// scheduled or have terminated.
+func toHost(resourceType, clusterDomain string, obj *object) (*plgpb.ListHostsResponseHost, error) {
+	if obj.Metadata.Uid == "" {
+		return nil, nil
+	}
+
+	var ips, dnsNames []string
+	var kind string
+	switch resourceType {
+	case ResourceTypePod:
+		kind = "Pod"
+		ips, dnsNames = podAddresses(clusterDomain, obj)
+	case ResourceTypeService:
+		kind = "Service"
+		ips, dnsNames = serviceAddresses(clusterDomain, obj)
+	case ResourceTypeNode:
+		kind = "Node"
+		ips, dnsNames = nodeAddresses(obj)
+	}
+	if len(ips) == 0 && len(dnsNames) == 0 {
+		return nil, nil
+	}
+
+	labels := make(map[string]any, len(obj.Metadata.Labels))
+	for k, v := range obj.Metadata.Labels {
+		labels[k] = v
+	}
+	attrs := map[string]any{
+		"kind":   kind,
+		"labels": labels,
+	}
+	description := fmt.Sprintf("%s %s", kind, obj.Metadata.Name)
+	if obj.Metadata.Namespace != "" {
+		attrs["namespace"] = obj.Metadata.Namespace
+		description = fmt.Sprintf("%s %s/%s", kind, obj.Metadata.Namespace, obj.Metadata.Name)
+	}
+	attributes, err := structpb.NewStruct(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &plgpb.ListHostsResponseHost{
+		ExternalId:  obj.Metadata.Uid,
+		Name:        obj.Metadata.Name,
+		Description: description,
+		IpAddresses: ips,
+		DnsNames:    dnsNames,
+		Attributes:  attributes,
+	}, nil
+}
+
+func podAddresses(clusterDomain string, obj *object) ([]string, []string) {
+	switch obj.Status.Phase {
+	case "Succeeded", "Failed":
+		return nil, nil
+	}
+	ips := newStringSet()
+	for _, ip := range obj.Status.PodIPs {
+		ips.add(ip.Ip)
+	}
+	ips.add(obj.Status.PodIP)
+
+	dnsNames := newStringSet()
+	if obj.Spec.Hostname != "" && obj.Spec.Subdomain != "" {
+		dnsNames.add(fmt.Sprintf("%s.%s.%s.svc.%s", obj.Spec.Hostname, obj.Spec.Subdomain, obj.Metadata.Namespace, clusterDomain))
+	}
+	for _, ip := range ips.values {
+		if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() != nil {
+			dnsNames.add(fmt.Sprintf("%s.%s.pod.%s", strings.ReplaceAll(ip, ".", "-"), obj.Metadata.Namespace, clusterDomain))
+		}
+	}
+	if len(ips.values) == 0 {
+		// Pods without an IP have not been scheduled yet, so their DNS names
+		// do not resolve either.
+		return nil, nil
+	}
+	return ips.values, dnsNames.values
+}
+
+func serviceAddresses(clusterDomain string, obj *object) ([]string, []string) {
+	ips, dnsNames := newStringSet(), newStringSet()
+	if obj.Spec.Type == "ExternalName" {
+		dnsNames.add(obj.Spec.ExternalName)
+		return ips.values, dnsNames.values
+	}
+	for _, ip := range obj.Spec.ClusterIPs {
+		ips.add(ip)
+	}
+	ips.add(obj.Spec.ClusterIP)
+	for _, ip := range obj.Spec.ExternalIPs {
+		ips.add(ip)
+	}
+	for _, ing := range obj.Status.LoadBalancer.Ingress {
+		ips.add(ing.Ip)
+		dnsNames.add(ing.Hostname)
+	}
+	dnsNames.add(fmt.Sprintf("%s.%s.svc.%s", obj.Metadata.Name, obj.Metadata.Namespace, clusterDomain))
+	return ips.values, dnsNames.values
+}
+
+func nodeAddresses(obj *object) ([]string, []string) {
+	ips, dnsNames := newStringSet(), newStringSet()
+	for _, addr := range obj.Status.Addresses {
+		switch addr.Type {
+		case "InternalIP", "ExternalIP":
+			ips.add(addr.Address)
+		case "Hostname", "InternalDNS", "ExternalDNS":
+			dnsNames.add(addr.Address)
+		}
+	}
+	return ips.values, dnsNames.values
+}
+
+// stringSet is an insertion ordered set of non-empty strings.
+type stringSet struct {
+	seen   map[string]struct{}
+	values []string
+}
+
+func newStringSet() *stringSet {
+	return &stringSet{seen: make(map[string]struct{})}
+}
+
+func (s *stringSet) add(v string) {
+	// "None" is the cluster IP of headless services.
+	if v == "" || v == "None" {
+		return
+	}
+	if _, ok := s.seen[v]; ok {
+		return
+	}
+	s.seen[v] = struct{}{}
+	s.values = append(s.values, v)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package plugin provides a host plugin which discovers the pods,
+// services or nodes of a Kubernetes cluster.
+//
+// A catalog is a cluster: its attributes hold the URL of the cluster's API
+// server and how to verify it, and its secrets hold either a bearer token or a
+// client certificate and key. A host set is a resource type, an optional
+// namespace and an optional label selector. Each matching object becomes a
+// host whose addresses are the object's IPs and DNS names, and whose
+// attributes hold the object's kind, namespace and labels.
+package plugin
+
+import (
+	"context"
+
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PluginName is the name the plugin is registered with.
+const PluginName = "kubernetes"
+
+var _ plgpb.HostPluginServiceServer = (*Plugin)(nil)
+
+// Plugin implements the host plugin service for Kubernetes clusters. It holds
+// no state between calls and is safe for concurrent use.
+type Plugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+}
+
+// NewPlugin returns a new kubernetes host plugin.
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+// NormalizeCatalogData validates the catalog attributes and removes any
+// trailing slash from the API server URL.
+func (p *Plugin) NormalizeCatalogData(_ context.Context, req *plgpb.NormalizeCatalogDataRequest) (*plgpb.NormalizeCatalogDataResponse, error) {
+	attrs, err := getCatalogAttributes(req.GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+	out := proto.Clone(req.GetAttributes()).(*structpb.Struct)
+	out.Fields["api_url"] = structpb.NewStringValue(attrs.ApiUrl)
+	return &plgpb.NormalizeCatalogDataResponse{Attributes: out}, nil
+}
+
+// OnCreateCatalog verifies that the cluster accepts the catalog's credentials
+// and returns them to be persisted.
+func (p *Plugin) OnCreateCatalog(ctx context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	cat := req.GetCatalog()
+	if cat == nil {
+		return nil, status.Error(codes.InvalidArgument, "catalog is nil")
+	}
+	c, err := clientFor(cat.GetAttributes(), cat.GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateCatalogResponse{
+		Persisted: &plgpb.HostCatalogPersisted{Secrets: cat.GetSecrets()},
+	}, nil
+}
+
+// OnUpdateCatalog verifies that the cluster accepts the catalog's credentials,
+// using the new secrets if any were given and the persisted ones otherwise.
+// New secrets are returned to be persisted.
+func (p *Plugin) OnUpdateCatalog(ctx context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	cat := req.GetNewCatalog()
+	if cat == nil {
+		return nil, status.Error(codes.InvalidArgument, "new catalog is nil")
+	}
+	secrets := cat.GetSecrets()
+	if secrets == nil {
+		secrets = req.GetPersisted().GetSecrets()
+	}
+	c, err := clientFor(cat.GetAttributes(), secrets)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkAccess(ctx); err != nil {
+		return nil, err
+	}
+	if cat.GetSecrets() == nil {
+		return &plgpb.OnUpdateCatalogResponse{}, nil
+	}
+	return &plgpb.OnUpdateCatalogResponse{
+		Persisted: &plgpb.HostCatalogPersisted{Secrets: cat.GetSecrets()},
+	}, nil
+}
+
+// OnDeleteCatalog is a no-op; the plugin keeps no state in the cluster.
+func (p *Plugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+// NormalizeSetData validates the set attributes and sets the resource type to
+// pod if it was not given.
+func (p *Plugin) NormalizeSetData(_ context.Context, req *plgpb.NormalizeSetDataRequest) (*plgpb.NormalizeSetDataResponse, error) {
+	attrs, err := getSetAttributes(req.GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+	out := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	if req.GetAttributes() != nil {
+		out = proto.Clone(req.GetAttributes()).(*structpb.Struct)
+	}
+	out.Fields["resource_type"] = structpb.NewStringValue(attrs.ResourceType)
+	return &plgpb.NormalizeSetDataResponse{Attributes: out}, nil
+}
+
+// OnCreateSet verifies that the set's objects can be listed with the catalog's
+// credentials and that its label selector is accepted by the cluster.
+func (p *Plugin) OnCreateSet(ctx context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	if err := p.checkSet(ctx, req.GetCatalog().GetAttributes(), req.GetPersisted().GetSecrets(), req.GetSet().GetAttributes()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet verifies that the set's objects can be listed with the catalog's
+// credentials and that its label selector is accepted by the cluster.
+func (p *Plugin) OnUpdateSet(ctx context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	if err := p.checkSet(ctx, req.GetCatalog().GetAttributes(), req.GetPersisted().GetSecrets(), req.GetNewSet().GetAttributes()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op; the plugin keeps no state in the cluster.
+func (p *Plugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts lists the objects matching each of the sets and returns them as
+// hosts. Objects matched by more than one set are returned once, with the ids
+// of all the sets which matched them.
+func (p *Plugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	catAttrs, err := getCatalogAttributes(req.GetCatalog().GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := getCatalogSecrets(req.GetPersisted().GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	c, err := newClient(catAttrs, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []*plgpb.ListHostsResponseHost
+	byId := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, set := range req.GetSets() {
+		if set.GetId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "set is missing an id")
+		}
+		setAttrs, err := getSetAttributes(set.GetAttributes())
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "set %s: %s", set.GetId(), status.Convert(err).Message())
+		}
+		objects, err := c.list(ctx, setAttrs, 0)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "set %s: %s", set.GetId(), status.Convert(err).Message())
+		}
+		for _, obj := range objects {
+			if h, ok := byId[obj.Metadata.Uid]; ok {
+				h.SetIds = append(h.SetIds, set.GetId())
+				continue
+			}
+			h, err := toHost(setAttrs.ResourceType, catAttrs.ClusterDomain, obj)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "error building host for %s: %s", obj.Metadata.Name, err)
+			}
+			if h == nil {
+				continue
+			}
+			h.SetIds = []string{set.GetId()}
+			byId[h.GetExternalId()] = h
+			hosts = append(hosts, h)
+		}
+	}
+	return &plgpb.ListHostsResponse{Hosts: hosts}, nil
+}
+
+func (p *Plugin) checkSet(ctx context.Context, catAttrs, secrets, setAttrs *structpb.Struct) error {
+	attrs, err := getSetAttributes(setAttrs)
+	if err != nil {
+		return err
+	}
+	c, err := clientFor(catAttrs, secrets)
+	if err != nil {
+		return err
+	}
+	_, err = c.list(ctx, attrs, 1)
+	return err
+}
+
+func clientFor(catAttrs, secrets *structpb.Struct) (*client, error) {
+	attrs, err := getCatalogAttributes(catAttrs)
+	if err != nil {
+		return nil, err
+	}
+	s, err := getCatalogSecrets(secrets)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(attrs, s)
+}
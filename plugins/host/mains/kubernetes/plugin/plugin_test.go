@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package plugin
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+func testCatalog(t *testing.T, attrs, secrets map[string]any) *hostcatalogs.HostCatalog {
+	t.Helper()
+	cat := &hostcatalogs.HostCatalog{
+		Id:    "hc_1234567890",
+		Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: testStruct(t, attrs)},
+	}
+	if secrets != nil {
+		cat.Secrets = testStruct(t, secrets)
+	}
+	return cat
+}
+
+func testSet(t *testing.T, id string, attrs map[string]any) *hostsets.HostSet {
+	t.Helper()
+	return &hostsets.HostSet{
+		Id:    id,
+		Attrs: &hostsets.HostSet_Attributes{Attributes: testStruct(t, attrs)},
+	}
+}
+
+func TestPlugin_NormalizeCatalogData(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+
+	got, err := p.NormalizeCatalogData(ctx, &plgpb.NormalizeCatalogDataRequest{
+		Attributes: testStruct(t, map[string]any{"api_url": "https://k8s.example.com:6443/", "cluster_domain": "corp.local"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"api_url": "https://k8s.example.com:6443", "cluster_domain": "corp.local"}, got.GetAttributes().AsMap())
+
+	tests := []struct {
+		name    string
+		attrs   map[string]any
+		wantErr string
+	}{
+		{name: "missing-api-url", attrs: map[string]any{}, wantErr: "attributes.api_url: missing value"},
+		{name: "bad-scheme", attrs: map[string]any{"api_url": "ftp://k8s.example.com"}, wantErr: "must be an http or https URL"},
+		{name: "bad-ca-cert", attrs: map[string]any{"api_url": "https://k8s.example.com", "ca_cert": "nope"}, wantErr: "attributes.ca_cert"},
+		{name: "unknown-field", attrs: map[string]any{"api_url": "https://k8s.example.com", "region": "us-east-1"}, wantErr: "region"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.NormalizeCatalogData(ctx, &plgpb.NormalizeCatalogDataRequest{Attributes: testStruct(t, tt.attrs)})
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestPlugin_OnCreateCatalog(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newFakeApiServer(t, false)
+	tlsSrv := newFakeApiServer(t, true)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsSrv.Certificate().Raw}))
+
+	tests := []struct {
+		name     string
+		attrs    map[string]any
+		secrets  map[string]any
+		wantCode codes.Code
+	}{
+		{
+			name:    "valid",
+			attrs:   map[string]any{"api_url": srv.URL},
+			secrets: map[string]any{"token": testToken},
+		},
+		{
+			name:    "valid-tls",
+			attrs:   map[string]any{"api_url": tlsSrv.URL, "ca_cert": caCert},
+			secrets: map[string]any{"token": testToken},
+		},
+		{
+			name:     "untrusted-tls",
+			attrs:    map[string]any{"api_url": tlsSrv.URL},
+			secrets:  map[string]any{"token": testToken},
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "bad-token",
+			attrs:    map[string]any{"api_url": srv.URL},
+			secrets:  map[string]any{"token": "wrong"},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "missing-secrets",
+			attrs:    map[string]any{"api_url": srv.URL},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "token-and-certificate",
+			attrs:    map[string]any{"api_url": srv.URL},
+			secrets:  map[string]any{"token": testToken, "client_certificate": "cert", "client_certificate_key": "key"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "bad-certificate",
+			attrs:    map[string]any{"api_url": srv.URL},
+			secrets:  map[string]any{"client_certificate": "cert", "client_certificate_key": "key"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: testCatalog(t, tt.attrs, tt.secrets)})
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.secrets, got.GetPersisted().GetSecrets().AsMap())
+		})
+	}
+}
+
+func TestPlugin_OnUpdateCatalog(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newFakeApiServer(t, false)
+	attrs := map[string]any{"api_url": srv.URL}
+
+	// Without new secrets the persisted ones are used and left unchanged.
+	got, err := p.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{
+		CurrentCatalog: testCatalog(t, attrs, nil),
+		NewCatalog:     testCatalog(t, attrs, nil),
+		Persisted:      &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": testToken})},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, got.GetPersisted())
+
+	// New secrets are checked and returned to be persisted.
+	got, err = p.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{
+		CurrentCatalog: testCatalog(t, attrs, nil),
+		NewCatalog:     testCatalog(t, attrs, map[string]any{"token": testToken}),
+		Persisted:      &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": "old"})},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"token": testToken}, got.GetPersisted().GetSecrets().AsMap())
+
+	_, err = p.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{
+		CurrentCatalog: testCatalog(t, attrs, nil),
+		NewCatalog:     testCatalog(t, attrs, map[string]any{"token": "wrong"}),
+		Persisted:      &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": testToken})},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestPlugin_NormalizeSetData(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+
+	got, err := p.NormalizeSetData(ctx, &plgpb.NormalizeSetDataRequest{
+		Attributes: testStruct(t, map[string]any{"namespace": "default", "label_selector": "app=web"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"resource_type": "pod", "namespace": "default", "label_selector": "app=web"}, got.GetAttributes().AsMap())
+
+	got, err = p.NormalizeSetData(ctx, &plgpb.NormalizeSetDataRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"resource_type": "pod"}, got.GetAttributes().AsMap())
+
+	tests := []struct {
+		name    string
+		attrs   map[string]any
+		wantErr string
+	}{
+		{name: "bad-resource-type", attrs: map[string]any{"resource_type": "deployment"}, wantErr: "attributes.resource_type"},
+		{name: "bad-namespace", attrs: map[string]any{"namespace": "Not_Valid"}, wantErr: "attributes.namespace"},
+		{name: "namespaced-node", attrs: map[string]any{"resource_type": "node", "namespace": "default"}, wantErr: "nodes are not namespaced"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.NormalizeSetData(ctx, &plgpb.NormalizeSetDataRequest{Attributes: testStruct(t, tt.attrs)})
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestPlugin_OnCreateSet(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newFakeApiServer(t, false)
+	cat := testCatalog(t, map[string]any{"api_url": srv.URL}, nil)
+	persisted := &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": testToken})}
+
+	_, err := p.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{
+		Catalog:   cat,
+		Set:       testSet(t, "hsplg_1", map[string]any{"resource_type": "service", "namespace": "prod", "label_selector": "app=web"}),
+		Persisted: persisted,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/v1/namespaces/prod/services?labelSelector=app%3Dweb&limit=1"}, srv.requests)
+
+	_, err = p.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{
+		Catalog:   cat,
+		Set:       testSet(t, "hsplg_1", map[string]any{"label_selector": "app"}),
+		Persisted: persisted,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "unable to parse requirement")
+
+	_, err = p.OnUpdateSet(ctx, &plgpb.OnUpdateSetRequest{
+		Catalog:    cat,
+		CurrentSet: testSet(t, "hsplg_1", map[string]any{}),
+		NewSet:     testSet(t, "hsplg_1", map[string]any{"resource_type": "node"}),
+		Persisted:  &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": "wrong"})},
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestPlugin_ListHosts(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newFakeApiServer(t, false)
+
+	web := map[string]string{"app": "web"}
+	srv.add(ResourceTypePod, "default", "web-0", "pod-1", web,
+		map[string]any{"hostname": "web-0", "subdomain": "web"},
+		map[string]any{"phase": "Running", "podIP": "10.1.0.5", "podIPs": []map[string]any{{"ip": "10.1.0.5"}, {"ip": "fd00::5"}}})
+	srv.add(ResourceTypePod, "default", "web-1", "pod-2", web, nil,
+		map[string]any{"phase": "Pending"})
+	srv.add(ResourceTypePod, "default", "web-2", "pod-3", web, nil,
+		map[string]any{"phase": "Succeeded", "podIP": "10.1.0.7"})
+	srv.add(ResourceTypePod, "other", "web-0", "pod-4", web, nil,
+		map[string]any{"phase": "Running", "podIP": "10.1.1.5"})
+	srv.add(ResourceTypePod, "default", "db-0", "pod-5", map[string]string{"app": "db"}, nil,
+		map[string]any{"phase": "Running", "podIP": "10.1.0.9"})
+
+	srv.add(ResourceTypeService, "default", "web", "svc-1", web,
+		map[string]any{"type": "LoadBalancer", "clusterIP": "10.96.0.10", "clusterIPs": []string{"10.96.0.10"}},
+		map[string]any{"loadBalancer": map[string]any{"ingress": []map[string]any{{"ip": "203.0.113.10"}, {"hostname": "web.elb.example.com"}}}})
+	srv.add(ResourceTypeService, "default", "web-headless", "svc-2", web,
+		map[string]any{"type": "ClusterIP", "clusterIP": "None", "clusterIPs": []string{"None"}}, nil)
+	srv.add(ResourceTypeService, "default", "db", "svc-3", map[string]string{"app": "db"},
+		map[string]any{"type": "ExternalName", "externalName": "db.example.com"}, nil)
+
+	srv.add(ResourceTypeNode, "", "node-a", "node-1", map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}, nil,
+		map[string]any{"addresses": []map[string]any{
+			{"type": "InternalIP", "address": "192.168.0.1"},
+			{"type": "ExternalIP", "address": "198.51.100.1"},
+			{"type": "Hostname", "address": "node-a"},
+			{"type": "InternalDNS", "address": "node-a.internal"},
+		}})
+
+	cat := testCatalog(t, map[string]any{"api_url": srv.URL, "cluster_domain": "corp.local"}, nil)
+	persisted := &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": testToken})}
+
+	got, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+		Catalog: cat,
+		Sets: []*hostsets.HostSet{
+			testSet(t, "hsplg_web", map[string]any{"resource_type": "pod", "namespace": "default", "label_selector": "app=web"}),
+			testSet(t, "hsplg_pods", map[string]any{"resource_type": "pod"}),
+			testSet(t, "hsplg_svc", map[string]any{"resource_type": "service", "namespace": "default"}),
+			testSet(t, "hsplg_nodes", map[string]any{"resource_type": "node"}),
+		},
+		Persisted: persisted,
+	})
+	require.NoError(t, err)
+
+	byId := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, h := range got.GetHosts() {
+		byId[h.GetExternalId()] = h
+	}
+	// Pods without IPs and terminated pods are skipped.
+	require.Len(t, byId, 7)
+	assert.NotContains(t, byId, "pod-2")
+	assert.NotContains(t, byId, "pod-3")
+
+	pod := byId["pod-1"]
+	assert.Equal(t, "web-0", pod.GetName())
+	assert.Equal(t, "Pod default/web-0", pod.GetDescription())
+	assert.Equal(t, []string{"10.1.0.5", "fd00::5"}, pod.GetIpAddresses())
+	assert.Equal(t, []string{"web-0.web.default.svc.corp.local", "10-1-0-5.default.pod.corp.local"}, pod.GetDnsNames())
+	assert.Equal(t, []string{"hsplg_web", "hsplg_pods"}, pod.GetSetIds())
+	assert.Equal(t, map[string]any{"kind": "Pod", "namespace": "default", "labels": map[string]any{"app": "web"}}, pod.GetAttributes().AsMap())
+	assert.Equal(t, []string{"hsplg_pods"}, byId["pod-4"].GetSetIds())
+	assert.Equal(t, []string{"hsplg_pods"}, byId["pod-5"].GetSetIds())
+
+	svc := byId["svc-1"]
+	assert.Equal(t, []string{"10.96.0.10", "203.0.113.10"}, svc.GetIpAddresses())
+	assert.Equal(t, []string{"web.elb.example.com", "web.default.svc.corp.local"}, svc.GetDnsNames())
+	assert.Equal(t, []string{"hsplg_svc"}, svc.GetSetIds())
+	assert.Empty(t, byId["svc-2"].GetIpAddresses())
+	assert.Equal(t, []string{"web-headless.default.svc.corp.local"}, byId["svc-2"].GetDnsNames())
+	assert.Equal(t, []string{"db.example.com"}, byId["svc-3"].GetDnsNames())
+
+	node := byId["node-1"]
+	assert.Equal(t, "Node node-a", node.GetDescription())
+	assert.Equal(t, []string{"192.168.0.1", "198.51.100.1"}, node.GetIpAddresses())
+	assert.Equal(t, []string{"node-a", "node-a.internal"}, node.GetDnsNames())
+	assert.Equal(t, map[string]any{"kind": "Node", "labels": map[string]any{"topology.kubernetes.io/zone": "us-east-1a"}}, node.GetAttributes().AsMap())
+
+	t.Run("bad-credentials", func(t *testing.T) {
+		_, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   cat,
+			Sets:      []*hostsets.HostSet{testSet(t, "hsplg_nodes", map[string]any{"resource_type": "node"})},
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": "wrong"})},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Contains(t, err.Error(), "hsplg_nodes")
+	})
+}
+
+func TestPlugin_ListHostsPagination(t *testing.T) {
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newFakeApiServer(t, false)
+
+	const numNodes = listPageSize + 10
+	for i := 0; i < numNodes; i++ {
+		srv.add(ResourceTypeNode, "", fmt.Sprintf("node-%d", i), fmt.Sprintf("node-%04d", i), map[string]string{}, nil,
+			map[string]any{"addresses": []map[string]any{{"type": "InternalIP", "address": fmt.Sprintf("10.0.%d.%d", i/256, i%256)}}})
+	}
+
+	got, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+		Catalog:   testCatalog(t, map[string]any{"api_url": srv.URL}, nil),
+		Sets:      []*hostsets.HostSet{testSet(t, "hsplg_nodes", map[string]any{"resource_type": "node"})},
+		Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]any{"token": testToken})},
+	})
+	require.NoError(t, err)
+	assert.Len(t, got.GetHosts(), numNodes)
+	require.Len(t, srv.requests, 2)
+	assert.True(t, strings.Contains(srv.requests[1], "continue="), srv.requests[1])
+}
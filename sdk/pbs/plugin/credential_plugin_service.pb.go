@@ -0,0 +1,1770 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: plugin/v1/credential_plugin_service.proto
+
+package plugin
+
+import (
+	credentiallibraries "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	credentialstores "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NormalizeStoreDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The incoming attributes in the create or update request.
+	Attributes *structpb.Struct `protobuf:"bytes,100,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *NormalizeStoreDataRequest) Reset() {
+	*x = NormalizeStoreDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizeStoreDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeStoreDataRequest) ProtoMessage() {}
+
+func (x *NormalizeStoreDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeStoreDataRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeStoreDataRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NormalizeStoreDataRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type NormalizeStoreDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Outgoing attributes. If nil, no changes will be recorded. If non-nil, the
+	// values here will be used in place of the original set of attributes.
+	Attributes *structpb.Struct `protobuf:"bytes,100,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *NormalizeStoreDataResponse) Reset() {
+	*x = NormalizeStoreDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizeStoreDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeStoreDataResponse) ProtoMessage() {}
+
+func (x *NormalizeStoreDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeStoreDataResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeStoreDataResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NormalizeStoreDataResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type OnCreateStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The credential store to create. The request may contain optional secret
+	// data to help authenticate the request against the secret manager.
+	Store *credentialstores.CredentialStore `protobuf:"bytes,10,opt,name=store,proto3" json:"store,omitempty"`
+}
+
+func (x *OnCreateStoreRequest) Reset() {
+	*x = OnCreateStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnCreateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCreateStoreRequest) ProtoMessage() {}
+
+func (x *OnCreateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCreateStoreRequest.ProtoReflect.Descriptor instead.
+func (*OnCreateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OnCreateStoreRequest) GetStore() *credentialstores.CredentialStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+type OnCreateStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Secret data to persist encrypted within Boundary. This should be used to
+	// store authentication data and other necessary configuration to be used in
+	// later hooks and calls. Returning an error from the call will cause this
+	// data to not be persisted. If this is nil, nothing is written.
+	Persisted *CredentialStorePersisted `protobuf:"bytes,10,opt,name=persisted,proto3" json:"persisted,omitempty"`
+}
+
+func (x *OnCreateStoreResponse) Reset() {
+	*x = OnCreateStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnCreateStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnCreateStoreResponse) ProtoMessage() {}
+
+func (x *OnCreateStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnCreateStoreResponse.ProtoReflect.Descriptor instead.
+func (*OnCreateStoreResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OnCreateStoreResponse) GetPersisted() *CredentialStorePersisted {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+type OnUpdateStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The existing state of the store.
+	CurrentStore *credentialstores.CredentialStore `protobuf:"bytes,10,opt,name=current_store,json=currentStore,proto3" json:"current_store,omitempty"`
+	// The requested new state of the store. This field may contain optional
+	// secret data that may have been updated from old authentication data
+	// contained within the persisted state.
+	NewStore *credentialstores.CredentialStore `protobuf:"bytes,20,opt,name=new_store,json=newStore,proto3" json:"new_store,omitempty"`
+	// The existing persisted secret data.
+	Persisted *CredentialStorePersisted `protobuf:"bytes,30,opt,name=persisted,proto3" json:"persisted,omitempty"`
+}
+
+func (x *OnUpdateStoreRequest) Reset() {
+	*x = OnUpdateStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnUpdateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnUpdateStoreRequest) ProtoMessage() {}
+
+func (x *OnUpdateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnUpdateStoreRequest.ProtoReflect.Descriptor instead.
+func (*OnUpdateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OnUpdateStoreRequest) GetCurrentStore() *credentialstores.CredentialStore {
+	if x != nil {
+		return x.CurrentStore
+	}
+	return nil
+}
+
+func (x *OnUpdateStoreRequest) GetNewStore() *credentialstores.CredentialStore {
+	if x != nil {
+		return x.NewStore
+	}
+	return nil
+}
+
+func (x *OnUpdateStoreRequest) GetPersisted() *CredentialStorePersisted {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+type OnUpdateStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated secret data to persist encrypted within Boundary. If an error
+	// is returned, the update of the persisted data is aborted. If this is nil,
+	// no changes are written. To remove all values, simply return an allocated
+	// but empty map.
+	Persisted *CredentialStorePersisted `protobuf:"bytes,10,opt,name=persisted,proto3" json:"persisted,omitempty"`
+}
+
+func (x *OnUpdateStoreResponse) Reset() {
+	*x = OnUpdateStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnUpdateStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnUpdateStoreResponse) ProtoMessage() {}
+
+func (x *OnUpdateStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnUpdateStoreResponse.ProtoReflect.Descriptor instead.
+func (*OnUpdateStoreResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OnUpdateStoreResponse) GetPersisted() *CredentialStorePersisted {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+type OnDeleteStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The existing state of the store to delete.
+	Store *credentialstores.CredentialStore `protobuf:"bytes,10,opt,name=store,proto3" json:"store,omitempty"`
+	// The existing persisted secret data.
+	Persisted *CredentialStorePersisted `protobuf:"bytes,20,opt,name=persisted,proto3" json:"persisted,omitempty"`
+}
+
+func (x *OnDeleteStoreRequest) Reset() {
+	*x = OnDeleteStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnDeleteStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnDeleteStoreRequest) ProtoMessage() {}
+
+func (x *OnDeleteStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnDeleteStoreRequest.ProtoReflect.Descriptor instead.
+func (*OnDeleteStoreRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OnDeleteStoreRequest) GetStore() *credentialstores.CredentialStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *OnDeleteStoreRequest) GetPersisted() *CredentialStorePersisted {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+type OnDeleteStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OnDeleteStoreResponse) Reset() {
+	*x = OnDeleteStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnDeleteStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnDeleteStoreResponse) ProtoMessage() {}
+
+func (x *OnDeleteStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnDeleteStoreResponse.ProtoReflect.Descriptor instead.
+func (*OnDeleteStoreResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{7}
+}
+
+type IssueCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The credential store the libraries belong to.
+	Store *credentialstores.CredentialStore `protobuf:"bytes,10,opt,name=store,proto3" json:"store,omitempty"`
+	// The persisted data for the credential store.
+	Persisted *CredentialStorePersisted `protobuf:"bytes,20,opt,name=persisted,proto3" json:"persisted,omitempty"`
+	// The id of the session the credentials are issued for.
+	SessionId string `protobuf:"bytes,30,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The time the session expires. Credentials should not be valid for longer
+	// than the session.
+	SessionExpirationTime *timestamppb.Timestamp `protobuf:"bytes,40,opt,name=session_expiration_time,json=sessionExpirationTime,proto3" json:"session_expiration_time,omitempty"`
+	// The credentials to issue.
+	Requests []*CredentialRequest `protobuf:"bytes,50,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Information about the user the session is authorized for, which the
+	// plugin may use to template the credentials it issues.
+	TemplateData *TemplateData `protobuf:"bytes,60,opt,name=template_data,json=templateData,proto3" json:"template_data,omitempty"`
+}
+
+func (x *IssueCredentialsRequest) Reset() {
+	*x = IssueCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCredentialsRequest) ProtoMessage() {}
+
+func (x *IssueCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*IssueCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IssueCredentialsRequest) GetStore() *credentialstores.CredentialStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *IssueCredentialsRequest) GetPersisted() *CredentialStorePersisted {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+func (x *IssueCredentialsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *IssueCredentialsRequest) GetSessionExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionExpirationTime
+	}
+	return nil
+}
+
+func (x *IssueCredentialsRequest) GetRequests() []*CredentialRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *IssueCredentialsRequest) GetTemplateData() *TemplateData {
+	if x != nil {
+		return x.TemplateData
+	}
+	return nil
+}
+
+// TemplateData contains the user and account information available to
+// credential templates. Fields which are not known are not set.
+type TemplateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User    *TemplateUser    `protobuf:"bytes,10,opt,name=user,proto3" json:"user,omitempty"`
+	Account *TemplateAccount `protobuf:"bytes,20,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *TemplateData) Reset() {
+	*x = TemplateData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateData) ProtoMessage() {}
+
+func (x *TemplateData) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateData.ProtoReflect.Descriptor instead.
+func (*TemplateData) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TemplateData) GetUser() *TemplateUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *TemplateData) GetAccount() *TemplateAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type TemplateUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name     *wrapperspb.StringValue `protobuf:"bytes,20,opt,name=name,proto3" json:"name,omitempty"`
+	FullName *wrapperspb.StringValue `protobuf:"bytes,30,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email    *wrapperspb.StringValue `protobuf:"bytes,40,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *TemplateUser) Reset() {
+	*x = TemplateUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateUser) ProtoMessage() {}
+
+func (x *TemplateUser) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateUser.ProtoReflect.Descriptor instead.
+func (*TemplateUser) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TemplateUser) GetId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TemplateUser) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TemplateUser) GetFullName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FullName
+	}
+	return nil
+}
+
+func (x *TemplateUser) GetEmail() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+type TemplateAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name      *wrapperspb.StringValue `protobuf:"bytes,20,opt,name=name,proto3" json:"name,omitempty"`
+	LoginName *wrapperspb.StringValue `protobuf:"bytes,30,opt,name=login_name,json=loginName,proto3" json:"login_name,omitempty"`
+	Subject   *wrapperspb.StringValue `protobuf:"bytes,40,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email     *wrapperspb.StringValue `protobuf:"bytes,50,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *TemplateAccount) Reset() {
+	*x = TemplateAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateAccount) ProtoMessage() {}
+
+func (x *TemplateAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateAccount.ProtoReflect.Descriptor instead.
+func (*TemplateAccount) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TemplateAccount) GetId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TemplateAccount) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *TemplateAccount) GetLoginName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.LoginName
+	}
+	return nil
+}
+
+func (x *TemplateAccount) GetSubject() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *TemplateAccount) GetEmail() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+type CredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The credential library to issue the credential from.
+	Library *credentiallibraries.CredentialLibrary `protobuf:"bytes,10,opt,name=library,proto3" json:"library,omitempty"`
+	// The purpose of the credential within the session.
+	Purpose string `protobuf:"bytes,20,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (x *CredentialRequest) Reset() {
+	*x = CredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialRequest) ProtoMessage() {}
+
+func (x *CredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialRequest.ProtoReflect.Descriptor instead.
+func (*CredentialRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CredentialRequest) GetLibrary() *credentiallibraries.CredentialLibrary {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+func (x *CredentialRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+type IssueCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The issued credentials, in the order of the requests.
+	Credentials []*IssuedCredential `protobuf:"bytes,10,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *IssueCredentialsResponse) Reset() {
+	*x = IssueCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCredentialsResponse) ProtoMessage() {}
+
+func (x *IssueCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*IssueCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IssueCredentialsResponse) GetCredentials() []*IssuedCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type IssuedCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the credential library the credential was issued from.
+	LibraryId string `protobuf:"bytes,10,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	// The purpose of the credential within the session.
+	Purpose string `protobuf:"bytes,20,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// An identifier for the credential in the secret manager, passed back to
+	// the plugin when the credential is revoked.
+	ExternalId string `protobuf:"bytes,30,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// The secret data of the credential.
+	Secret *structpb.Struct `protobuf:"bytes,40,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The time the credential expires, if any.
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,50,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// Whether the credential must be revoked when the session ends.
+	Revocable bool `protobuf:"varint,60,opt,name=revocable,proto3" json:"revocable,omitempty"`
+}
+
+func (x *IssuedCredential) Reset() {
+	*x = IssuedCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuedCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedCredential) ProtoMessage() {}
+
+func (x *IssuedCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedCredential.ProtoReflect.Descriptor instead.
+func (*IssuedCredential) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IssuedCredential) GetLibraryId() string {
+	if x != nil {
+		return x.LibraryId
+	}
+	return ""
+}
+
+func (x *IssuedCredential) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *IssuedCredential) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *IssuedCredential) GetSecret() *structpb.Struct {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *IssuedCredential) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *IssuedCredential) GetRevocable() bool {
+	if x != nil {
+		return x.Revocable
+	}
+	return false
+}
+
+type RevokeCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The credential store the credentials were issued from.
+	Store *credentialstores.CredentialStore `protobuf:"bytes,10,opt,name=store,proto3" json:"store,omitempty"`
+	// The persisted data for the credential store.
+	Persisted *CredentialStorePersisted `protobuf:"bytes,20,opt,name=persisted,proto3" json:"persisted,omitempty"`
+	// The id of the session the credentials were issued for.
+	SessionId string `protobuf:"bytes,30,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The credentials to revoke.
+	Credentials []*RevokeCredential `protobuf:"bytes,40,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RevokeCredentialsRequest) Reset() {
+	*x = RevokeCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCredentialsRequest) ProtoMessage() {}
+
+func (x *RevokeCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeCredentialsRequest) GetStore() *credentialstores.CredentialStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *RevokeCredentialsRequest) GetPersisted() *CredentialStorePersisted {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+func (x *RevokeCredentialsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RevokeCredentialsRequest) GetCredentials() []*RevokeCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type RevokeCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the credential library the credential was issued from.
+	LibraryId string `protobuf:"bytes,10,opt,name=library_id,json=libraryId,proto3" json:"library_id,omitempty"`
+	// The identifier returned by the plugin when the credential was issued.
+	ExternalId string `protobuf:"bytes,20,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (x *RevokeCredential) Reset() {
+	*x = RevokeCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCredential) ProtoMessage() {}
+
+func (x *RevokeCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCredential.ProtoReflect.Descriptor instead.
+func (*RevokeCredential) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeCredential) GetLibraryId() string {
+	if x != nil {
+		return x.LibraryId
+	}
+	return ""
+}
+
+func (x *RevokeCredential) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type RevokeCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The external ids of the credentials which were revoked. Credentials which
+	// are not listed will be retried.
+	RevokedExternalIds []string `protobuf:"bytes,10,rep,name=revoked_external_ids,json=revokedExternalIds,proto3" json:"revoked_external_ids,omitempty"`
+}
+
+func (x *RevokeCredentialsResponse) Reset() {
+	*x = RevokeCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCredentialsResponse) ProtoMessage() {}
+
+func (x *RevokeCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevokeCredentialsResponse) GetRevokedExternalIds() []string {
+	if x != nil {
+		return x.RevokedExternalIds
+	}
+	return nil
+}
+
+// CredentialStorePersisted is persisted data for a credential store.
+type CredentialStorePersisted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The persisted secrets.
+	Secrets *structpb.Struct `protobuf:"bytes,100,opt,name=secrets,proto3" json:"secrets,omitempty"`
+}
+
+func (x *CredentialStorePersisted) Reset() {
+	*x = CredentialStorePersisted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialStorePersisted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialStorePersisted) ProtoMessage() {}
+
+func (x *CredentialStorePersisted) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_v1_credential_plugin_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialStorePersisted.ProtoReflect.Descriptor instead.
+func (*CredentialStorePersisted) Descriptor() ([]byte, []int) {
+	return file_plugin_v1_credential_plugin_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CredentialStorePersisted) GetSecrets() *structpb.Struct {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+var File_plugin_v1_credential_plugin_service_proto protoreflect.FileDescriptor
+
+var file_plugin_v1_credential_plugin_service_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x48, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x43, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x54, 0x0a, 0x19, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x1a, 0x4e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x6b, 0x0a, 0x14, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x05, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x5a,
+	0x0a, 0x15, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x14, 0x4f,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x5a, 0x0a, 0x15, 0x4f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x05, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x41, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x03, 0x0a,
+	0x17, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x41, 0x0a,
+	0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x52, 0x0a, 0x17, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3c, 0x0a,
+	0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x3c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x0c, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdd,
+	0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x39, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x9a,
+	0x02, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x5c, 0x0a, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70,
+	0x6f, 0x73, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x6f, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x52, 0x09, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x52, 0x0a, 0x10, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x4d,
+	0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x4d, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x32, 0xb5, 0x04, 0x0a,
+	0x17, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x12, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x4f,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0d, 0x4f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x62, 0x73, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x3b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_plugin_v1_credential_plugin_service_proto_rawDescOnce sync.Once
+	file_plugin_v1_credential_plugin_service_proto_rawDescData = file_plugin_v1_credential_plugin_service_proto_rawDesc
+)
+
+func file_plugin_v1_credential_plugin_service_proto_rawDescGZIP() []byte {
+	file_plugin_v1_credential_plugin_service_proto_rawDescOnce.Do(func() {
+		file_plugin_v1_credential_plugin_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_plugin_v1_credential_plugin_service_proto_rawDescData)
+	})
+	return file_plugin_v1_credential_plugin_service_proto_rawDescData
+}
+
+var file_plugin_v1_credential_plugin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_plugin_v1_credential_plugin_service_proto_goTypes = []interface{}{
+	(*NormalizeStoreDataRequest)(nil),             // 0: plugin.v1.NormalizeStoreDataRequest
+	(*NormalizeStoreDataResponse)(nil),            // 1: plugin.v1.NormalizeStoreDataResponse
+	(*OnCreateStoreRequest)(nil),                  // 2: plugin.v1.OnCreateStoreRequest
+	(*OnCreateStoreResponse)(nil),                 // 3: plugin.v1.OnCreateStoreResponse
+	(*OnUpdateStoreRequest)(nil),                  // 4: plugin.v1.OnUpdateStoreRequest
+	(*OnUpdateStoreResponse)(nil),                 // 5: plugin.v1.OnUpdateStoreResponse
+	(*OnDeleteStoreRequest)(nil),                  // 6: plugin.v1.OnDeleteStoreRequest
+	(*OnDeleteStoreResponse)(nil),                 // 7: plugin.v1.OnDeleteStoreResponse
+	(*IssueCredentialsRequest)(nil),               // 8: plugin.v1.IssueCredentialsRequest
+	(*TemplateData)(nil),                          // 9: plugin.v1.TemplateData
+	(*TemplateUser)(nil),                          // 10: plugin.v1.TemplateUser
+	(*TemplateAccount)(nil),                       // 11: plugin.v1.TemplateAccount
+	(*CredentialRequest)(nil),                     // 12: plugin.v1.CredentialRequest
+	(*IssueCredentialsResponse)(nil),              // 13: plugin.v1.IssueCredentialsResponse
+	(*IssuedCredential)(nil),                      // 14: plugin.v1.IssuedCredential
+	(*RevokeCredentialsRequest)(nil),              // 15: plugin.v1.RevokeCredentialsRequest
+	(*RevokeCredential)(nil),                      // 16: plugin.v1.RevokeCredential
+	(*RevokeCredentialsResponse)(nil),             // 17: plugin.v1.RevokeCredentialsResponse
+	(*CredentialStorePersisted)(nil),              // 18: plugin.v1.CredentialStorePersisted
+	(*structpb.Struct)(nil),                       // 19: google.protobuf.Struct
+	(*credentialstores.CredentialStore)(nil),      // 20: controller.api.resources.credentialstores.v1.CredentialStore
+	(*timestamppb.Timestamp)(nil),                 // 21: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),                // 22: google.protobuf.StringValue
+	(*credentiallibraries.CredentialLibrary)(nil), // 23: controller.api.resources.credentiallibraries.v1.CredentialLibrary
+}
+var file_plugin_v1_credential_plugin_service_proto_depIdxs = []int32{
+	19, // 0: plugin.v1.NormalizeStoreDataRequest.attributes:type_name -> google.protobuf.Struct
+	19, // 1: plugin.v1.NormalizeStoreDataResponse.attributes:type_name -> google.protobuf.Struct
+	20, // 2: plugin.v1.OnCreateStoreRequest.store:type_name -> controller.api.resources.credentialstores.v1.CredentialStore
+	18, // 3: plugin.v1.OnCreateStoreResponse.persisted:type_name -> plugin.v1.CredentialStorePersisted
+	20, // 4: plugin.v1.OnUpdateStoreRequest.current_store:type_name -> controller.api.resources.credentialstores.v1.CredentialStore
+	20, // 5: plugin.v1.OnUpdateStoreRequest.new_store:type_name -> controller.api.resources.credentialstores.v1.CredentialStore
+	18, // 6: plugin.v1.OnUpdateStoreRequest.persisted:type_name -> plugin.v1.CredentialStorePersisted
+	18, // 7: plugin.v1.OnUpdateStoreResponse.persisted:type_name -> plugin.v1.CredentialStorePersisted
+	20, // 8: plugin.v1.OnDeleteStoreRequest.store:type_name -> controller.api.resources.credentialstores.v1.CredentialStore
+	18, // 9: plugin.v1.OnDeleteStoreRequest.persisted:type_name -> plugin.v1.CredentialStorePersisted
+	20, // 10: plugin.v1.IssueCredentialsRequest.store:type_name -> controller.api.resources.credentialstores.v1.CredentialStore
+	18, // 11: plugin.v1.IssueCredentialsRequest.persisted:type_name -> plugin.v1.CredentialStorePersisted
+	21, // 12: plugin.v1.IssueCredentialsRequest.session_expiration_time:type_name -> google.protobuf.Timestamp
+	12, // 13: plugin.v1.IssueCredentialsRequest.requests:type_name -> plugin.v1.CredentialRequest
+	9,  // 14: plugin.v1.IssueCredentialsRequest.template_data:type_name -> plugin.v1.TemplateData
+	10, // 15: plugin.v1.TemplateData.user:type_name -> plugin.v1.TemplateUser
+	11, // 16: plugin.v1.TemplateData.account:type_name -> plugin.v1.TemplateAccount
+	22, // 17: plugin.v1.TemplateUser.id:type_name -> google.protobuf.StringValue
+	22, // 18: plugin.v1.TemplateUser.name:type_name -> google.protobuf.StringValue
+	22, // 19: plugin.v1.TemplateUser.full_name:type_name -> google.protobuf.StringValue
+	22, // 20: plugin.v1.TemplateUser.email:type_name -> google.protobuf.StringValue
+	22, // 21: plugin.v1.TemplateAccount.id:type_name -> google.protobuf.StringValue
+	22, // 22: plugin.v1.TemplateAccount.name:type_name -> google.protobuf.StringValue
+	22, // 23: plugin.v1.TemplateAccount.login_name:type_name -> google.protobuf.StringValue
+	22, // 24: plugin.v1.TemplateAccount.subject:type_name -> google.protobuf.StringValue
+	22, // 25: plugin.v1.TemplateAccount.email:type_name -> google.protobuf.StringValue
+	23, // 26: plugin.v1.CredentialRequest.library:type_name -> controller.api.resources.credentiallibraries.v1.CredentialLibrary
+	14, // 27: plugin.v1.IssueCredentialsResponse.credentials:type_name -> plugin.v1.IssuedCredential
+	19, // 28: plugin.v1.IssuedCredential.secret:type_name -> google.protobuf.Struct
+	21, // 29: plugin.v1.IssuedCredential.expiration_time:type_name -> google.protobuf.Timestamp
+	20, // 30: plugin.v1.RevokeCredentialsRequest.store:type_name -> controller.api.resources.credentialstores.v1.CredentialStore
+	18, // 31: plugin.v1.RevokeCredentialsRequest.persisted:type_name -> plugin.v1.CredentialStorePersisted
+	16, // 32: plugin.v1.RevokeCredentialsRequest.credentials:type_name -> plugin.v1.RevokeCredential
+	19, // 33: plugin.v1.CredentialStorePersisted.secrets:type_name -> google.protobuf.Struct
+	0,  // 34: plugin.v1.CredentialPluginService.NormalizeStoreData:input_type -> plugin.v1.NormalizeStoreDataRequest
+	2,  // 35: plugin.v1.CredentialPluginService.OnCreateStore:input_type -> plugin.v1.OnCreateStoreRequest
+	4,  // 36: plugin.v1.CredentialPluginService.OnUpdateStore:input_type -> plugin.v1.OnUpdateStoreRequest
+	6,  // 37: plugin.v1.CredentialPluginService.OnDeleteStore:input_type -> plugin.v1.OnDeleteStoreRequest
+	8,  // 38: plugin.v1.CredentialPluginService.IssueCredentials:input_type -> plugin.v1.IssueCredentialsRequest
+	15, // 39: plugin.v1.CredentialPluginService.RevokeCredentials:input_type -> plugin.v1.RevokeCredentialsRequest
+	1,  // 40: plugin.v1.CredentialPluginService.NormalizeStoreData:output_type -> plugin.v1.NormalizeStoreDataResponse
+	3,  // 41: plugin.v1.CredentialPluginService.OnCreateStore:output_type -> plugin.v1.OnCreateStoreResponse
+	5,  // 42: plugin.v1.CredentialPluginService.OnUpdateStore:output_type -> plugin.v1.OnUpdateStoreResponse
+	7,  // 43: plugin.v1.CredentialPluginService.OnDeleteStore:output_type -> plugin.v1.OnDeleteStoreResponse
+	13, // 44: plugin.v1.CredentialPluginService.IssueCredentials:output_type -> plugin.v1.IssueCredentialsResponse
+	17, // 45: plugin.v1.CredentialPluginService.RevokeCredentials:output_type -> plugin.v1.RevokeCredentialsResponse
+	40, // [40:46] is the sub-list for method output_type
+	34, // [34:40] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_plugin_v1_credential_plugin_service_proto_init() }
+func file_plugin_v1_credential_plugin_service_proto_init() {
+	if File_plugin_v1_credential_plugin_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeStoreDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeStoreDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnCreateStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnCreateStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnUpdateStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnUpdateStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnDeleteStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnDeleteStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuedCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_v1_credential_plugin_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialStorePersisted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_plugin_v1_credential_plugin_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugin_v1_credential_plugin_service_proto_goTypes,
+		DependencyIndexes: file_plugin_v1_credential_plugin_service_proto_depIdxs,
+		MessageInfos:      file_plugin_v1_credential_plugin_service_proto_msgTypes,
+	}.Build()
+	File_plugin_v1_credential_plugin_service_proto = out.File
+	file_plugin_v1_credential_plugin_service_proto_rawDesc = nil
+	file_plugin_v1_credential_plugin_service_proto_goTypes = nil
+	file_plugin_v1_credential_plugin_service_proto_depIdxs = nil
+}
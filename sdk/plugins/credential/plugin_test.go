@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external_credential_plugins
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type testCredentialPlugin struct {
+	pb.UnimplementedCredentialPluginServiceServer
+}
+
+func (testCredentialPlugin) IssueCredentials(_ context.Context, req *pb.IssueCredentialsRequest) (*pb.IssueCredentialsResponse, error) {
+	resp := &pb.IssueCredentialsResponse{}
+	for _, r := range req.GetRequests() {
+		secret, err := structpb.NewStruct(map[string]any{"session_id": req.GetSessionId()})
+		if err != nil {
+			return nil, err
+		}
+		resp.Credentials = append(resp.Credentials, &pb.IssuedCredential{
+			LibraryId:  r.GetLibrary().GetId(),
+			Purpose:    r.GetPurpose(),
+			ExternalId: "ext_" + r.GetLibrary().GetId(),
+			Secret:     secret,
+		})
+	}
+	return resp, nil
+}
+
+func TestNewCredentialPluginServiceServer(t *testing.T) {
+	_, err := NewCredentialPluginServiceServer(nil)
+	assert.Error(t, err)
+
+	srv, err := NewCredentialPluginServiceServer(testCredentialPlugin{})
+	require.NoError(t, err)
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{credentialServicePluginSetName: srv})
+	t.Cleanup(func() { _ = client.Close() })
+
+	raw, err := client.Dispense(credentialServicePluginSetName)
+	require.NoError(t, err)
+	cp, ok := raw.(pb.CredentialPluginServiceClient)
+	require.True(t, ok)
+
+	resp, err := cp.IssueCredentials(context.Background(), &pb.IssueCredentialsRequest{
+		SessionId: "s_1234567890",
+		Requests: []*pb.CredentialRequest{
+			{Purpose: "brokered"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetCredentials(), 1)
+	assert.Equal(t, "brokered", resp.GetCredentials()[0].GetPurpose())
+	assert.Equal(t, "s_1234567890", resp.GetCredentials()[0].GetSecret().AsMap()["session_id"])
+
+	_, err = cp.RevokeCredentials(context.Background(), &pb.RevokeCredentialsRequest{})
+	assert.Error(t, err)
+}
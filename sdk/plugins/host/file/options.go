@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package file
+
+// getOpts iterates the inbound Options and returns a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		if o != nil {
+			o(&opts)
+		}
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withAllowedDir string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithAllowedDir provides the directory the hosts files of catalogs must be
+// in. Without it, no catalog can be created.
+func WithAllowedDir(dir string) Option {
+	return func(o *options) {
+		o.withAllowedDir = dir
+	}
+}
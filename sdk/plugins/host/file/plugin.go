@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package file provides a host plugin which reads hosts from a JSON or YAML
+// file on the controller. It is a reference for host plugin authors, and is
+// also useful where hosts cannot be discovered from a cloud API, such as in
+// air-gapped deployments.
+//
+// A catalog's "path" attribute is the absolute path of the file, which must
+// exist on every controller and be in the directory the plugin is allowed to
+// read, see WithAllowedDir. The file lists the hosts:
+//
+//	hosts:
+//	  - id: web-1
+//	    name: web-1
+//	    description: Primary web server
+//	    ip_addresses: ["10.0.0.1"]
+//	    dns_names: ["web-1.internal.example.com"]
+//	    labels:
+//	      env: prod
+//	      role: web
+//
+// A host set's "match_labels" attribute selects the hosts having all of the
+// given labels; a set without it holds every host in the file. The file is
+// watched: it is read again whenever its modification time or size changes,
+// so edits are picked up on the next sync of the host sets.
+package file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+// PluginName is the name the plugin is registered with.
+const PluginName = "file"
+
+const (
+	pathAttrField        = "path"
+	matchLabelsAttrField = "match_labels"
+)
+
+var _ pb.HostPluginServiceServer = (*Plugin)(nil)
+
+// Plugin implements the host plugin service for hosts listed in files. It is
+// safe for concurrent use.
+type Plugin struct {
+	pb.UnimplementedHostPluginServiceServer
+
+	allowedDir string
+
+	mu    sync.Mutex
+	files map[string]*hostsFile
+}
+
+// NewPlugin returns a new file host plugin. Supported options:
+// WithAllowedDir.
+func NewPlugin(opt ...Option) *Plugin {
+	opts := getOpts(opt...)
+	p := &Plugin{files: make(map[string]*hostsFile)}
+	if opts.withAllowedDir != "" {
+		p.allowedDir = filepath.Clean(opts.withAllowedDir)
+	}
+	return p
+}
+
+// hostsFile is the parsed content of a file along with the modification time
+// and size of the file when it was read.
+type hostsFile struct {
+	modTime time.Time
+	size    int64
+	hosts   []*fileHost
+}
+
+type fileContent struct {
+	Hosts []*fileHost `yaml:"hosts"`
+}
+
+type fileHost struct {
+	Id          string            `yaml:"id"`
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	IpAddresses []string          `yaml:"ip_addresses"`
+	DnsNames    []string          `yaml:"dns_names"`
+	Labels      map[string]string `yaml:"labels"`
+}
+
+// NormalizeCatalogData validates the catalog attributes and cleans the path
+// of the file.
+func (p *Plugin) NormalizeCatalogData(_ context.Context, req *pb.NormalizeCatalogDataRequest) (*pb.NormalizeCatalogDataResponse, error) {
+	path, err := p.getPath(req.GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := structpb.NewStruct(map[string]any{pathAttrField: path})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error building attributes: %s", err)
+	}
+	return &pb.NormalizeCatalogDataResponse{Attributes: attrs}, nil
+}
+
+// OnCreateCatalog checks that the file can be read. The plugin does not use
+// secrets, so none are persisted.
+func (p *Plugin) OnCreateCatalog(_ context.Context, req *pb.OnCreateCatalogRequest) (*pb.OnCreateCatalogResponse, error) {
+	if err := p.checkCatalog(req.GetCatalog().GetAttributes(), req.GetCatalog().GetSecrets()); err != nil {
+		return nil, err
+	}
+	return &pb.OnCreateCatalogResponse{}, nil
+}
+
+// OnUpdateCatalog checks that the file can be read.
+func (p *Plugin) OnUpdateCatalog(_ context.Context, req *pb.OnUpdateCatalogRequest) (*pb.OnUpdateCatalogResponse, error) {
+	if err := p.checkCatalog(req.GetNewCatalog().GetAttributes(), req.GetNewCatalog().GetSecrets()); err != nil {
+		return nil, err
+	}
+	return &pb.OnUpdateCatalogResponse{}, nil
+}
+
+// OnDeleteCatalog forgets the file of the catalog.
+func (p *Plugin) OnDeleteCatalog(_ context.Context, req *pb.OnDeleteCatalogRequest) (*pb.OnDeleteCatalogResponse, error) {
+	if path, err := p.getPath(req.GetCatalog().GetAttributes()); err == nil {
+		p.mu.Lock()
+		delete(p.files, path)
+		p.mu.Unlock()
+	}
+	return &pb.OnDeleteCatalogResponse{}, nil
+}
+
+// NormalizeSetData validates the set attributes.
+func (p *Plugin) NormalizeSetData(_ context.Context, req *pb.NormalizeSetDataRequest) (*pb.NormalizeSetDataResponse, error) {
+	if _, err := getMatchLabels(req.GetAttributes()); err != nil {
+		return nil, err
+	}
+	return &pb.NormalizeSetDataResponse{}, nil
+}
+
+// OnCreateSet validates the set attributes.
+func (p *Plugin) OnCreateSet(_ context.Context, req *pb.OnCreateSetRequest) (*pb.OnCreateSetResponse, error) {
+	if _, err := getMatchLabels(req.GetSet().GetAttributes()); err != nil {
+		return nil, err
+	}
+	return &pb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet validates the set attributes.
+func (p *Plugin) OnUpdateSet(_ context.Context, req *pb.OnUpdateSetRequest) (*pb.OnUpdateSetResponse, error) {
+	if _, err := getMatchLabels(req.GetNewSet().GetAttributes()); err != nil {
+		return nil, err
+	}
+	return &pb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op.
+func (p *Plugin) OnDeleteSet(context.Context, *pb.OnDeleteSetRequest) (*pb.OnDeleteSetResponse, error) {
+	return &pb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts returns the hosts in the file which match at least one of the
+// sets, reading the file again if it changed since it was last read.
+func (p *Plugin) ListHosts(_ context.Context, req *pb.ListHostsRequest) (*pb.ListHostsResponse, error) {
+	path, err := p.getPath(req.GetCatalog().GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+	type setMatcher struct {
+		id     string
+		labels map[string]string
+	}
+	sets := make([]setMatcher, 0, len(req.GetSets()))
+	for _, s := range req.GetSets() {
+		if s.GetId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "set is missing an id")
+		}
+		labels, err := getMatchLabels(s.GetAttributes())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "set %s: %s", s.GetId(), status.Convert(err).Message())
+		}
+		sets = append(sets, setMatcher{id: s.GetId(), labels: labels})
+	}
+
+	f, err := p.load(path)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListHostsResponse{}
+	for _, h := range f.hosts {
+		var setIds []string
+		for _, s := range sets {
+			if matches(h.Labels, s.labels) {
+				setIds = append(setIds, s.id)
+			}
+		}
+		if len(setIds) == 0 {
+			continue
+		}
+		labels := make(map[string]any, len(h.Labels))
+		for k, v := range h.Labels {
+			labels[k] = v
+		}
+		attrs, err := structpb.NewStruct(map[string]any{"labels": labels})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error building attributes of host %q: %s", h.Id, err)
+		}
+		resp.Hosts = append(resp.Hosts, &pb.ListHostsResponseHost{
+			ExternalId:  h.Id,
+			Name:        h.Name,
+			Description: h.Description,
+			IpAddresses: h.IpAddresses,
+			DnsNames:    h.DnsNames,
+			SetIds:      setIds,
+			Attributes:  attrs,
+		})
+	}
+	return resp, nil
+}
+
+func (p *Plugin) checkCatalog(attrs, secrets *structpb.Struct) error {
+	if len(secrets.GetFields()) > 0 {
+		return status.Error(codes.InvalidArgument, "the file host plugin does not use secrets")
+	}
+	path, err := p.getPath(attrs)
+	if err != nil {
+		return err
+	}
+	_, err = p.load(path)
+	return err
+}
+
+// load returns the hosts in the file at path, reading and parsing the file if
+// it has not been read before or has changed since it was last read. The
+// file must be in the allowed directory once symbolic links are resolved.
+func (p *Plugin) load(path string) (*hostsFile, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error reading hosts file: %s", err)
+	}
+	allowedDir, err := filepath.EvalSymlinks(p.allowedDir)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error reading allowed directory: %s", err)
+	}
+	if !inDir(allowedDir, resolved) {
+		return nil, status.Error(codes.FailedPrecondition, "hosts file is not in the allowed directory")
+	}
+	fi, err := os.Stat(resolved)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error reading hosts file: %s", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if f, ok := p.files[path]; ok && f.modTime.Equal(fi.ModTime()) && f.size == fi.Size() {
+		return f, nil
+	}
+
+	b, err := os.ReadFile(resolved)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error reading hosts file: %s", err)
+	}
+	hosts, err := parse(b)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error parsing hosts file %s: %s", path, err)
+	}
+	f := &hostsFile{modTime: fi.ModTime(), size: fi.Size(), hosts: hosts}
+	p.files[path] = f
+	return f, nil
+}
+
+// parse parses and validates the content of a hosts file. JSON is parsed as
+// YAML, of which it is a subset. The errors of the YAML parser are not
+// returned since they can quote the content of the file, and the error of
+// a sync is shown to users who may not read the file.
+func parse(b []byte) ([]*fileHost, error) {
+	var content fileContent
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&content); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.New("not a valid JSON or YAML hosts file")
+	}
+
+	seen := make(map[string]bool, len(content.Hosts))
+	for i, h := range content.Hosts {
+		switch {
+		case h == nil, strings.TrimSpace(h.Id) == "":
+			return nil, fmt.Errorf("host %d: missing id", i)
+		case seen[h.Id]:
+			return nil, fmt.Errorf("host %q: duplicate id", h.Id)
+		case len(h.IpAddresses) == 0 && len(h.DnsNames) == 0:
+			return nil, fmt.Errorf("host %q: at least one ip address or dns name is required", h.Id)
+		}
+		seen[h.Id] = true
+		for _, ip := range h.IpAddresses {
+			if net.ParseIP(ip) == nil {
+				return nil, fmt.Errorf("host %q: invalid ip address %q", h.Id, ip)
+			}
+		}
+		for _, n := range h.DnsNames {
+			if strings.TrimSpace(n) == "" {
+				return nil, fmt.Errorf("host %q: empty dns name", h.Id)
+			}
+		}
+	}
+	sort.SliceStable(content.Hosts, func(i, j int) bool { return content.Hosts[i].Id < content.Hosts[j].Id })
+	return content.Hosts, nil
+}
+
+func matches(labels, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// inDir reports if path is dir or is in dir. Both must be clean absolute
+// paths.
+func inDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (p *Plugin) getPath(attrs *structpb.Struct) (string, error) {
+	var path string
+	for k, v := range attrs.GetFields() {
+		switch k {
+		case pathAttrField:
+			path = v.GetStringValue()
+		default:
+			return "", status.Errorf(codes.InvalidArgument, "attributes.%s: unknown field", k)
+		}
+	}
+	switch {
+	case path == "":
+		return "", status.Error(codes.InvalidArgument, "attributes.path: missing value")
+	case !filepath.IsAbs(path):
+		return "", status.Error(codes.InvalidArgument, "attributes.path: must be an absolute path")
+	case p.allowedDir == "":
+		return "", status.Error(codes.FailedPrecondition, "the file host plugin has no allowed directory")
+	}
+	path = filepath.Clean(path)
+	if !inDir(p.allowedDir, path) {
+		return "", status.Error(codes.InvalidArgument, "attributes.path: must be in the allowed directory")
+	}
+	return path, nil
+}
+
+func getMatchLabels(attrs *structpb.Struct) (map[string]string, error) {
+	labels := make(map[string]string)
+	for k, v := range attrs.GetFields() {
+		if k != matchLabelsAttrField {
+			return nil, status.Errorf(codes.InvalidArgument, "attributes.%s: unknown field", k)
+		}
+		if _, ok := v.GetKind().(*structpb.Value_NullValue); ok {
+			continue
+		}
+		m := v.GetStructValue()
+		if m == nil {
+			return nil, status.Error(codes.InvalidArgument, "attributes.match_labels: must be an object")
+		}
+		for lk, lv := range m.GetFields() {
+			s, ok := lv.GetKind().(*structpb.Value_StringValue)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "attributes.match_labels.%s: must be a string", lk)
+			}
+			labels[lk] = s.StringValue
+		}
+	}
+	return labels, nil
+}
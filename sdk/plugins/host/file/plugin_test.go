@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/boundary/sdk/plugins/host/hostplugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testYaml = `
+hosts:
+  - id: web-1
+    name: web-1
+    description: Primary web server
+    ip_addresses: ["10.0.0.1"]
+    dns_names: ["web-1.internal.example.com"]
+    labels:
+      env: prod
+      role: web
+  - id: web-2
+    ip_addresses: ["10.0.0.2", "fd00::2"]
+    labels:
+      env: staging
+      role: web
+  - id: db-1
+    dns_names: ["db-1.internal.example.com"]
+    labels:
+      env: prod
+      role: db
+`
+
+const testJson = `{
+  "hosts": [
+    {"id": "web-1", "ip_addresses": ["10.0.0.1"], "labels": {"env": "prod", "role": "web"}},
+    {"id": "web-2", "ip_addresses": ["10.0.0.2"], "labels": {"env": "staging", "role": "web"}},
+    {"id": "db-1", "dns_names": ["db-1.internal.example.com"], "labels": {"env": "prod", "role": "db"}}
+  ]
+}`
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestPlugin_Conformance(t *testing.T) {
+	for _, tc := range []struct {
+		name, file, content string
+	}{
+		{name: "yaml", file: "hosts.yaml", content: testYaml},
+		{name: "json", file: "hosts.json", content: testJson},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := writeFile(t, dir, tc.file, tc.content)
+			hostplugintest.Run(t, hostplugintest.Config{
+				Plugin:            NewPlugin(WithAllowedDir(dir)),
+				CatalogAttributes: map[string]any{"path": path},
+				Sets: []map[string]any{
+					{"match_labels": map[string]any{"role": "web"}},
+					{"match_labels": map[string]any{"env": "prod"}},
+					{},
+				},
+				CheckHosts: func(t *testing.T, setIds []string, hosts []*pb.ListHostsResponseHost) {
+					got := make(map[string][]string)
+					for _, h := range hosts {
+						got[h.GetExternalId()] = h.GetSetIds()
+					}
+					assert.Equal(t, map[string][]string{
+						"web-1": {setIds[0], setIds[1], setIds[2]},
+						"web-2": {setIds[0], setIds[2]},
+						"db-1":  {setIds[1], setIds[2]},
+					}, got)
+				},
+			})
+		})
+	}
+}
+
+func TestPlugin_Reload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := NewPlugin(WithAllowedDir(dir))
+	path := writeFile(t, dir, "hosts.yaml", testYaml)
+
+	attrs, err := structpb.NewStruct(map[string]any{"path": path})
+	require.NoError(t, err)
+	req := &pb.ListHostsRequest{
+		Catalog: &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: attrs}},
+		Sets:    []*hostsets.HostSet{{Id: "hsplg_1234567890"}},
+	}
+	resp, err := p.ListHosts(ctx, req)
+	require.NoError(t, err)
+	assert.Len(t, resp.GetHosts(), 3)
+
+	require.NoError(t, os.WriteFile(path, []byte(`hosts: [{id: web-3, ip_addresses: ["10.0.0.3"]}]`), 0o600))
+	// Make sure the change is seen even on filesystems with a coarse
+	// modification time.
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+	resp, err = p.ListHosts(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetHosts(), 1)
+	assert.Equal(t, "web-3", resp.GetHosts()[0].GetExternalId())
+
+	// An invalid file is reported rather than silently ignored.
+	require.NoError(t, os.WriteFile(path, []byte(`hosts: [{id: web-3}]`), 0o600))
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+	_, err = p.ListHosts(ctx, req)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "at least one ip address or dns name is required")
+}
+
+func TestPlugin_Errors(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p := NewPlugin(WithAllowedDir(dir))
+	outside := writeFile(t, t.TempDir(), "hosts.yaml", testYaml)
+	symlink := filepath.Join(dir, "symlink.yaml")
+	require.NoError(t, os.Symlink(outside, symlink))
+
+	tests := []struct {
+		name     string
+		content  string
+		attrs    map[string]any
+		secrets  map[string]any
+		wantCode codes.Code
+		wantErr  string
+	}{
+		{name: "missing-path", attrs: map[string]any{}, wantCode: codes.InvalidArgument, wantErr: "attributes.path: missing value"},
+		{name: "relative-path", attrs: map[string]any{"path": "hosts.yaml"}, wantCode: codes.InvalidArgument, wantErr: "must be an absolute path"},
+		{name: "unknown-attribute", attrs: map[string]any{"path": "/hosts.yaml", "region": "x"}, wantCode: codes.InvalidArgument, wantErr: "attributes.region"},
+		{name: "missing-file", attrs: map[string]any{"path": filepath.Join(dir, "missing.yaml")}, wantCode: codes.FailedPrecondition, wantErr: "error reading hosts file"},
+		{name: "outside-allowed-dir", attrs: map[string]any{"path": outside}, wantCode: codes.InvalidArgument, wantErr: "must be in the allowed directory"},
+		{name: "parent-of-allowed-dir", attrs: map[string]any{"path": filepath.Join(dir, "..", "hosts.yaml")}, wantCode: codes.InvalidArgument, wantErr: "must be in the allowed directory"},
+		{name: "symlink-outside-allowed-dir", attrs: map[string]any{"path": symlink}, wantCode: codes.FailedPrecondition, wantErr: "not in the allowed directory"},
+		{name: "secrets", content: testYaml, secrets: map[string]any{"token": "x"}, wantCode: codes.InvalidArgument, wantErr: "does not use secrets"},
+		{name: "unknown-field", content: `hosts: [{id: a, ip_addresses: ["10.0.0.1"], ip: "10.0.0.1"}]`, wantCode: codes.FailedPrecondition, wantErr: "not a valid JSON or YAML hosts file"},
+		{name: "not-yaml", content: "password: [hunter2", wantCode: codes.FailedPrecondition, wantErr: "not a valid JSON or YAML hosts file"},
+		{name: "duplicate-id", content: `hosts: [{id: a, ip_addresses: ["10.0.0.1"]}, {id: a, ip_addresses: ["10.0.0.2"]}]`, wantCode: codes.FailedPrecondition, wantErr: "duplicate id"},
+		{name: "bad-ip", content: `hosts: [{id: a, ip_addresses: ["10.0.0.300"]}]`, wantCode: codes.FailedPrecondition, wantErr: "invalid ip address"},
+		{name: "missing-id", content: `hosts: [{ip_addresses: ["10.0.0.1"]}]`, wantCode: codes.FailedPrecondition, wantErr: "missing id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := tt.attrs
+			if attrs == nil {
+				attrs = map[string]any{"path": writeFile(t, dir, tt.name+".yaml", tt.content)}
+			}
+			a, err := structpb.NewStruct(attrs)
+			require.NoError(t, err)
+			cat := &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: a}}
+			if tt.secrets != nil {
+				cat.Secrets, err = structpb.NewStruct(tt.secrets)
+				require.NoError(t, err)
+			}
+			_, err = p.OnCreateCatalog(ctx, &pb.OnCreateCatalogRequest{Catalog: cat})
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.NotContains(t, err.Error(), "hunter2")
+		})
+	}
+
+	t.Run("no-allowed-dir", func(t *testing.T) {
+		a, err := structpb.NewStruct(map[string]any{"path": writeFile(t, dir, "no-allowed-dir.yaml", testYaml)})
+		require.NoError(t, err)
+		_, err = NewPlugin().OnCreateCatalog(ctx, &pb.OnCreateCatalogRequest{
+			Catalog: &hostcatalogs.HostCatalog{Attrs: &hostcatalogs.HostCatalog_Attributes{Attributes: a}},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "no allowed directory")
+	})
+
+	t.Run("bad-match-labels", func(t *testing.T) {
+		a, err := structpb.NewStruct(map[string]any{"match_labels": map[string]any{"env": 1}})
+		require.NoError(t, err)
+		_, err = p.NormalizeSetData(ctx, &pb.NormalizeSetDataRequest{Attributes: a})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "attributes.match_labels.env: must be a string")
+	})
+}
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package hostplugintest provides a conformance test harness for host
+// plugins. Run drives a plugin through the calls the controller makes over
+// the lifetime of a host catalog and its host sets, handling persisted
+// secrets the way the controller does, and checks that the hosts the plugin
+// lists can be synced into host sets.
+//
+// The harness talks to the plugin over an in-memory gRPC connection, so the
+// plugin sees requests exactly as it would when run by the controller.
+package hostplugintest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	hostplugin "github.com/hashicorp/boundary/sdk/plugins/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	testScopeId   = "p_1234567890"
+	testPluginId  = "pl_1234567890"
+	testCatalogId = "hcplg_1234567890"
+)
+
+// Config configures a conformance run.
+type Config struct {
+	// Plugin is the plugin under test. Required.
+	Plugin pb.HostPluginServiceServer
+
+	// CatalogAttributes are the attributes of the host catalog.
+	CatalogAttributes map[string]any
+
+	// CatalogSecrets are the secrets given when creating the host catalog.
+	// They may be nil if the plugin does not use secrets.
+	CatalogSecrets map[string]any
+
+	// UpdatedCatalogSecrets, if set, are given in an update of the host
+	// catalog after its hosts are first listed, as if the user rotated the
+	// catalog's credentials. Hosts are then listed again using whatever the
+	// plugin persisted.
+	UpdatedCatalogSecrets map[string]any
+
+	// Sets are the attributes of the host sets to create in the catalog. At
+	// least one is required.
+	Sets []map[string]any
+
+	// CheckHosts, if set, is called with the ids of the host sets and the
+	// hosts listed for all of them, after the hosts have been checked by the
+	// harness. It can be used to check which hosts the plugin found.
+	CheckHosts func(t *testing.T, setIds []string, hosts []*pb.ListHostsResponseHost)
+}
+
+// Run runs the conformance tests against the plugin in c. The hosts the
+// plugin lists must not change while Run is running.
+//
+// The calls are made in the order the controller makes them:
+//
+//   - NormalizeCatalogData and OnCreateCatalog when the catalog is created.
+//     Secrets the plugin returns are persisted; the secrets given by the user
+//     are never sent to the plugin again.
+//   - NormalizeSetData and OnCreateSet for each host set.
+//   - ListHosts for all the sets of the catalog, and for each set alone, as
+//     the set sync job only includes the sets which need to be synced.
+//   - OnUpdateCatalog, with and without new secrets. A nil persisted message
+//     in the response leaves the persisted secrets unchanged, an empty one
+//     deletes them and any other replaces them.
+//   - OnUpdateSet for each host set.
+//   - OnDeleteSet for each host set and OnDeleteCatalog.
+//
+// Calls which return codes.Unimplemented are treated as no-ops, except for
+// ListHosts.
+func Run(t *testing.T, c Config) {
+	t.Helper()
+	require.NotNil(t, c.Plugin, "a plugin is required")
+	require.NotEmpty(t, c.Sets, "at least one set is required")
+
+	h := &harness{
+		client: hostplugin.TestHostPluginClient(t, c.Plugin),
+		config: c,
+	}
+	steps := []struct {
+		name string
+		fn   func(*testing.T)
+	}{
+		{"create-catalog", h.createCatalog},
+		{"create-sets", h.createSets},
+		{"list-hosts", h.listHosts},
+		{"list-hosts-by-set", h.listHostsBySet},
+		{"update-catalog", h.updateCatalog},
+		{"update-sets", h.updateSets},
+		{"delete", h.delete},
+	}
+	for _, s := range steps {
+		if !t.Run(s.name, s.fn) {
+			return
+		}
+	}
+}
+
+// harness holds the state the controller would store between calls.
+type harness struct {
+	client pb.HostPluginServiceClient
+	config Config
+
+	catalog   *hostcatalogs.HostCatalog
+	persisted *pb.HostCatalogPersisted
+	sets      []*hostsets.HostSet
+	setIds    []string
+
+	// members maps each set id to the sorted external ids of its hosts, as
+	// listed for all sets.
+	members map[string][]string
+}
+
+func (h *harness) createCatalog(t *testing.T) {
+	ctx := context.Background()
+	attrs := toStruct(t, h.config.CatalogAttributes)
+	attrs = normalize(t, attrs, func(in *structpb.Struct) (*structpb.Struct, error) {
+		resp, err := h.client.NormalizeCatalogData(ctx, &pb.NormalizeCatalogDataRequest{Attributes: in})
+		return resp.GetAttributes(), err
+	})
+
+	cat := &hostcatalogs.HostCatalog{
+		Id:       testCatalogId,
+		ScopeId:  testScopeId,
+		PluginId: testPluginId,
+		Type:     "plugin",
+		Attrs:    &hostcatalogs.HostCatalog_Attributes{Attributes: attrs},
+	}
+	if h.config.CatalogSecrets != nil {
+		cat.Secrets = toStruct(t, h.config.CatalogSecrets)
+	}
+	resp, err := h.client.OnCreateCatalog(ctx, &pb.OnCreateCatalogRequest{Catalog: cat})
+	if status.Code(err) != codes.Unimplemented {
+		require.NoError(t, err, "OnCreateCatalog")
+	}
+	if s := resp.GetPersisted().GetSecrets(); s != nil && len(s.GetFields()) > 0 {
+		h.persisted = &pb.HostCatalogPersisted{Secrets: s}
+	}
+
+	// The controller stores the secrets encrypted and only ever gives the
+	// plugin what it persisted.
+	cat.Secrets = nil
+	h.catalog = cat
+}
+
+func (h *harness) createSets(t *testing.T) {
+	ctx := context.Background()
+	for i, a := range h.config.Sets {
+		attrs := normalize(t, toStruct(t, a), func(in *structpb.Struct) (*structpb.Struct, error) {
+			resp, err := h.client.NormalizeSetData(ctx, &pb.NormalizeSetDataRequest{Attributes: in})
+			return resp.GetAttributes(), err
+		})
+		set := &hostsets.HostSet{
+			Id:            fmt.Sprintf("hsplg_%010d", i+1),
+			HostCatalogId: h.catalog.GetId(),
+			Type:          "plugin",
+			Attrs:         &hostsets.HostSet_Attributes{Attributes: attrs},
+		}
+		_, err := h.client.OnCreateSet(ctx, &pb.OnCreateSetRequest{Catalog: h.catalog, Set: set, Persisted: h.persisted})
+		if status.Code(err) != codes.Unimplemented {
+			require.NoError(t, err, "OnCreateSet for set %d", i)
+		}
+		h.sets = append(h.sets, set)
+		h.setIds = append(h.setIds, set.GetId())
+	}
+}
+
+func (h *harness) listHosts(t *testing.T) {
+	hosts := h.list(t, h.sets)
+	h.members = membership(hosts)
+	if h.config.CheckHosts != nil {
+		h.config.CheckHosts(t, h.setIds, hosts)
+	}
+}
+
+func (h *harness) listHostsBySet(t *testing.T) {
+	for _, set := range h.sets {
+		got := membership(h.list(t, []*hostsets.HostSet{set}))
+		assert.Equal(t, h.members[set.GetId()], got[set.GetId()],
+			"hosts listed for set %s alone differ from the hosts listed for it with the other sets", set.GetId())
+	}
+}
+
+func (h *harness) updateCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	// An update which does not change the secrets must leave the plugin able
+	// to list hosts using what it persisted.
+	newCat := proto.Clone(h.catalog).(*hostcatalogs.HostCatalog)
+	newCat.Name = wrapperspb.String("updated")
+	resp, err := h.client.OnUpdateCatalog(ctx, &pb.OnUpdateCatalogRequest{
+		CurrentCatalog: h.catalog,
+		NewCatalog:     newCat,
+		Persisted:      h.persisted,
+	})
+	if status.Code(err) != codes.Unimplemented {
+		require.NoError(t, err, "OnUpdateCatalog without secrets")
+	}
+	h.applyPersisted(resp.GetPersisted())
+	h.catalog = newCat
+	assert.Equal(t, h.members, membership(h.list(t, h.sets)), "hosts changed after updating the catalog without secrets")
+
+	if h.config.UpdatedCatalogSecrets == nil {
+		return
+	}
+	newCat = proto.Clone(h.catalog).(*hostcatalogs.HostCatalog)
+	newCat.Secrets = toStruct(t, h.config.UpdatedCatalogSecrets)
+	resp, err = h.client.OnUpdateCatalog(ctx, &pb.OnUpdateCatalogRequest{
+		CurrentCatalog: h.catalog,
+		NewCatalog:     newCat,
+		Persisted:      h.persisted,
+	})
+	if status.Code(err) != codes.Unimplemented {
+		require.NoError(t, err, "OnUpdateCatalog with secrets")
+	}
+	h.applyPersisted(resp.GetPersisted())
+	newCat.Secrets = nil
+	h.catalog = newCat
+	assert.Equal(t, h.members, membership(h.list(t, h.sets)), "hosts changed after updating the catalog secrets")
+}
+
+func (h *harness) updateSets(t *testing.T) {
+	ctx := context.Background()
+	for i, set := range h.sets {
+		newSet := proto.Clone(set).(*hostsets.HostSet)
+		newSet.Name = wrapperspb.String("updated")
+		_, err := h.client.OnUpdateSet(ctx, &pb.OnUpdateSetRequest{
+			Catalog:    h.catalog,
+			CurrentSet: set,
+			NewSet:     newSet,
+			Persisted:  h.persisted,
+		})
+		if status.Code(err) != codes.Unimplemented {
+			require.NoError(t, err, "OnUpdateSet")
+		}
+		h.sets[i] = newSet
+	}
+}
+
+func (h *harness) delete(t *testing.T) {
+	ctx := context.Background()
+	for _, set := range h.sets {
+		_, err := h.client.OnDeleteSet(ctx, &pb.OnDeleteSetRequest{Catalog: h.catalog, Set: set, Persisted: h.persisted})
+		if status.Code(err) != codes.Unimplemented {
+			require.NoError(t, err, "OnDeleteSet")
+		}
+	}
+	_, err := h.client.OnDeleteCatalog(ctx, &pb.OnDeleteCatalogRequest{Catalog: h.catalog, Sets: h.sets, Persisted: h.persisted})
+	if status.Code(err) != codes.Unimplemented {
+		require.NoError(t, err, "OnDeleteCatalog")
+	}
+}
+
+// applyPersisted updates the persisted secrets the way the controller does
+// after a catalog update.
+func (h *harness) applyPersisted(p *pb.HostCatalogPersisted) {
+	switch {
+	case p.GetSecrets() == nil:
+	case len(p.GetSecrets().GetFields()) == 0:
+		h.persisted = nil
+	default:
+		h.persisted = &pb.HostCatalogPersisted{Secrets: p.GetSecrets()}
+	}
+}
+
+// list lists the hosts of sets and checks that the controller can sync them.
+func (h *harness) list(t *testing.T, sets []*hostsets.HostSet) []*pb.ListHostsResponseHost {
+	t.Helper()
+	resp, err := h.client.ListHosts(context.Background(), &pb.ListHostsRequest{
+		Catalog:   h.catalog,
+		Sets:      sets,
+		Persisted: h.persisted,
+	})
+	require.NoError(t, err, "ListHosts")
+
+	requested := make(map[string]bool, len(sets))
+	for _, s := range sets {
+		requested[s.GetId()] = true
+	}
+	seen := make(map[string]bool, len(resp.GetHosts()))
+	for _, host := range resp.GetHosts() {
+		id := host.GetExternalId()
+		require.NotEmpty(t, id, "host %v has no external id", host)
+		require.False(t, seen[id], "host %q was listed more than once", id)
+		seen[id] = true
+
+		assert.True(t, len(host.GetIpAddresses()) > 0 || len(host.GetDnsNames()) > 0, "host %q has no addresses", id)
+		for _, ip := range host.GetIpAddresses() {
+			assert.NotNil(t, net.ParseIP(ip), "host %q has invalid ip address %q", id, ip)
+		}
+		for _, n := range host.GetDnsNames() {
+			assert.NotEmpty(t, n, "host %q has an empty dns name", id)
+		}
+
+		require.NotEmpty(t, host.GetSetIds(), "host %q is not in any set", id)
+		inSet := make(map[string]bool, len(host.GetSetIds()))
+		for _, setId := range host.GetSetIds() {
+			assert.True(t, requested[setId], "host %q is in set %q which was not requested", id, setId)
+			assert.False(t, inSet[setId], "host %q lists set %q more than once", id, setId)
+			inSet[setId] = true
+		}
+	}
+	return resp.GetHosts()
+}
+
+// normalize calls fn, which calls a Normalize method of the plugin, the way
+// the controller does, and checks that normalizing is idempotent.
+func normalize(t *testing.T, in *structpb.Struct, fn func(*structpb.Struct) (*structpb.Struct, error)) *structpb.Struct {
+	t.Helper()
+	out, err := fn(in)
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		return in
+	case err != nil:
+		require.NoError(t, err, "normalizing attributes")
+	case out == nil:
+		out = in
+	}
+	again, err := fn(out)
+	require.NoError(t, err, "normalizing normalized attributes")
+	if again != nil {
+		assert.True(t, proto.Equal(out, again), "normalizing normalized attributes changed them from %v to %v", out.AsMap(), again.AsMap())
+	}
+	return out
+}
+
+func membership(hosts []*pb.ListHostsResponseHost) map[string][]string {
+	members := make(map[string][]string)
+	for _, h := range hosts {
+		for _, setId := range h.GetSetIds() {
+			members[setId] = append(members[setId], h.GetExternalId())
+		}
+	}
+	for _, ids := range members {
+		sort.Strings(ids)
+	}
+	return members
+}
+
+func toStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	if m == nil {
+		m = map[string]any{}
+	}
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
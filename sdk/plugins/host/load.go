@@ -55,7 +55,7 @@ func CreateHostPlugin(
 			opts.withPluginOptions,
 			pluginutil.WithPluginClientCreationFunc(
 				func(pluginPath string, _ ...pluginutil.Option) (*plugin.Client, error) {
-					return NewHostPluginClient(pluginPath, WithLogger(opts.withLogger))
+					return NewHostPluginClient(pluginPath, WithLogger(opts.withLogger), WithPluginArgs(opts.withPluginArgs...))
 				}),
 		)...)
 	if err != nil {
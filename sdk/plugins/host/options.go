@@ -32,6 +32,7 @@ type Option func(*options) error
 type options struct {
 	withPluginOptions []pluginutil.Option
 	withLogger        hclog.Logger
+	withPluginArgs    []string
 }
 
 func getDefaultOptions() *options {
@@ -54,3 +55,12 @@ func WithLogger(logger hclog.Logger) Option {
 		return nil
 	}
 }
+
+// WithPluginArgs provides the command line arguments to run the plugin
+// executable with
+func WithPluginArgs(args ...string) Option {
+	return func(o *options) error {
+		o.withPluginArgs = append(o.withPluginArgs, args...)
+		return nil
+	}
+}
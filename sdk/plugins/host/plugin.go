@@ -85,7 +85,7 @@ func NewHostPluginClient(pluginPath string, opt ...Option) (*plugin.Client, erro
 		VersionedPlugins: map[int]plugin.PluginSet{
 			1: {hostServicePluginSetName: hostServiceClient},
 		},
-		Cmd: exec.Command(pluginPath),
+		Cmd: exec.Command(pluginPath, opts.withPluginArgs...),
 		AllowedProtocols: []plugin.Protocol{
 			plugin.ProtocolGRPC,
 		},
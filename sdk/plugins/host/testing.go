@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package external_host_plugins
+
+import (
+	"testing"
+
+	pb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-plugin"
+)
+
+// TestHostPluginClient serves impl over an in-memory gRPC connection and
+// returns a client of it. Requests and responses are serialized just as they
+// are between the controller and a plugin running in its own process. The
+// connection is closed when the test completes.
+func TestHostPluginClient(t *testing.T, impl pb.HostPluginServiceServer) pb.HostPluginServiceClient {
+	t.Helper()
+	srv, err := NewHostPluginServiceServer(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, _ := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{hostServicePluginSetName: srv})
+	t.Cleanup(func() { _ = client.Close() })
+
+	raw, err := client.Dispense(hostServicePluginSetName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hpc, ok := raw.(pb.HostPluginServiceClient)
+	if !ok {
+		t.Fatalf("dispensed plugin is a %T, not a host plugin client", raw)
+	}
+	return hpc
+}